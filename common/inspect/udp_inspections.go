@@ -0,0 +1,39 @@
+/*
+	Copyright NetFoundry Inc.
+
+	Licensed under the Apache License, Version 2.0 (the "License");
+	you may not use this file except in compliance with the License.
+	You may obtain a copy of the License at
+
+	https://www.apache.org/licenses/LICENSE-2.0
+
+	Unless required by applicable law or agreed to in writing, software
+	distributed under the License is distributed on an "AS IS" BASIS,
+	WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+	See the License for the specific language governing permissions and
+	limitations under the License.
+*/
+
+package inspect
+
+const (
+	UdpFlowsKey = "udp-flows"
+)
+
+type UdpFlowsInspectResult struct {
+	Flows  []*UdpFlowDetail `json:"flows"`
+	Errors []string         `json:"errors"`
+}
+
+type UdpFlowDetail struct {
+	Service   string `json:"service"`
+	SrcAddr   string `json:"srcAddr"`
+	LocalAddr string `json:"localAddr"`
+	CreatedAt string `json:"createdAt"`
+	Age       string `json:"age"`
+	Idle      string `json:"idle"`
+	RxBytes   uint64 `json:"rxBytes"`
+	RxPackets uint64 `json:"rxPackets"`
+	TxBytes   uint64 `json:"txBytes"`
+	TxPackets uint64 `json:"txPackets"`
+}
@@ -0,0 +1,144 @@
+/*
+	Copyright NetFoundry Inc.
+
+	Licensed under the Apache License, Version 2.0 (the "License");
+	you may not use this file except in compliance with the License.
+	You may obtain a copy of the License at
+
+	https://www.apache.org/licenses/LICENSE-2.0
+
+	Unless required by applicable law or agreed to in writing, software
+	distributed under the License is distributed on an "AS IS" BASIS,
+	WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+	See the License for the specific language governing permissions and
+	limitations under the License.
+*/
+
+package remotewrite
+
+import (
+	"sort"
+	"strings"
+	"time"
+	"unicode"
+
+	"github.com/openziti/ziti/common/pb/remote_write_pb"
+)
+
+const MetricNameLabel = "__name__"
+
+// Batch collects the time series for one push
+type Batch struct {
+	externalLabels map[string]string
+	series         []*remote_write_pb.TimeSeries
+}
+
+func NewBatch(externalLabels map[string]string) *Batch {
+	return &Batch{
+		externalLabels: externalLabels,
+	}
+}
+
+func (self *Batch) Len() int {
+	return len(self.series)
+}
+
+func (self *Batch) Series() []*remote_write_pb.TimeSeries {
+	return self.series
+}
+
+// AddSample adds a single value for the given metric. Names and label names are converted to valid Prometheus names
+func (self *Batch) AddSample(name string, labels map[string]string, value float64, timestamp time.Time) {
+	self.series = append(self.series, &remote_write_pb.TimeSeries{
+		Labels: self.labels(name, labels),
+		Samples: []*remote_write_pb.Sample{{
+			Value:     value,
+			Timestamp: timestamp.UnixMilli(),
+		}},
+	})
+}
+
+// AddHistogram adds a native histogram for the given metric
+func (self *Batch) AddHistogram(name string, labels map[string]string, histogram *remote_write_pb.Histogram) {
+	self.series = append(self.series, &remote_write_pb.TimeSeries{
+		Labels:     self.labels(name, labels),
+		Histograms: []*remote_write_pb.Histogram{histogram},
+	})
+}
+
+// AddSummary adds the count and quantiles of a histogram or timer, following the Prometheus summary conventions
+func (self *Batch) AddSummary(name string, labels map[string]string, count float64, quantiles map[string]float64, timestamp time.Time) {
+	self.AddSample(name+"_count", labels, count, timestamp)
+	for quantile, value := range quantiles {
+		quantileLabels := map[string]string{"quantile": quantile}
+		for k, v := range labels {
+			quantileLabels[k] = v
+		}
+		self.AddSample(name, quantileLabels, value, timestamp)
+	}
+}
+
+func (self *Batch) labels(name string, labels map[string]string) []*remote_write_pb.Label {
+	merged := map[string]string{}
+	for k, v := range self.externalLabels {
+		merged[LabelName(k)] = v
+	}
+	for k, v := range labels {
+		if v != "" {
+			merged[LabelName(k)] = v
+		}
+	}
+	merged[MetricNameLabel] = MetricName(name)
+
+	result := make([]*remote_write_pb.Label, 0, len(merged))
+	for k, v := range merged {
+		result = append(result, &remote_write_pb.Label{Name: k, Value: v})
+	}
+
+	// remote write receivers require labels to be sorted by name
+	sort.Slice(result, func(i, j int) bool {
+		return result[i].Name < result[j].Name
+	})
+	return result
+}
+
+// MetricName converts a ziti metric name, such as link.latency, into a Prometheus metric name, such as
+// ziti_link_latency
+func MetricName(name string) string {
+	key := strings.ReplaceAll(name, " ", "_")
+	key = strings.ReplaceAll(key, ".", "_")
+	key = strings.ReplaceAll(key, "-", "_")
+	key = strings.ReplaceAll(key, "=", "_")
+	key = strings.ReplaceAll(key, "/", "_")
+	key = strings.ReplaceAll(key, ":", "")
+
+	// Prometheus complains about metrics ending in _count, so "fix" that.
+	if strings.HasSuffix(key, "_count") {
+		key = strings.TrimSuffix(key, "_count") + "_c"
+	}
+
+	return "ziti_" + key
+}
+
+// LabelName converts a tag name, such as sourceRouterId, into a Prometheus label name, such as source_router_id
+func LabelName(name string) string {
+	if strings.HasPrefix(name, "__") {
+		return name
+	}
+
+	var b strings.Builder
+	for i, r := range name {
+		switch {
+		case r >= 'A' && r <= 'Z':
+			if i > 0 {
+				b.WriteRune('_')
+			}
+			b.WriteRune(unicode.ToLower(r))
+		case r == '_' || (r >= 'a' && r <= 'z') || (i > 0 && r >= '0' && r <= '9'):
+			b.WriteRune(r)
+		default:
+			b.WriteRune('_')
+		}
+	}
+	return b.String()
+}
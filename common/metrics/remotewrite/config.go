@@ -0,0 +1,155 @@
+/*
+	Copyright NetFoundry Inc.
+
+	Licensed under the Apache License, Version 2.0 (the "License");
+	you may not use this file except in compliance with the License.
+	You may obtain a copy of the License at
+
+	https://www.apache.org/licenses/LICENSE-2.0
+
+	Unless required by applicable law or agreed to in writing, software
+	distributed under the License is distributed on an "AS IS" BASIS,
+	WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+	See the License for the specific language governing permissions and
+	limitations under the License.
+*/
+
+package remotewrite
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/pkg/errors"
+)
+
+const (
+	DefaultInterval            = 15 * time.Second
+	DefaultTimeout             = 10 * time.Second
+	DefaultHistogramSchema     = 3
+	DefaultMaxSeriesPerRequest = 2000
+	DefaultBufferSize          = 10000
+
+	MinHistogramSchema = -4
+	MaxHistogramSchema = 8
+)
+
+// Config defines where and how metrics are pushed using the Prometheus remote write protocol
+type Config struct {
+	Url                 string
+	Interval            time.Duration
+	Timeout             time.Duration
+	Headers             map[string]string
+	ExternalLabels      map[string]string
+	NativeHistograms    bool
+	HistogramSchema     int32
+	MaxSeriesPerRequest int
+	BufferSize          int
+}
+
+// LoadConfig loads remote write configuration from a config map. The url is required, everything else is optional
+func LoadConfig(src map[interface{}]interface{}) (*Config, error) {
+	result := &Config{
+		Interval:            DefaultInterval,
+		Timeout:             DefaultTimeout,
+		Headers:             map[string]string{},
+		ExternalLabels:      map[string]string{},
+		HistogramSchema:     DefaultHistogramSchema,
+		MaxSeriesPerRequest: DefaultMaxSeriesPerRequest,
+		BufferSize:          DefaultBufferSize,
+	}
+
+	if value, found := src["url"]; found {
+		if url, ok := value.(string); ok && url != "" {
+			result.Url = url
+		} else {
+			return nil, errors.New("invalid value for remote write 'url', must be a non-empty string")
+		}
+	} else {
+		return nil, errors.New("remote write 'url' is required")
+	}
+
+	var err error
+	if result.Interval, err = loadDuration(src, "interval", result.Interval); err != nil {
+		return nil, err
+	}
+
+	if result.Timeout, err = loadDuration(src, "timeout", result.Timeout); err != nil {
+		return nil, err
+	}
+
+	if result.Headers, err = loadStringMap(src, "headers"); err != nil {
+		return nil, err
+	}
+
+	if result.ExternalLabels, err = loadStringMap(src, "externalLabels"); err != nil {
+		return nil, err
+	}
+
+	if value, found := src["nativeHistograms"]; found {
+		if enabled, ok := value.(bool); ok {
+			result.NativeHistograms = enabled
+		} else {
+			return nil, errors.New("invalid value for remote write 'nativeHistograms', must be a boolean")
+		}
+	}
+
+	if value, found := src["histogramSchema"]; found {
+		if schema, ok := value.(int); ok && schema >= MinHistogramSchema && schema <= MaxHistogramSchema {
+			result.HistogramSchema = int32(schema)
+		} else {
+			return nil, errors.Errorf("invalid value for remote write 'histogramSchema', must be an integer between %d and %d",
+				MinHistogramSchema, MaxHistogramSchema)
+		}
+	}
+
+	if value, found := src["maxSeriesPerRequest"]; found {
+		if count, ok := value.(int); ok && count > 0 {
+			result.MaxSeriesPerRequest = count
+		} else {
+			return nil, errors.New("invalid value for remote write 'maxSeriesPerRequest', must be a positive integer")
+		}
+	}
+
+	if value, found := src["bufferSize"]; found {
+		if size, ok := value.(int); ok && size > 0 {
+			result.BufferSize = size
+		} else {
+			return nil, errors.New("invalid value for remote write 'bufferSize', must be a positive integer")
+		}
+	}
+
+	return result, nil
+}
+
+func loadDuration(src map[interface{}]interface{}, key string, defaultValue time.Duration) (time.Duration, error) {
+	value, found := src[key]
+	if !found {
+		return defaultValue, nil
+	}
+
+	if s, ok := value.(string); ok {
+		if d, err := time.ParseDuration(s); err == nil && d > 0 {
+			return d, nil
+		}
+	}
+	return 0, errors.Errorf("invalid value for remote write '%s', must be a positive duration, such as '15s'", key)
+}
+
+func loadStringMap(src map[interface{}]interface{}, key string) (map[string]string, error) {
+	result := map[string]string{}
+	value, found := src[key]
+	if !found {
+		return result, nil
+	}
+
+	m, ok := value.(map[interface{}]interface{})
+	if !ok {
+		return nil, errors.Errorf("invalid value for remote write '%s', must be a map", key)
+	}
+
+	for k, v := range m {
+		result[fmt.Sprintf("%v", k)] = fmt.Sprintf("%v", v)
+	}
+	return result, nil
+}
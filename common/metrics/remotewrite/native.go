@@ -0,0 +1,108 @@
+/*
+	Copyright NetFoundry Inc.
+
+	Licensed under the Apache License, Version 2.0 (the "License");
+	you may not use this file except in compliance with the License.
+	You may obtain a copy of the License at
+
+	https://www.apache.org/licenses/LICENSE-2.0
+
+	Unless required by applicable law or agreed to in writing, software
+	distributed under the License is distributed on an "AS IS" BASIS,
+	WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+	See the License for the specific language governing permissions and
+	limitations under the License.
+*/
+
+package remotewrite
+
+import (
+	"math"
+	"sort"
+	"time"
+
+	"github.com/openziti/ziti/common/pb/remote_write_pb"
+)
+
+// NewGaugeHistogram builds a native histogram from the sampled values of a histogram, using exponential buckets with
+// the given schema. Bucket boundaries grow by a factor of 2^(2^-schema). The samples are a window of recent values,
+// rather than a cumulative count, so the histogram is reported as a gauge histogram.
+func NewGaugeHistogram(values []int64, schema int32, timestamp time.Time) *remote_write_pb.Histogram {
+	positive := map[int32]uint64{}
+	negative := map[int32]uint64{}
+	var zeroCount uint64
+	var sum float64
+
+	for _, value := range values {
+		v := float64(value)
+		sum += v
+		switch {
+		case value == 0:
+			zeroCount++
+		case value > 0:
+			positive[bucketIndex(v, schema)]++
+		default:
+			negative[bucketIndex(-v, schema)]++
+		}
+	}
+
+	result := &remote_write_pb.Histogram{
+		Count:     &remote_write_pb.Histogram_CountInt{CountInt: uint64(len(values))},
+		Sum:       sum,
+		Schema:    schema,
+		ZeroCount: &remote_write_pb.Histogram_ZeroCountInt{ZeroCountInt: zeroCount},
+		ResetHint: remote_write_pb.Histogram_GAUGE,
+		Timestamp: timestamp.UnixMilli(),
+	}
+
+	result.PositiveSpans, result.PositiveDeltas = encodeBuckets(positive)
+	result.NegativeSpans, result.NegativeDeltas = encodeBuckets(negative)
+
+	return result
+}
+
+// bucketIndex returns the index of the bucket holding v. Bucket i covers (base^(i-1), base^i], where
+// base is 2^(2^-schema)
+func bucketIndex(v float64, schema int32) int32 {
+	scale := math.Exp2(float64(schema))
+	if frac, exp := math.Frexp(v); frac == 0.5 {
+		// exact powers of two are the upper bound of their bucket. They're handled separately, so that rounding errors
+		// in log2 can't put them in the next bucket
+		return int32(math.Ceil(float64(exp-1) * scale))
+	}
+	return int32(math.Ceil(math.Log2(v) * scale))
+}
+
+// encodeBuckets converts bucket counts into the span and delta encoding used by native histograms. Spans give runs of
+// consecutive buckets, with each offset relative to the end of the previous span. Counts are given as the difference
+// from the previous bucket
+func encodeBuckets(counts map[int32]uint64) ([]*remote_write_pb.BucketSpan, []int64) {
+	if len(counts) == 0 {
+		return nil, nil
+	}
+
+	indices := make([]int32, 0, len(counts))
+	for idx := range counts {
+		indices = append(indices, idx)
+	}
+	sort.Slice(indices, func(i, j int) bool { return indices[i] < indices[j] })
+
+	var spans []*remote_write_pb.BucketSpan
+	var deltas []int64
+	var prevCount int64
+	for i, idx := range indices {
+		if i == 0 {
+			spans = append(spans, &remote_write_pb.BucketSpan{Offset: idx, Length: 1})
+		} else if idx == indices[i-1]+1 {
+			spans[len(spans)-1].Length++
+		} else {
+			spans = append(spans, &remote_write_pb.BucketSpan{Offset: idx - indices[i-1] - 1, Length: 1})
+		}
+
+		count := int64(counts[idx])
+		deltas = append(deltas, count-prevCount)
+		prevCount = count
+	}
+
+	return spans, deltas
+}
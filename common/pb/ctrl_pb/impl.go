@@ -125,3 +125,31 @@ func (request *LinkStateUpdate) GetContentType() int32 {
 func (request *Alerts) GetContentType() int32 {
 	return int32(ContentType_AlertsType)
 }
+
+func (request *ProbeRequest) GetContentType() int32 {
+	return int32(ContentType_ProbeRequestType)
+}
+
+func (response *ProbeResponse) GetContentType() int32 {
+	return int32(ContentType_ProbeResponseType)
+}
+
+func (request *CircuitRehomeRequest) GetContentType() int32 {
+	return int32(ContentType_CircuitRehomeRequestType)
+}
+
+func (response *CircuitRehomeResponse) GetContentType() int32 {
+	return int32(ContentType_CircuitRehomeResponseType)
+}
+
+func (request *CircuitFragmentsRequest) GetContentType() int32 {
+	return int32(ContentType_CircuitFragmentsRequestType)
+}
+
+func (response *CircuitFragmentsResponse) GetContentType() int32 {
+	return int32(ContentType_CircuitFragmentsResponseType)
+}
+
+func (request *CircuitOwnershipUpdate) GetContentType() int32 {
+	return int32(ContentType_CircuitOwnershipUpdateType)
+}
@@ -32,6 +32,14 @@ func (x *UpdateServiceConfigsCmd) GetCommandType() int32 {
 	return int32(CommandType_UpdateServiceConfigsType)
 }
 
+func (x *UpdateQuotaUsageCmd) GetCommandType() int32 {
+	return int32(CommandType_UpdateQuotaUsageType)
+}
+
+func (x *BulkUpdateIdentitiesCmd) GetCommandType() int32 {
+	return int32(CommandType_BulkUpdateIdentitiesType)
+}
+
 func EncodeTags(tags map[string]interface{}) (map[string]*TagValue, error) {
 	if len(tags) == 0 {
 		return nil, nil
@@ -67,6 +67,8 @@ func (x *DataState_Event) Summarize() string {
 		return fmt.Sprintf("%s public-key %s", x.Action.String(), v.PublicKey.Kid)
 	case *DataState_Event_Revocation:
 		return fmt.Sprintf("%s revocation %s", x.Action.String(), v.Revocation.Id)
+	case *DataState_Event_BandwidthLimit:
+		return fmt.Sprintf("%s bandwidth-limit %s", x.Action.String(), v.BandwidthLimit.Id)
 	case *DataState_Event_ServicePolicyChange:
 		return fmt.Sprintf("%s service-policy-change %s add: %v, type: %s",
 			x.Action.String(), v.ServicePolicyChange.PolicyId, v.ServicePolicyChange.Add, v.ServicePolicyChange.RelatedEntityType.String())
@@ -128,6 +128,10 @@ func (request *EnrollmentExtendRouterVerifyRequest) GetContentType() int32 {
 	return int32(ContentType_EnrollmentExtendRouterVerifyRequestType)
 }
 
+func (request *EnrollmentExtendRouterTrigger) GetContentType() int32 {
+	return int32(ContentType_EnrollmentExtendRouterTriggerType)
+}
+
 func (request *CreateTunnelTerminatorRequest) GetXtPrecedence() xt.Precedence {
 	if request.GetPrecedence() == TerminatorPrecedence_Failed {
 		return xt.Precedences.Failed
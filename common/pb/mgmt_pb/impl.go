@@ -103,3 +103,15 @@ func (request *RouterCircuitDetails) GetContentType() int32 {
 func (msg *RouterCircuitDetail) IsInErrorState() bool {
 	return msg.MissingInCtrl || msg.MissingInForwarder || msg.MissingInEdge || msg.MissingInSdk
 }
+
+func (request *ValidateResidencyRequest) GetContentType() int32 {
+	return int32(ContentType_ValidateResidencyRequestType)
+}
+
+func (request *ValidateResidencyResponse) GetContentType() int32 {
+	return int32(ContentType_ValidateResidencyResponseType)
+}
+
+func (request *ServiceResidencyDetail) GetContentType() int32 {
+	return int32(ContentType_ValidateResidencyResultType)
+}
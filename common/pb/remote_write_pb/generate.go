@@ -0,0 +1,5 @@
+//go:generate protoc -I ./ ./remote_write.proto --go_out=paths=source_relative:./
+
+package remote_write_pb
+
+// Here to provide the go:generate line above
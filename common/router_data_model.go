@@ -167,6 +167,59 @@ type PostureCheck struct {
 	index uint64
 }
 
+type DataStateBandwidthLimit = edge_ctrl_pb.DataState_BandwidthLimit
+
+// BandwidthLimit is a bandwidth limit with its resolved identity, service and router sets indexed for lookup
+type BandwidthLimit struct {
+	*DataStateBandwidthLimit
+	identities map[string]struct{}
+	services   map[string]struct{}
+	routers    map[string]struct{}
+	index      uint64
+}
+
+func newBandwidthLimit(model *DataStateBandwidthLimit, index uint64) *BandwidthLimit {
+	toSet := func(ids []string) map[string]struct{} {
+		result := make(map[string]struct{}, len(ids))
+		for _, id := range ids {
+			result[id] = struct{}{}
+		}
+		return result
+	}
+
+	return &BandwidthLimit{
+		DataStateBandwidthLimit: model,
+		identities:              toSet(model.IdentityIds),
+		services:                toSet(model.ServiceIds),
+		routers:                 toSet(model.RouterIds),
+		index:                   index,
+	}
+}
+
+// Matches returns true if the limit applies to traffic from the given identity, for the given service,
+// entering the fabric at the given router
+func (self *BandwidthLimit) Matches(routerId, identityId, serviceId string) bool {
+	if !self.AllRouters {
+		if _, ok := self.routers[routerId]; !ok {
+			return false
+		}
+	}
+
+	if !self.AllIdentities {
+		if _, ok := self.identities[identityId]; !ok {
+			return false
+		}
+	}
+
+	if !self.AllServices {
+		if _, ok := self.services[serviceId]; !ok {
+			return false
+		}
+	}
+
+	return true
+}
+
 type DataStateServicePolicy = edge_ctrl_pb.DataState_ServicePolicy
 
 type ServicePolicy struct {
@@ -192,8 +245,13 @@ type RouterDataModel struct {
 	PostureChecks    cmap.ConcurrentMap[string, *PostureCheck]                      `json:"postureChecks"`
 	PublicKeys       cmap.ConcurrentMap[string, *edge_ctrl_pb.DataState_PublicKey]  `json:"publicKeys"`
 	Revocations      cmap.ConcurrentMap[string, *edge_ctrl_pb.DataState_Revocation] `json:"revocations"`
+	BandwidthLimits  cmap.ConcurrentMap[string, *BandwidthLimit]                    `json:"bandwidthLimits"`
 	cachedPublicKeys concurrenz.AtomicValue[map[string]crypto.PublicKey]
 
+	// bandwidthLimitsVersion is incremented whenever a bandwidth limit changes, so that consumers caching
+	// limit lookups know when to re-resolve
+	bandwidthLimitsVersion *atomic.Uint64
+
 	terminatorIdCache cmap.ConcurrentMap[string, string]
 
 	listenerBufferSize uint
@@ -222,7 +280,10 @@ func NewBareRouterDataModel() *RouterDataModel {
 		PostureChecks:     cmap.New[*PostureCheck](),
 		PublicKeys:        cmap.New[*edge_ctrl_pb.DataState_PublicKey](),
 		Revocations:       cmap.New[*edge_ctrl_pb.DataState_Revocation](),
+		BandwidthLimits:   cmap.New[*BandwidthLimit](),
 		terminatorIdCache: cmap.New[string](),
+
+		bandwidthLimitsVersion: &atomic.Uint64{},
 	}
 }
 
@@ -239,9 +300,12 @@ func NewSenderRouterDataModel(timelineId string, logSize uint64, listenerBufferS
 		PostureChecks:      cmap.New[*PostureCheck](),
 		PublicKeys:         cmap.New[*edge_ctrl_pb.DataState_PublicKey](),
 		Revocations:        cmap.New[*edge_ctrl_pb.DataState_Revocation](),
+		BandwidthLimits:    cmap.New[*BandwidthLimit](),
 		listenerBufferSize: listenerBufferSize,
 		timelineId:         timelineId,
 		terminatorIdCache:  cmap.New[string](),
+
+		bandwidthLimitsVersion: &atomic.Uint64{},
 	}
 }
 
@@ -258,12 +322,15 @@ func NewReceiverRouterDataModel(listenerBufferSize uint, closeNotify <-chan stru
 		PostureChecks:      cmap.New[*PostureCheck](),
 		PublicKeys:         cmap.New[*edge_ctrl_pb.DataState_PublicKey](),
 		Revocations:        cmap.New[*edge_ctrl_pb.DataState_Revocation](),
+		BandwidthLimits:    cmap.New[*BandwidthLimit](),
 		listenerBufferSize: listenerBufferSize,
 		subscriptions:      cmap.New[*IdentitySubscription](),
 		events:             make(chan subscriberEvent),
 		closeNotify:        closeNotify,
 		stopNotify:         make(chan struct{}),
 		terminatorIdCache:  cmap.New[string](),
+
+		bandwidthLimitsVersion: &atomic.Uint64{},
 	}
 	go result.processSubscriberEvents()
 	return result
@@ -282,6 +349,7 @@ func NewReceiverRouterDataModelFromDataState(dataState *edge_ctrl_pb.DataState,
 		PostureChecks:      cmap.New[*PostureCheck](),
 		PublicKeys:         cmap.New[*edge_ctrl_pb.DataState_PublicKey](),
 		Revocations:        cmap.New[*edge_ctrl_pb.DataState_Revocation](),
+		BandwidthLimits:    cmap.New[*BandwidthLimit](),
 		listenerBufferSize: listenerBufferSize,
 		subscriptions:      cmap.New[*IdentitySubscription](),
 		events:             make(chan subscriberEvent),
@@ -289,6 +357,8 @@ func NewReceiverRouterDataModelFromDataState(dataState *edge_ctrl_pb.DataState,
 		stopNotify:         make(chan struct{}),
 		timelineId:         dataState.TimelineId,
 		terminatorIdCache:  cmap.New[string](),
+
+		bandwidthLimitsVersion: &atomic.Uint64{},
 	}
 
 	if tIdCache, ok := dataState.Caches[edge_ctrl_pb.CacheType_TerminatorIds.String()]; ok && tIdCache != nil && tIdCache.Data != nil {
@@ -323,6 +393,7 @@ func NewReceiverRouterDataModelFromExisting(existing *RouterDataModel, listenerB
 		PublicKeys:         existing.PublicKeys,
 		cachedPublicKeys:   existing.cachedPublicKeys,
 		Revocations:        existing.Revocations,
+		BandwidthLimits:    existing.BandwidthLimits,
 		listenerBufferSize: listenerBufferSize,
 		subscriptions:      cmap.New[*IdentitySubscription](),
 		events:             make(chan subscriberEvent),
@@ -330,6 +401,8 @@ func NewReceiverRouterDataModelFromExisting(existing *RouterDataModel, listenerB
 		stopNotify:         make(chan struct{}),
 		timelineId:         existing.timelineId,
 		terminatorIdCache:  existing.terminatorIdCache,
+
+		bandwidthLimitsVersion: existing.bandwidthLimitsVersion,
 	}
 	currentIndex, _ := existing.CurrentIndex()
 	result.SetCurrentIndex(currentIndex)
@@ -474,6 +547,9 @@ func (rdm *RouterDataModel) Handle(index uint64, event *edge_ctrl_pb.DataState_E
 		return false // don't affect identity subscribers, so don't require a sync
 	case *edge_ctrl_pb.DataState_Event_ServicePolicyChange:
 		rdm.HandleServicePolicyChange(index, typedModel.ServicePolicyChange)
+	case *edge_ctrl_pb.DataState_Event_BandwidthLimit:
+		rdm.HandleBandwidthLimitEvent(index, event, typedModel)
+		return false // don't affect identity subscribers, so don't require a sync
 	}
 	return true
 }
@@ -645,6 +721,33 @@ func (rdm *RouterDataModel) HandleRevocationEvent(event *edge_ctrl_pb.DataState_
 	}
 }
 
+// HandleBandwidthLimitEvent will apply the delta event to the router data model. It is not restricted by index calculations.
+func (rdm *RouterDataModel) HandleBandwidthLimitEvent(index uint64, event *edge_ctrl_pb.DataState_Event, model *edge_ctrl_pb.DataState_Event_BandwidthLimit) {
+	if event.Action == edge_ctrl_pb.DataState_Delete {
+		rdm.BandwidthLimits.Remove(model.BandwidthLimit.Id)
+	} else {
+		rdm.BandwidthLimits.Set(model.BandwidthLimit.Id, newBandwidthLimit(model.BandwidthLimit, index))
+	}
+	rdm.bandwidthLimitsVersion.Add(1)
+}
+
+// GetBandwidthLimitsVersion returns a counter which changes whenever the set of bandwidth limits changes
+func (rdm *RouterDataModel) GetBandwidthLimitsVersion() uint64 {
+	return rdm.bandwidthLimitsVersion.Load()
+}
+
+// GetMatchingBandwidthLimits returns the bandwidth limits which apply to traffic from the given identity, for the
+// given service, entering the fabric at the given router
+func (rdm *RouterDataModel) GetMatchingBandwidthLimits(routerId, identityId, serviceId string) []*BandwidthLimit {
+	var result []*BandwidthLimit
+	rdm.BandwidthLimits.IterCb(func(_ string, v *BandwidthLimit) {
+		if v.Matches(routerId, identityId, serviceId) {
+			result = append(result, v)
+		}
+	})
+	return result
+}
+
 func (rdm *RouterDataModel) HandleServicePolicyChange(index uint64, model *edge_ctrl_pb.DataState_ServicePolicyChange) {
 	pfxlog.Logger().
 		WithField("policyId", model.PolicyId).
@@ -865,6 +968,16 @@ func (rdm *RouterDataModel) getDataStateAlreadyLocked(index uint64) *edge_ctrl_p
 		}
 	})
 
+	rdm.BandwidthLimits.IterCb(func(key string, v *BandwidthLimit) {
+		newEvent := &edge_ctrl_pb.DataState_Event{
+			Action: edge_ctrl_pb.DataState_Create,
+			Model: &edge_ctrl_pb.DataState_Event_BandwidthLimit{
+				BandwidthLimit: v.DataStateBandwidthLimit,
+			},
+		}
+		events = append(events, newEvent)
+	})
+
 	rdm.PublicKeys.IterCb(func(key string, v *edge_ctrl_pb.DataState_PublicKey) {
 		newEvent := &edge_ctrl_pb.DataState_Event{
 			Action: edge_ctrl_pb.DataState_Create,
@@ -1153,6 +1266,7 @@ func (rdm *RouterDataModel) GetEntityCounts() map[string]uint32 {
 		"posture-checks":     uint32(rdm.PostureChecks.Count()),
 		"public-keys":        uint32(rdm.PublicKeys.Count()),
 		"revocations":        uint32(rdm.Revocations.Count()),
+		"bandwidth-limits":   uint32(rdm.BandwidthLimits.Count()),
 		"cached-public-keys": uint32(rdm.getPublicKeysAsCmap().Count()),
 	}
 	return result
@@ -1212,6 +1326,7 @@ func (rdm *RouterDataModel) Diff(o *RouterDataModel, sink DiffSink) {
 		edge_ctrl_pb.DataState_PostureCheck_ProcessMulti_{}, edge_ctrl_pb.DataState_PostureCheck_ProcessMulti{})
 	diffType("public-keys", rdm.PublicKeys, o.PublicKeys, sink, edge_ctrl_pb.DataState_PublicKey{})
 	diffType("revocations", rdm.Revocations, o.Revocations, sink, edge_ctrl_pb.DataState_Revocation{})
+	diffType("bandwidth-limits", rdm.BandwidthLimits, o.BandwidthLimits, sink, BandwidthLimit{}, DataStateBandwidthLimit{})
 	diffMaps("cached-public-keys", rdm.getPublicKeysAsCmap(), o.getPublicKeysAsCmap(), sink, func(a, b crypto.PublicKey) []string {
 		if a == nil || b == nil {
 			return []string{fmt.Sprintf("cached public key is nil: orig: %v, dest: %v", a, b)}
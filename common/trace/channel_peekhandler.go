@@ -61,7 +61,9 @@ func (self *ChannelPeekHandler) ToggleTracing(sourceType SourceType, matcher Sou
 			self.enabled.Store(true)
 			self.eventSinks.Append(handler)
 		} else {
-			self.eventSinks.Delete(handler)
+			self.eventSinks.DeleteIf(func(sink EventHandler) bool {
+				return isSameHandler(sink, handler)
+			})
 			if len(self.eventSinks.Value()) == 0 {
 				self.enabled.Store(false)
 			}
@@ -0,0 +1,98 @@
+/*
+	Copyright NetFoundry Inc.
+
+	Licensed under the Apache License, Version 2.0 (the "License");
+	you may not use this file except in compliance with the License.
+	You may obtain a copy of the License at
+
+	https://www.apache.org/licenses/LICENSE-2.0
+
+	Unless required by applicable law or agreed to in writing, software
+	distributed under the License is distributed on an "AS IS" BASIS,
+	WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+	See the License for the specific language governing permissions and
+	limitations under the License.
+*/
+
+package trace
+
+import (
+	"encoding/json"
+
+	"github.com/openziti/channel/v4"
+	"github.com/openziti/sdk-golang/xgress"
+)
+
+const (
+	// CapturePayloadHeader is set on toggle pipe traces requests to ask that xgress payload traces include the
+	// payload data
+	CapturePayloadHeader = 1200
+
+	// CapturePayloadMaxBytesHeader limits the payload data captured for each payload. If not set, or zero, full
+	// payloads are captured
+	CapturePayloadMaxBytesHeader = 1201
+
+	// PayloadDataField is the field of an xgress payload trace decode which holds the captured payload data
+	PayloadDataField = "data"
+)
+
+// PayloadCaptureHandler wraps an EventHandler, asking that the xgress payload traces sent to it include the
+// payload data
+type PayloadCaptureHandler struct {
+	EventHandler
+	MaxBytes uint32
+}
+
+// NewPayloadCaptureHandler wraps the handler in a PayloadCaptureHandler if the toggle pipe traces request asks for
+// payloads to be captured. Otherwise, the handler is returned unchanged
+func NewPayloadCaptureHandler(handler EventHandler, request *channel.Message) EventHandler {
+	if capture, _ := request.Headers.GetBoolHeader(CapturePayloadHeader); !capture {
+		return handler
+	}
+	maxBytes, _ := request.Headers.GetUint32Header(CapturePayloadMaxBytesHeader)
+	return PayloadCaptureHandler{EventHandler: handler, MaxBytes: maxBytes}
+}
+
+// CopyPayloadCaptureHeaders copies the payload capture headers of a toggle pipe traces request onto a request
+// being forwarded to routers
+func CopyPayloadCaptureHeaders(from, to *channel.Message) {
+	for _, key := range []int32{CapturePayloadHeader, CapturePayloadMaxBytesHeader} {
+		if val, found := from.Headers[key]; found {
+			to.Headers[key] = val
+		}
+	}
+}
+
+// isSameHandler returns true if the handlers are the same, ignoring any payload capture wrapper, so that disabling
+// tracing removes handlers regardless of whether payloads were being captured
+func isSameHandler(a, b EventHandler) bool {
+	if wrapper, ok := a.(PayloadCaptureHandler); ok {
+		a = wrapper.EventHandler
+	}
+	if wrapper, ok := b.(PayloadCaptureHandler); ok {
+		b = wrapper.EventHandler
+	}
+	return a == b
+}
+
+// DecodePayloadWithData returns the trace decode of the payload, including the payload data, truncated to maxBytes
+// if maxBytes is greater than zero
+func DecodePayloadWithData(payload *xgress.Payload, maxBytes uint32) []byte {
+	decode, _ := xgress.DecodePayload(payload)
+
+	meta := channel.TraceMessageDecode{}
+	if err := json.Unmarshal(decode, &meta); err != nil {
+		return decode
+	}
+
+	data := payload.Data
+	if maxBytes > 0 && uint32(len(data)) > maxBytes {
+		data = data[:maxBytes]
+	}
+	meta[PayloadDataField] = data
+
+	if result, err := meta.MarshalTraceMessageDecode(); err == nil {
+		return result
+	}
+	return decode
+}
@@ -0,0 +1,452 @@
+/*
+	Copyright NetFoundry Inc.
+
+	Licensed under the Apache License, Version 2.0 (the "License");
+	you may not use this file except in compliance with the License.
+	You may obtain a copy of the License at
+
+	https://www.apache.org/licenses/LICENSE-2.0
+
+	Unless required by applicable law or agreed to in writing, software
+	distributed under the License is distributed on an "AS IS" BASIS,
+	WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+	See the License for the specific language governing permissions and
+	limitations under the License.
+*/
+
+// Package pcap reconstructs the byte streams of circuits from xgress payload traces and writes them as pcapng, so
+// they can be opened in Wireshark. Each circuit is written as a TCP connection or UDP flow between the addresses
+// of the circuit, with synthesized IP and TCP/UDP headers.
+package pcap
+
+import (
+	"encoding/binary"
+	"encoding/json"
+	"io"
+	"net"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/google/gopacket"
+	"github.com/google/gopacket/layers"
+	"github.com/google/gopacket/pcapgo"
+	trace_pb "github.com/openziti/channel/v4/trace/pb"
+	"github.com/openziti/sdk-golang/xgress"
+	"github.com/pkg/errors"
+)
+
+const (
+	ProtocolTCP = "tcp"
+	ProtocolUDP = "udp"
+
+	// maxSegmentSize is the largest amount of payload data written in a single packet. Larger payloads are split
+	maxSegmentSize = 32 * 1024
+
+	// maxReorderBuffer is the number of out of order payloads buffered per direction before a gap in the
+	// sequence is skipped over
+	maxReorderBuffer = 256
+
+	// reorderWindow is how long a gap in the sequence is waited on before it's skipped over
+	reorderWindow = time.Second
+
+	syntheticServerPort = 10000
+	syntheticClientPort = 40000
+)
+
+// Payload is an xgress payload captured from a trace
+type Payload struct {
+	CircuitId  string
+	Sequence   int32
+	Originator xgress.Originator
+	Flags      uint32
+	// Length is the length of the payload, which may be greater than len(Data) if the capture was truncated
+	Length    int
+	Data      []byte
+	Timestamp time.Time
+}
+
+func (self *Payload) isEnd() bool {
+	return self.Flags&(uint32(xgress.PayloadFlagCircuitEnd)|uint32(xgress.PayloadFlagEOF)) != 0
+}
+
+type payloadDecode struct {
+	CircuitId  string `json:"circuitId"`
+	Sequence   int32  `json:"sequence"`
+	Originator string `json:"originator"`
+	Flags      uint32 `json:"flags"`
+	Length     int    `json:"length"`
+	Data       []byte `json:"data"`
+}
+
+// ParsePayloadTrace extracts the captured payload from an xgress payload trace. It returns false if the trace isn't
+// a payload trace or doesn't include the payload data
+func ParsePayloadTrace(event *trace_pb.ChannelMessage) (*Payload, bool) {
+	if event.ContentType != xgress.ContentTypePayloadType || len(event.Decode) == 0 {
+		return nil, false
+	}
+
+	decode := &payloadDecode{}
+	if err := json.Unmarshal(event.Decode, decode); err != nil || decode.CircuitId == "" {
+		return nil, false
+	}
+
+	if decode.Data == nil && decode.Length > 0 {
+		return nil, false
+	}
+
+	result := &Payload{
+		CircuitId:  decode.CircuitId,
+		Sequence:   decode.Sequence,
+		Originator: xgress.Initiator,
+		Flags:      decode.Flags,
+		Length:     decode.Length,
+		Data:       decode.Data,
+		Timestamp:  time.Unix(0, event.Timestamp),
+	}
+
+	if decode.Originator == "e" {
+		result.Originator = xgress.Terminator
+	}
+
+	return result, true
+}
+
+// AddressResolver returns the client and server addresses of a circuit, in host:port form. If either can't be
+// resolved, addresses are synthesized
+type AddressResolver func(circuitId string) (clientAddr, serverAddr string)
+
+// Writer writes captured payloads to a pcapng file. Payloads may be delivered out of order or more than once, as
+// happens when both ends of a circuit are on the traced router. They are reordered and de-duplicated using the
+// payload sequence. Writer isn't safe for concurrent use.
+type Writer struct {
+	out      *pcapgo.NgWriter
+	protocol string
+	resolver AddressResolver
+	circuits map[string]*circuitStream
+}
+
+func NewWriter(w io.Writer, protocol string, resolver AddressResolver) (*Writer, error) {
+	if protocol != ProtocolTCP && protocol != ProtocolUDP {
+		return nil, errors.Errorf("unsupported protocol '%s', must be %s or %s", protocol, ProtocolTCP, ProtocolUDP)
+	}
+
+	out, err := pcapgo.NewNgWriter(w, layers.LinkTypeRaw)
+	if err != nil {
+		return nil, err
+	}
+
+	return &Writer{
+		out:      out,
+		protocol: protocol,
+		resolver: resolver,
+		circuits: map[string]*circuitStream{},
+	}, nil
+}
+
+// Write adds a captured payload to the capture
+func (self *Writer) Write(payload *Payload) error {
+	stream, err := self.getStream(payload)
+	if err != nil {
+		return err
+	}
+
+	dir := stream.directions[payload.Originator]
+	if payload.Sequence < dir.next {
+		return nil
+	}
+	if _, found := dir.pending[payload.Sequence]; found {
+		return nil
+	}
+	dir.pending[payload.Sequence] = payload
+
+	for {
+		next, found := dir.pending[dir.next]
+		if !found {
+			earliest := dir.earliestPending()
+			if earliest == nil || (len(dir.pending) <= maxReorderBuffer && payload.Timestamp.Sub(earliest.Timestamp) < reorderWindow) {
+				return self.out.Flush()
+			}
+			// assume the missing payloads were lost, or were sent before tracing was enabled, and skip to the
+			// earliest buffered payload
+			dir.next = earliest.Sequence
+			continue
+		}
+
+		delete(dir.pending, dir.next)
+		dir.next++
+
+		if err = stream.write(self, next); err != nil {
+			return err
+		}
+	}
+}
+
+// Close writes any buffered payloads, skipping over gaps in their sequences, and flushes the capture
+func (self *Writer) Close() error {
+	ids := make([]string, 0, len(self.circuits))
+	for id := range self.circuits {
+		ids = append(ids, id)
+	}
+	sort.Strings(ids)
+
+	for _, id := range ids {
+		stream := self.circuits[id]
+		for _, dir := range stream.directions {
+			var sequences []int32
+			for sequence := range dir.pending {
+				sequences = append(sequences, sequence)
+			}
+			sort.Slice(sequences, func(i, j int) bool { return sequences[i] < sequences[j] })
+
+			for _, sequence := range sequences {
+				if err := stream.write(self, dir.pending[sequence]); err != nil {
+					return err
+				}
+				delete(dir.pending, sequence)
+			}
+		}
+	}
+
+	return self.out.Flush()
+}
+
+func (self *Writer) getStream(payload *Payload) (*circuitStream, error) {
+	if stream, found := self.circuits[payload.CircuitId]; found {
+		return stream, nil
+	}
+
+	index := uint32(len(self.circuits)) + 1
+	var clientAddr, serverAddr string
+	if self.resolver != nil {
+		clientAddr, serverAddr = self.resolver(payload.CircuitId)
+	}
+
+	client, clientErr := parseEndpoint(clientAddr)
+	server, serverErr := parseEndpoint(serverAddr)
+	if clientErr != nil || serverErr != nil || (client.ip.To4() == nil) != (server.ip.To4() == nil) {
+		client = &endpoint{ip: syntheticIP(10, index), port: uint16(syntheticClientPort + index%20000)}
+		server = &endpoint{ip: syntheticIP(11, index), port: syntheticServerPort}
+	}
+
+	stream := &circuitStream{
+		client: client,
+		server: server,
+		directions: [2]*direction{
+			{pending: map[int32]*Payload{}, nextSeq: 1},
+			{pending: map[int32]*Payload{}, nextSeq: 1},
+		},
+	}
+	self.circuits[payload.CircuitId] = stream
+
+	if self.protocol == ProtocolTCP {
+		if err := stream.writeHandshake(self, payload.Timestamp); err != nil {
+			return nil, err
+		}
+	}
+
+	return stream, nil
+}
+
+func (self *Writer) writePacket(timestamp time.Time, packet []byte, length int) error {
+	return self.out.WritePacket(gopacket.CaptureInfo{
+		Timestamp:     timestamp,
+		CaptureLength: len(packet),
+		Length:        length,
+	}, packet)
+}
+
+type endpoint struct {
+	ip   net.IP
+	port uint16
+}
+
+func parseEndpoint(addr string) (*endpoint, error) {
+	addr = strings.TrimPrefix(strings.TrimPrefix(addr, "tcp:"), "udp:")
+	host, portStr, err := net.SplitHostPort(addr)
+	if err != nil {
+		return nil, err
+	}
+
+	ip := net.ParseIP(host)
+	if ip == nil {
+		return nil, errors.Errorf("invalid ip address '%s'", host)
+	}
+
+	port, err := strconv.ParseUint(portStr, 10, 16)
+	if err != nil {
+		return nil, err
+	}
+
+	return &endpoint{ip: ip, port: uint16(port)}, nil
+}
+
+func syntheticIP(prefix byte, index uint32) net.IP {
+	return net.IPv4(prefix, byte(index>>16), byte(index>>8), byte(index))
+}
+
+type direction struct {
+	next    int32
+	pending map[int32]*Payload
+	nextSeq uint32
+	ended   bool
+}
+
+func (self *direction) earliestPending() *Payload {
+	var result *Payload
+	for _, payload := range self.pending {
+		if result == nil || payload.Sequence < result.Sequence {
+			result = payload
+		}
+	}
+	return result
+}
+
+type circuitStream struct {
+	client     *endpoint
+	server     *endpoint
+	directions [2]*direction
+}
+
+func (self *circuitStream) endpoints(originator xgress.Originator) (*endpoint, *endpoint) {
+	if originator == xgress.Initiator {
+		return self.client, self.server
+	}
+	return self.server, self.client
+}
+
+func (self *circuitStream) writeHandshake(w *Writer, timestamp time.Time) error {
+	client := self.directions[xgress.Initiator]
+	server := self.directions[xgress.Terminator]
+
+	if err := self.writeTCP(w, timestamp, xgress.Initiator, &layers.TCP{SYN: true, Seq: 0}, nil, 0); err != nil {
+		return err
+	}
+	if err := self.writeTCP(w, timestamp, xgress.Terminator, &layers.TCP{SYN: true, ACK: true, Seq: 0, Ack: client.nextSeq}, nil, 0); err != nil {
+		return err
+	}
+	return self.writeTCP(w, timestamp, xgress.Initiator, &layers.TCP{ACK: true, Seq: client.nextSeq, Ack: server.nextSeq}, nil, 0)
+}
+
+func (self *circuitStream) write(w *Writer, payload *Payload) error {
+	dir := self.directions[payload.Originator]
+	if dir.ended {
+		return nil
+	}
+
+	total := max(payload.Length, len(payload.Data))
+	for offset := 0; offset < total; offset += maxSegmentSize {
+		length := min(total-offset, maxSegmentSize)
+
+		var data []byte
+		if offset < len(payload.Data) {
+			data = payload.Data[offset:min(offset+length, len(payload.Data))]
+		}
+
+		if err := self.writeSegment(w, payload, data, length); err != nil {
+			return err
+		}
+	}
+
+	if payload.isEnd() && w.protocol == ProtocolTCP {
+		dir.ended = true
+		peer := self.directions[1-payload.Originator]
+		if err := self.writeTCP(w, payload.Timestamp, payload.Originator, &layers.TCP{FIN: true, ACK: true, Seq: dir.nextSeq, Ack: peer.nextSeq}, nil, 0); err != nil {
+			return err
+		}
+		dir.nextSeq++
+	}
+
+	return nil
+}
+
+func (self *circuitStream) writeSegment(w *Writer, payload *Payload, data []byte, length int) error {
+	if w.protocol == ProtocolUDP {
+		return self.writeUDP(w, payload.Timestamp, payload.Originator, data, length)
+	}
+
+	dir := self.directions[payload.Originator]
+	peer := self.directions[1-payload.Originator]
+	tcp := &layers.TCP{PSH: true, ACK: true, Seq: dir.nextSeq, Ack: peer.nextSeq}
+	if err := self.writeTCP(w, payload.Timestamp, payload.Originator, tcp, data, length); err != nil {
+		return err
+	}
+	dir.nextSeq += uint32(length)
+	return nil
+}
+
+func (self *circuitStream) writeTCP(w *Writer, timestamp time.Time, originator xgress.Originator, tcp *layers.TCP, data []byte, length int) error {
+	src, dst := self.endpoints(originator)
+	tcp.SrcPort = layers.TCPPort(src.port)
+	tcp.DstPort = layers.TCPPort(dst.port)
+	tcp.Window = 65535
+	return self.writeIP(w, timestamp, src, dst, layers.IPProtocolTCP, tcp, data, length)
+}
+
+func (self *circuitStream) writeUDP(w *Writer, timestamp time.Time, originator xgress.Originator, data []byte, length int) error {
+	src, dst := self.endpoints(originator)
+	udp := &layers.UDP{SrcPort: layers.UDPPort(src.port), DstPort: layers.UDPPort(dst.port)}
+	return self.writeIP(w, timestamp, src, dst, layers.IPProtocolUDP, udp, data, length)
+}
+
+type transportLayer interface {
+	gopacket.SerializableLayer
+	SetNetworkLayerForChecksum(gopacket.NetworkLayer) error
+}
+
+func (self *circuitStream) writeIP(w *Writer, timestamp time.Time, src, dst *endpoint, protocol layers.IPProtocol, transport transportLayer, data []byte, length int) error {
+	var network gopacket.SerializableLayer
+	if src.ip.To4() != nil {
+		ip := &layers.IPv4{Version: 4, TTL: 64, Protocol: protocol, SrcIP: src.ip.To4(), DstIP: dst.ip.To4()}
+		_ = transport.SetNetworkLayerForChecksum(ip)
+		network = ip
+	} else {
+		ip := &layers.IPv6{Version: 6, HopLimit: 64, NextHeader: protocol, SrcIP: src.ip, DstIP: dst.ip}
+		_ = transport.SetNetworkLayerForChecksum(ip)
+		network = ip
+	}
+
+	buf := gopacket.NewSerializeBuffer()
+	opts := gopacket.SerializeOptions{FixLengths: true, ComputeChecksums: true}
+	if err := gopacket.SerializeLayers(buf, opts, network, transport, gopacket.Payload(data)); err != nil {
+		return err
+	}
+
+	packet := buf.Bytes()
+	truncated := length - len(data)
+	if truncated > 0 {
+		setOriginalLength(packet, protocol, truncated)
+	}
+
+	return w.writePacket(timestamp, packet, len(packet)+truncated)
+}
+
+// setOriginalLength updates the length fields of a packet whose payload was truncated during capture, so they
+// reflect the length of the original packet
+func setOriginalLength(packet []byte, protocol layers.IPProtocol, truncated int) {
+	transportOffset := 40
+	if packet[0]>>4 == 4 {
+		transportOffset = int(packet[0]&0x0f) * 4
+		binary.BigEndian.PutUint16(packet[2:4], uint16(len(packet)+truncated))
+		binary.BigEndian.PutUint16(packet[10:12], 0)
+		binary.BigEndian.PutUint16(packet[10:12], ipv4Checksum(packet[:transportOffset]))
+	} else {
+		binary.BigEndian.PutUint16(packet[4:6], uint16(len(packet)+truncated-40))
+	}
+
+	if protocol == layers.IPProtocolUDP {
+		binary.BigEndian.PutUint16(packet[transportOffset+4:transportOffset+6], uint16(len(packet)+truncated-transportOffset))
+	}
+}
+
+func ipv4Checksum(header []byte) uint16 {
+	var sum uint32
+	for i := 0; i+1 < len(header); i += 2 {
+		sum += uint32(binary.BigEndian.Uint16(header[i : i+2]))
+	}
+	for sum > 0xffff {
+		sum = (sum >> 16) + (sum & 0xffff)
+	}
+	return ^uint16(sum)
+}
@@ -0,0 +1,193 @@
+/*
+	Copyright NetFoundry Inc.
+
+	Licensed under the Apache License, Version 2.0 (the "License");
+	you may not use this file except in compliance with the License.
+	You may obtain a copy of the License at
+
+	https://www.apache.org/licenses/LICENSE-2.0
+
+	Unless required by applicable law or agreed to in writing, software
+	distributed under the License is distributed on an "AS IS" BASIS,
+	WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+	See the License for the specific language governing permissions and
+	limitations under the License.
+*/
+
+package pcap
+
+import (
+	"bytes"
+	"testing"
+	"time"
+
+	"github.com/google/gopacket"
+	"github.com/google/gopacket/layers"
+	"github.com/google/gopacket/pcapgo"
+	trace_pb "github.com/openziti/channel/v4/trace/pb"
+	"github.com/openziti/sdk-golang/xgress"
+	"github.com/openziti/ziti/common/trace"
+	"github.com/stretchr/testify/require"
+)
+
+type capturedPacket struct {
+	ci  gopacket.CaptureInfo
+	pkt gopacket.Packet
+}
+
+func readCapture(t *testing.T, data []byte) []*capturedPacket {
+	req := require.New(t)
+	reader, err := pcapgo.NewNgReader(bytes.NewReader(data), pcapgo.DefaultNgReaderOptions)
+	req.NoError(err)
+	req.Equal(layers.LinkTypeRaw, reader.LinkType())
+
+	var result []*capturedPacket
+	for {
+		packetData, ci, err := reader.ReadPacketData()
+		if err != nil {
+			break
+		}
+		result = append(result, &capturedPacket{
+			ci:  ci,
+			pkt: gopacket.NewPacket(packetData, layers.LinkTypeRaw, gopacket.Default),
+		})
+	}
+	return result
+}
+
+func TestParsePayloadTrace(t *testing.T) {
+	req := require.New(t)
+
+	payload := &xgress.Payload{
+		CircuitId: "c1",
+		Sequence:  7,
+		Flags:     uint32(xgress.PayloadFlagOriginator),
+		Data:      []byte("hello world"),
+	}
+
+	event := &trace_pb.ChannelMessage{
+		Timestamp:   time.Now().UnixNano(),
+		ContentType: xgress.ContentTypePayloadType,
+		Decode:      trace.DecodePayloadWithData(payload, 5),
+	}
+
+	parsed, ok := ParsePayloadTrace(event)
+	req.True(ok)
+	req.Equal("c1", parsed.CircuitId)
+	req.Equal(int32(7), parsed.Sequence)
+	req.Equal(xgress.Terminator, parsed.Originator)
+	req.Equal(11, parsed.Length)
+	req.Equal([]byte("hello"), parsed.Data)
+
+	// traces without payload data can't be used to reconstruct the stream
+	event.Decode, _ = xgress.DecodePayload(payload)
+	_, ok = ParsePayloadTrace(event)
+	req.False(ok)
+}
+
+func TestWriterTCP(t *testing.T) {
+	req := require.New(t)
+
+	out := &bytes.Buffer{}
+	w, err := NewWriter(out, ProtocolTCP, func(circuitId string) (string, string) {
+		return "192.168.1.10:51000", "10.10.0.5:443"
+	})
+	req.NoError(err)
+
+	now := time.Now()
+	newPayload := func(originator xgress.Originator, sequence int32, data string, flags uint32) *Payload {
+		return &Payload{CircuitId: "c1", Sequence: sequence, Originator: originator, Flags: flags,
+			Length: len(data), Data: []byte(data), Timestamp: now}
+	}
+
+	// delivered out of order and with duplicates, as happens when both ends of a circuit are traced
+	req.NoError(w.Write(newPayload(xgress.Initiator, 1, "world", 0)))
+	req.NoError(w.Write(newPayload(xgress.Initiator, 0, "hello ", 0)))
+	req.NoError(w.Write(newPayload(xgress.Initiator, 0, "hello ", 0)))
+	truncated := newPayload(xgress.Terminator, 0, "response", 0)
+	truncated.Length = 100
+	req.NoError(w.Write(truncated))
+	req.NoError(w.Write(newPayload(xgress.Initiator, 2, "", uint32(xgress.PayloadFlagCircuitEnd))))
+	req.NoError(w.Close())
+
+	packets := readCapture(t, out.Bytes())
+	req.Len(packets, 7)
+
+	var flags []string
+	clientData := &bytes.Buffer{}
+	for _, p := range packets {
+		ip := p.pkt.Layer(layers.LayerTypeIPv4).(*layers.IPv4)
+		tcp := p.pkt.Layer(layers.LayerTypeTCP).(*layers.TCP)
+
+		switch {
+		case tcp.SYN && tcp.ACK:
+			flags = append(flags, "syn-ack")
+		case tcp.SYN:
+			flags = append(flags, "syn")
+		case tcp.FIN:
+			flags = append(flags, "fin")
+		case len(tcp.Payload) > 0:
+			flags = append(flags, "data")
+		default:
+			flags = append(flags, "ack")
+		}
+
+		if ip.SrcIP.String() == "192.168.1.10" {
+			req.Equal("10.10.0.5", ip.DstIP.String())
+			req.Equal(layers.TCPPort(51000), tcp.SrcPort)
+			req.Equal(layers.TCPPort(443), tcp.DstPort)
+			clientData.Write(tcp.Payload)
+		} else {
+			req.Equal("10.10.0.5", ip.SrcIP.String())
+			req.Equal(layers.TCPPort(443), tcp.SrcPort)
+		}
+	}
+
+	req.Equal([]string{"syn", "syn-ack", "ack", "data", "data", "data", "fin"}, flags)
+	req.Equal("hello world", clientData.String())
+
+	// the truncated response is reported with its original length
+	response := packets[5]
+	req.Equal(response.ci.CaptureLength+100-len("response"), response.ci.Length)
+	req.Equal(uint16(response.ci.Length), response.pkt.Layer(layers.LayerTypeIPv4).(*layers.IPv4).Length)
+
+	// sequence numbers continue across payloads, so the stream can be followed
+	fin := packets[6].pkt.Layer(layers.LayerTypeTCP).(*layers.TCP)
+	req.Equal(uint32(1+len("hello world")), fin.Seq)
+	req.Equal(uint32(1+100), fin.Ack)
+}
+
+func TestWriterUDPSynthesizedAddresses(t *testing.T) {
+	req := require.New(t)
+
+	out := &bytes.Buffer{}
+	w, err := NewWriter(out, ProtocolUDP, nil)
+	req.NoError(err)
+
+	// tracing was enabled part way through the circuits, so earlier payloads are skipped once the reorder window passes
+	start := time.Now()
+	req.NoError(w.Write(&Payload{CircuitId: "c1", Sequence: 10, Originator: xgress.Initiator, Length: 4, Data: []byte("ping"), Timestamp: start}))
+	req.NoError(w.Write(&Payload{CircuitId: "c2", Sequence: 3, Originator: xgress.Terminator, Length: 4, Data: []byte("pong"), Timestamp: start}))
+	req.Len(readCapture(t, out.Bytes()), 0)
+
+	req.NoError(w.Write(&Payload{CircuitId: "c1", Sequence: 11, Originator: xgress.Initiator, Length: 4, Data: []byte("ping"), Timestamp: start.Add(2 * reorderWindow)}))
+	req.Len(readCapture(t, out.Bytes()), 2)
+
+	req.NoError(w.Close())
+
+	packets := readCapture(t, out.Bytes())
+	req.Len(packets, 3)
+
+	first := packets[0].pkt
+	req.Equal("10.0.0.1", first.Layer(layers.LayerTypeIPv4).(*layers.IPv4).SrcIP.String())
+	req.Equal("11.0.0.1", first.Layer(layers.LayerTypeIPv4).(*layers.IPv4).DstIP.String())
+	req.Equal([]byte("ping"), first.Layer(layers.LayerTypeUDP).(*layers.UDP).Payload)
+
+	// terminator payloads flow from the server to the client
+	second := packets[2].pkt
+	req.Equal("11.0.0.2", second.Layer(layers.LayerTypeIPv4).(*layers.IPv4).SrcIP.String())
+	req.Equal("10.0.0.2", second.Layer(layers.LayerTypeIPv4).(*layers.IPv4).DstIP.String())
+
+	_, err = NewWriter(out, "sctp", nil)
+	req.Error(err)
+}
@@ -55,7 +55,9 @@ func (self *XgressPeekHandler) ToggleTracing(sourceType SourceType, matcher Sour
 			self.enabled.Store(true)
 			self.eventSinks.Append(handler)
 		} else {
-			self.eventSinks.Delete(handler)
+			self.eventSinks.DeleteIf(func(sink EventHandler) bool {
+				return isSameHandler(sink, handler)
+			})
 			if len(self.eventSinks.Value()) == 0 {
 				self.enabled.Store(false)
 			}
@@ -76,7 +78,6 @@ func (self *XgressPeekHandler) Tx(x *xgress.Xgress, payload *xgress.Payload) {
 }
 
 func (self *XgressPeekHandler) Close(*xgress.Xgress) {
-	panic("implement me")
 }
 
 func NewXgressPeekHandler(appId *identity.TokenId, controller Controller) *XgressPeekHandler {
@@ -94,10 +95,31 @@ func (self *XgressPeekHandler) IsEnabled() bool {
 }
 
 func (self *XgressPeekHandler) trace(x *xgress.Xgress, payload *xgress.Payload, rx bool) {
-	decode, _ := xgress.DecodePayload(payload)
+	if !self.IsEnabled() {
+		return
+	}
+
+	timestamp := time.Now().UnixNano()
+	var traceMsg *trace_pb.ChannelMessage
+
+	// This can result in a message send. Doing a send from inside a peekhandler can cause deadlocks, so it's best avoided
+	for _, eventSink := range self.eventSinks.Value() {
+		if capture, ok := eventSink.(PayloadCaptureHandler); ok {
+			go eventSink.Accept(self.newTraceMsg(x, timestamp, rx, payload, DecodePayloadWithData(payload, capture.MaxBytes)))
+			continue
+		}
+
+		if traceMsg == nil {
+			decode, _ := xgress.DecodePayload(payload)
+			traceMsg = self.newTraceMsg(x, timestamp, rx, payload, decode)
+		}
+		go eventSink.Accept(traceMsg)
+	}
+}
 
-	traceMsg := &trace_pb.ChannelMessage{
-		Timestamp:   time.Now().UnixNano(),
+func (self *XgressPeekHandler) newTraceMsg(x *xgress.Xgress, timestamp int64, rx bool, payload *xgress.Payload, decode []byte) *trace_pb.ChannelMessage {
+	return &trace_pb.ChannelMessage{
+		Timestamp:   timestamp,
 		Identity:    self.appId.Token,
 		Channel:     x.Label(),
 		IsRx:        rx,
@@ -107,9 +129,4 @@ func (self *XgressPeekHandler) trace(x *xgress.Xgress, payload *xgress.Payload,
 		Length:      int32(len(payload.Data)),
 		Decode:      decode,
 	}
-
-	// This can result in a message send. Doing a send from inside a peekhandler can cause deadlocks, so it's best avoided
-	for _, eventSink := range self.eventSinks.Value() {
-		go eventSink.Accept(traceMsg)
-	}
 }
@@ -0,0 +1,112 @@
+/*
+	(c) Copyright NetFoundry Inc.
+
+	Licensed under the Apache License, Version 2.0 (the "License");
+	you may not use this file except in compliance with the License.
+	You may obtain a copy of the License at
+
+	https://www.apache.org/licenses/LICENSE-2.0
+
+	Unless required by applicable law or agreed to in writing, software
+	distributed under the License is distributed on an "AS IS" BASIS,
+	WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+	See the License for the specific language governing permissions and
+	limitations under the License.
+*/
+
+// Package quic provides a QUIC transport, intended for router links. Each transport connection is a bidirectional
+// QUIC stream. Outbound streams to the same destination share a single QUIC connection, so the underlays of a
+// multi-underlay link are multiplexed as independent streams, and loss on one underlay doesn't block the others.
+// Traffic on a single underlay, which may carry many circuits, is still delivered in order. TLS session tickets allow
+// resumption, optionally with 0-RTT, after a connection is lost.
+package quic
+
+import (
+	"fmt"
+	"io"
+	"net"
+	"strconv"
+	"time"
+
+	"github.com/openziti/identity"
+	"github.com/openziti/transport/v2"
+	"github.com/pkg/errors"
+)
+
+var _ transport.Address = &address{} // enforce that address implements transport.Address
+
+const Type = "quic"
+
+type address struct {
+	hostname string
+	port     uint16
+}
+
+func (a address) Dial(name string, i *identity.TokenId, timeout time.Duration, tcfg transport.Configuration) (transport.Conn, error) {
+	return a.DialWithLocalBinding(name, "", i, timeout, tcfg)
+}
+
+func (a address) DialWithLocalBinding(name string, localBinding string, i *identity.TokenId, timeout time.Duration, tcfg transport.Configuration) (transport.Conn, error) {
+	config, err := loadConfig(tcfg)
+	if err != nil {
+		return nil, err
+	}
+	return defaultPool.dial(a, name, localBinding, i, timeout, config)
+}
+
+func (a address) Listen(name string, i *identity.TokenId, acceptF func(transport.Conn), tcfg transport.Configuration) (io.Closer, error) {
+	config, err := loadConfig(tcfg)
+	if err != nil {
+		return nil, err
+	}
+	return Listen(a, name, i, acceptF, config)
+}
+
+func (a address) MustListen(name string, i *identity.TokenId, acceptF func(transport.Conn), tcfg transport.Configuration) io.Closer {
+	closer, err := a.Listen(name, i, acceptF, tcfg)
+	if err != nil {
+		panic(err)
+	}
+	return closer
+}
+
+func (a address) String() string {
+	return fmt.Sprintf("%s:%s", Type, a.bindableAddress())
+}
+
+func (a address) bindableAddress() string {
+	return net.JoinHostPort(a.hostname, strconv.Itoa(int(a.port)))
+}
+
+func (a address) Type() string {
+	return Type
+}
+
+func (a address) Hostname() string {
+	return a.hostname
+}
+
+func (a address) Port() uint16 {
+	return a.port
+}
+
+type AddressParser struct{}
+
+func (ap AddressParser) Parse(s string) (transport.Address, error) {
+	prefix := Type + ":"
+	if len(s) <= len(prefix) || s[:len(prefix)] != prefix {
+		return nil, errors.New("invalid format")
+	}
+
+	host, portStr, err := net.SplitHostPort(s[len(prefix):])
+	if err != nil {
+		return nil, errors.Wrapf(err, "invalid quic address '%s'", s)
+	}
+
+	port, err := strconv.ParseUint(portStr, 10, 16)
+	if err != nil {
+		return nil, errors.Wrapf(err, "invalid port in quic address '%s'", s)
+	}
+
+	return &address{hostname: host, port: uint16(port)}, nil
+}
@@ -0,0 +1,124 @@
+/*
+	(c) Copyright NetFoundry Inc.
+
+	Licensed under the Apache License, Version 2.0 (the "License");
+	you may not use this file except in compliance with the License.
+	You may obtain a copy of the License at
+
+	https://www.apache.org/licenses/LICENSE-2.0
+
+	Unless required by applicable law or agreed to in writing, software
+	distributed under the License is distributed on an "AS IS" BASIS,
+	WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+	See the License for the specific language governing permissions and
+	limitations under the License.
+*/
+
+package quic
+
+import (
+	"time"
+
+	"github.com/openziti/transport/v2"
+	"github.com/pkg/errors"
+	"github.com/quic-go/quic-go"
+)
+
+const (
+	// NextProto is the ALPN protocol negotiated by QUIC link connections
+	NextProto = "ziti-link"
+
+	DefaultHandshakeTimeout = 10 * time.Second
+	DefaultMaxIdleTimeout   = 30 * time.Second
+	DefaultKeepAlivePeriod  = 10 * time.Second
+)
+
+// Config holds the QUIC settings, which are read from the `quic` section of the transport configuration
+type Config struct {
+	HandshakeTimeout time.Duration
+	MaxIdleTimeout   time.Duration
+	KeepAlivePeriod  time.Duration
+
+	// Enable0RTT allows data to be sent and accepted before the handshake completes, when a connection is resumed.
+	// 0-RTT data may be replayed by an attacker, so this is off by default.
+	Enable0RTT bool
+}
+
+func (self *Config) quicConfig() *quic.Config {
+	return &quic.Config{
+		HandshakeIdleTimeout: self.HandshakeTimeout,
+		MaxIdleTimeout:       self.MaxIdleTimeout,
+		KeepAlivePeriod:      self.KeepAlivePeriod,
+		Allow0RTT:            self.Enable0RTT,
+	}
+}
+
+func loadConfig(tcfg transport.Configuration) (*Config, error) {
+	config := &Config{
+		HandshakeTimeout: DefaultHandshakeTimeout,
+		MaxIdleTimeout:   DefaultMaxIdleTimeout,
+		KeepAlivePeriod:  DefaultKeepAlivePeriod,
+	}
+
+	if tcfg == nil {
+		return config, nil
+	}
+
+	handshakeTimeout, err := tcfg.GetHandshakeTimeout()
+	if err != nil {
+		return nil, err
+	}
+	if handshakeTimeout > 0 {
+		config.HandshakeTimeout = handshakeTimeout
+	}
+
+	if err = loadDuration(tcfg, "maxIdleTimeout", &config.MaxIdleTimeout); err != nil {
+		return nil, err
+	}
+
+	if err = loadDuration(tcfg, "keepAlivePeriod", &config.KeepAlivePeriod); err != nil {
+		return nil, err
+	}
+
+	value, err := tcfg.GetValue(Type, "enable0RTT")
+	if err != nil {
+		return nil, err
+	}
+	if value != nil {
+		enable0RTT, ok := value.(bool)
+		if !ok {
+			return nil, errors.Errorf("invalid value '%v' for quic:enable0RTT, must be a boolean", value)
+		}
+		config.Enable0RTT = enable0RTT
+	}
+
+	if config.KeepAlivePeriod >= config.MaxIdleTimeout {
+		return nil, errors.Errorf("quic:keepAlivePeriod (%v) must be less than quic:maxIdleTimeout (%v)", config.KeepAlivePeriod, config.MaxIdleTimeout)
+	}
+
+	return config, nil
+}
+
+func loadDuration(tcfg transport.Configuration, key string, target *time.Duration) error {
+	value, err := tcfg.GetValue(Type, key)
+	if err != nil || value == nil {
+		return err
+	}
+
+	strVal, ok := value.(string)
+	if !ok {
+		return errors.Errorf("invalid value '%v' for quic:%s, must be a duration string (ex: 1m or 30s)", value, key)
+	}
+
+	duration, err := time.ParseDuration(strVal)
+	if err != nil {
+		return errors.Wrapf(err, "invalid value '%v' for quic:%s, must be a duration string (ex: 1m or 30s)", value, key)
+	}
+
+	if duration <= 0 {
+		return errors.Errorf("invalid value '%v' for quic:%s, must be greater than zero", value, key)
+	}
+
+	*target = duration
+	return nil
+}
@@ -0,0 +1,67 @@
+/*
+	(c) Copyright NetFoundry Inc.
+
+	Licensed under the Apache License, Version 2.0 (the "License");
+	you may not use this file except in compliance with the License.
+	You may obtain a copy of the License at
+
+	https://www.apache.org/licenses/LICENSE-2.0
+
+	Unless required by applicable law or agreed to in writing, software
+	distributed under the License is distributed on an "AS IS" BASIS,
+	WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+	See the License for the specific language governing permissions and
+	limitations under the License.
+*/
+
+package quic
+
+import (
+	"crypto/x509"
+	"net"
+	"sync"
+
+	"github.com/openziti/transport/v2"
+	"github.com/quic-go/quic-go"
+)
+
+var _ transport.Conn = &Connection{} // enforce that Connection implements transport.Conn
+
+// Connection is a single bidirectional stream on a QUIC connection
+type Connection struct {
+	*quic.Stream
+	conn      *quic.Conn
+	detail    *transport.ConnectionDetail
+	closeOnce sync.Once
+	onClose   func()
+}
+
+func (self *Connection) Detail() *transport.ConnectionDetail {
+	return self.detail
+}
+
+func (self *Connection) PeerCertificates() []*x509.Certificate {
+	return self.conn.ConnectionState().TLS.PeerCertificates
+}
+
+func (self *Connection) LocalAddr() net.Addr {
+	return self.conn.LocalAddr()
+}
+
+func (self *Connection) RemoteAddr() net.Addr {
+	return self.conn.RemoteAddr()
+}
+
+// Close closes both directions of the stream. The underlying QUIC connection may be shared with other streams,
+// so its lifetime is managed separately.
+func (self *Connection) Close() error {
+	var err error
+	self.closeOnce.Do(func() {
+		self.Stream.CancelRead(0)
+		err = self.Stream.Close()
+		if self.onClose != nil {
+			self.onClose()
+		}
+	})
+	return err
+}
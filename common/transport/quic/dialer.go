@@ -0,0 +1,230 @@
+/*
+	(c) Copyright NetFoundry Inc.
+
+	Licensed under the Apache License, Version 2.0 (the "License");
+	you may not use this file except in compliance with the License.
+	You may obtain a copy of the License at
+
+	https://www.apache.org/licenses/LICENSE-2.0
+
+	Unless required by applicable law or agreed to in writing, software
+	distributed under the License is distributed on an "AS IS" BASIS,
+	WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+	See the License for the specific language governing permissions and
+	limitations under the License.
+*/
+
+package quic
+
+import (
+	"context"
+	"crypto/sha256"
+	"crypto/tls"
+	"encoding/hex"
+	"errors"
+	"net"
+	"sync"
+	"time"
+
+	"github.com/michaelquigley/pfxlog"
+	"github.com/openziti/identity"
+	"github.com/openziti/transport/v2"
+	"github.com/quic-go/quic-go"
+)
+
+var defaultPool = newConnPool()
+
+// connPool shares outbound QUIC connections between streams dialed to the same destination, from the same local
+// binding, using the same identity. A connection is closed once its last stream is closed.
+type connPool struct {
+	lock         sync.Mutex
+	entries      map[string]*poolEntry
+	sessionCache tls.ClientSessionCache
+}
+
+type poolEntry struct {
+	lock    sync.Mutex
+	current *pooledConn
+}
+
+type pooledConn struct {
+	conn       *quic.Conn
+	transport  *quic.Transport
+	packetConn net.PacketConn
+	streams    int
+}
+
+func (self *pooledConn) close() {
+	_ = self.conn.CloseWithError(0, "")
+	_ = self.transport.Close()
+	_ = self.packetConn.Close()
+}
+
+func newConnPool() *connPool {
+	return &connPool{
+		entries:      map[string]*poolEntry{},
+		sessionCache: tls.NewLRUClientSessionCache(0),
+	}
+}
+
+func (self *connPool) dial(a address, name string, localBinding string, i *identity.TokenId, timeout time.Duration, config *Config) (transport.Conn, error) {
+	if timeout <= 0 {
+		timeout = config.HandshakeTimeout
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), timeout)
+	defer cancel()
+
+	entry := self.getEntry(poolKey(a, localBinding, i))
+
+	pc, conn, err := self.acquire(ctx, entry, a, localBinding, i, config)
+	if err != nil {
+		return nil, err
+	}
+
+	stream, err := conn.OpenStreamSync(ctx)
+	if errors.Is(err, quic.Err0RTTRejected) {
+		// the server rejected 0-RTT, so streams opened before the handshake completed were reset. Once the
+		// handshake completes, the connection may be used for new streams.
+		var next *quic.Conn
+		if next, err = conn.NextConnection(ctx); err == nil {
+			conn = self.replaceConn(entry, pc, conn, next)
+			stream, err = conn.OpenStreamSync(ctx)
+		}
+	}
+
+	if err != nil {
+		self.release(entry, pc)
+		return nil, err
+	}
+
+	return &Connection{
+		Stream: stream,
+		conn:   conn,
+		detail: &transport.ConnectionDetail{
+			Address: Type + ":" + a.bindableAddress(),
+			InBound: false,
+			Name:    name,
+		},
+		onClose: func() {
+			self.release(entry, pc)
+		},
+	}, nil
+}
+
+func (self *connPool) getEntry(key string) *poolEntry {
+	self.lock.Lock()
+	defer self.lock.Unlock()
+
+	entry, found := self.entries[key]
+	if !found {
+		entry = &poolEntry{}
+		self.entries[key] = entry
+	}
+	return entry
+}
+
+// acquire returns the pooled connection for the entry, creating it if necessary, along with the QUIC connection
+// to use for new streams
+func (self *connPool) acquire(ctx context.Context, entry *poolEntry, a address, localBinding string, i *identity.TokenId, config *Config) (*pooledConn, *quic.Conn, error) {
+	entry.lock.Lock()
+	defer entry.lock.Unlock()
+
+	if current := entry.current; current != nil && current.conn.Context().Err() == nil {
+		current.streams++
+		return current, current.conn, nil
+	}
+
+	pc, err := self.connect(ctx, a, localBinding, i, config)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	pc.streams = 1
+	entry.current = pc
+	return pc, pc.conn, nil
+}
+
+// replaceConn swaps the early connection of a pooled connection for the connection returned once 0-RTT was
+// rejected and the handshake completed, so later streams don't use the rejected connection. If another stream
+// already replaced it, the current connection is returned.
+func (self *connPool) replaceConn(entry *poolEntry, pc *pooledConn, early *quic.Conn, next *quic.Conn) *quic.Conn {
+	entry.lock.Lock()
+	defer entry.lock.Unlock()
+
+	if pc.conn == early {
+		pc.conn = next
+	}
+	return pc.conn
+}
+
+func (self *connPool) release(entry *poolEntry, pc *pooledConn) {
+	entry.lock.Lock()
+	defer entry.lock.Unlock()
+
+	pc.streams--
+	if pc.streams > 0 {
+		return
+	}
+
+	if entry.current == pc {
+		entry.current = nil
+	}
+	pc.close()
+}
+
+func (self *connPool) connect(ctx context.Context, a address, localBinding string, i *identity.TokenId, config *Config) (*pooledConn, error) {
+	log := pfxlog.Logger().WithField("dest", a.bindableAddress())
+
+	remoteAddr, err := net.ResolveUDPAddr("udp", a.bindableAddress())
+	if err != nil {
+		return nil, err
+	}
+
+	localIp, err := transport.ResolveLocalBinding(localBinding)
+	if err != nil {
+		return nil, err
+	}
+
+	packetConn, err := net.ListenUDP("udp", &net.UDPAddr{IP: localIp})
+	if err != nil {
+		return nil, err
+	}
+
+	tr := &quic.Transport{Conn: packetConn}
+
+	tlsConfig := i.ClientTLSConfig().Clone()
+	tlsConfig.ServerName = a.hostname
+	tlsConfig.NextProtos = []string{NextProto}
+	tlsConfig.ClientSessionCache = self.sessionCache
+
+	var conn *quic.Conn
+	if config.Enable0RTT {
+		conn, err = tr.DialEarly(ctx, remoteAddr, tlsConfig, config.quicConfig())
+	} else {
+		conn, err = tr.Dial(ctx, remoteAddr, tlsConfig, config.quicConfig())
+	}
+
+	if err != nil {
+		_ = tr.Close()
+		_ = packetConn.Close()
+		return nil, err
+	}
+
+	log.WithField("local", conn.LocalAddr().String()).Debug("quic connection established")
+
+	return &pooledConn{
+		conn:       conn,
+		transport:  tr,
+		packetConn: packetConn,
+	}, nil
+}
+
+func poolKey(a address, localBinding string, i *identity.TokenId) string {
+	certKey := ""
+	if cert := i.Cert(); cert != nil && len(cert.Certificate) > 0 {
+		sum := sha256.Sum256(cert.Certificate[0])
+		certKey = hex.EncodeToString(sum[:])
+	}
+	return localBinding + "|" + a.bindableAddress() + "|" + certKey
+}
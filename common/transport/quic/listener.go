@@ -0,0 +1,155 @@
+/*
+	(c) Copyright NetFoundry Inc.
+
+	Licensed under the Apache License, Version 2.0 (the "License");
+	you may not use this file except in compliance with the License.
+	You may obtain a copy of the License at
+
+	https://www.apache.org/licenses/LICENSE-2.0
+
+	Unless required by applicable law or agreed to in writing, software
+	distributed under the License is distributed on an "AS IS" BASIS,
+	WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+	See the License for the specific language governing permissions and
+	limitations under the License.
+*/
+
+package quic
+
+import (
+	"context"
+	"crypto/tls"
+	"errors"
+	"io"
+	"net"
+	"sync/atomic"
+
+	"github.com/michaelquigley/pfxlog"
+	"github.com/openziti/identity"
+	"github.com/openziti/transport/v2"
+	"github.com/quic-go/quic-go"
+	"github.com/sirupsen/logrus"
+)
+
+// Listen accepts QUIC connections on the given address. Each stream opened by a peer is passed to acceptF as a
+// separate transport.Conn.
+func Listen(a address, name string, i *identity.TokenId, acceptF func(transport.Conn), config *Config) (io.Closer, error) {
+	log := pfxlog.ContextLogger(name + "/" + a.String()).Entry
+
+	tlsConfig := serverTlsConfig(i.ServerTLSConfig())
+
+	udpAddr, err := net.ResolveUDPAddr("udp", a.bindableAddress())
+	if err != nil {
+		return nil, err
+	}
+
+	packetConn, err := net.ListenUDP("udp", udpAddr)
+	if err != nil {
+		return nil, err
+	}
+
+	// the transport is created explicitly, so that closing the listener also releases the UDP socket
+	tr := &quic.Transport{Conn: packetConn}
+
+	var acceptConn func(context.Context) (*quic.Conn, error)
+	var closeListener func() error
+
+	if config.Enable0RTT {
+		listener, err := tr.ListenEarly(tlsConfig, config.quicConfig())
+		if err != nil {
+			_ = packetConn.Close()
+			return nil, err
+		}
+		acceptConn, closeListener = listener.Accept, listener.Close
+	} else {
+		listener, err := tr.Listen(tlsConfig, config.quicConfig())
+		if err != nil {
+			_ = packetConn.Close()
+			return nil, err
+		}
+		acceptConn, closeListener = listener.Accept, listener.Close
+	}
+
+	closeF := func() error {
+		err := closeListener()
+		_ = tr.Close()
+		_ = packetConn.Close()
+		return err
+	}
+
+	result := &acceptor{
+		name:    name,
+		accept:  acceptConn,
+		closeF:  closeF,
+		acceptF: acceptF,
+	}
+
+	go result.acceptLoop(log)
+
+	return result, nil
+}
+
+type acceptor struct {
+	name    string
+	accept  func(context.Context) (*quic.Conn, error)
+	closeF  func() error
+	acceptF func(transport.Conn)
+	closed  atomic.Bool
+}
+
+func (self *acceptor) Close() error {
+	if self.closed.CompareAndSwap(false, true) {
+		return self.closeF()
+	}
+	return nil
+}
+
+func (self *acceptor) acceptLoop(log *logrus.Entry) {
+	defer log.Info("exited")
+
+	for {
+		conn, err := self.accept(context.Background())
+		if err != nil {
+			if self.closed.Load() || errors.Is(err, quic.ErrServerClosed) {
+				log.WithError(err).Info("listener closed, exiting")
+				return
+			}
+			log.WithError(err).Error("accept failed. Failure not recoverable. Exiting listen loop")
+			return
+		}
+
+		go self.acceptStreams(conn, log.WithField("remote", conn.RemoteAddr().String()))
+	}
+}
+
+func (self *acceptor) acceptStreams(conn *quic.Conn, log *logrus.Entry) {
+	log.Debug("accepted quic connection")
+	for {
+		stream, err := conn.AcceptStream(conn.Context())
+		if err != nil {
+			log.WithError(err).Debug("quic connection closed, no longer accepting streams")
+			return
+		}
+
+		self.acceptF(&Connection{
+			Stream: stream,
+			conn:   conn,
+			detail: &transport.ConnectionDetail{
+				Address: Type + ":" + conn.RemoteAddr().String(),
+				InBound: true,
+				Name:    self.name,
+			},
+		})
+	}
+}
+
+// serverTlsConfig adds the link ALPN protocol to the identity's server config. The identity's GetConfigForClient
+// only refreshes RootCAs, which aren't used here, and would return a config without the ALPN protocol, so it's
+// dropped. Peer certificates are verified by the channel connection handlers, as with dtls.
+func serverTlsConfig(base *tls.Config) *tls.Config {
+	result := base.Clone()
+	result.NextProtos = []string{NextProto}
+	result.ClientAuth = tls.RequireAnyClientCert
+	result.GetConfigForClient = nil
+	return result
+}
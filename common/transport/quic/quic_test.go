@@ -0,0 +1,250 @@
+package quic
+
+import (
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/pem"
+	"io"
+	"math/big"
+	"net"
+	"strconv"
+	"testing"
+	"time"
+
+	"github.com/openziti/identity"
+	"github.com/openziti/transport/v2"
+	"github.com/quic-go/quic-go"
+	"github.com/stretchr/testify/require"
+)
+
+func newTestIdentity(t *testing.T) *identity.TokenId {
+	req := require.New(t)
+
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	req.NoError(err)
+
+	template := &x509.Certificate{
+		SerialNumber:          big.NewInt(1),
+		Subject:               pkix.Name{CommonName: "test"},
+		NotBefore:             time.Now().Add(-time.Minute),
+		NotAfter:              time.Now().Add(time.Hour),
+		KeyUsage:              x509.KeyUsageDigitalSignature | x509.KeyUsageCertSign,
+		ExtKeyUsage:           []x509.ExtKeyUsage{x509.ExtKeyUsageClientAuth, x509.ExtKeyUsageServerAuth},
+		BasicConstraintsValid: true,
+		IsCA:                  true,
+		IPAddresses:           []net.IP{net.ParseIP("127.0.0.1")},
+	}
+
+	certDer, err := x509.CreateCertificate(rand.Reader, template, template, &key.PublicKey, key)
+	req.NoError(err)
+
+	keyDer, err := x509.MarshalECPrivateKey(key)
+	req.NoError(err)
+
+	certPem := string(pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: certDer}))
+	keyPem := string(pem.EncodeToMemory(&pem.Block{Type: "EC PRIVATE KEY", Bytes: keyDer}))
+
+	id, err := identity.LoadIdentity(identity.Config{
+		Key:        "pem:" + keyPem,
+		Cert:       "pem:" + certPem,
+		ServerCert: "pem:" + certPem,
+		CA:         "pem:" + certPem,
+	})
+	req.NoError(err)
+
+	return identity.NewIdentity(id)
+}
+
+func freeUdpPort(t *testing.T) int {
+	conn, err := net.ListenUDP("udp", &net.UDPAddr{IP: net.ParseIP("127.0.0.1")})
+	require.NoError(t, err)
+	defer func() { _ = conn.Close() }()
+	return conn.LocalAddr().(*net.UDPAddr).Port
+}
+
+func Test_ParseAddress(t *testing.T) {
+	req := require.New(t)
+
+	addr, err := AddressParser{}.Parse("quic:router1.example.com:6262")
+	req.NoError(err)
+	req.Equal("quic:router1.example.com:6262", addr.String())
+	req.Equal(Type, addr.Type())
+
+	addr, err = AddressParser{}.Parse("quic:[::1]:6262")
+	req.NoError(err)
+	req.Equal("quic:[::1]:6262", addr.String())
+
+	_, err = AddressParser{}.Parse("tls:router1.example.com:6262")
+	req.Error(err)
+
+	_, err = AddressParser{}.Parse("quic:router1.example.com")
+	req.Error(err)
+}
+
+func Test_StreamsShareConnection(t *testing.T) {
+	req := require.New(t)
+	id := newTestIdentity(t)
+
+	addr, err := AddressParser{}.Parse("quic:127.0.0.1:" + strconv.Itoa(freeUdpPort(t)))
+	req.NoError(err)
+
+	accepted := make(chan transport.Conn, 2)
+	listener, err := addr.Listen("test", id, func(conn transport.Conn) {
+		accepted <- conn
+	}, nil)
+	req.NoError(err)
+	defer func() { _ = listener.Close() }()
+
+	var inbound []transport.Conn
+	var outbound []transport.Conn
+	for _, msg := range []string{"payload", "ack"} {
+		conn, err := addr.Dial("test", id, time.Second, nil)
+		req.NoError(err)
+		outbound = append(outbound, conn)
+
+		// streams are only visible to the peer once data is sent
+		_, err = conn.Write([]byte(msg))
+		req.NoError(err)
+
+		select {
+		case in := <-accepted:
+			buf := make([]byte, len(msg))
+			_, err = io.ReadFull(in, buf)
+			req.NoError(err)
+			req.Equal(msg, string(buf))
+			req.NotEmpty(in.PeerCertificates())
+			inbound = append(inbound, in)
+		case <-time.After(5 * time.Second):
+			req.FailNow("timed out waiting for stream")
+		}
+	}
+
+	req.Equal(outbound[0].LocalAddr().String(), outbound[1].LocalAddr().String())
+	req.Equal(inbound[0].RemoteAddr().String(), inbound[1].RemoteAddr().String())
+
+	for _, conn := range outbound {
+		req.NoError(conn.Close())
+	}
+}
+
+func Test_Resumption(t *testing.T) {
+	req := require.New(t)
+	id := newTestIdentity(t)
+
+	addr, err := AddressParser{}.Parse("quic:127.0.0.1:" + strconv.Itoa(freeUdpPort(t)))
+	req.NoError(err)
+
+	tcfg := transport.Configuration{
+		Type: map[interface{}]interface{}{"enable0RTT": true},
+	}
+
+	accepted := make(chan transport.Conn, 2)
+	listener, err := addr.Listen("test", id, func(conn transport.Conn) {
+		accepted <- conn
+	}, tcfg)
+	req.NoError(err)
+	defer func() { _ = listener.Close() }()
+
+	dial := func() *Connection {
+		conn, err := addr.Dial("test", id, time.Second, tcfg)
+		req.NoError(err)
+		_, err = conn.Write([]byte("hello"))
+		req.NoError(err)
+		select {
+		case in := <-accepted:
+			buf := make([]byte, 5)
+			_, err = io.ReadFull(in, buf)
+			req.NoError(err)
+		case <-time.After(5 * time.Second):
+			req.FailNow("timed out waiting for stream")
+		}
+		return conn.(*Connection)
+	}
+
+	first := dial()
+	<-first.conn.HandshakeComplete()
+	req.False(first.conn.ConnectionState().TLS.DidResume)
+	// give the client time to receive the session ticket
+	time.Sleep(100 * time.Millisecond)
+	req.NoError(first.Close())
+
+	second := dial()
+	<-second.conn.HandshakeComplete()
+	req.True(second.conn.ConnectionState().TLS.DidResume)
+	req.True(second.conn.ConnectionState().Used0RTT)
+	req.NoError(second.Close())
+}
+
+func Test_Rejected0RTT(t *testing.T) {
+	req := require.New(t)
+	id := newTestIdentity(t)
+
+	addr, err := AddressParser{}.Parse("quic:127.0.0.1:" + strconv.Itoa(freeUdpPort(t)))
+	req.NoError(err)
+
+	tcfg := transport.Configuration{
+		Type: map[interface{}]interface{}{"enable0RTT": true},
+	}
+
+	accepted := make(chan transport.Conn, 4)
+	listen := func() io.Closer {
+		listener, err := addr.Listen("test", id, func(conn transport.Conn) {
+			accepted <- conn
+		}, tcfg)
+		req.NoError(err)
+		return listener
+	}
+
+	dial := func() *Connection {
+		conn, err := addr.Dial("test", id, time.Second, tcfg)
+		req.NoError(err)
+		_, err = conn.Write([]byte("hello"))
+		req.NoError(err)
+		select {
+		case in := <-accepted:
+			buf := make([]byte, 5)
+			_, err = io.ReadFull(in, buf)
+			req.NoError(err)
+		case <-time.After(5 * time.Second):
+			req.FailNow("timed out waiting for stream")
+		}
+		return conn.(*Connection)
+	}
+
+	listener := listen()
+	first := dial()
+	<-first.conn.HandshakeComplete()
+	time.Sleep(100 * time.Millisecond)
+	req.NoError(first.Close())
+	req.NoError(listener.Close())
+
+	// the new listener has new session ticket keys, so it can't resume the session and rejects 0-RTT
+	listener = listen()
+	defer func() { _ = listener.Close() }()
+
+	conn, err := addr.Dial("test", id, time.Second, tcfg)
+	req.NoError(err)
+	rejected := conn.(*Connection)
+	_, _ = rejected.Write([]byte("hello"))
+
+	// data sent in 0-RTT packets is dropped, so the stream opened before the handshake completed is reset
+	_, err = rejected.Read(make([]byte, 1))
+	req.ErrorIs(err, quic.Err0RTTRejected)
+
+	// the pooled connection is still usable for new streams once the handshake completes
+	third := dial()
+	defer func() { _ = third.Close() }()
+	req.Equal(rejected.conn, third.conn)
+	req.NotEmpty(third.conn.ConnectionState().TLS.PeerCertificates)
+	req.False(third.conn.ConnectionState().Used0RTT)
+
+	entry := defaultPool.getEntry(poolKey(*addr.(*address), "", id))
+	entry.lock.Lock()
+	defer entry.lock.Unlock()
+	req.NotNil(entry.current)
+	req.Equal(third.conn, entry.current.conn)
+	req.NoError(entry.current.conn.Context().Err())
+}
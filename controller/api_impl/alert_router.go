@@ -0,0 +1,191 @@
+/*
+	Copyright NetFoundry Inc.
+
+	Licensed under the Apache License, Version 2.0 (the "License");
+	you may not use this file except in compliance with the License.
+	You may obtain a copy of the License at
+
+	https://www.apache.org/licenses/LICENSE-2.0
+
+	Unless required by applicable law or agreed to in writing, software
+	distributed under the License is distributed on an "AS IS" BASIS,
+	WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+	See the License for the specific language governing permissions and
+	limitations under the License.
+*/
+
+package api_impl
+
+import (
+	"net/http"
+	"time"
+
+	"github.com/go-openapi/runtime/middleware"
+	"github.com/go-openapi/strfmt"
+	"github.com/openziti/ziti/controller/api"
+	"github.com/openziti/ziti/controller/config"
+	"github.com/openziti/ziti/controller/model"
+	"github.com/openziti/ziti/controller/network"
+	"github.com/openziti/ziti/controller/rest_model"
+	"github.com/openziti/ziti/controller/rest_server/operations"
+	"github.com/openziti/ziti/controller/rest_server/operations/alert"
+)
+
+func init() {
+	r := NewAlertRouter()
+	AddRouter(r)
+}
+
+type AlertRouter struct{}
+
+func NewAlertRouter() *AlertRouter {
+	return &AlertRouter{}
+}
+
+func (r *AlertRouter) Register(fabricApi *operations.ZitiFabricAPI, wrapper RequestWrapper) {
+	fabricApi.AlertListAlertsHandler = alert.ListAlertsHandlerFunc(func(params alert.ListAlertsParams) middleware.Responder {
+		return wrapper.WrapRequest(r.ListAlerts, params.HTTPRequest, "", "")
+	})
+
+	fabricApi.AlertListAlertRulesHandler = alert.ListAlertRulesHandlerFunc(func(params alert.ListAlertRulesParams) middleware.Responder {
+		return wrapper.WrapRequest(r.ListRules, params.HTTPRequest, "", "")
+	})
+
+	fabricApi.AlertListAlertSilencesHandler = alert.ListAlertSilencesHandlerFunc(func(params alert.ListAlertSilencesParams) middleware.Responder {
+		return wrapper.WrapRequest(r.ListSilences, params.HTTPRequest, "", "")
+	})
+
+	fabricApi.AlertCreateAlertSilenceHandler = alert.CreateAlertSilenceHandlerFunc(func(params alert.CreateAlertSilenceParams) middleware.Responder {
+		return wrapper.WrapRequest(func(n *network.Network, rc api.RequestContext) { r.CreateSilence(n, rc, params) }, params.HTTPRequest, "", "")
+	})
+
+	fabricApi.AlertDeleteAlertSilenceHandler = alert.DeleteAlertSilenceHandlerFunc(func(params alert.DeleteAlertSilenceParams) middleware.Responder {
+		return wrapper.WrapRequest(func(n *network.Network, rc api.RequestContext) { r.DeleteSilence(n, rc, params.ID) }, params.HTTPRequest, params.ID, "")
+	})
+}
+
+func (r *AlertRouter) ListAlerts(n *network.Network, rc api.RequestContext) {
+	result := rest_model.AlertList{}
+	for _, v := range n.Managers.Alert.List() {
+		result = append(result, MapAlertToRestModel(v))
+	}
+
+	rc.Respond(&rest_model.ListAlertsEnvelope{
+		Data: result,
+		Meta: &rest_model.Meta{},
+	}, http.StatusOK)
+}
+
+func (r *AlertRouter) ListRules(n *network.Network, rc api.RequestContext) {
+	result := rest_model.AlertRuleList{}
+	for _, rule := range n.Managers.Alert.ListRules() {
+		result = append(result, MapAlertRuleToRestModel(rule))
+	}
+
+	rc.Respond(&rest_model.ListAlertRulesEnvelope{
+		Data: result,
+		Meta: &rest_model.Meta{},
+	}, http.StatusOK)
+}
+
+func (r *AlertRouter) ListSilences(n *network.Network, rc api.RequestContext) {
+	result := rest_model.AlertSilenceList{}
+	for _, silence := range n.Managers.Alert.ListSilences() {
+		result = append(result, MapAlertSilenceToRestModel(silence))
+	}
+
+	rc.Respond(&rest_model.ListAlertSilencesEnvelope{
+		Data: result,
+		Meta: &rest_model.Meta{},
+	}, http.StatusOK)
+}
+
+func (r *AlertRouter) CreateSilence(n *network.Network, rc api.RequestContext, params alert.CreateAlertSilenceParams) {
+	silence := &model.AlertSilence{
+		Rule:     params.Silence.Rule,
+		EntityId: params.Silence.EntityID,
+		Comment:  params.Silence.Comment,
+	}
+
+	if params.Silence.ExpiresAt != nil {
+		silence.ExpiresAt = time.Time(*params.Silence.ExpiresAt)
+	}
+
+	if err := n.Managers.Alert.AddSilence(silence); err != nil {
+		rc.RespondWithError(err)
+		return
+	}
+
+	rc.Respond(&rest_model.AlertSilenceEnvelope{
+		Data: MapAlertSilenceToRestModel(silence),
+		Meta: &rest_model.Meta{},
+	}, http.StatusCreated)
+}
+
+func (r *AlertRouter) DeleteSilence(n *network.Network, rc api.RequestContext, id string) {
+	if err := n.Managers.Alert.RemoveSilence(id); err != nil {
+		rc.RespondWithError(err)
+		return
+	}
+
+	rc.Respond(&rest_model.Empty{Data: map[string]interface{}{}, Meta: &rest_model.Meta{}}, http.StatusOK)
+}
+
+func MapAlertToRestModel(v *model.Alert) *rest_model.AlertDetail {
+	result := &rest_model.AlertDetail{
+		ID:           &v.Id,
+		Rule:         &v.Rule,
+		Severity:     &v.Severity,
+		State:        &v.State,
+		EntityType:   &v.EntityType,
+		EntityID:     &v.EntityId,
+		EntityName:   v.EntityName,
+		Message:      &v.Message,
+		PendingSince: (*strfmt.DateTime)(&v.PendingSince),
+		SilencedBy:   v.SilencedBy,
+	}
+
+	if v.FiringSince != nil {
+		result.FiringSince = strfmt.DateTime(*v.FiringSince)
+	}
+
+	return result
+}
+
+func MapAlertRuleToRestModel(rule *config.AlertRuleConfig) *rest_model.AlertRuleDetail {
+	forSeconds := int64(rule.For / time.Second)
+	result := &rest_model.AlertRuleDetail{
+		Name:       &rule.Name,
+		Type:       &rule.Type,
+		Severity:   &rule.Severity,
+		ForSeconds: &forSeconds,
+	}
+
+	switch rule.Type {
+	case config.AlertRuleTypeLinkLatency:
+		result.LatencyMillis = rule.Latency.Milliseconds()
+		result.Percentile = rule.Percentile
+		result.WindowSeconds = int64(rule.Window / time.Second)
+	case config.AlertRuleTypeServiceTerminators:
+		result.Filter = rule.Filter
+		result.MinTerminators = int64(rule.MinTerminators)
+	case config.AlertRuleTypeRouterOffline:
+		result.Filter = rule.Filter
+	case config.AlertRuleTypeCertExpiry:
+		result.Filter = rule.Filter
+		result.ExpiresWithinSeconds = int64(rule.ExpiresWithin / time.Second)
+	}
+
+	return result
+}
+
+func MapAlertSilenceToRestModel(silence *model.AlertSilence) *rest_model.AlertSilenceDetail {
+	return &rest_model.AlertSilenceDetail{
+		ID:        &silence.Id,
+		Rule:      silence.Rule,
+		EntityID:  silence.EntityId,
+		Comment:   silence.Comment,
+		CreatedAt: (*strfmt.DateTime)(&silence.CreatedAt),
+		ExpiresAt: (*strfmt.DateTime)(&silence.ExpiresAt),
+	}
+}
@@ -0,0 +1,164 @@
+/*
+	Copyright NetFoundry Inc.
+
+	Licensed under the Apache License, Version 2.0 (the "License");
+	you may not use this file except in compliance with the License.
+	You may obtain a copy of the License at
+
+	https://www.apache.org/licenses/LICENSE-2.0
+
+	Unless required by applicable law or agreed to in writing, software
+	distributed under the License is distributed on an "AS IS" BASIS,
+	WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+	See the License for the specific language governing permissions and
+	limitations under the License.
+*/
+
+package api_impl
+
+import (
+	"encoding/json"
+	"time"
+
+	"github.com/go-openapi/runtime/middleware"
+	"github.com/go-openapi/strfmt"
+	"github.com/openziti/ziti/controller/api"
+	"github.com/openziti/ziti/controller/db"
+	"github.com/openziti/ziti/controller/internal/permissions"
+	"github.com/openziti/ziti/controller/network"
+	"github.com/openziti/ziti/controller/rest_model"
+	"github.com/openziti/ziti/controller/rest_server/operations"
+	"github.com/openziti/ziti/controller/rest_server/operations/audit"
+)
+
+func init() {
+	r := NewAuditRouter()
+	AddRouter(r)
+}
+
+type AuditRouter struct{}
+
+func NewAuditRouter() *AuditRouter {
+	return &AuditRouter{}
+}
+
+func (r *AuditRouter) Register(fabricApi *operations.ZitiFabricAPI, wrapper RequestWrapper) {
+	fabricApi.AuditListAuditEntriesHandler = audit.ListAuditEntriesHandlerFunc(func(params audit.ListAuditEntriesParams) middleware.Responder {
+		return wrapper.WrapRequest(func(n *network.Network, rc api.RequestContext) { r.List(n, rc, params) }, params.HTTPRequest, "", "")
+	})
+
+	fabricApi.AuditListServiceHistoryHandler = audit.ListServiceHistoryHandlerFunc(func(params audit.ListServiceHistoryParams) middleware.Responder {
+		return wrapper.WrapRequest(r.ListServiceHistory, params.HTTPRequest, params.ID, "", permissions.CanRead(EntityNameService))
+	})
+
+	fabricApi.AuditRevertServiceHandler = audit.RevertServiceHandlerFunc(func(params audit.RevertServiceParams) middleware.Responder {
+		return wrapper.WrapRequest(func(n *network.Network, rc api.RequestContext) { r.RevertService(n, rc, params) }, params.HTTPRequest, params.ID, "", permissions.CanUpdate(EntityNameService))
+	})
+}
+
+func (r *AuditRouter) List(n *network.Network, rc api.RequestContext, params audit.ListAuditEntriesParams) {
+	filter := &db.AuditFilter{}
+	if params.EntityType != nil {
+		filter.EntityType = *params.EntityType
+	}
+	if params.EntityID != nil {
+		filter.EntityId = *params.EntityID
+	}
+	if params.AuthorID != nil {
+		filter.AuthorId = *params.AuthorID
+	}
+	if params.Since != nil {
+		filter.Since = time.Time(*params.Since)
+	}
+	if params.Limit != nil {
+		filter.Limit = int(*params.Limit)
+	}
+
+	entries, err := n.Managers.Audit.List(filter)
+	if err != nil {
+		rc.RespondWithError(err)
+		return
+	}
+	RespondWithOk(rc, MapAuditEntriesToRestModel(entries), &rest_model.Meta{})
+}
+
+func (r *AuditRouter) ListServiceHistory(n *network.Network, rc api.RequestContext) {
+	id, err := rc.GetEntityId()
+	if err != nil {
+		rc.RespondWithError(err)
+		return
+	}
+
+	entries, err := n.Managers.Audit.GetHistory(db.EntityTypeServices, id)
+	if err != nil {
+		rc.RespondWithError(err)
+		return
+	}
+	RespondWithOk(rc, MapAuditEntriesToRestModel(entries), &rest_model.Meta{})
+}
+
+func (r *AuditRouter) RevertService(n *network.Network, rc api.RequestContext, params audit.RevertServiceParams) {
+	if err := n.Managers.Audit.RevertService(params.ID, uint64(params.Version), rc.NewChangeContext()); err != nil {
+		rc.RespondWithError(err)
+		return
+	}
+	rc.RespondWithEmptyOk()
+}
+
+func MapAuditEntriesToRestModel(entries []*db.AuditEntry) rest_model.AuditEntryList {
+	result := rest_model.AuditEntryList{}
+	for _, entry := range entries {
+		result = append(result, MapAuditEntryToRestModel(entry))
+	}
+	return result
+}
+
+func MapAuditEntryToRestModel(entry *db.AuditEntry) *rest_model.AuditEntry {
+	sequence := int64(entry.Sequence)
+	version := int64(entry.Version)
+	timestamp := strfmt.DateTime(entry.Timestamp)
+
+	result := &rest_model.AuditEntry{
+		Sequence:     &sequence,
+		EntityType:   &entry.EntityType,
+		EntityID:     &entry.EntityId,
+		Version:      &version,
+		ChangeType:   &entry.ChangeType,
+		Timestamp:    &timestamp,
+		EventID:      entry.EventId,
+		TraceID:      entry.TraceId,
+		InitialState: decodeAuditState(entry.InitialState),
+		FinalState:   decodeAuditState(entry.FinalState),
+	}
+
+	if entry.Author != nil {
+		result.Author = &rest_model.AuditAuthor{
+			Type: entry.Author.Type,
+			ID:   entry.Author.Id,
+			Name: entry.Author.Name,
+		}
+	}
+
+	if entry.Source != nil {
+		result.Source = &rest_model.AuditSource{
+			Type:       entry.Source.Type,
+			Auth:       entry.Source.Auth,
+			LocalAddr:  entry.Source.LocalAddr,
+			RemoteAddr: entry.Source.RemoteAddr,
+			Method:     entry.Source.Method,
+		}
+	}
+
+	return result
+}
+
+func decodeAuditState(state json.RawMessage) interface{} {
+	if len(state) == 0 {
+		return nil
+	}
+	var result map[string]interface{}
+	if err := json.Unmarshal(state, &result); err != nil {
+		return nil
+	}
+	return result
+}
@@ -0,0 +1,112 @@
+/*
+	Copyright NetFoundry Inc.
+
+	Licensed under the Apache License, Version 2.0 (the "License");
+	you may not use this file except in compliance with the License.
+	You may obtain a copy of the License at
+
+	https://www.apache.org/licenses/LICENSE-2.0
+
+	Unless required by applicable law or agreed to in writing, software
+	distributed under the License is distributed on an "AS IS" BASIS,
+	WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+	See the License for the specific language governing permissions and
+	limitations under the License.
+*/
+
+package api_impl
+
+import (
+	"github.com/openziti/foundation/v2/stringz"
+	"github.com/openziti/ziti/controller/api"
+	"github.com/openziti/ziti/controller/model"
+	"github.com/openziti/ziti/controller/models"
+	"github.com/openziti/ziti/controller/network"
+	"github.com/openziti/ziti/controller/rest_model"
+)
+
+const EntityNameBandwidthLimit = "bandwidth-limits"
+
+var BandwidthLimitLinkFactory = NewBasicLinkFactory(EntityNameBandwidthLimit)
+
+func MapCreateBandwidthLimitToModel(limit *rest_model.BandwidthLimitCreate) *model.BandwidthLimit {
+	result := &model.BandwidthLimit{
+		BaseEntity: models.BaseEntity{
+			Tags: TagsOrDefault(limit.Tags),
+		},
+		Name:               stringz.OrEmpty(limit.Name),
+		Burst:              int64(limit.Burst),
+		Shared:             limit.Shared,
+		IdentityRoles:      limit.IdentityRoles,
+		ServiceRoles:       limit.ServiceRoles,
+		EdgeRouterPolicies: limit.EdgeRouterPolicies,
+	}
+	if limit.Rate != nil {
+		result.Rate = int64(*limit.Rate)
+	}
+	return result
+}
+
+func MapUpdateBandwidthLimitToModel(id string, limit *rest_model.BandwidthLimitUpdate) *model.BandwidthLimit {
+	result := &model.BandwidthLimit{
+		BaseEntity: models.BaseEntity{
+			Tags: TagsOrDefault(limit.Tags),
+			Id:   id,
+		},
+		Name:               stringz.OrEmpty(limit.Name),
+		Burst:              int64(limit.Burst),
+		Shared:             limit.Shared,
+		IdentityRoles:      limit.IdentityRoles,
+		ServiceRoles:       limit.ServiceRoles,
+		EdgeRouterPolicies: limit.EdgeRouterPolicies,
+	}
+	if limit.Rate != nil {
+		result.Rate = int64(*limit.Rate)
+	}
+	return result
+}
+
+func MapPatchBandwidthLimitToModel(id string, limit *rest_model.BandwidthLimitPatch) *model.BandwidthLimit {
+	return &model.BandwidthLimit{
+		BaseEntity: models.BaseEntity{
+			Tags: TagsOrDefault(limit.Tags),
+			Id:   id,
+		},
+		Name:               limit.Name,
+		Rate:               int64(limit.Rate),
+		Burst:              int64(limit.Burst),
+		Shared:             limit.Shared,
+		IdentityRoles:      limit.IdentityRoles,
+		ServiceRoles:       limit.ServiceRoles,
+		EdgeRouterPolicies: limit.EdgeRouterPolicies,
+	}
+}
+
+type BandwidthLimitModelMapper struct{}
+
+func (BandwidthLimitModelMapper) ToApi(_ *network.Network, _ api.RequestContext, limit *model.BandwidthLimit) (interface{}, error) {
+	identityRoles := rest_model.Roles(limit.IdentityRoles)
+	if identityRoles == nil {
+		identityRoles = rest_model.Roles{}
+	}
+	serviceRoles := rest_model.Roles(limit.ServiceRoles)
+	if serviceRoles == nil {
+		serviceRoles = rest_model.Roles{}
+	}
+	edgeRouterPolicies := rest_model.EdgeRouterPolicyIds(limit.EdgeRouterPolicies)
+	if edgeRouterPolicies == nil {
+		edgeRouterPolicies = rest_model.EdgeRouterPolicyIds{}
+	}
+	rate := rest_model.BandwidthRate(limit.Rate)
+	burst := rest_model.BandwidthBurst(limit.Burst)
+	return &rest_model.BandwidthLimitDetail{
+		BaseEntity:         BaseEntityToRestModel(limit, BandwidthLimitLinkFactory),
+		Name:               &limit.Name,
+		Rate:               &rate,
+		Burst:              &burst,
+		Shared:             &limit.Shared,
+		IdentityRoles:      identityRoles,
+		ServiceRoles:       serviceRoles,
+		EdgeRouterPolicies: edgeRouterPolicies,
+	}, nil
+}
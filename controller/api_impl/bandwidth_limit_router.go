@@ -0,0 +1,102 @@
+/*
+	Copyright NetFoundry Inc.
+
+	Licensed under the Apache License, Version 2.0 (the "License");
+	you may not use this file except in compliance with the License.
+	You may obtain a copy of the License at
+
+	https://www.apache.org/licenses/LICENSE-2.0
+
+	Unless required by applicable law or agreed to in writing, software
+	distributed under the License is distributed on an "AS IS" BASIS,
+	WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+	See the License for the specific language governing permissions and
+	limitations under the License.
+*/
+
+package api_impl
+
+import (
+	"github.com/go-openapi/runtime/middleware"
+	"github.com/openziti/ziti/controller/api"
+	"github.com/openziti/ziti/controller/fields"
+	"github.com/openziti/ziti/controller/model"
+	"github.com/openziti/ziti/controller/network"
+	"github.com/openziti/ziti/controller/rest_server/operations"
+	"github.com/openziti/ziti/controller/rest_server/operations/bandwidth_limit"
+)
+
+func init() {
+	r := NewBandwidthLimitRouter()
+	AddRouter(r)
+}
+
+type BandwidthLimitRouter struct {
+	BasePath string
+}
+
+func NewBandwidthLimitRouter() *BandwidthLimitRouter {
+	return &BandwidthLimitRouter{
+		BasePath: "/" + EntityNameBandwidthLimit,
+	}
+}
+
+func (r *BandwidthLimitRouter) Register(fabricApi *operations.ZitiFabricAPI, wrapper RequestWrapper) {
+	fabricApi.BandwidthLimitDeleteBandwidthLimitHandler = bandwidth_limit.DeleteBandwidthLimitHandlerFunc(func(params bandwidth_limit.DeleteBandwidthLimitParams) middleware.Responder {
+		return wrapper.WrapRequest(r.Delete, params.HTTPRequest, params.ID, "")
+	})
+
+	fabricApi.BandwidthLimitDetailBandwidthLimitHandler = bandwidth_limit.DetailBandwidthLimitHandlerFunc(func(params bandwidth_limit.DetailBandwidthLimitParams) middleware.Responder {
+		return wrapper.WrapRequest(r.Detail, params.HTTPRequest, params.ID, "")
+	})
+
+	fabricApi.BandwidthLimitListBandwidthLimitsHandler = bandwidth_limit.ListBandwidthLimitsHandlerFunc(func(params bandwidth_limit.ListBandwidthLimitsParams) middleware.Responder {
+		return wrapper.WrapRequest(r.ListBandwidthLimits, params.HTTPRequest, "", "")
+	})
+
+	fabricApi.BandwidthLimitUpdateBandwidthLimitHandler = bandwidth_limit.UpdateBandwidthLimitHandlerFunc(func(params bandwidth_limit.UpdateBandwidthLimitParams) middleware.Responder {
+		return wrapper.WrapRequest(func(n *network.Network, rc api.RequestContext) { r.Update(n, rc, params) }, params.HTTPRequest, params.ID, "")
+	})
+
+	fabricApi.BandwidthLimitCreateBandwidthLimitHandler = bandwidth_limit.CreateBandwidthLimitHandlerFunc(func(params bandwidth_limit.CreateBandwidthLimitParams) middleware.Responder {
+		return wrapper.WrapRequest(func(n *network.Network, rc api.RequestContext) { r.Create(n, rc, params) }, params.HTTPRequest, "", "")
+	})
+
+	fabricApi.BandwidthLimitPatchBandwidthLimitHandler = bandwidth_limit.PatchBandwidthLimitHandlerFunc(func(params bandwidth_limit.PatchBandwidthLimitParams) middleware.Responder {
+		return wrapper.WrapRequest(func(n *network.Network, rc api.RequestContext) { r.Patch(n, rc, params) }, params.HTTPRequest, params.ID, "")
+	})
+}
+
+func (r *BandwidthLimitRouter) ListBandwidthLimits(n *network.Network, rc api.RequestContext) {
+	ListWithHandler[*model.BandwidthLimit](n, rc, n.Managers.BandwidthLimit, BandwidthLimitModelMapper{})
+}
+
+func (r *BandwidthLimitRouter) Detail(n *network.Network, rc api.RequestContext) {
+	DetailWithHandler[*model.BandwidthLimit](n, rc, n.Managers.BandwidthLimit, BandwidthLimitModelMapper{})
+}
+
+func (r *BandwidthLimitRouter) Create(n *network.Network, rc api.RequestContext, params bandwidth_limit.CreateBandwidthLimitParams) {
+	Create(rc, BandwidthLimitLinkFactory, func() (string, error) {
+		entity := MapCreateBandwidthLimitToModel(params.BandwidthLimit)
+		if err := n.Managers.BandwidthLimit.Create(entity, rc.NewChangeContext()); err != nil {
+			return "", err
+		}
+		return entity.Id, nil
+	})
+}
+
+func (r *BandwidthLimitRouter) Delete(n *network.Network, rc api.RequestContext) {
+	DeleteWithHandler(rc, n.Managers.BandwidthLimit)
+}
+
+func (r *BandwidthLimitRouter) Update(n *network.Network, rc api.RequestContext, params bandwidth_limit.UpdateBandwidthLimitParams) {
+	Update(rc, func(id string) error {
+		return n.Managers.BandwidthLimit.Update(MapUpdateBandwidthLimitToModel(params.ID, params.BandwidthLimit), nil, rc.NewChangeContext())
+	})
+}
+
+func (r *BandwidthLimitRouter) Patch(n *network.Network, rc api.RequestContext, params bandwidth_limit.PatchBandwidthLimitParams) {
+	Patch(rc, func(id string, fields fields.UpdatedFields) error {
+		return n.Managers.BandwidthLimit.Update(MapPatchBandwidthLimitToModel(params.ID, params.BandwidthLimit), fields.FilterMaps("tags"), rc.NewChangeContext())
+	})
+}
@@ -0,0 +1,140 @@
+/*
+	Copyright NetFoundry Inc.
+
+	Licensed under the Apache License, Version 2.0 (the "License");
+	you may not use this file except in compliance with the License.
+	You may obtain a copy of the License at
+
+	https://www.apache.org/licenses/LICENSE-2.0
+
+	Unless required by applicable law or agreed to in writing, software
+	distributed under the License is distributed on an "AS IS" BASIS,
+	WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+	See the License for the specific language governing permissions and
+	limitations under the License.
+*/
+
+package api_impl
+
+import (
+	"net/http"
+	"time"
+
+	"github.com/go-openapi/runtime/middleware"
+	"github.com/go-openapi/strfmt"
+	"github.com/openziti/ziti/controller/api"
+	"github.com/openziti/ziti/controller/model"
+	"github.com/openziti/ziti/controller/network"
+	"github.com/openziti/ziti/controller/rest_model"
+	"github.com/openziti/ziti/controller/rest_server/operations"
+	"github.com/openziti/ziti/controller/rest_server/operations/bulk_operation"
+)
+
+func init() {
+	r := NewBulkOperationRouter()
+	AddRouter(r)
+}
+
+type BulkOperationRouter struct{}
+
+func NewBulkOperationRouter() *BulkOperationRouter {
+	return &BulkOperationRouter{}
+}
+
+func (r *BulkOperationRouter) Register(fabricApi *operations.ZitiFabricAPI, wrapper RequestWrapper) {
+	fabricApi.BulkOperationListBulkOperationsHandler = bulk_operation.ListBulkOperationsHandlerFunc(func(params bulk_operation.ListBulkOperationsParams) middleware.Responder {
+		return wrapper.WrapRequest(r.List, params.HTTPRequest, "", "")
+	})
+
+	fabricApi.BulkOperationCreateBulkOperationHandler = bulk_operation.CreateBulkOperationHandlerFunc(func(params bulk_operation.CreateBulkOperationParams) middleware.Responder {
+		return wrapper.WrapRequest(func(n *network.Network, rc api.RequestContext) { r.Create(n, rc, params) }, params.HTTPRequest, "", "")
+	})
+
+	fabricApi.BulkOperationDetailBulkOperationHandler = bulk_operation.DetailBulkOperationHandlerFunc(func(params bulk_operation.DetailBulkOperationParams) middleware.Responder {
+		return wrapper.WrapRequest(func(n *network.Network, rc api.RequestContext) { r.Detail(n, rc, params.ID) }, params.HTTPRequest, params.ID, "")
+	})
+}
+
+func (r *BulkOperationRouter) List(n *network.Network, rc api.RequestContext) {
+	result := rest_model.BulkOperationList{}
+	for _, op := range n.Managers.Identity.ListBulkOperations() {
+		result = append(result, MapBulkOperationToRestModel(op))
+	}
+
+	rc.Respond(&rest_model.ListBulkOperationsEnvelope{
+		Data: result,
+		Meta: &rest_model.Meta{},
+	}, http.StatusOK)
+}
+
+func (r *BulkOperationRouter) Create(n *network.Network, rc api.RequestContext, params bulk_operation.CreateBulkOperationParams) {
+	op, err := n.Managers.Identity.StartBulkOperation(MapBulkOperationCreateToModel(params.Operation), rc.NewChangeContext())
+	if err != nil {
+		rc.RespondWithError(err)
+		return
+	}
+
+	rc.Respond(&rest_model.BulkOperationEnvelope{
+		Data: MapBulkOperationToRestModel(op),
+		Meta: &rest_model.Meta{},
+	}, http.StatusAccepted)
+}
+
+func (r *BulkOperationRouter) Detail(n *network.Network, rc api.RequestContext, id string) {
+	op, err := n.Managers.Identity.GetBulkOperation(id)
+	if err != nil {
+		rc.RespondWithError(err)
+		return
+	}
+
+	rc.Respond(&rest_model.BulkOperationEnvelope{
+		Data: MapBulkOperationToRestModel(op),
+		Meta: &rest_model.Meta{},
+	}, http.StatusOK)
+}
+
+func MapBulkOperationCreateToModel(op *rest_model.BulkOperationCreate) *model.BulkIdentityOperation {
+	return &model.BulkIdentityOperation{
+		Operation:       *op.Operation,
+		IdentityIds:     op.IdentityIds,
+		Filter:          op.Filter,
+		RoleAttributes:  op.RoleAttributes,
+		AuthPolicyId:    op.AuthPolicyID,
+		DisableDuration: time.Duration(op.DurationMinutes) * time.Minute,
+		ExpiresAt:       (*time.Time)(op.ExpiresAt),
+		BatchSize:       int(op.BatchSize),
+	}
+}
+
+func MapBulkOperationToRestModel(op *model.BulkOperation) *rest_model.BulkOperationDetail {
+	count := func(v int) *int64 {
+		result := int64(v)
+		return &result
+	}
+
+	complete := op.IsComplete()
+	result := &rest_model.BulkOperationDetail{
+		ID:        &op.Id,
+		Operation: &op.Operation,
+		Total:     count(op.Total),
+		Processed: count(op.Processed),
+		Succeeded: count(op.Succeeded),
+		Failed:    count(len(op.Failures)),
+		Complete:  &complete,
+		StartedAt: (*strfmt.DateTime)(&op.StartedAt),
+		Failures:  []*rest_model.BulkOperationFailure{},
+	}
+
+	if op.CompletedAt != nil {
+		result.CompletedAt = strfmt.DateTime(*op.CompletedAt)
+	}
+
+	for _, failure := range op.Failures {
+		result.Failures = append(result.Failures, &rest_model.BulkOperationFailure{
+			IdentityID: &failure.IdentityId,
+			Error:      &failure.Error,
+		})
+	}
+
+	return result
+}
@@ -46,7 +46,12 @@ func (factory *CircuitLinkFactoryIml) Links(entity LinkEntity) rest_model.Links
 }
 
 func MapCircuitToRestModel(n *network.Network, _ api.RequestContext, circuit *model.Circuit) (*rest_model.CircuitDetail, error) {
-	path := &rest_model.Path{}
+	path := &rest_model.Path{
+		InitiatorLocalAddr:   circuit.Path.InitiatorLocalAddr,
+		InitiatorRemoteAddr:  circuit.Path.InitiatorRemoteAddr,
+		TerminatorLocalAddr:  circuit.Path.TerminatorLocalAddr,
+		TerminatorRemoteAddr: circuit.Path.TerminatorRemoteAddr,
+	}
 	for _, node := range circuit.Path.Nodes {
 		path.Nodes = append(path.Nodes, ToEntityRef(node.Name, node, RouterLinkFactory))
 	}
@@ -0,0 +1,169 @@
+/*
+	Copyright NetFoundry Inc.
+
+	Licensed under the Apache License, Version 2.0 (the "License");
+	you may not use this file except in compliance with the License.
+	You may obtain a copy of the License at
+
+	https://www.apache.org/licenses/LICENSE-2.0
+
+	Unless required by applicable law or agreed to in writing, software
+	distributed under the License is distributed on an "AS IS" BASIS,
+	WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+	See the License for the specific language governing permissions and
+	limitations under the License.
+*/
+
+package api_impl
+
+import (
+	"net/http"
+	"time"
+
+	"github.com/go-openapi/runtime/middleware"
+	"github.com/go-openapi/strfmt"
+	"github.com/openziti/ziti/controller/api"
+	"github.com/openziti/ziti/controller/model"
+	"github.com/openziti/ziti/controller/network"
+	"github.com/openziti/ziti/controller/rest_model"
+	"github.com/openziti/ziti/controller/rest_server/operations"
+	"github.com/openziti/ziti/controller/rest_server/operations/circuit_history"
+)
+
+func init() {
+	r := NewCircuitHistoryRouter()
+	AddRouter(r)
+}
+
+type CircuitHistoryRouter struct{}
+
+func NewCircuitHistoryRouter() *CircuitHistoryRouter {
+	return &CircuitHistoryRouter{}
+}
+
+func (r *CircuitHistoryRouter) Register(fabricApi *operations.ZitiFabricAPI, wrapper RequestWrapper) {
+	fabricApi.CircuitHistoryListCircuitHistoryHandler = circuit_history.ListCircuitHistoryHandlerFunc(func(params circuit_history.ListCircuitHistoryParams) middleware.Responder {
+		return wrapper.WrapRequest(func(n *network.Network, rc api.RequestContext) { r.List(n, rc, params) }, params.HTTPRequest, "", "")
+	})
+
+	fabricApi.CircuitHistoryDetailCircuitHistoryHandler = circuit_history.DetailCircuitHistoryHandlerFunc(func(params circuit_history.DetailCircuitHistoryParams) middleware.Responder {
+		return wrapper.WrapRequest(r.Detail, params.HTTPRequest, params.ID, "")
+	})
+}
+
+func (r *CircuitHistoryRouter) List(n *network.Network, rc api.RequestContext, params circuit_history.ListCircuitHistoryParams) {
+	query := &model.CircuitHistoryQuery{}
+
+	stringParams := map[*string]*string{
+		&query.IdentityId:   params.IdentityID,
+		&query.ServiceId:    params.ServiceID,
+		&query.RouterId:     params.RouterID,
+		&query.LinkId:       params.LinkID,
+		&query.TerminatorId: params.TerminatorID,
+		&query.HostId:       params.HostID,
+		&query.State:        params.State,
+		&query.FailureCause: params.FailureCause,
+	}
+
+	for target, value := range stringParams {
+		if value != nil {
+			*target = *value
+		}
+	}
+
+	if params.Since != nil {
+		since := time.Time(*params.Since)
+		query.Since = &since
+	}
+	if params.Until != nil {
+		until := time.Time(*params.Until)
+		query.Until = &until
+	}
+	if params.Limit != nil {
+		query.Limit = int(*params.Limit)
+	}
+
+	entries, err := n.Managers.CircuitHistory.Query(query)
+	if err != nil {
+		rc.RespondWithError(err)
+		return
+	}
+
+	now := time.Now()
+	data := rest_model.CircuitHistoryList{}
+	for _, entry := range entries {
+		data = append(data, MapCircuitHistoryEntryToRestModel(entry, now))
+	}
+
+	rc.Respond(&rest_model.ListCircuitHistoryEnvelope{
+		Data: data,
+		Meta: &rest_model.Meta{},
+	}, http.StatusOK)
+}
+
+func (r *CircuitHistoryRouter) Detail(n *network.Network, rc api.RequestContext) {
+	id, err := rc.GetEntityId()
+	if err != nil {
+		rc.RespondWithError(err)
+		return
+	}
+
+	entry, err := n.Managers.CircuitHistory.Get(id)
+	if err != nil {
+		rc.RespondWithError(err)
+		return
+	}
+
+	rc.Respond(&rest_model.DetailCircuitHistoryEnvelope{
+		Data: MapCircuitHistoryEntryToRestModel(entry, time.Now()),
+		Meta: &rest_model.Meta{},
+	}, http.StatusOK)
+}
+
+func MapCircuitHistoryEntryToRestModel(entry *model.CircuitHistoryEntry, now time.Time) *rest_model.CircuitHistoryEntry {
+	createdAt := strfmt.DateTime(entry.CreatedAt)
+	pathUpdates := int64(entry.PathUpdates)
+	ingressRxBytes := int64(entry.IngressRxBytes)
+	ingressTxBytes := int64(entry.IngressTxBytes)
+	egressRxBytes := int64(entry.EgressRxBytes)
+	egressTxBytes := int64(entry.EgressTxBytes)
+
+	result := &rest_model.CircuitHistoryEntry{
+		CircuitID:      &entry.CircuitId,
+		ServiceID:      &entry.ServiceId,
+		InstanceID:     entry.InstanceId,
+		TerminatorID:   entry.TerminatorId,
+		IdentityID:     entry.IdentityId,
+		HostID:         entry.HostId,
+		State:          &entry.State,
+		FailureCause:   entry.FailureCause,
+		CreatedAt:      &createdAt,
+		DurationMillis: durationToMillis(entry.GetDuration(now)),
+		Routers:        entry.Routers,
+		Links:          entry.Links,
+		PathUpdates:    &pathUpdates,
+		IngressRxBytes: &ingressRxBytes,
+		IngressTxBytes: &ingressTxBytes,
+		EgressRxBytes:  &egressRxBytes,
+		EgressTxBytes:  &egressTxBytes,
+		Tags:           entry.Tags,
+	}
+
+	if result.Routers == nil {
+		result.Routers = []string{}
+	}
+	if result.Links == nil {
+		result.Links = []string{}
+	}
+
+	if entry.EndedAt != nil {
+		endedAt := strfmt.DateTime(*entry.EndedAt)
+		result.EndedAt = &endedAt
+	}
+
+	if entry.CreationTimespan != nil {
+		result.CreationTimespanMillis = durationToMillis(*entry.CreationTimespan)
+	}
+
+	return result
+}
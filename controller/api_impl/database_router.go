@@ -24,6 +24,7 @@ import (
 	"github.com/michaelquigley/pfxlog"
 	"github.com/openziti/ziti/controller/api"
 	"github.com/openziti/ziti/controller/apierror"
+	"github.com/openziti/ziti/controller/backup"
 	"github.com/openziti/ziti/controller/rest_model"
 	"github.com/openziti/ziti/controller/rest_server/operations"
 	"github.com/openziti/ziti/controller/rest_server/operations/database"
@@ -81,6 +82,14 @@ func (r *DatabaseRouter) Register(fabricApi *operations.ZitiFabricAPI, wrapper R
 	fabricApi.DatabaseDataIntegrityResultsHandler = database.DataIntegrityResultsHandlerFunc(func(params database.DataIntegrityResultsParams, _ interface{}) middleware.Responder {
 		return wrapper.WrapRequest(r.GetCheckProgress, params.HTTPRequest, "", "")
 	})
+
+	fabricApi.DatabaseListDatabaseBackupsHandler = database.ListDatabaseBackupsHandlerFunc(func(params database.ListDatabaseBackupsParams, _ interface{}) middleware.Responder {
+		return wrapper.WrapRequest(r.ListBackups, params.HTTPRequest, "", "")
+	})
+
+	fabricApi.DatabaseCreateDatabaseBackupHandler = database.CreateDatabaseBackupHandlerFunc(func(params database.CreateDatabaseBackupParams, _ interface{}) middleware.Responder {
+		return wrapper.WrapRequest(r.CreateBackup, params.HTTPRequest, "", "")
+	})
 }
 
 func (r *DatabaseRouter) CreateSnapshot(n *network.Network, rc api.RequestContext) {
@@ -120,6 +129,76 @@ func (r *DatabaseRouter) CreateSnapshotWithPath(n *network.Network, rc api.Reque
 	rc.Respond(result, http.StatusOK)
 }
 
+func (r *DatabaseRouter) ListBackups(n *network.Network, rc api.RequestContext) {
+	if n.Backups == nil {
+		rc.RespondWithApiError(apierror.NewBackupNotConfiguredError())
+		return
+	}
+
+	backups, err := n.Backups.ListBackups()
+	if err != nil {
+		rc.RespondWithError(err)
+		return
+	}
+
+	status := n.Backups.GetStatus()
+	interval := status.Interval.String()
+	retain := int64(status.Retain)
+
+	data := &rest_model.DatabaseBackupStatusDetails{
+		Backups:       rest_model.DatabaseBackupList{},
+		Compressed:    &status.Compressed,
+		Encrypted:     &status.Encrypted,
+		InProgress:    &status.InProgress,
+		Interval:      &interval,
+		LastAttempt:   (*strfmt.DateTime)(status.LastAttempt),
+		LastBackup:    status.LastBackup,
+		LastError:     status.LastError,
+		LastSuccess:   (*strfmt.DateTime)(status.LastSuccess),
+		NextScheduled: (*strfmt.DateTime)(status.NextScheduled),
+		Retain:        &retain,
+		Target:        &status.Target,
+	}
+
+	for _, b := range backups {
+		data.Backups = append(data.Backups, &rest_model.DatabaseBackupDetails{
+			CreatedAt: (*strfmt.DateTime)(&b.Modified),
+			Name:      &b.Name,
+			Size:      &b.Size,
+		})
+	}
+
+	rc.Respond(rest_model.DatabaseBackupStatusEnvelope{Data: data, Meta: &rest_model.Meta{}}, http.StatusOK)
+}
+
+func (r *DatabaseRouter) CreateBackup(n *network.Network, rc api.RequestContext) {
+	if n.Backups == nil {
+		rc.RespondWithApiError(apierror.NewBackupNotConfiguredError())
+		return
+	}
+
+	result, err := n.Backups.BackupNow()
+	if err != nil {
+		if errors.Is(err, backup.ErrBackupInProgress) {
+			rc.RespondWithApiError(apierror.NewRateLimited())
+			return
+		}
+		rc.RespondWithError(err)
+		return
+	}
+
+	durationMs := result.Duration.Milliseconds()
+	data := &rest_model.DatabaseBackupCreateDetails{
+		DurationMs: &durationMs,
+		Name:       &result.Name,
+		RaftIndex:  int64(result.RaftIndex),
+		Removed:    result.Removed,
+		Size:       &result.Size,
+	}
+
+	rc.Respond(rest_model.DatabaseBackupCreateResultEnvelope{Data: data, Meta: &rest_model.Meta{}}, http.StatusOK)
+}
+
 func (r *DatabaseRouter) CheckDatastoreIntegrity(n *network.Network, rc api.RequestContext, fixErrors bool) {
 	if r.integrityCheck.running.CompareAndSwap(false, true) {
 		r.integrityCheck.fixingErrors = fixErrors
@@ -0,0 +1,129 @@
+/*
+	Copyright NetFoundry Inc.
+
+	Licensed under the Apache License, Version 2.0 (the "License");
+	you may not use this file except in compliance with the License.
+	You may obtain a copy of the License at
+
+	https://www.apache.org/licenses/LICENSE-2.0
+
+	Unless required by applicable law or agreed to in writing, software
+	distributed under the License is distributed on an "AS IS" BASIS,
+	WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+	See the License for the specific language governing permissions and
+	limitations under the License.
+*/
+
+package api_impl
+
+import (
+	"net/http"
+
+	"github.com/go-openapi/runtime/middleware"
+	"github.com/go-openapi/strfmt"
+	"github.com/openziti/ziti/controller/api"
+	"github.com/openziti/ziti/controller/model"
+	"github.com/openziti/ziti/controller/network"
+	"github.com/openziti/ziti/controller/rest_model"
+	"github.com/openziti/ziti/controller/rest_server/operations"
+	"github.com/openziti/ziti/controller/rest_server/operations/enrollment_signer"
+)
+
+func init() {
+	r := NewEnrollmentSignerRouter()
+	AddRouter(r)
+}
+
+type EnrollmentSignerRouter struct{}
+
+func NewEnrollmentSignerRouter() *EnrollmentSignerRouter {
+	return &EnrollmentSignerRouter{}
+}
+
+func (r *EnrollmentSignerRouter) Register(fabricApi *operations.ZitiFabricAPI, wrapper RequestWrapper) {
+	fabricApi.EnrollmentSignerListEnrollmentSignersHandler = enrollment_signer.ListEnrollmentSignersHandlerFunc(func(params enrollment_signer.ListEnrollmentSignersParams) middleware.Responder {
+		return wrapper.WrapRequest(r.List, params.HTTPRequest, "", "")
+	})
+
+	fabricApi.EnrollmentSignerDetailEnrollmentSignerHandler = enrollment_signer.DetailEnrollmentSignerHandlerFunc(func(params enrollment_signer.DetailEnrollmentSignerParams) middleware.Responder {
+		return wrapper.WrapRequest(func(n *network.Network, rc api.RequestContext) { r.Detail(n, rc, params.ID) }, params.HTTPRequest, params.ID, "")
+	})
+
+	fabricApi.EnrollmentSignerReissueEnrollmentSignerHandler = enrollment_signer.ReissueEnrollmentSignerHandlerFunc(func(params enrollment_signer.ReissueEnrollmentSignerParams) middleware.Responder {
+		return wrapper.WrapRequest(func(n *network.Network, rc api.RequestContext) { r.Reissue(n, rc, params.ID) }, params.HTTPRequest, params.ID, "")
+	})
+}
+
+func (r *EnrollmentSignerRouter) List(n *network.Network, rc api.RequestContext) {
+	signers, err := n.Managers.EnrollmentSigner.List()
+	if err != nil {
+		rc.RespondWithError(err)
+		return
+	}
+
+	result := rest_model.EnrollmentSignerList{}
+	for _, signer := range signers {
+		result = append(result, MapEnrollmentSignerToRestModel(signer))
+	}
+
+	rc.Respond(&rest_model.ListEnrollmentSignersEnvelope{
+		Data: result,
+		Meta: &rest_model.Meta{},
+	}, http.StatusOK)
+}
+
+func (r *EnrollmentSignerRouter) Detail(n *network.Network, rc api.RequestContext, id string) {
+	signer, err := n.Managers.EnrollmentSigner.Read(id)
+	if err != nil {
+		rc.RespondWithError(err)
+		return
+	}
+
+	rc.Respond(&rest_model.EnrollmentSignerEnvelope{
+		Data: MapEnrollmentSignerToRestModel(signer),
+		Meta: &rest_model.Meta{},
+	}, http.StatusOK)
+}
+
+func (r *EnrollmentSignerRouter) Reissue(n *network.Network, rc api.RequestContext, id string) {
+	reissue, err := n.Managers.EnrollmentSigner.Reissue(id, rc.NewChangeContext())
+	if err != nil {
+		rc.RespondWithError(err)
+		return
+	}
+
+	requested := int64(reissue.IdentityCertsRequested)
+	failed := int64(reissue.IdentityCertsFailed)
+	result := &rest_model.EnrollmentSignerReissue{
+		SignerID:               &reissue.SignerId,
+		IdentityCertsRequested: &requested,
+		IdentityCertsFailed:    &failed,
+	}
+
+	if reissue.RouterCertRotation != nil {
+		result.RouterCertRotationID = reissue.RouterCertRotation.Id
+	}
+
+	rc.Respond(&rest_model.EnrollmentSignerReissueEnvelope{
+		Data: result,
+		Meta: &rest_model.Meta{},
+	}, http.StatusAccepted)
+}
+
+func MapEnrollmentSignerToRestModel(signer *model.EnrollmentSigner) *rest_model.EnrollmentSignerDetail {
+	count := func(v int) *int64 {
+		result := int64(v)
+		return &result
+	}
+
+	return &rest_model.EnrollmentSignerDetail{
+		ID:            &signer.Id,
+		Subject:       &signer.Subject,
+		Issuer:        &signer.Issuer,
+		NotBefore:     (*strfmt.DateTime)(&signer.NotBefore),
+		NotAfter:      (*strfmt.DateTime)(&signer.NotAfter),
+		State:         &signer.State,
+		IdentityCerts: count(signer.IdentityCerts),
+		RouterCerts:   count(signer.RouterCerts),
+	}
+}
@@ -0,0 +1,155 @@
+/*
+	Copyright NetFoundry Inc.
+
+	Licensed under the Apache License, Version 2.0 (the "License");
+	you may not use this file except in compliance with the License.
+	You may obtain a copy of the License at
+
+	https://www.apache.org/licenses/LICENSE-2.0
+
+	Unless required by applicable law or agreed to in writing, software
+	distributed under the License is distributed on an "AS IS" BASIS,
+	WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+	See the License for the specific language governing permissions and
+	limitations under the License.
+*/
+
+package api_impl
+
+import (
+	"net/http"
+
+	"github.com/go-openapi/runtime/middleware"
+	"github.com/openziti/ziti/controller/api"
+	"github.com/openziti/ziti/controller/model"
+	"github.com/openziti/ziti/controller/network"
+	"github.com/openziti/ziti/controller/rest_model"
+	"github.com/openziti/ziti/controller/rest_server/operations"
+	"github.com/openziti/ziti/controller/rest_server/operations/policy_simulation"
+)
+
+func init() {
+	r := NewPolicySimulationRouter()
+	AddRouter(r)
+}
+
+type PolicySimulationRouter struct{}
+
+func NewPolicySimulationRouter() *PolicySimulationRouter {
+	return &PolicySimulationRouter{}
+}
+
+func (r *PolicySimulationRouter) Register(fabricApi *operations.ZitiFabricAPI, wrapper RequestWrapper) {
+	fabricApi.PolicySimulationSimulatePolicyChangesHandler = policy_simulation.SimulatePolicyChangesHandlerFunc(func(params policy_simulation.SimulatePolicyChangesParams) middleware.Responder {
+		return wrapper.WrapRequest(func(n *network.Network, rc api.RequestContext) { r.Simulate(n, rc, params) }, params.HTTPRequest, "", "")
+	})
+}
+
+func (r *PolicySimulationRouter) Simulate(n *network.Network, rc api.RequestContext, params policy_simulation.SimulatePolicyChangesParams) {
+	result, err := n.Managers.PolicyAdvisor.SimulatePolicyChanges(MapPolicySimulationToModel(params.Simulation))
+	if err != nil {
+		rc.RespondWithError(err)
+		return
+	}
+
+	rc.Respond(&rest_model.PolicySimulationResultEnvelope{
+		Data: MapPolicySimulationResultToRestModel(result),
+		Meta: &rest_model.Meta{},
+	}, http.StatusOK)
+}
+
+func MapPolicySimulationToModel(simulation *rest_model.PolicySimulationCreate) *model.PolicySimulation {
+	result := &model.PolicySimulation{
+		IdentityRoleAttributes:   mapSimulatedRoleAttributesToModel(simulation.IdentityRoleAttributes),
+		ServiceRoleAttributes:    mapSimulatedRoleAttributesToModel(simulation.ServiceRoleAttributes),
+		EdgeRouterRoleAttributes: mapSimulatedRoleAttributesToModel(simulation.EdgeRouterRoleAttributes),
+		RemovedEdgeRouterIds:     simulation.RemovedEdgeRouters,
+		MaxChanges:               int(simulation.MaxChanges),
+	}
+
+	for _, policy := range simulation.ServicePolicies {
+		result.ServicePolicies = append(result.ServicePolicies, &model.SimulatedServicePolicy{
+			Action:            string(*policy.Action),
+			Id:                policy.ID,
+			Name:              policy.Name,
+			PolicyType:        string(policy.Type),
+			Semantic:          string(policy.Semantic),
+			IdentityRoles:     policy.IdentityRoles,
+			ServiceRoles:      policy.ServiceRoles,
+			PostureCheckRoles: policy.PostureCheckRoles,
+		})
+	}
+
+	for _, policy := range simulation.EdgeRouterPolicies {
+		result.EdgeRouterPolicies = append(result.EdgeRouterPolicies, &model.SimulatedEdgeRouterPolicy{
+			Action:          string(*policy.Action),
+			Id:              policy.ID,
+			Name:            policy.Name,
+			Semantic:        string(policy.Semantic),
+			IdentityRoles:   policy.IdentityRoles,
+			EdgeRouterRoles: policy.EdgeRouterRoles,
+		})
+	}
+
+	for _, policy := range simulation.ServiceEdgeRouterPolicies {
+		result.ServiceEdgeRouterPolicies = append(result.ServiceEdgeRouterPolicies, &model.SimulatedServiceEdgeRouterPolicy{
+			Action:          string(*policy.Action),
+			Id:              policy.ID,
+			Name:            policy.Name,
+			Semantic:        string(policy.Semantic),
+			ServiceRoles:    policy.ServiceRoles,
+			EdgeRouterRoles: policy.EdgeRouterRoles,
+		})
+	}
+
+	return result
+}
+
+func mapSimulatedRoleAttributesToModel(changes []*rest_model.SimulatedRoleAttributes) []*model.SimulatedRoleAttributes {
+	var result []*model.SimulatedRoleAttributes
+	for _, change := range changes {
+		result = append(result, &model.SimulatedRoleAttributes{
+			Id:             *change.ID,
+			RoleAttributes: change.RoleAttributes,
+		})
+	}
+	return result
+}
+
+func MapPolicySimulationResultToRestModel(result *model.PolicySimulationResult) *rest_model.PolicySimulationResultDetail {
+	count := func(v int) *int64 {
+		result := int64(v)
+		return &result
+	}
+
+	detail := &rest_model.PolicySimulationResultDetail{
+		IdentitiesAffected: count(result.IdentitiesAffected),
+		ServicesAffected:   count(result.ServicesAffected),
+		Granted:            count(result.Granted),
+		Revoked:            count(result.Revoked),
+		Changed:            count(result.Changed),
+		TuplesAdded:        count(result.TuplesAdded),
+		TuplesRemoved:      count(result.TuplesRemoved),
+		Truncated:          &result.Truncated,
+		Changes:            []*rest_model.PolicySimulationChange{},
+	}
+
+	for _, change := range result.Changes {
+		policyType := rest_model.DialBind(change.PolicyType)
+		detail.Changes = append(detail.Changes, &rest_model.PolicySimulationChange{
+			IdentityID:          &change.IdentityId,
+			IdentityName:        &change.IdentityName,
+			ServiceID:           &change.ServiceId,
+			ServiceName:         &change.ServiceName,
+			PolicyType:          &policyType,
+			ChangeType:          &change.ChangeType,
+			EdgeRouters:         change.EdgeRouterIds,
+			AddedEdgeRouters:    change.AddedEdgeRouterIds,
+			RemovedEdgeRouters:  change.RemovedEdgeRouterIds,
+			PostureChecksBefore: change.PostureChecksBefore,
+			PostureChecksAfter:  change.PostureChecksAfter,
+		})
+	}
+
+	return detail
+}
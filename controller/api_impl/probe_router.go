@@ -0,0 +1,200 @@
+/*
+	Copyright NetFoundry Inc.
+
+	Licensed under the Apache License, Version 2.0 (the "License");
+	you may not use this file except in compliance with the License.
+	You may obtain a copy of the License at
+
+	https://www.apache.org/licenses/LICENSE-2.0
+
+	Unless required by applicable law or agreed to in writing, software
+	distributed under the License is distributed on an "AS IS" BASIS,
+	WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+	See the License for the specific language governing permissions and
+	limitations under the License.
+*/
+
+package api_impl
+
+import (
+	"net/http"
+	"time"
+
+	"github.com/go-openapi/runtime/middleware"
+	"github.com/go-openapi/strfmt"
+	"github.com/openziti/ziti/controller/api"
+	"github.com/openziti/ziti/controller/config"
+	"github.com/openziti/ziti/controller/model"
+	"github.com/openziti/ziti/controller/network"
+	"github.com/openziti/ziti/controller/rest_model"
+	"github.com/openziti/ziti/controller/rest_server/operations"
+	"github.com/openziti/ziti/controller/rest_server/operations/probe"
+)
+
+func init() {
+	r := NewProbeRouter()
+	AddRouter(r)
+}
+
+type ProbeRouter struct{}
+
+func NewProbeRouter() *ProbeRouter {
+	return &ProbeRouter{}
+}
+
+func (r *ProbeRouter) Register(fabricApi *operations.ZitiFabricAPI, wrapper RequestWrapper) {
+	fabricApi.ProbeListProbesHandler = probe.ListProbesHandlerFunc(func(params probe.ListProbesParams) middleware.Responder {
+		return wrapper.WrapRequest(r.List, params.HTTPRequest, "", "")
+	})
+
+	fabricApi.ProbeDetailProbeHandler = probe.DetailProbeHandlerFunc(func(params probe.DetailProbeParams) middleware.Responder {
+		return wrapper.WrapRequest(func(n *network.Network, rc api.RequestContext) { r.Detail(n, rc, params.Name) }, params.HTTPRequest, params.Name, "")
+	})
+
+	fabricApi.ProbeListProbeResultsHandler = probe.ListProbeResultsHandlerFunc(func(params probe.ListProbeResultsParams) middleware.Responder {
+		return wrapper.WrapRequest(func(n *network.Network, rc api.RequestContext) { r.ListResults(n, rc, params.Name) }, params.HTTPRequest, params.Name, "")
+	})
+}
+
+func (r *ProbeRouter) List(n *network.Network, rc api.RequestContext) {
+	result := rest_model.ProbeList{}
+	for _, summary := range n.Managers.Probe.ListSummaries() {
+		result = append(result, MapProbeToRestModel(summary))
+	}
+
+	rc.Respond(&rest_model.ListProbesEnvelope{
+		Data: result,
+		Meta: &rest_model.Meta{},
+	}, http.StatusOK)
+}
+
+func (r *ProbeRouter) Detail(n *network.Network, rc api.RequestContext, name string) {
+	summary, err := n.Managers.Probe.GetSummary(name)
+	if err != nil {
+		rc.RespondWithError(err)
+		return
+	}
+
+	rc.Respond(&rest_model.ProbeEnvelope{
+		Data: MapProbeToRestModel(summary),
+		Meta: &rest_model.Meta{},
+	}, http.StatusOK)
+}
+
+func (r *ProbeRouter) ListResults(n *network.Network, rc api.RequestContext, name string) {
+	results, err := n.Managers.Probe.GetResults(name)
+	if err != nil {
+		rc.RespondWithError(err)
+		return
+	}
+
+	data := rest_model.ProbeResultList{}
+	for _, result := range results {
+		data = append(data, MapProbeResultToRestModel(result))
+	}
+
+	rc.Respond(&rest_model.ListProbeResultsEnvelope{
+		Data: data,
+		Meta: &rest_model.Meta{},
+	}, http.StatusOK)
+}
+
+func durationToMillis(d time.Duration) *float64 {
+	result := float64(d) / float64(time.Millisecond)
+	return &result
+}
+
+func MapProbeToRestModel(summary *model.ProbeSummary) *rest_model.ProbeDetail {
+	probeConfig := summary.Probe
+	intervalSeconds := int64(probeConfig.Interval / time.Second)
+	timeoutMillis := probeConfig.Timeout.Milliseconds()
+
+	result := &rest_model.ProbeDetail{
+		Name:            &probeConfig.Name,
+		Type:            &probeConfig.Type,
+		Router:          &probeConfig.Router,
+		IntervalSeconds: &intervalSeconds,
+		TimeoutMillis:   &timeoutMillis,
+		Slo:             MapProbeSloToRestModel(&probeConfig.Slo),
+		Summary:         MapProbeSummaryToRestModel(summary),
+	}
+
+	switch probeConfig.Type {
+	case config.ProbeTypeRouterPath:
+		result.TargetRouter = probeConfig.TargetRouter
+	case config.ProbeTypeService:
+		result.Service = probeConfig.Service
+		result.Send = probeConfig.Send
+		result.Expect = probeConfig.Expect
+	}
+
+	return result
+}
+
+func MapProbeSloToRestModel(slo *config.ProbeSloConfig) *rest_model.ProbeSlo {
+	result := &rest_model.ProbeSlo{
+		Percentile: slo.Percentile,
+	}
+
+	if slo.Availability > 0 {
+		availability := slo.Availability
+		result.Availability = &availability
+	}
+
+	if slo.Latency > 0 {
+		result.LatencyMillis = durationToMillis(slo.Latency)
+	}
+
+	return result
+}
+
+func MapProbeSummaryToRestModel(summary *model.ProbeSummary) *rest_model.ProbeSummary {
+	windowSeconds := int64(summary.Window / time.Second)
+	total := int64(summary.Total)
+	succeeded := int64(summary.Succeeded)
+
+	result := &rest_model.ProbeSummary{
+		WindowSeconds: &windowSeconds,
+		Total:         &total,
+		Succeeded:     &succeeded,
+		SloMet:        summary.SloMet,
+	}
+
+	if summary.Total > 0 {
+		availability := summary.Availability
+		result.Availability = &availability
+	}
+
+	if summary.Succeeded > 0 {
+		result.LatencyP50Millis = durationToMillis(summary.LatencyP50)
+		result.LatencyP95Millis = durationToMillis(summary.LatencyP95)
+		result.LatencyP99Millis = durationToMillis(summary.LatencyP99)
+		result.SloLatencyMillis = durationToMillis(summary.SloLatency)
+	}
+
+	if summary.LastResult != nil {
+		result.LastResult = MapProbeResultToRestModel(summary.LastResult)
+	}
+
+	if summary.LastFailure != nil {
+		result.LastFailure = MapProbeResultToRestModel(summary.LastFailure)
+	}
+
+	return result
+}
+
+func MapProbeResultToRestModel(result *model.ProbeResult) *rest_model.ProbeResult {
+	restResult := &rest_model.ProbeResult{
+		Timestamp:      (*strfmt.DateTime)(&result.Timestamp),
+		RouterID:       &result.RouterId,
+		TargetRouterID: result.TargetRouterId,
+		Success:        &result.Success,
+		Error:          result.Error,
+	}
+
+	if result.Success {
+		restResult.LatencyMillis = durationToMillis(result.Latency)
+	}
+
+	return restResult
+}
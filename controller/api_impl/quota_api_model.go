@@ -0,0 +1,119 @@
+/*
+	Copyright NetFoundry Inc.
+
+	Licensed under the Apache License, Version 2.0 (the "License");
+	you may not use this file except in compliance with the License.
+	You may obtain a copy of the License at
+
+	https://www.apache.org/licenses/LICENSE-2.0
+
+	Unless required by applicable law or agreed to in writing, software
+	distributed under the License is distributed on an "AS IS" BASIS,
+	WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+	See the License for the specific language governing permissions and
+	limitations under the License.
+*/
+
+package api_impl
+
+import (
+	"github.com/openziti/foundation/v2/stringz"
+	"github.com/openziti/ziti/controller/api"
+	"github.com/openziti/ziti/controller/model"
+	"github.com/openziti/ziti/controller/models"
+	"github.com/openziti/ziti/controller/network"
+	"github.com/openziti/ziti/controller/rest_model"
+)
+
+const EntityNameQuota = "quotas"
+
+var QuotaLinkFactory = NewBasicLinkFactory(EntityNameQuota)
+
+func MapCreateQuotaToModel(quota *rest_model.QuotaCreate) *model.Quota {
+	result := &model.Quota{
+		BaseEntity: models.BaseEntity{
+			Tags: TagsOrDefault(quota.Tags),
+		},
+		Name:            stringz.OrEmpty(quota.Name),
+		SoftLimit:       int64(quota.SoftLimit),
+		HardLimit:       int64(quota.HardLimit),
+		HardLimitAction: string(quota.HardLimitAction),
+		IdentityRoles:   quota.IdentityRoles,
+		ServiceRoles:    quota.ServiceRoles,
+	}
+	if quota.Scope != nil {
+		result.Scope = string(*quota.Scope)
+	}
+	if quota.Period != nil {
+		result.Period = string(*quota.Period)
+	}
+	return result
+}
+
+func MapUpdateQuotaToModel(id string, quota *rest_model.QuotaUpdate) *model.Quota {
+	result := &model.Quota{
+		BaseEntity: models.BaseEntity{
+			Tags: TagsOrDefault(quota.Tags),
+			Id:   id,
+		},
+		Name:            stringz.OrEmpty(quota.Name),
+		SoftLimit:       int64(quota.SoftLimit),
+		HardLimit:       int64(quota.HardLimit),
+		HardLimitAction: string(quota.HardLimitAction),
+		IdentityRoles:   quota.IdentityRoles,
+		ServiceRoles:    quota.ServiceRoles,
+	}
+	if quota.Scope != nil {
+		result.Scope = string(*quota.Scope)
+	}
+	if quota.Period != nil {
+		result.Period = string(*quota.Period)
+	}
+	return result
+}
+
+func MapPatchQuotaToModel(id string, quota *rest_model.QuotaPatch) *model.Quota {
+	return &model.Quota{
+		BaseEntity: models.BaseEntity{
+			Tags: TagsOrDefault(quota.Tags),
+			Id:   id,
+		},
+		Name:            quota.Name,
+		Scope:           string(quota.Scope),
+		Period:          string(quota.Period),
+		SoftLimit:       int64(quota.SoftLimit),
+		HardLimit:       int64(quota.HardLimit),
+		HardLimitAction: string(quota.HardLimitAction),
+		IdentityRoles:   quota.IdentityRoles,
+		ServiceRoles:    quota.ServiceRoles,
+	}
+}
+
+type QuotaModelMapper struct{}
+
+func (QuotaModelMapper) ToApi(_ *network.Network, _ api.RequestContext, quota *model.Quota) (interface{}, error) {
+	identityRoles := rest_model.Roles(quota.IdentityRoles)
+	if identityRoles == nil {
+		identityRoles = rest_model.Roles{}
+	}
+	serviceRoles := rest_model.Roles(quota.ServiceRoles)
+	if serviceRoles == nil {
+		serviceRoles = rest_model.Roles{}
+	}
+	scope := rest_model.QuotaScope(quota.Scope)
+	period := rest_model.QuotaPeriod(quota.Period)
+	softLimit := rest_model.QuotaBytes(quota.SoftLimit)
+	hardLimit := rest_model.QuotaBytes(quota.HardLimit)
+	hardLimitAction := rest_model.QuotaHardLimitAction(quota.GetHardLimitAction())
+	return &rest_model.QuotaDetail{
+		BaseEntity:      BaseEntityToRestModel(quota, QuotaLinkFactory),
+		Name:            &quota.Name,
+		Scope:           &scope,
+		Period:          &period,
+		SoftLimit:       &softLimit,
+		HardLimit:       &hardLimit,
+		HardLimitAction: &hardLimitAction,
+		IdentityRoles:   identityRoles,
+		ServiceRoles:    serviceRoles,
+	}, nil
+}
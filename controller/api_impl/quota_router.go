@@ -0,0 +1,139 @@
+/*
+	Copyright NetFoundry Inc.
+
+	Licensed under the Apache License, Version 2.0 (the "License");
+	you may not use this file except in compliance with the License.
+	You may obtain a copy of the License at
+
+	https://www.apache.org/licenses/LICENSE-2.0
+
+	Unless required by applicable law or agreed to in writing, software
+	distributed under the License is distributed on an "AS IS" BASIS,
+	WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+	See the License for the specific language governing permissions and
+	limitations under the License.
+*/
+
+package api_impl
+
+import (
+	"github.com/go-openapi/runtime/middleware"
+	"github.com/go-openapi/strfmt"
+	"github.com/openziti/ziti/controller/api"
+	"github.com/openziti/ziti/controller/db"
+	"github.com/openziti/ziti/controller/fields"
+	"github.com/openziti/ziti/controller/model"
+	"github.com/openziti/ziti/controller/network"
+	"github.com/openziti/ziti/controller/rest_model"
+	"github.com/openziti/ziti/controller/rest_server/operations"
+	"github.com/openziti/ziti/controller/rest_server/operations/quota"
+)
+
+func init() {
+	r := NewQuotaRouter()
+	AddRouter(r)
+}
+
+type QuotaRouter struct {
+	BasePath string
+}
+
+func NewQuotaRouter() *QuotaRouter {
+	return &QuotaRouter{
+		BasePath: "/" + EntityNameQuota,
+	}
+}
+
+func (r *QuotaRouter) Register(fabricApi *operations.ZitiFabricAPI, wrapper RequestWrapper) {
+	fabricApi.QuotaDeleteQuotaHandler = quota.DeleteQuotaHandlerFunc(func(params quota.DeleteQuotaParams) middleware.Responder {
+		return wrapper.WrapRequest(r.Delete, params.HTTPRequest, params.ID, "")
+	})
+
+	fabricApi.QuotaDetailQuotaHandler = quota.DetailQuotaHandlerFunc(func(params quota.DetailQuotaParams) middleware.Responder {
+		return wrapper.WrapRequest(r.Detail, params.HTTPRequest, params.ID, "")
+	})
+
+	fabricApi.QuotaListQuotasHandler = quota.ListQuotasHandlerFunc(func(params quota.ListQuotasParams) middleware.Responder {
+		return wrapper.WrapRequest(r.ListQuotas, params.HTTPRequest, "", "")
+	})
+
+	fabricApi.QuotaListQuotaUsageHandler = quota.ListQuotaUsageHandlerFunc(func(params quota.ListQuotaUsageParams) middleware.Responder {
+		return wrapper.WrapRequest(r.ListUsage, params.HTTPRequest, params.ID, "")
+	})
+
+	fabricApi.QuotaUpdateQuotaHandler = quota.UpdateQuotaHandlerFunc(func(params quota.UpdateQuotaParams) middleware.Responder {
+		return wrapper.WrapRequest(func(n *network.Network, rc api.RequestContext) { r.Update(n, rc, params) }, params.HTTPRequest, params.ID, "")
+	})
+
+	fabricApi.QuotaCreateQuotaHandler = quota.CreateQuotaHandlerFunc(func(params quota.CreateQuotaParams) middleware.Responder {
+		return wrapper.WrapRequest(func(n *network.Network, rc api.RequestContext) { r.Create(n, rc, params) }, params.HTTPRequest, "", "")
+	})
+
+	fabricApi.QuotaPatchQuotaHandler = quota.PatchQuotaHandlerFunc(func(params quota.PatchQuotaParams) middleware.Responder {
+		return wrapper.WrapRequest(func(n *network.Network, rc api.RequestContext) { r.Patch(n, rc, params) }, params.HTTPRequest, params.ID, "")
+	})
+}
+
+func (r *QuotaRouter) ListQuotas(n *network.Network, rc api.RequestContext) {
+	ListWithHandler[*model.Quota](n, rc, n.Managers.Quota, QuotaModelMapper{})
+}
+
+func (r *QuotaRouter) Detail(n *network.Network, rc api.RequestContext) {
+	DetailWithHandler[*model.Quota](n, rc, n.Managers.Quota, QuotaModelMapper{})
+}
+
+func (r *QuotaRouter) ListUsage(n *network.Network, rc api.RequestContext) {
+	id, err := rc.GetEntityId()
+	if err != nil {
+		rc.RespondWithError(err)
+		return
+	}
+
+	usage, err := n.Managers.Quota.GetUsage(id)
+	if err != nil {
+		rc.RespondWithError(err)
+		return
+	}
+	RespondWithOk(rc, MapQuotaUsageToRestModel(usage), &rest_model.Meta{})
+}
+
+func (r *QuotaRouter) Create(n *network.Network, rc api.RequestContext, params quota.CreateQuotaParams) {
+	Create(rc, QuotaLinkFactory, func() (string, error) {
+		entity := MapCreateQuotaToModel(params.Quota)
+		if err := n.Managers.Quota.Create(entity, rc.NewChangeContext()); err != nil {
+			return "", err
+		}
+		return entity.Id, nil
+	})
+}
+
+func (r *QuotaRouter) Delete(n *network.Network, rc api.RequestContext) {
+	DeleteWithHandler(rc, n.Managers.Quota)
+}
+
+func (r *QuotaRouter) Update(n *network.Network, rc api.RequestContext, params quota.UpdateQuotaParams) {
+	Update(rc, func(id string) error {
+		return n.Managers.Quota.Update(MapUpdateQuotaToModel(params.ID, params.Quota), nil, rc.NewChangeContext())
+	})
+}
+
+func (r *QuotaRouter) Patch(n *network.Network, rc api.RequestContext, params quota.PatchQuotaParams) {
+	Patch(rc, func(id string, fields fields.UpdatedFields) error {
+		return n.Managers.Quota.Update(MapPatchQuotaToModel(params.ID, params.Quota), fields.FilterMaps("tags"), rc.NewChangeContext())
+	})
+}
+
+func MapQuotaUsageToRestModel(usage []*db.QuotaUsage) rest_model.QuotaUsageList {
+	result := rest_model.QuotaUsageList{}
+	for _, u := range usage {
+		periodStart := strfmt.DateTime(u.PeriodStart)
+		result = append(result, &rest_model.QuotaUsage{
+			SubjectID:         &u.SubjectId,
+			PeriodStart:       &periodStart,
+			Bytes:             &u.Bytes,
+			SoftLimitExceeded: &u.SoftLimitExceeded,
+			HardLimitExceeded: &u.HardLimitExceeded,
+		})
+	}
+	return result
+}
@@ -0,0 +1,88 @@
+/*
+	Copyright NetFoundry Inc.
+
+	Licensed under the Apache License, Version 2.0 (the "License");
+	you may not use this file except in compliance with the License.
+	You may obtain a copy of the License at
+
+	https://www.apache.org/licenses/LICENSE-2.0
+
+	Unless required by applicable law or agreed to in writing, software
+	distributed under the License is distributed on an "AS IS" BASIS,
+	WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+	See the License for the specific language governing permissions and
+	limitations under the License.
+*/
+
+package api_impl
+
+import (
+	"github.com/openziti/foundation/v2/stringz"
+	"github.com/openziti/ziti/controller/api"
+	"github.com/openziti/ziti/controller/model"
+	"github.com/openziti/ziti/controller/models"
+	"github.com/openziti/ziti/controller/network"
+	"github.com/openziti/ziti/controller/rest_model"
+)
+
+const EntityNameRole = "roles"
+
+var RoleLinkFactory = NewBasicLinkFactory(EntityNameRole)
+
+func MapCreateRoleToModel(role *rest_model.RoleCreate) *model.Role {
+	return &model.Role{
+		BaseEntity: models.BaseEntity{
+			Tags: TagsOrDefault(role.Tags),
+		},
+		Name:          stringz.OrEmpty(role.Name),
+		IdentityRoles: role.IdentityRoles,
+		Permissions:   role.Permissions,
+		ScopeRoles:    role.ScopeRoles,
+	}
+}
+
+func MapUpdateRoleToModel(id string, role *rest_model.RoleUpdate) *model.Role {
+	return &model.Role{
+		BaseEntity: models.BaseEntity{
+			Tags: TagsOrDefault(role.Tags),
+			Id:   id,
+		},
+		Name:          stringz.OrEmpty(role.Name),
+		IdentityRoles: role.IdentityRoles,
+		Permissions:   role.Permissions,
+		ScopeRoles:    role.ScopeRoles,
+	}
+}
+
+func MapPatchRoleToModel(id string, role *rest_model.RolePatch) *model.Role {
+	return &model.Role{
+		BaseEntity: models.BaseEntity{
+			Tags: TagsOrDefault(role.Tags),
+			Id:   id,
+		},
+		Name:          role.Name,
+		IdentityRoles: role.IdentityRoles,
+		Permissions:   role.Permissions,
+		ScopeRoles:    role.ScopeRoles,
+	}
+}
+
+type RoleModelMapper struct{}
+
+func (RoleModelMapper) ToApi(_ *network.Network, _ api.RequestContext, role *model.Role) (interface{}, error) {
+	identityRoles := rest_model.Roles(role.IdentityRoles)
+	if identityRoles == nil {
+		identityRoles = rest_model.Roles{}
+	}
+	scopeRoles := rest_model.Roles(role.ScopeRoles)
+	if scopeRoles == nil {
+		scopeRoles = rest_model.Roles{}
+	}
+	return &rest_model.RoleDetail{
+		BaseEntity:    BaseEntityToRestModel(role, RoleLinkFactory),
+		Name:          &role.Name,
+		IdentityRoles: identityRoles,
+		Permissions:   role.Permissions,
+		ScopeRoles:    scopeRoles,
+	}, nil
+}
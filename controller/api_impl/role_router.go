@@ -0,0 +1,136 @@
+/*
+	Copyright NetFoundry Inc.
+
+	Licensed under the Apache License, Version 2.0 (the "License");
+	you may not use this file except in compliance with the License.
+	You may obtain a copy of the License at
+
+	https://www.apache.org/licenses/LICENSE-2.0
+
+	Unless required by applicable law or agreed to in writing, software
+	distributed under the License is distributed on an "AS IS" BASIS,
+	WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+	See the License for the specific language governing permissions and
+	limitations under the License.
+*/
+
+package api_impl
+
+import (
+	"github.com/go-openapi/runtime/middleware"
+	"github.com/openziti/ziti/controller/api"
+	"github.com/openziti/ziti/controller/fields"
+	"github.com/openziti/ziti/controller/internal/permissions"
+	"github.com/openziti/ziti/controller/model"
+	"github.com/openziti/ziti/controller/network"
+	"github.com/openziti/ziti/controller/rest_model"
+	"github.com/openziti/ziti/controller/rest_server/operations"
+	"github.com/openziti/ziti/controller/rest_server/operations/role"
+)
+
+func init() {
+	r := NewRoleRouter()
+	AddRouter(r)
+}
+
+type RoleRouter struct {
+	BasePath string
+}
+
+func NewRoleRouter() *RoleRouter {
+	return &RoleRouter{
+		BasePath: "/" + EntityNameRole,
+	}
+}
+
+// ActivePermissionsProvider is implemented by request contexts which carry the permissions of an authenticated identity
+type ActivePermissionsProvider interface {
+	GetActivePermissions() []string
+}
+
+func (r *RoleRouter) Register(fabricApi *operations.ZitiFabricAPI, wrapper RequestWrapper) {
+	fabricApi.RoleDeleteRoleHandler = role.DeleteRoleHandlerFunc(func(params role.DeleteRoleParams) middleware.Responder {
+		return wrapper.WrapRequest(r.Delete, params.HTTPRequest, params.ID, "")
+	})
+
+	fabricApi.RoleDetailRoleHandler = role.DetailRoleHandlerFunc(func(params role.DetailRoleParams) middleware.Responder {
+		return wrapper.WrapRequest(r.Detail, params.HTTPRequest, params.ID, "")
+	})
+
+	fabricApi.RoleListRolesHandler = role.ListRolesHandlerFunc(func(params role.ListRolesParams) middleware.Responder {
+		return wrapper.WrapRequest(r.ListRoles, params.HTTPRequest, "", "")
+	})
+
+	fabricApi.RoleUpdateRoleHandler = role.UpdateRoleHandlerFunc(func(params role.UpdateRoleParams) middleware.Responder {
+		return wrapper.WrapRequest(func(n *network.Network, rc api.RequestContext) { r.Update(n, rc, params) }, params.HTTPRequest, params.ID, "")
+	})
+
+	fabricApi.RoleCreateRoleHandler = role.CreateRoleHandlerFunc(func(params role.CreateRoleParams) middleware.Responder {
+		return wrapper.WrapRequest(func(n *network.Network, rc api.RequestContext) { r.Create(n, rc, params) }, params.HTTPRequest, "", "")
+	})
+
+	fabricApi.RolePatchRoleHandler = role.PatchRoleHandlerFunc(func(params role.PatchRoleParams) middleware.Responder {
+		return wrapper.WrapRequest(func(n *network.Network, rc api.RequestContext) { r.Patch(n, rc, params) }, params.HTTPRequest, params.ID, "")
+	})
+
+	fabricApi.RoleDetailCurrentIdentityPermissionsHandler = role.DetailCurrentIdentityPermissionsHandlerFunc(func(params role.DetailCurrentIdentityPermissionsParams) middleware.Responder {
+		return wrapper.WrapRequest(r.DetailCurrentIdentityPermissions, params.HTTPRequest, "", "", permissions.IsAuthenticated())
+	})
+}
+
+func (r *RoleRouter) ListRoles(n *network.Network, rc api.RequestContext) {
+	ListWithHandler[*model.Role](n, rc, n.Managers.Role, RoleModelMapper{})
+}
+
+func (r *RoleRouter) Detail(n *network.Network, rc api.RequestContext) {
+	DetailWithHandler[*model.Role](n, rc, n.Managers.Role, RoleModelMapper{})
+}
+
+func (r *RoleRouter) Create(n *network.Network, rc api.RequestContext, params role.CreateRoleParams) {
+	Create(rc, RoleLinkFactory, func() (string, error) {
+		entity := MapCreateRoleToModel(params.Role)
+		if err := n.Managers.Role.Create(entity, rc.NewChangeContext()); err != nil {
+			return "", err
+		}
+		return entity.Id, nil
+	})
+}
+
+func (r *RoleRouter) Delete(n *network.Network, rc api.RequestContext) {
+	DeleteWithHandler(rc, n.Managers.Role)
+}
+
+func (r *RoleRouter) Update(n *network.Network, rc api.RequestContext, params role.UpdateRoleParams) {
+	Update(rc, func(id string) error {
+		return n.Managers.Role.Update(MapUpdateRoleToModel(params.ID, params.Role), nil, rc.NewChangeContext())
+	})
+}
+
+func (r *RoleRouter) Patch(n *network.Network, rc api.RequestContext, params role.PatchRoleParams) {
+	Patch(rc, func(id string, fields fields.UpdatedFields) error {
+		return n.Managers.Role.Update(MapPatchRoleToModel(params.ID, params.Role), fields.FilterMaps("tags"), rc.NewChangeContext())
+	})
+}
+
+func (r *RoleRouter) DetailCurrentIdentityPermissions(_ *network.Network, rc api.RequestContext) {
+	provider, ok := rc.(ActivePermissionsProvider)
+	if !ok {
+		rc.RespondWithNotFound()
+		return
+	}
+
+	activePermissions := provider.GetActivePermissions()
+	isAdmin := permissions.IsAdmin().IsAllowed(activePermissions...)
+	result := &rest_model.CurrentIdentityPermissions{
+		IsAdmin: &isAdmin,
+		Grants:  rest_model.Permissions{},
+	}
+
+	for _, p := range activePermissions {
+		if _, err := permissions.ParseGrant(p); err == nil {
+			result.Grants = append(result.Grants, p)
+		}
+	}
+
+	RespondWithOk(rc, result, &rest_model.Meta{})
+}
@@ -0,0 +1,145 @@
+/*
+	Copyright NetFoundry Inc.
+
+	Licensed under the Apache License, Version 2.0 (the "License");
+	you may not use this file except in compliance with the License.
+	You may obtain a copy of the License at
+
+	https://www.apache.org/licenses/LICENSE-2.0
+
+	Unless required by applicable law or agreed to in writing, software
+	distributed under the License is distributed on an "AS IS" BASIS,
+	WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+	See the License for the specific language governing permissions and
+	limitations under the License.
+*/
+
+package api_impl
+
+import (
+	"net/http"
+	"time"
+
+	"github.com/go-openapi/runtime/middleware"
+	"github.com/go-openapi/strfmt"
+	"github.com/openziti/ziti/controller/api"
+	"github.com/openziti/ziti/controller/model"
+	"github.com/openziti/ziti/controller/network"
+	"github.com/openziti/ziti/controller/rest_model"
+	"github.com/openziti/ziti/controller/rest_server/operations"
+	"github.com/openziti/ziti/controller/rest_server/operations/router_cert_rotation"
+)
+
+func init() {
+	r := NewRouterCertRotationRouter()
+	AddRouter(r)
+}
+
+type RouterCertRotationRouter struct{}
+
+func NewRouterCertRotationRouter() *RouterCertRotationRouter {
+	return &RouterCertRotationRouter{}
+}
+
+func (r *RouterCertRotationRouter) Register(fabricApi *operations.ZitiFabricAPI, wrapper RequestWrapper) {
+	fabricApi.RouterCertRotationListRouterCertRotationsHandler = router_cert_rotation.ListRouterCertRotationsHandlerFunc(func(params router_cert_rotation.ListRouterCertRotationsParams) middleware.Responder {
+		return wrapper.WrapRequest(r.List, params.HTTPRequest, "", "")
+	})
+
+	fabricApi.RouterCertRotationCreateRouterCertRotationHandler = router_cert_rotation.CreateRouterCertRotationHandlerFunc(func(params router_cert_rotation.CreateRouterCertRotationParams) middleware.Responder {
+		return wrapper.WrapRequest(func(n *network.Network, rc api.RequestContext) { r.Create(n, rc, params) }, params.HTTPRequest, "", "")
+	})
+
+	fabricApi.RouterCertRotationDetailRouterCertRotationHandler = router_cert_rotation.DetailRouterCertRotationHandlerFunc(func(params router_cert_rotation.DetailRouterCertRotationParams) middleware.Responder {
+		return wrapper.WrapRequest(func(n *network.Network, rc api.RequestContext) { r.Detail(n, rc, params.ID) }, params.HTTPRequest, params.ID, "")
+	})
+}
+
+func (r *RouterCertRotationRouter) List(n *network.Network, rc api.RequestContext) {
+	result := rest_model.RouterCertRotationList{}
+	for _, rotation := range n.Managers.RouterCertRotation.List() {
+		result = append(result, MapRouterCertRotationToRestModel(rotation))
+	}
+
+	rc.Respond(&rest_model.ListRouterCertRotationsEnvelope{
+		Data: result,
+		Meta: &rest_model.Meta{},
+	}, http.StatusOK)
+}
+
+func (r *RouterCertRotationRouter) Create(n *network.Network, rc api.RequestContext, params router_cert_rotation.CreateRouterCertRotationParams) {
+	rotation, err := n.Managers.RouterCertRotation.Start(MapRouterCertRotationCreateToModel(params.Rotation))
+	if err != nil {
+		rc.RespondWithError(err)
+		return
+	}
+
+	rc.Respond(&rest_model.RouterCertRotationEnvelope{
+		Data: MapRouterCertRotationToRestModel(rotation),
+		Meta: &rest_model.Meta{},
+	}, http.StatusAccepted)
+}
+
+func (r *RouterCertRotationRouter) Detail(n *network.Network, rc api.RequestContext, id string) {
+	rotation, err := n.Managers.RouterCertRotation.Get(id)
+	if err != nil {
+		rc.RespondWithError(err)
+		return
+	}
+
+	rc.Respond(&rest_model.RouterCertRotationEnvelope{
+		Data: MapRouterCertRotationToRestModel(rotation),
+		Meta: &rest_model.Meta{},
+	}, http.StatusOK)
+}
+
+func MapRouterCertRotationCreateToModel(rotation *rest_model.RouterCertRotationCreate) *model.RouterCertRotationSpec {
+	return &model.RouterCertRotationSpec{
+		RouterIds: rotation.RouterIds,
+		Filter:    rotation.Filter,
+		BatchSize: int(rotation.BatchSize),
+		Timeout:   time.Duration(rotation.TimeoutSeconds) * time.Second,
+	}
+}
+
+func MapRouterCertRotationToRestModel(rotation *model.RouterCertRotation) *rest_model.RouterCertRotationDetail {
+	count := func(v int) *int64 {
+		result := int64(v)
+		return &result
+	}
+
+	complete := rotation.IsComplete()
+	result := &rest_model.RouterCertRotationDetail{
+		ID:             &rotation.Id,
+		BatchSize:      count(rotation.BatchSize),
+		TimeoutSeconds: count(int(rotation.Timeout / time.Second)),
+		Total:          count(len(rotation.Routers)),
+		Completed:      count(rotation.CountByState(model.RouterCertRotationCompleted)),
+		Failed:         count(rotation.CountByState(model.RouterCertRotationFailed)),
+		Complete:       &complete,
+		StartedAt:      (*strfmt.DateTime)(&rotation.StartedAt),
+		Routers:        []*rest_model.RouterCertRotationStatus{},
+	}
+
+	if rotation.CompletedAt != nil {
+		result.CompletedAt = strfmt.DateTime(*rotation.CompletedAt)
+	}
+
+	for _, status := range rotation.Routers {
+		restStatus := &rest_model.RouterCertRotationStatus{
+			RouterID:   &status.RouterId,
+			RouterName: status.RouterName,
+			State:      &status.State,
+			Error:      status.Error,
+		}
+		if status.RequestedAt != nil {
+			restStatus.RequestedAt = strfmt.DateTime(*status.RequestedAt)
+		}
+		if status.CompletedAt != nil {
+			restStatus.CompletedAt = strfmt.DateTime(*status.CompletedAt)
+		}
+		result.Routers = append(result.Routers, restStatus)
+	}
+
+	return result
+}
@@ -0,0 +1,150 @@
+/*
+	Copyright NetFoundry Inc.
+
+	Licensed under the Apache License, Version 2.0 (the "License");
+	you may not use this file except in compliance with the License.
+	You may obtain a copy of the License at
+
+	https://www.apache.org/licenses/LICENSE-2.0
+
+	Unless required by applicable law or agreed to in writing, software
+	distributed under the License is distributed on an "AS IS" BASIS,
+	WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+	See the License for the specific language governing permissions and
+	limitations under the License.
+*/
+
+package api_impl
+
+import (
+	"net/http"
+	"time"
+
+	"github.com/go-openapi/runtime/middleware"
+	"github.com/go-openapi/strfmt"
+	"github.com/openziti/ziti/controller/api"
+	"github.com/openziti/ziti/controller/network"
+	"github.com/openziti/ziti/controller/rest_model"
+	"github.com/openziti/ziti/controller/rest_server/operations"
+	"github.com/openziti/ziti/controller/rest_server/operations/router"
+)
+
+func init() {
+	r := NewRouterDrainRouter()
+	AddRouter(r)
+}
+
+type RouterDrainRouter struct{}
+
+func NewRouterDrainRouter() *RouterDrainRouter {
+	return &RouterDrainRouter{}
+}
+
+func (r *RouterDrainRouter) Register(fabricApi *operations.ZitiFabricAPI, wrapper RequestWrapper) {
+	fabricApi.RouterListRouterDrainsHandler = router.ListRouterDrainsHandlerFunc(func(params router.ListRouterDrainsParams) middleware.Responder {
+		return wrapper.WrapRequest(r.List, params.HTTPRequest, "", "")
+	})
+
+	fabricApi.RouterDetailRouterDrainHandler = router.DetailRouterDrainHandlerFunc(func(params router.DetailRouterDrainParams) middleware.Responder {
+		return wrapper.WrapRequest(func(n *network.Network, rc api.RequestContext) { r.Detail(n, rc, params.ID) }, params.HTTPRequest, params.ID, "")
+	})
+
+	fabricApi.RouterDrainRouterHandler = router.DrainRouterHandlerFunc(func(params router.DrainRouterParams) middleware.Responder {
+		return wrapper.WrapRequest(func(n *network.Network, rc api.RequestContext) { r.Drain(n, rc, params) }, params.HTTPRequest, params.ID, "")
+	})
+
+	fabricApi.RouterCancelRouterDrainHandler = router.CancelRouterDrainHandlerFunc(func(params router.CancelRouterDrainParams) middleware.Responder {
+		return wrapper.WrapRequest(func(n *network.Network, rc api.RequestContext) { r.Cancel(n, rc, params.ID) }, params.HTTPRequest, params.ID, "")
+	})
+}
+
+func (r *RouterDrainRouter) List(n *network.Network, rc api.RequestContext) {
+	result := rest_model.RouterDrainList{}
+	for _, drain := range n.Drains.List() {
+		result = append(result, MapRouterDrainToRestModel(drain))
+	}
+
+	rc.Respond(&rest_model.ListRouterDrainsEnvelope{
+		Data: result,
+		Meta: &rest_model.Meta{},
+	}, http.StatusOK)
+}
+
+func (r *RouterDrainRouter) Detail(n *network.Network, rc api.RequestContext, id string) {
+	drain, err := n.Drains.Get(id)
+	if err != nil {
+		rc.RespondWithError(err)
+		return
+	}
+
+	rc.Respond(&rest_model.RouterDrainEnvelope{
+		Data: MapRouterDrainToRestModel(drain),
+		Meta: &rest_model.Meta{},
+	}, http.StatusOK)
+}
+
+func (r *RouterDrainRouter) Drain(n *network.Network, rc api.RequestContext, params router.DrainRouterParams) {
+	spec := &network.RouterDrainSpec{
+		RouterId: params.ID,
+	}
+
+	if params.Drain != nil {
+		spec.Quiesce = params.Drain.Quiesce
+		spec.EdgeCircuitTimeout = time.Duration(params.Drain.EdgeCircuitTimeoutSeconds) * time.Second
+	}
+
+	drain, err := n.Drains.Start(spec, rc.NewChangeContext())
+	if err != nil {
+		rc.RespondWithError(err)
+		return
+	}
+
+	rc.Respond(&rest_model.RouterDrainEnvelope{
+		Data: MapRouterDrainToRestModel(drain),
+		Meta: &rest_model.Meta{},
+	}, http.StatusAccepted)
+}
+
+func (r *RouterDrainRouter) Cancel(n *network.Network, rc api.RequestContext, id string) {
+	if err := n.Drains.Cancel(id); err != nil {
+		rc.RespondWithError(err)
+		return
+	}
+
+	rc.Respond(&rest_model.Empty{Data: map[string]interface{}{}, Meta: &rest_model.Meta{}}, http.StatusOK)
+}
+
+func MapRouterDrainToRestModel(drain *network.RouterDrain) *rest_model.RouterDrainDetail {
+	transitCircuits := int64(drain.TransitCircuits)
+	transitRerouted := int64(drain.TransitRerouted)
+	transitEnded := int64(drain.TransitEnded)
+	edgeCircuits := int64(drain.EdgeCircuits)
+
+	result := &rest_model.RouterDrainDetail{
+		RouterID:                  &drain.RouterId,
+		RouterName:                drain.RouterName,
+		State:                     &drain.State,
+		Quiesce:                   drain.Quiesce,
+		EdgeCircuitTimeoutSeconds: int64(drain.EdgeCircuitTimeout / time.Second),
+		StartedAt:                 (*strfmt.DateTime)(&drain.StartedAt),
+		TransitCircuits:           &transitCircuits,
+		TransitRerouted:           &transitRerouted,
+		TransitEnded:              &transitEnded,
+		EdgeCircuits:              &edgeCircuits,
+		Stragglers:                []*rest_model.RouterDrainStraggler{},
+	}
+
+	if drain.CompletedAt != nil {
+		result.CompletedAt = (*strfmt.DateTime)(drain.CompletedAt)
+	}
+
+	for _, straggler := range drain.Stragglers {
+		result.Stragglers = append(result.Stragglers, &rest_model.RouterDrainStraggler{
+			CircuitID: &straggler.CircuitId,
+			Kind:      &straggler.Kind,
+			Error:     straggler.Error,
+		})
+	}
+
+	return result
+}
@@ -20,6 +20,7 @@ import (
 	"github.com/go-openapi/runtime/middleware"
 	"github.com/openziti/ziti/controller/api"
 	"github.com/openziti/ziti/controller/fields"
+	"github.com/openziti/ziti/controller/internal/permissions"
 	"github.com/openziti/ziti/controller/model"
 	"github.com/openziti/ziti/controller/network"
 	"github.com/openziti/ziti/controller/rest_server/operations"
@@ -43,31 +44,31 @@ func NewRouterRouter() *RouterRouter {
 
 func (r *RouterRouter) Register(fabricApi *operations.ZitiFabricAPI, wrapper RequestWrapper) {
 	fabricApi.RouterDeleteRouterHandler = router.DeleteRouterHandlerFunc(func(params router.DeleteRouterParams) middleware.Responder {
-		return wrapper.WrapRequest(r.Delete, params.HTTPRequest, params.ID, "")
+		return wrapper.WrapRequest(r.Delete, params.HTTPRequest, params.ID, "", permissions.CanDelete(EntityNameRouter))
 	})
 
 	fabricApi.RouterDetailRouterHandler = router.DetailRouterHandlerFunc(func(params router.DetailRouterParams) middleware.Responder {
-		return wrapper.WrapRequest(r.Detail, params.HTTPRequest, params.ID, "")
+		return wrapper.WrapRequest(r.Detail, params.HTTPRequest, params.ID, "", permissions.CanRead(EntityNameRouter))
 	})
 
 	fabricApi.RouterListRoutersHandler = router.ListRoutersHandlerFunc(func(params router.ListRoutersParams) middleware.Responder {
-		return wrapper.WrapRequest(r.ListRouters, params.HTTPRequest, "", "")
+		return wrapper.WrapRequest(r.ListRouters, params.HTTPRequest, "", "", permissions.CanRead(EntityNameRouter))
 	})
 
 	fabricApi.RouterCreateRouterHandler = router.CreateRouterHandlerFunc(func(params router.CreateRouterParams) middleware.Responder {
-		return wrapper.WrapRequest(func(n *network.Network, rc api.RequestContext) { r.Create(n, rc, params) }, params.HTTPRequest, "", "")
+		return wrapper.WrapRequest(func(n *network.Network, rc api.RequestContext) { r.Create(n, rc, params) }, params.HTTPRequest, "", "", permissions.CanCreate(EntityNameRouter))
 	})
 
 	fabricApi.RouterUpdateRouterHandler = router.UpdateRouterHandlerFunc(func(params router.UpdateRouterParams) middleware.Responder {
-		return wrapper.WrapRequest(func(n *network.Network, rc api.RequestContext) { r.Update(n, rc, params) }, params.HTTPRequest, params.ID, "")
+		return wrapper.WrapRequest(func(n *network.Network, rc api.RequestContext) { r.Update(n, rc, params) }, params.HTTPRequest, params.ID, "", permissions.CanUpdate(EntityNameRouter))
 	})
 
 	fabricApi.RouterPatchRouterHandler = router.PatchRouterHandlerFunc(func(params router.PatchRouterParams) middleware.Responder {
-		return wrapper.WrapRequest(func(n *network.Network, rc api.RequestContext) { r.Patch(n, rc, params) }, params.HTTPRequest, params.ID, "")
+		return wrapper.WrapRequest(func(n *network.Network, rc api.RequestContext) { r.Patch(n, rc, params) }, params.HTTPRequest, params.ID, "", permissions.CanUpdate(EntityNameRouter))
 	})
 
 	fabricApi.RouterListRouterTerminatorsHandler = router.ListRouterTerminatorsHandlerFunc(func(params router.ListRouterTerminatorsParams) middleware.Responder {
-		return wrapper.WrapRequest(r.listManagementTerminators, params.HTTPRequest, params.ID, "")
+		return wrapper.WrapRequest(r.listManagementTerminators, params.HTTPRequest, params.ID, "", permissions.CanRead(EntityNameRouter))
 	})
 }
 
@@ -0,0 +1,106 @@
+/*
+	Copyright NetFoundry Inc.
+
+	Licensed under the Apache License, Version 2.0 (the "License");
+	you may not use this file except in compliance with the License.
+	You may obtain a copy of the License at
+
+	https://www.apache.org/licenses/LICENSE-2.0
+
+	Unless required by applicable law or agreed to in writing, software
+	distributed under the License is distributed on an "AS IS" BASIS,
+	WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+	See the License for the specific language governing permissions and
+	limitations under the License.
+*/
+
+package api_impl
+
+import (
+	"net/http"
+
+	"github.com/go-openapi/runtime/middleware"
+	"github.com/go-openapi/strfmt"
+	"github.com/openziti/ziti/controller/api"
+	"github.com/openziti/ziti/controller/network"
+	"github.com/openziti/ziti/controller/rest_model"
+	"github.com/openziti/ziti/controller/rest_server/operations"
+	"github.com/openziti/ziti/controller/rest_server/operations/topology"
+)
+
+func init() {
+	r := NewTopologyRouter()
+	AddRouter(r)
+}
+
+type TopologyRouter struct{}
+
+func NewTopologyRouter() *TopologyRouter {
+	return &TopologyRouter{}
+}
+
+func (r *TopologyRouter) Register(fabricApi *operations.ZitiFabricAPI, wrapper RequestWrapper) {
+	fabricApi.TopologyDetailTopologyHandler = topology.DetailTopologyHandlerFunc(func(params topology.DetailTopologyParams) middleware.Responder {
+		return wrapper.WrapRequest(r.Detail, params.HTTPRequest, "", "")
+	})
+}
+
+func (r *TopologyRouter) Detail(n *network.Network, rc api.RequestContext) {
+	result, err := n.GetTopology()
+	if err != nil {
+		rc.RespondWithError(err)
+		return
+	}
+
+	rc.Respond(&rest_model.TopologyEnvelope{
+		Data: MapTopologyToRestModel(result),
+		Meta: &rest_model.Meta{},
+	}, http.StatusOK)
+}
+
+func MapTopologyToRestModel(t *network.Topology) *rest_model.Topology {
+	result := &rest_model.Topology{
+		ControllerID: &t.ControllerId,
+		Timestamp:    (*strfmt.DateTime)(&t.Timestamp),
+		Routers:      []*rest_model.TopologyRouter{},
+		Links:        []*rest_model.TopologyLink{},
+	}
+
+	for _, router := range t.Routers {
+		cost := int64(router.Cost)
+		circuitCount := int64(router.CircuitCount)
+		result.Routers = append(result.Routers, &rest_model.TopologyRouter{
+			ID:                  &router.Id,
+			Name:                &router.Name,
+			Connected:           &router.Connected,
+			ConnectedController: router.ConnectedController,
+			ConnectedSince:      (*strfmt.DateTime)(router.ConnectedSince),
+			Version:             router.Version,
+			Cost:                &cost,
+			NoTraversal:         &router.NoTraversal,
+			Disabled:            &router.Disabled,
+			Draining:            &router.Draining,
+			CircuitCount:        &circuitCount,
+		})
+	}
+
+	for _, link := range t.Links {
+		staticCost := int64(link.StaticCost)
+		circuitCount := int64(link.CircuitCount)
+		result.Links = append(result.Links, &rest_model.TopologyLink{
+			ID:             &link.Id,
+			SourceRouterID: &link.SrcRouterId,
+			DestRouterID:   &link.DstRouterId,
+			Protocol:       &link.Protocol,
+			State:          &link.State,
+			Down:           &link.Down,
+			StaticCost:     &staticCost,
+			Cost:           &link.Cost,
+			SourceLatency:  &link.SrcLatency,
+			DestLatency:    &link.DstLatency,
+			CircuitCount:   &circuitCount,
+		})
+	}
+
+	return result
+}
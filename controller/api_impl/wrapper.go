@@ -3,6 +3,7 @@ package api_impl
 import (
 	"github.com/go-openapi/runtime/middleware"
 	"github.com/openziti/ziti/controller/api"
+	"github.com/openziti/ziti/controller/internal/permissions"
 	"github.com/openziti/ziti/controller/network"
 	"net/http"
 )
@@ -10,7 +11,8 @@ import (
 type RequestHandler func(network *network.Network, rc api.RequestContext)
 
 type RequestWrapper interface {
-	WrapRequest(handler RequestHandler, request *http.Request, entityId, entitySubId string) middleware.Responder
+	// WrapRequest wraps the handler with the request context. If no permissions are given, admin access is required.
+	WrapRequest(handler RequestHandler, request *http.Request, entityId, entitySubId string, permissions ...permissions.Resolver) middleware.Responder
 	WrapHttpHandler(handler http.Handler) http.Handler
 	WrapWsHandler(handler http.Handler) http.Handler
 }
@@ -450,3 +450,45 @@ func NewTransferLeadershipError(err error) *errorz.ApiError {
 		AppendCause: true,
 	}
 }
+
+func NewAuditNotEnabledError() *errorz.ApiError {
+	return &errorz.ApiError{
+		Code:    AuditNotEnabledCode,
+		Message: AuditNotEnabledMessage,
+		Status:  AuditNotEnabledStatus,
+	}
+}
+
+func NewAuditVersionNotRevertibleError() *errorz.ApiError {
+	return &errorz.ApiError{
+		Code:    AuditVersionNotRevertibleCode,
+		Message: AuditVersionNotRevertibleMessage,
+		Status:  AuditVersionNotRevertibleStatus,
+	}
+}
+
+func NewCircuitHistoryNotEnabledError() *errorz.ApiError {
+	return &errorz.ApiError{
+		Code:    CircuitHistoryNotEnabledCode,
+		Message: CircuitHistoryNotEnabledMessage,
+		Status:  CircuitHistoryNotEnabledStatus,
+	}
+}
+
+func NewQuotaExceededError(quotaName string) *errorz.ApiError {
+	return &errorz.ApiError{
+		Code:        QuotaExceededCode,
+		Message:     QuotaExceededMessage,
+		Status:      QuotaExceededStatus,
+		Cause:       fmt.Errorf("hard limit of quota '%s' reached", quotaName),
+		AppendCause: true,
+	}
+}
+
+func NewBackupNotConfiguredError() *errorz.ApiError {
+	return &errorz.ApiError{
+		Code:    BackupNotConfiguredCode,
+		Message: BackupNotConfiguredMessage,
+		Status:  BackupNotConfiguredStatus,
+	}
+}
@@ -230,4 +230,26 @@ const (
 	ClusterHasNoLeaderCode    string = "CLUSTER_NO_LEADER"
 	ClusterHasNoLeaderMessage string = "Cluster has no leader, unable to make model updates."
 	ClusterHasNoLeaderStatus  int    = http.StatusServiceUnavailable
+
+	AuditNotEnabledCode    string = "AUDIT_NOT_ENABLED"
+	AuditNotEnabledMessage string = "The audit log is not enabled on this controller"
+	AuditNotEnabledStatus  int    = http.StatusConflict
+
+	AuditVersionNotRevertibleCode    string = "AUDIT_VERSION_NOT_REVERTIBLE"
+	AuditVersionNotRevertibleMessage string = "The requested version has no state to revert to"
+	AuditVersionNotRevertibleStatus  int    = http.StatusBadRequest
+
+	QuotaExceededCode    string = "QUOTA_EXCEEDED"
+	QuotaExceededMessage string = "The data usage quota for the requested service has been exceeded"
+	QuotaExceededStatus  int    = http.StatusTooManyRequests
+
+	CircuitHistoryNotEnabledCode    string = "CIRCUIT_HISTORY_NOT_ENABLED"
+	CircuitHistoryNotEnabledMessage string = "The circuit history is not enabled on this controller"
+	CircuitHistoryNotEnabledStatus  int    = http.StatusConflict
+)
+
+const (
+	BackupNotConfiguredCode    string = "BACKUP_NOT_CONFIGURED"
+	BackupNotConfiguredMessage string = "Database backups are not configured on this controller"
+	BackupNotConfiguredStatus  int    = http.StatusConflict
 )
@@ -0,0 +1,239 @@
+/*
+	Copyright NetFoundry Inc.
+
+	Licensed under the Apache License, Version 2.0 (the "License");
+	you may not use this file except in compliance with the License.
+	You may obtain a copy of the License at
+
+	https://www.apache.org/licenses/LICENSE-2.0
+
+	Unless required by applicable law or agreed to in writing, software
+	distributed under the License is distributed on an "AS IS" BASIS,
+	WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+	See the License for the specific language governing permissions and
+	limitations under the License.
+*/
+
+package backup
+
+import (
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/rand"
+	"encoding/base64"
+	"encoding/binary"
+	"encoding/hex"
+	"io"
+	"os"
+	"strings"
+
+	"github.com/pkg/errors"
+)
+
+// Encrypted backups are written as a header followed by a sequence of AES-256-GCM sealed chunks, so that
+// arbitrarily large databases can be encrypted and decrypted as streams. Each chunk is prefixed with its sealed
+// length. The top bit of the length marks the final chunk and is also authenticated, so truncated backups are
+// detected on decryption.
+const (
+	encryptionMagic      = "ZBKENC01"
+	encryptionChunkSize  = 64 * 1024
+	encryptionNonceSize  = 12
+	encryptionPrefixSize = 8
+	finalChunkFlag       = uint32(1) << 31
+)
+
+// LoadKey reads a 256-bit encryption key from the given file. The file must contain the key hex or base64 encoded,
+// as generated by `openssl rand -hex 32`, for example.
+func LoadKey(path string) ([]byte, error) {
+	contents, err := os.ReadFile(path)
+	if err != nil {
+		return nil, errors.Wrapf(err, "unable to read backup encryption key file '%s'", path)
+	}
+
+	encoded := strings.TrimSpace(string(contents))
+	if key, err := hex.DecodeString(encoded); err == nil && len(key) == 32 {
+		return key, nil
+	}
+	if key, err := base64.StdEncoding.DecodeString(encoded); err == nil && len(key) == 32 {
+		return key, nil
+	}
+	return nil, errors.Errorf("backup encryption key file '%s' must contain a 32 byte key, hex or base64 encoded", path)
+}
+
+type encryptingWriter struct {
+	w       io.Writer
+	aead    cipher.AEAD
+	prefix  []byte
+	counter uint32
+	buf     []byte
+	closed  bool
+}
+
+// NewEncryptingWriter returns a writer which encrypts everything written to it with the given key. Close must be
+// called to write the final chunk. Closing doesn't close the underlying writer.
+func NewEncryptingWriter(key []byte, w io.Writer) (io.WriteCloser, error) {
+	aead, err := newAead(key)
+	if err != nil {
+		return nil, err
+	}
+
+	prefix := make([]byte, encryptionPrefixSize)
+	if _, err = rand.Read(prefix); err != nil {
+		return nil, err
+	}
+
+	if _, err = w.Write([]byte(encryptionMagic)); err != nil {
+		return nil, err
+	}
+	if _, err = w.Write(prefix); err != nil {
+		return nil, err
+	}
+
+	return &encryptingWriter{
+		w:      w,
+		aead:   aead,
+		prefix: prefix,
+		buf:    make([]byte, 0, encryptionChunkSize),
+	}, nil
+}
+
+func (self *encryptingWriter) Write(p []byte) (int, error) {
+	if self.closed {
+		return 0, errors.New("write to closed encrypting writer")
+	}
+
+	written := 0
+	for len(p) > 0 {
+		if len(self.buf) == encryptionChunkSize {
+			if err := self.writeChunk(false); err != nil {
+				return written, err
+			}
+		}
+		n := copy(self.buf[len(self.buf):encryptionChunkSize], p)
+		self.buf = self.buf[:len(self.buf)+n]
+		p = p[n:]
+		written += n
+	}
+	return written, nil
+}
+
+func (self *encryptingWriter) Close() error {
+	if self.closed {
+		return nil
+	}
+	self.closed = true
+	return self.writeChunk(true)
+}
+
+func (self *encryptingWriter) writeChunk(final bool) error {
+	header := make([]byte, 4)
+	length := uint32(len(self.buf) + self.aead.Overhead())
+	if final {
+		length |= finalChunkFlag
+	}
+	binary.BigEndian.PutUint32(header, length)
+
+	sealed := self.aead.Seal(nil, chunkNonce(self.prefix, self.counter), self.buf, header)
+	self.counter++
+	self.buf = self.buf[:0]
+
+	if _, err := self.w.Write(header); err != nil {
+		return err
+	}
+	_, err := self.w.Write(sealed)
+	return err
+}
+
+type decryptingReader struct {
+	r       io.Reader
+	aead    cipher.AEAD
+	prefix  []byte
+	counter uint32
+	buf     []byte
+	done    bool
+}
+
+// NewDecryptingReader returns a reader which decrypts a backup written by an encrypting writer with the same key
+func NewDecryptingReader(key []byte, r io.Reader) (io.Reader, error) {
+	aead, err := newAead(key)
+	if err != nil {
+		return nil, err
+	}
+
+	header := make([]byte, len(encryptionMagic)+encryptionPrefixSize)
+	if _, err = io.ReadFull(r, header); err != nil {
+		return nil, errors.Wrap(err, "unable to read encrypted backup header")
+	}
+	if string(header[:len(encryptionMagic)]) != encryptionMagic {
+		return nil, errors.New("not an encrypted backup")
+	}
+
+	return &decryptingReader{
+		r:      r,
+		aead:   aead,
+		prefix: header[len(encryptionMagic):],
+	}, nil
+}
+
+func (self *decryptingReader) Read(p []byte) (int, error) {
+	for len(self.buf) == 0 {
+		if self.done {
+			return 0, io.EOF
+		}
+		if err := self.readChunk(); err != nil {
+			return 0, err
+		}
+	}
+	n := copy(p, self.buf)
+	self.buf = self.buf[n:]
+	return n, nil
+}
+
+func (self *decryptingReader) readChunk() error {
+	header := make([]byte, 4)
+	if _, err := io.ReadFull(self.r, header); err != nil {
+		if errors.Is(err, io.EOF) {
+			return errors.New("encrypted backup is truncated")
+		}
+		return err
+	}
+
+	length := binary.BigEndian.Uint32(header)
+	final := length&finalChunkFlag != 0
+	length &^= finalChunkFlag
+	if length < uint32(self.aead.Overhead()) || length > uint32(encryptionChunkSize+self.aead.Overhead()) {
+		return errors.Errorf("invalid encrypted chunk length %d", length)
+	}
+
+	sealed := make([]byte, length)
+	if _, err := io.ReadFull(self.r, sealed); err != nil {
+		return errors.Wrap(err, "encrypted backup is truncated")
+	}
+
+	plain, err := self.aead.Open(sealed[:0], chunkNonce(self.prefix, self.counter), sealed, header)
+	if err != nil {
+		return errors.Wrap(err, "unable to decrypt backup, wrong key or corrupted file")
+	}
+	self.counter++
+	self.buf = plain
+	self.done = final
+	return nil
+}
+
+func newAead(key []byte) (cipher.AEAD, error) {
+	if len(key) != 32 {
+		return nil, errors.Errorf("backup encryption key must be 32 bytes, got %d", len(key))
+	}
+	block, err := aes.NewCipher(key)
+	if err != nil {
+		return nil, err
+	}
+	return cipher.NewGCM(block)
+}
+
+func chunkNonce(prefix []byte, counter uint32) []byte {
+	nonce := make([]byte, encryptionNonceSize)
+	copy(nonce, prefix)
+	binary.BigEndian.PutUint32(nonce[encryptionPrefixSize:], counter)
+	return nonce
+}
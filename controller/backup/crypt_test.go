@@ -0,0 +1,116 @@
+/*
+	Copyright NetFoundry Inc.
+
+	Licensed under the Apache License, Version 2.0 (the "License");
+	you may not use this file except in compliance with the License.
+	You may obtain a copy of the License at
+
+	https://www.apache.org/licenses/LICENSE-2.0
+
+	Unless required by applicable law or agreed to in writing, software
+	distributed under the License is distributed on an "AS IS" BASIS,
+	WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+	See the License for the specific language governing permissions and
+	limitations under the License.
+*/
+
+package backup
+
+import (
+	"bytes"
+	"crypto/rand"
+	"encoding/hex"
+	"io"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func newTestKey(t *testing.T) []byte {
+	key := make([]byte, 32)
+	_, err := rand.Read(key)
+	require.NoError(t, err)
+	return key
+}
+
+func encryptForTest(t *testing.T, key, plain []byte) []byte {
+	buf := &bytes.Buffer{}
+	w, err := NewEncryptingWriter(key, buf)
+	require.NoError(t, err)
+	_, err = w.Write(plain)
+	require.NoError(t, err)
+	require.NoError(t, w.Close())
+	return buf.Bytes()
+}
+
+func TestEncryptionRoundTrip(t *testing.T) {
+	req := require.New(t)
+	key := newTestKey(t)
+
+	for _, size := range []int{0, 1, encryptionChunkSize, 3*encryptionChunkSize + 17} {
+		plain := make([]byte, size)
+		_, err := rand.Read(plain)
+		req.NoError(err)
+
+		sealed := encryptForTest(t, key, plain)
+		req.False(size > 16 && bytes.Contains(sealed, plain[:16]))
+
+		r, err := NewDecryptingReader(key, bytes.NewReader(sealed))
+		req.NoError(err)
+		result, err := io.ReadAll(r)
+		req.NoError(err)
+		req.Equal(plain, result, "size %d", size)
+	}
+}
+
+func TestEncryptionDetectsTamperingAndTruncation(t *testing.T) {
+	req := require.New(t)
+	key := newTestKey(t)
+
+	plain := make([]byte, 2*encryptionChunkSize+100)
+	_, err := rand.Read(plain)
+	req.NoError(err)
+	sealed := encryptForTest(t, key, plain)
+
+	decrypt := func(key, data []byte) error {
+		r, err := NewDecryptingReader(key, bytes.NewReader(data))
+		if err != nil {
+			return err
+		}
+		_, err = io.ReadAll(r)
+		return err
+	}
+
+	req.NoError(decrypt(key, sealed))
+
+	// dropping whole trailing chunks must not go unnoticed
+	firstChunkEnd := len(encryptionMagic) + encryptionPrefixSize + 4 + encryptionChunkSize + 16
+	req.ErrorContains(decrypt(key, sealed[:firstChunkEnd]), "truncated")
+	req.ErrorContains(decrypt(key, sealed[:len(sealed)-10]), "truncated")
+
+	tampered := bytes.Clone(sealed)
+	tampered[firstChunkEnd-5] ^= 0xff
+	req.ErrorContains(decrypt(key, tampered), "wrong key or corrupted")
+
+	req.ErrorContains(decrypt(newTestKey(t), sealed), "wrong key or corrupted")
+	req.ErrorContains(decrypt(key, plain), "not an encrypted backup")
+}
+
+func TestLoadKey(t *testing.T) {
+	req := require.New(t)
+	key := newTestKey(t)
+	dir := t.TempDir()
+
+	hexPath := filepath.Join(dir, "hex.key")
+	req.NoError(os.WriteFile(hexPath, []byte(hex.EncodeToString(key)+"\n"), 0600))
+	loaded, err := LoadKey(hexPath)
+	req.NoError(err)
+	req.Equal(key, loaded)
+
+	shortPath := filepath.Join(dir, "short.key")
+	req.NoError(os.WriteFile(shortPath, []byte(hex.EncodeToString(key[:16])), 0600))
+	_, err = LoadKey(shortPath)
+	req.Error(err)
+}
@@ -0,0 +1,445 @@
+/*
+	Copyright NetFoundry Inc.
+
+	Licensed under the Apache License, Version 2.0 (the "License");
+	you may not use this file except in compliance with the License.
+	You may obtain a copy of the License at
+
+	https://www.apache.org/licenses/LICENSE-2.0
+
+	Unless required by applicable law or agreed to in writing, software
+	distributed under the License is distributed on an "AS IS" BASIS,
+	WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+	See the License for the specific language governing permissions and
+	limitations under the License.
+*/
+
+package backup
+
+import (
+	"compress/gzip"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"regexp"
+	"sort"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/michaelquigley/pfxlog"
+	"github.com/openziti/identity"
+	"github.com/openziti/storage/boltz"
+	"github.com/openziti/ziti/controller/command"
+	"github.com/openziti/ziti/controller/config"
+	"github.com/openziti/ziti/controller/db"
+	"github.com/openziti/ziti/controller/event"
+	"github.com/pkg/errors"
+	"go.etcd.io/bbolt"
+)
+
+const (
+	backupTimeFormat   = "20060102-150405"
+	scheduleCheckEvery = time.Minute
+	maxRetryDelay      = 15 * time.Minute
+)
+
+// ErrBackupInProgress is returned when a backup is requested while another is still running
+var ErrBackupInProgress = errors.New("a database backup is already in progress")
+
+type Env interface {
+	GetId() *identity.TokenId
+	GetDb() boltz.Db
+	GetCommandDispatcher() command.Dispatcher
+	GetEventDispatcher() event.Dispatcher
+	GetCloseNotify() <-chan struct{}
+}
+
+// Result describes a completed backup
+type Result struct {
+	Name      string
+	Size      int64
+	RaftIndex uint64
+	Duration  time.Duration
+	Removed   []string
+}
+
+// Status describes the backup schedule and the outcome of the most recent backup taken by this controller
+type Status struct {
+	Interval      time.Duration
+	Retain        int
+	Target        string
+	Compressed    bool
+	Encrypted     bool
+	InProgress    bool
+	LastAttempt   *time.Time
+	LastSuccess   *time.Time
+	LastBackup    string
+	LastError     string
+	NextScheduled *time.Time
+}
+
+// Manager takes scheduled backups of the controller database, writes them to the configured target and removes
+// backups beyond the retention limit. In a cluster, only the leader takes scheduled backups. Backups may also be
+// requested on demand from any controller.
+type Manager struct {
+	env     Env
+	config  *config.BackupConfig
+	target  Target
+	key     []byte
+	pattern *regexp.Regexp
+	now     func() time.Time
+
+	running atomic.Bool
+	lock    sync.Mutex
+	status  Status
+}
+
+func NewManager(env Env, cfg *config.BackupConfig) (*Manager, error) {
+	target, err := NewTarget(cfg.Target)
+	if err != nil {
+		return nil, err
+	}
+
+	result := &Manager{
+		env:     env,
+		config:  cfg,
+		target:  target,
+		pattern: regexp.MustCompile("^" + regexp.QuoteMeta(cfg.Prefix) + `-(\d{8}-\d{6})-\d+\.db(\.gz)?(\.enc)?$`),
+		now:     time.Now,
+		status: Status{
+			Interval:   cfg.Interval,
+			Retain:     cfg.Retain,
+			Target:     target.String(),
+			Compressed: cfg.Compress,
+			Encrypted:  cfg.EncryptionKeyFile != "",
+		},
+	}
+
+	if cfg.EncryptionKeyFile != "" {
+		if result.key, err = LoadKey(cfg.EncryptionKeyFile); err != nil {
+			return nil, err
+		}
+	}
+
+	if cfg.WorkDir != "" {
+		if err = os.MkdirAll(cfg.WorkDir, 0700); err != nil {
+			return nil, errors.Wrapf(err, "unable to create backup work dir '%s'", cfg.WorkDir)
+		}
+	}
+
+	return result, nil
+}
+
+// Start begins taking scheduled backups
+func (self *Manager) Start() {
+	go self.run()
+}
+
+func (self *Manager) run() {
+	log := pfxlog.Logger().WithField("target", self.target.String())
+	log.WithField("interval", self.config.Interval).WithField("retain", self.config.Retain).Info("scheduled database backups enabled")
+
+	ticker := time.NewTicker(scheduleCheckEvery)
+	defer ticker.Stop()
+
+	var nextRun time.Time
+	for {
+		if !self.env.GetCommandDispatcher().IsLeader() {
+			// a new leader picks up the schedule from the backups in the target
+			nextRun = time.Time{}
+			self.setNextScheduled(nil)
+		} else {
+			if nextRun.IsZero() {
+				nextRun = self.getNextScheduledFromTarget()
+				self.setNextScheduled(&nextRun)
+			}
+
+			if !self.now().Before(nextRun) {
+				if _, err := self.BackupNow(); err != nil && !errors.Is(err, ErrBackupInProgress) {
+					nextRun = self.now().Add(min(self.config.Interval, maxRetryDelay))
+				} else {
+					nextRun = self.now().Add(self.config.Interval)
+				}
+				self.setNextScheduled(&nextRun)
+			}
+		}
+
+		select {
+		case <-ticker.C:
+		case <-self.env.GetCloseNotify():
+			return
+		}
+	}
+}
+
+// getNextScheduledFromTarget schedules the next backup one interval after the most recent backup in the target,
+// so restarts and leadership changes don't reset the schedule
+func (self *Manager) getNextScheduledFromTarget() time.Time {
+	backups, err := self.ListBackups()
+	if err != nil {
+		pfxlog.Logger().WithError(err).WithField("target", self.target.String()).Error("unable to list backups, backing up now")
+		return self.now()
+	}
+	if len(backups) == 0 {
+		return self.now()
+	}
+	return backups[0].Modified.Add(self.config.Interval)
+}
+
+// BackupNow takes a backup and writes it to the target, then removes backups beyond the retention limit
+func (self *Manager) BackupNow() (*Result, error) {
+	if !self.running.CompareAndSwap(false, true) {
+		return nil, ErrBackupInProgress
+	}
+	defer self.running.Store(false)
+
+	start := self.now()
+	self.lock.Lock()
+	self.status.InProgress = true
+	self.status.LastAttempt = &start
+	self.lock.Unlock()
+
+	log := pfxlog.Logger().WithField("target", self.target.String())
+	result, err := self.backup(start)
+
+	evt := &event.BackupEvent{
+		Namespace:  event.BackupEventNS,
+		EventSrcId: self.env.GetId().Token,
+		Timestamp:  self.now(),
+		Target:     self.target.String(),
+		DurationMs: self.now().Sub(start).Milliseconds(),
+	}
+	if result != nil {
+		evt.Name = result.Name
+		evt.RaftIndex = result.RaftIndex
+	}
+
+	self.lock.Lock()
+	self.status.InProgress = false
+	if err != nil {
+		self.status.LastError = err.Error()
+		evt.EventType = event.BackupFailed
+		evt.Error = err.Error()
+		log.WithError(err).WithField("name", evt.Name).Error("database backup failed")
+	} else {
+		finished := self.now()
+		self.status.LastSuccess = &finished
+		self.status.LastBackup = result.Name
+		self.status.LastError = ""
+		evt.EventType = event.BackupCompleted
+		evt.Size = result.Size
+		evt.Removed = result.Removed
+		log.WithField("name", result.Name).WithField("size", result.Size).
+			WithField("duration", result.Duration).Info("database backup completed")
+	}
+	self.lock.Unlock()
+
+	if dispatcher := self.env.GetEventDispatcher(); dispatcher != nil {
+		dispatcher.AcceptBackupEvent(evt)
+	}
+
+	return result, err
+}
+
+func (self *Manager) backup(start time.Time) (*Result, error) {
+	tmpDir, err := os.MkdirTemp(self.config.WorkDir, "ziti-backup-")
+	if err != nil {
+		return nil, errors.Wrap(err, "unable to create backup work dir")
+	}
+	defer func() {
+		if err := os.RemoveAll(tmpDir); err != nil {
+			pfxlog.Logger().WithError(err).WithField("path", tmpDir).Error("unable to remove backup work dir")
+		}
+	}()
+
+	result := &Result{}
+	snapshotPath := filepath.Join(tmpDir, "snapshot.db")
+
+	err = self.env.GetDb().View(func(tx *bbolt.Tx) error {
+		result.RaftIndex = db.LoadCurrentRaftIndex(tx)
+		_, _, err := self.env.GetDb().SnapshotInTx(tx, snapshotPath)
+		return err
+	})
+	if err != nil {
+		return nil, errors.Wrap(err, "unable to snapshot database")
+	}
+
+	result.Name = fmt.Sprintf("%s-%s-%d.db", self.config.Prefix, start.UTC().Format(backupTimeFormat), result.RaftIndex)
+	if self.config.Compress {
+		result.Name += ".gz"
+	}
+	if self.key != nil {
+		result.Name += ".enc"
+	}
+
+	artifactPath := filepath.Join(tmpDir, result.Name)
+	if err = self.writeArtifact(snapshotPath, artifactPath); err != nil {
+		return result, err
+	}
+
+	artifact, err := os.Open(artifactPath)
+	if err != nil {
+		return result, err
+	}
+	defer func() { _ = artifact.Close() }()
+
+	info, err := artifact.Stat()
+	if err != nil {
+		return result, err
+	}
+	result.Size = info.Size()
+
+	if err = self.target.Put(result.Name, artifact, result.Size); err != nil {
+		return result, errors.Wrapf(err, "unable to write backup to %s", self.target.String())
+	}
+	result.Duration = self.now().Sub(start)
+
+	result.Removed = self.prune()
+	return result, nil
+}
+
+// writeArtifact compresses and encrypts the snapshot, as configured
+func (self *Manager) writeArtifact(snapshotPath, artifactPath string) error {
+	snapshot, err := os.Open(snapshotPath)
+	if err != nil {
+		return err
+	}
+	defer func() { _ = snapshot.Close() }()
+
+	artifact, err := os.OpenFile(artifactPath, os.O_CREATE|os.O_TRUNC|os.O_WRONLY, 0600)
+	if err != nil {
+		return err
+	}
+
+	var closers []io.Closer
+	var w io.Writer = artifact
+
+	if self.key != nil {
+		encWriter, err := NewEncryptingWriter(self.key, w)
+		if err != nil {
+			_ = artifact.Close()
+			return err
+		}
+		closers = append(closers, encWriter)
+		w = encWriter
+	}
+
+	if self.config.Compress {
+		gzWriter := gzip.NewWriter(w)
+		closers = append(closers, gzWriter)
+		w = gzWriter
+	}
+
+	_, err = io.Copy(w, snapshot)
+
+	// close from the outermost writer in, so each flushes into the next
+	for i := len(closers) - 1; i >= 0; i-- {
+		if closeErr := closers[i].Close(); err == nil {
+			err = closeErr
+		}
+	}
+	if closeErr := artifact.Close(); err == nil {
+		err = closeErr
+	}
+
+	if err != nil {
+		return errors.Wrap(err, "unable to write backup file")
+	}
+	return nil
+}
+
+// NewArtifactReader returns a reader for the database in a backup, decrypting and decompressing it as indicated
+// by the suffixes of the backup name. The key is only required for encrypted backups.
+func NewArtifactReader(name string, r io.Reader, key []byte) (io.Reader, error) {
+	if strings.HasSuffix(name, ".enc") {
+		if key == nil {
+			return nil, errors.Errorf("backup '%s' is encrypted, but no key was provided", name)
+		}
+		decReader, err := NewDecryptingReader(key, r)
+		if err != nil {
+			return nil, err
+		}
+		r = decReader
+		name = strings.TrimSuffix(name, ".enc")
+	}
+
+	if strings.HasSuffix(name, ".gz") {
+		gzReader, err := gzip.NewReader(r)
+		if err != nil {
+			return nil, errors.Wrap(err, "unable to read compressed backup")
+		}
+		r = gzReader
+	}
+
+	return r, nil
+}
+
+// prune removes the oldest backups beyond the retention limit. Failures are logged, but don't fail the backup.
+func (self *Manager) prune() []string {
+	log := pfxlog.Logger().WithField("target", self.target.String())
+
+	backups, err := self.ListBackups()
+	if err != nil {
+		log.WithError(err).Error("unable to list backups to enforce retention")
+		return nil
+	}
+
+	var removed []string
+	for i := self.config.Retain; i < len(backups); i++ {
+		if err = self.target.Delete(backups[i].Name); err != nil {
+			log.WithError(err).WithField("name", backups[i].Name).Error("unable to remove old backup")
+			continue
+		}
+		log.WithField("name", backups[i].Name).Info("removed old backup")
+		removed = append(removed, backups[i].Name)
+	}
+	return removed
+}
+
+// ListBackups returns the backups in the target, newest first. Other files in the target are ignored. The modified
+// time of each backup is the time the backup was taken.
+func (self *Manager) ListBackups() ([]*Object, error) {
+	objects, err := self.target.List()
+	if err != nil {
+		return nil, err
+	}
+
+	var result []*Object
+	for _, object := range objects {
+		match := self.pattern.FindStringSubmatch(object.Name)
+		if match == nil {
+			continue
+		}
+		if t, err := time.ParseInLocation(backupTimeFormat, match[1], time.UTC); err == nil {
+			object.Modified = t
+		}
+		result = append(result, object)
+	}
+
+	sort.SliceStable(result, func(i, j int) bool {
+		return result[i].Modified.After(result[j].Modified)
+	})
+	return result, nil
+}
+
+// GetStatus returns the backup schedule and the outcome of the most recent backup taken by this controller
+func (self *Manager) GetStatus() *Status {
+	self.lock.Lock()
+	defer self.lock.Unlock()
+	result := self.status
+	return &result
+}
+
+func (self *Manager) setNextScheduled(t *time.Time) {
+	self.lock.Lock()
+	defer self.lock.Unlock()
+	if t == nil {
+		self.status.NextScheduled = nil
+	} else {
+		next := *t
+		self.status.NextScheduled = &next
+	}
+}
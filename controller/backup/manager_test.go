@@ -0,0 +1,217 @@
+/*
+	Copyright NetFoundry Inc.
+
+	Licensed under the Apache License, Version 2.0 (the "License");
+	you may not use this file except in compliance with the License.
+	You may obtain a copy of the License at
+
+	https://www.apache.org/licenses/LICENSE-2.0
+
+	Unless required by applicable law or agreed to in writing, software
+	distributed under the License is distributed on an "AS IS" BASIS,
+	WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+	See the License for the specific language governing permissions and
+	limitations under the License.
+*/
+
+package backup
+
+import (
+	"encoding/hex"
+	"io"
+	"os"
+	"path/filepath"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/openziti/identity"
+	"github.com/openziti/storage/boltz"
+	"github.com/openziti/ziti/controller/command"
+	"github.com/openziti/ziti/controller/config"
+	"github.com/openziti/ziti/controller/db"
+	"github.com/openziti/ziti/controller/event"
+	"github.com/stretchr/testify/require"
+	"go.etcd.io/bbolt"
+)
+
+type testEventDispatcher struct {
+	event.DispatcherMock
+	lock   sync.Mutex
+	events []*event.BackupEvent
+}
+
+func (self *testEventDispatcher) AcceptBackupEvent(evt *event.BackupEvent) {
+	self.lock.Lock()
+	defer self.lock.Unlock()
+	self.events = append(self.events, evt)
+}
+
+type testEnv struct {
+	db         boltz.Db
+	dispatcher *testEventDispatcher
+	closeC     chan struct{}
+}
+
+func (self *testEnv) GetId() *identity.TokenId {
+	return &identity.TokenId{Token: "ctrl1"}
+}
+
+func (self *testEnv) GetDb() boltz.Db {
+	return self.db
+}
+
+func (self *testEnv) GetCommandDispatcher() command.Dispatcher {
+	return &command.LocalDispatcher{}
+}
+
+func (self *testEnv) GetEventDispatcher() event.Dispatcher {
+	return self.dispatcher
+}
+
+func (self *testEnv) GetCloseNotify() <-chan struct{} {
+	return self.closeC
+}
+
+func newTestEnv(t *testing.T) *testEnv {
+	dbFile := filepath.Join(t.TempDir(), "ctrl.db")
+	ctrlDb, err := db.Open(dbFile)
+	require.NoError(t, err)
+	t.Cleanup(func() { _ = ctrlDb.Close() })
+
+	err = ctrlDb.Update(nil, func(ctx boltz.MutateContext) error {
+		bucket, err := ctx.Tx().CreateBucketIfNotExists([]byte("test"))
+		if err != nil {
+			return err
+		}
+		return bucket.Put([]byte("key"), []byte("value"))
+	})
+	require.NoError(t, err)
+
+	return &testEnv{
+		db:         ctrlDb,
+		dispatcher: &testEventDispatcher{},
+		closeC:     make(chan struct{}),
+	}
+}
+
+func TestManagerBackupAndRetention(t *testing.T) {
+	req := require.New(t)
+	env := newTestEnv(t)
+
+	key := newTestKey(t)
+	keyFile := filepath.Join(t.TempDir(), "backup.key")
+	req.NoError(os.WriteFile(keyFile, []byte(hex.EncodeToString(key)), 0600))
+
+	targetDir := filepath.Join(t.TempDir(), "backups")
+	req.NoError(os.MkdirAll(targetDir, 0700))
+	unrelated := filepath.Join(targetDir, "notes.txt")
+	req.NoError(os.WriteFile(unrelated, []byte("keep me"), 0600))
+
+	manager, err := NewManager(env, &config.BackupConfig{
+		Enabled:           true,
+		Interval:          time.Hour,
+		Retain:            2,
+		Compress:          true,
+		EncryptionKeyFile: keyFile,
+		Prefix:            config.DefaultBackupPrefix,
+		Target:            map[interface{}]interface{}{"type": "local", "path": targetDir},
+	})
+	req.NoError(err)
+
+	now := time.Date(2024, 3, 1, 12, 0, 0, 0, time.UTC)
+	manager.now = func() time.Time { return now }
+
+	var names []string
+	for i := 0; i < 3; i++ {
+		result, err := manager.BackupNow()
+		req.NoError(err)
+		req.Regexp(`^ziti-ctrl-20240301-1[234]0000-\d+\.db\.gz\.enc$`, result.Name)
+		names = append(names, result.Name)
+		now = now.Add(time.Hour)
+	}
+
+	backups, err := manager.ListBackups()
+	req.NoError(err)
+	req.Len(backups, 2)
+	req.Equal(names[2], backups[0].Name)
+	req.Equal(names[1], backups[1].Name)
+	req.Equal(time.Date(2024, 3, 1, 14, 0, 0, 0, time.UTC), backups[0].Modified)
+	req.FileExists(unrelated)
+
+	req.Len(env.dispatcher.events, 3)
+	req.Equal(event.BackupCompleted, env.dispatcher.events[2].EventType)
+	req.Equal("ctrl1", env.dispatcher.events[2].EventSrcId)
+	req.Equal([]string{names[0]}, env.dispatcher.events[2].Removed)
+
+	status := manager.GetStatus()
+	req.Equal(names[2], status.LastBackup)
+	req.Empty(status.LastError)
+	req.False(status.InProgress)
+	req.True(status.Encrypted)
+	req.True(status.Compressed)
+
+	// the schedule continues one interval after the newest backup
+	req.Equal(backups[0].Modified.Add(time.Hour), manager.getNextScheduledFromTarget())
+
+	// the newest backup restores to a usable database
+	artifact, err := os.Open(filepath.Join(targetDir, names[2]))
+	req.NoError(err)
+	defer func() { _ = artifact.Close() }()
+
+	reader, err := NewArtifactReader(names[2], artifact, key)
+	req.NoError(err)
+
+	restored := filepath.Join(t.TempDir(), "restored.db")
+	restoredFile, err := os.Create(restored)
+	req.NoError(err)
+	_, err = io.Copy(restoredFile, reader)
+	req.NoError(err)
+	req.NoError(restoredFile.Close())
+
+	restoredDb, err := bbolt.Open(restored, 0600, &bbolt.Options{ReadOnly: true})
+	req.NoError(err)
+	defer func() { _ = restoredDb.Close() }()
+
+	err = restoredDb.View(func(tx *bbolt.Tx) error {
+		bucket := tx.Bucket([]byte("test"))
+		req.NotNil(bucket)
+		req.Equal([]byte("value"), bucket.Get([]byte("key")))
+		return nil
+	})
+	req.NoError(err)
+
+	_, err = NewArtifactReader(names[2], artifact, nil)
+	req.ErrorContains(err, "no key was provided")
+}
+
+func TestManagerReportsFailures(t *testing.T) {
+	req := require.New(t)
+	env := newTestEnv(t)
+
+	targetDir := filepath.Join(t.TempDir(), "backups")
+	manager, err := NewManager(env, &config.BackupConfig{
+		Enabled:  true,
+		Interval: time.Hour,
+		Retain:   2,
+		Prefix:   config.DefaultBackupPrefix,
+		Target:   map[interface{}]interface{}{"type": "local", "path": targetDir},
+	})
+	req.NoError(err)
+
+	// replace the target directory with a file, so writing the backup fails
+	req.NoError(os.RemoveAll(targetDir))
+	req.NoError(os.WriteFile(targetDir, []byte("not a dir"), 0600))
+
+	_, err = manager.BackupNow()
+	req.Error(err)
+
+	req.Len(env.dispatcher.events, 1)
+	req.Equal(event.BackupFailed, env.dispatcher.events[0].EventType)
+	req.NotEmpty(env.dispatcher.events[0].Error)
+
+	status := manager.GetStatus()
+	req.NotEmpty(status.LastError)
+	req.Nil(status.LastSuccess)
+	req.NotNil(status.LastAttempt)
+}
@@ -0,0 +1,357 @@
+/*
+	Copyright NetFoundry Inc.
+
+	Licensed under the Apache License, Version 2.0 (the "License");
+	you may not use this file except in compliance with the License.
+	You may obtain a copy of the License at
+
+	https://www.apache.org/licenses/LICENSE-2.0
+
+	Unless required by applicable law or agreed to in writing, software
+	distributed under the License is distributed on an "AS IS" BASIS,
+	WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+	See the License for the specific language governing permissions and
+	limitations under the License.
+*/
+
+package backup
+
+import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/xml"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"os"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/pkg/errors"
+)
+
+const (
+	s3DefaultRegion   = "us-east-1"
+	s3Algorithm       = "AWS4-HMAC-SHA256"
+	s3EmptyBodyHash   = "e3b0c44298fc1c149afbf4c8996fb92427ae41e4649b934ca495991b7852b855"
+	s3AmzDateFormat   = "20060102T150405Z"
+	s3ShortDateFormat = "20060102"
+	s3MaxErrorBody    = 4096
+)
+
+// s3Target writes backups to a bucket on S3 or any service exposing an S3 compatible API. Requests are signed with
+// AWS signature version 4.
+type s3Target struct {
+	endpoint        *url.URL
+	region          string
+	bucket          string
+	prefix          string
+	pathStyle       bool
+	accessKeyId     string
+	secretAccessKey string
+	sessionToken    string
+	client          *http.Client
+	now             func() time.Time
+}
+
+func newS3Target(config map[interface{}]interface{}) (Target, error) {
+	result := &s3Target{
+		region:          getString(config, "region"),
+		bucket:          getString(config, "bucket"),
+		prefix:          getString(config, "prefix"),
+		accessKeyId:     getString(config, "accessKeyId"),
+		secretAccessKey: getString(config, "secretAccessKey"),
+		sessionToken:    getString(config, "sessionToken"),
+		client:          &http.Client{Timeout: 30 * time.Minute},
+		now:             time.Now,
+	}
+
+	if result.bucket == "" {
+		return nil, errors.New("backup.target.bucket is required for s3 backup targets")
+	}
+
+	if result.region == "" {
+		result.region = s3DefaultRegion
+	}
+
+	endpoint := getString(config, "endpoint")
+	result.pathStyle = endpoint != ""
+	if endpoint == "" {
+		endpoint = fmt.Sprintf("https://s3.%s.amazonaws.com", result.region)
+	}
+	if _, found := config["pathStyle"]; found {
+		result.pathStyle = getBool(config, "pathStyle")
+	}
+
+	var err error
+	if result.endpoint, err = url.Parse(endpoint); err != nil {
+		return nil, errors.Wrapf(err, "invalid backup.target.endpoint '%s'", endpoint)
+	}
+	if result.endpoint.Scheme != "http" && result.endpoint.Scheme != "https" {
+		return nil, errors.Errorf("invalid backup.target.endpoint '%s', must be an http or https url", endpoint)
+	}
+
+	if result.accessKeyId == "" {
+		result.accessKeyId = os.Getenv("AWS_ACCESS_KEY_ID")
+	}
+	if result.secretAccessKey == "" {
+		result.secretAccessKey = os.Getenv("AWS_SECRET_ACCESS_KEY")
+	}
+	if result.sessionToken == "" {
+		result.sessionToken = os.Getenv("AWS_SESSION_TOKEN")
+	}
+	if result.accessKeyId == "" || result.secretAccessKey == "" {
+		return nil, errors.New("s3 backup targets require backup.target.accessKeyId and backup.target.secretAccessKey, " +
+			"or the AWS_ACCESS_KEY_ID and AWS_SECRET_ACCESS_KEY environment variables")
+	}
+
+	return result, nil
+}
+
+func (self *s3Target) Put(name string, content io.ReadSeeker, size int64) error {
+	if _, err := content.Seek(0, io.SeekStart); err != nil {
+		return err
+	}
+	hash := sha256.New()
+	if _, err := io.Copy(hash, content); err != nil {
+		return err
+	}
+	if _, err := content.Seek(0, io.SeekStart); err != nil {
+		return err
+	}
+
+	req, err := self.newRequest(http.MethodPut, self.prefix+name, nil, io.NopCloser(content))
+	if err != nil {
+		return err
+	}
+	req.ContentLength = size
+	req.Header.Set("Content-Type", "application/octet-stream")
+
+	_, err = self.do(req, hex.EncodeToString(hash.Sum(nil)))
+	return err
+}
+
+type s3ListBucketResult struct {
+	Contents []struct {
+		Key          string    `xml:"Key"`
+		Size         int64     `xml:"Size"`
+		LastModified time.Time `xml:"LastModified"`
+	} `xml:"Contents"`
+	IsTruncated           bool   `xml:"IsTruncated"`
+	NextContinuationToken string `xml:"NextContinuationToken"`
+}
+
+func (self *s3Target) List() ([]*Object, error) {
+	var result []*Object
+	continuationToken := ""
+
+	for {
+		query := url.Values{}
+		query.Set("list-type", "2")
+		query.Set("prefix", self.prefix)
+		if continuationToken != "" {
+			query.Set("continuation-token", continuationToken)
+		}
+
+		req, err := self.newRequest(http.MethodGet, "", query, nil)
+		if err != nil {
+			return nil, err
+		}
+
+		body, err := self.do(req, s3EmptyBodyHash)
+		if err != nil {
+			return nil, err
+		}
+
+		listResult := &s3ListBucketResult{}
+		if err = xml.Unmarshal(body, listResult); err != nil {
+			return nil, errors.Wrap(err, "unable to parse s3 list objects response")
+		}
+
+		for _, content := range listResult.Contents {
+			name := strings.TrimPrefix(content.Key, self.prefix)
+			if name == "" || strings.Contains(name, "/") {
+				continue
+			}
+			result = append(result, &Object{
+				Name:     name,
+				Size:     content.Size,
+				Modified: content.LastModified,
+			})
+		}
+
+		if !listResult.IsTruncated || listResult.NextContinuationToken == "" {
+			return result, nil
+		}
+		continuationToken = listResult.NextContinuationToken
+	}
+}
+
+func (self *s3Target) Delete(name string) error {
+	req, err := self.newRequest(http.MethodDelete, self.prefix+name, nil, nil)
+	if err != nil {
+		return err
+	}
+	_, err = self.do(req, s3EmptyBodyHash)
+	return err
+}
+
+func (self *s3Target) String() string {
+	if self.pathStyle {
+		return fmt.Sprintf("s3:%s/%s/%s", strings.TrimSuffix(self.endpoint.String(), "/"), self.bucket, self.prefix)
+	}
+	return fmt.Sprintf("s3:%s://%s.%s/%s", self.endpoint.Scheme, self.bucket, self.endpoint.Host, self.prefix)
+}
+
+func (self *s3Target) newRequest(method string, key string, query url.Values, body io.ReadCloser) (*http.Request, error) {
+	u := *self.endpoint
+	basePath := strings.TrimSuffix(u.Path, "/")
+	if self.pathStyle {
+		u.Path = basePath + "/" + self.bucket + "/" + key
+	} else {
+		u.Host = self.bucket + "." + u.Host
+		u.Path = basePath + "/" + key
+	}
+	u.RawPath = s3UriEncode(u.Path, false)
+	u.RawQuery = s3CanonicalQuery(query)
+
+	return http.NewRequest(method, u.String(), body)
+}
+
+func (self *s3Target) do(req *http.Request, payloadHash string) ([]byte, error) {
+	s3SignV4(req, payloadHash, self.accessKeyId, self.secretAccessKey, self.sessionToken, self.region, self.now())
+
+	resp, err := self.client.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer func() { _ = resp.Body.Close() }()
+
+	if resp.StatusCode < 200 || resp.StatusCode > 299 {
+		body, _ := io.ReadAll(io.LimitReader(resp.Body, s3MaxErrorBody))
+		s3Err := &struct {
+			Code    string `xml:"Code"`
+			Message string `xml:"Message"`
+		}{}
+		if xml.Unmarshal(body, s3Err) == nil && s3Err.Code != "" {
+			return nil, errors.Errorf("s3 %s %s failed with status %d: %s: %s", req.Method, req.URL.Path, resp.StatusCode, s3Err.Code, s3Err.Message)
+		}
+		return nil, errors.Errorf("s3 %s %s failed with status %d", req.Method, req.URL.Path, resp.StatusCode)
+	}
+
+	return io.ReadAll(resp.Body)
+}
+
+// s3SignV4 adds the AWS signature version 4 headers to the request. The request URL must already be in canonical
+// form, as produced by newRequest.
+func s3SignV4(req *http.Request, payloadHash, accessKeyId, secretAccessKey, sessionToken, region string, now time.Time) {
+	now = now.UTC()
+	amzDate := now.Format(s3AmzDateFormat)
+	shortDate := now.Format(s3ShortDateFormat)
+
+	req.Header.Set("X-Amz-Date", amzDate)
+	req.Header.Set("X-Amz-Content-Sha256", payloadHash)
+	if sessionToken != "" {
+		req.Header.Set("X-Amz-Security-Token", sessionToken)
+	}
+
+	headers := map[string]string{"host": req.URL.Host}
+	for name, values := range req.Header {
+		lowerName := strings.ToLower(name)
+		if strings.HasPrefix(lowerName, "x-amz-") || lowerName == "range" || lowerName == "content-type" || lowerName == "content-md5" {
+			headers[lowerName] = strings.Join(strings.Fields(strings.Join(values, ",")), " ")
+		}
+	}
+
+	var headerNames []string
+	for name := range headers {
+		headerNames = append(headerNames, name)
+	}
+	sort.Strings(headerNames)
+
+	canonicalHeaders := &strings.Builder{}
+	for _, name := range headerNames {
+		canonicalHeaders.WriteString(name)
+		canonicalHeaders.WriteByte(':')
+		canonicalHeaders.WriteString(headers[name])
+		canonicalHeaders.WriteByte('\n')
+	}
+	signedHeaders := strings.Join(headerNames, ";")
+
+	canonicalUri := req.URL.EscapedPath()
+	if canonicalUri == "" {
+		canonicalUri = "/"
+	}
+
+	canonicalRequest := strings.Join([]string{
+		req.Method,
+		canonicalUri,
+		req.URL.RawQuery,
+		canonicalHeaders.String(),
+		signedHeaders,
+		payloadHash,
+	}, "\n")
+
+	scope := shortDate + "/" + region + "/s3/aws4_request"
+	canonicalRequestHash := sha256.Sum256([]byte(canonicalRequest))
+	stringToSign := strings.Join([]string{
+		s3Algorithm,
+		amzDate,
+		scope,
+		hex.EncodeToString(canonicalRequestHash[:]),
+	}, "\n")
+
+	signingKey := s3Hmac([]byte("AWS4"+secretAccessKey), shortDate)
+	signingKey = s3Hmac(signingKey, region)
+	signingKey = s3Hmac(signingKey, "s3")
+	signingKey = s3Hmac(signingKey, "aws4_request")
+	signature := hex.EncodeToString(s3Hmac(signingKey, stringToSign))
+
+	req.Header.Set("Authorization", fmt.Sprintf("%s Credential=%s/%s, SignedHeaders=%s, Signature=%s",
+		s3Algorithm, accessKeyId, scope, signedHeaders, signature))
+}
+
+func s3Hmac(key []byte, data string) []byte {
+	mac := hmac.New(sha256.New, key)
+	mac.Write([]byte(data))
+	return mac.Sum(nil)
+}
+
+func s3CanonicalQuery(query url.Values) string {
+	if len(query) == 0 {
+		return ""
+	}
+
+	var keys []string
+	for key := range query {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+
+	var parts []string
+	for _, key := range keys {
+		values := append([]string(nil), query[key]...)
+		sort.Strings(values)
+		for _, value := range values {
+			parts = append(parts, s3UriEncode(key, true)+"="+s3UriEncode(value, true))
+		}
+	}
+	return strings.Join(parts, "&")
+}
+
+// s3UriEncode encodes everything except the RFC 3986 unreserved characters, as required by signature version 4
+func s3UriEncode(s string, encodeSlash bool) string {
+	result := &strings.Builder{}
+	for _, b := range []byte(s) {
+		if (b >= 'A' && b <= 'Z') || (b >= 'a' && b <= 'z') || (b >= '0' && b <= '9') ||
+			b == '-' || b == '_' || b == '.' || b == '~' || (b == '/' && !encodeSlash) {
+			result.WriteByte(b)
+		} else {
+			_, _ = fmt.Fprintf(result, "%%%02X", b)
+		}
+	}
+	return result.String()
+}
@@ -0,0 +1,489 @@
+/*
+	Copyright NetFoundry Inc.
+
+	Licensed under the Apache License, Version 2.0 (the "License");
+	you may not use this file except in compliance with the License.
+	You may obtain a copy of the License at
+
+	https://www.apache.org/licenses/LICENSE-2.0
+
+	Unless required by applicable law or agreed to in writing, software
+	distributed under the License is distributed on an "AS IS" BASIS,
+	WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+	See the License for the specific language governing permissions and
+	limitations under the License.
+*/
+
+package backup
+
+import (
+	"encoding/binary"
+	"fmt"
+	"io"
+	"net"
+	"os"
+	"path"
+	"strings"
+	"time"
+
+	"github.com/pkg/errors"
+	"golang.org/x/crypto/ssh"
+)
+
+// SFTP protocol version 3 packet types and flags, see draft-ietf-secsh-filexfer-02
+const (
+	sftpFxpInit     = 1
+	sftpFxpVersion  = 2
+	sftpFxpOpen     = 3
+	sftpFxpClose    = 4
+	sftpFxpWrite    = 6
+	sftpFxpOpenDir  = 11
+	sftpFxpReadDir  = 12
+	sftpFxpRemove   = 13
+	sftpFxpRename   = 18
+	sftpFxpStatus   = 101
+	sftpFxpHandle   = 102
+	sftpFxpName     = 104
+	sftpProtocolV3  = 3
+	sftpStatusOk    = 0
+	sftpStatusEof   = 1
+	sftpOpenWrite   = 0x02
+	sftpOpenCreate  = 0x08
+	sftpOpenTrunc   = 0x10
+	sftpAttrSize    = 0x01
+	sftpAttrUidGid  = 0x02
+	sftpAttrPerms   = 0x04
+	sftpAttrTimes   = 0x08
+	sftpAttrExtend  = 0x80000000
+	sftpModeTypeDir = 0040000
+	sftpModeTypeMsk = 0170000
+
+	sftpWriteChunkSize = 32 * 1024
+	sftpMaxPacketSize  = 256 * 1024
+	sftpDefaultPort    = "22"
+)
+
+// sftpTarget writes backups to a directory on an SFTP server. A new SSH connection is made for each operation, since
+// backups are infrequent.
+type sftpTarget struct {
+	address   string
+	path      string
+	sshConfig *ssh.ClientConfig
+}
+
+func newSftpTarget(config map[interface{}]interface{}) (Target, error) {
+	result := &sftpTarget{
+		address: getString(config, "address"),
+		path:    getString(config, "path"),
+	}
+
+	if result.address == "" {
+		return nil, errors.New("backup.target.address is required for sftp backup targets")
+	}
+	if _, _, err := net.SplitHostPort(result.address); err != nil {
+		result.address = net.JoinHostPort(result.address, sftpDefaultPort)
+	}
+
+	if result.path == "" {
+		return nil, errors.New("backup.target.path is required for sftp backup targets")
+	}
+
+	username := getString(config, "username")
+	if username == "" {
+		return nil, errors.New("backup.target.username is required for sftp backup targets")
+	}
+
+	var authMethods []ssh.AuthMethod
+	if keyFile := getString(config, "privateKeyFile"); keyFile != "" {
+		keyPem, err := os.ReadFile(keyFile)
+		if err != nil {
+			return nil, errors.Wrapf(err, "unable to read backup.target.privateKeyFile '%s'", keyFile)
+		}
+		signer, err := ssh.ParsePrivateKey(keyPem)
+		if err != nil {
+			return nil, errors.Wrapf(err, "unable to parse backup.target.privateKeyFile '%s'", keyFile)
+		}
+		authMethods = append(authMethods, ssh.PublicKeys(signer))
+	}
+	if password := getString(config, "password"); password != "" {
+		authMethods = append(authMethods, ssh.Password(password))
+	}
+	if len(authMethods) == 0 {
+		return nil, errors.New("sftp backup targets require backup.target.privateKeyFile or backup.target.password")
+	}
+
+	var hostKeyCallback ssh.HostKeyCallback
+	if hostKey := getString(config, "hostKey"); hostKey != "" {
+		publicKey, _, _, _, err := ssh.ParseAuthorizedKey([]byte(hostKey))
+		if err != nil {
+			return nil, errors.Wrapf(err, "unable to parse backup.target.hostKey")
+		}
+		hostKeyCallback = ssh.FixedHostKey(publicKey)
+	} else if getBool(config, "insecureIgnoreHostKey") {
+		hostKeyCallback = ssh.InsecureIgnoreHostKey()
+	} else {
+		return nil, errors.New("sftp backup targets require backup.target.hostKey, or backup.target.insecureIgnoreHostKey set to true")
+	}
+
+	result.sshConfig = &ssh.ClientConfig{
+		User:            username,
+		Auth:            authMethods,
+		HostKeyCallback: hostKeyCallback,
+		Timeout:         30 * time.Second,
+	}
+
+	return result, nil
+}
+
+func (self *sftpTarget) Put(name string, content io.ReadSeeker, _ int64) error {
+	if _, err := content.Seek(0, io.SeekStart); err != nil {
+		return err
+	}
+
+	return self.withClient(func(client *sftpClient) error {
+		// write to a temporary name first, so a partial backup is never mistaken for a complete one
+		tmpPath := path.Join(self.path, "."+name+".tmp")
+		if err := client.writeFile(tmpPath, content); err != nil {
+			_ = client.remove(tmpPath)
+			return err
+		}
+		return client.rename(tmpPath, path.Join(self.path, name))
+	})
+}
+
+func (self *sftpTarget) List() ([]*Object, error) {
+	var result []*Object
+	err := self.withClient(func(client *sftpClient) error {
+		var err error
+		result, err = client.readDir(self.path)
+		return err
+	})
+	return result, err
+}
+
+func (self *sftpTarget) Delete(name string) error {
+	return self.withClient(func(client *sftpClient) error {
+		return client.remove(path.Join(self.path, name))
+	})
+}
+
+func (self *sftpTarget) String() string {
+	return fmt.Sprintf("sftp:%s@%s%s", self.sshConfig.User, self.address, self.path)
+}
+
+func (self *sftpTarget) withClient(f func(client *sftpClient) error) error {
+	conn, err := ssh.Dial("tcp", self.address, self.sshConfig)
+	if err != nil {
+		return err
+	}
+	defer func() { _ = conn.Close() }()
+
+	session, err := conn.NewSession()
+	if err != nil {
+		return err
+	}
+	defer func() { _ = session.Close() }()
+
+	w, err := session.StdinPipe()
+	if err != nil {
+		return err
+	}
+	r, err := session.StdoutPipe()
+	if err != nil {
+		return err
+	}
+	if err = session.RequestSubsystem("sftp"); err != nil {
+		return err
+	}
+
+	client, err := newSftpClient(r, w)
+	if err != nil {
+		return err
+	}
+	return f(client)
+}
+
+// sftpClient implements the small subset of SFTP version 3 needed to manage backups. Requests are sent one at a time.
+type sftpClient struct {
+	r      io.Reader
+	w      io.Writer
+	nextId uint32
+}
+
+func newSftpClient(r io.Reader, w io.Writer) (*sftpClient, error) {
+	client := &sftpClient{r: r, w: w}
+
+	if err := client.sendPacket(sftpFxpInit, binary.BigEndian.AppendUint32(nil, sftpProtocolV3)); err != nil {
+		return nil, err
+	}
+
+	packetType, payload, err := client.recvPacket()
+	if err != nil {
+		return nil, err
+	}
+	if packetType != sftpFxpVersion {
+		return nil, errors.Errorf("unexpected sftp packet type %d, expected version", packetType)
+	}
+	version, _, err := sftpReadUint32(payload)
+	if err != nil {
+		return nil, err
+	}
+	if version < sftpProtocolV3 {
+		return nil, errors.Errorf("unsupported sftp protocol version %d", version)
+	}
+	return client, nil
+}
+
+func (self *sftpClient) sendPacket(packetType byte, payload []byte) error {
+	packet := binary.BigEndian.AppendUint32(nil, uint32(len(payload)+1))
+	packet = append(packet, packetType)
+	packet = append(packet, payload...)
+	_, err := self.w.Write(packet)
+	return err
+}
+
+func (self *sftpClient) recvPacket() (byte, []byte, error) {
+	header := make([]byte, 5)
+	if _, err := io.ReadFull(self.r, header); err != nil {
+		return 0, nil, err
+	}
+	length := binary.BigEndian.Uint32(header)
+	if length < 1 || length > sftpMaxPacketSize {
+		return 0, nil, errors.Errorf("invalid sftp packet length %d", length)
+	}
+	payload := make([]byte, length-1)
+	if _, err := io.ReadFull(self.r, payload); err != nil {
+		return 0, nil, err
+	}
+	return header[4], payload, nil
+}
+
+// request sends a request and returns the type and the payload, after the request id, of the response
+func (self *sftpClient) request(packetType byte, payload []byte) (byte, []byte, error) {
+	self.nextId++
+	id := self.nextId
+
+	if err := self.sendPacket(packetType, append(binary.BigEndian.AppendUint32(nil, id), payload...)); err != nil {
+		return 0, nil, err
+	}
+
+	respType, resp, err := self.recvPacket()
+	if err != nil {
+		return 0, nil, err
+	}
+	respId, resp, err := sftpReadUint32(resp)
+	if err != nil {
+		return 0, nil, err
+	}
+	if respId != id {
+		return 0, nil, errors.Errorf("unexpected sftp response id %d, expected %d", respId, id)
+	}
+	return respType, resp, nil
+}
+
+// requestStatus sends a request which is expected to be answered with a status
+func (self *sftpClient) requestStatus(op string, packetType byte, payload []byte) error {
+	respType, resp, err := self.request(packetType, payload)
+	if err != nil {
+		return err
+	}
+	return sftpCheckStatus(op, respType, resp, false)
+}
+
+func (self *sftpClient) requestHandle(op string, packetType byte, payload []byte) (string, error) {
+	respType, resp, err := self.request(packetType, payload)
+	if err != nil {
+		return "", err
+	}
+	if respType != sftpFxpHandle {
+		return "", sftpCheckStatus(op, respType, resp, false)
+	}
+	handle, _, err := sftpReadString(resp)
+	return handle, err
+}
+
+func (self *sftpClient) writeFile(filePath string, content io.Reader) error {
+	payload := sftpAppendString(nil, filePath)
+	payload = binary.BigEndian.AppendUint32(payload, sftpOpenWrite|sftpOpenCreate|sftpOpenTrunc)
+	payload = binary.BigEndian.AppendUint32(payload, 0) // no attributes
+
+	handle, err := self.requestHandle("open "+filePath, sftpFxpOpen, payload)
+	if err != nil {
+		return err
+	}
+
+	buf := make([]byte, sftpWriteChunkSize)
+	offset := uint64(0)
+	for {
+		n, readErr := io.ReadFull(content, buf)
+		if n > 0 {
+			payload = sftpAppendString(nil, handle)
+			payload = binary.BigEndian.AppendUint64(payload, offset)
+			payload = sftpAppendString(payload, string(buf[:n]))
+			if err = self.requestStatus("write "+filePath, sftpFxpWrite, payload); err != nil {
+				_ = self.close(handle)
+				return err
+			}
+			offset += uint64(n)
+		}
+		if readErr == io.EOF || errors.Is(readErr, io.ErrUnexpectedEOF) {
+			break
+		}
+		if readErr != nil {
+			_ = self.close(handle)
+			return readErr
+		}
+	}
+
+	return self.close(handle)
+}
+
+func (self *sftpClient) close(handle string) error {
+	return self.requestStatus("close", sftpFxpClose, sftpAppendString(nil, handle))
+}
+
+func (self *sftpClient) remove(filePath string) error {
+	return self.requestStatus("remove "+filePath, sftpFxpRemove, sftpAppendString(nil, filePath))
+}
+
+func (self *sftpClient) rename(oldPath, newPath string) error {
+	return self.requestStatus("rename "+oldPath, sftpFxpRename, sftpAppendString(sftpAppendString(nil, oldPath), newPath))
+}
+
+func (self *sftpClient) readDir(dirPath string) ([]*Object, error) {
+	handle, err := self.requestHandle("opendir "+dirPath, sftpFxpOpenDir, sftpAppendString(nil, dirPath))
+	if err != nil {
+		return nil, err
+	}
+	defer func() { _ = self.close(handle) }()
+
+	var result []*Object
+	for {
+		respType, resp, err := self.request(sftpFxpReadDir, sftpAppendString(nil, handle))
+		if err != nil {
+			return nil, err
+		}
+		if respType != sftpFxpName {
+			if err = sftpCheckStatus("readdir "+dirPath, respType, resp, true); err != nil {
+				return nil, err
+			}
+			return result, nil
+		}
+
+		count, resp, err := sftpReadUint32(resp)
+		if err != nil {
+			return nil, err
+		}
+		for i := uint32(0); i < count; i++ {
+			var name string
+			var attrs *sftpAttrs
+			if name, resp, err = sftpReadString(resp); err != nil {
+				return nil, err
+			}
+			if _, resp, err = sftpReadString(resp); err != nil { // long name
+				return nil, err
+			}
+			if attrs, resp, err = sftpReadAttrs(resp); err != nil {
+				return nil, err
+			}
+			if strings.HasPrefix(name, ".") || attrs.permissions&sftpModeTypeMsk == sftpModeTypeDir {
+				continue
+			}
+			result = append(result, &Object{
+				Name:     name,
+				Size:     int64(attrs.size),
+				Modified: time.Unix(int64(attrs.mtime), 0),
+			})
+		}
+	}
+}
+
+type sftpAttrs struct {
+	size        uint64
+	permissions uint32
+	mtime       uint32
+}
+
+func sftpReadAttrs(b []byte) (*sftpAttrs, []byte, error) {
+	result := &sftpAttrs{}
+	flags, b, err := sftpReadUint32(b)
+	if err != nil {
+		return nil, nil, err
+	}
+	if flags&sftpAttrSize != 0 {
+		if len(b) < 8 {
+			return nil, nil, io.ErrUnexpectedEOF
+		}
+		result.size = binary.BigEndian.Uint64(b)
+		b = b[8:]
+	}
+	if flags&sftpAttrUidGid != 0 {
+		if len(b) < 8 {
+			return nil, nil, io.ErrUnexpectedEOF
+		}
+		b = b[8:]
+	}
+	if flags&sftpAttrPerms != 0 {
+		if result.permissions, b, err = sftpReadUint32(b); err != nil {
+			return nil, nil, err
+		}
+	}
+	if flags&sftpAttrTimes != 0 {
+		if _, b, err = sftpReadUint32(b); err != nil { // atime
+			return nil, nil, err
+		}
+		if result.mtime, b, err = sftpReadUint32(b); err != nil {
+			return nil, nil, err
+		}
+	}
+	if flags&sftpAttrExtend != 0 {
+		var count uint32
+		if count, b, err = sftpReadUint32(b); err != nil {
+			return nil, nil, err
+		}
+		for i := uint32(0); i < count*2; i++ {
+			if _, b, err = sftpReadString(b); err != nil {
+				return nil, nil, err
+			}
+		}
+	}
+	return result, b, nil
+}
+
+func sftpCheckStatus(op string, respType byte, resp []byte, allowEof bool) error {
+	if respType != sftpFxpStatus {
+		return errors.Errorf("sftp %s: unexpected response type %d", op, respType)
+	}
+	code, resp, err := sftpReadUint32(resp)
+	if err != nil {
+		return err
+	}
+	if code == sftpStatusOk || (allowEof && code == sftpStatusEof) {
+		return nil
+	}
+	msg, _, _ := sftpReadString(resp)
+	return errors.Errorf("sftp %s failed with status %d: %s", op, code, msg)
+}
+
+func sftpAppendString(b []byte, s string) []byte {
+	b = binary.BigEndian.AppendUint32(b, uint32(len(s)))
+	return append(b, s...)
+}
+
+func sftpReadUint32(b []byte) (uint32, []byte, error) {
+	if len(b) < 4 {
+		return 0, nil, io.ErrUnexpectedEOF
+	}
+	return binary.BigEndian.Uint32(b), b[4:], nil
+}
+
+func sftpReadString(b []byte) (string, []byte, error) {
+	length, b, err := sftpReadUint32(b)
+	if err != nil {
+		return "", nil, err
+	}
+	if uint32(len(b)) < length {
+		return "", nil, io.ErrUnexpectedEOF
+	}
+	return string(b[:length]), b[length:], nil
+}
@@ -0,0 +1,170 @@
+/*
+	Copyright NetFoundry Inc.
+
+	Licensed under the Apache License, Version 2.0 (the "License");
+	you may not use this file except in compliance with the License.
+	You may obtain a copy of the License at
+
+	https://www.apache.org/licenses/LICENSE-2.0
+
+	Unless required by applicable law or agreed to in writing, software
+	distributed under the License is distributed on an "AS IS" BASIS,
+	WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+	See the License for the specific language governing permissions and
+	limitations under the License.
+*/
+
+package backup
+
+import (
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/pkg/errors"
+)
+
+// An Object is a backup file stored in a Target
+type Object struct {
+	Name     string
+	Size     int64
+	Modified time.Time
+}
+
+// A Target is somewhere backups are written to, such as a local directory or an S3 bucket
+type Target interface {
+	// Put stores the backup with the given name. The content is read from the start, and may be read more than once.
+	Put(name string, content io.ReadSeeker, size int64) error
+	// List returns the backups currently stored in the target
+	List() ([]*Object, error)
+	// Delete removes the backup with the given name
+	Delete(name string) error
+	// String returns a description of the target, suitable for logging
+	String() string
+}
+
+// A TargetFactory creates a Target from the target section of the backup configuration
+type TargetFactory func(config map[interface{}]interface{}) (Target, error)
+
+var targetFactories = map[string]TargetFactory{}
+var targetFactoriesLock sync.Mutex
+
+// RegisterTargetFactory makes a target type available for use in the backup configuration
+func RegisterTargetFactory(targetType string, factory TargetFactory) {
+	targetFactoriesLock.Lock()
+	defer targetFactoriesLock.Unlock()
+	targetFactories[targetType] = factory
+}
+
+func init() {
+	RegisterTargetFactory("local", newLocalTarget)
+	RegisterTargetFactory("s3", newS3Target)
+	RegisterTargetFactory("sftp", newSftpTarget)
+}
+
+// NewTarget creates a target from the target section of the backup configuration. The type field selects the
+// target factory.
+func NewTarget(config map[interface{}]interface{}) (Target, error) {
+	targetType := getString(config, "type")
+	if targetType == "" {
+		return nil, errors.New("backup.target.type is required")
+	}
+
+	targetFactoriesLock.Lock()
+	factory, found := targetFactories[targetType]
+	targetFactoriesLock.Unlock()
+
+	if !found {
+		return nil, errors.Errorf("unsupported backup target type '%s'", targetType)
+	}
+	return factory(config)
+}
+
+type localTarget struct {
+	path string
+}
+
+func newLocalTarget(config map[interface{}]interface{}) (Target, error) {
+	path := getString(config, "path")
+	if path == "" {
+		return nil, errors.New("backup.target.path is required for local backup targets")
+	}
+	if err := os.MkdirAll(path, 0700); err != nil {
+		return nil, errors.Wrapf(err, "unable to create backup directory '%s'", path)
+	}
+	return &localTarget{path: path}, nil
+}
+
+func (self *localTarget) Put(name string, content io.ReadSeeker, _ int64) error {
+	if _, err := content.Seek(0, io.SeekStart); err != nil {
+		return err
+	}
+
+	// write to a temporary name first, so a partial backup is never mistaken for a complete one
+	tmpPath := filepath.Join(self.path, "."+name+".tmp")
+	f, err := os.OpenFile(tmpPath, os.O_CREATE|os.O_TRUNC|os.O_WRONLY, 0600)
+	if err != nil {
+		return err
+	}
+
+	if _, err = io.Copy(f, content); err == nil {
+		err = f.Sync()
+	}
+	if closeErr := f.Close(); err == nil {
+		err = closeErr
+	}
+	if err == nil {
+		err = os.Rename(tmpPath, filepath.Join(self.path, name))
+	}
+	if err != nil {
+		_ = os.Remove(tmpPath)
+	}
+	return err
+}
+
+func (self *localTarget) List() ([]*Object, error) {
+	entries, err := os.ReadDir(self.path)
+	if err != nil {
+		return nil, err
+	}
+
+	var result []*Object
+	for _, entry := range entries {
+		if entry.IsDir() || strings.HasPrefix(entry.Name(), ".") {
+			continue
+		}
+		info, err := entry.Info()
+		if err != nil {
+			continue
+		}
+		result = append(result, &Object{
+			Name:     entry.Name(),
+			Size:     info.Size(),
+			Modified: info.ModTime(),
+		})
+	}
+	return result, nil
+}
+
+func (self *localTarget) Delete(name string) error {
+	return os.Remove(filepath.Join(self.path, name))
+}
+
+func (self *localTarget) String() string {
+	return "local:" + self.path
+}
+
+func getString(config map[interface{}]interface{}, key string) string {
+	if value, found := config[key]; found && value != nil {
+		return fmt.Sprintf("%v", value)
+	}
+	return ""
+}
+
+func getBool(config map[interface{}]interface{}, key string) bool {
+	return strings.EqualFold(getString(config, key), "true")
+}
@@ -81,6 +81,11 @@ const (
 	DefaultRouterDataModelLogSize            = 10_000
 	DefaultRouterDataModelListenerBufferSize = 1000
 
+	DefaultBackupInterval = 24 * time.Hour
+	DefaultBackupRetain   = 7
+	DefaultBackupPrefix   = "ziti-ctrl"
+	MinBackupInterval     = time.Minute
+
 	DefaultRaftSnapshotInterval  = 2 * time.Minute
 	DefaultRaftSnapshotThreshold = 500
 	DefaultRaftTrailingLogs      = 500
@@ -121,9 +126,33 @@ type Config struct {
 	RouterDataModel         common.RouterDataModelConfig
 	CommandRateLimiter      command.RateLimiterConfig
 	TlsHandshakeRateLimiter command.AdaptiveRateLimiterConfig
+	Audit                   AuditConfig
+	Backup                  BackupConfig
+	Alerts                  AlertsConfig
+	Probes                  ProbesConfig
+	CircuitHistory          CircuitHistoryConfig
+	Residency               ResidencyConfig
 	Src                     map[interface{}]interface{}
 }
 
+// AuditConfig controls the persisted audit log of entity changes
+type AuditConfig struct {
+	Enabled  bool
+	Excluded []string
+}
+
+// BackupConfig controls scheduled database backups
+type BackupConfig struct {
+	Enabled           bool
+	Interval          time.Duration
+	Retain            int
+	Compress          bool
+	EncryptionKeyFile string
+	Prefix            string
+	WorkDir           string
+	Target            map[interface{}]interface{}
+}
+
 func (self *Config) ToJson() (string, error) {
 	jsonMap, err := config.ToJsonCompatibleMap(self.Src)
 	if err != nil {
@@ -723,6 +752,110 @@ func LoadConfig(path string) (*Config, error) {
 		}
 	}
 
+	controllerConfig.Audit.Excluded = db.DefaultAuditExcludedEntityTypes
+
+	if value, found := cfgmap["audit"]; found {
+		if submap, ok := value.(map[interface{}]interface{}); ok {
+			if value, found := submap["enabled"]; found {
+				controllerConfig.Audit.Enabled = strings.EqualFold("true", fmt.Sprintf("%v", value))
+			}
+
+			if value, found := submap["exclude"]; found {
+				list, ok := value.([]interface{})
+				if !ok {
+					return nil, errors.Errorf("invalid value %v for audit.exclude, must be a list of entity types", value)
+				}
+				controllerConfig.Audit.Excluded = nil
+				for _, entityType := range list {
+					controllerConfig.Audit.Excluded = append(controllerConfig.Audit.Excluded, fmt.Sprintf("%v", entityType))
+				}
+			}
+		} else {
+			return nil, errors.Errorf("invalid audit configuration")
+		}
+	}
+
+	controllerConfig.Backup.Interval = DefaultBackupInterval
+	controllerConfig.Backup.Retain = DefaultBackupRetain
+	controllerConfig.Backup.Compress = true
+	controllerConfig.Backup.Prefix = DefaultBackupPrefix
+
+	if value, found := cfgmap["backup"]; found {
+		if submap, ok := value.(map[interface{}]interface{}); ok {
+			controllerConfig.Backup.Enabled = true
+
+			if value, found := submap["enabled"]; found {
+				controllerConfig.Backup.Enabled = strings.EqualFold("true", fmt.Sprintf("%v", value))
+			}
+
+			if value, found := submap["interval"]; found {
+				if val, err := time.ParseDuration(fmt.Sprintf("%v", value)); err == nil {
+					if val < MinBackupInterval {
+						return nil, errors.Errorf("invalid value %v for backup.interval, must be at least %v", value, MinBackupInterval)
+					}
+					controllerConfig.Backup.Interval = val
+				} else {
+					return nil, errors.Wrapf(err, "failed to parse backup.interval value '%v'", value)
+				}
+			}
+
+			if value, found := submap["retain"]; found {
+				if val, ok := value.(int); ok && val > 0 {
+					controllerConfig.Backup.Retain = val
+				} else {
+					return nil, errors.Errorf("invalid value %v for backup.retain, must be a positive integer", value)
+				}
+			}
+
+			if value, found := submap["compress"]; found {
+				controllerConfig.Backup.Compress = strings.EqualFold("true", fmt.Sprintf("%v", value))
+			}
+
+			if value, found := submap["encryptionKeyFile"]; found {
+				controllerConfig.Backup.EncryptionKeyFile = fmt.Sprintf("%v", value)
+			}
+
+			if value, found := submap["prefix"]; found {
+				controllerConfig.Backup.Prefix = fmt.Sprintf("%v", value)
+				if controllerConfig.Backup.Prefix == "" || strings.ContainsAny(controllerConfig.Backup.Prefix, `/\`) {
+					return nil, errors.Errorf("invalid value '%v' for backup.prefix, must be non-empty and may not contain path separators", value)
+				}
+			}
+
+			if value, found := submap["workDir"]; found {
+				controllerConfig.Backup.WorkDir = fmt.Sprintf("%v", value)
+			}
+
+			if value, found := submap["target"]; found {
+				if targetMap, ok := value.(map[interface{}]interface{}); ok {
+					controllerConfig.Backup.Target = targetMap
+				} else {
+					return nil, errors.Errorf("invalid backup.target configuration, must be a map")
+				}
+			} else if controllerConfig.Backup.Enabled {
+				return nil, errors.Errorf("backup.target is required when backups are enabled")
+			}
+		} else {
+			return nil, errors.Errorf("invalid backup configuration")
+		}
+	}
+
+	if err = controllerConfig.loadAlertsConfig(cfgmap); err != nil {
+		return nil, err
+	}
+
+	if err = controllerConfig.loadProbesConfig(cfgmap); err != nil {
+		return nil, err
+	}
+
+	if err = controllerConfig.loadCircuitHistoryConfig(cfgmap); err != nil {
+		return nil, err
+	}
+
+	if err = controllerConfig.loadResidencyConfig(cfgmap); err != nil {
+		return nil, err
+	}
+
 	edgeConfig, err := LoadEdgeConfigFromMap(cfgmap)
 	if err != nil {
 		return nil, err
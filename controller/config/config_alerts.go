@@ -0,0 +1,219 @@
+/*
+	Copyright NetFoundry Inc.
+
+	Licensed under the Apache License, Version 2.0 (the "License");
+	you may not use this file except in compliance with the License.
+	You may obtain a copy of the License at
+
+	https://www.apache.org/licenses/LICENSE-2.0
+
+	Unless required by applicable law or agreed to in writing, software
+	distributed under the License is distributed on an "AS IS" BASIS,
+	WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+	See the License for the specific language governing permissions and
+	limitations under the License.
+*/
+
+package config
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/openziti/ziti/controller/event"
+	"github.com/pkg/errors"
+)
+
+const (
+	AlertRuleTypeLinkLatency        = "linkLatency"
+	AlertRuleTypeServiceTerminators = "serviceTerminators"
+	AlertRuleTypeRouterOffline      = "routerOffline"
+	AlertRuleTypeCertExpiry         = "certExpiry"
+
+	DefaultAlertEvaluationInterval = 30 * time.Second
+	MinAlertEvaluationInterval     = time.Second
+	DefaultAlertLatencyPercentile  = 95
+	DefaultAlertLatencyWindow      = 5 * time.Minute
+	DefaultAlertMinTerminators     = 1
+	DefaultAlertCertExpiresWithin  = 14 * 24 * time.Hour
+)
+
+// AlertsConfig configures the alert rules evaluated by the controller
+type AlertsConfig struct {
+	EvaluationInterval time.Duration
+	Rules              []*AlertRuleConfig
+}
+
+// AlertRuleConfig defines a single alert rule. Which thresholds apply depends on the rule type:
+//   - linkLatency: fires when the Percentile of link latency samples over Window exceeds Latency
+//   - serviceTerminators: fires when a service selected by Filter has fewer than MinTerminators terminators
+//   - routerOffline: fires when a router selected by Filter isn't connected
+//   - certExpiry: fires when a router or controller certificate expires within ExpiresWithin
+//
+// An alert fires once the rule condition has held for For, and resolves as soon as it no longer holds
+type AlertRuleConfig struct {
+	Name           string
+	Type           string
+	Severity       string
+	For            time.Duration
+	Filter         string
+	Latency        time.Duration
+	Percentile     float64
+	Window         time.Duration
+	MinTerminators int
+	ExpiresWithin  time.Duration
+}
+
+func (self *Config) loadAlertsConfig(cfgmap map[interface{}]interface{}) error {
+	self.Alerts.EvaluationInterval = DefaultAlertEvaluationInterval
+
+	value, found := cfgmap["alerts"]
+	if !found {
+		return nil
+	}
+
+	submap, ok := value.(map[interface{}]interface{})
+	if !ok {
+		return errors.Errorf("invalid alerts configuration")
+	}
+
+	if value, found := submap["evaluationInterval"]; found {
+		val, err := time.ParseDuration(fmt.Sprintf("%v", value))
+		if err != nil {
+			return errors.Wrapf(err, "failed to parse alerts.evaluationInterval value '%v'", value)
+		}
+		if val < MinAlertEvaluationInterval {
+			return errors.Errorf("invalid value %v for alerts.evaluationInterval, must be at least %v", value, MinAlertEvaluationInterval)
+		}
+		self.Alerts.EvaluationInterval = val
+	}
+
+	if value, found := submap["rules"]; found {
+		ruleList, ok := value.([]interface{})
+		if !ok {
+			return errors.Errorf("invalid value for alerts.rules, must be a list of rules")
+		}
+
+		names := map[string]struct{}{}
+		for i, ruleValue := range ruleList {
+			ruleMap, ok := ruleValue.(map[interface{}]interface{})
+			if !ok {
+				return errors.Errorf("invalid value for alerts.rules[%d], must be a map", i)
+			}
+
+			rule, err := loadAlertRule(ruleMap)
+			if err != nil {
+				return errors.Wrapf(err, "invalid alerts.rules[%d]", i)
+			}
+
+			if _, found := names[rule.Name]; found {
+				return errors.Errorf("invalid alerts.rules[%d], rule name '%s' is used more than once", i, rule.Name)
+			}
+			names[rule.Name] = struct{}{}
+
+			self.Alerts.Rules = append(self.Alerts.Rules, rule)
+		}
+	}
+
+	return nil
+}
+
+func loadAlertRule(ruleMap map[interface{}]interface{}) (*AlertRuleConfig, error) {
+	rule := &AlertRuleConfig{
+		Severity:       event.AlertSeverityWarning,
+		Filter:         "true",
+		Percentile:     DefaultAlertLatencyPercentile,
+		Window:         DefaultAlertLatencyWindow,
+		MinTerminators: DefaultAlertMinTerminators,
+		ExpiresWithin:  DefaultAlertCertExpiresWithin,
+	}
+
+	if value, found := ruleMap["name"]; found {
+		rule.Name = fmt.Sprintf("%v", value)
+	}
+	if rule.Name == "" {
+		return nil, errors.New("name is required")
+	}
+
+	if value, found := ruleMap["type"]; found {
+		rule.Type = fmt.Sprintf("%v", value)
+	}
+
+	switch rule.Type {
+	case AlertRuleTypeLinkLatency, AlertRuleTypeServiceTerminators, AlertRuleTypeRouterOffline, AlertRuleTypeCertExpiry:
+	default:
+		return nil, errors.Errorf("invalid type '%s', must be one of %s, %s, %s or %s", rule.Type,
+			AlertRuleTypeLinkLatency, AlertRuleTypeServiceTerminators, AlertRuleTypeRouterOffline, AlertRuleTypeCertExpiry)
+	}
+
+	if value, found := ruleMap["severity"]; found {
+		rule.Severity = fmt.Sprintf("%v", value)
+		switch rule.Severity {
+		case event.AlertSeverityInfo, event.AlertSeverityWarning, event.AlertSeverityError, event.AlertSeverityCritical:
+		default:
+			return nil, errors.Errorf("invalid severity '%s', must be one of %s, %s, %s or %s", rule.Severity,
+				event.AlertSeverityInfo, event.AlertSeverityWarning, event.AlertSeverityError, event.AlertSeverityCritical)
+		}
+	}
+
+	if value, found := ruleMap["filter"]; found {
+		rule.Filter = fmt.Sprintf("%v", value)
+	}
+
+	durations := map[string]*time.Duration{
+		"for":           &rule.For,
+		"latency":       &rule.Latency,
+		"window":        &rule.Window,
+		"expiresWithin": &rule.ExpiresWithin,
+	}
+
+	for key, target := range durations {
+		if value, found := ruleMap[key]; found {
+			val, err := time.ParseDuration(fmt.Sprintf("%v", value))
+			if err != nil {
+				return nil, errors.Wrapf(err, "failed to parse %s value '%v'", key, value)
+			}
+			if val < 0 {
+				return nil, errors.Errorf("invalid value %v for %s, may not be negative", value, key)
+			}
+			*target = val
+		}
+	}
+
+	if value, found := ruleMap["percentile"]; found {
+		switch val := value.(type) {
+		case int:
+			rule.Percentile = float64(val)
+		case float64:
+			rule.Percentile = val
+		default:
+			return nil, errors.Errorf("invalid value %v for percentile, must be a number", value)
+		}
+		if rule.Percentile <= 0 || rule.Percentile > 100 {
+			return nil, errors.Errorf("invalid value %v for percentile, must be greater than 0 and at most 100", value)
+		}
+	}
+
+	if value, found := ruleMap["minTerminators"]; found {
+		val, ok := value.(int)
+		if !ok || val < 1 {
+			return nil, errors.Errorf("invalid value %v for minTerminators, must be a positive integer", value)
+		}
+		rule.MinTerminators = val
+	}
+
+	if rule.Type == AlertRuleTypeLinkLatency {
+		if rule.Latency == 0 {
+			return nil, errors.New("latency is required for linkLatency rules")
+		}
+		if rule.Window == 0 {
+			return nil, errors.New("window must be greater than 0 for linkLatency rules")
+		}
+	}
+
+	if rule.Type == AlertRuleTypeCertExpiry && rule.ExpiresWithin == 0 {
+		return nil, errors.New("expiresWithin must be greater than 0 for certExpiry rules")
+	}
+
+	return rule, nil
+}
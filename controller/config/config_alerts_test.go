@@ -0,0 +1,91 @@
+/*
+	Copyright NetFoundry Inc.
+
+	Licensed under the Apache License, Version 2.0 (the "License");
+	you may not use this file except in compliance with the License.
+	You may obtain a copy of the License at
+
+	https://www.apache.org/licenses/LICENSE-2.0
+
+	Unless required by applicable law or agreed to in writing, software
+	distributed under the License is distributed on an "AS IS" BASIS,
+	WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+	See the License for the specific language governing permissions and
+	limitations under the License.
+*/
+
+package config
+
+import (
+	"testing"
+	"time"
+
+	"github.com/openziti/ziti/controller/event"
+	"github.com/stretchr/testify/require"
+	"gopkg.in/yaml.v2"
+)
+
+func loadTestAlertsConfig(t *testing.T, src string) (*Config, error) {
+	cfgmap := map[interface{}]interface{}{}
+	require.NoError(t, yaml.Unmarshal([]byte(src), &cfgmap))
+	cfg := &Config{}
+	return cfg, cfg.loadAlertsConfig(cfgmap)
+}
+
+func Test_loadAlertsConfig(t *testing.T) {
+	t.Run("defaults are applied", func(t *testing.T) {
+		req := require.New(t)
+
+		cfg, err := loadTestAlertsConfig(t, `
+alerts:
+  rules:
+    - name: offline
+      type: routerOffline
+    - name: latency
+      type: linkLatency
+      severity: critical
+      for: 5m
+      latency: 250ms
+`)
+		req.NoError(err)
+		req.Equal(DefaultAlertEvaluationInterval, cfg.Alerts.EvaluationInterval)
+		req.Len(cfg.Alerts.Rules, 2)
+
+		req.Equal(event.AlertSeverityWarning, cfg.Alerts.Rules[0].Severity)
+		req.Equal("true", cfg.Alerts.Rules[0].Filter)
+		req.Equal(time.Duration(0), cfg.Alerts.Rules[0].For)
+
+		req.Equal(event.AlertSeverityCritical, cfg.Alerts.Rules[1].Severity)
+		req.Equal(5*time.Minute, cfg.Alerts.Rules[1].For)
+		req.Equal(250*time.Millisecond, cfg.Alerts.Rules[1].Latency)
+		req.Equal(float64(DefaultAlertLatencyPercentile), cfg.Alerts.Rules[1].Percentile)
+		req.Equal(DefaultAlertLatencyWindow, cfg.Alerts.Rules[1].Window)
+	})
+
+	t.Run("no alerts section is valid", func(t *testing.T) {
+		req := require.New(t)
+
+		cfg, err := loadTestAlertsConfig(t, `v: 3`)
+		req.NoError(err)
+		req.Empty(cfg.Alerts.Rules)
+	})
+
+	t.Run("invalid rules are rejected", func(t *testing.T) {
+		invalid := []string{
+			"rules: [{type: routerOffline}]",
+			"rules: [{name: a, type: unknown}]",
+			"rules: [{name: a, type: routerOffline, severity: fatal}]",
+			"rules: [{name: a, type: linkLatency}]",
+			"rules: [{name: a, type: linkLatency, latency: 1s, percentile: 101}]",
+			"rules: [{name: a, type: serviceTerminators, minTerminators: 0}]",
+			"rules: [{name: a, type: routerOffline, for: -1m}]",
+			"rules: [{name: a, type: routerOffline}, {name: a, type: certExpiry}]",
+			"evaluationInterval: 10ms",
+		}
+
+		for _, src := range invalid {
+			_, err := loadTestAlertsConfig(t, "alerts:\n  "+src)
+			require.Error(t, err, src)
+		}
+	})
+}
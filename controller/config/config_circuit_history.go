@@ -0,0 +1,82 @@
+/*
+	Copyright NetFoundry Inc.
+
+	Licensed under the Apache License, Version 2.0 (the "License");
+	you may not use this file except in compliance with the License.
+	You may obtain a copy of the License at
+
+	https://www.apache.org/licenses/LICENSE-2.0
+
+	Unless required by applicable law or agreed to in writing, software
+	distributed under the License is distributed on an "AS IS" BASIS,
+	WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+	See the License for the specific language governing permissions and
+	limitations under the License.
+*/
+
+package config
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/pkg/errors"
+)
+
+const (
+	DefaultCircuitHistoryMaxCircuits = 10000
+	DefaultCircuitHistoryRetention   = 24 * time.Hour
+)
+
+// CircuitHistoryConfig configures the controller's in memory circuit history. When enabled, the most recent
+// MaxCircuits circuits are kept, including failed circuits, for up to Retention after they end
+type CircuitHistoryConfig struct {
+	Enabled     bool
+	MaxCircuits int
+	Retention   time.Duration
+}
+
+func (self *Config) loadCircuitHistoryConfig(cfgmap map[interface{}]interface{}) error {
+	self.CircuitHistory.MaxCircuits = DefaultCircuitHistoryMaxCircuits
+	self.CircuitHistory.Retention = DefaultCircuitHistoryRetention
+
+	value, found := cfgmap["circuitHistory"]
+	if !found {
+		return nil
+	}
+
+	submap, ok := value.(map[interface{}]interface{})
+	if !ok {
+		return errors.Errorf("invalid circuitHistory configuration")
+	}
+
+	self.CircuitHistory.Enabled = true
+	if value, found := submap["enabled"]; found {
+		val, ok := value.(bool)
+		if !ok {
+			return errors.Errorf("invalid value %v for circuitHistory.enabled, must be true or false", value)
+		}
+		self.CircuitHistory.Enabled = val
+	}
+
+	if value, found := submap["maxCircuits"]; found {
+		val, ok := value.(int)
+		if !ok || val < 1 {
+			return errors.Errorf("invalid value %v for circuitHistory.maxCircuits, must be a positive integer", value)
+		}
+		self.CircuitHistory.MaxCircuits = val
+	}
+
+	if value, found := submap["retention"]; found {
+		val, err := time.ParseDuration(fmt.Sprintf("%v", value))
+		if err != nil {
+			return errors.Wrapf(err, "failed to parse circuitHistory.retention value '%v'", value)
+		}
+		if val <= 0 {
+			return errors.Errorf("invalid value %v for circuitHistory.retention, must be greater than 0", value)
+		}
+		self.CircuitHistory.Retention = val
+	}
+
+	return nil
+}
@@ -70,14 +70,23 @@ const (
 
 	DefaultIdentityOnlineStatusUnknownTimeout = 5 * time.Minute
 	DefaultIdentityOnlineStatusSource         = IdentityStatusSourceHybrid
+
+	DefaultIdentityLifecycleCheckInterval = time.Hour
+	MinIdentityLifecycleCheckInterval     = time.Minute
+	DefaultIdentityExpiryWarning          = 7 * 24 * time.Hour
 )
 
 type Enrollment struct {
 	SigningCert       identity.Identity
 	SigningCertConfig identity.Config
 	SigningCertCaPem  []byte
-	EdgeIdentity      EnrollmentOption
-	EdgeRouter        EnrollmentOption
+	// TrustedSigners are signing certificates which are trusted, and published in the CA bundle, but which aren't
+	// used to issue certificates. They allow the signing certificate to be rotated without re-enrolling: the next
+	// signer is trusted before it is made active, and the previous signer is trusted until the certificates it
+	// issued have been re-issued
+	TrustedSigners []*x509.Certificate
+	EdgeIdentity   EnrollmentOption
+	EdgeRouter     EnrollmentOption
 }
 
 type EnrollmentOption struct {
@@ -111,6 +120,7 @@ type EdgeConfig struct {
 	Oidc                 Oidc
 	Enrollment           Enrollment
 	IdentityStatusConfig IdentityStatusConfig
+	IdentityLifecycle    IdentityLifecycleConfig
 	caPems               *bytes.Buffer
 	caPemsOnce           sync.Once
 	Totp                 Totp
@@ -151,6 +161,15 @@ type IdentityStatusConfig struct {
 	UnknownTimeout time.Duration
 }
 
+// IdentityLifecycleConfig controls the automatic expiry, disabling and removal of identities. A zero
+// InactivityDisableAfter or UnenrolledDeleteAfter turns off the corresponding rule
+type IdentityLifecycleConfig struct {
+	CheckInterval          time.Duration
+	ExpiryWarning          time.Duration
+	InactivityDisableAfter time.Duration
+	UnenrolledDeleteAfter  time.Duration
+}
+
 func NewEdgeConfig() *EdgeConfig {
 	return &EdgeConfig{
 		Enabled: false,
@@ -204,6 +223,55 @@ func (c *EdgeConfig) RefreshCas() {
 	}
 }
 
+// loadTrustedSigners loads the [edge.enrollment.trustedSigners] list. Each entry has a required cert and an optional
+// ca, both of which are added to the CA bundle
+func (c *EdgeConfig) loadTrustedSigners(value interface{}) error {
+	signerList, ok := value.([]interface{})
+	if !ok {
+		return errors.New("[edge.enrollment.trustedSigners] must be a list")
+	}
+
+	for i, entry := range signerList {
+		signerMap, ok := entry.(map[interface{}]interface{})
+		if !ok {
+			return errors.Errorf("[edge.enrollment.trustedSigners[%d]] must be a map", i)
+		}
+
+		certPath, ok := signerMap["cert"].(string)
+		if !ok || certPath == "" {
+			return errors.Errorf("required configuration value [edge.enrollment.trustedSigners[%d].cert] is missing", i)
+		}
+
+		certPem, err := os.ReadFile(certPath)
+		if err != nil {
+			return errors.Wrapf(err, "could not read [edge.enrollment.trustedSigners[%d].cert]", i)
+		}
+
+		certs := nfpem.PemBytesToCertificates(certPem)
+		if len(certs) == 0 {
+			return errors.Errorf("[edge.enrollment.trustedSigners[%d].cert] does not contain a PEM certificate", i)
+		}
+
+		if !certs[0].IsCA {
+			return errors.Errorf("[edge.enrollment.trustedSigners[%d].cert] is not a CA certificate and can't be a signer", i)
+		}
+
+		c.AddCaPems(certPem)
+
+		if caPath, ok := signerMap["ca"].(string); ok && caPath != "" {
+			caPem, err := os.ReadFile(caPath)
+			if err != nil {
+				return errors.Wrapf(err, "could not read [edge.enrollment.trustedSigners[%d].ca]", i)
+			}
+			c.AddCaPems(caPem)
+		}
+
+		c.Enrollment.TrustedSigners = append(c.Enrollment.TrustedSigners, certs[0])
+	}
+
+	return nil
+}
+
 func (c *EdgeConfig) loadTotpSection(edgeConfigMap map[any]any) error {
 	c.Totp = Totp{}
 	c.Totp.Hostname = DefaultTotpDomain
@@ -463,6 +531,12 @@ func (c *EdgeConfig) loadEnrollmentSection(edgeConfigMap map[interface{}]interfa
 			return errors.New("required configuration section [edge.enrollment.signingCert] missing")
 		}
 
+		if value, found := enrollmentSubMap["trustedSigners"]; found {
+			if err = c.loadTrustedSigners(value); err != nil {
+				return err
+			}
+		}
+
 		if value, found := enrollmentSubMap["edgeIdentity"]; found {
 			edgeIdentitySubMap := value.(map[interface{}]interface{})
 
@@ -606,6 +680,48 @@ func (c *EdgeConfig) loadIdentityStatusConfig(cfgmap map[interface{}]interface{}
 	return nil
 }
 
+func (c *EdgeConfig) loadIdentityLifecycleConfig(cfgmap map[interface{}]interface{}) error {
+	c.IdentityLifecycle.CheckInterval = DefaultIdentityLifecycleCheckInterval
+	c.IdentityLifecycle.ExpiryWarning = DefaultIdentityExpiryWarning
+
+	value, found := cfgmap["identityLifecycle"]
+	if !found {
+		return nil
+	}
+
+	submap, ok := value.(map[interface{}]interface{})
+	if !ok {
+		return errors.Errorf("invalid type for identityLifecycle, should be map instead of %T", value)
+	}
+
+	durations := map[string]*time.Duration{
+		"checkInterval":          &c.IdentityLifecycle.CheckInterval,
+		"expiryWarning":          &c.IdentityLifecycle.ExpiryWarning,
+		"inactivityDisableAfter": &c.IdentityLifecycle.InactivityDisableAfter,
+		"unenrolledDeleteAfter":  &c.IdentityLifecycle.UnenrolledDeleteAfter,
+	}
+
+	for name, target := range durations {
+		if value, found := submap[name]; found {
+			val, err := time.ParseDuration(fmt.Sprintf("%v", value))
+			if err != nil {
+				return errors.Wrapf(err, "failed to parse identityLifecycle.%s value '%v'", name, value)
+			}
+			if val < 0 {
+				return errors.Errorf("invalid value %v for identityLifecycle.%s, may not be negative", value, name)
+			}
+			*target = val
+		}
+	}
+
+	if c.IdentityLifecycle.CheckInterval < MinIdentityLifecycleCheckInterval {
+		return errors.Errorf("invalid value %v for identityLifecycle.checkInterval, must be at least %v",
+			c.IdentityLifecycle.CheckInterval, MinIdentityLifecycleCheckInterval)
+	}
+
+	return nil
+}
+
 func LoadEdgeConfigFromMap(configMap map[interface{}]interface{}) (*EdgeConfig, error) {
 	edgeConfig := NewEdgeConfig()
 
@@ -651,6 +767,10 @@ func LoadEdgeConfigFromMap(configMap map[interface{}]interface{}) (*EdgeConfig,
 		return nil, err
 	}
 
+	if err = edgeConfig.loadIdentityLifecycleConfig(edgeConfigMap); err != nil {
+		return nil, err
+	}
+
 	if v, ok := edgeConfigMap["disablePostureChecks"]; ok {
 		if boolVal, ok := v.(bool); ok {
 			edgeConfig.DisablePostureChecks = boolVal
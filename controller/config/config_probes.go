@@ -0,0 +1,257 @@
+/*
+	Copyright NetFoundry Inc.
+
+	Licensed under the Apache License, Version 2.0 (the "License");
+	you may not use this file except in compliance with the License.
+	You may obtain a copy of the License at
+
+	https://www.apache.org/licenses/LICENSE-2.0
+
+	Unless required by applicable law or agreed to in writing, software
+	distributed under the License is distributed on an "AS IS" BASIS,
+	WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+	See the License for the specific language governing permissions and
+	limitations under the License.
+*/
+
+package config
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/pkg/errors"
+)
+
+const (
+	ProbeTypeRouterPath = "routerPath"
+	ProbeTypeService    = "service"
+
+	DefaultProbeWindow          = time.Hour
+	DefaultProbeInterval        = time.Minute
+	MinProbeInterval            = time.Second
+	DefaultProbeTimeout         = 10 * time.Second
+	DefaultProbeSloPercentile   = 95
+	DefaultProbeMaxResultsCount = 10000
+)
+
+// ProbesConfig configures the synthetic probes scheduled by the controller. Results are kept for Window, and SLO
+// summaries are computed over the results in the window
+type ProbesConfig struct {
+	Window      time.Duration
+	MaxResults  int
+	Definitions []*ProbeConfig
+}
+
+// ProbeConfig defines a single synthetic probe, executed by Router every Interval. Which fields apply depends on the
+// probe type:
+//   - routerPath: measures the round trip time over the links from Router to TargetRouter
+//   - service: dials Service using the router's probe identity, writes Send, if set, and waits for a response
+//     containing Expect, if set
+//
+// Routers may be given by id or by name
+type ProbeConfig struct {
+	Name         string
+	Type         string
+	Router       string
+	TargetRouter string
+	Service      string
+	Send         string
+	Expect       string
+	Interval     time.Duration
+	Timeout      time.Duration
+	Slo          ProbeSloConfig
+}
+
+// ProbeSloConfig defines the service level objectives for a probe. Availability is the percentage of probes in the
+// window which must succeed, and Latency the upper bound for the Percentile of successful probe latencies. Zero
+// values mean that the objective isn't set
+type ProbeSloConfig struct {
+	Availability float64
+	Latency      time.Duration
+	Percentile   float64
+}
+
+func (self *Config) loadProbesConfig(cfgmap map[interface{}]interface{}) error {
+	self.Probes.Window = DefaultProbeWindow
+	self.Probes.MaxResults = DefaultProbeMaxResultsCount
+
+	value, found := cfgmap["probes"]
+	if !found {
+		return nil
+	}
+
+	submap, ok := value.(map[interface{}]interface{})
+	if !ok {
+		return errors.Errorf("invalid probes configuration")
+	}
+
+	if value, found := submap["window"]; found {
+		val, err := time.ParseDuration(fmt.Sprintf("%v", value))
+		if err != nil {
+			return errors.Wrapf(err, "failed to parse probes.window value '%v'", value)
+		}
+		if val <= 0 {
+			return errors.Errorf("invalid value %v for probes.window, must be greater than 0", value)
+		}
+		self.Probes.Window = val
+	}
+
+	if value, found := submap["maxResults"]; found {
+		val, ok := value.(int)
+		if !ok || val < 1 {
+			return errors.Errorf("invalid value %v for probes.maxResults, must be a positive integer", value)
+		}
+		self.Probes.MaxResults = val
+	}
+
+	if value, found := submap["definitions"]; found {
+		probeList, ok := value.([]interface{})
+		if !ok {
+			return errors.Errorf("invalid value for probes.definitions, must be a list of probes")
+		}
+
+		names := map[string]struct{}{}
+		for i, probeValue := range probeList {
+			probeMap, ok := probeValue.(map[interface{}]interface{})
+			if !ok {
+				return errors.Errorf("invalid value for probes.definitions[%d], must be a map", i)
+			}
+
+			probe, err := loadProbe(probeMap)
+			if err != nil {
+				return errors.Wrapf(err, "invalid probes.definitions[%d]", i)
+			}
+
+			if _, found := names[probe.Name]; found {
+				return errors.Errorf("invalid probes.definitions[%d], probe name '%s' is used more than once", i, probe.Name)
+			}
+			names[probe.Name] = struct{}{}
+
+			self.Probes.Definitions = append(self.Probes.Definitions, probe)
+		}
+	}
+
+	return nil
+}
+
+func loadProbe(probeMap map[interface{}]interface{}) (*ProbeConfig, error) {
+	probe := &ProbeConfig{
+		Interval: DefaultProbeInterval,
+		Timeout:  DefaultProbeTimeout,
+		Slo: ProbeSloConfig{
+			Percentile: DefaultProbeSloPercentile,
+		},
+	}
+
+	stringValues := map[string]*string{
+		"name":         &probe.Name,
+		"type":         &probe.Type,
+		"router":       &probe.Router,
+		"targetRouter": &probe.TargetRouter,
+		"service":      &probe.Service,
+		"send":         &probe.Send,
+		"expect":       &probe.Expect,
+	}
+
+	for key, target := range stringValues {
+		if value, found := probeMap[key]; found {
+			*target = fmt.Sprintf("%v", value)
+		}
+	}
+
+	if probe.Name == "" {
+		return nil, errors.New("name is required")
+	}
+
+	if probe.Router == "" {
+		return nil, errors.New("router is required")
+	}
+
+	switch probe.Type {
+	case ProbeTypeRouterPath:
+		if probe.TargetRouter == "" {
+			return nil, errors.New("targetRouter is required for routerPath probes")
+		}
+	case ProbeTypeService:
+		if probe.Service == "" {
+			return nil, errors.New("service is required for service probes")
+		}
+	default:
+		return nil, errors.Errorf("invalid type '%s', must be one of %s or %s", probe.Type, ProbeTypeRouterPath, ProbeTypeService)
+	}
+
+	durations := map[string]*time.Duration{
+		"interval": &probe.Interval,
+		"timeout":  &probe.Timeout,
+	}
+
+	for key, target := range durations {
+		if value, found := probeMap[key]; found {
+			val, err := time.ParseDuration(fmt.Sprintf("%v", value))
+			if err != nil {
+				return nil, errors.Wrapf(err, "failed to parse %s value '%v'", key, value)
+			}
+			if val <= 0 {
+				return nil, errors.Errorf("invalid value %v for %s, must be greater than 0", value, key)
+			}
+			*target = val
+		}
+	}
+
+	if probe.Interval < MinProbeInterval {
+		return nil, errors.Errorf("invalid value %v for interval, must be at least %v", probe.Interval, MinProbeInterval)
+	}
+
+	if probe.Timeout > probe.Interval {
+		return nil, errors.Errorf("timeout %v may not be greater than the interval %v", probe.Timeout, probe.Interval)
+	}
+
+	if value, found := probeMap["slo"]; found {
+		sloMap, ok := value.(map[interface{}]interface{})
+		if !ok {
+			return nil, errors.New("invalid value for slo, must be a map")
+		}
+		if err := loadProbeSlo(sloMap, &probe.Slo); err != nil {
+			return nil, err
+		}
+	}
+
+	return probe, nil
+}
+
+func loadProbeSlo(sloMap map[interface{}]interface{}, slo *ProbeSloConfig) error {
+	percentages := map[string]*float64{
+		"availability": &slo.Availability,
+		"percentile":   &slo.Percentile,
+	}
+
+	for key, target := range percentages {
+		if value, found := sloMap[key]; found {
+			switch val := value.(type) {
+			case int:
+				*target = float64(val)
+			case float64:
+				*target = val
+			default:
+				return errors.Errorf("invalid value %v for slo.%s, must be a number", value, key)
+			}
+			if *target <= 0 || *target > 100 {
+				return errors.Errorf("invalid value %v for slo.%s, must be greater than 0 and at most 100", value, key)
+			}
+		}
+	}
+
+	if value, found := sloMap["latency"]; found {
+		val, err := time.ParseDuration(fmt.Sprintf("%v", value))
+		if err != nil {
+			return errors.Wrapf(err, "failed to parse slo.latency value '%v'", value)
+		}
+		if val <= 0 {
+			return errors.Errorf("invalid value %v for slo.latency, must be greater than 0", value)
+		}
+		slo.Latency = val
+	}
+
+	return nil
+}
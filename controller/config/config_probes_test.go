@@ -0,0 +1,105 @@
+/*
+	Copyright NetFoundry Inc.
+
+	Licensed under the Apache License, Version 2.0 (the "License");
+	you may not use this file except in compliance with the License.
+	You may obtain a copy of the License at
+
+	https://www.apache.org/licenses/LICENSE-2.0
+
+	Unless required by applicable law or agreed to in writing, software
+	distributed under the License is distributed on an "AS IS" BASIS,
+	WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+	See the License for the specific language governing permissions and
+	limitations under the License.
+*/
+
+package config
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"gopkg.in/yaml.v2"
+)
+
+func loadTestProbesConfig(t *testing.T, src string) (*Config, error) {
+	cfgmap := map[interface{}]interface{}{}
+	require.NoError(t, yaml.Unmarshal([]byte(src), &cfgmap))
+	cfg := &Config{}
+	return cfg, cfg.loadProbesConfig(cfgmap)
+}
+
+func Test_loadProbesConfig(t *testing.T) {
+	t.Run("defaults are applied", func(t *testing.T) {
+		req := require.New(t)
+
+		cfg, err := loadTestProbesConfig(t, `
+probes:
+  definitions:
+    - name: east-west
+      type: routerPath
+      router: east
+      targetRouter: west
+    - name: web
+      type: service
+      router: east
+      service: web
+      send: "GET / HTTP/1.0\r\n\r\n"
+      expect: "200 OK"
+      interval: 30s
+      timeout: 5s
+      slo:
+        availability: 99.9
+        latency: 250ms
+`)
+		req.NoError(err)
+		req.Equal(DefaultProbeWindow, cfg.Probes.Window)
+		req.Equal(DefaultProbeMaxResultsCount, cfg.Probes.MaxResults)
+		req.Len(cfg.Probes.Definitions, 2)
+
+		req.Equal("west", cfg.Probes.Definitions[0].TargetRouter)
+		req.Equal(DefaultProbeInterval, cfg.Probes.Definitions[0].Interval)
+		req.Equal(DefaultProbeTimeout, cfg.Probes.Definitions[0].Timeout)
+		req.Equal(float64(0), cfg.Probes.Definitions[0].Slo.Availability)
+
+		req.Equal("GET / HTTP/1.0\r\n\r\n", cfg.Probes.Definitions[1].Send)
+		req.Equal("200 OK", cfg.Probes.Definitions[1].Expect)
+		req.Equal(30*time.Second, cfg.Probes.Definitions[1].Interval)
+		req.Equal(5*time.Second, cfg.Probes.Definitions[1].Timeout)
+		req.Equal(99.9, cfg.Probes.Definitions[1].Slo.Availability)
+		req.Equal(250*time.Millisecond, cfg.Probes.Definitions[1].Slo.Latency)
+		req.Equal(float64(DefaultProbeSloPercentile), cfg.Probes.Definitions[1].Slo.Percentile)
+	})
+
+	t.Run("no probes section is valid", func(t *testing.T) {
+		req := require.New(t)
+
+		cfg, err := loadTestProbesConfig(t, `v: 3`)
+		req.NoError(err)
+		req.Empty(cfg.Probes.Definitions)
+	})
+
+	t.Run("invalid probes are rejected", func(t *testing.T) {
+		invalid := []string{
+			"definitions: [{type: routerPath, router: a, targetRouter: b}]",
+			"definitions: [{name: a, type: unknown, router: a}]",
+			"definitions: [{name: a, type: routerPath, targetRouter: b}]",
+			"definitions: [{name: a, type: routerPath, router: a}]",
+			"definitions: [{name: a, type: service, router: a}]",
+			"definitions: [{name: a, type: service, router: a, service: b, interval: 10ms}]",
+			"definitions: [{name: a, type: service, router: a, service: b, interval: 5s, timeout: 10s}]",
+			"definitions: [{name: a, type: service, router: a, service: b, slo: {availability: 101}}]",
+			"definitions: [{name: a, type: service, router: a, service: b, slo: {latency: -1s}}]",
+			"definitions: [{name: a, type: service, router: a, service: b}, {name: a, type: service, router: a, service: c}]",
+			"window: 0s",
+			"maxResults: 0",
+		}
+
+		for _, src := range invalid {
+			_, err := loadTestProbesConfig(t, "probes:\n  "+src)
+			require.Error(t, err, src)
+		}
+	})
+}
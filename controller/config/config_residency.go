@@ -0,0 +1,135 @@
+/*
+	Copyright NetFoundry Inc.
+
+	Licensed under the Apache License, Version 2.0 (the "License");
+	you may not use this file except in compliance with the License.
+	You may obtain a copy of the License at
+
+	https://www.apache.org/licenses/LICENSE-2.0
+
+	Unless required by applicable law or agreed to in writing, software
+	distributed under the License is distributed on an "AS IS" BASIS,
+	WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+	See the License for the specific language governing permissions and
+	limitations under the License.
+*/
+
+package config
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/openziti/ziti/controller/db"
+	"github.com/pkg/errors"
+)
+
+// ResidencyConfig holds the data residency constraints enforced when computing circuit paths
+type ResidencyConfig struct {
+	Constraints []*ResidencyConstraintConfig
+}
+
+// ResidencyConstraintConfig restricts the circuits for the matching Services to the matching Routers. Both lists
+// contain role attributes, prefixed with #, or entity ids or names, prefixed with @. #all matches every entity.
+// Service role attributes are matched against edge services and router role attributes against edge routers, so
+// routers without role attributes must be listed by id or name. If several constraints match a service, a router
+// must be allowed by all of them
+type ResidencyConstraintConfig struct {
+	Name     string
+	Services []string
+	Routers  []string
+}
+
+func (self *Config) loadResidencyConfig(cfgmap map[interface{}]interface{}) error {
+	value, found := cfgmap["residency"]
+	if !found {
+		return nil
+	}
+
+	submap, ok := value.(map[interface{}]interface{})
+	if !ok {
+		return errors.Errorf("invalid residency configuration")
+	}
+
+	value, found = submap["constraints"]
+	if !found {
+		return nil
+	}
+
+	constraintList, ok := value.([]interface{})
+	if !ok {
+		return errors.Errorf("invalid value for residency.constraints, must be a list of constraints")
+	}
+
+	names := map[string]struct{}{}
+	for i, constraintValue := range constraintList {
+		constraintMap, ok := constraintValue.(map[interface{}]interface{})
+		if !ok {
+			return errors.Errorf("invalid value for residency.constraints[%d], must be a map", i)
+		}
+
+		constraint, err := loadResidencyConstraint(constraintMap)
+		if err != nil {
+			return errors.Wrapf(err, "invalid residency.constraints[%d]", i)
+		}
+
+		if _, found := names[constraint.Name]; found {
+			return errors.Errorf("invalid residency.constraints[%d], constraint name '%s' is used more than once", i, constraint.Name)
+		}
+		names[constraint.Name] = struct{}{}
+
+		self.Residency.Constraints = append(self.Residency.Constraints, constraint)
+	}
+
+	return nil
+}
+
+func loadResidencyConstraint(constraintMap map[interface{}]interface{}) (*ResidencyConstraintConfig, error) {
+	constraint := &ResidencyConstraintConfig{}
+
+	if value, found := constraintMap["name"]; found {
+		constraint.Name = fmt.Sprintf("%v", value)
+	}
+
+	if constraint.Name == "" {
+		return nil, errors.New("name is required")
+	}
+
+	var err error
+	if constraint.Services, err = loadResidencyRoles(constraintMap, "services"); err != nil {
+		return nil, err
+	}
+
+	if constraint.Routers, err = loadResidencyRoles(constraintMap, "routers"); err != nil {
+		return nil, err
+	}
+
+	return constraint, nil
+}
+
+func loadResidencyRoles(constraintMap map[interface{}]interface{}, key string) ([]string, error) {
+	value, found := constraintMap[key]
+	if !found {
+		return nil, errors.Errorf("%s is required", key)
+	}
+
+	list, ok := value.([]interface{})
+	if !ok || len(list) == 0 {
+		return nil, errors.Errorf("invalid value for %s, must be a non-empty list", key)
+	}
+
+	var result []string
+	for _, entry := range list {
+		role := fmt.Sprintf("%v", entry)
+		if !strings.HasPrefix(role, db.RolePrefix) && !strings.HasPrefix(role, db.EntityPrefix) {
+			return nil, errors.Errorf("invalid %s entry '%s', must be a role attribute (prefixed with %s) or an entity id or name (prefixed with %s)",
+				key, role, db.RolePrefix, db.EntityPrefix)
+		}
+		if len(role) == 1 {
+			return nil, errors.Errorf("invalid %s entry '%s', role attribute or entity id or name is missing", key, role)
+		}
+		result = append(result, role)
+	}
+
+	return result, nil
+}
@@ -0,0 +1,81 @@
+/*
+	Copyright NetFoundry Inc.
+
+	Licensed under the Apache License, Version 2.0 (the "License");
+	you may not use this file except in compliance with the License.
+	You may obtain a copy of the License at
+
+	https://www.apache.org/licenses/LICENSE-2.0
+
+	Unless required by applicable law or agreed to in writing, software
+	distributed under the License is distributed on an "AS IS" BASIS,
+	WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+	See the License for the specific language governing permissions and
+	limitations under the License.
+*/
+
+package config
+
+import (
+	"testing"
+
+	"github.com/openziti/ziti/controller/db"
+	"github.com/stretchr/testify/require"
+	"gopkg.in/yaml.v2"
+)
+
+func loadTestResidencyConfig(t *testing.T, src string) (*Config, error) {
+	cfgmap := map[interface{}]interface{}{}
+	require.NoError(t, yaml.Unmarshal([]byte(src), &cfgmap))
+	cfg := &Config{}
+	return cfg, cfg.loadResidencyConfig(cfgmap)
+}
+
+func Test_loadResidencyConfig(t *testing.T) {
+	t.Run("constraints are loaded", func(t *testing.T) {
+		req := require.New(t)
+
+		cfg, err := loadTestResidencyConfig(t, `
+residency:
+  constraints:
+    - name: eu-only
+      services: ['#eu-data', '@payroll']
+      routers: ['#eu']
+    - name: core
+      services: ['#all']
+      routers: ['#all']
+`)
+		req.NoError(err)
+		req.Len(cfg.Residency.Constraints, 2)
+		req.Equal("eu-only", cfg.Residency.Constraints[0].Name)
+		req.Equal([]string{"#eu-data", "@payroll"}, cfg.Residency.Constraints[0].Services)
+		req.Equal([]string{"#eu"}, cfg.Residency.Constraints[0].Routers)
+		req.Equal([]string{db.AllRole}, cfg.Residency.Constraints[1].Routers)
+	})
+
+	t.Run("no residency section is valid", func(t *testing.T) {
+		req := require.New(t)
+
+		cfg, err := loadTestResidencyConfig(t, `v: 3`)
+		req.NoError(err)
+		req.Empty(cfg.Residency.Constraints)
+	})
+
+	t.Run("invalid constraints are rejected", func(t *testing.T) {
+		invalid := []string{
+			"constraints: [{services: ['#a'], routers: ['#b']}]",
+			"constraints: [{name: a, routers: ['#b']}]",
+			"constraints: [{name: a, services: ['#a']}]",
+			"constraints: [{name: a, services: [], routers: ['#b']}]",
+			"constraints: [{name: a, services: ['a'], routers: ['#b']}]",
+			"constraints: [{name: a, services: ['#a'], routers: ['@']}]",
+			"constraints: [{name: a, services: ['#a'], routers: ['#b']}, {name: a, services: ['#c'], routers: ['#d']}]",
+			"constraints: foo",
+		}
+
+		for _, src := range invalid {
+			_, err := loadTestResidencyConfig(t, "residency:\n  "+src)
+			require.Error(t, err, src)
+		}
+	})
+}
@@ -45,6 +45,7 @@ import (
 	fabricMetrics "github.com/openziti/ziti/common/metrics"
 	"github.com/openziti/ziti/common/pb/ctrl_pb"
 	"github.com/openziti/ziti/common/profiler"
+	"github.com/openziti/ziti/controller/backup"
 	"github.com/openziti/ziti/controller/bindpoints"
 	"github.com/openziti/ziti/controller/command"
 	"github.com/openziti/ziti/controller/config"
@@ -296,6 +297,12 @@ func NewController(cfg *config.Config, versionProvider versions.VersionProvider)
 		return nil, err
 	}
 
+	if cfg.Backup.Enabled {
+		if c.network.Backups, err = backup.NewManager(c, &cfg.Backup); err != nil {
+			return nil, err
+		}
+	}
+
 	if c.raftController != nil {
 		if err = c.raftController.InitEnv(c.env); err != nil {
 			return nil, err
@@ -471,6 +478,10 @@ func (c *Controller) Run() error {
 		c.raftController.StartEventGeneration()
 	}
 
+	if c.network.Backups != nil {
+		c.network.Backups.Start()
+	}
+
 	c.network.Run()
 
 	return nil
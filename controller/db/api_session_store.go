@@ -233,6 +233,12 @@ func (store *apiSessionStoreImpl) Update(ctx boltz.MutateContext, entity *ApiSes
 
 func (store *apiSessionStoreImpl) ProcessPreCommit(state *boltz.EntityChangeState[*ApiSession]) error {
 	if state.ChangeType == boltz.EntityDeleted {
+		if state.InitialState != nil {
+			err := store.stores.identity.recordLastActivity(state.Ctx.Tx(), state.InitialState.IdentityId, state.InitialState.LastActivityAt)
+			if err != nil {
+				return err
+			}
+		}
 		return store.handleDeleteCleanup(state.Ctx, state.EntityId)
 	}
 	return nil
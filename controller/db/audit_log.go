@@ -0,0 +1,284 @@
+/*
+	(c) Copyright NetFoundry Inc.
+
+	Licensed under the Apache License, Version 2.0 (the "License");
+	you may not use this file except in compliance with the License.
+	You may obtain a copy of the License at
+
+	https://www.apache.org/licenses/LICENSE-2.0
+
+	Unless required by applicable law or agreed to in writing, software
+	distributed under the License is distributed on an "AS IS" BASIS,
+	WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+	See the License for the specific language governing permissions and
+	limitations under the License.
+*/
+
+package db
+
+import (
+	"encoding/binary"
+	"encoding/json"
+	"reflect"
+	"time"
+
+	"github.com/openziti/storage/boltz"
+	"github.com/openziti/ziti/controller/change"
+	"github.com/pkg/errors"
+	"go.etcd.io/bbolt"
+)
+
+const (
+	AuditBucket            = "audit"
+	auditEntriesBucket     = "entries"
+	auditEntityIndexBucket = "entities"
+
+	AuditChangeTypeCreated = "created"
+	AuditChangeTypeUpdated = "updated"
+	AuditChangeTypeDeleted = "deleted"
+)
+
+// DefaultAuditExcludedEntityTypes are the high churn, session related entity types which aren't audited unless
+// explicitly configured
+var DefaultAuditExcludedEntityTypes = []string{
+	EntityTypeApiSessions,
+	EntityTypeApiSessionCertificates,
+	EntityTypeEventualEvents,
+	EntityTypeSessions,
+}
+
+// AuditEntry records a single change to an entity, along with who made the change and how
+type AuditEntry struct {
+	Sequence     uint64          `json:"sequence"`
+	EntityType   string          `json:"entityType"`
+	EntityId     string          `json:"entityId"`
+	Version      uint64          `json:"version"`
+	ChangeType   string          `json:"changeType"`
+	Timestamp    time.Time       `json:"timestamp"`
+	EventId      string          `json:"eventId"`
+	Author       *change.Author  `json:"author,omitempty"`
+	Source       *change.Source  `json:"source,omitempty"`
+	TraceId      string          `json:"traceId,omitempty"`
+	InitialState json.RawMessage `json:"initialState,omitempty"`
+	FinalState   json.RawMessage `json:"finalState,omitempty"`
+}
+
+// AuditFilter restricts which entries are returned by AuditLog.List. Empty fields match everything.
+type AuditFilter struct {
+	EntityType string
+	EntityId   string
+	AuthorId   string
+	Since      time.Time
+	Limit      int
+}
+
+func (self *AuditFilter) matches(entry *AuditEntry) bool {
+	if self.EntityType != "" && self.EntityType != entry.EntityType {
+		return false
+	}
+	if self.EntityId != "" && self.EntityId != entry.EntityId {
+		return false
+	}
+	if self.AuthorId != "" && (entry.Author == nil || entry.Author.Id != self.AuthorId) {
+		return false
+	}
+	return self.Since.IsZero() || !entry.Timestamp.Before(self.Since)
+}
+
+// AuditLog persists entity changes in the same transaction as the change itself. Since every controller in
+// a cluster applies the same commands, the log is replicated along with the data it describes.
+type AuditLog struct {
+	enabled  bool
+	excluded map[string]struct{}
+}
+
+func (self *AuditLog) IsEnabled() bool {
+	return self != nil && self.enabled
+}
+
+// Enable starts recording changes for all stores, except those whose entity types are in the excluded list
+func (self *AuditLog) Enable(stores *Stores, excluded []string) {
+	self.excluded = map[string]struct{}{}
+	for _, entityType := range excluded {
+		self.excluded[entityType] = struct{}{}
+	}
+
+	for _, store := range stores.GetStores() {
+		if _, found := self.excluded[store.GetEntityType()]; !found {
+			store.AddUntypedEntityConstraint(self)
+		}
+	}
+	self.enabled = true
+}
+
+func (self *AuditLog) ProcessPreCommit(state boltz.UntypedEntityChangeState) error {
+	// child stores, such as edge services, report the full entity. The parent event only has a subset of the data
+	if state.IsParentEvent() {
+		return nil
+	}
+
+	entry := &AuditEntry{
+		EntityType: state.GetStore().GetEntityType(),
+		EntityId:   state.GetEntityId(),
+		Timestamp:  time.Now().UTC(),
+		EventId:    state.GetEventId(),
+	}
+
+	switch state.GetChangeType() {
+	case boltz.EntityCreated:
+		entry.ChangeType = AuditChangeTypeCreated
+	case boltz.EntityUpdated:
+		entry.ChangeType = AuditChangeTypeUpdated
+	case boltz.EntityDeleted:
+		entry.ChangeType = AuditChangeTypeDeleted
+	}
+
+	if changeCtx := change.FromContext(state.GetCtx().Context()); changeCtx != nil {
+		entry.Author = changeCtx.GetAuthor()
+		entry.Source = changeCtx.GetSource()
+		entry.TraceId = changeCtx.Attributes[change.TraceIdKey]
+	}
+
+	var err error
+	if entry.InitialState, err = marshalAuditState(state.GetInitialState()); err != nil {
+		return err
+	}
+	if entry.FinalState, err = marshalAuditState(state.GetFinalState()); err != nil {
+		return err
+	}
+
+	return self.append(state.GetCtx().Tx(), entry)
+}
+
+func (self *AuditLog) ProcessPostCommit(boltz.UntypedEntityChangeState) {}
+
+func (self *AuditLog) append(tx *bbolt.Tx, entry *AuditEntry) error {
+	entries := boltz.GetOrCreatePath(tx, RootBucket, AuditBucket, auditEntriesBucket)
+	if entries.HasError() {
+		return entries.GetError()
+	}
+	entityBucket := boltz.GetOrCreatePath(tx, RootBucket, AuditBucket, auditEntityIndexBucket, entry.EntityType, entry.EntityId)
+	if entityBucket.HasError() {
+		return entityBucket.GetError()
+	}
+
+	var err error
+	if entry.Sequence, err = entries.NextSequence(); err != nil {
+		return err
+	}
+	if entry.Version, err = entityBucket.NextSequence(); err != nil {
+		return err
+	}
+
+	val, err := json.Marshal(entry)
+	if err != nil {
+		return err
+	}
+
+	key := auditKey(entry.Sequence)
+	if err = entries.Put(key, val); err != nil {
+		return err
+	}
+	return entityBucket.Put(auditKey(entry.Version), key)
+}
+
+// List returns the entries matching the given filter, most recent first
+func (self *AuditLog) List(tx *bbolt.Tx, filter *AuditFilter) ([]*AuditEntry, error) {
+	if filter.EntityType != "" && filter.EntityId != "" {
+		history, err := self.GetHistory(tx, filter.EntityType, filter.EntityId)
+		if err != nil {
+			return nil, err
+		}
+		var result []*AuditEntry
+		for i := len(history) - 1; i >= 0; i-- {
+			if filter.matches(history[i]) {
+				result = append(result, history[i])
+				if filter.Limit > 0 && len(result) >= filter.Limit {
+					break
+				}
+			}
+		}
+		return result, nil
+	}
+
+	entries := boltz.Path(tx, RootBucket, AuditBucket, auditEntriesBucket)
+	if entries == nil {
+		return nil, nil
+	}
+
+	var result []*AuditEntry
+	cursor := entries.Cursor()
+	for k, v := cursor.Last(); k != nil; k, v = cursor.Prev() {
+		entry := &AuditEntry{}
+		if err := json.Unmarshal(v, entry); err != nil {
+			return nil, errors.Wrapf(err, "unable to decode audit entry %d", binary.BigEndian.Uint64(k))
+		}
+		if filter.matches(entry) {
+			result = append(result, entry)
+			if filter.Limit > 0 && len(result) >= filter.Limit {
+				break
+			}
+		}
+	}
+	return result, nil
+}
+
+// GetHistory returns all recorded versions of the given entity, oldest first
+func (self *AuditLog) GetHistory(tx *bbolt.Tx, entityType, entityId string) ([]*AuditEntry, error) {
+	entityBucket := boltz.Path(tx, RootBucket, AuditBucket, auditEntityIndexBucket, entityType, entityId)
+	if entityBucket == nil {
+		return nil, nil
+	}
+
+	var result []*AuditEntry
+	cursor := entityBucket.Cursor()
+	for k, v := cursor.First(); k != nil; k, v = cursor.Next() {
+		entry, err := self.loadEntry(tx, v)
+		if err != nil {
+			return nil, err
+		}
+		result = append(result, entry)
+	}
+	return result, nil
+}
+
+// GetVersion returns the entry for the given version of the entity, or nil if there is no such version
+func (self *AuditLog) GetVersion(tx *bbolt.Tx, entityType, entityId string, version uint64) (*AuditEntry, error) {
+	entityBucket := boltz.Path(tx, RootBucket, AuditBucket, auditEntityIndexBucket, entityType, entityId)
+	if entityBucket == nil {
+		return nil, nil
+	}
+	key := entityBucket.Get(auditKey(version))
+	if key == nil {
+		return nil, nil
+	}
+	return self.loadEntry(tx, key)
+}
+
+func (self *AuditLog) loadEntry(tx *bbolt.Tx, key []byte) (*AuditEntry, error) {
+	entries := boltz.Path(tx, RootBucket, AuditBucket, auditEntriesBucket)
+	if entries == nil {
+		return nil, errors.New("audit entries bucket not found")
+	}
+	val := entries.Get(key)
+	if val == nil {
+		return nil, errors.Errorf("audit entry %d not found", binary.BigEndian.Uint64(key))
+	}
+	entry := &AuditEntry{}
+	if err := json.Unmarshal(val, entry); err != nil {
+		return nil, errors.Wrapf(err, "unable to decode audit entry %d", binary.BigEndian.Uint64(key))
+	}
+	return entry, nil
+}
+
+func marshalAuditState(entity boltz.Entity) (json.RawMessage, error) {
+	// states are typed, so a missing state may be a nil pointer wrapped in a non-nil interface
+	if entity == nil || (reflect.ValueOf(entity).Kind() == reflect.Ptr && reflect.ValueOf(entity).IsNil()) {
+		return nil, nil
+	}
+	return json.Marshal(entity)
+}
+
+func auditKey(val uint64) []byte {
+	return binary.BigEndian.AppendUint64(nil, val)
+}
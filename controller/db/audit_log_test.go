@@ -0,0 +1,93 @@
+/*
+	Copyright NetFoundry Inc.
+
+	Licensed under the Apache License, Version 2.0 (the "License");
+	you may not use this file except in compliance with the License.
+	You may obtain a copy of the License at
+
+	https://www.apache.org/licenses/LICENSE-2.0
+
+	Unless required by applicable law or agreed to in writing, software
+	distributed under the License is distributed on an "AS IS" BASIS,
+	WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+	See the License for the specific language governing permissions and
+	limitations under the License.
+*/
+
+package db
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/openziti/storage/boltz"
+	"github.com/openziti/ziti/common/eid"
+	"github.com/openziti/ziti/controller/change"
+	"go.etcd.io/bbolt"
+)
+
+func Test_AuditLog(t *testing.T) {
+	ctx := NewTestContext(t)
+	defer ctx.Cleanup()
+	ctx.Init()
+	ctx.stores.Audit.Enable(ctx.stores, DefaultAuditExcludedEntityTypes)
+
+	changeCtx := change.New().SetChangeAuthorType(change.AuthorTypeIdentity).
+		SetChangeAuthorId("author-1").
+		SetChangeAuthorName("Author One").
+		SetSourceType(change.SourceTypeRest)
+
+	service := newEdgeService(eid.New(), "first")
+	ctx.NoError(ctx.GetDb().Update(changeCtx.NewMutateContext(), func(mutateCtx boltz.MutateContext) error {
+		return ctx.stores.EdgeService.Create(mutateCtx, service)
+	}))
+
+	service.RoleAttributes = []string{"second"}
+	ctx.NoError(ctx.GetDb().Update(changeCtx.NewMutateContext(), func(mutateCtx boltz.MutateContext) error {
+		return ctx.stores.EdgeService.Update(mutateCtx, service, nil)
+	}))
+
+	ctx.NoError(ctx.GetDb().Update(changeCtx.NewMutateContext(), func(mutateCtx boltz.MutateContext) error {
+		return ctx.stores.EdgeService.DeleteById(mutateCtx, service.Id)
+	}))
+
+	ctx.NoError(ctx.GetDb().View(func(tx *bbolt.Tx) error {
+		history, err := ctx.stores.Audit.GetHistory(tx, EntityTypeServices, service.Id)
+		ctx.NoError(err)
+		ctx.Len(history, 3)
+
+		for i, changeType := range []string{AuditChangeTypeCreated, AuditChangeTypeUpdated, AuditChangeTypeDeleted} {
+			ctx.Equal(uint64(i+1), history[i].Version)
+			ctx.Equal(changeType, history[i].ChangeType)
+			ctx.Equal("author-1", history[i].Author.Id)
+			ctx.Equal(change.SourceTypeRest, history[i].Source.Type)
+		}
+
+		ctx.Empty(history[0].InitialState)
+		ctx.Empty(history[2].FinalState)
+
+		updated := &EdgeService{}
+		ctx.NoError(json.Unmarshal(history[1].FinalState, updated))
+		ctx.Equal(service.Name, updated.Name)
+		ctx.Equal([]string{"second"}, updated.RoleAttributes)
+
+		version, err := ctx.stores.Audit.GetVersion(tx, EntityTypeServices, service.Id, 2)
+		ctx.NoError(err)
+		ctx.Equal(history[1].Sequence, version.Sequence)
+
+		version, err = ctx.stores.Audit.GetVersion(tx, EntityTypeServices, service.Id, 4)
+		ctx.NoError(err)
+		ctx.Nil(version)
+
+		entries, err := ctx.stores.Audit.List(tx, &AuditFilter{EntityType: EntityTypeServices, Limit: 2})
+		ctx.NoError(err)
+		ctx.Len(entries, 2)
+		ctx.Equal(AuditChangeTypeDeleted, entries[0].ChangeType)
+		ctx.Equal(AuditChangeTypeUpdated, entries[1].ChangeType)
+
+		entries, err = ctx.stores.Audit.List(tx, &AuditFilter{AuthorId: "someone-else"})
+		ctx.NoError(err)
+		ctx.Empty(entries)
+		return nil
+	}))
+}
@@ -0,0 +1,229 @@
+/*
+	(c) Copyright NetFoundry Inc.
+
+	Licensed under the Apache License, Version 2.0 (the "License");
+	you may not use this file except in compliance with the License.
+	You may obtain a copy of the License at
+
+	https://www.apache.org/licenses/LICENSE-2.0
+
+	Unless required by applicable law or agreed to in writing, software
+	distributed under the License is distributed on an "AS IS" BASIS,
+	WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+	See the License for the specific language governing permissions and
+	limitations under the License.
+*/
+
+package db
+
+import (
+	"github.com/openziti/foundation/v2/stringz"
+	"github.com/openziti/storage/ast"
+	"github.com/openziti/storage/boltz"
+	"go.etcd.io/bbolt"
+	"sort"
+)
+
+const (
+	FieldBandwidthLimitRate               = "rate"
+	FieldBandwidthLimitBurst              = "burst"
+	FieldBandwidthLimitShared             = "shared"
+	FieldBandwidthLimitEdgeRouterPolicies = "edgeRouterPolicies"
+)
+
+// BandwidthLimit caps the ingress bandwidth of traffic matching its identity and service roles, on the edge routers
+// granted by its edge router policies. Rate is in bytes per second and Burst in bytes. If Shared is true, all matching
+// traffic on a router shares a single budget, otherwise each identity gets its own.
+type BandwidthLimit struct {
+	boltz.BaseExtEntity
+	Name               string   `json:"name"`
+	Rate               int64    `json:"rate"`
+	Burst              int64    `json:"burst"`
+	Shared             bool     `json:"shared"`
+	IdentityRoles      []string `json:"identityRoles"`
+	ServiceRoles       []string `json:"serviceRoles"`
+	EdgeRouterPolicies []string `json:"edgeRouterPolicies"`
+}
+
+func (entity *BandwidthLimit) GetName() string {
+	return entity.Name
+}
+
+func (entity *BandwidthLimit) GetEntityType() string {
+	return EntityTypeBandwidthLimits
+}
+
+// BandwidthLimitTargets holds the identities, services and routers a bandwidth limit applies to. If one of the
+// All flags is set, the limit isn't restricted along that dimension and the matching id list is empty.
+type BandwidthLimitTargets struct {
+	IdentityIds   []string
+	AllIdentities bool
+	ServiceIds    []string
+	AllServices   bool
+	RouterIds     []string
+	AllRouters    bool
+}
+
+var _ BandwidthLimitStore = (*bandwidthLimitStoreImpl)(nil)
+
+type BandwidthLimitStore interface {
+	Store[*BandwidthLimit]
+	NameIndexed
+	LoadOneByName(tx *bbolt.Tx, name string) (*BandwidthLimit, error)
+	ResolveTargets(tx *bbolt.Tx, entity *BandwidthLimit) (*BandwidthLimitTargets, error)
+}
+
+func newBandwidthLimitStore(stores *stores) *bandwidthLimitStoreImpl {
+	store := &bandwidthLimitStoreImpl{}
+	store.baseStore = newBaseStore[*BandwidthLimit](stores, store)
+	store.InitImpl(store)
+	return store
+}
+
+type bandwidthLimitStoreImpl struct {
+	*baseStore[*BandwidthLimit]
+
+	indexName                boltz.ReadIndex
+	symbolEdgeRouterPolicies boltz.EntitySetSymbol
+}
+
+func (store *bandwidthLimitStoreImpl) GetNameIndex() boltz.ReadIndex {
+	return store.indexName
+}
+
+func (store *bandwidthLimitStoreImpl) initializeLocal() {
+	store.AddExtEntitySymbols()
+	store.indexName = store.addUniqueNameField()
+	store.AddSymbol(FieldBandwidthLimitRate, ast.NodeTypeInt64)
+	store.AddSymbol(FieldBandwidthLimitBurst, ast.NodeTypeInt64)
+	store.AddSymbol(FieldBandwidthLimitShared, ast.NodeTypeBool)
+	store.AddSetSymbol(FieldIdentityRoles, ast.NodeTypeString)
+	store.AddSetSymbol(FieldServiceRoles, ast.NodeTypeString)
+	store.symbolEdgeRouterPolicies = store.AddFkSetSymbol(FieldBandwidthLimitEdgeRouterPolicies, store.stores.edgeRouterPolicy)
+}
+
+func (store *bandwidthLimitStoreImpl) initializeLinked() {
+	store.AddLinkCollection(store.symbolEdgeRouterPolicies, store.stores.edgeRouterPolicy.symbolBandwidthLimits)
+}
+
+func (store *bandwidthLimitStoreImpl) NewEntity() *BandwidthLimit {
+	return &BandwidthLimit{}
+}
+
+func (store *bandwidthLimitStoreImpl) FillEntity(entity *BandwidthLimit, bucket *boltz.TypedBucket) {
+	entity.LoadBaseValues(bucket)
+	entity.Name = bucket.GetStringOrError(FieldName)
+	entity.Rate = bucket.GetInt64WithDefault(FieldBandwidthLimitRate, 0)
+	entity.Burst = bucket.GetInt64WithDefault(FieldBandwidthLimitBurst, 0)
+	entity.Shared = bucket.GetBoolWithDefault(FieldBandwidthLimitShared, false)
+	entity.IdentityRoles = bucket.GetStringList(FieldIdentityRoles)
+	entity.ServiceRoles = bucket.GetStringList(FieldServiceRoles)
+	entity.EdgeRouterPolicies = bucket.GetStringList(FieldBandwidthLimitEdgeRouterPolicies)
+}
+
+func (store *bandwidthLimitStoreImpl) PersistEntity(entity *BandwidthLimit, ctx *boltz.PersistContext) {
+	if err := validateRolesAndIds(FieldIdentityRoles, entity.IdentityRoles); err != nil {
+		ctx.Bucket.SetError(err)
+	}
+
+	if err := validateRolesAndIds(FieldServiceRoles, entity.ServiceRoles); err != nil {
+		ctx.Bucket.SetError(err)
+	}
+
+	entity.SetBaseValues(ctx)
+	ctx.SetString(FieldName, entity.Name)
+	ctx.SetInt64(FieldBandwidthLimitRate, entity.Rate)
+	ctx.SetInt64(FieldBandwidthLimitBurst, entity.Burst)
+	ctx.SetBool(FieldBandwidthLimitShared, entity.Shared)
+	ctx.SetStringList(FieldIdentityRoles, entity.IdentityRoles)
+	ctx.SetStringList(FieldServiceRoles, entity.ServiceRoles)
+	ctx.SetLinkedIds(FieldBandwidthLimitEdgeRouterPolicies, entity.EdgeRouterPolicies)
+}
+
+func (store *bandwidthLimitStoreImpl) LoadOneByName(tx *bbolt.Tx, name string) (*BandwidthLimit, error) {
+	id := store.indexName.Read(tx, []byte(name))
+	if id != nil {
+		return store.LoadById(tx, string(id))
+	}
+	return nil, nil
+}
+
+// ResolveTargets evaluates the limit's roles and edge router policies. Identity and service roles match any of the
+// given role attributes or ids. If edge router policies are given, the limit only applies to the identities and
+// edge routers granted by those policies.
+func (store *bandwidthLimitStoreImpl) ResolveTargets(tx *bbolt.Tx, entity *BandwidthLimit) (*BandwidthLimitTargets, error) {
+	result := &BandwidthLimitTargets{}
+
+	var policyIdentities map[string]struct{}
+	if len(entity.EdgeRouterPolicies) > 0 {
+		policyIdentities = map[string]struct{}{}
+		policyRouters := map[string]struct{}{}
+		for _, policyId := range entity.EdgeRouterPolicies {
+			for _, identityId := range store.stores.edgeRouterPolicy.symbolIdentities.EvalStringList(tx, []byte(policyId)) {
+				policyIdentities[identityId] = struct{}{}
+			}
+			for _, routerId := range store.stores.edgeRouterPolicy.symbolEdgeRouters.EvalStringList(tx, []byte(policyId)) {
+				policyRouters[routerId] = struct{}{}
+			}
+		}
+		result.RouterIds = sortedKeys(policyRouters)
+	} else {
+		result.AllRouters = true
+	}
+
+	if len(entity.IdentityRoles) == 0 || stringz.Contains(entity.IdentityRoles, AllRole) {
+		if policyIdentities == nil {
+			result.AllIdentities = true
+		} else {
+			result.IdentityIds = sortedKeys(policyIdentities)
+		}
+	} else {
+		ids, err := collectMatchingIds(tx, store.stores.identity.GetRoleAttributesCursorProvider, entity.IdentityRoles)
+		if err != nil {
+			return nil, err
+		}
+		for _, id := range ids {
+			if policyIdentities == nil {
+				result.IdentityIds = append(result.IdentityIds, id)
+			} else if _, ok := policyIdentities[id]; ok {
+				result.IdentityIds = append(result.IdentityIds, id)
+			}
+		}
+	}
+
+	if len(entity.ServiceRoles) == 0 || stringz.Contains(entity.ServiceRoles, AllRole) {
+		result.AllServices = true
+	} else {
+		ids, err := collectMatchingIds(tx, store.stores.edgeService.GetRoleAttributesCursorProvider, entity.ServiceRoles)
+		if err != nil {
+			return nil, err
+		}
+		result.ServiceIds = ids
+	}
+
+	return result, nil
+}
+
+// collectMatchingIds returns the sorted ids of the entities matching any of the given roles
+func collectMatchingIds(tx *bbolt.Tx, f func([]string, string) (ast.SetCursorProvider, error), roles []string) ([]string, error) {
+	cursorProvider, err := f(roles, SemanticAnyOf)
+	if err != nil {
+		return nil, err
+	}
+
+	var result []string
+	for cursor := cursorProvider(tx, true); cursor.IsValid(); cursor.Next() {
+		result = append(result, string(cursor.Current()))
+	}
+	sort.Strings(result)
+	return result, nil
+}
+
+func sortedKeys(m map[string]struct{}) []string {
+	result := make([]string, 0, len(m))
+	for k := range m {
+		result = append(result, k)
+	}
+	sort.Strings(result)
+	return result
+}
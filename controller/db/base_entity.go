@@ -44,6 +44,9 @@ const (
 	EntityTypeAuthenticators            = "authenticators"
 	EntityTypePostureChecks             = "postureChecks"
 	EntityTypePostureCheckTypes         = "postureCheckTypes"
+	EntityTypeRoles                     = "roles"
+	EntityTypeBandwidthLimits           = "bandwidthLimits"
+	EntityTypeQuotas                    = "quotas"
 	EdgeBucket                          = "edge"
 
 	FieldName           = "name"
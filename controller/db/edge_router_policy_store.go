@@ -61,6 +61,7 @@ type edgeRouterPolicyStoreImpl struct {
 	symbolEdgeRouterRoles boltz.EntitySetSymbol
 	symbolIdentities      boltz.EntitySetSymbol
 	symbolEdgeRouters     boltz.EntitySetSymbol
+	symbolBandwidthLimits boltz.EntitySetSymbol
 
 	identityCollection   boltz.LinkCollection
 	edgeRouterCollection boltz.LinkCollection
@@ -79,6 +80,7 @@ func (store *edgeRouterPolicyStoreImpl) initializeLocal() {
 	store.symbolEdgeRouterRoles = store.AddPublicSetSymbol(FieldEdgeRouterRoles, ast.NodeTypeString)
 	store.symbolIdentities = store.AddFkSetSymbol(EntityTypeIdentities, store.stores.identity)
 	store.symbolEdgeRouters = store.AddFkSetSymbol(EntityTypeRouters, store.stores.edgeRouter)
+	store.symbolBandwidthLimits = store.AddFkSetSymbol(EntityTypeBandwidthLimits, store.stores.bandwidthLimit)
 
 	store.AddConstraint(boltz.NewSystemEntityEnforcementConstraint(store))
 }
@@ -62,6 +62,8 @@ const (
 	FieldIdentityExternalId                = "externalId"
 	FieldIdentityDisabledAt                = "disabledAt"
 	FieldIdentityDisabledUntil             = "disabledUntil"
+	FieldIdentityExpiresAt                 = "expiresAt"
+	FieldIdentityLastActivityAt            = "lastActivityAt"
 )
 
 func newIdentity(name string, identityTypeId string, roleAttributes ...string) *Identity {
@@ -112,6 +114,8 @@ type Identity struct {
 	DisabledAt                *time.Time                   `json:"disabledAt"`
 	DisabledUntil             *time.Time                   `json:"disabledUntil"`
 	Disabled                  bool                         `json:"disabled"`
+	ExpiresAt                 *time.Time                   `json:"expiresAt"`
+	LastActivityAt            *time.Time                   `json:"lastActivityAt"`
 	ServiceConfigs            map[string]map[string]string `json:"serviceConfigs"`
 	Interfaces                []*Interface                 `json:"interfaces"`
 }
@@ -124,6 +128,12 @@ func (entity *Identity) GetName() string {
 	return entity.Name
 }
 
+// IsExpired returns true if the identity has an expiry date which has passed. Expired identities are treated as
+// disabled
+func (entity *Identity) IsExpired() bool {
+	return entity.ExpiresAt != nil && !entity.ExpiresAt.After(time.Now())
+}
+
 var identityFieldMappings = map[string]string{FieldIdentityType: "identityTypeId"}
 
 var _ IdentityStore = (*identityStoreImpl)(nil)
@@ -204,6 +214,8 @@ func (store *identityStoreImpl) initializeLocal() {
 
 	store.AddSymbol(FieldIdentityIsAdmin, ast.NodeTypeBool)
 	store.AddSymbol(FieldIdentityIsDefaultAdmin, ast.NodeTypeBool)
+	store.AddSymbol(FieldIdentityExpiresAt, ast.NodeTypeDatetime)
+	store.AddSymbol(FieldIdentityLastActivityAt, ast.NodeTypeDatetime)
 
 	store.indexRoleAttributes.AddListener(store.rolesChanged)
 }
@@ -239,6 +251,8 @@ func (store *identityStoreImpl) FillEntity(entity *Identity, bucket *boltz.Typed
 	entity.Disabled = false
 	entity.DisabledAt = bucket.GetTime(FieldIdentityDisabledAt)
 	entity.DisabledUntil = bucket.GetTime(FieldIdentityDisabledUntil)
+	entity.ExpiresAt = bucket.GetTime(FieldIdentityExpiresAt)
+	entity.LastActivityAt = bucket.GetTime(FieldIdentityLastActivityAt)
 
 	if entity.DisabledAt != nil {
 		if entity.DisabledUntil == nil || entity.DisabledUntil.After(time.Now()) {
@@ -246,6 +260,10 @@ func (store *identityStoreImpl) FillEntity(entity *Identity, bucket *boltz.Typed
 		}
 	}
 
+	if entity.IsExpired() {
+		entity.Disabled = true
+	}
+
 	entity.SdkInfo = &SdkInfo{
 		Branch:     bucket.GetStringWithDefault(FieldIdentitySdkInfoBranch, ""),
 		Revision:   bucket.GetStringWithDefault(FieldIdentitySdkInfoRevision, ""),
@@ -304,6 +322,26 @@ func (store *identityStoreImpl) fillServiceConfig(entity *Identity, entityBucket
 	}
 }
 
+// recordLastActivity updates the last activity time of an identity, if the given time is more recent. This is
+// bookkeeping, so it's written directly to the identity bucket rather than generating entity change events
+func (store *identityStoreImpl) recordLastActivity(tx *bbolt.Tx, identityId string, lastActivityAt time.Time) error {
+	if lastActivityAt.IsZero() {
+		return nil
+	}
+
+	bucket := store.GetEntityBucket(tx, []byte(identityId))
+	if bucket == nil {
+		return nil
+	}
+
+	if current := bucket.GetTime(FieldIdentityLastActivityAt); current != nil && !lastActivityAt.After(*current) {
+		return nil
+	}
+
+	bucket.SetTime(FieldIdentityLastActivityAt, lastActivityAt, nil)
+	return bucket.GetError()
+}
+
 func (store *identityStoreImpl) PersistEntity(entity *Identity, ctx *boltz.PersistContext) {
 	ctx.WithFieldOverrides(identityFieldMappings)
 
@@ -329,6 +367,8 @@ func (store *identityStoreImpl) PersistEntity(entity *Identity, ctx *boltz.Persi
 
 	ctx.SetTimeP(FieldIdentityDisabledAt, entity.DisabledAt)
 	ctx.SetTimeP(FieldIdentityDisabledUntil, entity.DisabledUntil)
+	ctx.SetTimeP(FieldIdentityExpiresAt, entity.ExpiresAt)
+	ctx.SetTimeP(FieldIdentityLastActivityAt, entity.LastActivityAt)
 
 	//treat empty string and white space like nil
 	if entity.ExternalId != nil && len(strings.TrimSpace(*entity.ExternalId)) == 0 {
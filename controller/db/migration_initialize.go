@@ -209,6 +209,88 @@ var healthCheckSchema = map[string]interface{}{
 				"expectInBody": map[string]interface{}{"type": "string"},
 			},
 		},
+		"tlsCheck": map[string]interface{}{
+			"type":                 "object",
+			"additionalProperties": false,
+			"required": []interface{}{
+				"interval",
+				"timeout",
+				"address",
+			},
+			"properties": map[string]interface{}{
+				"address":            map[string]interface{}{"type": "string"},
+				"serverName":         map[string]interface{}{"type": "string"},
+				"insecureSkipVerify": map[string]interface{}{"type": "boolean"},
+				"expiryThreshold":    map[string]interface{}{"$ref": "#/definitions/duration"},
+				"interval":           map[string]interface{}{"$ref": "#/definitions/duration"},
+				"timeout":            map[string]interface{}{"$ref": "#/definitions/duration"},
+				"actions":            map[string]interface{}{"$ref": "#/definitions/actionList"},
+			},
+		},
+		"dnsCheck": map[string]interface{}{
+			"type":                 "object",
+			"additionalProperties": false,
+			"required": []interface{}{
+				"interval",
+				"timeout",
+				"hostname",
+			},
+			"properties": map[string]interface{}{
+				"hostname": map[string]interface{}{"type": "string"},
+				"resolver": map[string]interface{}{"type": "string"},
+				"recordType": map[string]interface{}{
+					"type": "string",
+					"enum": []interface{}{"A", "AAAA"},
+				},
+				"expectAddress": map[string]interface{}{"type": "string"},
+				"interval":      map[string]interface{}{"$ref": "#/definitions/duration"},
+				"timeout":       map[string]interface{}{"$ref": "#/definitions/duration"},
+				"actions":       map[string]interface{}{"$ref": "#/definitions/actionList"},
+			},
+		},
+		"grpcCheck": map[string]interface{}{
+			"type":                 "object",
+			"additionalProperties": false,
+			"required": []interface{}{
+				"interval",
+				"timeout",
+				"address",
+			},
+			"properties": map[string]interface{}{
+				"address":            map[string]interface{}{"type": "string"},
+				"service":            map[string]interface{}{"type": "string"},
+				"tls":                map[string]interface{}{"type": "boolean"},
+				"serverName":         map[string]interface{}{"type": "string"},
+				"insecureSkipVerify": map[string]interface{}{"type": "boolean"},
+				"interval":           map[string]interface{}{"$ref": "#/definitions/duration"},
+				"timeout":            map[string]interface{}{"$ref": "#/definitions/duration"},
+				"actions":            map[string]interface{}{"$ref": "#/definitions/actionList"},
+			},
+		},
+		"execCheck": map[string]interface{}{
+			"type":                 "object",
+			"additionalProperties": false,
+			"required": []interface{}{
+				"interval",
+				"timeout",
+				"command",
+			},
+			"properties": map[string]interface{}{
+				"command": map[string]interface{}{"type": "string"},
+				"args": map[string]interface{}{
+					"type":  "array",
+					"items": map[string]interface{}{"type": "string"},
+				},
+				"expectExitCode": map[string]interface{}{
+					"type":    "integer",
+					"minimum": float64(0),
+					"maximum": float64(255),
+				},
+				"interval": map[string]interface{}{"$ref": "#/definitions/duration"},
+				"timeout":  map[string]interface{}{"$ref": "#/definitions/duration"},
+				"actions":  map[string]interface{}{"$ref": "#/definitions/actionList"},
+			},
+		},
 		"portCheckList": map[string]interface{}{
 			"type": "array",
 			"items": map[string]interface{}{
@@ -221,6 +303,30 @@ var healthCheckSchema = map[string]interface{}{
 				"$ref": "#/definitions/httpCheck",
 			},
 		},
+		"tlsCheckList": map[string]interface{}{
+			"type": "array",
+			"items": map[string]interface{}{
+				"$ref": "#/definitions/tlsCheck",
+			},
+		},
+		"dnsCheckList": map[string]interface{}{
+			"type": "array",
+			"items": map[string]interface{}{
+				"$ref": "#/definitions/dnsCheck",
+			},
+		},
+		"grpcCheckList": map[string]interface{}{
+			"type": "array",
+			"items": map[string]interface{}{
+				"$ref": "#/definitions/grpcCheck",
+			},
+		},
+		"execCheckList": map[string]interface{}{
+			"type": "array",
+			"items": map[string]interface{}{
+				"$ref": "#/definitions/execCheck",
+			},
+		},
 	},
 	"properties": map[string]interface{}{
 		"portChecks": map[string]interface{}{
@@ -229,6 +335,18 @@ var healthCheckSchema = map[string]interface{}{
 		"httpChecks": map[string]interface{}{
 			"$ref": "#/definitions/httpCheckList",
 		},
+		"tlsChecks": map[string]interface{}{
+			"$ref": "#/definitions/tlsCheckList",
+		},
+		"dnsChecks": map[string]interface{}{
+			"$ref": "#/definitions/dnsCheckList",
+		},
+		"grpcChecks": map[string]interface{}{
+			"$ref": "#/definitions/grpcCheckList",
+		},
+		"execChecks": map[string]interface{}{
+			"$ref": "#/definitions/execCheckList",
+		},
 	},
 }
 
@@ -576,6 +694,27 @@ var interceptV1ConfigType = &ConfigType{
 				},
 				"description": "white list of source ips/cidrs that can be intercepted. all ips can be intercepted if this is not set.",
 			},
+			"udpOptions": map[string]interface{}{
+				"type":                 "object",
+				"additionalProperties": false,
+				"properties": map[string]interface{}{
+					"maxFlows": map[string]interface{}{
+						"type":        "integer",
+						"minimum":     float64(1),
+						"maximum":     float64(math.MaxUint32),
+						"description": "maximum number of concurrent udp flows (distinct source addresses) intercepted for this service. unlimited if not set.",
+					},
+					"flowLimitPolicy": map[string]interface{}{
+						"type":        "string",
+						"enum":        []interface{}{"drop-new", "drop-lru"},
+						"description": "what to do with a new udp flow when maxFlows is reached. 'drop-new' discards the new flow, 'drop-lru' closes the least recently used flow. defaults to 'drop-new'.",
+					},
+					"idleTimeoutSeconds": map[string]interface{}{
+						"$ref":        "#/definitions/timeoutSeconds",
+						"description": "how long a udp flow may be idle before it is closed. defaults to the tunneler's udp idle timeout.",
+					},
+				},
+			},
 		},
 		"required": []interface{}{
 			"protocols",
@@ -25,7 +25,7 @@ import (
 )
 
 const (
-	CurrentDbVersion = 43
+	CurrentDbVersion = 45
 	FieldVersion     = "version"
 )
 
@@ -205,6 +205,16 @@ func (m *Migrations) migrate(step *boltz.MigrationStep) int {
 		m.createOrUpdateConfigType(step, proxyConfigTypeV1)
 	}
 
+	if step.CurrentVersion < 44 {
+		step.SetError(m.stores.ConfigType.Update(step.Ctx, serverConfigTypeV1, nil))
+		step.SetError(m.stores.ConfigType.Update(step.Ctx, hostV1ConfigType, nil))
+		step.SetError(m.stores.ConfigType.Update(step.Ctx, hostV2ConfigType, nil))
+	}
+
+	if step.CurrentVersion < 45 {
+		step.SetError(m.stores.ConfigType.Update(step.Ctx, interceptV1ConfigType, nil))
+	}
+
 	// current version
 	if step.CurrentVersion <= CurrentDbVersion {
 		return CurrentDbVersion
@@ -0,0 +1,260 @@
+/*
+	(c) Copyright NetFoundry Inc.
+
+	Licensed under the Apache License, Version 2.0 (the "License");
+	you may not use this file except in compliance with the License.
+	You may obtain a copy of the License at
+
+	https://www.apache.org/licenses/LICENSE-2.0
+
+	Unless required by applicable law or agreed to in writing, software
+	distributed under the License is distributed on an "AS IS" BASIS,
+	WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+	See the License for the specific language governing permissions and
+	limitations under the License.
+*/
+
+package db
+
+import (
+	"time"
+
+	"github.com/openziti/foundation/v2/errorz"
+	"github.com/openziti/foundation/v2/stringz"
+	"github.com/openziti/storage/ast"
+	"github.com/openziti/storage/boltz"
+	"go.etcd.io/bbolt"
+)
+
+const (
+	FieldQuotaScope           = "scope"
+	FieldQuotaPeriod          = "period"
+	FieldQuotaSoftLimit       = "softLimit"
+	FieldQuotaHardLimit       = "hardLimit"
+	FieldQuotaHardLimitAction = "hardLimitAction"
+
+	QuotaScopeIdentity = "identity"
+	QuotaScopeService  = "service"
+
+	QuotaPeriodDay   = "day"
+	QuotaPeriodMonth = "month"
+
+	QuotaActionDeny     = "deny"
+	QuotaActionTeardown = "teardown"
+
+	quotaUsageBucket          = "usage"
+	fieldQuotaUsagePeriod     = "periodStart"
+	fieldQuotaUsageBytes      = "bytes"
+	fieldQuotaUsageSoftNotify = "softLimitExceeded"
+	fieldQuotaUsageHardNotify = "hardLimitExceeded"
+)
+
+// Quota caps the data used by the identities or services matching its roles over a day or month. Consumption is
+// tracked separately for each matching identity or service, depending on the scope. Limits are in bytes, with zero
+// meaning no limit.
+type Quota struct {
+	boltz.BaseExtEntity
+	Name            string   `json:"name"`
+	Scope           string   `json:"scope"`
+	Period          string   `json:"period"`
+	SoftLimit       int64    `json:"softLimit"`
+	HardLimit       int64    `json:"hardLimit"`
+	HardLimitAction string   `json:"hardLimitAction"`
+	IdentityRoles   []string `json:"identityRoles"`
+	ServiceRoles    []string `json:"serviceRoles"`
+}
+
+func (entity *Quota) GetName() string {
+	return entity.Name
+}
+
+func (entity *Quota) GetEntityType() string {
+	return EntityTypeQuotas
+}
+
+// QuotaUsage is the consumption of a single identity or service tracked by a quota, for the period starting at
+// PeriodStart
+type QuotaUsage struct {
+	SubjectId         string
+	PeriodStart       time.Time
+	Bytes             int64
+	SoftLimitExceeded bool
+	HardLimitExceeded bool
+}
+
+// QuotaTargets holds the identities and services a quota applies to. If one of the All flags is set, the quota
+// isn't restricted along that dimension and the matching id list is empty.
+type QuotaTargets struct {
+	IdentityIds   []string
+	AllIdentities bool
+	ServiceIds    []string
+	AllServices   bool
+}
+
+var _ QuotaStore = (*quotaStoreImpl)(nil)
+
+type QuotaStore interface {
+	Store[*Quota]
+	NameIndexed
+	LoadOneByName(tx *bbolt.Tx, name string) (*Quota, error)
+	ResolveTargets(tx *bbolt.Tx, entity *Quota) (*QuotaTargets, error)
+	GetUsage(tx *bbolt.Tx, quotaId string) ([]*QuotaUsage, error)
+	SetUsage(ctx boltz.MutateContext, quotaId string, usage []*QuotaUsage) error
+}
+
+func newQuotaStore(stores *stores) *quotaStoreImpl {
+	store := &quotaStoreImpl{}
+	store.baseStore = newBaseStore[*Quota](stores, store)
+	store.InitImpl(store)
+	return store
+}
+
+type quotaStoreImpl struct {
+	*baseStore[*Quota]
+
+	indexName boltz.ReadIndex
+}
+
+func (store *quotaStoreImpl) GetNameIndex() boltz.ReadIndex {
+	return store.indexName
+}
+
+func (store *quotaStoreImpl) initializeLocal() {
+	store.AddExtEntitySymbols()
+	store.indexName = store.addUniqueNameField()
+	store.AddSymbol(FieldQuotaScope, ast.NodeTypeString)
+	store.AddSymbol(FieldQuotaPeriod, ast.NodeTypeString)
+	store.AddSymbol(FieldQuotaSoftLimit, ast.NodeTypeInt64)
+	store.AddSymbol(FieldQuotaHardLimit, ast.NodeTypeInt64)
+	store.AddSymbol(FieldQuotaHardLimitAction, ast.NodeTypeString)
+	store.AddSetSymbol(FieldIdentityRoles, ast.NodeTypeString)
+	store.AddSetSymbol(FieldServiceRoles, ast.NodeTypeString)
+}
+
+func (store *quotaStoreImpl) initializeLinked() {}
+
+func (store *quotaStoreImpl) NewEntity() *Quota {
+	return &Quota{}
+}
+
+func (store *quotaStoreImpl) FillEntity(entity *Quota, bucket *boltz.TypedBucket) {
+	entity.LoadBaseValues(bucket)
+	entity.Name = bucket.GetStringOrError(FieldName)
+	entity.Scope = bucket.GetStringWithDefault(FieldQuotaScope, QuotaScopeIdentity)
+	entity.Period = bucket.GetStringWithDefault(FieldQuotaPeriod, QuotaPeriodMonth)
+	entity.SoftLimit = bucket.GetInt64WithDefault(FieldQuotaSoftLimit, 0)
+	entity.HardLimit = bucket.GetInt64WithDefault(FieldQuotaHardLimit, 0)
+	entity.HardLimitAction = bucket.GetStringWithDefault(FieldQuotaHardLimitAction, QuotaActionDeny)
+	entity.IdentityRoles = bucket.GetStringList(FieldIdentityRoles)
+	entity.ServiceRoles = bucket.GetStringList(FieldServiceRoles)
+}
+
+func (store *quotaStoreImpl) PersistEntity(entity *Quota, ctx *boltz.PersistContext) {
+	if err := validateRolesAndIds(FieldIdentityRoles, entity.IdentityRoles); err != nil {
+		ctx.Bucket.SetError(err)
+	}
+
+	if err := validateRolesAndIds(FieldServiceRoles, entity.ServiceRoles); err != nil {
+		ctx.Bucket.SetError(err)
+	}
+
+	entity.SetBaseValues(ctx)
+	ctx.SetString(FieldName, entity.Name)
+	_, scopeChanged := ctx.GetAndSetString(FieldQuotaScope, entity.Scope)
+	_, periodChanged := ctx.GetAndSetString(FieldQuotaPeriod, entity.Period)
+	ctx.SetInt64(FieldQuotaSoftLimit, entity.SoftLimit)
+	ctx.SetInt64(FieldQuotaHardLimit, entity.HardLimit)
+	ctx.SetString(FieldQuotaHardLimitAction, entity.HardLimitAction)
+	ctx.SetStringList(FieldIdentityRoles, entity.IdentityRoles)
+	ctx.SetStringList(FieldServiceRoles, entity.ServiceRoles)
+
+	// consumption is only meaningful for the scope and period it was tracked under
+	if !ctx.IsCreate && (scopeChanged || periodChanged) && ctx.Bucket.GetBucket(quotaUsageBucket) != nil {
+		ctx.Bucket.DeleteEntity(quotaUsageBucket)
+	}
+}
+
+func (store *quotaStoreImpl) LoadOneByName(tx *bbolt.Tx, name string) (*Quota, error) {
+	id := store.indexName.Read(tx, []byte(name))
+	if id != nil {
+		return store.LoadById(tx, string(id))
+	}
+	return nil, nil
+}
+
+// ResolveTargets evaluates the quota's roles. Identity and service roles match any of the given role attributes or
+// ids. Empty roles match everything.
+func (store *quotaStoreImpl) ResolveTargets(tx *bbolt.Tx, entity *Quota) (*QuotaTargets, error) {
+	result := &QuotaTargets{}
+
+	if len(entity.IdentityRoles) == 0 || stringz.Contains(entity.IdentityRoles, AllRole) {
+		result.AllIdentities = true
+	} else {
+		ids, err := collectMatchingIds(tx, store.stores.identity.GetRoleAttributesCursorProvider, entity.IdentityRoles)
+		if err != nil {
+			return nil, err
+		}
+		result.IdentityIds = ids
+	}
+
+	if len(entity.ServiceRoles) == 0 || stringz.Contains(entity.ServiceRoles, AllRole) {
+		result.AllServices = true
+	} else {
+		ids, err := collectMatchingIds(tx, store.stores.edgeService.GetRoleAttributesCursorProvider, entity.ServiceRoles)
+		if err != nil {
+			return nil, err
+		}
+		result.ServiceIds = ids
+	}
+
+	return result, nil
+}
+
+func (store *quotaStoreImpl) GetUsage(tx *bbolt.Tx, quotaId string) ([]*QuotaUsage, error) {
+	entityBucket := store.GetEntityBucket(tx, []byte(quotaId))
+	if entityBucket == nil {
+		return nil, boltz.NewNotFoundError(store.GetSingularEntityType(), "id", quotaId)
+	}
+
+	usageBucket := entityBucket.GetBucket(quotaUsageBucket)
+	if usageBucket == nil {
+		return nil, nil
+	}
+
+	var result []*QuotaUsage
+	err := usageBucket.ForEachTypedBucket(func(subjectId string, bucket *boltz.TypedBucket) error {
+		result = append(result, &QuotaUsage{
+			SubjectId:         subjectId,
+			PeriodStart:       bucket.GetTimeOrDefault(fieldQuotaUsagePeriod, time.Time{}),
+			Bytes:             bucket.GetInt64WithDefault(fieldQuotaUsageBytes, 0),
+			SoftLimitExceeded: bucket.GetBoolWithDefault(fieldQuotaUsageSoftNotify, false),
+			HardLimitExceeded: bucket.GetBoolWithDefault(fieldQuotaUsageHardNotify, false),
+		})
+		return nil
+	})
+	return result, err
+}
+
+// SetUsage records the consumption of the given subjects. Usage for quotas which have since been deleted is ignored.
+func (store *quotaStoreImpl) SetUsage(ctx boltz.MutateContext, quotaId string, usage []*QuotaUsage) error {
+	entityBucket := store.GetEntityBucket(ctx.Tx(), []byte(quotaId))
+	if entityBucket == nil {
+		return nil
+	}
+
+	usageBucket := entityBucket.GetOrCreateBucket(quotaUsageBucket)
+	for _, subjectUsage := range usage {
+		if subjectUsage.SubjectId == "" {
+			return errorz.NewFieldError("subject id is required", "subjectId", subjectUsage.SubjectId)
+		}
+		bucket := usageBucket.GetOrCreateBucket(subjectUsage.SubjectId)
+		bucket.SetTime(fieldQuotaUsagePeriod, subjectUsage.PeriodStart, nil)
+		bucket.SetInt64(fieldQuotaUsageBytes, subjectUsage.Bytes, nil)
+		bucket.SetBool(fieldQuotaUsageSoftNotify, subjectUsage.SoftLimitExceeded, nil)
+		bucket.SetBool(fieldQuotaUsageHardNotify, subjectUsage.HardLimitExceeded, nil)
+		if bucket.HasError() {
+			return bucket.GetError()
+		}
+	}
+	return usageBucket.GetError()
+}
@@ -0,0 +1,111 @@
+/*
+	(c) Copyright NetFoundry Inc.
+
+	Licensed under the Apache License, Version 2.0 (the "License");
+	you may not use this file except in compliance with the License.
+	You may obtain a copy of the License at
+
+	https://www.apache.org/licenses/LICENSE-2.0
+
+	Unless required by applicable law or agreed to in writing, software
+	distributed under the License is distributed on an "AS IS" BASIS,
+	WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+	See the License for the specific language governing permissions and
+	limitations under the License.
+*/
+
+package db
+
+import (
+	"github.com/openziti/storage/ast"
+	"github.com/openziti/storage/boltz"
+	"go.etcd.io/bbolt"
+)
+
+const (
+	FieldRolePermissions = "permissions"
+	FieldRoleScopeRoles  = "scopeRoles"
+)
+
+// Role grants a set of management permissions to the identities matched by IdentityRoles. Permissions are
+// of the form <entity type>.<verb>. If ScopeRoles is set, the permissions only apply to entities carrying
+// at least one of the given role attributes.
+type Role struct {
+	boltz.BaseExtEntity
+	Name          string   `json:"name"`
+	IdentityRoles []string `json:"identityRoles"`
+	Permissions   []string `json:"permissions"`
+	ScopeRoles    []string `json:"scopeRoles"`
+}
+
+func (entity *Role) GetName() string {
+	return entity.Name
+}
+
+func (entity *Role) GetEntityType() string {
+	return EntityTypeRoles
+}
+
+var _ RoleStore = (*roleStoreImpl)(nil)
+
+type RoleStore interface {
+	Store[*Role]
+	NameIndexed
+	LoadOneByName(tx *bbolt.Tx, name string) (*Role, error)
+}
+
+func newRoleStore(stores *stores) *roleStoreImpl {
+	store := &roleStoreImpl{}
+	store.baseStore = newBaseStore[*Role](stores, store)
+	store.InitImpl(store)
+	return store
+}
+
+type roleStoreImpl struct {
+	*baseStore[*Role]
+
+	indexName boltz.ReadIndex
+}
+
+func (store *roleStoreImpl) GetNameIndex() boltz.ReadIndex {
+	return store.indexName
+}
+
+func (store *roleStoreImpl) initializeLocal() {
+	store.AddExtEntitySymbols()
+	store.indexName = store.addUniqueNameField()
+	store.AddSetSymbol(FieldIdentityRoles, ast.NodeTypeString)
+	store.AddSetSymbol(FieldRolePermissions, ast.NodeTypeString)
+	store.AddSetSymbol(FieldRoleScopeRoles, ast.NodeTypeString)
+}
+
+func (store *roleStoreImpl) initializeLinked() {
+}
+
+func (store *roleStoreImpl) NewEntity() *Role {
+	return &Role{}
+}
+
+func (store *roleStoreImpl) FillEntity(entity *Role, bucket *boltz.TypedBucket) {
+	entity.LoadBaseValues(bucket)
+	entity.Name = bucket.GetStringOrError(FieldName)
+	entity.IdentityRoles = bucket.GetStringList(FieldIdentityRoles)
+	entity.Permissions = bucket.GetStringList(FieldRolePermissions)
+	entity.ScopeRoles = bucket.GetStringList(FieldRoleScopeRoles)
+}
+
+func (store *roleStoreImpl) PersistEntity(entity *Role, ctx *boltz.PersistContext) {
+	entity.SetBaseValues(ctx)
+	ctx.SetString(FieldName, entity.Name)
+	ctx.SetStringList(FieldIdentityRoles, entity.IdentityRoles)
+	ctx.SetStringList(FieldRolePermissions, entity.Permissions)
+	ctx.SetStringList(FieldRoleScopeRoles, entity.ScopeRoles)
+}
+
+func (store *roleStoreImpl) LoadOneByName(tx *bbolt.Tx, name string) (*Role, error) {
+	id := store.indexName.Read(tx, []byte(name))
+	if id != nil {
+		return store.LoadById(tx, string(id))
+	}
+	return nil, nil
+}
@@ -123,6 +123,10 @@ type Stores struct {
 	PostureCheck            PostureCheckStore
 	PostureCheckType        PostureCheckTypeStore
 	Mfa                     MfaStore
+	Role                    RoleStore
+	BandwidthLimit          BandwidthLimitStore
+	Quota                   QuotaStore
+	Audit                   *AuditLog
 	storeMap                map[reflect.Type]boltz.Store
 	lock                    sync.Mutex
 	checkables              []boltz.Checkable
@@ -225,6 +229,9 @@ type stores struct {
 	postureCheckType        *postureCheckTypeStoreImpl
 	apiSessionCertificate   *ApiSessionCertificateStoreImpl
 	mfa                     *MfaStoreImpl
+	role                    *roleStoreImpl
+	bandwidthLimit          *bandwidthLimitStoreImpl
+	quota                   *quotaStoreImpl
 
 	rateLimiter rate.RateLimiter
 }
@@ -279,6 +286,9 @@ func InitStores(db boltz.Db, rateLimiter rate.RateLimiter, signingCert *x509.Cer
 	internalStores.postureCheck = newPostureCheckStore(internalStores)
 	internalStores.postureCheckType = newPostureCheckTypeStore(internalStores)
 	internalStores.mfa = newMfaStore(internalStores)
+	internalStores.role = newRoleStore(internalStores)
+	internalStores.bandwidthLimit = newBandwidthLimitStore(internalStores)
+	internalStores.quota = newQuotaStore(internalStores)
 
 	externalStores := &Stores{
 		internal: internalStores,
@@ -311,6 +321,10 @@ func InitStores(db boltz.Db, rateLimiter rate.RateLimiter, signingCert *x509.Cer
 		PostureCheck:            internalStores.postureCheck,
 		PostureCheckType:        internalStores.postureCheckType,
 		Mfa:                     internalStores.mfa,
+		Role:                    internalStores.role,
+		BandwidthLimit:          internalStores.bandwidthLimit,
+		Quota:                   internalStores.quota,
+		Audit:                   &AuditLog{},
 
 		storeMap: make(map[reflect.Type]boltz.Store),
 	}
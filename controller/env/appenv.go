@@ -693,6 +693,10 @@ func (ae *AppEnv) ProcessZtSession(rc *response.RequestContext, ztSession string
 
 		if rc.Identity.IsAdmin || rc.Identity.IsDefaultAdmin {
 			rc.ActivePermissions = append(rc.ActivePermissions, permissions.AdminPermission)
+		} else if !isPartialAuth {
+			if err := ae.addRoleGrants(rc); err != nil {
+				return err
+			}
 		}
 	}
 
@@ -774,11 +778,23 @@ func (ae *AppEnv) ProcessJwt(rc *response.RequestContext, token *jwt.Token) erro
 
 	if rc.Identity.IsAdmin || rc.Identity.IsDefaultAdmin {
 		rc.ActivePermissions = append(rc.ActivePermissions, permissions.AdminPermission)
+	} else if err = ae.addRoleGrants(rc); err != nil {
+		return err
 	}
 
 	return nil
 }
 
+// addRoleGrants adds the grants from all roles matching the request's identity to the active permissions
+func (ae *AppEnv) addRoleGrants(rc *response.RequestContext) error {
+	grants, err := ae.GetManagers().Role.GetGrantsForIdentity(rc.Identity)
+	if err != nil {
+		return err
+	}
+	rc.ActivePermissions = append(rc.ActivePermissions, grants...)
+	return nil
+}
+
 // FillRequestContext extracts authentication information from the HTTP request
 // and populates the request context with session or JWT token data.
 func (ae *AppEnv) FillRequestContext(rc *response.RequestContext) error {
@@ -891,6 +907,10 @@ func NewAppEnv(host HostController) (*AppEnv, error) {
 		return nil, err
 	}
 
+	if cfg.Audit.Enabled {
+		stores.Audit.Enable(stores, cfg.Audit.Excluded)
+	}
+
 	clientSpec, err := loads.Embedded(clientServer.SwaggerJSON, clientServer.FlatSwaggerJSON)
 	if err != nil {
 		pfxlog.Logger().Fatalln(err)
@@ -1186,11 +1206,9 @@ func (ae *AppEnv) IsAllowed(responderFunc func(ae *AppEnv, rc *response.RequestC
 			return
 		}
 
-		for _, permission := range permissions {
-			if !permission.IsAllowed(rc.ActivePermissions...) {
-				rc.RespondWithApiError(errorz.NewUnauthorized())
-				return
-			}
+		if !ae.HasPermissions(rc, permissions...) {
+			rc.RespondWithApiError(errorz.NewUnauthorized())
+			return
 		}
 
 		responderFunc(ae, rc)
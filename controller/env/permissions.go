@@ -0,0 +1,151 @@
+/*
+	(c) Copyright NetFoundry Inc.
+
+	Licensed under the Apache License, Version 2.0 (the "License");
+	you may not use this file except in compliance with the License.
+	You may obtain a copy of the License at
+
+	https://www.apache.org/licenses/LICENSE-2.0
+
+	Unless required by applicable law or agreed to in writing, software
+	distributed under the License is distributed on an "AS IS" BASIS,
+	WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+	See the License for the specific language governing permissions and
+	limitations under the License.
+*/
+
+package env
+
+import (
+	"encoding/json"
+	"github.com/michaelquigley/pfxlog"
+	"github.com/openziti/foundation/v2/stringz"
+	"github.com/openziti/storage/boltz"
+	"github.com/openziti/ziti/controller/db"
+	"github.com/openziti/ziti/controller/internal/permissions"
+	"github.com/openziti/ziti/controller/response"
+	"go.etcd.io/bbolt"
+)
+
+// scopedEntityBody holds the fields of a create, update or patch request body relevant to permission checks
+type scopedEntityBody struct {
+	RoleAttributes *[]string `json:"roleAttributes"`
+	IsAdmin        *bool     `json:"isAdmin"`
+}
+
+// HasPermissions returns true if the request's active permissions satisfy all the given resolvers. Resolvers
+// which can be satisfied by grants scoped to role attributes are checked against the target entity or, for
+// lists, limit the results by setting the request's list scope.
+func (ae *AppEnv) HasPermissions(rc *response.RequestContext, resolvers ...permissions.Resolver) bool {
+	isAdmin := permissions.IsAdmin().IsAllowed(rc.ActivePermissions...)
+
+	for _, resolver := range resolvers {
+		if !resolver.IsAllowed(rc.ActivePermissions...) {
+			scoped, ok := resolver.(permissions.ScopedResolver)
+			if !ok {
+				return false
+			}
+			scopes := scoped.Scopes(rc.ActivePermissions...)
+			if len(scopes) == 0 || !ae.isInScope(rc, scoped, scopes) {
+				return false
+			}
+		}
+
+		if !isAdmin {
+			if scoped, ok := resolver.(permissions.ScopedResolver); ok && scoped.GetEntityType() == "identities" {
+				if !ae.isIdentityChangeAllowed(rc, scoped.GetVerb()) {
+					return false
+				}
+			}
+		}
+	}
+	return true
+}
+
+func (ae *AppEnv) getScopedStore(entityType string) boltz.Store {
+	switch entityType {
+	case "edge-routers":
+		return ae.GetStores().EdgeRouter
+	case "identities":
+		return ae.GetStores().Identity
+	case "posture-checks":
+		return ae.GetStores().PostureCheck
+	case "services":
+		return ae.GetStores().EdgeService
+	}
+	return nil
+}
+
+func (ae *AppEnv) isInScope(rc *response.RequestContext, resolver permissions.ScopedResolver, scopes []string) bool {
+	store := ae.getScopedStore(resolver.GetEntityType())
+	if store == nil {
+		return false
+	}
+
+	entityId, _ := rc.GetEntityId()
+
+	if entityId != "" {
+		symbol, ok := store.GetSymbol(db.FieldRoleAttributes).(boltz.EntitySetSymbol)
+		if !ok {
+			return false
+		}
+
+		var roleAttributes []string
+		err := ae.GetDb().View(func(tx *bbolt.Tx) error {
+			roleAttributes = symbol.EvalStringList(tx, []byte(entityId))
+			return nil
+		})
+		if err != nil {
+			pfxlog.Logger().WithError(err).WithField("id", entityId).Error("unable to read role attributes for scope check")
+			return false
+		}
+
+		if !stringz.ContainsAny(roleAttributes, scopes...) {
+			return false
+		}
+	} else if resolver.GetVerb() == permissions.VerbRead {
+		rc.ListScope = scopes
+		return true
+	}
+
+	// creates must land in scope and updates must not move the entity out of it
+	if resolver.GetVerb() == permissions.VerbCreate || resolver.GetVerb() == permissions.VerbUpdate {
+		body := &scopedEntityBody{}
+		if len(rc.Body) > 0 {
+			if err := json.Unmarshal(rc.Body, body); err != nil {
+				return false
+			}
+		}
+		if body.RoleAttributes != nil {
+			return stringz.ContainsAny(*body.RoleAttributes, scopes...)
+		}
+		return resolver.GetVerb() == permissions.VerbUpdate
+	}
+
+	return true
+}
+
+// isIdentityChangeAllowed prevents identities which aren't admins from creating admins or modifying existing admins
+func (ae *AppEnv) isIdentityChangeAllowed(rc *response.RequestContext, verb string) bool {
+	if verb == permissions.VerbRead {
+		return true
+	}
+
+	if verb == permissions.VerbCreate || verb == permissions.VerbUpdate {
+		body := &scopedEntityBody{}
+		if len(rc.Body) > 0 && json.Unmarshal(rc.Body, body) == nil && body.IsAdmin != nil && *body.IsAdmin {
+			return false
+		}
+	}
+
+	if entityId, _ := rc.GetEntityId(); entityId != "" {
+		identity, err := ae.GetManagers().Identity.Read(entityId)
+		if err != nil {
+			// let the handler report missing identities
+			return boltz.IsErrNotFoundErr(err)
+		}
+		return !identity.IsAdmin && !identity.IsDefaultAdmin
+	}
+
+	return true
+}
@@ -24,7 +24,16 @@ import (
 const (
 	AlertEventNS = "alert"
 
-	AlertSourceTypeRouter = "router"
+	AlertSourceTypeRouter     = "router"
+	AlertSourceTypeController = "controller"
+
+	AlertSeverityInfo     = "info"
+	AlertSeverityWarning  = "warning"
+	AlertSeverityError    = "error"
+	AlertSeverityCritical = "critical"
+
+	AlertStateFiring   = "firing"
+	AlertStateResolved = "resolved"
 )
 
 // An AlertEvent is emitted when a ziti component generates an alert. Alerts are expected to be something that
@@ -32,13 +41,19 @@ const (
 //
 // Valid values for alert source type:
 //   - router
+//   - controller
 //
-// In the future, other alert sources may be supported, such as controller or SDK.
+// In the future, other alert sources may be supported, such as SDK.
 //
 // Valid values for severity:
+//   - info
+//   - warning
 //   - error
+//   - critical
 //
-// In the future, other severities may be supported, such as info or warning.
+// Alerts generated by controller alert rules have a lifecycle. They are emitted once with a state of firing when the
+// rule condition has held long enough, and once with a state of resolved when it no longer holds. Both events share
+// the same alert id.
 //
 // Example: An alert generated because a config referenced an interface which was currently unavailable.
 //
@@ -59,6 +74,25 @@ const (
 //	    "service"  : "3DPjxybDvXlo878CB0X2Zs",
 //	  }
 //	}
+//
+// Example: An alert generated by a controller alert rule because a router has been offline for too long
+//
+//	{
+//	  "namespace"         : "alert",
+//	  "event_src_id"      : "ctrl1",
+//	  "timestamp"         : "2025-03-11T10:12:41.185112713-04:00",
+//	  "alert_source_type" : "controller",
+//	  "alert_source_id"   : "ctrl1",
+//	  "severity"          : "critical",
+//	  "message"           : "router 'edge-east-1' is offline",
+//	  "details"           : null,
+//	  "related_entities"  : {
+//	    "router" : "DJFljCCoLs"
+//	  },
+//	  "rule"              : "router-offline",
+//	  "alert_id"          : "cm8i3dw2b0001kqh2x1ehkjbu",
+//	  "state"             : "firing"
+//	}
 type AlertEvent struct {
 	Namespace  string    `json:"namespace"`
 	EventSrcId string    `json:"event_src_id"`
@@ -81,6 +115,15 @@ type AlertEvent struct {
 
 	// Entities related to the alert. The map is keyed by entity type and the value is the entity
 	RelatedEntities map[string]string `json:"related_entities"`
+
+	// The name of the controller alert rule which generated the alert, if any
+	Rule string `json:"rule,omitempty"`
+
+	// The id of the alert, shared by its firing and resolved events. Only set for alerts generated by alert rules
+	AlertId string `json:"alert_id,omitempty"`
+
+	// Either firing or resolved. Only set for alerts generated by alert rules
+	State string `json:"state,omitempty"`
 }
 
 func (event *AlertEvent) String() string {
@@ -0,0 +1,110 @@
+/*
+	Copyright NetFoundry Inc.
+
+	Licensed under the Apache License, Version 2.0 (the "License");
+	you may not use this file except in compliance with the License.
+	You may obtain a copy of the License at
+
+	https://www.apache.org/licenses/LICENSE-2.0
+
+	Unless required by applicable law or agreed to in writing, software
+	distributed under the License is distributed on an "AS IS" BASIS,
+	WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+	See the License for the specific language governing permissions and
+	limitations under the License.
+*/
+
+package event
+
+import (
+	"fmt"
+	"time"
+)
+
+type BackupEventType string
+
+const (
+	BackupEventNS = "backup"
+
+	BackupCompleted BackupEventType = "completed"
+	BackupFailed    BackupEventType = "failed"
+)
+
+// A BackupEvent is emitted when the controller finishes a database backup.
+// BackupEvents can be of the following types:
+//   - completed - the backup was written to the backup target
+//   - failed - the backup could not be taken or written to the backup target
+//
+// Example: Backup Completed Event
+//
+//	{
+//	 "namespace": "backup",
+//	 "event_src_id": "ctrl1",
+//	 "timestamp": "2025-03-04T02:00:03.118541126-05:00",
+//	 "eventType": "completed",
+//	 "name": "ziti-ctrl-20250304-070000-1234.db.gz",
+//	 "target": "s3:https://s3.us-east-1.amazonaws.com/backups/ziti/",
+//	 "size": 1384120,
+//	 "raftIndex": 1234,
+//	 "durationMs": 3118,
+//	 "removed": [
+//	   "ziti-ctrl-20250225-070000-1101.db.gz"
+//	 ]
+//	}
+//
+// Example: Backup Failed Event
+//
+//	{
+//	 "namespace": "backup",
+//	 "event_src_id": "ctrl1",
+//	 "timestamp": "2025-03-04T02:00:01.003118541-05:00",
+//	 "eventType": "failed",
+//	 "name": "ziti-ctrl-20250304-070000-1234.db.gz",
+//	 "target": "sftp:backup@backups.example.com:22/ziti",
+//	 "durationMs": 1003,
+//	 "error": "ssh: handshake failed: ssh: unable to authenticate"
+//	}
+type BackupEvent struct {
+	Namespace  string    `json:"namespace"`
+	EventSrcId string    `json:"event_src_id"`
+	Timestamp  time.Time `json:"timestamp"`
+
+	// The backup event type. See above for set of valid types.
+	EventType BackupEventType `json:"eventType"`
+
+	// The name of the backup file.
+	Name string `json:"name,omitempty"`
+
+	// A description of where backups are being written.
+	Target string `json:"target"`
+
+	// The size of the backup file, after compression and encryption.
+	Size int64 `json:"size,omitempty"`
+
+	// The raft index of the backed up database. Zero if the controller is not clustered.
+	RaftIndex uint64 `json:"raftIndex,omitempty"`
+
+	// How long the backup took, in milliseconds.
+	DurationMs int64 `json:"durationMs"`
+
+	// The older backups which were removed to enforce the retention limit.
+	Removed []string `json:"removed,omitempty"`
+
+	// The reason the backup failed. Only populated for failed events.
+	Error string `json:"error,omitempty"`
+}
+
+func (event *BackupEvent) String() string {
+	return fmt.Sprintf("%v.%v time=%v name=%v target=%v error=%v",
+		event.Namespace, event.EventType, event.Timestamp, event.Name, event.Target, event.Error)
+}
+
+type BackupEventHandler interface {
+	AcceptBackupEvent(event *BackupEvent)
+}
+
+type BackupEventHandlerF func(event *BackupEvent)
+
+func (f BackupEventHandlerF) AcceptBackupEvent(event *BackupEvent) {
+	f(event)
+}
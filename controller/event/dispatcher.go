@@ -83,6 +83,12 @@ type Dispatcher interface {
 	AddAlertEventHandler(handler AlertEventHandler)
 	RemoveAlertEventHandler(handler AlertEventHandler)
 
+	AddBackupEventHandler(handler BackupEventHandler)
+	RemoveBackupEventHandler(handler BackupEventHandler)
+
+	AddIdentityLifecycleEventHandler(handler IdentityLifecycleEventHandler)
+	RemoveIdentityLifecycleEventHandler(handler IdentityLifecycleEventHandler)
+
 	AddCircuitEventHandler(handler CircuitEventHandler)
 	RemoveCircuitEventHandler(handler CircuitEventHandler)
 
@@ -98,6 +104,9 @@ type Dispatcher interface {
 	RemoveMetricsMessageHandler(handler MetricsMessageHandler)
 	NewFilteredMetricsAdapter(sourceFilter *regexp.Regexp, metricFilter *regexp.Regexp, handler MetricsEventHandler) MetricsMessageHandler
 
+	AddProbeEventHandler(handler ProbeEventHandler)
+	RemoveProbeEventHandler(handler ProbeEventHandler)
+
 	AddRouterEventHandler(handler RouterEventHandler)
 	RemoveRouterEventHandler(handler RouterEventHandler)
 
@@ -134,13 +143,16 @@ type Dispatcher interface {
 	AlertEventHandler
 	ApiSessionEventHandler
 	AuthenticationEventHandler
+	BackupEventHandler
 	CircuitEventHandler
 	ConnectEventHandler
 	ClusterEventHandler
 	EntityChangeEventHandler
+	IdentityLifecycleEventHandler
 	LinkEventHandler
 	MetricsEventHandler
 	MetricsMessageHandler
+	ProbeEventHandler
 	RouterEventHandler
 	SdkEventHandler
 	SessionEventHandler
@@ -34,6 +34,24 @@ func (d DispatcherMock) RemoveAlertEventHandler(handler AlertEventHandler) {}
 
 func (d DispatcherMock) AcceptAlertEvent(event *AlertEvent) {}
 
+func (d DispatcherMock) AddBackupEventHandler(handler BackupEventHandler) {}
+
+func (d DispatcherMock) RemoveBackupEventHandler(handler BackupEventHandler) {}
+
+func (d DispatcherMock) AcceptBackupEvent(event *BackupEvent) {}
+
+func (d DispatcherMock) AddProbeEventHandler(handler ProbeEventHandler) {}
+
+func (d DispatcherMock) RemoveProbeEventHandler(handler ProbeEventHandler) {}
+
+func (d DispatcherMock) AcceptProbeEvent(event *ProbeEvent) {}
+
+func (d DispatcherMock) AddIdentityLifecycleEventHandler(handler IdentityLifecycleEventHandler) {}
+
+func (d DispatcherMock) RemoveIdentityLifecycleEventHandler(handler IdentityLifecycleEventHandler) {}
+
+func (d DispatcherMock) AcceptIdentityLifecycleEvent(event *IdentityLifecycleEvent) {}
+
 func (d DispatcherMock) AcceptSessionEvent(event *SessionEvent) {}
 
 func (d DispatcherMock) AcceptAuthenticationEvent(event *AuthenticationEvent) {}
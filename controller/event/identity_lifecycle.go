@@ -0,0 +1,106 @@
+/*
+	Copyright NetFoundry Inc.
+
+	Licensed under the Apache License, Version 2.0 (the "License");
+	you may not use this file except in compliance with the License.
+	You may obtain a copy of the License at
+
+	https://www.apache.org/licenses/LICENSE-2.0
+
+	Unless required by applicable law or agreed to in writing, software
+	distributed under the License is distributed on an "AS IS" BASIS,
+	WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+	See the License for the specific language governing permissions and
+	limitations under the License.
+*/
+
+package event
+
+import (
+	"fmt"
+	"time"
+)
+
+type IdentityLifecycleEventType string
+
+const (
+	IdentityLifecycleEventNS = "identityLifecycle"
+
+	IdentityLifecycleExpiring IdentityLifecycleEventType = "expiring"
+	IdentityLifecycleExpired  IdentityLifecycleEventType = "expired"
+	IdentityLifecycleDisabled IdentityLifecycleEventType = "disabled"
+	IdentityLifecycleDeleted  IdentityLifecycleEventType = "deleted"
+
+	IdentityLifecycleReasonInactive          = "inactive"
+	IdentityLifecycleReasonEnrollmentExpired = "enrollmentExpired"
+)
+
+// An IdentityLifecycleEvent is emitted when the controller's identity lifecycle rules apply to an identity.
+// IdentityLifecycleEvents can be of the following types:
+//   - expiring - the identity will expire within the configured warning period. Emitted once per expiry date
+//   - expired - the identity's expiry date has passed. Expired identities can't authenticate
+//   - disabled - the identity was disabled because it had no activity for the configured period
+//   - deleted - the identity was deleted because it never enrolled and its enrollments expired
+//
+// Example: Identity Expiring Event
+//
+//	{
+//	 "namespace": "identityLifecycle",
+//	 "event_src_id": "ctrl1",
+//	 "timestamp": "2025-03-04T02:00:00.118541126-05:00",
+//	 "eventType": "expiring",
+//	 "identityId": "Ds.5xSVN3",
+//	 "identityName": "contractor-laptop-17",
+//	 "expiresAt": "2025-03-10T00:00:00Z"
+//	}
+//
+// Example: Identity Disabled Event
+//
+//	{
+//	 "namespace": "identityLifecycle",
+//	 "event_src_id": "ctrl1",
+//	 "timestamp": "2025-03-04T02:00:00.003118541-05:00",
+//	 "eventType": "disabled",
+//	 "identityId": "h5n2v0Q3k",
+//	 "identityName": "build-agent-4",
+//	 "lastActivityAt": "2024-12-01T17:22:41Z",
+//	 "reason": "inactive"
+//	}
+type IdentityLifecycleEvent struct {
+	Namespace  string    `json:"namespace"`
+	EventSrcId string    `json:"event_src_id"`
+	Timestamp  time.Time `json:"timestamp"`
+
+	// The identity lifecycle event type. See above for set of valid types.
+	EventType IdentityLifecycleEventType `json:"eventType"`
+
+	// The id of the identity.
+	IdentityId string `json:"identityId"`
+
+	// The name of the identity.
+	IdentityName string `json:"identityName"`
+
+	// When the identity expires or expired. Only populated for expiring and expired events.
+	ExpiresAt *time.Time `json:"expiresAt,omitempty"`
+
+	// The last time the identity was active. Only populated for disabled events.
+	LastActivityAt *time.Time `json:"lastActivityAt,omitempty"`
+
+	// Why the identity was disabled or deleted. One of inactive or enrollmentExpired.
+	Reason string `json:"reason,omitempty"`
+}
+
+func (event *IdentityLifecycleEvent) String() string {
+	return fmt.Sprintf("%v.%v time=%v identityId=%v identityName=%v reason=%v",
+		event.Namespace, event.EventType, event.Timestamp, event.IdentityId, event.IdentityName, event.Reason)
+}
+
+type IdentityLifecycleEventHandler interface {
+	AcceptIdentityLifecycleEvent(event *IdentityLifecycleEvent)
+}
+
+type IdentityLifecycleEventHandlerF func(event *IdentityLifecycleEvent)
+
+func (f IdentityLifecycleEventHandlerF) AcceptIdentityLifecycleEvent(event *IdentityLifecycleEvent) {
+	f(event)
+}
@@ -0,0 +1,106 @@
+/*
+	Copyright NetFoundry Inc.
+
+	Licensed under the Apache License, Version 2.0 (the "License");
+	you may not use this file except in compliance with the License.
+	You may obtain a copy of the License at
+
+	https://www.apache.org/licenses/LICENSE-2.0
+
+	Unless required by applicable law or agreed to in writing, software
+	distributed under the License is distributed on an "AS IS" BASIS,
+	WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+	See the License for the specific language governing permissions and
+	limitations under the License.
+*/
+
+package event
+
+import (
+	"fmt"
+	"time"
+)
+
+const (
+	ProbeEventNS = "probe"
+)
+
+// A ProbeEvent is emitted each time a synthetic probe scheduled by the controller completes. Probes are executed by
+// routers, and either measure the round trip time between two routers or dial a service using the router's probe
+// identity.
+//
+// Valid values for probe type:
+//   - routerPath
+//   - service
+//
+// Example: A service probe which succeeded
+//
+//	{
+//	  "namespace"    : "probe",
+//	  "event_src_id" : "ctrl1",
+//	  "timestamp"    : "2025-03-18T09:21:07.415129871-04:00",
+//	  "probe"        : "web",
+//	  "probe_type"   : "service",
+//	  "router_id"    : "DJFljCCoLs",
+//	  "service"      : "web",
+//	  "success"      : true,
+//	  "latency"      : 41833120
+//	}
+//
+// Example: A router path probe which failed
+//
+//	{
+//	  "namespace"        : "probe",
+//	  "event_src_id"     : "ctrl1",
+//	  "timestamp"        : "2025-03-18T09:21:37.415762316-04:00",
+//	  "probe"            : "east-west",
+//	  "probe_type"       : "routerPath",
+//	  "router_id"        : "DJFljCCoLs",
+//	  "target_router_id" : "oC8g5qNfRA",
+//	  "success"          : false,
+//	  "error"            : "no links to router oC8g5qNfRA"
+//	}
+type ProbeEvent struct {
+	Namespace  string    `json:"namespace"`
+	EventSrcId string    `json:"event_src_id"`
+	Timestamp  time.Time `json:"timestamp"`
+
+	// The name of the probe definition
+	Probe string `json:"probe"`
+
+	// The probe type. See above for valid values
+	ProbeType string `json:"probe_type"`
+
+	// The id of the router which executed the probe
+	RouterId string `json:"router_id"`
+
+	// The id of the router at the other end of the path, for router path probes
+	TargetRouterId string `json:"target_router_id,omitempty"`
+
+	// The name of the service dialed, for service probes
+	Service string `json:"service,omitempty"`
+
+	// Whether the probe succeeded
+	Success bool `json:"success"`
+
+	// The probe latency in nanoseconds. Only set for successful probes
+	Latency int64 `json:"latency,omitempty"`
+
+	// The reason the probe failed. Only set for failed probes
+	Error string `json:"error,omitempty"`
+}
+
+func (event *ProbeEvent) String() string {
+	return fmt.Sprintf("%v probe=%v type=%v router=%v success=%v latency=%v error=%v",
+		event.Namespace, event.Probe, event.ProbeType, event.RouterId, event.Success, time.Duration(event.Latency), event.Error)
+}
+
+type ProbeEventHandler interface {
+	AcceptProbeEvent(event *ProbeEvent)
+}
+
+type ProbeEventHandlerF func(event *ProbeEvent)
+
+func (f ProbeEventHandlerF) AcceptProbeEvent(event *ProbeEvent) {
+	f(event)
+}
@@ -68,9 +68,12 @@ func NewDispatcher(closeNotify <-chan struct{}) *Dispatcher {
 
 	result.RegisterEventTypeFunctions(event.AlertEventNS, result.registerAlertEventHandler, result.unregisterAlertEventHandler)
 	result.RegisterEventTypeFunctions(event.ApiSessionEventNS, result.registerApiSessionEventHandler, result.unregisterApiSessionEventHandler)
+	result.RegisterEventTypeFunctions(event.BackupEventNS, result.registerBackupEventHandler, result.unregisterBackupEventHandler)
 	result.RegisterEventTypeFunctions(event.AuthenticationEventNS, result.registerAuthenticationEventHandler, result.unregisterAuthenticationEventHandler)
 	result.RegisterEventTypeFunctions(event.CircuitEventNS, result.registerCircuitEventHandler, result.unregisterCircuitEventHandler)
 	result.RegisterEventTypeFunctions(event.ClusterEventNS, result.registerClusterEventHandler, result.unregisterClusterEventHandler)
+	result.RegisterEventTypeFunctions(event.IdentityLifecycleEventNS, result.registerIdentityLifecycleEventHandler, result.unregisterIdentityLifecycleEventHandler)
+	result.RegisterEventTypeFunctions(event.ProbeEventNS, result.registerProbeEventHandler, result.unregisterProbeEventHandler)
 	result.RegisterEventTypeFunctions(event.ConnectEventNS, result.registerConnectEventHandler, result.unregisterConnectEventHandler)
 	result.RegisterEventTypeFunctions(event.EntityChangeEventNS, result.registerEntityChangeEventHandler, result.unregisterEntityChangeEventHandler)
 	result.RegisterEventTypeFunctions(event.EntityCountEventNS, result.registerEntityCountEventHandler, result.unregisterEntityCountEventHandler)
@@ -100,11 +103,14 @@ var _ event.Dispatcher = (*Dispatcher)(nil)
 type Dispatcher struct {
 	ctrlId                    string
 	alertEventHandlers        concurrenz.CopyOnWriteSlice[event.AlertEventHandler]
+	backupEventHandlers       concurrenz.CopyOnWriteSlice[event.BackupEventHandler]
 	circuitEventHandlers      concurrenz.CopyOnWriteSlice[event.CircuitEventHandler]
 	entityChangeEventHandlers concurrenz.CopyOnWriteSlice[event.EntityChangeEventHandler]
+	identityLifecycleHandlers concurrenz.CopyOnWriteSlice[event.IdentityLifecycleEventHandler]
 	linkEventHandlers         concurrenz.CopyOnWriteSlice[event.LinkEventHandler]
 	metricsEventHandlers      concurrenz.CopyOnWriteSlice[event.MetricsEventHandler]
 	metricsMsgEventHandlers   concurrenz.CopyOnWriteSlice[event.MetricsMessageHandler]
+	probeEventHandlers        concurrenz.CopyOnWriteSlice[event.ProbeEventHandler]
 	routerEventHandlers       concurrenz.CopyOnWriteSlice[event.RouterEventHandler]
 	serviceEventHandlers      concurrenz.CopyOnWriteSlice[event.ServiceEventHandler]
 	terminatorEventHandlers   concurrenz.CopyOnWriteSlice[event.TerminatorEventHandler]
@@ -0,0 +1,59 @@
+/*
+	Copyright NetFoundry Inc.
+
+	Licensed under the Apache License, Version 2.0 (the "License");
+	you may not use this file except in compliance with the License.
+	You may obtain a copy of the License at
+
+	https://www.apache.org/licenses/LICENSE-2.0
+
+	Unless required by applicable law or agreed to in writing, software
+	distributed under the License is distributed on an "AS IS" BASIS,
+	WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+	See the License for the specific language governing permissions and
+	limitations under the License.
+*/
+
+package events
+
+import (
+	"reflect"
+
+	"github.com/openziti/ziti/controller/event"
+	"github.com/pkg/errors"
+)
+
+func (self *Dispatcher) AddIdentityLifecycleEventHandler(handler event.IdentityLifecycleEventHandler) {
+	self.identityLifecycleHandlers.Append(handler)
+}
+
+func (self *Dispatcher) RemoveIdentityLifecycleEventHandler(handler event.IdentityLifecycleEventHandler) {
+	self.identityLifecycleHandlers.Delete(handler)
+}
+
+func (self *Dispatcher) AcceptIdentityLifecycleEvent(event *event.IdentityLifecycleEvent) {
+	event.EventSrcId = self.ctrlId
+	go func() {
+		for _, handler := range self.identityLifecycleHandlers.Value() {
+			handler.AcceptIdentityLifecycleEvent(event)
+		}
+	}()
+}
+
+func (self *Dispatcher) registerIdentityLifecycleEventHandler(_ string, val interface{}, _ map[string]interface{}) error {
+	handler, ok := val.(event.IdentityLifecycleEventHandler)
+
+	if !ok {
+		return errors.Errorf("type %v doesn't implement github.com/openziti/ziti/controller/event/IdentityLifecycleEventHandler interface.", reflect.TypeOf(val))
+	}
+
+	self.AddIdentityLifecycleEventHandler(handler)
+
+	return nil
+}
+
+func (self *Dispatcher) unregisterIdentityLifecycleEventHandler(val interface{}) {
+	if handler, ok := val.(event.IdentityLifecycleEventHandler); ok {
+		self.RemoveIdentityLifecycleEventHandler(handler)
+	}
+}
@@ -0,0 +1,59 @@
+/*
+	Copyright NetFoundry Inc.
+
+	Licensed under the Apache License, Version 2.0 (the "License");
+	you may not use this file except in compliance with the License.
+	You may obtain a copy of the License at
+
+	https://www.apache.org/licenses/LICENSE-2.0
+
+	Unless required by applicable law or agreed to in writing, software
+	distributed under the License is distributed on an "AS IS" BASIS,
+	WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+	See the License for the specific language governing permissions and
+	limitations under the License.
+*/
+
+package events
+
+import (
+	"reflect"
+
+	"github.com/openziti/ziti/controller/event"
+	"github.com/pkg/errors"
+)
+
+func (self *Dispatcher) AddProbeEventHandler(handler event.ProbeEventHandler) {
+	self.probeEventHandlers.Append(handler)
+}
+
+func (self *Dispatcher) RemoveProbeEventHandler(handler event.ProbeEventHandler) {
+	self.probeEventHandlers.Delete(handler)
+}
+
+func (self *Dispatcher) AcceptProbeEvent(event *event.ProbeEvent) {
+	event.EventSrcId = self.ctrlId
+	go func() {
+		for _, handler := range self.probeEventHandlers.Value() {
+			handler.AcceptProbeEvent(event)
+		}
+	}()
+}
+
+func (self *Dispatcher) registerProbeEventHandler(_ string, val interface{}, _ map[string]interface{}) error {
+	handler, ok := val.(event.ProbeEventHandler)
+
+	if !ok {
+		return errors.Errorf("type %v doesn't implement github.com/openziti/ziti/controller/event/ProbeEventHandler interface.", reflect.TypeOf(val))
+	}
+
+	self.AddProbeEventHandler(handler)
+
+	return nil
+}
+
+func (self *Dispatcher) unregisterProbeEventHandler(val interface{}) {
+	if handler, ok := val.(event.ProbeEventHandler); ok {
+		self.RemoveProbeEventHandler(handler)
+	}
+}
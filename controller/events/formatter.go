@@ -106,6 +106,36 @@ func (event *JsonAlertEvent) Format() ([]byte, error) {
 	return MarshalJson(event)
 }
 
+type JsonProbeEvent event.ProbeEvent
+
+func (event *JsonProbeEvent) GetEventType() string {
+	return "probe"
+}
+
+func (event *JsonProbeEvent) Format() ([]byte, error) {
+	return MarshalJson(event)
+}
+
+type JsonBackupEvent event.BackupEvent
+
+func (event *JsonBackupEvent) GetEventType() string {
+	return "backup"
+}
+
+func (event *JsonBackupEvent) Format() ([]byte, error) {
+	return MarshalJson(event)
+}
+
+type JsonIdentityLifecycleEvent event.IdentityLifecycleEvent
+
+func (event *JsonIdentityLifecycleEvent) GetEventType() string {
+	return "identityLifecycle"
+}
+
+func (event *JsonIdentityLifecycleEvent) Format() ([]byte, error) {
+	return MarshalJson(event)
+}
+
 type JsonCircuitEvent event.CircuitEvent
 
 func (event *JsonCircuitEvent) GetEventType() string {
@@ -286,6 +316,18 @@ func (formatter *JsonFormatter) AcceptAlertEvent(evt *event.AlertEvent) {
 	formatter.AcceptLoggingEvent((*JsonAlertEvent)(evt))
 }
 
+func (formatter *JsonFormatter) AcceptBackupEvent(evt *event.BackupEvent) {
+	formatter.AcceptLoggingEvent((*JsonBackupEvent)(evt))
+}
+
+func (formatter *JsonFormatter) AcceptProbeEvent(evt *event.ProbeEvent) {
+	formatter.AcceptLoggingEvent((*JsonProbeEvent)(evt))
+}
+
+func (formatter *JsonFormatter) AcceptIdentityLifecycleEvent(evt *event.IdentityLifecycleEvent) {
+	formatter.AcceptLoggingEvent((*JsonIdentityLifecycleEvent)(evt))
+}
+
 func (formatter *JsonFormatter) AcceptCircuitEvent(evt *event.CircuitEvent) {
 	formatter.AcceptLoggingEvent((*JsonCircuitEvent)(evt))
 }
@@ -0,0 +1,67 @@
+/*
+	Copyright NetFoundry Inc.
+
+	Licensed under the Apache License, Version 2.0 (the "License");
+	you may not use this file except in compliance with the License.
+	You may obtain a copy of the License at
+
+	https://www.apache.org/licenses/LICENSE-2.0
+
+	Unless required by applicable law or agreed to in writing, software
+	distributed under the License is distributed on an "AS IS" BASIS,
+	WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+	See the License for the specific language governing permissions and
+	limitations under the License.
+*/
+
+package handler_ctrl
+
+import (
+	"time"
+
+	"github.com/michaelquigley/pfxlog"
+	"github.com/openziti/channel/v4"
+	"github.com/openziti/channel/v4/protobufs"
+	"github.com/openziti/ziti/common/pb/ctrl_pb"
+	"github.com/openziti/ziti/controller/model"
+	"github.com/openziti/ziti/controller/network"
+	"google.golang.org/protobuf/proto"
+)
+
+type circuitRehomeHandler struct {
+	baseHandler
+}
+
+func newCircuitRehomeHandler(r *model.Router, network *network.Network) *circuitRehomeHandler {
+	return &circuitRehomeHandler{
+		baseHandler: baseHandler{
+			router:  r,
+			network: network,
+		},
+	}
+}
+
+func (self *circuitRehomeHandler) ContentType() int32 {
+	return int32(ctrl_pb.ContentType_CircuitRehomeRequestType)
+}
+
+func (self *circuitRehomeHandler) HandleReceive(msg *channel.Message, ch channel.Channel) {
+	log := pfxlog.ContextLogger(ch.Label()).Entry
+
+	request := &ctrl_pb.CircuitRehomeRequest{}
+	if err := proto.Unmarshal(msg.Body, request); err != nil {
+		log.WithError(err).Error("could not unmarshal circuit re-home request")
+		return
+	}
+
+	go func() {
+		response := self.network.Rehoming.RehomeCircuits(self.router, request.Fragments)
+		err := protobufs.MarshalTyped(response).
+			ReplyTo(msg).
+			WithTimeout(5 * time.Second).
+			SendAndWaitForWire(ch)
+		if err != nil {
+			log.WithError(err).Error("failed to send circuit re-home response")
+		}
+	}()
+}
@@ -88,6 +88,12 @@ func (bindHandler *BindHandler) BindChannel(binding channel.Binding) error {
 		Handler: validateErtTerminatorsRequestHandler.HandleReceive,
 	})
 
+	validateResidencyRequestHandler := newValidateResidencyHandler(bindHandler.network)
+	binding.AddTypedReceiveHandler(&channel.AsyncFunctionReceiveAdapter{
+		Type:    validateResidencyRequestHandler.ContentType(),
+		Handler: validateResidencyRequestHandler.HandleReceive,
+	})
+
 	tracesHandler := newStreamTracesHandler(bindHandler.network)
 	binding.AddTypedReceiveHandler(tracesHandler)
 	binding.AddCloseHandler(tracesHandler)
@@ -146,6 +146,7 @@ func handleResponse(router *model.Router, mgmtReq *channel.Message, msgsCh chan<
 	defer waitGroup.Done()
 
 	msg := channel.NewMessage(int32(ctrl_pb.ContentType_TogglePipeTracesRequestType), mgmtReq.Body)
+	trace.CopyPayloadCaptureHeaders(mgmtReq, msg)
 	response, err := msg.WithTimeout(5 * time.Second).SendForReply(router.Control)
 
 	if err != nil {
@@ -0,0 +1,85 @@
+/*
+	Copyright NetFoundry Inc.
+
+	Licensed under the Apache License, Version 2.0 (the "License");
+	you may not use this file except in compliance with the License.
+	You may obtain a copy of the License at
+
+	https://www.apache.org/licenses/LICENSE-2.0
+
+	Unless required by applicable law or agreed to in writing, software
+	distributed under the License is distributed on an "AS IS" BASIS,
+	WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+	See the License for the specific language governing permissions and
+	limitations under the License.
+*/
+
+package handler_mgmt
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/michaelquigley/pfxlog"
+	"github.com/openziti/channel/v4"
+	"github.com/openziti/channel/v4/protobufs"
+	"github.com/openziti/ziti/common/pb/mgmt_pb"
+	"github.com/openziti/ziti/controller/network"
+	"google.golang.org/protobuf/proto"
+)
+
+type validateResidencyHandler struct {
+	network *network.Network
+}
+
+func newValidateResidencyHandler(network *network.Network) *validateResidencyHandler {
+	return &validateResidencyHandler{network: network}
+}
+
+func (*validateResidencyHandler) ContentType() int32 {
+	return int32(mgmt_pb.ContentType_ValidateResidencyRequestType)
+}
+
+func (handler *validateResidencyHandler) HandleReceive(msg *channel.Message, ch channel.Channel) {
+	log := pfxlog.ContextLogger(ch.Label())
+	request := &mgmt_pb.ValidateResidencyRequest{}
+
+	var err error
+	var serviceCount uint64
+
+	if err = proto.Unmarshal(msg.Body, request); err == nil {
+		serviceCount, err = handler.network.ValidateResidency(request.Filter, func(detail *mgmt_pb.ServiceResidencyDetail) {
+			if !ch.IsClosed() {
+				if sendErr := protobufs.MarshalTyped(detail).WithTimeout(15 * time.Second).SendAndWaitForWire(ch); sendErr != nil {
+					log.WithError(sendErr).Error("send of service residency detail failed, closing channel")
+					if closeErr := ch.Close(); closeErr != nil {
+						log.WithError(closeErr).Error("failed to close channel")
+					}
+				}
+			} else {
+				log.Info("channel closed, unable to send service residency detail")
+			}
+		})
+	}
+
+	response := &mgmt_pb.ValidateResidencyResponse{}
+	if err == nil {
+		response.Success = true
+		response.ServiceCount = serviceCount
+	} else {
+		response.Success = false
+		response.Message = fmt.Sprintf("%v: failed to validate residency: %v", handler.network.GetAppId(), err)
+	}
+
+	body, err := proto.Marshal(response)
+	if err != nil {
+		pfxlog.Logger().WithError(err).Error("unexpected error serializing ValidateResidencyResponse")
+		return
+	}
+
+	responseMsg := channel.NewMessage(int32(mgmt_pb.ContentType_ValidateResidencyResponseType), body)
+	responseMsg.ReplyTo(msg)
+	if err = ch.Send(responseMsg); err != nil {
+		pfxlog.Logger().WithError(err).Error("unexpected error sending ValidateResidencyResponse")
+	}
+}
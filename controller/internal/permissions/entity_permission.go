@@ -0,0 +1,233 @@
+/*
+	(c) Copyright NetFoundry Inc.
+
+	Licensed under the Apache License, Version 2.0 (the "License");
+	you may not use this file except in compliance with the License.
+	You may obtain a copy of the License at
+
+	https://www.apache.org/licenses/LICENSE-2.0
+
+	Unless required by applicable law or agreed to in writing, software
+	distributed under the License is distributed on an "AS IS" BASIS,
+	WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+	See the License for the specific language governing permissions and
+	limitations under the License.
+*/
+
+package permissions
+
+import (
+	"fmt"
+	"strings"
+)
+
+const (
+	VerbRead   = "read"
+	VerbCreate = "create"
+	VerbUpdate = "update"
+	VerbDelete = "delete"
+
+	// Wildcard may be used in place of the entity type or the verb of a grant
+	Wildcard = "*"
+
+	// ScopeSeparator separates a grant from the role attribute it is scoped to, ex: services.update#team-payments
+	ScopeSeparator = "#"
+)
+
+var verbs = []string{VerbRead, VerbCreate, VerbUpdate, VerbDelete}
+
+// explicitOnlyEntityTypes are not covered by a wildcard entity type, as managing them is close to being an
+// administrator. They may still be granted by name.
+var explicitOnlyEntityTypes = map[string]struct{}{
+	"api-sessions":         {},
+	"auth-policies":        {},
+	"authenticators":       {},
+	"cas":                  {},
+	"enrollments":          {},
+	"external-jwt-signers": {},
+}
+
+// scopableEntityTypes are entity types which carry role attributes, and so can be the target of scoped grants
+var scopableEntityTypes = map[string]struct{}{
+	"edge-routers":   {},
+	"identities":     {},
+	"posture-checks": {},
+	"services":       {},
+}
+
+// ScopedResolver is implemented by resolvers which may also be satisfied by grants limited to a set of
+// role attributes. The caller is responsible for checking that the target entity is in scope.
+type ScopedResolver interface {
+	Resolver
+	GetEntityType() string
+	GetVerb() string
+	Scopes(identityPerms ...string) []string
+}
+
+// EntityPermission requires the verb on the given entity type. Entity types are named as they appear in
+// the management API paths, ex: services, edge-routers, service-policies.
+type EntityPermission struct {
+	entityType string
+	verb       string
+}
+
+func CanRead(entityType string) *EntityPermission {
+	return &EntityPermission{entityType: entityType, verb: VerbRead}
+}
+
+func CanCreate(entityType string) *EntityPermission {
+	return &EntityPermission{entityType: entityType, verb: VerbCreate}
+}
+
+func CanUpdate(entityType string) *EntityPermission {
+	return &EntityPermission{entityType: entityType, verb: VerbUpdate}
+}
+
+func CanDelete(entityType string) *EntityPermission {
+	return &EntityPermission{entityType: entityType, verb: VerbDelete}
+}
+
+func (self *EntityPermission) GetEntityType() string {
+	return self.entityType
+}
+
+func (self *EntityPermission) GetVerb() string {
+	return self.verb
+}
+
+// IsAllowed returns true if the permissions include admin or an unscoped grant covering the entity type and verb
+func (self *EntityPermission) IsAllowed(identityPerms ...string) bool {
+	for _, p := range identityPerms {
+		if p == AdminPermission {
+			return true
+		}
+		if grant, err := ParseGrant(p); err == nil && grant.Scope == "" && grant.Covers(self.entityType, self.verb) {
+			return true
+		}
+	}
+	return false
+}
+
+// Scopes returns the role attributes of the scoped grants covering the entity type and verb
+func (self *EntityPermission) Scopes(identityPerms ...string) []string {
+	if !IsScopable(self.entityType) {
+		return nil
+	}
+	var result []string
+	for _, p := range identityPerms {
+		if grant, err := ParseGrant(p); err == nil && grant.Scope != "" && grant.Covers(self.entityType, self.verb) {
+			result = append(result, grant.Scope)
+		}
+	}
+	return result
+}
+
+// Grant is a single permission given to an identity by a role
+type Grant struct {
+	EntityType string
+	Verb       string
+	Scope      string
+}
+
+func (self *Grant) Covers(entityType, verb string) bool {
+	if self.Verb != Wildcard && self.Verb != verb {
+		return false
+	}
+	if self.EntityType == entityType {
+		return true
+	}
+	if self.EntityType == Wildcard {
+		_, explicitOnly := explicitOnlyEntityTypes[entityType]
+		return !explicitOnly
+	}
+	return false
+}
+
+func (self *Grant) String() string {
+	result := self.EntityType + "." + self.Verb
+	if self.Scope != "" {
+		result += ScopeSeparator + self.Scope
+	}
+	return result
+}
+
+// ParseGrant parses a grant of the form <entity type>.<verb>[#<role attribute>]
+func ParseGrant(val string) (*Grant, error) {
+	if val == AdminPermission || val == AuthenticatedPermission || val == PartiallyAuthenticatePermission {
+		return nil, fmt.Errorf("%s is not a grant", val)
+	}
+
+	grant := &Grant{}
+	if idx := strings.Index(val, ScopeSeparator); idx >= 0 {
+		grant.Scope = val[idx+1:]
+		val = val[:idx]
+		if grant.Scope == "" {
+			return nil, fmt.Errorf("grant %s has an empty scope", val)
+		}
+	}
+
+	idx := strings.LastIndex(val, ".")
+	if idx <= 0 || idx == len(val)-1 {
+		return nil, fmt.Errorf("invalid grant %s, expected <entity type>.<verb>", val)
+	}
+
+	grant.EntityType = val[:idx]
+	grant.Verb = val[idx+1:]
+
+	if grant.Verb != Wildcard && !isVerb(grant.Verb) {
+		return nil, fmt.Errorf("invalid verb %s in grant %s, expected one of %s or %s", grant.Verb, val, strings.Join(verbs, ", "), Wildcard)
+	}
+
+	return grant, nil
+}
+
+// ValidatePermission checks that the given role permission is well-formed
+func ValidatePermission(val string) error {
+	if strings.Contains(val, ScopeSeparator) {
+		return fmt.Errorf("invalid permission %s, scopes are set on the role, not on individual permissions", val)
+	}
+	_, err := ParseGrant(val)
+	return err
+}
+
+// IsScopable returns true if grants on the entity type may be limited by role attribute. A wildcard entity type
+// is scopable, but only matches scopable entity types when scoped.
+func IsScopable(entityType string) bool {
+	if entityType == Wildcard {
+		return true
+	}
+	_, ok := scopableEntityTypes[entityType]
+	return ok
+}
+
+// ScopedGrants expands a role's permissions into the grant strings carried in a request's active permissions.
+// Scopes are role attributes, with or without the leading #.
+func ScopedGrants(permissions []string, scopes []string) []string {
+	var result []string
+	for _, p := range permissions {
+		grant, err := ParseGrant(p)
+		if err != nil {
+			continue
+		}
+		if len(scopes) == 0 {
+			result = append(result, grant.String())
+			continue
+		}
+		for _, scope := range scopes {
+			if grant.EntityType == Wildcard || IsScopable(grant.EntityType) {
+				grant.Scope = strings.TrimPrefix(scope, "#")
+				result = append(result, grant.String())
+			}
+		}
+	}
+	return result
+}
+
+func isVerb(val string) bool {
+	for _, verb := range verbs {
+		if verb == val {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,81 @@
+/*
+	(c) Copyright NetFoundry Inc.
+
+	Licensed under the Apache License, Version 2.0 (the "License");
+	you may not use this file except in compliance with the License.
+	You may obtain a copy of the License at
+
+	https://www.apache.org/licenses/LICENSE-2.0
+
+	Unless required by applicable law or agreed to in writing, software
+	distributed under the License is distributed on an "AS IS" BASIS,
+	WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+	See the License for the specific language governing permissions and
+	limitations under the License.
+*/
+
+package permissions
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestEntityPermission(t *testing.T) {
+	t.Run("admin is allowed everything", func(t *testing.T) {
+		req := require.New(t)
+		req.True(CanDelete("cas").IsAllowed(AdminPermission))
+		req.True(CanRead("services").IsAllowed(AuthenticatedPermission, AdminPermission))
+	})
+
+	t.Run("grants match entity type and verb", func(t *testing.T) {
+		req := require.New(t)
+		req.True(CanUpdate("services").IsAllowed("services.update"))
+		req.True(CanUpdate("services").IsAllowed("services.*"))
+		req.False(CanDelete("services").IsAllowed("services.update"))
+		req.False(CanUpdate("configs").IsAllowed("services.update"))
+		req.False(CanRead("services").IsAllowed(AuthenticatedPermission))
+	})
+
+	t.Run("wildcard entity types exclude sensitive types", func(t *testing.T) {
+		req := require.New(t)
+		req.True(CanCreate("configs").IsAllowed("*.create"))
+		req.False(CanCreate("cas").IsAllowed("*.create"))
+		req.False(CanUpdate("auth-policies").IsAllowed("*.*"))
+		req.True(CanUpdate("auth-policies").IsAllowed("auth-policies.update"))
+	})
+
+	t.Run("scoped grants don't satisfy the unscoped check", func(t *testing.T) {
+		req := require.New(t)
+		perm := CanUpdate("services")
+		req.False(perm.IsAllowed("services.update#team-payments"))
+		req.Equal([]string{"team-payments", "team-billing"}, perm.Scopes("services.update#team-payments", "*.update#team-billing", "services.read#other"))
+		req.Empty(CanUpdate("configs").Scopes("*.update#team-payments"))
+	})
+}
+
+func TestParseGrant(t *testing.T) {
+	req := require.New(t)
+
+	grant, err := ParseGrant("service-policies.delete#team")
+	req.NoError(err)
+	req.Equal("service-policies", grant.EntityType)
+	req.Equal(VerbDelete, grant.Verb)
+	req.Equal("team", grant.Scope)
+	req.Equal("service-policies.delete#team", grant.String())
+
+	for _, invalid := range []string{"services", "services.", ".read", "services.write", "services.read#", AdminPermission} {
+		_, err = ParseGrant(invalid)
+		req.Error(err, invalid)
+	}
+
+	req.Error(ValidatePermission("services.read#team"))
+	req.NoError(ValidatePermission("*.*"))
+}
+
+func TestScopedGrants(t *testing.T) {
+	req := require.New(t)
+	req.Equal([]string{"services.update", "configs.read"}, ScopedGrants([]string{"services.update", "configs.read"}, nil))
+	req.Equal([]string{"services.update#a", "services.update#b"}, ScopedGrants([]string{"services.update", "configs.read"}, []string{"#a", "#b"}))
+}
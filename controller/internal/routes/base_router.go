@@ -132,6 +132,8 @@ func ListWithEnvelopeFactory(rc *response.RequestContext, toEnvelope ApiListEnve
 		return
 	}
 
+	qo.Scope = rc.ListScope
+
 	result, err := f(rc, qo)
 
 	if err != nil {
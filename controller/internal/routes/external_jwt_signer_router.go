@@ -52,27 +52,27 @@ func (r *ExternalJwtSignerRouter) Register(ae *env.AppEnv) {
 
 	// management
 	ae.ManagementApi.ExternalJWTSignerDeleteExternalJWTSignerHandler = extJwtManagement.DeleteExternalJWTSignerHandlerFunc(func(params extJwtManagement.DeleteExternalJWTSignerParams, _ interface{}) middleware.Responder {
-		return ae.IsAllowed(r.DeleteForManagement, params.HTTPRequest, params.ID, "", permissions.IsAdmin())
+		return ae.IsAllowed(r.DeleteForManagement, params.HTTPRequest, params.ID, "", permissions.CanDelete(EntityNameExternalJwtSigner))
 	})
 
 	ae.ManagementApi.ExternalJWTSignerDetailExternalJWTSignerHandler = extJwtManagement.DetailExternalJWTSignerHandlerFunc(func(params extJwtManagement.DetailExternalJWTSignerParams, _ interface{}) middleware.Responder {
-		return ae.IsAllowed(r.DetailForManagement, params.HTTPRequest, params.ID, "", permissions.IsAdmin())
+		return ae.IsAllowed(r.DetailForManagement, params.HTTPRequest, params.ID, "", permissions.CanRead(EntityNameExternalJwtSigner))
 	})
 
 	ae.ManagementApi.ExternalJWTSignerListExternalJWTSignersHandler = extJwtManagement.ListExternalJWTSignersHandlerFunc(func(params extJwtManagement.ListExternalJWTSignersParams, _ interface{}) middleware.Responder {
-		return ae.IsAllowed(r.ListForManagement, params.HTTPRequest, "", "", permissions.IsAdmin())
+		return ae.IsAllowed(r.ListForManagement, params.HTTPRequest, "", "", permissions.CanRead(EntityNameExternalJwtSigner))
 	})
 
 	ae.ManagementApi.ExternalJWTSignerUpdateExternalJWTSignerHandler = extJwtManagement.UpdateExternalJWTSignerHandlerFunc(func(params extJwtManagement.UpdateExternalJWTSignerParams, _ interface{}) middleware.Responder {
-		return ae.IsAllowed(func(ae *env.AppEnv, rc *response.RequestContext) { r.UpdateForManagement(ae, rc, params) }, params.HTTPRequest, params.ID, "", permissions.IsAdmin())
+		return ae.IsAllowed(func(ae *env.AppEnv, rc *response.RequestContext) { r.UpdateForManagement(ae, rc, params) }, params.HTTPRequest, params.ID, "", permissions.CanUpdate(EntityNameExternalJwtSigner))
 	})
 
 	ae.ManagementApi.ExternalJWTSignerCreateExternalJWTSignerHandler = extJwtManagement.CreateExternalJWTSignerHandlerFunc(func(params extJwtManagement.CreateExternalJWTSignerParams, _ interface{}) middleware.Responder {
-		return ae.IsAllowed(func(ae *env.AppEnv, rc *response.RequestContext) { r.CreateForManagement(ae, rc, params) }, params.HTTPRequest, "", "", permissions.IsAdmin())
+		return ae.IsAllowed(func(ae *env.AppEnv, rc *response.RequestContext) { r.CreateForManagement(ae, rc, params) }, params.HTTPRequest, "", "", permissions.CanCreate(EntityNameExternalJwtSigner))
 	})
 
 	ae.ManagementApi.ExternalJWTSignerPatchExternalJWTSignerHandler = extJwtManagement.PatchExternalJWTSignerHandlerFunc(func(params extJwtManagement.PatchExternalJWTSignerParams, _ interface{}) middleware.Responder {
-		return ae.IsAllowed(func(ae *env.AppEnv, rc *response.RequestContext) { r.PatchForManagement(ae, rc, params) }, params.HTTPRequest, params.ID, "", permissions.IsAdmin())
+		return ae.IsAllowed(func(ae *env.AppEnv, rc *response.RequestContext) { r.PatchForManagement(ae, rc, params) }, params.HTTPRequest, params.ID, "", permissions.CanUpdate(EntityNameExternalJwtSigner))
 	})
 }
 
@@ -22,6 +22,9 @@ import (
 	"github.com/openziti/foundation/v2/errorz"
 	"github.com/openziti/storage/ast"
 	"github.com/openziti/storage/boltz"
+	"github.com/openziti/ziti/controller/db"
+	"strconv"
+	"strings"
 )
 
 const (
@@ -34,6 +37,8 @@ type PublicQueryOptions struct {
 	Predicate string
 	Sort      string
 	Paging    *Paging
+	// Scope limits results to entities with at least one of the given role attributes
+	Scope []string
 }
 
 func (qo *PublicQueryOptions) String() string {
@@ -57,6 +62,12 @@ func (qo *PublicQueryOptions) getFullQuery(store boltz.Store) (ast.Query, error)
 		return nil, errorz.NewInvalidFilter(err)
 	}
 
+	if len(qo.Scope) > 0 {
+		if err = qo.applyScope(store, query); err != nil {
+			return nil, err
+		}
+	}
+
 	pfxlog.Logger().Debugf("query: %v", qo)
 
 	if qo.Paging != nil {
@@ -95,6 +106,26 @@ func (qo *PublicQueryOptions) getFullQuery(store boltz.Store) (ast.Query, error)
 	return query, nil
 }
 
+// applyScope restricts the query to entities in scope. It fails closed if the store has no role attributes.
+func (qo *PublicQueryOptions) applyScope(store boltz.Store, query ast.Query) error {
+	if store.GetSymbol(db.FieldRoleAttributes) == nil {
+		return errorz.NewUnauthorized()
+	}
+
+	var quoted []string
+	for _, scope := range qo.Scope {
+		quoted = append(quoted, strconv.Quote(scope))
+	}
+
+	scopeQuery, err := ast.Parse(store, fmt.Sprintf("anyOf(%s) in [%s]", db.FieldRoleAttributes, strings.Join(quoted, ",")))
+	if err != nil {
+		return err
+	}
+
+	query.SetPredicate(ast.NewAndExprNode(query.GetPredicate(), scopeQuery.GetPredicate()))
+	return nil
+}
+
 type Paging struct {
 	Offset    int64
 	Limit     int64
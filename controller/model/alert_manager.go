@@ -0,0 +1,624 @@
+/*
+	Copyright NetFoundry Inc.
+
+	Licensed under the Apache License, Version 2.0 (the "License");
+	you may not use this file except in compliance with the License.
+	You may obtain a copy of the License at
+
+	https://www.apache.org/licenses/LICENSE-2.0
+
+	Unless required by applicable law or agreed to in writing, software
+	distributed under the License is distributed on an "AS IS" BASIS,
+	WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+	See the License for the specific language governing permissions and
+	limitations under the License.
+*/
+
+package model
+
+import (
+	"crypto/tls"
+	"crypto/x509"
+	"fmt"
+	"math"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/michaelquigley/pfxlog"
+	"github.com/openziti/foundation/v2/errorz"
+	nfpem "github.com/openziti/foundation/v2/pem"
+	"github.com/openziti/storage/ast"
+	"github.com/openziti/storage/boltz"
+	"github.com/openziti/ziti/common/eid"
+	"github.com/openziti/ziti/controller/config"
+	"github.com/openziti/ziti/controller/db"
+	"github.com/openziti/ziti/controller/event"
+	"github.com/pkg/errors"
+	"go.etcd.io/bbolt"
+)
+
+const (
+	AlertStatePending = "pending"
+	AlertStateFiring  = event.AlertStateFiring
+
+	AlertEntityTypeLink       = "link"
+	AlertEntityTypeService    = "service"
+	AlertEntityTypeRouter     = "router"
+	AlertEntityTypeController = "controller"
+)
+
+// Alert is an alert rule condition which currently holds for an entity. An alert is pending until the condition has
+// held for the rule's for duration, at which point it fires
+type Alert struct {
+	Id           string
+	Rule         string
+	Severity     string
+	State        string
+	EntityType   string
+	EntityId     string
+	EntityName   string
+	Message      string
+	PendingSince time.Time
+	FiringSince  *time.Time
+	SilencedBy   string
+	notified     bool
+}
+
+// AlertSilence suppresses the events of alerts matching the given rule and/or entity until it expires. Silenced
+// alerts are still tracked and reported by the management API
+type AlertSilence struct {
+	Id        string
+	Rule      string
+	EntityId  string
+	Comment   string
+	CreatedAt time.Time
+	ExpiresAt time.Time
+}
+
+func (self *AlertSilence) Matches(alert *Alert) bool {
+	return (self.Rule == "" || self.Rule == alert.Rule) && (self.EntityId == "" || self.EntityId == alert.EntityId)
+}
+
+type alertObservation struct {
+	entityType string
+	entityId   string
+	entityName string
+	message    string
+}
+
+type latencySample struct {
+	at      time.Time
+	latency time.Duration
+}
+
+// AlertManager evaluates the configured alert rules against the controller's view of the network. Alerts are
+// de-duplicated by rule and entity, so an alert event is only emitted when an alert starts firing and when it
+// resolves. Each controller evaluates the rules independently, and alerts and silences are kept in memory.
+type AlertManager struct {
+	env       Env
+	lock      sync.Mutex
+	alerts    map[string]*Alert
+	silences  map[string]*AlertSilence
+	latencies map[string][]latencySample
+}
+
+func NewAlertManager(env Env) *AlertManager {
+	result := &AlertManager{
+		env:       env,
+		alerts:    map[string]*Alert{},
+		silences:  map[string]*AlertSilence{},
+		latencies: map[string][]latencySample{},
+	}
+
+	if len(env.GetConfig().Alerts.Rules) > 0 {
+		go result.run()
+	}
+
+	return result
+}
+
+func (self *AlertManager) run() {
+	ticker := time.NewTicker(self.env.GetConfig().Alerts.EvaluationInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ticker.C:
+			self.evaluate(time.Now())
+		case <-self.env.GetCloseNotifyChannel():
+			return
+		}
+	}
+}
+
+// ListRules returns the configured alert rules
+func (self *AlertManager) ListRules() []*config.AlertRuleConfig {
+	return self.env.GetConfig().Alerts.Rules
+}
+
+// List returns the pending and firing alerts, oldest first
+func (self *AlertManager) List() []*Alert {
+	self.lock.Lock()
+	defer self.lock.Unlock()
+
+	var result []*Alert
+	for _, alert := range self.alerts {
+		alertCopy := *alert
+		result = append(result, &alertCopy)
+	}
+
+	sort.Slice(result, func(i, j int) bool {
+		if result[i].PendingSince.Equal(result[j].PendingSince) {
+			return result[i].Id < result[j].Id
+		}
+		return result[i].PendingSince.Before(result[j].PendingSince)
+	})
+
+	return result
+}
+
+// AddSilence validates and adds the given silence. Silences must match on rule and/or entity, and must expire in
+// the future
+func (self *AlertManager) AddSilence(silence *AlertSilence) error {
+	if silence.Rule == "" && silence.EntityId == "" {
+		return errorz.NewFieldError("a silence must match on rule and/or entity id", "rule", silence.Rule)
+	}
+
+	if silence.Rule != "" && self.getRule(silence.Rule) == nil {
+		return errorz.NewFieldError("no alert rule with the given name", "rule", silence.Rule)
+	}
+
+	now := time.Now()
+	if !silence.ExpiresAt.After(now) {
+		return errorz.NewFieldError("silences must expire in the future", "expiresAt", silence.ExpiresAt)
+	}
+
+	silence.Id = eid.New()
+	silence.CreatedAt = now
+
+	self.lock.Lock()
+	defer self.lock.Unlock()
+
+	self.silences[silence.Id] = silence
+	for _, alert := range self.alerts {
+		if alert.SilencedBy == "" && silence.Matches(alert) {
+			alert.SilencedBy = silence.Id
+		}
+	}
+
+	return nil
+}
+
+// RemoveSilence removes the silence with the given id. Firing alerts which are no longer silenced will emit their
+// firing events on the next evaluation
+func (self *AlertManager) RemoveSilence(id string) error {
+	self.lock.Lock()
+	defer self.lock.Unlock()
+
+	if _, found := self.silences[id]; !found {
+		return boltz.NewNotFoundError("alert-silence", "id", id)
+	}
+
+	delete(self.silences, id)
+	self.updateSilenced()
+	return nil
+}
+
+// ListSilences returns the unexpired silences, in order of expiry
+func (self *AlertManager) ListSilences() []*AlertSilence {
+	self.lock.Lock()
+	defer self.lock.Unlock()
+
+	self.removeExpiredSilences(time.Now())
+
+	var result []*AlertSilence
+	for _, silence := range self.silences {
+		silenceCopy := *silence
+		result = append(result, &silenceCopy)
+	}
+
+	sort.Slice(result, func(i, j int) bool {
+		return result[i].ExpiresAt.Before(result[j].ExpiresAt)
+	})
+
+	return result
+}
+
+func (self *AlertManager) getRule(name string) *config.AlertRuleConfig {
+	for _, rule := range self.ListRules() {
+		if rule.Name == name {
+			return rule
+		}
+	}
+	return nil
+}
+
+func (self *AlertManager) removeExpiredSilences(now time.Time) {
+	removed := false
+	for id, silence := range self.silences {
+		if !silence.ExpiresAt.After(now) {
+			delete(self.silences, id)
+			removed = true
+		}
+	}
+
+	if removed {
+		self.updateSilenced()
+	}
+}
+
+func (self *AlertManager) updateSilenced() {
+	for _, alert := range self.alerts {
+		alert.SilencedBy = ""
+		for _, silence := range self.silences {
+			if silence.Matches(alert) {
+				alert.SilencedBy = silence.Id
+				break
+			}
+		}
+	}
+}
+
+func (self *AlertManager) evaluate(now time.Time) {
+	rules := self.ListRules()
+	self.sampleLatencies(now, rules)
+
+	observations := map[*config.AlertRuleConfig][]*alertObservation{}
+	for _, rule := range rules {
+		ruleObservations, err := self.evaluateRule(rule, now)
+		if err != nil {
+			// keep the current alerts for the rule, rather than resolving them because the rule couldn't be evaluated
+			pfxlog.Logger().WithError(err).WithField("rule", rule.Name).Error("failed to evaluate alert rule")
+			continue
+		}
+		observations[rule] = ruleObservations
+	}
+
+	self.lock.Lock()
+	defer self.lock.Unlock()
+
+	self.removeExpiredSilences(now)
+
+	for rule, ruleObservations := range observations {
+		self.apply(rule, ruleObservations, now)
+	}
+}
+
+func (self *AlertManager) apply(rule *config.AlertRuleConfig, observations []*alertObservation, now time.Time) {
+	current := map[string]struct{}{}
+
+	for _, observation := range observations {
+		key := rule.Name + "/" + observation.entityType + "/" + observation.entityId
+		current[key] = struct{}{}
+
+		alert, found := self.alerts[key]
+		if !found {
+			alert = &Alert{
+				Id:           eid.New(),
+				Rule:         rule.Name,
+				Severity:     rule.Severity,
+				State:        AlertStatePending,
+				EntityType:   observation.entityType,
+				EntityId:     observation.entityId,
+				PendingSince: now,
+			}
+			for _, silence := range self.silences {
+				if silence.Matches(alert) {
+					alert.SilencedBy = silence.Id
+					break
+				}
+			}
+			self.alerts[key] = alert
+		}
+
+		alert.EntityName = observation.entityName
+		alert.Message = observation.message
+
+		if alert.State == AlertStatePending && now.Sub(alert.PendingSince) >= rule.For {
+			alert.State = AlertStateFiring
+			alert.FiringSince = &now
+			pfxlog.Logger().WithField("rule", rule.Name).WithField("alertId", alert.Id).Infof("alert firing: %s", alert.Message)
+		}
+
+		if alert.State == AlertStateFiring && !alert.notified && alert.SilencedBy == "" {
+			alert.notified = true
+			self.accept(alert, event.AlertStateFiring, now)
+		}
+	}
+
+	for key, alert := range self.alerts {
+		if alert.Rule != rule.Name {
+			continue
+		}
+		if _, found := current[key]; found {
+			continue
+		}
+
+		delete(self.alerts, key)
+		if alert.State == AlertStateFiring {
+			pfxlog.Logger().WithField("rule", rule.Name).WithField("alertId", alert.Id).Infof("alert resolved: %s", alert.Message)
+			if alert.notified {
+				self.accept(alert, event.AlertStateResolved, now)
+			}
+		}
+	}
+}
+
+func (self *AlertManager) accept(alert *Alert, state string, now time.Time) {
+	dispatcher := self.env.GetEventDispatcher()
+	if dispatcher == nil {
+		return
+	}
+
+	dispatcher.AcceptAlertEvent(&event.AlertEvent{
+		Namespace:       event.AlertEventNS,
+		Timestamp:       now,
+		AlertSourceType: event.AlertSourceTypeController,
+		AlertSourceId:   self.env.GetId(),
+		Severity:        alert.Severity,
+		Message:         alert.Message,
+		RelatedEntities: map[string]string{
+			alert.EntityType: alert.EntityId,
+		},
+		Rule:    alert.Rule,
+		AlertId: alert.Id,
+		State:   state,
+	})
+}
+
+func (self *AlertManager) evaluateRule(rule *config.AlertRuleConfig, now time.Time) ([]*alertObservation, error) {
+	switch rule.Type {
+	case config.AlertRuleTypeLinkLatency:
+		return self.evaluateLinkLatency(rule, now), nil
+	case config.AlertRuleTypeServiceTerminators:
+		return self.evaluateServiceTerminators(rule)
+	case config.AlertRuleTypeRouterOffline:
+		return self.evaluateRouterOffline(rule)
+	case config.AlertRuleTypeCertExpiry:
+		return self.evaluateCertExpiry(rule, now)
+	}
+	return nil, errors.Errorf("unsupported alert rule type '%s'", rule.Type)
+}
+
+// sampleLatencies records the latency of each connected link, keeping samples for the longest window of the link
+// latency rules
+func (self *AlertManager) sampleLatencies(now time.Time, rules []*config.AlertRuleConfig) {
+	var window time.Duration
+	for _, rule := range rules {
+		if rule.Type == config.AlertRuleTypeLinkLatency && rule.Window > window {
+			window = rule.Window
+		}
+	}
+
+	if window == 0 {
+		return
+	}
+
+	self.lock.Lock()
+	defer self.lock.Unlock()
+
+	current := map[string]struct{}{}
+	for _, link := range self.env.GetManagers().Link.LinksInMode(Connected) {
+		current[link.Id] = struct{}{}
+		latency := time.Duration(max(link.GetSrcLatency(), link.GetDstLatency()))
+
+		samples := append(self.latencies[link.Id], latencySample{at: now, latency: latency})
+		for len(samples) > 0 && now.Sub(samples[0].at) > window {
+			samples = samples[1:]
+		}
+		self.latencies[link.Id] = samples
+	}
+
+	for linkId := range self.latencies {
+		if _, found := current[linkId]; !found {
+			delete(self.latencies, linkId)
+		}
+	}
+}
+
+func (self *AlertManager) evaluateLinkLatency(rule *config.AlertRuleConfig, now time.Time) []*alertObservation {
+	self.lock.Lock()
+	defer self.lock.Unlock()
+
+	var result []*alertObservation
+	for linkId, samples := range self.latencies {
+		var latencies []time.Duration
+		for _, sample := range samples {
+			if now.Sub(sample.at) <= rule.Window {
+				latencies = append(latencies, sample.latency)
+			}
+		}
+
+		if len(latencies) == 0 {
+			continue
+		}
+
+		latency := percentile(latencies, rule.Percentile)
+		if latency <= rule.Latency {
+			continue
+		}
+
+		name := linkId
+		if link, found := self.env.GetManagers().Link.Get(linkId); found {
+			dstName := link.DstId
+			if dst := link.GetDest(); dst != nil {
+				dstName = dst.Name
+			}
+			name = fmt.Sprintf("%s -> %s", link.Src.Name, dstName)
+		}
+
+		result = append(result, &alertObservation{
+			entityType: AlertEntityTypeLink,
+			entityId:   linkId,
+			entityName: name,
+			message: fmt.Sprintf("p%v latency of link %s (%s) over %v is %v, above %v", rule.Percentile, linkId, name,
+				rule.Window, latency, rule.Latency),
+		})
+	}
+	return result
+}
+
+// percentile returns the given percentile of the values, using the nearest rank method
+func percentile(values []time.Duration, p float64) time.Duration {
+	sort.Slice(values, func(i, j int) bool {
+		return values[i] < values[j]
+	})
+	rank := int(math.Ceil(p / 100 * float64(len(values))))
+	return values[max(rank, 1)-1]
+}
+
+func (self *AlertManager) evaluateServiceTerminators(rule *config.AlertRuleConfig) ([]*alertObservation, error) {
+	store := self.env.GetStores().Service
+	filter, err := ast.Parse(store, rule.Filter)
+	if err != nil {
+		return nil, errors.Wrapf(err, "invalid filter '%s'", rule.Filter)
+	}
+
+	var result []*alertObservation
+	err = self.env.GetDb().View(func(tx *bbolt.Tx) error {
+		for cursor := store.IterateIds(tx, filter); cursor.IsValid(); cursor.Next() {
+			serviceId := string(cursor.Current())
+			count := len(store.GetRelatedEntitiesIdList(tx, serviceId, db.EntityTypeTerminators))
+			if count >= rule.MinTerminators {
+				continue
+			}
+
+			service, err := store.LoadById(tx, serviceId)
+			if err != nil {
+				return err
+			}
+
+			result = append(result, &alertObservation{
+				entityType: AlertEntityTypeService,
+				entityId:   serviceId,
+				entityName: service.Name,
+				message: fmt.Sprintf("service '%s' has %d terminators, fewer than the minimum of %d",
+					service.Name, count, rule.MinTerminators),
+			})
+		}
+		return nil
+	})
+	return result, err
+}
+
+// evaluateRouterOffline reports enabled routers which have enrolled, but aren't connected to this controller
+func (self *AlertManager) evaluateRouterOffline(rule *config.AlertRuleConfig) ([]*alertObservation, error) {
+	store := self.env.GetStores().Router
+	filter, err := ast.Parse(store, rule.Filter)
+	if err != nil {
+		return nil, errors.Wrapf(err, "invalid filter '%s'", rule.Filter)
+	}
+
+	var result []*alertObservation
+	err = self.env.GetDb().View(func(tx *bbolt.Tx) error {
+		for cursor := store.IterateIds(tx, filter); cursor.IsValid(); cursor.Next() {
+			routerId := string(cursor.Current())
+			if self.env.GetManagers().Router.IsConnected(routerId) {
+				continue
+			}
+
+			router, err := store.LoadById(tx, routerId)
+			if err != nil {
+				return err
+			}
+
+			if router.Disabled || router.Fingerprint == nil {
+				continue
+			}
+
+			result = append(result, &alertObservation{
+				entityType: AlertEntityTypeRouter,
+				entityId:   routerId,
+				entityName: router.Name,
+				message:    fmt.Sprintf("router '%s' is offline", router.Name),
+			})
+		}
+		return nil
+	})
+	return result, err
+}
+
+// evaluateCertExpiry reports edge routers selected by the rule filter whose certificates expire soon, as well as the
+// controller, if its identity or enrollment signing certificates expire soon
+func (self *AlertManager) evaluateCertExpiry(rule *config.AlertRuleConfig, now time.Time) ([]*alertObservation, error) {
+	expiresBy := now.Add(rule.ExpiresWithin)
+
+	var result []*alertObservation
+	if cert, desc := self.getFirstExpiringControllerCert(); cert != nil && cert.NotAfter.Before(expiresBy) {
+		result = append(result, &alertObservation{
+			entityType: AlertEntityTypeController,
+			entityId:   self.env.GetId(),
+			entityName: self.env.GetId(),
+			message: fmt.Sprintf("controller %s certificate '%s' expires at %s", desc, cert.Subject.CommonName,
+				cert.NotAfter.UTC().Format(time.RFC3339)),
+		})
+	}
+
+	store := self.env.GetStores().EdgeRouter
+	filter, err := ast.Parse(store, rule.Filter)
+	if err != nil {
+		return nil, errors.Wrapf(err, "invalid filter '%s'", rule.Filter)
+	}
+
+	err = self.env.GetDb().View(func(tx *bbolt.Tx) error {
+		for cursor := store.IterateIds(tx, filter); cursor.IsValid(); cursor.Next() {
+			router, err := store.LoadById(tx, string(cursor.Current()))
+			if err != nil {
+				return err
+			}
+
+			if router.CertPem == nil {
+				continue
+			}
+
+			certs := nfpem.PemStringToCertificates(*router.CertPem)
+			if len(certs) == 0 || !certs[0].NotAfter.Before(expiresBy) {
+				continue
+			}
+
+			result = append(result, &alertObservation{
+				entityType: AlertEntityTypeRouter,
+				entityId:   router.Id,
+				entityName: router.Name,
+				message: fmt.Sprintf("router '%s' certificate expires at %s", router.Name,
+					certs[0].NotAfter.UTC().Format(time.RFC3339)),
+			})
+		}
+		return nil
+	})
+	return result, err
+}
+
+func (self *AlertManager) getFirstExpiringControllerCert() (*x509.Certificate, string) {
+	var result *x509.Certificate
+	var resultDesc string
+
+	check := func(tlsCert *tls.Certificate, desc string) {
+		if tlsCert == nil {
+			return
+		}
+		cert := tlsCert.Leaf
+		if cert == nil && len(tlsCert.Certificate) > 0 {
+			cert, _ = x509.ParseCertificate(tlsCert.Certificate[0])
+		}
+		if cert != nil && (result == nil || cert.NotAfter.Before(result.NotAfter)) {
+			result = cert
+			resultDesc = desc
+		}
+	}
+
+	cfg := self.env.GetConfig()
+	if cfg.Id != nil && cfg.Id.Identity != nil {
+		check(cfg.Id.Cert(), "client")
+		for _, serverCert := range cfg.Id.ServerCert() {
+			check(serverCert, "server")
+		}
+	}
+
+	if cfg.Edge != nil && cfg.Edge.Enrollment.SigningCert != nil {
+		check(cfg.Edge.Enrollment.SigningCert.Cert(), "enrollment signing")
+	}
+
+	return result, resultDesc
+}
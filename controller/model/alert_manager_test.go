@@ -0,0 +1,255 @@
+/*
+	Copyright NetFoundry Inc.
+
+	Licensed under the Apache License, Version 2.0 (the "License");
+	you may not use this file except in compliance with the License.
+	You may obtain a copy of the License at
+
+	https://www.apache.org/licenses/LICENSE-2.0
+
+	Unless required by applicable law or agreed to in writing, software
+	distributed under the License is distributed on an "AS IS" BASIS,
+	WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+	See the License for the specific language governing permissions and
+	limitations under the License.
+*/
+
+package model
+
+import (
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/openziti/foundation/v2/errorz"
+	nfpem "github.com/openziti/foundation/v2/pem"
+	"github.com/openziti/storage/boltz"
+	"github.com/openziti/ziti/common/eid"
+	"github.com/openziti/ziti/controller/change"
+	"github.com/openziti/ziti/controller/config"
+	"github.com/openziti/ziti/controller/db"
+	"github.com/openziti/ziti/controller/event"
+	"github.com/openziti/ziti/controller/fields"
+	"github.com/openziti/ziti/controller/models"
+	"github.com/stretchr/testify/require"
+)
+
+type alertEventCollector struct {
+	event.DispatcherMock
+	lock   sync.Mutex
+	events []*event.AlertEvent
+}
+
+func (self *alertEventCollector) AcceptAlertEvent(evt *event.AlertEvent) {
+	self.lock.Lock()
+	defer self.lock.Unlock()
+	self.events = append(self.events, evt)
+}
+
+func (self *alertEventCollector) take() []*event.AlertEvent {
+	self.lock.Lock()
+	defer self.lock.Unlock()
+	result := self.events
+	self.events = nil
+	return result
+}
+
+func TestAlertRules(t *testing.T) {
+	ctx := NewTestContext(t)
+	defer ctx.Cleanup()
+	ctx.Init()
+
+	collector := &alertEventCollector{}
+	ctx.eventDispatcher = collector
+	manager := ctx.managers.Alert
+
+	newRouter := func() *Router {
+		fingerprint := eid.New()
+		router := &Router{
+			BaseEntity:  models.BaseEntity{Id: eid.New()},
+			Name:        eid.New(),
+			Fingerprint: &fingerprint,
+		}
+		ctx.NoError(ctx.managers.Router.Create(router, change.New()))
+		return router
+	}
+
+	t.Run("offline routers fire once the rule duration passes and resolve on connect", func(t *testing.T) {
+		router := newRouter()
+		ctx.config.Alerts.Rules = []*config.AlertRuleConfig{{
+			Name:     "router-offline",
+			Type:     config.AlertRuleTypeRouterOffline,
+			Severity: event.AlertSeverityCritical,
+			For:      2 * time.Minute,
+			Filter:   `id = "` + router.Id + `"`,
+		}}
+
+		now := time.Now()
+		manager.evaluate(now)
+		alerts := manager.List()
+		ctx.Len(alerts, 1)
+		ctx.Equal(AlertStatePending, alerts[0].State)
+		ctx.Equal(router.Id, alerts[0].EntityId)
+		ctx.Empty(collector.take())
+
+		manager.evaluate(now.Add(2 * time.Minute))
+		alerts = manager.List()
+		ctx.Len(alerts, 1)
+		ctx.Equal(AlertStateFiring, alerts[0].State)
+
+		events := collector.take()
+		ctx.Len(events, 1)
+		ctx.Equal(event.AlertStateFiring, events[0].State)
+		ctx.Equal(event.AlertSeverityCritical, events[0].Severity)
+		ctx.Equal("router-offline", events[0].Rule)
+		ctx.Equal(alerts[0].Id, events[0].AlertId)
+		ctx.Equal(router.Id, events[0].RelatedEntities[AlertEntityTypeRouter])
+
+		// firing alerts aren't reported again
+		manager.evaluate(now.Add(3 * time.Minute))
+		ctx.Empty(collector.take())
+
+		ctx.managers.Router.MarkConnected(router)
+		defer ctx.managers.Router.MarkDisconnected(router)
+
+		manager.evaluate(now.Add(4 * time.Minute))
+		ctx.Empty(manager.List())
+
+		events = collector.take()
+		ctx.Len(events, 1)
+		ctx.Equal(event.AlertStateResolved, events[0].State)
+		ctx.Equal(alerts[0].Id, events[0].AlertId)
+	})
+
+	t.Run("silenced alerts are tracked but not reported", func(t *testing.T) {
+		service := &Service{
+			BaseEntity: models.BaseEntity{Id: eid.New()},
+			Name:       eid.New(),
+		}
+		ctx.NoError(ctx.managers.Service.Create(service, change.New()))
+
+		ctx.config.Alerts.Rules = []*config.AlertRuleConfig{{
+			Name:           "no-terminators",
+			Type:           config.AlertRuleTypeServiceTerminators,
+			Severity:       event.AlertSeverityError,
+			Filter:         `name = "` + service.Name + `"`,
+			MinTerminators: 1,
+		}}
+
+		var fieldErr *errorz.FieldError
+		ctx.ErrorAs(manager.AddSilence(&AlertSilence{ExpiresAt: time.Now().Add(time.Hour)}), &fieldErr)
+		ctx.ErrorAs(manager.AddSilence(&AlertSilence{Rule: "unknown", ExpiresAt: time.Now().Add(time.Hour)}), &fieldErr)
+		ctx.ErrorAs(manager.AddSilence(&AlertSilence{EntityId: service.Id, ExpiresAt: time.Now()}), &fieldErr)
+
+		silence := &AlertSilence{
+			EntityId:  service.Id,
+			Comment:   "maintenance",
+			ExpiresAt: time.Now().Add(time.Hour),
+		}
+		ctx.NoError(manager.AddSilence(silence))
+		ctx.Len(manager.ListSilences(), 1)
+
+		manager.evaluate(time.Now())
+		alerts := manager.List()
+		ctx.Len(alerts, 1)
+		ctx.Equal(AlertStateFiring, alerts[0].State)
+		ctx.Equal(service.Name, alerts[0].EntityName)
+		ctx.Equal(silence.Id, alerts[0].SilencedBy)
+		ctx.Empty(collector.take())
+
+		ctx.NoError(manager.RemoveSilence(silence.Id))
+		ctx.True(boltz.IsErrNotFoundErr(manager.RemoveSilence(silence.Id)))
+
+		manager.evaluate(time.Now())
+		events := collector.take()
+		ctx.Len(events, 1)
+		ctx.Equal(event.AlertStateFiring, events[0].State)
+		ctx.Equal(service.Id, events[0].RelatedEntities[AlertEntityTypeService])
+
+		ctx.config.Alerts.Rules[0].MinTerminators = 0
+		manager.evaluate(time.Now())
+		ctx.Empty(manager.List())
+		ctx.Len(collector.take(), 1)
+	})
+
+	t.Run("link latency percentiles over the window are checked", func(t *testing.T) {
+		src := newRouter()
+		dst := newRouter()
+		link := newLink(eid.New(), "tls", "tls:localhost:6262", 0)
+		link.Src = src
+		link.DstId = dst.Id
+		link.Dst.Store(dst)
+		link.SetState(Connected)
+		ctx.managers.Link.Add(link)
+		defer ctx.managers.Link.Remove(link)
+
+		ctx.config.Alerts.Rules = []*config.AlertRuleConfig{{
+			Name:       "link-latency",
+			Type:       config.AlertRuleTypeLinkLatency,
+			Severity:   event.AlertSeverityWarning,
+			Latency:    100 * time.Millisecond,
+			Percentile: 95,
+			Window:     5 * time.Minute,
+		}}
+
+		now := time.Now()
+		for i := 0; i < 4; i++ {
+			link.SetSrcLatency(int64(10 * time.Millisecond))
+			manager.evaluate(now.Add(time.Duration(i) * time.Minute))
+		}
+		ctx.Empty(manager.List())
+
+		link.SetDstLatency(int64(500 * time.Millisecond))
+		manager.evaluate(now.Add(4 * time.Minute))
+		alerts := manager.List()
+		ctx.Len(alerts, 1)
+		ctx.Equal(link.Id, alerts[0].EntityId)
+		ctx.Len(collector.take(), 1)
+
+		link.SetDstLatency(int64(10 * time.Millisecond))
+		manager.evaluate(now.Add(10 * time.Minute))
+		ctx.Empty(manager.List())
+		ctx.Len(collector.take(), 1)
+	})
+
+	t.Run("expiring router certificates are reported", func(t *testing.T) {
+		certPem := nfpem.EncodeToString(newRootCa().NewLeafWithAKID().cert)
+		edgeRouter := ctx.requireNewEdgeRouter()
+		edgeRouter.CertPem = &certPem
+		ctx.NoError(ctx.managers.EdgeRouter.Update(edgeRouter, true, &fields.UpdatedFieldsMap{
+			db.FieldEdgeRouterCertPEM: struct{}{},
+		}, change.New()))
+
+		ctx.config.Alerts.Rules = []*config.AlertRuleConfig{{
+			Name:          "cert-expiry",
+			Type:          config.AlertRuleTypeCertExpiry,
+			Severity:      event.AlertSeverityWarning,
+			Filter:        `id = "` + edgeRouter.Id + `"`,
+			ExpiresWithin: 14 * 24 * time.Hour,
+		}}
+
+		manager.evaluate(time.Now())
+		ctx.Empty(manager.List())
+
+		ctx.config.Alerts.Rules[0].ExpiresWithin = 11 * 365 * 24 * time.Hour
+		manager.evaluate(time.Now())
+		alerts := manager.List()
+		ctx.Len(alerts, 1)
+		ctx.Equal(edgeRouter.Id, alerts[0].EntityId)
+		ctx.Equal(AlertEntityTypeRouter, alerts[0].EntityType)
+	})
+}
+
+func TestAlertPercentile(t *testing.T) {
+	req := require.New(t)
+
+	var values []time.Duration
+	for i := 1; i <= 100; i++ {
+		values = append(values, time.Duration(101-i))
+	}
+
+	req.Equal(time.Duration(95), percentile(values, 95))
+	req.Equal(time.Duration(100), percentile(values, 100))
+	req.Equal(time.Duration(50), percentile(values, 50))
+	req.Equal(time.Duration(7), percentile([]time.Duration{7}, 1))
+}
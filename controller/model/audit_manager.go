@@ -0,0 +1,134 @@
+/*
+	(c) Copyright NetFoundry Inc.
+
+	Licensed under the Apache License, Version 2.0 (the "License");
+	you may not use this file except in compliance with the License.
+	You may obtain a copy of the License at
+
+	https://www.apache.org/licenses/LICENSE-2.0
+
+	Unless required by applicable law or agreed to in writing, software
+	distributed under the License is distributed on an "AS IS" BASIS,
+	WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+	See the License for the specific language governing permissions and
+	limitations under the License.
+*/
+
+package model
+
+import (
+	"encoding/json"
+	"strconv"
+
+	"github.com/openziti/storage/boltz"
+	"github.com/openziti/ziti/controller/apierror"
+	"github.com/openziti/ziti/controller/change"
+	"github.com/openziti/ziti/controller/db"
+	"github.com/pkg/errors"
+	"go.etcd.io/bbolt"
+)
+
+func NewAuditManager(env Env) *AuditManager {
+	return &AuditManager{
+		env: env,
+	}
+}
+
+// AuditManager provides access to the persisted audit log and allows reverting entities to earlier versions
+type AuditManager struct {
+	env Env
+}
+
+func (self *AuditManager) getAuditLog() (*db.AuditLog, error) {
+	auditLog := self.env.GetStores().Audit
+	if !auditLog.IsEnabled() {
+		return nil, apierror.NewAuditNotEnabledError()
+	}
+	return auditLog, nil
+}
+
+func (self *AuditManager) List(filter *db.AuditFilter) ([]*db.AuditEntry, error) {
+	auditLog, err := self.getAuditLog()
+	if err != nil {
+		return nil, err
+	}
+
+	var result []*db.AuditEntry
+	err = self.env.GetDb().View(func(tx *bbolt.Tx) error {
+		result, err = auditLog.List(tx, filter)
+		return err
+	})
+	return result, err
+}
+
+func (self *AuditManager) GetHistory(entityType, entityId string) ([]*db.AuditEntry, error) {
+	auditLog, err := self.getAuditLog()
+	if err != nil {
+		return nil, err
+	}
+
+	var result []*db.AuditEntry
+	err = self.env.GetDb().View(func(tx *bbolt.Tx) error {
+		result, err = auditLog.GetHistory(tx, entityType, entityId)
+		return err
+	})
+	return result, err
+}
+
+func (self *AuditManager) GetVersion(entityType, entityId string, version uint64) (*db.AuditEntry, error) {
+	auditLog, err := self.getAuditLog()
+	if err != nil {
+		return nil, err
+	}
+
+	var result *db.AuditEntry
+	err = self.env.GetDb().View(func(tx *bbolt.Tx) error {
+		result, err = auditLog.GetVersion(tx, entityType, entityId, version)
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+	if result == nil {
+		return nil, boltz.NewNotFoundError("audit entry", "version", strconv.FormatUint(version, 10))
+	}
+	return result, nil
+}
+
+// RevertService restores the service to the state it had after the given version. The change is applied
+// through the regular create/update commands, so it is replicated and audited like any other change.
+func (self *AuditManager) RevertService(serviceId string, version uint64, ctx *change.Context) error {
+	entry, err := self.GetVersion(db.EntityTypeServices, serviceId, version)
+	if err != nil {
+		return err
+	}
+
+	if len(entry.FinalState) == 0 {
+		return apierror.NewAuditVersionNotRevertibleError()
+	}
+
+	boltService := &db.EdgeService{}
+	if err = json.Unmarshal(entry.FinalState, boltService); err != nil {
+		return errors.Wrapf(err, "unable to decode service state for version %d", version)
+	}
+
+	service := &EdgeService{}
+	if err = service.fillFrom(self.env, nil, boltService); err != nil {
+		return err
+	}
+	service.MaxIdleTime = boltService.MaxIdleTime
+
+	exists := false
+	err = self.env.GetDb().View(func(tx *bbolt.Tx) error {
+		exists = self.env.GetStores().EdgeService.IsEntityPresent(tx, serviceId)
+		return nil
+	})
+	if err != nil {
+		return err
+	}
+
+	if exists {
+		return self.env.GetManagers().EdgeService.Update(service, nil, ctx)
+	}
+	return self.env.GetManagers().EdgeService.Create(service, ctx)
+}
@@ -451,6 +451,9 @@ func (self *AuthenticatorManager) ExtendCertForIdentity(identityId string, authe
 	rootPool := self.env.GetConfig().Edge.CaCertsPool()
 	intermediatePool := x509.NewCertPool()
 	intermediatePool.AddCert(self.env.GetConfig().Edge.Enrollment.SigningCert.Cert().Leaf)
+	for _, signer := range self.env.GetConfig().Edge.Enrollment.TrustedSigners {
+		intermediatePool.AddCert(signer)
+	}
 
 	for _, c := range peerChain {
 		intermediatePool.AddCert(c)
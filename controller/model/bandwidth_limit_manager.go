@@ -0,0 +1,115 @@
+/*
+	(c) Copyright NetFoundry Inc.
+
+	Licensed under the Apache License, Version 2.0 (the "License");
+	you may not use this file except in compliance with the License.
+	You may obtain a copy of the License at
+
+	https://www.apache.org/licenses/LICENSE-2.0
+
+	Unless required by applicable law or agreed to in writing, software
+	distributed under the License is distributed on an "AS IS" BASIS,
+	WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+	See the License for the specific language governing permissions and
+	limitations under the License.
+*/
+
+package model
+
+import (
+	"github.com/openziti/storage/boltz"
+	"github.com/openziti/ziti/common/pb/edge_cmd_pb"
+	"github.com/openziti/ziti/controller/change"
+	"github.com/openziti/ziti/controller/command"
+	"github.com/openziti/ziti/controller/db"
+	"github.com/openziti/ziti/controller/fields"
+	"github.com/openziti/ziti/controller/models"
+	"google.golang.org/protobuf/proto"
+)
+
+func NewBandwidthLimitManager(env Env) *BandwidthLimitManager {
+	manager := &BandwidthLimitManager{
+		baseEntityManager: newBaseEntityManager[*BandwidthLimit, *db.BandwidthLimit](env, env.GetStores().BandwidthLimit),
+	}
+	manager.impl = manager
+
+	RegisterManagerDecoder[*BandwidthLimit](env, manager)
+
+	return manager
+}
+
+type BandwidthLimitManager struct {
+	baseEntityManager[*BandwidthLimit, *db.BandwidthLimit]
+}
+
+func (self *BandwidthLimitManager) NewModelEntity() *BandwidthLimit {
+	return &BandwidthLimit{}
+}
+
+func (self *BandwidthLimitManager) Create(entity *BandwidthLimit, ctx *change.Context) error {
+	return DispatchCreate[*BandwidthLimit](self, entity, ctx)
+}
+
+func (self *BandwidthLimitManager) ApplyCreate(cmd *command.CreateEntityCommand[*BandwidthLimit], ctx boltz.MutateContext) error {
+	_, err := self.createEntity(cmd.Entity, ctx)
+	return err
+}
+
+func (self *BandwidthLimitManager) Update(entity *BandwidthLimit, checker fields.UpdatedFields, ctx *change.Context) error {
+	return DispatchUpdate[*BandwidthLimit](self, entity, checker, ctx)
+}
+
+func (self *BandwidthLimitManager) ApplyUpdate(cmd *command.UpdateEntityCommand[*BandwidthLimit], ctx boltz.MutateContext) error {
+	return self.updateEntity(cmd.Entity, cmd.UpdatedFields, ctx)
+}
+
+func (self *BandwidthLimitManager) ReadByName(name string) (*BandwidthLimit, error) {
+	modelEntity := &BandwidthLimit{}
+	nameIndex := self.env.GetStores().BandwidthLimit.GetNameIndex()
+	if err := self.readEntityWithIndex("name", []byte(name), nameIndex, modelEntity); err != nil {
+		return nil, err
+	}
+	return modelEntity, nil
+}
+
+func (self *BandwidthLimitManager) Marshall(entity *BandwidthLimit) ([]byte, error) {
+	tags, err := edge_cmd_pb.EncodeTags(entity.Tags)
+	if err != nil {
+		return nil, err
+	}
+
+	msg := &edge_cmd_pb.BandwidthLimit{
+		Id:                 entity.Id,
+		Name:               entity.Name,
+		Tags:               tags,
+		Rate:               entity.Rate,
+		Burst:              entity.Burst,
+		Shared:             entity.Shared,
+		IdentityRoles:      entity.IdentityRoles,
+		ServiceRoles:       entity.ServiceRoles,
+		EdgeRouterPolicies: entity.EdgeRouterPolicies,
+	}
+
+	return proto.Marshal(msg)
+}
+
+func (self *BandwidthLimitManager) Unmarshall(bytes []byte) (*BandwidthLimit, error) {
+	msg := &edge_cmd_pb.BandwidthLimit{}
+	if err := proto.Unmarshal(bytes, msg); err != nil {
+		return nil, err
+	}
+
+	return &BandwidthLimit{
+		BaseEntity: models.BaseEntity{
+			Id:   msg.Id,
+			Tags: edge_cmd_pb.DecodeTags(msg.Tags),
+		},
+		Name:               msg.Name,
+		Rate:               msg.Rate,
+		Burst:              msg.Burst,
+		Shared:             msg.Shared,
+		IdentityRoles:      msg.IdentityRoles,
+		ServiceRoles:       msg.ServiceRoles,
+		EdgeRouterPolicies: msg.EdgeRouterPolicies,
+	}, nil
+}
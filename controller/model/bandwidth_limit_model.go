@@ -0,0 +1,124 @@
+/*
+	(c) Copyright NetFoundry Inc.
+
+	Licensed under the Apache License, Version 2.0 (the "License");
+	you may not use this file except in compliance with the License.
+	You may obtain a copy of the License at
+
+	https://www.apache.org/licenses/LICENSE-2.0
+
+	Unless required by applicable law or agreed to in writing, software
+	distributed under the License is distributed on an "AS IS" BASIS,
+	WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+	See the License for the specific language governing permissions and
+	limitations under the License.
+*/
+
+package model
+
+import (
+	"github.com/openziti/foundation/v2/errorz"
+	"github.com/openziti/storage/boltz"
+	"github.com/openziti/ziti/controller/db"
+	"github.com/openziti/ziti/controller/models"
+	"go.etcd.io/bbolt"
+)
+
+type BandwidthLimit struct {
+	models.BaseEntity
+	Name               string
+	Rate               int64
+	Burst              int64
+	Shared             bool
+	IdentityRoles      []string
+	ServiceRoles       []string
+	EdgeRouterPolicies []string
+}
+
+func (entity *BandwidthLimit) validate() error {
+	if entity.Rate <= 0 {
+		return errorz.NewFieldError("rate must be greater than zero", db.FieldBandwidthLimitRate, entity.Rate)
+	}
+
+	if entity.Burst < 0 {
+		return errorz.NewFieldError("burst may not be negative", db.FieldBandwidthLimitBurst, entity.Burst)
+	}
+
+	if len(entity.IdentityRoles) == 0 && len(entity.ServiceRoles) == 0 && len(entity.EdgeRouterPolicies) == 0 {
+		return errorz.NewFieldError("at least one of identity roles, service roles or edge router policies is required",
+			db.FieldIdentityRoles, entity.IdentityRoles)
+	}
+
+	return nil
+}
+
+// GetBurst returns the configured burst, defaulting to one second of traffic at the configured rate
+func (entity *BandwidthLimit) GetBurst() int64 {
+	if entity.Burst == 0 {
+		return entity.Rate
+	}
+	return entity.Burst
+}
+
+func (entity *BandwidthLimit) toBoltEntity() (*db.BandwidthLimit, error) {
+	if err := entity.validate(); err != nil {
+		return nil, err
+	}
+
+	return &db.BandwidthLimit{
+		BaseExtEntity:      *boltz.NewExtEntity(entity.Id, entity.Tags),
+		Name:               entity.Name,
+		Rate:               entity.Rate,
+		Burst:              entity.GetBurst(),
+		Shared:             entity.Shared,
+		IdentityRoles:      entity.IdentityRoles,
+		ServiceRoles:       entity.ServiceRoles,
+		EdgeRouterPolicies: entity.EdgeRouterPolicies,
+	}, nil
+}
+
+func (entity *BandwidthLimit) toBoltEntityForCreate(*bbolt.Tx, Env) (*db.BandwidthLimit, error) {
+	return entity.toBoltEntity()
+}
+
+func (entity *BandwidthLimit) toBoltEntityForUpdate(tx *bbolt.Tx, env Env, checker boltz.FieldChecker) (*db.BandwidthLimit, error) {
+	if checker == nil {
+		return entity.toBoltEntity()
+	}
+
+	// patches only carry the updated fields, so fill in the rest before validating
+	existing, err := env.GetStores().BandwidthLimit.LoadById(tx, entity.Id)
+	if err != nil {
+		return nil, err
+	}
+
+	merged := *entity
+	if !checker.IsUpdated(db.FieldBandwidthLimitRate) {
+		merged.Rate = existing.Rate
+	}
+	if !checker.IsUpdated(db.FieldBandwidthLimitBurst) {
+		merged.Burst = existing.Burst
+	}
+	if !checker.IsUpdated(db.FieldIdentityRoles) {
+		merged.IdentityRoles = existing.IdentityRoles
+	}
+	if !checker.IsUpdated(db.FieldServiceRoles) {
+		merged.ServiceRoles = existing.ServiceRoles
+	}
+	if !checker.IsUpdated(db.FieldBandwidthLimitEdgeRouterPolicies) {
+		merged.EdgeRouterPolicies = existing.EdgeRouterPolicies
+	}
+	return merged.toBoltEntity()
+}
+
+func (entity *BandwidthLimit) fillFrom(_ Env, _ *bbolt.Tx, boltBandwidthLimit *db.BandwidthLimit) error {
+	entity.FillCommon(boltBandwidthLimit)
+	entity.Name = boltBandwidthLimit.Name
+	entity.Rate = boltBandwidthLimit.Rate
+	entity.Burst = boltBandwidthLimit.Burst
+	entity.Shared = boltBandwidthLimit.Shared
+	entity.IdentityRoles = boltBandwidthLimit.IdentityRoles
+	entity.ServiceRoles = boltBandwidthLimit.ServiceRoles
+	entity.EdgeRouterPolicies = boltBandwidthLimit.EdgeRouterPolicies
+	return nil
+}
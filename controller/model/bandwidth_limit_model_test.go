@@ -0,0 +1,56 @@
+/*
+	(c) Copyright NetFoundry Inc.
+
+	Licensed under the Apache License, Version 2.0 (the "License");
+	you may not use this file except in compliance with the License.
+	You may obtain a copy of the License at
+
+	https://www.apache.org/licenses/LICENSE-2.0
+
+	Unless required by applicable law or agreed to in writing, software
+	distributed under the License is distributed on an "AS IS" BASIS,
+	WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+	See the License for the specific language governing permissions and
+	limitations under the License.
+*/
+
+package model
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestBandwidthLimit(t *testing.T) {
+	t.Run("validation", func(t *testing.T) {
+		req := require.New(t)
+
+		limit := &BandwidthLimit{Name: "video", Rate: 1_000_000, ServiceRoles: []string{"#video"}}
+		req.NoError(limit.validate())
+
+		limit.Rate = 0
+		req.Error(limit.validate())
+
+		limit.Rate = 1_000_000
+		limit.Burst = -1
+		req.Error(limit.validate())
+
+		limit.Burst = 0
+		limit.ServiceRoles = nil
+		req.Error(limit.validate(), "a limit must target something")
+
+		limit.EdgeRouterPolicies = []string{"erp1"}
+		req.NoError(limit.validate())
+	})
+
+	t.Run("burst defaults to rate", func(t *testing.T) {
+		req := require.New(t)
+
+		limit := &BandwidthLimit{Rate: 5_000}
+		req.Equal(int64(5_000), limit.GetBurst())
+
+		limit.Burst = 20_000
+		req.Equal(int64(20_000), limit.GetBurst())
+	})
+}
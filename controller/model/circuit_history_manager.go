@@ -0,0 +1,336 @@
+/*
+	Copyright NetFoundry Inc.
+
+	Licensed under the Apache License, Version 2.0 (the "License");
+	you may not use this file except in compliance with the License.
+	You may obtain a copy of the License at
+
+	https://www.apache.org/licenses/LICENSE-2.0
+
+	Unless required by applicable law or agreed to in writing, software
+	distributed under the License is distributed on an "AS IS" BASIS,
+	WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+	See the License for the specific language governing permissions and
+	limitations under the License.
+*/
+
+package model
+
+import (
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/openziti/metrics/metrics_pb"
+	"github.com/openziti/storage/boltz"
+	"github.com/openziti/ziti/controller/apierror"
+	"github.com/openziti/ziti/controller/event"
+)
+
+const (
+	CircuitHistoryStateActive = "active"
+	CircuitHistoryStateEnded  = "ended"
+	CircuitHistoryStateFailed = "failed"
+
+	EntityTypeCircuitHistory = "circuitHistory"
+
+	circuitHistoryPruneInterval = time.Minute
+)
+
+// CircuitHistoryEntry records a single circuit, from creation, or failure to create, until it ends. Byte counts are
+// accumulated from the usage reported by the ingress and egress routers
+type CircuitHistoryEntry struct {
+	CircuitId        string
+	ServiceId        string
+	InstanceId       string
+	TerminatorId     string
+	IdentityId       string
+	HostId           string
+	Routers          []string
+	Links            []string
+	State            string
+	FailureCause     string
+	CreatedAt        time.Time
+	EndedAt          *time.Time
+	CreationTimespan *time.Duration
+	PathUpdates      int
+	IngressRxBytes   uint64
+	IngressTxBytes   uint64
+	EgressRxBytes    uint64
+	EgressTxBytes    uint64
+	Tags             map[string]string
+}
+
+func (self *CircuitHistoryEntry) GetDuration(now time.Time) time.Duration {
+	if self.EndedAt != nil {
+		return self.EndedAt.Sub(self.CreatedAt)
+	}
+	return now.Sub(self.CreatedAt)
+}
+
+func (self *CircuitHistoryEntry) usesRouter(routerId string) bool {
+	for _, id := range self.Routers {
+		if id == routerId {
+			return true
+		}
+	}
+	return false
+}
+
+func (self *CircuitHistoryEntry) usesLink(linkId string) bool {
+	for _, id := range self.Links {
+		if id == linkId {
+			return true
+		}
+	}
+	return false
+}
+
+// CircuitHistoryQuery filters the circuit history. Empty fields match all circuits. Since and Until select circuits
+// which were active at some point in the given range. Results are returned newest first, up to Limit, if set
+type CircuitHistoryQuery struct {
+	IdentityId   string
+	ServiceId    string
+	RouterId     string
+	LinkId       string
+	TerminatorId string
+	HostId       string
+	State        string
+	FailureCause string
+	Since        *time.Time
+	Until        *time.Time
+	Limit        int
+}
+
+func (self *CircuitHistoryQuery) matches(entry *CircuitHistoryEntry) bool {
+	if self.IdentityId != "" && self.IdentityId != entry.IdentityId {
+		return false
+	}
+	if self.ServiceId != "" && self.ServiceId != entry.ServiceId {
+		return false
+	}
+	if self.TerminatorId != "" && self.TerminatorId != entry.TerminatorId {
+		return false
+	}
+	if self.HostId != "" && self.HostId != entry.HostId {
+		return false
+	}
+	if self.State != "" && self.State != entry.State {
+		return false
+	}
+	if self.FailureCause != "" && self.FailureCause != entry.FailureCause {
+		return false
+	}
+	if self.RouterId != "" && !entry.usesRouter(self.RouterId) {
+		return false
+	}
+	if self.LinkId != "" && !entry.usesLink(self.LinkId) {
+		return false
+	}
+	if self.Since != nil && entry.EndedAt != nil && entry.EndedAt.Before(*self.Since) {
+		return false
+	}
+	if self.Until != nil && entry.CreatedAt.After(*self.Until) {
+		return false
+	}
+	return true
+}
+
+// CircuitHistoryManager keeps a bounded, in memory history of the circuits created by this controller. It's fed by
+// circuit events and by the usage counters reported by routers, and holds at most CircuitHistoryConfig.MaxCircuits
+// entries, dropping the oldest first. Circuits which have ended are also dropped once they're older than the
+// configured retention
+type CircuitHistoryManager struct {
+	env     Env
+	lock    sync.Mutex
+	entries []*CircuitHistoryEntry
+	index   map[string]*CircuitHistoryEntry
+}
+
+func NewCircuitHistoryManager(env Env) *CircuitHistoryManager {
+	result := &CircuitHistoryManager{
+		env:   env,
+		index: map[string]*CircuitHistoryEntry{},
+	}
+
+	if env.GetConfig().CircuitHistory.Enabled {
+		if dispatcher := env.GetEventDispatcher(); dispatcher != nil {
+			dispatcher.AddCircuitEventHandler(result)
+			dispatcher.AddMetricsMessageHandler(result)
+		}
+		go result.run()
+	}
+
+	return result
+}
+
+func (self *CircuitHistoryManager) IsEnabled() bool {
+	return self.env.GetConfig().CircuitHistory.Enabled
+}
+
+func (self *CircuitHistoryManager) run() {
+	ticker := time.NewTicker(circuitHistoryPruneInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ticker.C:
+			self.prune(time.Now())
+		case <-self.env.GetCloseNotifyChannel():
+			return
+		}
+	}
+}
+
+func (self *CircuitHistoryManager) AcceptCircuitEvent(evt *event.CircuitEvent) {
+	self.lock.Lock()
+	defer self.lock.Unlock()
+
+	entry := self.index[evt.CircuitId]
+	if entry == nil {
+		createdAt := evt.Timestamp
+		if evt.Duration != nil {
+			// we missed the created event, likely because the controller restarted
+			createdAt = createdAt.Add(-*evt.Duration)
+		}
+		entry = &CircuitHistoryEntry{
+			CircuitId: evt.CircuitId,
+			State:     CircuitHistoryStateActive,
+			CreatedAt: createdAt,
+		}
+		self.addAlreadyLocked(entry)
+	}
+
+	entry.ServiceId = evt.ServiceId
+	entry.InstanceId = evt.InstanceId
+	if evt.TerminatorId != "" {
+		entry.TerminatorId = evt.TerminatorId
+	}
+	if evt.CreationTimespan != nil {
+		entry.CreationTimespan = evt.CreationTimespan
+	}
+	if len(evt.Path.Nodes) > 0 {
+		entry.Routers = evt.Path.Nodes
+		entry.Links = evt.Path.Links
+	}
+	if evt.Tags != nil {
+		entry.Tags = evt.Tags
+		entry.IdentityId = evt.Tags["clientId"]
+		entry.HostId = evt.Tags["hostId"]
+	}
+
+	switch evt.EventType {
+	case event.CircuitUpdated:
+		entry.PathUpdates++
+	case event.CircuitDeleted:
+		entry.State = CircuitHistoryStateEnded
+		endedAt := evt.Timestamp
+		entry.EndedAt = &endedAt
+	case event.CircuitFailed:
+		entry.State = CircuitHistoryStateFailed
+		endedAt := evt.Timestamp
+		entry.EndedAt = &endedAt
+		if evt.FailureCause != nil {
+			entry.FailureCause = *evt.FailureCause
+		}
+	}
+}
+
+func (self *CircuitHistoryManager) AcceptMetricsMsg(msg *metrics_pb.MetricsMessage) {
+	if len(msg.UsageCounters) == 0 {
+		return
+	}
+
+	self.lock.Lock()
+	defer self.lock.Unlock()
+
+	for _, counter := range msg.UsageCounters {
+		for circuitId, bucket := range counter.Buckets {
+			if entry := self.index[circuitId]; entry != nil {
+				entry.IngressRxBytes += bucket.Values["ingress.rx"]
+				entry.IngressTxBytes += bucket.Values["ingress.tx"]
+				entry.EgressRxBytes += bucket.Values["egress.rx"]
+				entry.EgressTxBytes += bucket.Values["egress.tx"]
+			}
+		}
+	}
+}
+
+func (self *CircuitHistoryManager) addAlreadyLocked(entry *CircuitHistoryEntry) {
+	self.entries = append(self.entries, entry)
+	self.index[entry.CircuitId] = entry
+
+	if maxCircuits := self.env.GetConfig().CircuitHistory.MaxCircuits; len(self.entries) > maxCircuits {
+		evicted := len(self.entries) - maxCircuits
+		for _, evictedEntry := range self.entries[:evicted] {
+			delete(self.index, evictedEntry.CircuitId)
+		}
+		self.entries = append([]*CircuitHistoryEntry(nil), self.entries[evicted:]...)
+	}
+}
+
+func (self *CircuitHistoryManager) prune(now time.Time) {
+	cutoff := now.Add(-self.env.GetConfig().CircuitHistory.Retention)
+
+	self.lock.Lock()
+	defer self.lock.Unlock()
+
+	var retained []*CircuitHistoryEntry
+	for _, entry := range self.entries {
+		if entry.EndedAt != nil && entry.EndedAt.Before(cutoff) {
+			delete(self.index, entry.CircuitId)
+		} else {
+			retained = append(retained, entry)
+		}
+	}
+	self.entries = retained
+}
+
+func (self *CircuitHistoryManager) copyEntry(entry *CircuitHistoryEntry) *CircuitHistoryEntry {
+	result := *entry
+	return &result
+}
+
+// Query returns copies of the matching entries, newest first
+func (self *CircuitHistoryManager) Query(query *CircuitHistoryQuery) ([]*CircuitHistoryEntry, error) {
+	if !self.IsEnabled() {
+		return nil, apierror.NewCircuitHistoryNotEnabledError()
+	}
+
+	self.lock.Lock()
+	defer self.lock.Unlock()
+
+	var result []*CircuitHistoryEntry
+	for i := len(self.entries) - 1; i >= 0; i-- {
+		if entry := self.entries[i]; query.matches(entry) {
+			result = append(result, self.copyEntry(entry))
+		}
+	}
+
+	// entries are kept in the order in which the controller first saw them, which is nearly, but not always, the
+	// order in which they were created
+	sort.SliceStable(result, func(i, j int) bool {
+		return result[i].CreatedAt.After(result[j].CreatedAt)
+	})
+
+	if query.Limit > 0 && len(result) > query.Limit {
+		result = result[:query.Limit]
+	}
+
+	return result, nil
+}
+
+func (self *CircuitHistoryManager) Get(circuitId string) (*CircuitHistoryEntry, error) {
+	if !self.IsEnabled() {
+		return nil, apierror.NewCircuitHistoryNotEnabledError()
+	}
+
+	self.lock.Lock()
+	defer self.lock.Unlock()
+
+	entry := self.index[circuitId]
+	if entry == nil {
+		return nil, boltz.NewNotFoundError(EntityTypeCircuitHistory, "id", circuitId)
+	}
+	return self.copyEntry(entry), nil
+}
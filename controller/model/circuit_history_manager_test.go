@@ -0,0 +1,186 @@
+/*
+	Copyright NetFoundry Inc.
+
+	Licensed under the Apache License, Version 2.0 (the "License");
+	you may not use this file except in compliance with the License.
+	You may obtain a copy of the License at
+
+	https://www.apache.org/licenses/LICENSE-2.0
+
+	Unless required by applicable law or agreed to in writing, software
+	distributed under the License is distributed on an "AS IS" BASIS,
+	WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+	See the License for the specific language governing permissions and
+	limitations under the License.
+*/
+
+package model
+
+import (
+	"testing"
+	"time"
+
+	"github.com/openziti/metrics/metrics_pb"
+	"github.com/openziti/storage/boltz"
+	"github.com/openziti/ziti/controller/apierror"
+	"github.com/openziti/ziti/controller/config"
+	"github.com/openziti/ziti/controller/event"
+)
+
+func TestCircuitHistory(t *testing.T) {
+	ctx := NewTestContext(t)
+	defer ctx.Cleanup()
+	ctx.Init()
+
+	manager := ctx.managers.CircuitHistory
+
+	t.Run("queries fail if the circuit history isn't enabled", func(t *testing.T) {
+		_, err := manager.Query(&CircuitHistoryQuery{})
+		ctx.Error(err)
+		ctx.Contains(err.Error(), apierror.CircuitHistoryNotEnabledMessage)
+	})
+
+	ctx.config.CircuitHistory = config.CircuitHistoryConfig{
+		Enabled:     true,
+		MaxCircuits: 3,
+		Retention:   time.Hour,
+	}
+
+	start := time.Now().Add(-10 * time.Minute)
+
+	circuitEvent := func(eventType event.CircuitEventType, circuitId string, at time.Time, identityId string, routers ...string) *event.CircuitEvent {
+		result := &event.CircuitEvent{
+			EventType: eventType,
+			CircuitId: circuitId,
+			Timestamp: at,
+			ServiceId: "web",
+			Tags: map[string]string{
+				"clientId":  identityId,
+				"serviceId": "web",
+				"hostId":    "host",
+			},
+		}
+		result.Path.Nodes = routers
+		if eventType == event.CircuitCreated {
+			creationTimespan := 15 * time.Millisecond
+			result.CreationTimespan = &creationTimespan
+			result.TerminatorId = "t1"
+		}
+		return result
+	}
+
+	usage := func(circuitId string, ingress, egress uint64) *metrics_pb.MetricsMessage {
+		return &metrics_pb.MetricsMessage{
+			UsageCounters: []*metrics_pb.MetricsMessage_UsageCounter{{
+				Buckets: map[string]*metrics_pb.MetricsMessage_UsageBucket{
+					circuitId: {
+						Values: map[string]uint64{
+							"ingress.rx": ingress,
+							"ingress.tx": ingress * 2,
+							"egress.rx":  egress,
+							"egress.tx":  egress * 2,
+						},
+					},
+				},
+			}},
+		}
+	}
+
+	t.Run("circuits are recorded from creation until they end, with their usage", func(t *testing.T) {
+		manager.AcceptCircuitEvent(circuitEvent(event.CircuitCreated, "c1", start, "alice", "r1", "r2"))
+
+		entry, err := manager.Get("c1")
+		ctx.NoError(err)
+		ctx.Equal(CircuitHistoryStateActive, entry.State)
+		ctx.Nil(entry.EndedAt)
+		ctx.Equal("t1", entry.TerminatorId)
+		ctx.Equal("alice", entry.IdentityId)
+		ctx.Equal("host", entry.HostId)
+		ctx.Equal([]string{"r1", "r2"}, entry.Routers)
+
+		manager.AcceptMetricsMsg(usage("c1", 100, 50))
+		manager.AcceptMetricsMsg(usage("c1", 10, 5))
+		manager.AcceptCircuitEvent(circuitEvent(event.CircuitUpdated, "c1", start.Add(time.Minute), "alice", "r1", "r3", "r2"))
+		manager.AcceptCircuitEvent(circuitEvent(event.CircuitDeleted, "c1", start.Add(2*time.Minute), "alice", "r1", "r3", "r2"))
+
+		entry, err = manager.Get("c1")
+		ctx.NoError(err)
+		ctx.Equal(CircuitHistoryStateEnded, entry.State)
+		ctx.Equal(2*time.Minute, entry.GetDuration(time.Now()))
+		ctx.Equal(1, entry.PathUpdates)
+		ctx.Equal([]string{"r1", "r3", "r2"}, entry.Routers)
+		ctx.Equal(15*time.Millisecond, *entry.CreationTimespan)
+		ctx.Equal(uint64(110), entry.IngressRxBytes)
+		ctx.Equal(uint64(220), entry.IngressTxBytes)
+		ctx.Equal(uint64(55), entry.EgressRxBytes)
+		ctx.Equal(uint64(110), entry.EgressTxBytes)
+	})
+
+	t.Run("failed circuits are recorded with their failure cause", func(t *testing.T) {
+		failed := circuitEvent(event.CircuitFailed, "c2", start.Add(3*time.Minute), "bob", "r1")
+		cause := "NO_TERMINATORS"
+		failed.FailureCause = &cause
+		manager.AcceptCircuitEvent(failed)
+
+		entry, err := manager.Get("c2")
+		ctx.NoError(err)
+		ctx.Equal(CircuitHistoryStateFailed, entry.State)
+		ctx.Equal("NO_TERMINATORS", entry.FailureCause)
+	})
+
+	t.Run("circuits whose creation was missed are recorded when they end", func(t *testing.T) {
+		deleted := circuitEvent(event.CircuitDeleted, "c3", start.Add(5*time.Minute), "alice", "r2")
+		duration := time.Minute
+		deleted.Duration = &duration
+		manager.AcceptCircuitEvent(deleted)
+
+		entry, err := manager.Get("c3")
+		ctx.NoError(err)
+		ctx.Equal(CircuitHistoryStateEnded, entry.State)
+		ctx.Equal(start.Add(4*time.Minute), entry.CreatedAt)
+	})
+
+	t.Run("queries filter circuits and return the newest first", func(t *testing.T) {
+		ids := func(query *CircuitHistoryQuery) []string {
+			entries, err := manager.Query(query)
+			ctx.NoError(err)
+			var result []string
+			for _, entry := range entries {
+				result = append(result, entry.CircuitId)
+			}
+			return result
+		}
+
+		since := start.Add(3 * time.Minute)
+		until := start.Add(time.Minute)
+
+		ctx.Equal([]string{"c3", "c2", "c1"}, ids(&CircuitHistoryQuery{}))
+		ctx.Equal([]string{"c3", "c1"}, ids(&CircuitHistoryQuery{IdentityId: "alice"}))
+		ctx.Equal([]string{"c3", "c1"}, ids(&CircuitHistoryQuery{RouterId: "r2"}))
+		ctx.Equal([]string{"c2"}, ids(&CircuitHistoryQuery{State: CircuitHistoryStateFailed}))
+		ctx.Equal([]string{"c3", "c2"}, ids(&CircuitHistoryQuery{Since: &since}))
+		ctx.Equal([]string{"c1"}, ids(&CircuitHistoryQuery{Until: &until}))
+		ctx.Equal([]string{"c3"}, ids(&CircuitHistoryQuery{Limit: 1}))
+	})
+
+	t.Run("the oldest circuits are dropped once the history is full", func(t *testing.T) {
+		manager.AcceptCircuitEvent(circuitEvent(event.CircuitCreated, "c4", start.Add(6*time.Minute), "carol", "r1"))
+
+		_, err := manager.Get("c1")
+		ctx.True(boltz.IsErrNotFoundErr(err))
+
+		entries, err := manager.Query(&CircuitHistoryQuery{})
+		ctx.NoError(err)
+		ctx.Len(entries, 3)
+	})
+
+	t.Run("ended circuits are dropped after the retention period", func(t *testing.T) {
+		manager.prune(start.Add(time.Hour + 4*time.Minute))
+
+		entries, err := manager.Query(&CircuitHistoryQuery{})
+		ctx.NoError(err)
+		ctx.Len(entries, 2)
+		ctx.Equal("c4", entries[0].CircuitId)
+		ctx.Equal("c3", entries[1].CircuitId)
+	})
+}
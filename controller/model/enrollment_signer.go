@@ -0,0 +1,254 @@
+/*
+	Copyright NetFoundry Inc.
+
+	Licensed under the Apache License, Version 2.0 (the "License");
+	you may not use this file except in compliance with the License.
+	You may obtain a copy of the License at
+
+	https://www.apache.org/licenses/LICENSE-2.0
+
+	Unless required by applicable law or agreed to in writing, software
+	distributed under the License is distributed on an "AS IS" BASIS,
+	WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+	See the License for the specific language governing permissions and
+	limitations under the License.
+*/
+
+package model
+
+import (
+	"crypto/x509"
+	"time"
+
+	"github.com/michaelquigley/pfxlog"
+	"github.com/openziti/foundation/v2/errorz"
+	nfpem "github.com/openziti/foundation/v2/pem"
+	"github.com/openziti/storage/ast"
+	"github.com/openziti/storage/boltz"
+	"github.com/openziti/ziti/common/cert"
+	"github.com/openziti/ziti/controller/change"
+	"github.com/openziti/ziti/controller/db"
+	"go.etcd.io/bbolt"
+)
+
+const (
+	// EnrollmentSignerActive is the state of the signer which issues certificates
+	EnrollmentSignerActive = "active"
+
+	// EnrollmentSignerTrusted is the state of signers which are trusted, but don't issue certificates
+	EnrollmentSignerTrusted = "trusted"
+)
+
+// EnrollmentSigner describes a configured enrollment signer, along with the number of identity and router
+// certificates it issued which are still in use
+type EnrollmentSigner struct {
+	Id            string
+	Subject       string
+	Issuer        string
+	NotBefore     time.Time
+	NotAfter      time.Time
+	State         string
+	IdentityCerts int
+	RouterCerts   int
+	cert          *x509.Certificate
+}
+
+// EnrollmentSignerReissue reports the result of asking for the certificates issued by a signer to be re-issued
+type EnrollmentSignerReissue struct {
+	SignerId               string
+	IdentityCertsRequested int
+	IdentityCertsFailed    int
+	RouterCertRotation     *RouterCertRotation
+}
+
+// EnrollmentSignerManager reports on the enrollment signers and drives re-issuing certificates during signer
+// rotation. Signers are configured, with edge.enrollment.signingCert being the active signer and
+// edge.enrollment.trustedSigners holding the signers being rotated in or out.
+type EnrollmentSignerManager struct {
+	env Env
+}
+
+func NewEnrollmentSignerManager(env Env) *EnrollmentSignerManager {
+	return &EnrollmentSignerManager{
+		env: env,
+	}
+}
+
+func (self *EnrollmentSignerManager) getSigners() []*EnrollmentSigner {
+	var result []*EnrollmentSigner
+	if self.env.GetConfig().Edge == nil {
+		return result
+	}
+
+	fingerprints := cert.NewFingerprintGenerator()
+	add := func(signerCert *x509.Certificate, state string) {
+		id := fingerprints.FromCert(signerCert)
+		for _, signer := range result {
+			if signer.Id == id {
+				return
+			}
+		}
+		result = append(result, &EnrollmentSigner{
+			Id:        id,
+			Subject:   signerCert.Subject.String(),
+			Issuer:    signerCert.Issuer.String(),
+			NotBefore: signerCert.NotBefore,
+			NotAfter:  signerCert.NotAfter,
+			State:     state,
+			cert:      signerCert,
+		})
+	}
+
+	enrollment := self.env.GetConfig().Edge.Enrollment
+	if enrollment.SigningCert != nil && enrollment.SigningCert.Cert() != nil {
+		add(enrollment.SigningCert.Cert().Leaf, EnrollmentSignerActive)
+	}
+
+	for _, signerCert := range enrollment.TrustedSigners {
+		add(signerCert, EnrollmentSignerTrusted)
+	}
+
+	return result
+}
+
+// List returns the configured signers, the active signer first. Determining the certificates issued by each signer
+// requires checking every certificate authenticator and edge router certificate
+func (self *EnrollmentSignerManager) List() ([]*EnrollmentSigner, error) {
+	signers := self.getSigners()
+	err := self.env.GetDb().View(func(tx *bbolt.Tx) error {
+		return self.scanIssued(tx, signers, func(signer *EnrollmentSigner, authenticator *db.Authenticator) {
+			signer.IdentityCerts++
+		}, func(signer *EnrollmentSigner, router *db.EdgeRouter) {
+			signer.RouterCerts++
+		})
+	})
+	if err != nil {
+		return nil, err
+	}
+	return signers, nil
+}
+
+func (self *EnrollmentSignerManager) Read(id string) (*EnrollmentSigner, error) {
+	signers, err := self.List()
+	if err != nil {
+		return nil, err
+	}
+	for _, signer := range signers {
+		if signer.Id == id {
+			return signer, nil
+		}
+	}
+	return nil, boltz.NewNotFoundError("enrollment-signer", "id", id)
+}
+
+// Reissue asks for the certificates issued by the given signer to be re-issued by the active signer. Identities are
+// asked to extend their certificates the next time they connect. Edge routers are asked to renew their certificates
+// with a router certificate rotation campaign
+func (self *EnrollmentSignerManager) Reissue(id string, ctx *change.Context) (*EnrollmentSignerReissue, error) {
+	var signer *EnrollmentSigner
+	for _, v := range self.getSigners() {
+		if v.Id == id {
+			signer = v
+		}
+	}
+
+	if signer == nil {
+		return nil, boltz.NewNotFoundError("enrollment-signer", "id", id)
+	}
+
+	if signer.State == EnrollmentSignerActive {
+		return nil, errorz.NewFieldError("certificates issued by the active signer don't need to be re-issued", "id", id)
+	}
+
+	var authenticatorIds []string
+	var routerIds []string
+
+	err := self.env.GetDb().View(func(tx *bbolt.Tx) error {
+		return self.scanIssued(tx, []*EnrollmentSigner{signer}, func(_ *EnrollmentSigner, authenticator *db.Authenticator) {
+			if certAuthenticator := authenticator.ToCert(); certAuthenticator != nil && !certAuthenticator.IsExtendRequested {
+				authenticatorIds = append(authenticatorIds, authenticator.Id)
+			}
+		}, func(_ *EnrollmentSigner, router *db.EdgeRouter) {
+			routerIds = append(routerIds, router.Id)
+		})
+	})
+
+	if err != nil {
+		return nil, err
+	}
+
+	result := &EnrollmentSignerReissue{
+		SignerId: signer.Id,
+	}
+
+	for _, authenticatorId := range authenticatorIds {
+		if err = self.env.GetManagers().Authenticator.RequestExtend(authenticatorId, false, ctx); err != nil {
+			pfxlog.Logger().WithError(err).WithField("authenticatorId", authenticatorId).WithField("signerId", signer.Id).
+				Error("unable to request certificate extension")
+			result.IdentityCertsFailed++
+		} else {
+			result.IdentityCertsRequested++
+		}
+	}
+
+	if len(routerIds) > 0 {
+		if result.RouterCertRotation, err = self.env.GetManagers().RouterCertRotation.Start(&RouterCertRotationSpec{
+			RouterIds: routerIds,
+		}); err != nil {
+			return nil, err
+		}
+	}
+
+	return result, nil
+}
+
+// scanIssued finds the certificate authenticators and edge routers whose certificates were issued by one of the
+// given signers
+func (self *EnrollmentSignerManager) scanIssued(tx *bbolt.Tx, signers []*EnrollmentSigner,
+	authenticatorF func(*EnrollmentSigner, *db.Authenticator), routerF func(*EnrollmentSigner, *db.EdgeRouter)) error {
+
+	if len(signers) == 0 {
+		return nil
+	}
+
+	findIssuer := func(pem string) *EnrollmentSigner {
+		certs := nfpem.PemStringToCertificates(pem)
+		if len(certs) == 0 {
+			return nil
+		}
+		for _, signer := range signers {
+			if certs[0].CheckSignatureFrom(signer.cert) == nil {
+				return signer
+			}
+		}
+		return nil
+	}
+
+	authenticatorStore := self.env.GetStores().Authenticator
+	for cursor := authenticatorStore.IterateIds(tx, ast.BoolNodeTrue); cursor.IsValid(); cursor.Next() {
+		authenticator, err := authenticatorStore.LoadById(tx, string(cursor.Current()))
+		if err != nil {
+			return err
+		}
+		if certAuthenticator := authenticator.ToCert(); certAuthenticator != nil && certAuthenticator.Pem != "" {
+			if signer := findIssuer(certAuthenticator.Pem); signer != nil {
+				authenticatorF(signer, authenticator)
+			}
+		}
+	}
+
+	edgeRouterStore := self.env.GetStores().EdgeRouter
+	for cursor := edgeRouterStore.IterateIds(tx, ast.BoolNodeTrue); cursor.IsValid(); cursor.Next() {
+		router, err := edgeRouterStore.LoadById(tx, string(cursor.Current()))
+		if err != nil {
+			return err
+		}
+		if router.CertPem != nil {
+			if signer := findIssuer(*router.CertPem); signer != nil {
+				routerF(signer, router)
+			}
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,123 @@
+/*
+	Copyright NetFoundry Inc.
+
+	Licensed under the Apache License, Version 2.0 (the "License");
+	you may not use this file except in compliance with the License.
+	You may obtain a copy of the License at
+
+	https://www.apache.org/licenses/LICENSE-2.0
+
+	Unless required by applicable law or agreed to in writing, software
+	distributed under the License is distributed on an "AS IS" BASIS,
+	WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+	See the License for the specific language governing permissions and
+	limitations under the License.
+*/
+
+package model
+
+import (
+	"crypto/x509"
+	"testing"
+
+	nfpem "github.com/openziti/foundation/v2/pem"
+	"github.com/openziti/storage/boltz"
+	"github.com/openziti/ziti/common/cert"
+	"github.com/openziti/ziti/common/eid"
+	"github.com/openziti/ziti/controller/change"
+	"github.com/openziti/ziti/controller/db"
+	"github.com/openziti/ziti/controller/fields"
+)
+
+func TestEnrollmentSigners(t *testing.T) {
+	ctx := NewTestContext(t)
+	defer ctx.Cleanup()
+	ctx.Init()
+
+	manager := ctx.managers.EnrollmentSigner
+	fingerprints := cert.NewFingerprintGenerator()
+
+	oldSigner := newRootCa()
+	newSigner := newRootCa()
+	ctx.config.Edge.Enrollment.TrustedSigners = []*x509.Certificate{oldSigner.cert, newSigner.cert, oldSigner.cert}
+
+	oldSignerId := fingerprints.FromCert(oldSigner.cert)
+	newSignerId := fingerprints.FromCert(newSigner.cert)
+
+	requireNewCertAuthenticator := func(signer *testCa) string {
+		identity := ctx.requireNewIdentity(false)
+		leaf := signer.NewLeafWithAKID()
+		authenticator := &db.Authenticator{
+			BaseExtEntity: boltz.BaseExtEntity{Id: eid.New()},
+			Type:          db.MethodAuthenticatorCert,
+			IdentityId:    identity.Id,
+			SubType: &db.AuthenticatorCert{
+				Fingerprint:       fingerprints.FromCert(leaf.cert),
+				Pem:               nfpem.EncodeToString(leaf.cert),
+				IsIssuedByNetwork: true,
+			},
+		}
+		ctx.NoError(ctx.GetDb().Update(change.New().NewMutateContext(), func(mutateCtx boltz.MutateContext) error {
+			return ctx.GetStores().Authenticator.Create(mutateCtx, authenticator)
+		}))
+		return authenticator.Id
+	}
+
+	oldAuthenticatorIds := []string{requireNewCertAuthenticator(oldSigner), requireNewCertAuthenticator(oldSigner)}
+	requireNewCertAuthenticator(newSigner)
+
+	certPem := nfpem.EncodeToString(oldSigner.NewLeafWithAKID().cert)
+	edgeRouter := ctx.requireNewEdgeRouter()
+	edgeRouter.CertPem = &certPem
+	ctx.NoError(ctx.managers.EdgeRouter.Update(edgeRouter, true, &fields.UpdatedFieldsMap{
+		db.FieldEdgeRouterCertPEM: struct{}{},
+	}, change.New()))
+
+	t.Run("signers report the certificates they issued", func(t *testing.T) {
+		signers, err := manager.List()
+		ctx.NoError(err)
+		ctx.Len(signers, 2)
+
+		ctx.Equal(oldSignerId, signers[0].Id)
+		ctx.Equal(EnrollmentSignerTrusted, signers[0].State)
+		ctx.Equal(2, signers[0].IdentityCerts)
+		ctx.Equal(1, signers[0].RouterCerts)
+
+		ctx.Equal(newSignerId, signers[1].Id)
+		ctx.Equal(1, signers[1].IdentityCerts)
+		ctx.Equal(0, signers[1].RouterCerts)
+
+		signer, err := manager.Read(newSignerId)
+		ctx.NoError(err)
+		ctx.Equal(newSigner.cert.Subject.String(), signer.Subject)
+
+		_, err = manager.Read(eid.New())
+		ctx.True(boltz.IsErrNotFoundErr(err))
+	})
+
+	t.Run("reissue requests extension and router rotation", func(t *testing.T) {
+		reissue, err := manager.Reissue(oldSignerId, change.New())
+		ctx.NoError(err)
+		ctx.Equal(oldSignerId, reissue.SignerId)
+		ctx.Equal(2, reissue.IdentityCertsRequested)
+		ctx.Equal(0, reissue.IdentityCertsFailed)
+		ctx.NotNil(reissue.RouterCertRotation)
+		ctx.Equal(edgeRouter.Id, reissue.RouterCertRotation.Routers[0].RouterId)
+
+		for _, authenticatorId := range oldAuthenticatorIds {
+			authenticator, err := ctx.managers.Authenticator.Read(authenticatorId)
+			ctx.NoError(err)
+			ctx.True(authenticator.ToCert().IsExtendRequested)
+		}
+
+		reissue, err = manager.Reissue(oldSignerId, change.New())
+		ctx.NoError(err)
+		ctx.Equal(0, reissue.IdentityCertsRequested)
+	})
+
+	t.Run("reissuing from unknown signers fails", func(t *testing.T) {
+		_, err := manager.Reissue(eid.New(), change.New())
+		ctx.True(boltz.IsErrNotFoundErr(err))
+	})
+
+}
@@ -0,0 +1,526 @@
+/*
+	Copyright NetFoundry Inc.
+
+	Licensed under the Apache License, Version 2.0 (the "License");
+	you may not use this file except in compliance with the License.
+	You may obtain a copy of the License at
+
+	https://www.apache.org/licenses/LICENSE-2.0
+
+	Unless required by applicable law or agreed to in writing, software
+	distributed under the License is distributed on an "AS IS" BASIS,
+	WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+	See the License for the specific language governing permissions and
+	limitations under the License.
+*/
+
+package model
+
+import (
+	"fmt"
+	"slices"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/michaelquigley/pfxlog"
+	"github.com/openziti/foundation/v2/errorz"
+	"github.com/openziti/storage/boltz"
+	"github.com/openziti/ziti/common/eid"
+	"github.com/openziti/ziti/common/pb/cmd_pb"
+	"github.com/openziti/ziti/common/pb/edge_cmd_pb"
+	"github.com/openziti/ziti/controller/change"
+	"github.com/openziti/ziti/controller/db"
+	"github.com/pkg/errors"
+	"go.etcd.io/bbolt"
+)
+
+const (
+	BulkOperationAddRoleAttributes    = "addRoleAttributes"
+	BulkOperationRemoveRoleAttributes = "removeRoleAttributes"
+	BulkOperationSetAuthPolicy        = "setAuthPolicy"
+	BulkOperationSetExpiresAt         = "setExpiresAt"
+	BulkOperationDisable              = "disable"
+	BulkOperationEnable               = "enable"
+	BulkOperationDelete               = "delete"
+
+	DefaultBulkOperationBatchSize = 100
+	MaxBulkOperationBatchSize     = 1000
+
+	// maxRetainedBulkOperations is the number of bulk operations whose status is kept in memory. Once exceeded, the
+	// oldest completed operations are discarded
+	maxRetainedBulkOperations = 50
+)
+
+var bulkOperations = []string{
+	BulkOperationAddRoleAttributes,
+	BulkOperationRemoveRoleAttributes,
+	BulkOperationSetAuthPolicy,
+	BulkOperationSetExpiresAt,
+	BulkOperationDisable,
+	BulkOperationEnable,
+	BulkOperationDelete,
+}
+
+// BulkIdentityOperation describes an operation to apply to a set of identities. Identities are selected either by
+// id or with a filter, but not both
+type BulkIdentityOperation struct {
+	Operation       string
+	IdentityIds     []string
+	Filter          string
+	RoleAttributes  []string
+	AuthPolicyId    string
+	ExpiresAt       *time.Time
+	DisableDuration time.Duration
+	BatchSize       int
+}
+
+type BulkOperationFailure struct {
+	IdentityId string
+	Error      string
+}
+
+// BulkOperation reports the progress of a bulk operation. Instances returned by the IdentityManager are copies and
+// won't be updated as the operation progresses
+type BulkOperation struct {
+	Id          string
+	Operation   string
+	Total       int
+	Processed   int
+	Succeeded   int
+	Failures    []*BulkOperationFailure
+	StartedAt   time.Time
+	CompletedAt *time.Time
+}
+
+func (self *BulkOperation) IsComplete() bool {
+	return self.CompletedAt != nil
+}
+
+type bulkOperationTracker struct {
+	sync.Mutex
+	operations map[string]*BulkOperation
+}
+
+func newBulkOperationTracker() *bulkOperationTracker {
+	return &bulkOperationTracker{
+		operations: map[string]*BulkOperation{},
+	}
+}
+
+func (self *bulkOperationTracker) add(op *BulkOperation) {
+	self.Lock()
+	defer self.Unlock()
+
+	self.operations[op.Id] = op
+
+	if len(self.operations) > maxRetainedBulkOperations {
+		var completed []*BulkOperation
+		for _, v := range self.operations {
+			if v.IsComplete() {
+				completed = append(completed, v)
+			}
+		}
+		sort.Slice(completed, func(i, j int) bool {
+			return completed[i].StartedAt.Before(completed[j].StartedAt)
+		})
+		for i := 0; i < len(completed) && len(self.operations) > maxRetainedBulkOperations; i++ {
+			delete(self.operations, completed[i].Id)
+		}
+	}
+}
+
+func (self *bulkOperationTracker) get(id string) *BulkOperation {
+	self.Lock()
+	defer self.Unlock()
+
+	if op, ok := self.operations[id]; ok {
+		return op.copy()
+	}
+	return nil
+}
+
+func (self *bulkOperationTracker) list() []*BulkOperation {
+	self.Lock()
+	defer self.Unlock()
+
+	var result []*BulkOperation
+	for _, op := range self.operations {
+		result = append(result, op.copy())
+	}
+	sort.Slice(result, func(i, j int) bool {
+		return result[i].StartedAt.After(result[j].StartedAt)
+	})
+	return result
+}
+
+func (self *bulkOperationTracker) succeeded(op *BulkOperation, count int) {
+	self.Lock()
+	defer self.Unlock()
+	op.Processed += count
+	op.Succeeded += count
+}
+
+func (self *bulkOperationTracker) failed(op *BulkOperation, identityId string, err error) {
+	self.Lock()
+	defer self.Unlock()
+	op.Processed++
+	op.Failures = append(op.Failures, &BulkOperationFailure{
+		IdentityId: identityId,
+		Error:      err.Error(),
+	})
+}
+
+func (self *bulkOperationTracker) complete(op *BulkOperation) {
+	self.Lock()
+	defer self.Unlock()
+	now := time.Now()
+	op.CompletedAt = &now
+}
+
+func (self *BulkOperation) copy() *BulkOperation {
+	result := *self
+	result.Failures = slices.Clone(self.Failures)
+	return &result
+}
+
+// StartBulkOperation resolves the identities the operation applies to and starts applying it in the background.
+// Identities are updated in batches, with each batch applied as a single command. If a batch fails, its identities
+// are retried one at a time, so that a single bad identity doesn't fail the whole batch and each failure can be
+// reported. The returned BulkOperation has the initial status, later status can be retrieved with GetBulkOperation
+func (self *IdentityManager) StartBulkOperation(bulkOp *BulkIdentityOperation, ctx *change.Context) (*BulkOperation, error) {
+	if err := self.validateBulkOperation(bulkOp); err != nil {
+		return nil, err
+	}
+
+	batchSize := bulkOp.BatchSize
+	if batchSize == 0 {
+		batchSize = DefaultBulkOperationBatchSize
+	}
+
+	ids, missing, err := self.resolveBulkOperationIdentities(bulkOp)
+	if err != nil {
+		return nil, err
+	}
+
+	op := &BulkOperation{
+		Id:        eid.New(),
+		Operation: bulkOp.Operation,
+		Total:     len(ids) + len(missing),
+		StartedAt: time.Now(),
+	}
+
+	for _, id := range missing {
+		self.bulkOperations.failed(op, id, boltz.NewNotFoundError(db.EntityTypeIdentities, "id", id))
+	}
+
+	self.bulkOperations.add(op)
+	result := self.bulkOperations.get(op.Id)
+
+	cmd := &BulkUpdateIdentitiesCmd{
+		manager:        self,
+		Operation:      bulkOp.Operation,
+		RoleAttributes: bulkOp.RoleAttributes,
+		AuthPolicyId:   bulkOp.AuthPolicyId,
+		ExpiresAt:      bulkOp.ExpiresAt,
+		Context:        ctx,
+	}
+
+	now := time.Now()
+	if bulkOp.Operation == BulkOperationDisable {
+		cmd.DisabledAt = &now
+		if bulkOp.DisableDuration > 0 {
+			disabledUntil := now.Add(bulkOp.DisableDuration)
+			cmd.DisabledUntil = &disabledUntil
+		}
+	} else if bulkOp.Operation == BulkOperationEnable {
+		cmd.LastActivityAt = &now
+	}
+
+	go self.runBulkOperation(op, cmd, ids, batchSize)
+
+	return result, nil
+}
+
+func (self *IdentityManager) GetBulkOperation(id string) (*BulkOperation, error) {
+	if op := self.bulkOperations.get(id); op != nil {
+		return op, nil
+	}
+	return nil, boltz.NewNotFoundError("bulk-operation", "id", id)
+}
+
+// ListBulkOperations returns the bulk operations started on this controller, most recent first
+func (self *IdentityManager) ListBulkOperations() []*BulkOperation {
+	return self.bulkOperations.list()
+}
+
+func (self *IdentityManager) validateBulkOperation(bulkOp *BulkIdentityOperation) error {
+	if !slices.Contains(bulkOperations, bulkOp.Operation) {
+		return errorz.NewFieldError(fmt.Sprintf("invalid operation, must be one of %s", strings.Join(bulkOperations, ", ")),
+			"operation", bulkOp.Operation)
+	}
+
+	if len(bulkOp.IdentityIds) > 0 && bulkOp.Filter != "" {
+		return errorz.NewFieldError("only one of identityIds or filter may be provided", "filter", bulkOp.Filter)
+	}
+
+	if len(bulkOp.IdentityIds) == 0 && bulkOp.Filter == "" {
+		return errorz.NewFieldError("one of identityIds or filter must be provided", "identityIds", bulkOp.IdentityIds)
+	}
+
+	if bulkOp.BatchSize < 0 || bulkOp.BatchSize > MaxBulkOperationBatchSize {
+		return errorz.NewFieldError(fmt.Sprintf("batch size must be between 1 and %d", MaxBulkOperationBatchSize),
+			"batchSize", bulkOp.BatchSize)
+	}
+
+	switch bulkOp.Operation {
+	case BulkOperationAddRoleAttributes, BulkOperationRemoveRoleAttributes:
+		if len(bulkOp.RoleAttributes) == 0 {
+			return errorz.NewFieldError("role attributes are required", "roleAttributes", bulkOp.RoleAttributes)
+		}
+	case BulkOperationSetAuthPolicy:
+		if bulkOp.AuthPolicyId == "" {
+			return errorz.NewFieldError("auth policy id is required", "authPolicyId", bulkOp.AuthPolicyId)
+		}
+		if _, err := self.env.GetManagers().AuthPolicy.Read(bulkOp.AuthPolicyId); err != nil {
+			if boltz.IsErrNotFoundErr(err) {
+				return errorz.NewFieldError("auth policy not found", "authPolicyId", bulkOp.AuthPolicyId)
+			}
+			return err
+		}
+	case BulkOperationDisable:
+		if bulkOp.DisableDuration < 0 {
+			return errorz.NewFieldError("disable duration may not be negative", "durationMinutes", bulkOp.DisableDuration)
+		}
+	}
+
+	return nil
+}
+
+// resolveBulkOperationIdentities returns the ids of the identities the operation applies to, along with any
+// explicitly requested ids which don't exist
+func (self *IdentityManager) resolveBulkOperationIdentities(bulkOp *BulkIdentityOperation) ([]string, []string, error) {
+	store := self.env.GetStores().Identity
+
+	var ids []string
+	var missing []string
+
+	err := self.GetDb().View(func(tx *bbolt.Tx) error {
+		if bulkOp.Filter != "" {
+			var err error
+			ids, _, err = store.QueryIds(tx, bulkOp.Filter)
+			return err
+		}
+
+		seen := map[string]struct{}{}
+		for _, id := range bulkOp.IdentityIds {
+			if _, ok := seen[id]; ok {
+				continue
+			}
+			seen[id] = struct{}{}
+			if store.IsEntityPresent(tx, id) {
+				ids = append(ids, id)
+			} else {
+				missing = append(missing, id)
+			}
+		}
+		return nil
+	})
+
+	if err != nil {
+		return nil, nil, errorz.NewFieldError(err.Error(), "filter", bulkOp.Filter)
+	}
+
+	return ids, missing, nil
+}
+
+func (self *IdentityManager) runBulkOperation(op *BulkOperation, template *BulkUpdateIdentitiesCmd, ids []string, batchSize int) {
+	log := pfxlog.Logger().WithField("bulkOperationId", op.Id).WithField("operation", op.Operation)
+	log.WithField("identities", len(ids)).Info("starting bulk identity operation")
+
+	self.dispatchBulkUpdate(template, ids, batchSize, func(ids []string) {
+		self.bulkOperations.succeeded(op, len(ids))
+	}, func(id string, err error) {
+		self.bulkOperations.failed(op, id, err)
+	})
+
+	self.bulkOperations.complete(op)
+
+	result := self.bulkOperations.get(op.Id)
+	log.WithField("succeeded", result.Succeeded).WithField("failed", len(result.Failures)).Info("bulk identity operation complete")
+}
+
+// dispatchBulkUpdate applies the given command to the identities in batches. If a batch fails, its identities are
+// retried individually, so that failures can be attributed to specific identities
+func (self *IdentityManager) dispatchBulkUpdate(template *BulkUpdateIdentitiesCmd, ids []string, batchSize int,
+	onSuccess func(ids []string), onFailure func(id string, err error)) {
+
+	for len(ids) > 0 {
+		batch := ids[:min(batchSize, len(ids))]
+		ids = ids[len(batch):]
+
+		if err := self.Dispatch(template.forIdentities(batch)); err == nil {
+			onSuccess(batch)
+			self.afterBulkUpdate(template, batch)
+			continue
+		} else if len(batch) == 1 {
+			onFailure(batch[0], err)
+			continue
+		}
+
+		for _, id := range batch {
+			if err := self.Dispatch(template.forIdentities([]string{id})); err != nil {
+				onFailure(id, err)
+			} else {
+				onSuccess([]string{id})
+				self.afterBulkUpdate(template, []string{id})
+			}
+		}
+	}
+}
+
+// afterBulkUpdate removes the api sessions of identities which were disabled or expired by the update
+func (self *IdentityManager) afterBulkUpdate(cmd *BulkUpdateIdentitiesCmd, ids []string) {
+	expired := cmd.Operation == BulkOperationSetExpiresAt && cmd.ExpiresAt != nil && !cmd.ExpiresAt.After(time.Now())
+	if cmd.Operation != BulkOperationDisable && !expired {
+		return
+	}
+
+	for _, id := range ids {
+		if err := self.env.GetManagers().ApiSession.DeleteByIdentityId(id, cmd.Context); err != nil {
+			pfxlog.Logger().WithError(err).WithField("identityId", id).Error("failed to remove api sessions of disabled identity")
+		}
+	}
+}
+
+func (self *IdentityManager) ApplyBulkUpdate(cmd *BulkUpdateIdentitiesCmd, ctx boltz.MutateContext) error {
+	store := self.env.GetStores().Identity
+
+	return self.GetDb().Update(ctx, func(ctx boltz.MutateContext) error {
+		for _, id := range cmd.IdentityIds {
+			if cmd.Operation == BulkOperationDelete {
+				if err := store.DeleteById(ctx, id); err != nil {
+					return err
+				}
+				continue
+			}
+
+			identity, err := store.LoadById(ctx.Tx(), id)
+			if err != nil {
+				return err
+			}
+
+			var checker boltz.MapFieldChecker
+
+			switch cmd.Operation {
+			case BulkOperationAddRoleAttributes:
+				updated := slices.Clone(identity.RoleAttributes)
+				for _, attr := range cmd.RoleAttributes {
+					if !slices.Contains(updated, attr) {
+						updated = append(updated, attr)
+					}
+				}
+				if len(updated) == len(identity.RoleAttributes) {
+					continue
+				}
+				identity.RoleAttributes = updated
+				checker = boltz.MapFieldChecker{db.FieldRoleAttributes: struct{}{}}
+			case BulkOperationRemoveRoleAttributes:
+				updated := slices.DeleteFunc(slices.Clone(identity.RoleAttributes), func(attr string) bool {
+					return slices.Contains(cmd.RoleAttributes, attr)
+				})
+				if len(updated) == len(identity.RoleAttributes) {
+					continue
+				}
+				identity.RoleAttributes = updated
+				checker = boltz.MapFieldChecker{db.FieldRoleAttributes: struct{}{}}
+			case BulkOperationSetAuthPolicy:
+				identity.AuthPolicyId = cmd.AuthPolicyId
+				checker = boltz.MapFieldChecker{db.FieldIdentityAuthPolicyId: struct{}{}}
+			case BulkOperationSetExpiresAt:
+				identity.ExpiresAt = cmd.ExpiresAt
+				checker = boltz.MapFieldChecker{db.FieldIdentityExpiresAt: struct{}{}}
+			case BulkOperationDisable:
+				identity.DisabledAt = cmd.DisabledAt
+				identity.DisabledUntil = cmd.DisabledUntil
+				checker = boltz.MapFieldChecker{
+					db.FieldIdentityDisabledAt:    struct{}{},
+					db.FieldIdentityDisabledUntil: struct{}{},
+				}
+			case BulkOperationEnable:
+				identity.DisabledAt = nil
+				identity.DisabledUntil = nil
+				identity.LastActivityAt = cmd.LastActivityAt
+				checker = boltz.MapFieldChecker{
+					db.FieldIdentityDisabledAt:     struct{}{},
+					db.FieldIdentityDisabledUntil:  struct{}{},
+					db.FieldIdentityLastActivityAt: struct{}{},
+				}
+			default:
+				return errors.Errorf("unsupported bulk identity operation: %s", cmd.Operation)
+			}
+
+			if err = store.Update(ctx, identity, checker); err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+}
+
+// BulkUpdateIdentitiesCmd applies a bulk operation to a batch of identities in a single transaction
+type BulkUpdateIdentitiesCmd struct {
+	manager        *IdentityManager
+	Operation      string
+	IdentityIds    []string
+	RoleAttributes []string
+	AuthPolicyId   string
+	DisabledAt     *time.Time
+	DisabledUntil  *time.Time
+	ExpiresAt      *time.Time
+	LastActivityAt *time.Time
+	Context        *change.Context
+}
+
+func (self *BulkUpdateIdentitiesCmd) forIdentities(ids []string) *BulkUpdateIdentitiesCmd {
+	result := *self
+	result.IdentityIds = ids
+	return &result
+}
+
+func (self *BulkUpdateIdentitiesCmd) Apply(ctx boltz.MutateContext) error {
+	return self.manager.ApplyBulkUpdate(self, ctx)
+}
+
+func (self *BulkUpdateIdentitiesCmd) Encode() ([]byte, error) {
+	return cmd_pb.EncodeProtobuf(&edge_cmd_pb.BulkUpdateIdentitiesCmd{
+		Operation:      self.Operation,
+		IdentityIds:    self.IdentityIds,
+		RoleAttributes: self.RoleAttributes,
+		AuthPolicyId:   self.AuthPolicyId,
+		DisabledAt:     timePtrToPb(self.DisabledAt),
+		DisabledUntil:  timePtrToPb(self.DisabledUntil),
+		ExpiresAt:      timePtrToPb(self.ExpiresAt),
+		LastActivityAt: timePtrToPb(self.LastActivityAt),
+		Ctx:            ContextToProtobuf(self.Context),
+	})
+}
+
+func (self *BulkUpdateIdentitiesCmd) Decode(env Env, msg *edge_cmd_pb.BulkUpdateIdentitiesCmd) error {
+	self.manager = env.GetManagers().Identity
+	self.Operation = msg.Operation
+	self.IdentityIds = msg.IdentityIds
+	self.RoleAttributes = msg.RoleAttributes
+	self.AuthPolicyId = msg.AuthPolicyId
+	self.DisabledAt = pbTimeToTimePtr(msg.DisabledAt)
+	self.DisabledUntil = pbTimeToTimePtr(msg.DisabledUntil)
+	self.ExpiresAt = pbTimeToTimePtr(msg.ExpiresAt)
+	self.LastActivityAt = pbTimeToTimePtr(msg.LastActivityAt)
+	self.Context = ProtobufToContext(msg.Ctx)
+	return nil
+}
+
+func (self *BulkUpdateIdentitiesCmd) GetChangeContext() *change.Context {
+	return self.Context
+}
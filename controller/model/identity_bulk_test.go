@@ -0,0 +1,202 @@
+/*
+	Copyright NetFoundry Inc.
+
+	Licensed under the Apache License, Version 2.0 (the "License");
+	you may not use this file except in compliance with the License.
+	You may obtain a copy of the License at
+
+	https://www.apache.org/licenses/LICENSE-2.0
+
+	Unless required by applicable law or agreed to in writing, software
+	distributed under the License is distributed on an "AS IS" BASIS,
+	WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+	See the License for the specific language governing permissions and
+	limitations under the License.
+*/
+
+package model
+
+import (
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/openziti/foundation/v2/errorz"
+	"github.com/openziti/storage/boltz"
+	"github.com/openziti/ziti/common/eid"
+	"github.com/openziti/ziti/controller/change"
+	"github.com/openziti/ziti/controller/db"
+	"github.com/stretchr/testify/require"
+)
+
+func TestBulkIdentityOperations(t *testing.T) {
+	ctx := NewTestContext(t)
+	defer ctx.Cleanup()
+	ctx.Init()
+
+	manager := ctx.managers.Identity
+
+	requireComplete := func(op *BulkOperation, err error) *BulkOperation {
+		ctx.NoError(err)
+		var result *BulkOperation
+		require.Eventually(t, func() bool {
+			result, err = manager.GetBulkOperation(op.Id)
+			ctx.NoError(err)
+			return result.IsComplete()
+		}, 10*time.Second, 10*time.Millisecond)
+		return result
+	}
+
+	newIdentities := func(count int, roleAttributes ...string) []*Identity {
+		var result []*Identity
+		for i := 0; i < count; i++ {
+			identity := &Identity{
+				Name:           eid.New(),
+				IdentityTypeId: db.DefaultIdentityType,
+				RoleAttributes: roleAttributes,
+			}
+			ctx.NoError(manager.Create(identity, change.New()))
+			result = append(result, identity)
+		}
+		return result
+	}
+
+	t.Run("role attributes are added and removed by filter", func(t *testing.T) {
+		attr := eid.New()
+		added := eid.New()
+		identities := newIdentities(5, attr)
+		filter := fmt.Sprintf(`anyOf(roleAttributes) = "%s"`, attr)
+
+		op := requireComplete(manager.StartBulkOperation(&BulkIdentityOperation{
+			Operation:      BulkOperationAddRoleAttributes,
+			Filter:         filter,
+			RoleAttributes: ss(added, attr),
+			BatchSize:      2,
+		}, change.New()))
+
+		ctx.Equal(5, op.Total)
+		ctx.Equal(5, op.Processed)
+		ctx.Equal(5, op.Succeeded)
+		ctx.Empty(op.Failures)
+
+		for _, identity := range identities {
+			loaded, err := manager.Read(identity.Id)
+			ctx.NoError(err)
+			ctx.ElementsMatch(ss(attr, added), loaded.RoleAttributes)
+		}
+
+		op = requireComplete(manager.StartBulkOperation(&BulkIdentityOperation{
+			Operation:      BulkOperationRemoveRoleAttributes,
+			Filter:         filter,
+			RoleAttributes: ss(attr),
+		}, change.New()))
+		ctx.Equal(5, op.Succeeded)
+
+		for _, identity := range identities {
+			loaded, err := manager.Read(identity.Id)
+			ctx.NoError(err)
+			ctx.Equal(ss(added), loaded.RoleAttributes)
+		}
+	})
+
+	t.Run("identities are disabled and enabled", func(t *testing.T) {
+		identities := newIdentities(3)
+		ids := ss(identities[0].Id, identities[1].Id, identities[2].Id)
+
+		op := requireComplete(manager.StartBulkOperation(&BulkIdentityOperation{
+			Operation:       BulkOperationDisable,
+			IdentityIds:     ids,
+			DisableDuration: time.Hour,
+		}, change.New()))
+		ctx.Equal(3, op.Succeeded)
+
+		for _, id := range ids {
+			loaded, err := manager.Read(id)
+			ctx.NoError(err)
+			ctx.NotNil(loaded.DisabledAt)
+			ctx.NotNil(loaded.DisabledUntil)
+			ctx.Equal(time.Hour, loaded.DisabledUntil.Sub(*loaded.DisabledAt))
+		}
+
+		op = requireComplete(manager.StartBulkOperation(&BulkIdentityOperation{
+			Operation:   BulkOperationEnable,
+			IdentityIds: ids,
+		}, change.New()))
+		ctx.Equal(3, op.Succeeded)
+
+		for _, id := range ids {
+			loaded, err := manager.Read(id)
+			ctx.NoError(err)
+			ctx.Nil(loaded.DisabledAt)
+			ctx.Nil(loaded.DisabledUntil)
+		}
+	})
+
+	t.Run("failures in a batch are reported per identity", func(t *testing.T) {
+		identities := newIdentities(2)
+		admin := &Identity{
+			Name:           eid.New(),
+			IdentityTypeId: db.DefaultIdentityType,
+			IsAdmin:        true,
+			IsDefaultAdmin: true,
+		}
+		ctx.NoError(manager.Create(admin, change.New()))
+		missingId := eid.New()
+
+		op := requireComplete(manager.StartBulkOperation(&BulkIdentityOperation{
+			Operation:   BulkOperationDelete,
+			IdentityIds: ss(identities[0].Id, admin.Id, missingId, identities[1].Id, identities[0].Id),
+		}, change.New()))
+
+		ctx.Equal(4, op.Total)
+		ctx.Equal(4, op.Processed)
+		ctx.Equal(2, op.Succeeded)
+		ctx.Len(op.Failures, 2)
+
+		failedIds := map[string]string{}
+		for _, failure := range op.Failures {
+			failedIds[failure.IdentityId] = failure.Error
+		}
+		ctx.Contains(failedIds, admin.Id)
+		ctx.Contains(failedIds, missingId)
+
+		for _, identity := range identities {
+			_, err := manager.Read(identity.Id)
+			ctx.True(boltz.IsErrNotFoundErr(err))
+		}
+
+		_, err := manager.Read(admin.Id)
+		ctx.NoError(err)
+	})
+
+	t.Run("operations are listed most recent first", func(t *testing.T) {
+		ops := manager.ListBulkOperations()
+		ctx.NotEmpty(ops)
+		for i := 1; i < len(ops); i++ {
+			ctx.False(ops[i].StartedAt.After(ops[i-1].StartedAt))
+		}
+	})
+
+	t.Run("invalid operations are rejected", func(t *testing.T) {
+		identity := newIdentities(1)[0]
+
+		invalid := []*BulkIdentityOperation{
+			{Operation: "rename", IdentityIds: ss(identity.Id)},
+			{Operation: BulkOperationEnable},
+			{Operation: BulkOperationEnable, IdentityIds: ss(identity.Id), Filter: "true"},
+			{Operation: BulkOperationAddRoleAttributes, IdentityIds: ss(identity.Id)},
+			{Operation: BulkOperationSetAuthPolicy, IdentityIds: ss(identity.Id), AuthPolicyId: eid.New()},
+			{Operation: BulkOperationEnable, IdentityIds: ss(identity.Id), BatchSize: MaxBulkOperationBatchSize + 1},
+			{Operation: BulkOperationEnable, Filter: "not a valid filter"},
+		}
+
+		for _, bulkOp := range invalid {
+			_, err := manager.StartBulkOperation(bulkOp, change.New())
+			var fieldErr *errorz.FieldError
+			ctx.ErrorAs(err, &fieldErr)
+		}
+
+		_, err := manager.GetBulkOperation(eid.New())
+		ctx.True(boltz.IsErrNotFoundErr(err))
+	})
+}
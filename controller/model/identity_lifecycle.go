@@ -0,0 +1,276 @@
+/*
+	Copyright NetFoundry Inc.
+
+	Licensed under the Apache License, Version 2.0 (the "License");
+	you may not use this file except in compliance with the License.
+	You may obtain a copy of the License at
+
+	https://www.apache.org/licenses/LICENSE-2.0
+
+	Unless required by applicable law or agreed to in writing, software
+	distributed under the License is distributed on an "AS IS" BASIS,
+	WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+	See the License for the specific language governing permissions and
+	limitations under the License.
+*/
+
+package model
+
+import (
+	"time"
+
+	"github.com/michaelquigley/pfxlog"
+	"github.com/openziti/storage/ast"
+	"github.com/openziti/ziti/controller/change"
+	"github.com/openziti/ziti/controller/db"
+	"github.com/openziti/ziti/controller/event"
+	"go.etcd.io/bbolt"
+)
+
+// identityLifecycleChecker periodically applies the configured identity lifecycle rules. Expired identities are
+// treated as disabled when loaded, so the checker only needs to report them and remove their api sessions. Identities
+// which have been inactive for too long are disabled and identities which never enrolled are deleted once their
+// enrollments have expired. Router identities and the default admin are exempt from disabling and deletion.
+//
+// Checks only run on the leader. The expiry dates which have already been reported are tracked in memory, so a new
+// leader may report them again.
+type identityLifecycleChecker struct {
+	env     Env
+	manager *IdentityManager
+	warned  map[string]time.Time
+	expired map[string]time.Time
+}
+
+func newIdentityLifecycleChecker(env Env, manager *IdentityManager) *identityLifecycleChecker {
+	return &identityLifecycleChecker{
+		env:     env,
+		manager: manager,
+		warned:  map[string]time.Time{},
+		expired: map[string]time.Time{},
+	}
+}
+
+type identityLifecycleActions struct {
+	expiring []*event.IdentityLifecycleEvent
+	expired  []*event.IdentityLifecycleEvent
+	disable  []*event.IdentityLifecycleEvent
+	delete   []*event.IdentityLifecycleEvent
+}
+
+func (self *identityLifecycleChecker) run() {
+	ticker := time.NewTicker(self.env.GetConfig().Edge.IdentityLifecycle.CheckInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ticker.C:
+			if self.env.GetCommandDispatcher().IsLeaderOrLeaderless() {
+				self.check(time.Now())
+			}
+		case <-self.env.GetCloseNotifyChannel():
+			return
+		}
+	}
+}
+
+func (self *identityLifecycleChecker) check(now time.Time) {
+	actions, err := self.evaluate(now)
+	if err != nil {
+		pfxlog.Logger().WithError(err).Error("failed to evaluate identity lifecycle rules")
+		return
+	}
+
+	for _, evt := range actions.expiring {
+		self.warned[evt.IdentityId] = *evt.ExpiresAt
+		self.accept(evt)
+	}
+
+	ctx := change.New().SetSourceType("identity.lifecycle").SetChangeAuthorType(change.AuthorTypeController)
+
+	for _, evt := range actions.expired {
+		if err = self.env.GetManagers().ApiSession.DeleteByIdentityId(evt.IdentityId, ctx); err != nil {
+			pfxlog.Logger().WithError(err).WithField("identityId", evt.IdentityId).Error("failed to remove api sessions of expired identity")
+			continue
+		}
+		self.expired[evt.IdentityId] = *evt.ExpiresAt
+		self.accept(evt)
+	}
+
+	self.apply(&BulkUpdateIdentitiesCmd{
+		manager:    self.manager,
+		Operation:  BulkOperationDisable,
+		DisabledAt: &now,
+		Context:    ctx,
+	}, actions.disable)
+
+	self.apply(&BulkUpdateIdentitiesCmd{
+		manager:   self.manager,
+		Operation: BulkOperationDelete,
+		Context:   ctx,
+	}, actions.delete)
+}
+
+func (self *identityLifecycleChecker) accept(evt *event.IdentityLifecycleEvent) {
+	if dispatcher := self.env.GetEventDispatcher(); dispatcher != nil {
+		dispatcher.AcceptIdentityLifecycleEvent(evt)
+	}
+}
+
+func (self *identityLifecycleChecker) apply(cmd *BulkUpdateIdentitiesCmd, events []*event.IdentityLifecycleEvent) {
+	if len(events) == 0 {
+		return
+	}
+
+	eventsById := map[string]*event.IdentityLifecycleEvent{}
+	var ids []string
+	for _, evt := range events {
+		eventsById[evt.IdentityId] = evt
+		ids = append(ids, evt.IdentityId)
+	}
+
+	self.manager.dispatchBulkUpdate(cmd, ids, DefaultBulkOperationBatchSize, func(ids []string) {
+		for _, id := range ids {
+			self.accept(eventsById[id])
+		}
+	}, func(id string, err error) {
+		pfxlog.Logger().WithError(err).WithField("identityId", id).WithField("operation", cmd.Operation).
+			Error("failed to apply identity lifecycle rule")
+	})
+}
+
+// evaluate determines which lifecycle rules apply to which identities. Expiry notifications which no longer apply,
+// because the expiry date changed or the identity was removed, are forgotten
+func (self *identityLifecycleChecker) evaluate(now time.Time) (*identityLifecycleActions, error) {
+	cfg := self.env.GetConfig().Edge.IdentityLifecycle
+	result := &identityLifecycleActions{}
+	warned := map[string]time.Time{}
+	expired := map[string]time.Time{}
+
+	newEvent := func(eventType event.IdentityLifecycleEventType, identity *db.Identity) *event.IdentityLifecycleEvent {
+		return &event.IdentityLifecycleEvent{
+			Namespace:    event.IdentityLifecycleEventNS,
+			Timestamp:    now,
+			EventType:    eventType,
+			IdentityId:   identity.Id,
+			IdentityName: identity.Name,
+		}
+	}
+
+	err := self.env.GetDb().View(func(tx *bbolt.Tx) error {
+		store := self.env.GetStores().Identity
+		for cursor := store.IterateIds(tx, ast.BoolNodeTrue); cursor.IsValid(); cursor.Next() {
+			identity, err := store.LoadById(tx, string(cursor.Current()))
+			if err != nil {
+				return err
+			}
+
+			if identity.IdentityTypeId == db.RouterIdentityType {
+				continue
+			}
+
+			if expiresAt := identity.ExpiresAt; expiresAt != nil {
+				if !expiresAt.After(now) {
+					if previous, ok := self.expired[identity.Id]; ok && previous.Equal(*expiresAt) {
+						expired[identity.Id] = previous
+					} else {
+						evt := newEvent(event.IdentityLifecycleExpired, identity)
+						evt.ExpiresAt = expiresAt
+						result.expired = append(result.expired, evt)
+					}
+				} else if cfg.ExpiryWarning > 0 && expiresAt.Sub(now) <= cfg.ExpiryWarning {
+					if previous, ok := self.warned[identity.Id]; ok && previous.Equal(*expiresAt) {
+						warned[identity.Id] = previous
+					} else {
+						evt := newEvent(event.IdentityLifecycleExpiring, identity)
+						evt.ExpiresAt = expiresAt
+						result.expiring = append(result.expiring, evt)
+					}
+				}
+			}
+
+			if identity.IsDefaultAdmin {
+				continue
+			}
+
+			if cfg.InactivityDisableAfter > 0 && !identity.Disabled {
+				lastActivityAt := self.getLastActivity(tx, identity)
+				if now.Sub(lastActivityAt) > cfg.InactivityDisableAfter {
+					evt := newEvent(event.IdentityLifecycleDisabled, identity)
+					evt.LastActivityAt = &lastActivityAt
+					evt.Reason = event.IdentityLifecycleReasonInactive
+					result.disable = append(result.disable, evt)
+				}
+			}
+
+			if cfg.UnenrolledDeleteAfter > 0 && self.isUnenrolledAndExpired(tx, identity, now.Add(-cfg.UnenrolledDeleteAfter)) {
+				evt := newEvent(event.IdentityLifecycleDeleted, identity)
+				evt.Reason = event.IdentityLifecycleReasonEnrollmentExpired
+				result.delete = append(result.delete, evt)
+			}
+		}
+		return nil
+	})
+
+	if err != nil {
+		return nil, err
+	}
+
+	self.warned = warned
+	self.expired = expired
+	return result, nil
+}
+
+// getLastActivity returns the most recent of when the identity was created, last enrolled, and last used an api
+// session
+func (self *identityLifecycleChecker) getLastActivity(tx *bbolt.Tx, identity *db.Identity) time.Time {
+	result := identity.CreatedAt
+	update := func(t time.Time) {
+		if t.After(result) {
+			result = t
+		}
+	}
+
+	if identity.LastActivityAt != nil {
+		update(*identity.LastActivityAt)
+	}
+
+	for _, authenticatorId := range identity.Authenticators {
+		if authenticator, _ := self.env.GetStores().Authenticator.LoadById(tx, authenticatorId); authenticator != nil {
+			update(authenticator.CreatedAt)
+		}
+	}
+
+	heartbeats := self.env.GetManagers().ApiSession.HeartbeatCollector
+	for _, apiSessionId := range self.env.GetStores().Identity.GetRelatedEntitiesIdList(tx, identity.Id, db.EntityTypeApiSessions) {
+		if apiSession, _ := self.env.GetStores().ApiSession.LoadById(tx, apiSessionId); apiSession != nil {
+			update(apiSession.CreatedAt)
+			update(apiSession.LastActivityAt)
+		}
+		if lastAccessedAt, ok := heartbeats.LastAccessedAt(apiSessionId); ok {
+			update(*lastAccessedAt)
+		}
+	}
+
+	return result
+}
+
+// isUnenrolledAndExpired returns true if the identity has never been used, has no authenticators, and all of its
+// enrollments expired before the given cutoff
+func (self *identityLifecycleChecker) isUnenrolledAndExpired(tx *bbolt.Tx, identity *db.Identity, cutoff time.Time) bool {
+	if len(identity.Authenticators) > 0 || len(identity.Enrollments) == 0 || identity.LastActivityAt != nil {
+		return false
+	}
+
+	if len(self.env.GetStores().Identity.GetRelatedEntitiesIdList(tx, identity.Id, db.EntityTypeApiSessions)) > 0 {
+		return false
+	}
+
+	for _, enrollmentId := range identity.Enrollments {
+		enrollment, _ := self.env.GetStores().Enrollment.LoadById(tx, enrollmentId)
+		if enrollment == nil || enrollment.ExpiresAt == nil || !enrollment.ExpiresAt.Before(cutoff) {
+			return false
+		}
+	}
+
+	return true
+}
@@ -0,0 +1,145 @@
+/*
+	Copyright NetFoundry Inc.
+
+	Licensed under the Apache License, Version 2.0 (the "License");
+	you may not use this file except in compliance with the License.
+	You may obtain a copy of the License at
+
+	https://www.apache.org/licenses/LICENSE-2.0
+
+	Unless required by applicable law or agreed to in writing, software
+	distributed under the License is distributed on an "AS IS" BASIS,
+	WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+	See the License for the specific language governing permissions and
+	limitations under the License.
+*/
+
+package model
+
+import (
+	"testing"
+	"time"
+
+	"github.com/openziti/ziti/common/eid"
+	"github.com/openziti/ziti/controller/change"
+	"github.com/openziti/ziti/controller/config"
+	"github.com/openziti/ziti/controller/db"
+	"github.com/openziti/ziti/controller/event"
+)
+
+func TestIdentityLifecycle(t *testing.T) {
+	ctx := NewTestContext(t)
+	defer ctx.Cleanup()
+	ctx.Init()
+
+	ctx.config.Edge.IdentityLifecycle = config.IdentityLifecycleConfig{
+		ExpiryWarning:          7 * 24 * time.Hour,
+		InactivityDisableAfter: 30 * 24 * time.Hour,
+		UnenrolledDeleteAfter:  24 * time.Hour,
+	}
+
+	manager := ctx.managers.Identity
+	checker := manager.lifecycle
+
+	newIdentity := func(expiresAt *time.Time) *Identity {
+		identity := &Identity{
+			Name:           eid.New(),
+			IdentityTypeId: db.DefaultIdentityType,
+			ExpiresAt:      expiresAt,
+		}
+		ctx.NoError(manager.Create(identity, change.New()))
+		return identity
+	}
+
+	eventIds := func(events []*event.IdentityLifecycleEvent) []string {
+		var result []string
+		for _, evt := range events {
+			result = append(result, evt.IdentityId)
+		}
+		return result
+	}
+
+	t.Run("expiring identities are reported once", func(t *testing.T) {
+		now := time.Now()
+		expiresAt := now.Add(48 * time.Hour)
+		expiring := newIdentity(&expiresAt)
+		later := now.Add(30 * 24 * time.Hour)
+		notExpiring := newIdentity(&later)
+
+		actions, err := checker.evaluate(now)
+		ctx.NoError(err)
+		ctx.Contains(eventIds(actions.expiring), expiring.Id)
+		ctx.NotContains(eventIds(actions.expiring), notExpiring.Id)
+
+		checker.check(now)
+
+		actions, err = checker.evaluate(now)
+		ctx.NoError(err)
+		ctx.NotContains(eventIds(actions.expiring), expiring.Id)
+	})
+
+	t.Run("expired identities are disabled", func(t *testing.T) {
+		now := time.Now()
+		expiresAt := now.Add(-time.Minute)
+		identity := newIdentity(&expiresAt)
+
+		loaded, err := manager.Read(identity.Id)
+		ctx.NoError(err)
+		ctx.True(loaded.Disabled)
+
+		actions, err := checker.evaluate(now)
+		ctx.NoError(err)
+		ctx.Contains(eventIds(actions.expired), identity.Id)
+
+		checker.check(now)
+
+		actions, err = checker.evaluate(now)
+		ctx.NoError(err)
+		ctx.NotContains(eventIds(actions.expired), identity.Id)
+	})
+
+	t.Run("unenrolled identities are deleted after their enrollments expire", func(t *testing.T) {
+		now := time.Now()
+		enrollmentExpiresAt := now.Add(-time.Hour)
+		identity := &Identity{
+			Name:           eid.New(),
+			IdentityTypeId: db.DefaultIdentityType,
+		}
+		ctx.NoError(manager.CreateWithEnrollments(identity, []*Enrollment{{
+			Method:    db.MethodEnrollOtt,
+			Token:     eid.New(),
+			IssuedAt:  &now,
+			ExpiresAt: &enrollmentExpiresAt,
+		}}, change.New()))
+
+		checker.check(now)
+		_, err := manager.Read(identity.Id)
+		ctx.NoError(err)
+
+		checker.check(now.Add(25 * time.Hour))
+		_, err = manager.Read(identity.Id)
+		ctx.Error(err)
+	})
+
+	t.Run("inactive identities are disabled", func(t *testing.T) {
+		now := time.Now()
+		identity := newIdentity(nil)
+
+		checker.check(now)
+		loaded, err := manager.Read(identity.Id)
+		ctx.NoError(err)
+		ctx.False(loaded.Disabled)
+
+		checker.check(now.Add(31 * 24 * time.Hour))
+		loaded, err = manager.Read(identity.Id)
+		ctx.NoError(err)
+		ctx.True(loaded.Disabled)
+		ctx.NotNil(loaded.DisabledAt)
+
+		ctx.NoError(manager.Enable(identity.Id, change.New()))
+		loaded, err = manager.Read(identity.Id)
+		ctx.NoError(err)
+		ctx.False(loaded.Disabled)
+		ctx.NotNil(loaded.LastActivityAt)
+	})
+}
@@ -63,6 +63,8 @@ type IdentityManager struct {
 	identityStatusMap  *identityStatusMap
 	connections        *ConnectionTracker
 	statusSource       config.IdentityStatusSource
+	bulkOperations     *bulkOperationTracker
+	lifecycle          *identityLifecycleChecker
 }
 
 func NewIdentityManager(env Env) *IdentityManager {
@@ -72,6 +74,7 @@ func NewIdentityManager(env Env) *IdentityManager {
 		identityStatusMap:  newIdentityStatusMap(IdentityActiveIntervalSeconds * time.Second),
 		connections:        newConnectionTracker(env),
 		statusSource:       env.GetConfig().Edge.IdentityStatusConfig.Source,
+		bulkOperations:     newBulkOperationTracker(),
 	}
 	manager.impl = manager
 
@@ -79,6 +82,12 @@ func NewIdentityManager(env Env) *IdentityManager {
 	RegisterCommand(env, &CreateIdentityWithEnrollmentsCmd{}, &edge_cmd_pb.CreateIdentityWithEnrollmentsCmd{})
 	RegisterCommand(env, &CreateIdentityWithAuthenticatorsCmd{}, &edge_cmd_pb.CreateIdentityWithAuthenticatorsCmd{})
 	RegisterCommand(env, &UpdateServiceConfigsCmd{}, &edge_cmd_pb.UpdateServiceConfigsCmd{})
+	RegisterCommand(env, &BulkUpdateIdentitiesCmd{}, &edge_cmd_pb.BulkUpdateIdentitiesCmd{})
+
+	manager.lifecycle = newIdentityLifecycleChecker(env, manager)
+	if env.GetConfig().Edge.IdentityLifecycle.CheckInterval > 0 {
+		go manager.lifecycle.run()
+	}
 
 	return manager
 }
@@ -227,6 +236,8 @@ func (self *IdentityManager) ApplyUpdate(cmd *command.UpdateEntityCommand[*Ident
 			first: self,
 			second: NotFieldChecker{
 				db.FieldIdentityServiceConfigs: struct{}{},
+				db.FieldIdentityExpiresAt:      struct{}{},
+				db.FieldIdentityLastActivityAt: struct{}{},
 			},
 		}
 	} else {
@@ -578,18 +589,23 @@ func (self *IdentityManager) Disable(identityId string, duration time.Duration,
 	return self.GetEnv().GetManagers().ApiSession.DeleteByIdentityId(identityId, ctx)
 }
 
+// Enable re-enables a disabled identity. The last activity time of the identity is also set, so that an identity
+// which was disabled for inactivity isn't immediately disabled again
 func (self *IdentityManager) Enable(identityId string, ctx *change.Context) error {
 	fieldMap := fields.UpdatedFieldsMap{
-		db.FieldIdentityDisabledAt:    struct{}{},
-		db.FieldIdentityDisabledUntil: struct{}{},
+		db.FieldIdentityDisabledAt:     struct{}{},
+		db.FieldIdentityDisabledUntil:  struct{}{},
+		db.FieldIdentityLastActivityAt: struct{}{},
 	}
 
+	now := time.Now()
 	return self.Update(&Identity{
 		BaseEntity: models.BaseEntity{
 			Id: identityId,
 		},
-		DisabledAt:    nil,
-		DisabledUntil: nil,
+		DisabledAt:     nil,
+		DisabledUntil:  nil,
+		LastActivityAt: &now,
 	}, fieldMap, ctx)
 }
 
@@ -672,6 +688,8 @@ func (self *IdentityManager) IdentityToProtobuf(entity *Identity) (*edge_cmd_pb.
 		Disabled:                  entity.Disabled,
 		DisabledAt:                timePtrToPb(entity.DisabledAt),
 		DisabledUntil:             timePtrToPb(entity.DisabledUntil),
+		ExpiresAt:                 timePtrToPb(entity.ExpiresAt),
+		LastActivityAt:            timePtrToPb(entity.LastActivityAt),
 	}
 
 	for serviceId, configInfo := range entity.ServiceConfigs {
@@ -822,6 +840,8 @@ func (self *IdentityManager) ProtobufToIdentity(msg *edge_cmd_pb.Identity) (*Ide
 		Disabled:                  msg.Disabled,
 		DisabledAt:                pbTimeToTimePtr(msg.DisabledAt),
 		DisabledUntil:             pbTimeToTimePtr(msg.DisabledUntil),
+		ExpiresAt:                 pbTimeToTimePtr(msg.ExpiresAt),
+		LastActivityAt:            pbTimeToTimePtr(msg.LastActivityAt),
 		ServiceConfigs:            serviceConfigs,
 	}
 
@@ -95,6 +95,8 @@ type Identity struct {
 	Disabled                   bool
 	DisabledAt                 *time.Time
 	DisabledUntil              *time.Time
+	ExpiresAt                  *time.Time
+	LastActivityAt             *time.Time
 	ServiceConfigs             map[string]map[string]string
 	Interfaces                 []*Interface
 }
@@ -136,6 +138,8 @@ func (entity *Identity) toBoltEntityForCreate(_ *bbolt.Tx, env Env) (*db.Identit
 		ExternalId:                entity.ExternalId,
 		DisabledAt:                entity.DisabledAt,
 		DisabledUntil:             entity.DisabledUntil,
+		ExpiresAt:                 entity.ExpiresAt,
+		LastActivityAt:            entity.LastActivityAt,
 		ServiceConfigs:            entity.ServiceConfigs,
 		Interfaces:                InterfacesToBolt(entity.Interfaces),
 	}
@@ -246,6 +250,8 @@ func (entity *Identity) toBoltEntityForUpdate(tx *bbolt.Tx, env Env, checker bol
 		ExternalId:                entity.ExternalId,
 		DisabledAt:                entity.DisabledAt,
 		DisabledUntil:             entity.DisabledUntil,
+		ExpiresAt:                 entity.ExpiresAt,
+		LastActivityAt:            entity.LastActivityAt,
 		IsAdmin:                   entity.IsAdmin,
 		ServiceConfigs:            entity.ServiceConfigs,
 		Interfaces:                InterfacesToBolt(entity.Interfaces),
@@ -293,6 +299,8 @@ func (entity *Identity) fillFrom(env Env, _ *bbolt.Tx, boltIdentity *db.Identity
 	entity.DisabledUntil = boltIdentity.DisabledUntil
 	entity.DisabledAt = boltIdentity.DisabledAt
 	entity.Disabled = boltIdentity.Disabled
+	entity.ExpiresAt = boltIdentity.ExpiresAt
+	entity.LastActivityAt = boltIdentity.LastActivityAt
 	entity.ServiceConfigs = boltIdentity.ServiceConfigs
 	entity.Interfaces = InterfacesFromBolt(boltIdentity.Interfaces)
 	fillModelInfo(entity, boltIdentity.EnvInfo, boltIdentity.SdkInfo)
@@ -39,12 +39,17 @@ type Managers struct {
 	Dispatcher command.Dispatcher
 
 	// fabric
-	Circuit    *CircuitManager
-	Command    *CommandManager
-	Link       *LinkManager
-	Router     *RouterManager
-	Service    *ServiceManager
-	Terminator *TerminatorManager
+	Alert              *AlertManager
+	Circuit            *CircuitManager
+	CircuitHistory     *CircuitHistoryManager
+	Command            *CommandManager
+	Link               *LinkManager
+	Probe              *ProbeManager
+	Residency          *ResidencyManager
+	Router             *RouterManager
+	RouterCertRotation *RouterCertRotationManager
+	Service            *ServiceManager
+	Terminator         *TerminatorManager
 
 	// edge
 	ApiSession              *ApiSessionManager
@@ -56,6 +61,7 @@ type Managers struct {
 	EdgeRouter              *EdgeRouterManager
 	EdgeRouterPolicy        *EdgeRouterPolicyManager
 	EdgeService             *EdgeServiceManager
+	EnrollmentSigner        *EnrollmentSignerManager
 	ExternalJwtSigner       *ExternalJwtSignerManager
 	Identity                *IdentityManager
 	IdentityType            *IdentityTypeManager
@@ -72,6 +78,10 @@ type Managers struct {
 	PostureResponse         *PostureResponseManager
 	Mfa                     *MfaManager
 	AuthPolicy              *AuthPolicyManager
+	Role                    *RoleManager
+	BandwidthLimit          *BandwidthLimitManager
+	Quota                   *QuotaManager
+	Audit                   *AuditManager
 }
 
 func NewManagers() *Managers {
@@ -83,9 +93,14 @@ func NewManagers() *Managers {
 func (managers *Managers) Init(env Env) *Managers {
 	managers.Dispatcher = env.GetCommandDispatcher()
 	managers.Circuit = NewCircuitManager()
+	managers.CircuitHistory = NewCircuitHistoryManager(env)
+	managers.Alert = NewAlertManager(env)
 	managers.Command = newCommandManager(env, managers.Registry)
 	managers.Link = NewLinkManager(env)
+	managers.Probe = NewProbeManager(env)
+	managers.Residency = NewResidencyManager(env)
 	managers.Router = newRouterManager(env)
+	managers.RouterCertRotation = NewRouterCertRotationManager(env)
 	managers.Service = newServiceManager(env)
 	managers.Terminator = newTerminatorManager(env)
 
@@ -101,6 +116,7 @@ func (managers *Managers) Init(env Env) *Managers {
 	managers.EdgeRouterPolicy = NewEdgeRouterPolicyManager(env)
 	managers.EdgeService = NewEdgeServiceManager(env)
 	managers.Enrollment = NewEnrollmentManager(env)
+	managers.EnrollmentSigner = NewEnrollmentSignerManager(env)
 	managers.ExternalJwtSigner = NewExternalJwtSignerManager(env)
 	managers.Identity = NewIdentityManager(env)
 	managers.IdentityType = NewIdentityTypeManager(env)
@@ -114,6 +130,10 @@ func (managers *Managers) Init(env Env) *Managers {
 	managers.PostureCheckType = NewPostureCheckTypeManager(env)
 	managers.PostureResponse = NewPostureResponseManager(env)
 	managers.Mfa = NewMfaManager(env)
+	managers.Role = NewRoleManager(env)
+	managers.BandwidthLimit = NewBandwidthLimitManager(env)
+	managers.Quota = NewQuotaManager(env)
+	managers.Audit = NewAuditManager(env)
 
 	RegisterCommand(env, &CreateEdgeTerminatorCmd{}, &edge_cmd_pb.CreateEdgeTerminatorCommand{})
 	managers.Command.registerGenericCommands()
@@ -0,0 +1,543 @@
+/*
+	Copyright NetFoundry Inc.
+
+	Licensed under the Apache License, Version 2.0 (the "License");
+	you may not use this file except in compliance with the License.
+	You may obtain a copy of the License at
+
+	https://www.apache.org/licenses/LICENSE-2.0
+
+	Unless required by applicable law or agreed to in writing, software
+	distributed under the License is distributed on an "AS IS" BASIS,
+	WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+	See the License for the specific language governing permissions and
+	limitations under the License.
+*/
+
+package model
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"sort"
+	"strings"
+
+	"github.com/openziti/foundation/v2/errorz"
+	"github.com/openziti/storage/ast"
+	"github.com/openziti/storage/boltz"
+	"github.com/openziti/ziti/controller/db"
+	"github.com/openziti/ziti/controller/fields"
+	"github.com/pkg/errors"
+	"go.etcd.io/bbolt"
+)
+
+const (
+	SimulationActionCreate = "create"
+	SimulationActionUpdate = "update"
+	SimulationActionDelete = "delete"
+
+	SimulationChangeGranted = "granted"
+	SimulationChangeRevoked = "revoked"
+	SimulationChangeChanged = "changed"
+
+	DefaultSimulationMaxChanges = 1000
+)
+
+// errSimulationComplete is returned from the simulation transaction so the hypothetical changes are rolled back
+var errSimulationComplete = errors.New("policy simulation complete")
+
+// SimulatedServicePolicy describes a hypothetical service policy change. For updates, nil role lists and empty
+// strings leave the existing values in place.
+type SimulatedServicePolicy struct {
+	Action            string
+	Id                string
+	Name              string
+	PolicyType        string
+	Semantic          string
+	IdentityRoles     []string
+	ServiceRoles      []string
+	PostureCheckRoles []string
+}
+
+// SimulatedEdgeRouterPolicy describes a hypothetical edge router policy change
+type SimulatedEdgeRouterPolicy struct {
+	Action          string
+	Id              string
+	Name            string
+	Semantic        string
+	IdentityRoles   []string
+	EdgeRouterRoles []string
+}
+
+// SimulatedServiceEdgeRouterPolicy describes a hypothetical service edge router policy change
+type SimulatedServiceEdgeRouterPolicy struct {
+	Action          string
+	Id              string
+	Name            string
+	Semantic        string
+	ServiceRoles    []string
+	EdgeRouterRoles []string
+}
+
+// SimulatedRoleAttributes replaces the role attributes of an existing identity, service or edge router
+type SimulatedRoleAttributes struct {
+	Id             string
+	RoleAttributes []string
+}
+
+// PolicySimulation is a set of hypothetical changes to evaluate
+type PolicySimulation struct {
+	ServicePolicies           []*SimulatedServicePolicy
+	EdgeRouterPolicies        []*SimulatedEdgeRouterPolicy
+	ServiceEdgeRouterPolicies []*SimulatedServiceEdgeRouterPolicy
+	IdentityRoleAttributes    []*SimulatedRoleAttributes
+	ServiceRoleAttributes     []*SimulatedRoleAttributes
+	EdgeRouterRoleAttributes  []*SimulatedRoleAttributes
+	RemovedEdgeRouterIds      []string
+	MaxChanges                int
+}
+
+// PolicySimulationChange describes how access of one identity to one service, for one policy type, would change.
+// Access is granted through an edge router if the identity has the permission and both the identity and the
+// service may use the edge router. Posture checks are listed per granting policy: access requires every check of
+// at least one of the sets.
+type PolicySimulationChange struct {
+	IdentityId           string
+	IdentityName         string
+	ServiceId            string
+	ServiceName          string
+	PolicyType           string
+	ChangeType           string
+	EdgeRouterIds        []string
+	AddedEdgeRouterIds   []string
+	RemovedEdgeRouterIds []string
+	PostureChecksBefore  [][]string
+	PostureChecksAfter   [][]string
+}
+
+// PolicySimulationResult summarizes the access changes the simulated changes would cause. Counts cover all
+// changes, even if the list of changes was truncated.
+type PolicySimulationResult struct {
+	IdentitiesAffected int
+	ServicesAffected   int
+	Granted            int
+	Revoked            int
+	Changed            int
+	TuplesAdded        int
+	TuplesRemoved      int
+	Truncated          bool
+	Changes            []*PolicySimulationChange
+}
+
+type simulatedAccessKey struct {
+	identityId string
+	serviceId  string
+	policyType string
+}
+
+type simulatedAccess struct {
+	edgeRouterIds []string
+	postureChecks [][]string
+}
+
+// SimulatePolicyChanges reports how identity to service access would change if the given changes were made. The
+// changes are applied in a write transaction which is always rolled back, so the same validation as for real
+// changes applies, and nothing is persisted. Other writes wait while the simulation runs.
+func (advisor *PolicyAdvisor) SimulatePolicyChanges(simulation *PolicySimulation) (*PolicySimulationResult, error) {
+	var result *PolicySimulationResult
+
+	err := advisor.env.GetDb().Update(boltz.NewMutateContext(context.Background()), func(ctx boltz.MutateContext) error {
+		removedRouters := map[string]struct{}{}
+		for _, edgeRouterId := range simulation.RemovedEdgeRouterIds {
+			if !advisor.env.GetStores().EdgeRouter.IsEntityPresent(ctx.Tx(), edgeRouterId) {
+				return boltz.NewNotFoundError(db.EntityTypeRouters, "id", edgeRouterId)
+			}
+			removedRouters[edgeRouterId] = struct{}{}
+		}
+
+		before, err := advisor.getSimulatedAccess(ctx.Tx(), nil)
+		if err != nil {
+			return err
+		}
+
+		if err = advisor.applySimulation(ctx, simulation); err != nil {
+			return err
+		}
+
+		after, err := advisor.getSimulatedAccess(ctx.Tx(), removedRouters)
+		if err != nil {
+			return err
+		}
+
+		result = advisor.diffSimulatedAccess(ctx.Tx(), before, after, simulation.MaxChanges)
+		return errSimulationComplete
+	})
+
+	if err != nil && !errors.Is(err, errSimulationComplete) {
+		return nil, err
+	}
+	return result, nil
+}
+
+func (advisor *PolicyAdvisor) applySimulation(ctx boltz.MutateContext, simulation *PolicySimulation) error {
+	managers := advisor.env.GetManagers()
+	stores := advisor.env.GetStores()
+
+	for i, change := range simulation.ServicePolicies {
+		field := fmt.Sprintf("servicePolicies[%d]", i)
+		switch change.Action {
+		case SimulationActionCreate:
+			policy := &ServicePolicy{
+				Name:              change.Name,
+				PolicyType:        change.PolicyType,
+				Semantic:          defaultSemantic(change.Semantic),
+				IdentityRoles:     change.IdentityRoles,
+				ServiceRoles:      change.ServiceRoles,
+				PostureCheckRoles: change.PostureCheckRoles,
+			}
+			policy.Id = change.Id
+			if _, err := managers.ServicePolicy.createEntity(policy, ctx); err != nil {
+				return err
+			}
+		case SimulationActionUpdate:
+			policy, err := managers.ServicePolicy.readInTx(ctx.Tx(), change.Id)
+			if err != nil {
+				return err
+			}
+			updated := fields.UpdatedFieldsMap{}
+			setSimulatedString(&policy.Name, change.Name, db.FieldName, updated)
+			setSimulatedString(&policy.PolicyType, change.PolicyType, db.FieldServicePolicyType, updated)
+			setSimulatedString(&policy.Semantic, change.Semantic, db.FieldSemantic, updated)
+			setSimulatedRoles(&policy.IdentityRoles, change.IdentityRoles, db.FieldIdentityRoles, updated)
+			setSimulatedRoles(&policy.ServiceRoles, change.ServiceRoles, db.FieldServiceRoles, updated)
+			setSimulatedRoles(&policy.PostureCheckRoles, change.PostureCheckRoles, db.FieldPostureCheckRoles, updated)
+			if err = managers.ServicePolicy.updateEntity(policy, updated, ctx); err != nil {
+				return err
+			}
+		case SimulationActionDelete:
+			if err := stores.ServicePolicy.DeleteById(ctx, change.Id); err != nil {
+				return err
+			}
+		default:
+			return invalidSimulationAction(field, change.Action)
+		}
+	}
+
+	for i, change := range simulation.EdgeRouterPolicies {
+		field := fmt.Sprintf("edgeRouterPolicies[%d]", i)
+		switch change.Action {
+		case SimulationActionCreate:
+			policy := &EdgeRouterPolicy{
+				Name:            change.Name,
+				Semantic:        defaultSemantic(change.Semantic),
+				IdentityRoles:   change.IdentityRoles,
+				EdgeRouterRoles: change.EdgeRouterRoles,
+			}
+			policy.Id = change.Id
+			if _, err := managers.EdgeRouterPolicy.createEntity(policy, ctx); err != nil {
+				return err
+			}
+		case SimulationActionUpdate:
+			policy, err := managers.EdgeRouterPolicy.readInTx(ctx.Tx(), change.Id)
+			if err != nil {
+				return err
+			}
+			updated := fields.UpdatedFieldsMap{}
+			setSimulatedString(&policy.Name, change.Name, db.FieldName, updated)
+			setSimulatedString(&policy.Semantic, change.Semantic, db.FieldSemantic, updated)
+			setSimulatedRoles(&policy.IdentityRoles, change.IdentityRoles, db.FieldIdentityRoles, updated)
+			setSimulatedRoles(&policy.EdgeRouterRoles, change.EdgeRouterRoles, db.FieldEdgeRouterRoles, updated)
+			if err = managers.EdgeRouterPolicy.updateEntity(policy, updated, ctx); err != nil {
+				return err
+			}
+		case SimulationActionDelete:
+			if err := stores.EdgeRouterPolicy.DeleteById(ctx, change.Id); err != nil {
+				return err
+			}
+		default:
+			return invalidSimulationAction(field, change.Action)
+		}
+	}
+
+	for i, change := range simulation.ServiceEdgeRouterPolicies {
+		field := fmt.Sprintf("serviceEdgeRouterPolicies[%d]", i)
+		switch change.Action {
+		case SimulationActionCreate:
+			policy := &ServiceEdgeRouterPolicy{
+				Name:            change.Name,
+				Semantic:        defaultSemantic(change.Semantic),
+				ServiceRoles:    change.ServiceRoles,
+				EdgeRouterRoles: change.EdgeRouterRoles,
+			}
+			policy.Id = change.Id
+			if _, err := managers.ServiceEdgeRouterPolicy.createEntity(policy, ctx); err != nil {
+				return err
+			}
+		case SimulationActionUpdate:
+			policy, err := managers.ServiceEdgeRouterPolicy.readInTx(ctx.Tx(), change.Id)
+			if err != nil {
+				return err
+			}
+			updated := fields.UpdatedFieldsMap{}
+			setSimulatedString(&policy.Name, change.Name, db.FieldName, updated)
+			setSimulatedString(&policy.Semantic, change.Semantic, db.FieldSemantic, updated)
+			setSimulatedRoles(&policy.ServiceRoles, change.ServiceRoles, db.FieldServiceRoles, updated)
+			setSimulatedRoles(&policy.EdgeRouterRoles, change.EdgeRouterRoles, db.FieldEdgeRouterRoles, updated)
+			if err = managers.ServiceEdgeRouterPolicy.updateEntity(policy, updated, ctx); err != nil {
+				return err
+			}
+		case SimulationActionDelete:
+			if err := stores.ServiceEdgeRouterPolicy.DeleteById(ctx, change.Id); err != nil {
+				return err
+			}
+		default:
+			return invalidSimulationAction(field, change.Action)
+		}
+	}
+
+	roleAttributesChecker := fields.UpdatedFieldsMap{db.FieldRoleAttributes: struct{}{}}
+
+	for _, change := range simulation.IdentityRoleAttributes {
+		identity, err := stores.Identity.LoadById(ctx.Tx(), change.Id)
+		if err != nil {
+			return err
+		}
+		identity.RoleAttributes = change.RoleAttributes
+		if err = stores.Identity.Update(ctx, identity, roleAttributesChecker); err != nil {
+			return err
+		}
+	}
+
+	for _, change := range simulation.ServiceRoleAttributes {
+		service, err := stores.EdgeService.LoadById(ctx.Tx(), change.Id)
+		if err != nil {
+			return err
+		}
+		service.RoleAttributes = change.RoleAttributes
+		if err = stores.EdgeService.Update(ctx, service, roleAttributesChecker); err != nil {
+			return err
+		}
+	}
+
+	for _, change := range simulation.EdgeRouterRoleAttributes {
+		edgeRouter, err := stores.EdgeRouter.LoadById(ctx.Tx(), change.Id)
+		if err != nil {
+			return err
+		}
+		edgeRouter.RoleAttributes = change.RoleAttributes
+		if err = stores.EdgeRouter.Update(ctx, edgeRouter, roleAttributesChecker); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// getSimulatedAccess returns, for every identity, service and policy type with at least one usable edge router,
+// the edge routers which may be used and the posture check sets of the granting policies
+func (advisor *PolicyAdvisor) getSimulatedAccess(tx *bbolt.Tx, removedRouters map[string]struct{}) (map[simulatedAccessKey]*simulatedAccess, error) {
+	stores := advisor.env.GetStores()
+	result := map[simulatedAccessKey]*simulatedAccess{}
+
+	routersForPolicies := func(policyStore boltz.Store, policyIds []string) map[string]struct{} {
+		routers := map[string]struct{}{}
+		for _, policyId := range policyIds {
+			for _, edgeRouterId := range policyStore.GetRelatedEntitiesIdList(tx, policyId, db.EntityTypeRouters) {
+				if _, removed := removedRouters[edgeRouterId]; !removed {
+					routers[edgeRouterId] = struct{}{}
+				}
+			}
+		}
+		return routers
+	}
+
+	serviceRouters := map[string]map[string]struct{}{}
+	getServiceRouters := func(serviceId string) map[string]struct{} {
+		routers, found := serviceRouters[serviceId]
+		if !found {
+			policyIds := stores.EdgeService.GetRelatedEntitiesIdList(tx, serviceId, db.EntityTypeServiceEdgeRouterPolicies)
+			routers = routersForPolicies(stores.ServiceEdgeRouterPolicy, policyIds)
+			serviceRouters[serviceId] = routers
+		}
+		return routers
+	}
+
+	servicePolicyStore := stores.ServicePolicy
+
+	for cursor := stores.Identity.IterateIds(tx, ast.BoolNodeTrue); cursor.IsValid(); cursor.Next() {
+		identityId := string(cursor.Current())
+
+		policyIds := stores.Identity.GetRelatedEntitiesIdList(tx, identityId, db.EntityTypeEdgeRouterPolicies)
+		identityRouters := routersForPolicies(stores.EdgeRouterPolicy, policyIds)
+		if len(identityRouters) == 0 {
+			continue
+		}
+
+		for _, servicePolicyId := range stores.Identity.GetRelatedEntitiesIdList(tx, identityId, db.EntityTypeServicePolicies) {
+			servicePolicy, err := servicePolicyStore.LoadById(tx, servicePolicyId)
+			if err != nil {
+				return nil, err
+			}
+			postureChecks := servicePolicyStore.GetRelatedEntitiesIdList(tx, servicePolicyId, db.EntityTypePostureChecks)
+			sort.Strings(postureChecks)
+
+			for _, serviceId := range servicePolicyStore.GetRelatedEntitiesIdList(tx, servicePolicyId, db.EntityTypeServices) {
+				key := simulatedAccessKey{identityId: identityId, serviceId: serviceId, policyType: string(servicePolicy.PolicyType)}
+				access := result[key]
+				if access == nil {
+					var common []string
+					for edgeRouterId := range getServiceRouters(serviceId) {
+						if _, ok := identityRouters[edgeRouterId]; ok {
+							common = append(common, edgeRouterId)
+						}
+					}
+					if len(common) == 0 {
+						continue
+					}
+					sort.Strings(common)
+					access = &simulatedAccess{edgeRouterIds: common}
+					result[key] = access
+				}
+				if !slices.ContainsFunc(access.postureChecks, func(checks []string) bool { return slices.Equal(checks, postureChecks) }) {
+					access.postureChecks = append(access.postureChecks, postureChecks)
+				}
+			}
+		}
+	}
+
+	for _, access := range result {
+		sort.Slice(access.postureChecks, func(i, j int) bool {
+			return strings.Join(access.postureChecks[i], ",") < strings.Join(access.postureChecks[j], ",")
+		})
+	}
+
+	return result, nil
+}
+
+func (advisor *PolicyAdvisor) diffSimulatedAccess(tx *bbolt.Tx, before, after map[simulatedAccessKey]*simulatedAccess, maxChanges int) *PolicySimulationResult {
+	result := &PolicySimulationResult{}
+
+	var changes []*PolicySimulationChange
+	addChange := func(key simulatedAccessKey, beforeAccess, afterAccess *simulatedAccess) {
+		change := &PolicySimulationChange{
+			IdentityId: key.identityId,
+			ServiceId:  key.serviceId,
+			PolicyType: key.policyType,
+		}
+
+		var beforeRouters []string
+		if beforeAccess != nil {
+			beforeRouters = beforeAccess.edgeRouterIds
+			change.PostureChecksBefore = beforeAccess.postureChecks
+		}
+		if afterAccess != nil {
+			change.EdgeRouterIds = afterAccess.edgeRouterIds
+			change.PostureChecksAfter = afterAccess.postureChecks
+		}
+
+		for _, edgeRouterId := range change.EdgeRouterIds {
+			if !slices.Contains(beforeRouters, edgeRouterId) {
+				change.AddedEdgeRouterIds = append(change.AddedEdgeRouterIds, edgeRouterId)
+			}
+		}
+		for _, edgeRouterId := range beforeRouters {
+			if !slices.Contains(change.EdgeRouterIds, edgeRouterId) {
+				change.RemovedEdgeRouterIds = append(change.RemovedEdgeRouterIds, edgeRouterId)
+			}
+		}
+
+		if beforeAccess == nil {
+			change.ChangeType = SimulationChangeGranted
+			result.Granted++
+		} else if afterAccess == nil {
+			change.ChangeType = SimulationChangeRevoked
+			result.Revoked++
+		} else if len(change.AddedEdgeRouterIds) > 0 || len(change.RemovedEdgeRouterIds) > 0 ||
+			!slices.EqualFunc(beforeAccess.postureChecks, afterAccess.postureChecks, slices.Equal[[]string]) {
+			change.ChangeType = SimulationChangeChanged
+			result.Changed++
+		} else {
+			return
+		}
+
+		result.TuplesAdded += len(change.AddedEdgeRouterIds)
+		result.TuplesRemoved += len(change.RemovedEdgeRouterIds)
+		changes = append(changes, change)
+	}
+
+	for key, beforeAccess := range before {
+		addChange(key, beforeAccess, after[key])
+	}
+	for key, afterAccess := range after {
+		if _, found := before[key]; !found {
+			addChange(key, nil, afterAccess)
+		}
+	}
+
+	identityNames := map[string]string{}
+	serviceNames := map[string]string{}
+	for _, change := range changes {
+		change.IdentityName = getCachedName(tx, advisor.env.GetStores().Identity, change.IdentityId, identityNames)
+		change.ServiceName = getCachedName(tx, advisor.env.GetStores().EdgeService, change.ServiceId, serviceNames)
+	}
+	result.IdentitiesAffected = len(identityNames)
+	result.ServicesAffected = len(serviceNames)
+
+	sort.Slice(changes, func(i, j int) bool {
+		a, b := changes[i], changes[j]
+		if a.IdentityName != b.IdentityName {
+			return a.IdentityName < b.IdentityName
+		}
+		if a.ServiceName != b.ServiceName {
+			return a.ServiceName < b.ServiceName
+		}
+		return a.PolicyType < b.PolicyType
+	})
+
+	if maxChanges <= 0 {
+		maxChanges = DefaultSimulationMaxChanges
+	}
+	if len(changes) > maxChanges {
+		changes = changes[:maxChanges]
+		result.Truncated = true
+	}
+	result.Changes = changes
+
+	return result
+}
+
+func getCachedName(tx *bbolt.Tx, store boltz.Store, id string, cache map[string]string) string {
+	if name, found := cache[id]; found {
+		return name
+	}
+	_, name := store.GetSymbol(db.FieldName).Eval(tx, []byte(id))
+	cache[id] = string(name)
+	return string(name)
+}
+
+func defaultSemantic(semantic string) string {
+	if semantic == "" {
+		return db.SemanticAllOf
+	}
+	return semantic
+}
+
+func setSimulatedString(target *string, value, field string, updated fields.UpdatedFieldsMap) {
+	if value != "" {
+		*target = value
+		updated.AddField(field)
+	}
+}
+
+func setSimulatedRoles(target *[]string, value []string, field string, updated fields.UpdatedFieldsMap) {
+	if value != nil {
+		*target = value
+		updated.AddField(field)
+	}
+}
+
+func invalidSimulationAction(field, action string) error {
+	return errorz.NewFieldError(fmt.Sprintf("invalid action, must be one of %s, %s or %s",
+		SimulationActionCreate, SimulationActionUpdate, SimulationActionDelete), field+".action", action)
+}
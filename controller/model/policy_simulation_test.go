@@ -0,0 +1,189 @@
+/*
+	Copyright NetFoundry Inc.
+
+	Licensed under the Apache License, Version 2.0 (the "License");
+	you may not use this file except in compliance with the License.
+	You may obtain a copy of the License at
+
+	https://www.apache.org/licenses/LICENSE-2.0
+
+	Unless required by applicable law or agreed to in writing, software
+	distributed under the License is distributed on an "AS IS" BASIS,
+	WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+	See the License for the specific language governing permissions and
+	limitations under the License.
+*/
+
+package model
+
+import (
+	"sort"
+	"testing"
+
+	"github.com/openziti/storage/boltz"
+	"github.com/openziti/ziti/common/eid"
+	"github.com/openziti/ziti/controller/change"
+	"github.com/openziti/ziti/controller/db"
+	"go.etcd.io/bbolt"
+)
+
+func TestPolicySimulation(t *testing.T) {
+	ctx := NewTestContext(t)
+	defer ctx.Cleanup()
+	ctx.Init()
+
+	edgeRouter := ctx.requireNewEdgeRouter()
+	edgeRouter2 := ctx.requireNewEdgeRouter()
+	identity := ctx.requireNewIdentity(false)
+	service := ctx.requireNewService()
+
+	ctx.requireNewEdgeRouterPolicy(ss("@"+identity.Id), ss("@"+edgeRouter.Id, "@"+edgeRouter2.Id))
+	ctx.requireNewServiceNewEdgeRouterPolicy(ss("@"+service.Id), ss("#all"))
+
+	routerIds := ss(edgeRouter.Id, edgeRouter2.Id)
+	sort.Strings(routerIds)
+	advisor := ctx.managers.PolicyAdvisor
+
+	t.Run("new policy and role attributes grant access", func(t *testing.T) {
+		attr := eid.New()
+		policyName := eid.New()
+		result, err := advisor.SimulatePolicyChanges(&PolicySimulation{
+			ServicePolicies: []*SimulatedServicePolicy{{
+				Action:        SimulationActionCreate,
+				Name:          policyName,
+				PolicyType:    db.PolicyTypeDialName,
+				IdentityRoles: ss("#" + attr),
+				ServiceRoles:  ss("@" + service.Id),
+			}},
+			IdentityRoleAttributes: []*SimulatedRoleAttributes{{Id: identity.Id, RoleAttributes: ss(attr)}},
+		})
+		ctx.NoError(err)
+		ctx.Equal(1, result.Granted)
+		ctx.Equal(0, result.Revoked)
+		ctx.Equal(2, result.TuplesAdded)
+		ctx.Equal(1, result.IdentitiesAffected)
+		ctx.Equal(1, result.ServicesAffected)
+		ctx.Len(result.Changes, 1)
+
+		change := result.Changes[0]
+		ctx.Equal(identity.Id, change.IdentityId)
+		ctx.Equal(identity.Name, change.IdentityName)
+		ctx.Equal(service.Name, change.ServiceName)
+		ctx.Equal(db.PolicyTypeDialName, change.PolicyType)
+		ctx.Equal(SimulationChangeGranted, change.ChangeType)
+		ctx.Equal(routerIds, change.EdgeRouterIds)
+		ctx.Equal(routerIds, change.AddedEdgeRouterIds)
+		ctx.Len(change.PostureChecksAfter, 1)
+		ctx.Empty(change.PostureChecksAfter[0])
+
+		// nothing was persisted
+		current, err := ctx.managers.Identity.Read(identity.Id)
+		ctx.NoError(err)
+		ctx.Empty(current.RoleAttributes)
+		err = ctx.GetDb().View(func(tx *bbolt.Tx) error {
+			ctx.Nil(ctx.managers.ServicePolicy.GetStore().(db.NameIndexed).GetNameIndex().Read(tx, []byte(policyName)))
+			return nil
+		})
+		ctx.NoError(err)
+	})
+
+	policy := ctx.requireNewServicePolicy(db.PolicyTypeDialName, ss("@"+identity.Id), ss("@"+service.Id))
+
+	t.Run("removing an edge router changes access", func(t *testing.T) {
+		result, err := advisor.SimulatePolicyChanges(&PolicySimulation{
+			RemovedEdgeRouterIds: ss(edgeRouter.Id),
+		})
+		ctx.NoError(err)
+		ctx.Equal(1, result.Changed)
+		ctx.Equal(1, result.TuplesRemoved)
+		ctx.Len(result.Changes, 1)
+		ctx.Equal(ss(edgeRouter.Id), result.Changes[0].RemovedEdgeRouterIds)
+		ctx.Equal(ss(edgeRouter2.Id), result.Changes[0].EdgeRouterIds)
+	})
+
+	t.Run("deleting and updating policies revokes access", func(t *testing.T) {
+		result, err := advisor.SimulatePolicyChanges(&PolicySimulation{
+			ServicePolicies: []*SimulatedServicePolicy{{Action: SimulationActionDelete, Id: policy.Id}},
+		})
+		ctx.NoError(err)
+		ctx.Equal(1, result.Revoked)
+		ctx.Equal(2, result.TuplesRemoved)
+		ctx.Equal(SimulationChangeRevoked, result.Changes[0].ChangeType)
+		ctx.Equal(routerIds, result.Changes[0].RemovedEdgeRouterIds)
+
+		result, err = advisor.SimulatePolicyChanges(&PolicySimulation{
+			ServicePolicies: []*SimulatedServicePolicy{{
+				Action:     SimulationActionUpdate,
+				Id:         policy.Id,
+				PolicyType: db.PolicyTypeBindName,
+			}},
+		})
+		ctx.NoError(err)
+		ctx.Equal(1, result.Revoked)
+		ctx.Equal(1, result.Granted)
+
+		current, err := ctx.managers.ServicePolicy.Read(policy.Id)
+		ctx.NoError(err)
+		ctx.Equal(db.PolicyTypeDialName, current.PolicyType)
+	})
+
+	t.Run("unchanged access isn't reported", func(t *testing.T) {
+		result, err := advisor.SimulatePolicyChanges(&PolicySimulation{
+			EdgeRouterPolicies: []*SimulatedEdgeRouterPolicy{{
+				Action:          SimulationActionCreate,
+				Name:            eid.New(),
+				IdentityRoles:   ss("#all"),
+				EdgeRouterRoles: ss("@" + edgeRouter.Id),
+			}},
+		})
+		ctx.NoError(err)
+		ctx.Empty(result.Changes)
+	})
+
+	t.Run("results are truncated", func(t *testing.T) {
+		identity2 := ctx.requireNewIdentity(false)
+		identity2.RoleAttributes = ss("bulk")
+		ctx.NoError(ctx.managers.Identity.Update(identity2, nil, change.New()))
+		ctx.requireNewEdgeRouterPolicy(ss("@"+identity2.Id), ss("#all"))
+
+		result, err := advisor.SimulatePolicyChanges(&PolicySimulation{
+			ServicePolicies: []*SimulatedServicePolicy{{
+				Action:        SimulationActionCreate,
+				Name:          eid.New(),
+				PolicyType:    db.PolicyTypeBindName,
+				IdentityRoles: ss("@"+identity.Id, "#bulk"),
+				ServiceRoles:  ss("@" + service.Id),
+			}},
+			MaxChanges: 1,
+		})
+		ctx.NoError(err)
+		ctx.Equal(2, result.Granted)
+		ctx.Equal(2, result.IdentitiesAffected)
+		ctx.True(result.Truncated)
+		ctx.Len(result.Changes, 1)
+	})
+
+	t.Run("invalid changes are rejected", func(t *testing.T) {
+		_, err := advisor.SimulatePolicyChanges(&PolicySimulation{
+			ServicePolicies: []*SimulatedServicePolicy{{Action: "rename", Id: policy.Id}},
+		})
+		ctx.ErrorContains(err, "invalid action")
+
+		_, err = advisor.SimulatePolicyChanges(&PolicySimulation{
+			ServicePolicies: []*SimulatedServicePolicy{{
+				Action:     SimulationActionCreate,
+				Name:       eid.New(),
+				PolicyType: "Host",
+			}},
+		})
+		ctx.ErrorContains(err, "invalid policy type")
+
+		_, err = advisor.SimulatePolicyChanges(&PolicySimulation{RemovedEdgeRouterIds: ss(eid.New())})
+		ctx.True(boltz.IsErrNotFoundErr(err))
+
+		_, err = advisor.SimulatePolicyChanges(&PolicySimulation{
+			ServicePolicies: []*SimulatedServicePolicy{{Action: SimulationActionDelete, Id: eid.New()}},
+		})
+		ctx.True(boltz.IsErrNotFoundErr(err))
+	})
+}
@@ -0,0 +1,366 @@
+/*
+	Copyright NetFoundry Inc.
+
+	Licensed under the Apache License, Version 2.0 (the "License");
+	you may not use this file except in compliance with the License.
+	You may obtain a copy of the License at
+
+	https://www.apache.org/licenses/LICENSE-2.0
+
+	Unless required by applicable law or agreed to in writing, software
+	distributed under the License is distributed on an "AS IS" BASIS,
+	WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+	See the License for the specific language governing permissions and
+	limitations under the License.
+*/
+
+package model
+
+import (
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/michaelquigley/pfxlog"
+	"github.com/openziti/channel/v4/protobufs"
+	"github.com/openziti/storage/boltz"
+	"github.com/openziti/ziti/common/pb/ctrl_pb"
+	"github.com/openziti/ziti/controller/config"
+	"github.com/openziti/ziti/controller/event"
+	"github.com/pkg/errors"
+)
+
+// ProbeResult is the outcome of a single execution of a synthetic probe
+type ProbeResult struct {
+	Probe          string
+	RouterId       string
+	TargetRouterId string
+	Timestamp      time.Time
+	Success        bool
+	Latency        time.Duration
+	Error          string
+}
+
+// ProbeSummary summarizes the results of a probe over the probe results window. Latency percentiles only include
+// successful probes. SloMet is nil if the probe has no objectives or no results in the window
+type ProbeSummary struct {
+	Probe        *config.ProbeConfig
+	Window       time.Duration
+	Total        int
+	Succeeded    int
+	Availability float64
+	LatencyP50   time.Duration
+	LatencyP95   time.Duration
+	LatencyP99   time.Duration
+	SloLatency   time.Duration
+	SloMet       *bool
+	LastResult   *ProbeResult
+	LastFailure  *ProbeResult
+}
+
+// ProbeManager schedules the configured synthetic probes. Each probe is sent to the router which executes it, and
+// the results are emitted as probe events and metrics, and kept in memory for the probe results window so that SLO
+// summaries can be reported. Each controller schedules the probes independently, for the routers connected to it.
+type ProbeManager struct {
+	env      Env
+	lock     sync.Mutex
+	results  map[string][]*ProbeResult
+	nextRun  map[string]time.Time
+	inflight map[string]struct{}
+	send     func(router *Router, request *ctrl_pb.ProbeRequest, timeout time.Duration) (*ctrl_pb.ProbeResponse, error)
+}
+
+func NewProbeManager(env Env) *ProbeManager {
+	result := &ProbeManager{
+		env:      env,
+		results:  map[string][]*ProbeResult{},
+		nextRun:  map[string]time.Time{},
+		inflight: map[string]struct{}{},
+		send:     sendProbeRequest,
+	}
+
+	if len(env.GetConfig().Probes.Definitions) > 0 {
+		go result.run()
+	}
+
+	return result
+}
+
+func sendProbeRequest(router *Router, request *ctrl_pb.ProbeRequest, timeout time.Duration) (*ctrl_pb.ProbeResponse, error) {
+	response := &ctrl_pb.ProbeResponse{}
+	respMsg, err := protobufs.MarshalTyped(request).WithTimeout(timeout).SendForReply(router.Control)
+	if err = protobufs.TypedResponse(response).Unmarshall(respMsg, err); err != nil {
+		return nil, err
+	}
+	return response, nil
+}
+
+func (self *ProbeManager) run() {
+	ticker := time.NewTicker(config.MinProbeInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case now := <-ticker.C:
+			self.schedule(now)
+		case <-self.env.GetCloseNotifyChannel():
+			return
+		}
+	}
+}
+
+// schedule starts the probes which are due. A probe isn't started again while its previous execution is in progress
+func (self *ProbeManager) schedule(now time.Time) {
+	self.lock.Lock()
+	defer self.lock.Unlock()
+
+	for _, probe := range self.List() {
+		if next, found := self.nextRun[probe.Name]; found && now.Before(next) {
+			continue
+		}
+		if _, found := self.inflight[probe.Name]; found {
+			continue
+		}
+
+		self.nextRun[probe.Name] = now.Add(probe.Interval)
+		self.inflight[probe.Name] = struct{}{}
+
+		go func() {
+			self.runProbe(probe, time.Now())
+
+			self.lock.Lock()
+			delete(self.inflight, probe.Name)
+			self.lock.Unlock()
+		}()
+	}
+}
+
+// List returns the configured probe definitions
+func (self *ProbeManager) List() []*config.ProbeConfig {
+	return self.env.GetConfig().Probes.Definitions
+}
+
+// Get returns the probe definition with the given name
+func (self *ProbeManager) Get(name string) (*config.ProbeConfig, error) {
+	for _, probe := range self.List() {
+		if probe.Name == name {
+			return probe, nil
+		}
+	}
+	return nil, boltz.NewNotFoundError("probe", "name", name)
+}
+
+// GetResults returns the results of the probe with the given name in the results window, newest first
+func (self *ProbeManager) GetResults(name string) ([]*ProbeResult, error) {
+	if _, err := self.Get(name); err != nil {
+		return nil, err
+	}
+
+	self.lock.Lock()
+	defer self.lock.Unlock()
+
+	results := self.trim(name, time.Now())
+	result := make([]*ProbeResult, 0, len(results))
+	for i := len(results) - 1; i >= 0; i-- {
+		resultCopy := *results[i]
+		result = append(result, &resultCopy)
+	}
+	return result, nil
+}
+
+// GetSummary returns the SLO summary of the probe with the given name
+func (self *ProbeManager) GetSummary(name string) (*ProbeSummary, error) {
+	probe, err := self.Get(name)
+	if err != nil {
+		return nil, err
+	}
+	return self.summarize(probe, time.Now()), nil
+}
+
+// ListSummaries returns the SLO summaries of all configured probes
+func (self *ProbeManager) ListSummaries() []*ProbeSummary {
+	now := time.Now()
+	var result []*ProbeSummary
+	for _, probe := range self.List() {
+		result = append(result, self.summarize(probe, now))
+	}
+	return result
+}
+
+func (self *ProbeManager) getWindow() time.Duration {
+	if window := self.env.GetConfig().Probes.Window; window > 0 {
+		return window
+	}
+	return config.DefaultProbeWindow
+}
+
+func (self *ProbeManager) summarize(probe *config.ProbeConfig, now time.Time) *ProbeSummary {
+	self.lock.Lock()
+	results := self.trim(probe.Name, now)
+	self.lock.Unlock()
+
+	summary := &ProbeSummary{
+		Probe:  probe,
+		Window: self.getWindow(),
+		Total:  len(results),
+	}
+
+	var latencies []time.Duration
+	for _, result := range results {
+		if result.Success {
+			summary.Succeeded++
+			latencies = append(latencies, result.Latency)
+		} else {
+			summary.LastFailure = result
+		}
+		summary.LastResult = result
+	}
+
+	if summary.Total == 0 {
+		return summary
+	}
+
+	summary.Availability = float64(summary.Succeeded) * 100 / float64(summary.Total)
+
+	if len(latencies) > 0 {
+		summary.LatencyP50 = percentile(latencies, 50)
+		summary.LatencyP95 = percentile(latencies, 95)
+		summary.LatencyP99 = percentile(latencies, 99)
+		summary.SloLatency = percentile(latencies, probe.Slo.Percentile)
+	}
+
+	if probe.Slo.Availability > 0 || probe.Slo.Latency > 0 {
+		met := true
+		if probe.Slo.Availability > 0 && summary.Availability < probe.Slo.Availability {
+			met = false
+		}
+		if probe.Slo.Latency > 0 && (len(latencies) == 0 || summary.SloLatency > probe.Slo.Latency) {
+			met = false
+		}
+		summary.SloMet = &met
+	}
+
+	return summary
+}
+
+// trim removes results which have aged out of the results window and returns the remaining results for the probe,
+// oldest first. Must be called with the lock held
+func (self *ProbeManager) trim(name string, now time.Time) []*ProbeResult {
+	window := self.getWindow()
+	results := self.results[name]
+	for len(results) > 0 && now.Sub(results[0].Timestamp) > window {
+		results = results[1:]
+	}
+	self.results[name] = results
+	return results
+}
+
+func (self *ProbeManager) runProbe(probe *config.ProbeConfig, now time.Time) {
+	result := self.execute(probe, now)
+	if !result.Success {
+		pfxlog.Logger().WithField("probe", probe.Name).WithField("routerId", result.RouterId).
+			Debugf("probe failed: %s", result.Error)
+	}
+	self.record(probe, result)
+}
+
+func (self *ProbeManager) execute(probe *config.ProbeConfig, now time.Time) *ProbeResult {
+	result := &ProbeResult{
+		Probe:     probe.Name,
+		RouterId:  probe.Router,
+		Timestamp: now,
+	}
+
+	router := self.getConnectedRouter(probe.Router)
+	if router == nil {
+		result.Error = errors.Errorf("router '%s' isn't connected", probe.Router).Error()
+		return result
+	}
+	result.RouterId = router.Id
+
+	request := &ctrl_pb.ProbeRequest{
+		Name:    probe.Name,
+		Timeout: probe.Timeout.Nanoseconds(),
+	}
+
+	switch probe.Type {
+	case config.ProbeTypeRouterPath:
+		request.Type = ctrl_pb.ProbeType_RouterPathProbe
+		request.TargetRouterId = probe.TargetRouter
+		if target := self.getConnectedRouter(probe.TargetRouter); target != nil {
+			request.TargetRouterId = target.Id
+		}
+		result.TargetRouterId = request.TargetRouterId
+	case config.ProbeTypeService:
+		request.Type = ctrl_pb.ProbeType_ServiceProbe
+		request.Service = probe.Service
+		request.Send = []byte(probe.Send)
+		request.Expect = []byte(probe.Expect)
+	default:
+		result.Error = errors.Errorf("unsupported probe type '%s'", probe.Type).Error()
+		return result
+	}
+
+	// give the router time to report a probe timeout before giving up on it
+	response, err := self.send(router, request, probe.Timeout+5*time.Second)
+	if err != nil {
+		result.Error = errors.Wrapf(err, "probe request to router '%s' failed", router.Name).Error()
+		return result
+	}
+
+	result.Success = response.Success
+	result.Latency = time.Duration(response.Latency)
+	result.Error = response.Error
+	return result
+}
+
+// getConnectedRouter returns the connected router with the given id or, failing that, name
+func (self *ProbeManager) getConnectedRouter(idOrName string) *Router {
+	routers := self.env.GetManagers().Router
+	if router := routers.GetConnected(idOrName); router != nil {
+		return router
+	}
+	for _, router := range routers.AllConnected() {
+		if router.Name == idOrName {
+			return router
+		}
+	}
+	return nil
+}
+
+func (self *ProbeManager) record(probe *config.ProbeConfig, result *ProbeResult) {
+	self.lock.Lock()
+	results := append(self.trim(probe.Name, result.Timestamp), result)
+	sort.SliceStable(results, func(i, j int) bool {
+		return results[i].Timestamp.Before(results[j].Timestamp)
+	})
+	if maxResults := self.env.GetConfig().Probes.MaxResults; maxResults > 0 && len(results) > maxResults {
+		results = results[len(results)-maxResults:]
+	}
+	self.results[probe.Name] = results
+	self.lock.Unlock()
+
+	if registry := self.env.GetMetricsRegistry(); registry != nil {
+		if result.Success {
+			registry.Meter("probe.success:" + probe.Name).Mark(1)
+			registry.Histogram("probe.latency:" + probe.Name).Update(result.Latency.Nanoseconds())
+		} else {
+			registry.Meter("probe.failure:" + probe.Name).Mark(1)
+		}
+	}
+
+	if dispatcher := self.env.GetEventDispatcher(); dispatcher != nil {
+		dispatcher.AcceptProbeEvent(&event.ProbeEvent{
+			Namespace:      event.ProbeEventNS,
+			Timestamp:      result.Timestamp,
+			Probe:          probe.Name,
+			ProbeType:      probe.Type,
+			RouterId:       result.RouterId,
+			TargetRouterId: result.TargetRouterId,
+			Service:        probe.Service,
+			Success:        result.Success,
+			Latency:        result.Latency.Nanoseconds(),
+			Error:          result.Error,
+		})
+	}
+}
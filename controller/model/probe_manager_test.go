@@ -0,0 +1,222 @@
+/*
+	Copyright NetFoundry Inc.
+
+	Licensed under the Apache License, Version 2.0 (the "License");
+	you may not use this file except in compliance with the License.
+	You may obtain a copy of the License at
+
+	https://www.apache.org/licenses/LICENSE-2.0
+
+	Unless required by applicable law or agreed to in writing, software
+	distributed under the License is distributed on an "AS IS" BASIS,
+	WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+	See the License for the specific language governing permissions and
+	limitations under the License.
+*/
+
+package model
+
+import (
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/openziti/storage/boltz"
+	"github.com/openziti/ziti/common/eid"
+	"github.com/openziti/ziti/common/pb/ctrl_pb"
+	"github.com/openziti/ziti/controller/config"
+	"github.com/openziti/ziti/controller/event"
+	"github.com/openziti/ziti/controller/models"
+	"github.com/pkg/errors"
+)
+
+type probeEventCollector struct {
+	event.DispatcherMock
+	lock   sync.Mutex
+	events []*event.ProbeEvent
+}
+
+func (self *probeEventCollector) AcceptProbeEvent(evt *event.ProbeEvent) {
+	self.lock.Lock()
+	defer self.lock.Unlock()
+	self.events = append(self.events, evt)
+}
+
+func (self *probeEventCollector) take() []*event.ProbeEvent {
+	self.lock.Lock()
+	defer self.lock.Unlock()
+	result := self.events
+	self.events = nil
+	return result
+}
+
+func TestProbes(t *testing.T) {
+	ctx := NewTestContext(t)
+	defer ctx.Cleanup()
+	ctx.Init()
+
+	collector := &probeEventCollector{}
+	ctx.eventDispatcher = collector
+	manager := ctx.managers.Probe
+
+	src := &Router{BaseEntity: models.BaseEntity{Id: eid.New()}, Name: "east"}
+	dst := &Router{BaseEntity: models.BaseEntity{Id: eid.New()}, Name: "west"}
+
+	pathProbe := &config.ProbeConfig{
+		Name:         "east-west",
+		Type:         config.ProbeTypeRouterPath,
+		Router:       "east",
+		TargetRouter: "west",
+		Interval:     time.Minute,
+		Timeout:      5 * time.Second,
+		Slo: config.ProbeSloConfig{
+			Availability: 75,
+			Latency:      100 * time.Millisecond,
+			Percentile:   95,
+		},
+	}
+
+	serviceProbe := &config.ProbeConfig{
+		Name:     "web",
+		Type:     config.ProbeTypeService,
+		Router:   src.Id,
+		Service:  "web",
+		Send:     "ping",
+		Expect:   "pong",
+		Interval: time.Minute,
+		Timeout:  5 * time.Second,
+	}
+
+	ctx.config.Probes = config.ProbesConfig{
+		Window:      time.Hour,
+		MaxResults:  100,
+		Definitions: []*config.ProbeConfig{pathProbe, serviceProbe},
+	}
+
+	var requests []*ctrl_pb.ProbeRequest
+	var responses []*ctrl_pb.ProbeResponse
+	manager.send = func(router *Router, request *ctrl_pb.ProbeRequest, timeout time.Duration) (*ctrl_pb.ProbeResponse, error) {
+		ctx.Equal(src.Id, router.Id)
+		ctx.Equal(10*time.Second, timeout)
+		requests = append(requests, request)
+		if len(responses) == 0 {
+			return nil, errors.New("timeout waiting for response")
+		}
+		response := responses[0]
+		responses = responses[1:]
+		return response, nil
+	}
+
+	t.Run("unknown probes aren't found", func(t *testing.T) {
+		_, err := manager.GetResults("unknown")
+		ctx.True(boltz.IsErrNotFoundErr(err))
+	})
+
+	t.Run("probes fail if the router isn't connected", func(t *testing.T) {
+		manager.runProbe(pathProbe, time.Now())
+		ctx.Empty(requests)
+
+		events := collector.take()
+		ctx.Len(events, 1)
+		ctx.False(events[0].Success)
+		ctx.Equal("east-west", events[0].Probe)
+		ctx.Equal("router 'east' isn't connected", events[0].Error)
+	})
+
+	ctx.managers.Router.MarkConnected(src)
+	defer ctx.managers.Router.MarkDisconnected(src)
+	ctx.managers.Router.MarkConnected(dst)
+	defer ctx.managers.Router.MarkDisconnected(dst)
+
+	t.Run("router path probes resolve routers by name", func(t *testing.T) {
+		responses = append(responses,
+			&ctrl_pb.ProbeResponse{Success: true, Latency: (20 * time.Millisecond).Nanoseconds()},
+			&ctrl_pb.ProbeResponse{Success: true, Latency: (40 * time.Millisecond).Nanoseconds()},
+		)
+
+		manager.runProbe(pathProbe, time.Now())
+		manager.runProbe(pathProbe, time.Now())
+		manager.runProbe(pathProbe, time.Now())
+
+		ctx.Len(requests, 3)
+		ctx.Equal(ctrl_pb.ProbeType_RouterPathProbe, requests[0].Type)
+		ctx.Equal(dst.Id, requests[0].TargetRouterId)
+		ctx.Equal((5 * time.Second).Nanoseconds(), requests[0].Timeout)
+
+		events := collector.take()
+		ctx.Len(events, 3)
+		ctx.True(events[0].Success)
+		ctx.Equal(src.Id, events[0].RouterId)
+		ctx.Equal(dst.Id, events[0].TargetRouterId)
+		ctx.Equal((20 * time.Millisecond).Nanoseconds(), events[0].Latency)
+		ctx.False(events[2].Success)
+		ctx.Contains(events[2].Error, "timeout waiting for response")
+
+		results, err := manager.GetResults(pathProbe.Name)
+		ctx.NoError(err)
+		ctx.Len(results, 4)
+		ctx.False(results[0].Success)
+		ctx.True(results[1].Success)
+		ctx.Equal(40*time.Millisecond, results[1].Latency)
+
+		summary, err := manager.GetSummary(pathProbe.Name)
+		ctx.NoError(err)
+		ctx.Equal(4, summary.Total)
+		ctx.Equal(2, summary.Succeeded)
+		ctx.Equal(float64(50), summary.Availability)
+		ctx.Equal(20*time.Millisecond, summary.LatencyP50)
+		ctx.Equal(40*time.Millisecond, summary.LatencyP95)
+		ctx.Equal(40*time.Millisecond, summary.SloLatency)
+		ctx.NotNil(summary.SloMet)
+		ctx.False(*summary.SloMet)
+		ctx.NotNil(summary.LastFailure)
+		ctx.Equal(summary.LastFailure, summary.LastResult)
+	})
+
+	t.Run("service probes pass the payload and expected response", func(t *testing.T) {
+		requests = nil
+		responses = append(responses, &ctrl_pb.ProbeResponse{Success: true, Latency: (30 * time.Millisecond).Nanoseconds()})
+
+		manager.runProbe(serviceProbe, time.Now())
+		ctx.Len(requests, 1)
+		ctx.Equal(ctrl_pb.ProbeType_ServiceProbe, requests[0].Type)
+		ctx.Equal("web", requests[0].Service)
+		ctx.Equal([]byte("ping"), requests[0].Send)
+		ctx.Equal([]byte("pong"), requests[0].Expect)
+
+		events := collector.take()
+		ctx.Len(events, 1)
+		ctx.True(events[0].Success)
+		ctx.Equal("web", events[0].Service)
+
+		summary, err := manager.GetSummary(serviceProbe.Name)
+		ctx.NoError(err)
+		ctx.Equal(float64(100), summary.Availability)
+		ctx.Nil(summary.SloMet)
+	})
+
+	t.Run("results age out of the window", func(t *testing.T) {
+		old := &ProbeResult{Probe: serviceProbe.Name, Timestamp: time.Now().Add(-2 * time.Hour), Success: true}
+		manager.lock.Lock()
+		manager.results[serviceProbe.Name] = append([]*ProbeResult{old}, manager.results[serviceProbe.Name]...)
+		manager.lock.Unlock()
+
+		results, err := manager.GetResults(serviceProbe.Name)
+		ctx.NoError(err)
+		ctx.Len(results, 1)
+		ctx.Equal(30*time.Millisecond, results[0].Latency)
+	})
+
+	t.Run("probes are scheduled by interval", func(t *testing.T) {
+		now := time.Now()
+		manager.lock.Lock()
+		manager.nextRun[pathProbe.Name] = now.Add(time.Minute)
+		manager.nextRun[serviceProbe.Name] = now.Add(time.Minute)
+		manager.lock.Unlock()
+
+		manager.schedule(now)
+		manager.lock.Lock()
+		ctx.Empty(manager.inflight)
+		manager.lock.Unlock()
+	})
+}
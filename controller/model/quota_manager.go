@@ -0,0 +1,218 @@
+/*
+	(c) Copyright NetFoundry Inc.
+
+	Licensed under the Apache License, Version 2.0 (the "License");
+	you may not use this file except in compliance with the License.
+	You may obtain a copy of the License at
+
+	https://www.apache.org/licenses/LICENSE-2.0
+
+	Unless required by applicable law or agreed to in writing, software
+	distributed under the License is distributed on an "AS IS" BASIS,
+	WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+	See the License for the specific language governing permissions and
+	limitations under the License.
+*/
+
+package model
+
+import (
+	"time"
+
+	"github.com/openziti/storage/boltz"
+	"github.com/openziti/ziti/common/pb/cmd_pb"
+	"github.com/openziti/ziti/common/pb/edge_cmd_pb"
+	"github.com/openziti/ziti/controller/change"
+	"github.com/openziti/ziti/controller/command"
+	"github.com/openziti/ziti/controller/db"
+	"github.com/openziti/ziti/controller/fields"
+	"github.com/openziti/ziti/controller/models"
+	"google.golang.org/protobuf/proto"
+)
+
+func NewQuotaManager(env Env) *QuotaManager {
+	manager := &QuotaManager{
+		baseEntityManager: newBaseEntityManager[*Quota, *db.Quota](env, env.GetStores().Quota),
+	}
+	manager.impl = manager
+	manager.tracker = newQuotaTracker(env)
+
+	RegisterManagerDecoder[*Quota](env, manager)
+	RegisterCommand(env, &UpdateQuotaUsageCmd{}, &edge_cmd_pb.UpdateQuotaUsageCmd{})
+
+	if dispatcher := env.GetEventDispatcher(); dispatcher != nil {
+		dispatcher.AddMetricsMessageHandler(manager.tracker)
+	}
+	go manager.tracker.run()
+
+	return manager
+}
+
+type QuotaManager struct {
+	baseEntityManager[*Quota, *db.Quota]
+	tracker *quotaTracker
+}
+
+func (self *QuotaManager) NewModelEntity() *Quota {
+	return &Quota{}
+}
+
+func (self *QuotaManager) Create(entity *Quota, ctx *change.Context) error {
+	return DispatchCreate[*Quota](self, entity, ctx)
+}
+
+func (self *QuotaManager) ApplyCreate(cmd *command.CreateEntityCommand[*Quota], ctx boltz.MutateContext) error {
+	_, err := self.createEntity(cmd.Entity, ctx)
+	return err
+}
+
+func (self *QuotaManager) Update(entity *Quota, checker fields.UpdatedFields, ctx *change.Context) error {
+	return DispatchUpdate[*Quota](self, entity, checker, ctx)
+}
+
+func (self *QuotaManager) ApplyUpdate(cmd *command.UpdateEntityCommand[*Quota], ctx boltz.MutateContext) error {
+	return self.updateEntity(cmd.Entity, cmd.UpdatedFields, ctx)
+}
+
+func (self *QuotaManager) ReadByName(name string) (*Quota, error) {
+	modelEntity := &Quota{}
+	nameIndex := self.env.GetStores().Quota.GetNameIndex()
+	if err := self.readEntityWithIndex("name", []byte(name), nameIndex, modelEntity); err != nil {
+		return nil, err
+	}
+	return modelEntity, nil
+}
+
+// GetUsage returns the consumption of each identity or service tracked by the quota in the current period
+func (self *QuotaManager) GetUsage(quotaId string) ([]*db.QuotaUsage, error) {
+	if _, err := self.Read(quotaId); err != nil {
+		return nil, err
+	}
+	return self.tracker.getUsage(quotaId), nil
+}
+
+// GetExceededQuota returns a quota whose hard limit has been reached for traffic from the given identity to the
+// given service, or nil if there isn't one
+func (self *QuotaManager) GetExceededQuota(identityId, serviceId string) *Quota {
+	return self.tracker.getExceededQuota(identityId, serviceId)
+}
+
+// IsAffected returns true if the given quota is tracking traffic from the identity to the service under the
+// given subject
+func (self *QuotaManager) IsAffected(quotaId, subjectId, identityId, serviceId string) bool {
+	return self.tracker.isAffected(quotaId, subjectId, identityId, serviceId)
+}
+
+// AddHardLimitHandler registers a handler to be notified when an identity or service reaches the hard limit of a
+// quota whose hard limit action is teardown
+func (self *QuotaManager) AddHardLimitHandler(handler QuotaHardLimitHandler) {
+	self.tracker.hardLimitHandlers.Append(handler)
+}
+
+// FlushUsage persists any consumption recorded since the last flush. Only the leader persists consumption.
+func (self *QuotaManager) FlushUsage() {
+	self.tracker.flush()
+}
+
+func (self *QuotaManager) ApplyUpdateUsage(cmd *UpdateQuotaUsageCmd, ctx boltz.MutateContext) error {
+	return self.env.GetDb().Update(ctx, func(ctx boltz.MutateContext) error {
+		return self.env.GetStores().Quota.SetUsage(ctx, cmd.QuotaId, cmd.Usage)
+	})
+}
+
+func (self *QuotaManager) Marshall(entity *Quota) ([]byte, error) {
+	tags, err := edge_cmd_pb.EncodeTags(entity.Tags)
+	if err != nil {
+		return nil, err
+	}
+
+	msg := &edge_cmd_pb.Quota{
+		Id:              entity.Id,
+		Name:            entity.Name,
+		Tags:            tags,
+		Scope:           entity.Scope,
+		Period:          entity.Period,
+		SoftLimit:       entity.SoftLimit,
+		HardLimit:       entity.HardLimit,
+		HardLimitAction: entity.HardLimitAction,
+		IdentityRoles:   entity.IdentityRoles,
+		ServiceRoles:    entity.ServiceRoles,
+	}
+
+	return proto.Marshal(msg)
+}
+
+func (self *QuotaManager) Unmarshall(bytes []byte) (*Quota, error) {
+	msg := &edge_cmd_pb.Quota{}
+	if err := proto.Unmarshal(bytes, msg); err != nil {
+		return nil, err
+	}
+
+	return &Quota{
+		BaseEntity: models.BaseEntity{
+			Id:   msg.Id,
+			Tags: edge_cmd_pb.DecodeTags(msg.Tags),
+		},
+		Name:            msg.Name,
+		Scope:           msg.Scope,
+		Period:          msg.Period,
+		SoftLimit:       msg.SoftLimit,
+		HardLimit:       msg.HardLimit,
+		HardLimitAction: msg.HardLimitAction,
+		IdentityRoles:   msg.IdentityRoles,
+		ServiceRoles:    msg.ServiceRoles,
+	}, nil
+}
+
+// UpdateQuotaUsageCmd persists the consumption tracked for a quota
+type UpdateQuotaUsageCmd struct {
+	manager *QuotaManager
+	QuotaId string
+	Usage   []*db.QuotaUsage
+	Context *change.Context
+}
+
+func (self *UpdateQuotaUsageCmd) Apply(ctx boltz.MutateContext) error {
+	return self.manager.ApplyUpdateUsage(self, ctx)
+}
+
+func (self *UpdateQuotaUsageCmd) Encode() ([]byte, error) {
+	cmd := &edge_cmd_pb.UpdateQuotaUsageCmd{
+		QuotaId: self.QuotaId,
+		Ctx:     ContextToProtobuf(self.Context),
+	}
+
+	for _, usage := range self.Usage {
+		cmd.Usage = append(cmd.Usage, &edge_cmd_pb.UpdateQuotaUsageCmd_Usage{
+			SubjectId:         usage.SubjectId,
+			PeriodStart:       usage.PeriodStart.Unix(),
+			Bytes:             usage.Bytes,
+			SoftLimitExceeded: usage.SoftLimitExceeded,
+			HardLimitExceeded: usage.HardLimitExceeded,
+		})
+	}
+
+	return cmd_pb.EncodeProtobuf(cmd)
+}
+
+func (self *UpdateQuotaUsageCmd) Decode(env Env, msg *edge_cmd_pb.UpdateQuotaUsageCmd) error {
+	self.manager = env.GetManagers().Quota
+	self.QuotaId = msg.QuotaId
+	self.Context = ProtobufToContext(msg.Ctx)
+
+	for _, usage := range msg.Usage {
+		self.Usage = append(self.Usage, &db.QuotaUsage{
+			SubjectId:         usage.SubjectId,
+			PeriodStart:       time.Unix(usage.PeriodStart, 0).UTC(),
+			Bytes:             usage.Bytes,
+			SoftLimitExceeded: usage.SoftLimitExceeded,
+			HardLimitExceeded: usage.HardLimitExceeded,
+		})
+	}
+
+	return nil
+}
+
+func (self *UpdateQuotaUsageCmd) GetChangeContext() *change.Context {
+	return self.Context
+}
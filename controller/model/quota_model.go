@@ -0,0 +1,161 @@
+/*
+	(c) Copyright NetFoundry Inc.
+
+	Licensed under the Apache License, Version 2.0 (the "License");
+	you may not use this file except in compliance with the License.
+	You may obtain a copy of the License at
+
+	https://www.apache.org/licenses/LICENSE-2.0
+
+	Unless required by applicable law or agreed to in writing, software
+	distributed under the License is distributed on an "AS IS" BASIS,
+	WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+	See the License for the specific language governing permissions and
+	limitations under the License.
+*/
+
+package model
+
+import (
+	"time"
+
+	"github.com/openziti/foundation/v2/errorz"
+	"github.com/openziti/storage/boltz"
+	"github.com/openziti/ziti/controller/db"
+	"github.com/openziti/ziti/controller/models"
+	"go.etcd.io/bbolt"
+)
+
+type Quota struct {
+	models.BaseEntity
+	Name            string
+	Scope           string
+	Period          string
+	SoftLimit       int64
+	HardLimit       int64
+	HardLimitAction string
+	IdentityRoles   []string
+	ServiceRoles    []string
+}
+
+func (entity *Quota) validate() error {
+	if entity.Scope != db.QuotaScopeIdentity && entity.Scope != db.QuotaScopeService {
+		return errorz.NewFieldError("scope must be one of identity or service", db.FieldQuotaScope, entity.Scope)
+	}
+
+	if entity.Period != db.QuotaPeriodDay && entity.Period != db.QuotaPeriodMonth {
+		return errorz.NewFieldError("period must be one of day or month", db.FieldQuotaPeriod, entity.Period)
+	}
+
+	if entity.SoftLimit < 0 {
+		return errorz.NewFieldError("soft limit may not be negative", db.FieldQuotaSoftLimit, entity.SoftLimit)
+	}
+
+	if entity.HardLimit < 0 {
+		return errorz.NewFieldError("hard limit may not be negative", db.FieldQuotaHardLimit, entity.HardLimit)
+	}
+
+	if entity.SoftLimit == 0 && entity.HardLimit == 0 {
+		return errorz.NewFieldError("at least one of soft limit or hard limit is required", db.FieldQuotaHardLimit, entity.HardLimit)
+	}
+
+	if entity.SoftLimit > 0 && entity.HardLimit > 0 && entity.SoftLimit >= entity.HardLimit {
+		return errorz.NewFieldError("soft limit must be less than the hard limit", db.FieldQuotaSoftLimit, entity.SoftLimit)
+	}
+
+	if entity.HardLimitAction != "" && entity.HardLimitAction != db.QuotaActionDeny && entity.HardLimitAction != db.QuotaActionTeardown {
+		return errorz.NewFieldError("hard limit action must be one of deny or teardown", db.FieldQuotaHardLimitAction, entity.HardLimitAction)
+	}
+
+	return nil
+}
+
+// GetHardLimitAction returns the configured hard limit action, defaulting to deny
+func (entity *Quota) GetHardLimitAction() string {
+	if entity.HardLimitAction == "" {
+		return db.QuotaActionDeny
+	}
+	return entity.HardLimitAction
+}
+
+// GetPeriodStart returns the start of the quota period containing t. Periods start at midnight UTC.
+func (entity *Quota) GetPeriodStart(t time.Time) time.Time {
+	t = t.UTC()
+	if entity.Period == db.QuotaPeriodDay {
+		return time.Date(t.Year(), t.Month(), t.Day(), 0, 0, 0, 0, time.UTC)
+	}
+	return time.Date(t.Year(), t.Month(), 1, 0, 0, 0, 0, time.UTC)
+}
+
+// GetSubjectId returns the id consumption is tracked under for traffic from the given identity to the given service
+func (entity *Quota) GetSubjectId(identityId, serviceId string) string {
+	if entity.Scope == db.QuotaScopeService {
+		return serviceId
+	}
+	return identityId
+}
+
+func (entity *Quota) toBoltEntity() (*db.Quota, error) {
+	if err := entity.validate(); err != nil {
+		return nil, err
+	}
+
+	return &db.Quota{
+		BaseExtEntity:   *boltz.NewExtEntity(entity.Id, entity.Tags),
+		Name:            entity.Name,
+		Scope:           entity.Scope,
+		Period:          entity.Period,
+		SoftLimit:       entity.SoftLimit,
+		HardLimit:       entity.HardLimit,
+		HardLimitAction: entity.GetHardLimitAction(),
+		IdentityRoles:   entity.IdentityRoles,
+		ServiceRoles:    entity.ServiceRoles,
+	}, nil
+}
+
+func (entity *Quota) toBoltEntityForCreate(*bbolt.Tx, Env) (*db.Quota, error) {
+	return entity.toBoltEntity()
+}
+
+func (entity *Quota) toBoltEntityForUpdate(tx *bbolt.Tx, env Env, checker boltz.FieldChecker) (*db.Quota, error) {
+	if checker == nil {
+		return entity.toBoltEntity()
+	}
+
+	// patches only carry the updated fields, so fill in the rest before validating
+	existing, err := env.GetStores().Quota.LoadById(tx, entity.Id)
+	if err != nil {
+		return nil, err
+	}
+
+	merged := *entity
+	if !checker.IsUpdated(db.FieldQuotaScope) {
+		merged.Scope = existing.Scope
+	}
+	if !checker.IsUpdated(db.FieldQuotaPeriod) {
+		merged.Period = existing.Period
+	}
+	if !checker.IsUpdated(db.FieldQuotaSoftLimit) {
+		merged.SoftLimit = existing.SoftLimit
+	}
+	if !checker.IsUpdated(db.FieldQuotaHardLimit) {
+		merged.HardLimit = existing.HardLimit
+	}
+	if !checker.IsUpdated(db.FieldQuotaHardLimitAction) {
+		merged.HardLimitAction = existing.HardLimitAction
+	}
+	return merged.toBoltEntity()
+}
+
+func (entity *Quota) fillFrom(_ Env, _ *bbolt.Tx, boltQuota *db.Quota) error {
+	entity.FillCommon(boltQuota)
+	entity.Name = boltQuota.Name
+	entity.Scope = boltQuota.Scope
+	entity.Period = boltQuota.Period
+	entity.SoftLimit = boltQuota.SoftLimit
+	entity.HardLimit = boltQuota.HardLimit
+	entity.HardLimitAction = boltQuota.HardLimitAction
+	entity.IdentityRoles = boltQuota.IdentityRoles
+	entity.ServiceRoles = boltQuota.ServiceRoles
+	return nil
+}
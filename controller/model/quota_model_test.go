@@ -0,0 +1,142 @@
+/*
+	(c) Copyright NetFoundry Inc.
+
+	Licensed under the Apache License, Version 2.0 (the "License");
+	you may not use this file except in compliance with the License.
+	You may obtain a copy of the License at
+
+	https://www.apache.org/licenses/LICENSE-2.0
+
+	Unless required by applicable law or agreed to in writing, software
+	distributed under the License is distributed on an "AS IS" BASIS,
+	WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+	See the License for the specific language governing permissions and
+	limitations under the License.
+*/
+
+package model
+
+import (
+	"testing"
+	"time"
+
+	"github.com/openziti/metrics/metrics_pb"
+	"github.com/openziti/ziti/controller/change"
+	"github.com/openziti/ziti/controller/db"
+	"github.com/stretchr/testify/require"
+	"go.etcd.io/bbolt"
+)
+
+func TestQuota(t *testing.T) {
+	t.Run("validation", func(t *testing.T) {
+		req := require.New(t)
+
+		quota := &Quota{Name: "monthly", Scope: db.QuotaScopeIdentity, Period: db.QuotaPeriodMonth, HardLimit: 1000}
+		req.NoError(quota.validate())
+		req.Equal(db.QuotaActionDeny, quota.GetHardLimitAction())
+
+		quota.Scope = "router"
+		req.Error(quota.validate())
+
+		quota.Scope = db.QuotaScopeService
+		quota.Period = "year"
+		req.Error(quota.validate())
+
+		quota.Period = db.QuotaPeriodDay
+		quota.HardLimit = 0
+		req.Error(quota.validate(), "a quota must have a limit")
+
+		quota.SoftLimit = 1000
+		req.NoError(quota.validate())
+
+		quota.HardLimit = 500
+		req.Error(quota.validate(), "the soft limit must be below the hard limit")
+
+		quota.HardLimit = 2000
+		quota.HardLimitAction = "throttle"
+		req.Error(quota.validate())
+
+		quota.HardLimitAction = db.QuotaActionTeardown
+		req.NoError(quota.validate())
+	})
+
+	t.Run("period start", func(t *testing.T) {
+		req := require.New(t)
+
+		ts := time.Date(2024, time.March, 15, 13, 45, 0, 0, time.UTC)
+
+		quota := &Quota{Period: db.QuotaPeriodDay}
+		req.Equal(time.Date(2024, time.March, 15, 0, 0, 0, 0, time.UTC), quota.GetPeriodStart(ts))
+
+		quota.Period = db.QuotaPeriodMonth
+		req.Equal(time.Date(2024, time.March, 1, 0, 0, 0, 0, time.UTC), quota.GetPeriodStart(ts))
+	})
+
+	t.Run("usage tracking", func(t *testing.T) {
+		ctx := NewTestContext(t)
+		defer ctx.Cleanup()
+		ctx.Init()
+
+		identity := ctx.requireNewIdentity(false)
+		otherIdentity := ctx.requireNewIdentity(false)
+		service := ctx.requireNewService()
+
+		quota := &Quota{
+			Name:          "daily",
+			Scope:         db.QuotaScopeIdentity,
+			Period:        db.QuotaPeriodDay,
+			SoftLimit:     1000,
+			HardLimit:     2000,
+			IdentityRoles: []string{"@" + identity.Id},
+			ServiceRoles:  []string{"#all"},
+		}
+		ctx.NoError(ctx.managers.Quota.Create(quota, change.New()))
+
+		report := func(identityId string, bytes uint64) {
+			ctx.managers.Quota.tracker.AcceptMetricsMsg(&metrics_pb.MetricsMessage{
+				UsageCounters: []*metrics_pb.MetricsMessage_UsageCounter{
+					{
+						IntervalStartUTC: time.Now().Unix(),
+						IntervalLength:   60,
+						Buckets: map[string]*metrics_pb.MetricsMessage_UsageBucket{
+							"c1": {
+								Values: map[string]uint64{"ingress.rx": bytes / 2, "ingress.tx": bytes / 2},
+								Tags:   map[string]string{"clientId": identityId, "serviceId": "inst1@" + service.Id},
+							},
+						},
+					},
+				},
+			})
+		}
+
+		report(identity.Id, 1200)
+		report(otherIdentity.Id, 5000)
+
+		usage, err := ctx.managers.Quota.GetUsage(quota.Id)
+		ctx.NoError(err)
+		ctx.Len(usage, 1)
+		ctx.Equal(identity.Id, usage[0].SubjectId)
+		ctx.Equal(int64(1200), usage[0].Bytes)
+		ctx.True(usage[0].SoftLimitExceeded)
+		ctx.False(usage[0].HardLimitExceeded)
+		ctx.Nil(ctx.managers.Quota.GetExceededQuota(identity.Id, service.Id))
+
+		report(identity.Id, 1000)
+		exceeded := ctx.managers.Quota.GetExceededQuota(identity.Id, service.Id)
+		ctx.NotNil(exceeded)
+		ctx.Equal(quota.Id, exceeded.Id)
+		ctx.Nil(ctx.managers.Quota.GetExceededQuota(otherIdentity.Id, service.Id))
+
+		ctx.managers.Quota.FlushUsage()
+
+		var persisted []*db.QuotaUsage
+		ctx.NoError(ctx.GetDb().View(func(tx *bbolt.Tx) error {
+			var err error
+			persisted, err = ctx.GetStores().Quota.GetUsage(tx, quota.Id)
+			return err
+		}))
+		ctx.Len(persisted, 1)
+		ctx.Equal(int64(2200), persisted[0].Bytes)
+		ctx.True(persisted[0].HardLimitExceeded)
+	})
+}
@@ -0,0 +1,424 @@
+/*
+	(c) Copyright NetFoundry Inc.
+
+	Licensed under the Apache License, Version 2.0 (the "License");
+	you may not use this file except in compliance with the License.
+	You may obtain a copy of the License at
+
+	https://www.apache.org/licenses/LICENSE-2.0
+
+	Unless required by applicable law or agreed to in writing, software
+	distributed under the License is distributed on an "AS IS" BASIS,
+	WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+	See the License for the specific language governing permissions and
+	limitations under the License.
+*/
+
+package model
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/michaelquigley/pfxlog"
+	"github.com/openziti/foundation/v2/concurrenz"
+	"github.com/openziti/metrics/metrics_pb"
+	"github.com/openziti/storage/ast"
+	"github.com/openziti/storage/boltz"
+	"github.com/openziti/ziti/controller/change"
+	"github.com/openziti/ziti/controller/db"
+	"github.com/openziti/ziti/controller/event"
+	"go.etcd.io/bbolt"
+)
+
+const quotaFlushInterval = time.Minute
+
+// QuotaHardLimitHandler is notified when an identity or service reaches the hard limit of a quota whose hard limit
+// action is teardown
+type QuotaHardLimitHandler interface {
+	QuotaHardLimitReached(quota *Quota, subjectId string)
+}
+
+// quotaTracker counts the ingress usage reported by routers against the quotas. Every controller receives the
+// usage from every router, so each keeps its own counts and enforces the limits for the circuits it manages. Only
+// the leader raises alerts and persists the counts, so they survive restarts.
+type quotaTracker struct {
+	env               Env
+	lock              sync.Mutex
+	quotas            map[string]*trackedQuota
+	loaded            bool
+	dirty             atomic.Bool
+	hardLimitHandlers concurrenz.CopyOnWriteSlice[QuotaHardLimitHandler]
+}
+
+type trackedQuota struct {
+	*Quota
+	identities map[string]struct{}
+	services   map[string]struct{}
+	usage      map[string]*trackedQuotaUsage
+}
+
+// matches returns true if traffic from the given identity to the given service counts against the quota. A nil
+// identity or service set matches everything.
+func (self *trackedQuota) matches(identityId, serviceId string) bool {
+	if self.identities != nil {
+		if _, ok := self.identities[identityId]; !ok {
+			return false
+		}
+	}
+	if self.services != nil {
+		if _, ok := self.services[serviceId]; !ok {
+			return false
+		}
+	}
+	return true
+}
+
+type trackedQuotaUsage struct {
+	db.QuotaUsage
+	changed bool
+}
+
+type quotaNotification struct {
+	quota     *Quota
+	subjectId string
+	bytes     int64
+	hardLimit bool
+}
+
+func newQuotaTracker(env Env) *quotaTracker {
+	result := &quotaTracker{
+		env:    env,
+		quotas: map[string]*trackedQuota{},
+	}
+
+	markDirty := func(string) {
+		result.dirty.Store(true)
+	}
+
+	stores := env.GetStores()
+	stores.Quota.AddEntityIdListener(markDirty, boltz.EntityCreated, boltz.EntityUpdated, boltz.EntityDeleted)
+	stores.Identity.AddEntityIdListener(markDirty, boltz.EntityCreated, boltz.EntityUpdated, boltz.EntityDeleted)
+	stores.EdgeService.AddEntityIdListener(markDirty, boltz.EntityCreated, boltz.EntityUpdated, boltz.EntityDeleted)
+
+	return result
+}
+
+func (self *quotaTracker) run() {
+	ticker := time.NewTicker(quotaFlushInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ticker.C:
+			self.flush()
+		case <-self.env.GetCloseNotifyChannel():
+			return
+		}
+	}
+}
+
+func (self *quotaTracker) AcceptMetricsMsg(msg *metrics_pb.MetricsMessage) {
+	var notifications []*quotaNotification
+
+	self.lock.Lock()
+	self.ensureLoadedAlreadyLocked()
+	if len(self.quotas) > 0 {
+		for _, interval := range msg.UsageCounters {
+			intervalStart := time.Unix(interval.IntervalStartUTC, 0)
+			for _, bucket := range interval.Buckets {
+				bytes := int64(bucket.Values["ingress.rx"] + bucket.Values["ingress.tx"])
+				if bytes == 0 {
+					continue
+				}
+				identityId := bucket.Tags["clientId"]
+				serviceId := bucket.Tags["serviceId"]
+				if atIndex := strings.IndexRune(serviceId, '@'); atIndex >= 0 {
+					serviceId = serviceId[atIndex+1:]
+				}
+				notifications = self.recordUsageAlreadyLocked(identityId, serviceId, intervalStart, bytes, notifications)
+			}
+		}
+	}
+	self.lock.Unlock()
+
+	self.notify(notifications)
+}
+
+func (self *quotaTracker) recordUsageAlreadyLocked(identityId, serviceId string, t time.Time, bytes int64, notifications []*quotaNotification) []*quotaNotification {
+	for _, quota := range self.quotas {
+		if !quota.matches(identityId, serviceId) {
+			continue
+		}
+
+		subjectId := quota.GetSubjectId(identityId, serviceId)
+		if subjectId == "" {
+			continue
+		}
+
+		periodStart := quota.GetPeriodStart(t)
+		usage := quota.usage[subjectId]
+		if usage == nil || usage.PeriodStart.Before(periodStart) {
+			usage = &trackedQuotaUsage{
+				QuotaUsage: db.QuotaUsage{
+					SubjectId:   subjectId,
+					PeriodStart: periodStart,
+				},
+			}
+			quota.usage[subjectId] = usage
+		} else if periodStart.Before(usage.PeriodStart) {
+			// late report for a period which has already ended
+			continue
+		}
+
+		usage.Bytes += bytes
+		usage.changed = true
+
+		if quota.SoftLimit > 0 && !usage.SoftLimitExceeded && usage.Bytes >= quota.SoftLimit {
+			usage.SoftLimitExceeded = true
+			notifications = append(notifications, &quotaNotification{quota: quota.Quota, subjectId: subjectId, bytes: usage.Bytes})
+		}
+
+		if quota.HardLimit > 0 && !usage.HardLimitExceeded && usage.Bytes >= quota.HardLimit {
+			usage.HardLimitExceeded = true
+			notifications = append(notifications, &quotaNotification{quota: quota.Quota, subjectId: subjectId, bytes: usage.Bytes, hardLimit: true})
+		}
+	}
+	return notifications
+}
+
+func (self *quotaTracker) notify(notifications []*quotaNotification) {
+	if len(notifications) == 0 {
+		return
+	}
+
+	isLeader := self.env.GetCommandDispatcher().IsLeaderOrLeaderless()
+
+	for _, notification := range notifications {
+		quota := notification.quota
+		log := pfxlog.Logger().WithField("quotaId", quota.Id).
+			WithField("quota", quota.Name).
+			WithField("subjectId", notification.subjectId).
+			WithField("bytes", notification.bytes)
+
+		limitType, limit, severity := "soft", quota.SoftLimit, event.AlertSeverityWarning
+		if notification.hardLimit {
+			limitType, limit, severity = "hard", quota.HardLimit, event.AlertSeverityError
+		}
+		log.Infof("%s %s reached %s limit of quota", quota.Scope, notification.subjectId, limitType)
+
+		if isLeader {
+			self.env.GetEventDispatcher().AcceptAlertEvent(&event.AlertEvent{
+				Namespace:       event.AlertEventNS,
+				Timestamp:       time.Now(),
+				AlertSourceType: event.AlertSourceTypeController,
+				AlertSourceId:   self.env.GetId(),
+				Severity:        severity,
+				Message: fmt.Sprintf("%s %s has used %d bytes, reaching the %s limit of %d bytes of quota '%s'",
+					quota.Scope, notification.subjectId, notification.bytes, limitType, limit, quota.Name),
+				RelatedEntities: map[string]string{
+					"quota":     quota.Id,
+					quota.Scope: notification.subjectId,
+				},
+			})
+		}
+
+		if notification.hardLimit && quota.GetHardLimitAction() == db.QuotaActionTeardown {
+			for _, handler := range self.hardLimitHandlers.Value() {
+				handler.QuotaHardLimitReached(quota, notification.subjectId)
+			}
+		}
+	}
+}
+
+func (self *quotaTracker) ensureLoadedAlreadyLocked() {
+	if self.loaded && !self.dirty.Load() {
+		return
+	}
+
+	// clear the flag before loading, so changes committed while we load trigger another load
+	self.dirty.Store(false)
+
+	quotas := map[string]*trackedQuota{}
+	err := self.env.GetDb().View(func(tx *bbolt.Tx) error {
+		store := self.env.GetStores().Quota
+		for cursor := store.IterateIds(tx, ast.BoolNodeTrue); cursor.IsValid(); cursor.Next() {
+			quota, err := self.loadQuota(tx, store, string(cursor.Current()))
+			if err != nil {
+				return err
+			}
+			quotas[quota.Id] = quota
+		}
+		return nil
+	})
+
+	if err != nil {
+		pfxlog.Logger().WithError(err).Error("failed to load quotas")
+		self.dirty.Store(true)
+		return
+	}
+
+	self.quotas = quotas
+	self.loaded = true
+}
+
+func (self *quotaTracker) loadQuota(tx *bbolt.Tx, store db.QuotaStore, id string) (*trackedQuota, error) {
+	entity, err := store.LoadById(tx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	targets, err := store.ResolveTargets(tx, entity)
+	if err != nil {
+		return nil, err
+	}
+
+	result := &trackedQuota{
+		Quota: &Quota{},
+		usage: map[string]*trackedQuotaUsage{},
+	}
+	if err = result.fillFrom(self.env, tx, entity); err != nil {
+		return nil, err
+	}
+
+	if !targets.AllIdentities {
+		result.identities = toQuotaTargetSet(targets.IdentityIds)
+	}
+	if !targets.AllServices {
+		result.services = toQuotaTargetSet(targets.ServiceIds)
+	}
+
+	// keep our own counts, unless they were tracked under a different scope or period
+	if existing, ok := self.quotas[id]; ok && self.loaded && existing.Scope == entity.Scope && existing.Period == entity.Period {
+		result.usage = existing.usage
+	} else {
+		persisted, err := store.GetUsage(tx, id)
+		if err != nil {
+			return nil, err
+		}
+		for _, usage := range persisted {
+			result.usage[usage.SubjectId] = &trackedQuotaUsage{QuotaUsage: *usage}
+		}
+	}
+
+	// the limits may have changed, in which case we may need to notify again
+	for _, usage := range result.usage {
+		usage.SoftLimitExceeded = usage.SoftLimitExceeded && result.SoftLimit > 0 && usage.Bytes >= result.SoftLimit
+		usage.HardLimitExceeded = usage.HardLimitExceeded && result.HardLimit > 0 && usage.Bytes >= result.HardLimit
+	}
+
+	return result, nil
+}
+
+func toQuotaTargetSet(ids []string) map[string]struct{} {
+	result := make(map[string]struct{}, len(ids))
+	for _, id := range ids {
+		result[id] = struct{}{}
+	}
+	return result
+}
+
+func (self *quotaTracker) getUsage(quotaId string) []*db.QuotaUsage {
+	self.lock.Lock()
+	defer self.lock.Unlock()
+
+	self.ensureLoadedAlreadyLocked()
+
+	quota, ok := self.quotas[quotaId]
+	if !ok {
+		return nil
+	}
+
+	periodStart := quota.GetPeriodStart(time.Now())
+	var result []*db.QuotaUsage
+	for _, usage := range quota.usage {
+		if usage.PeriodStart.Equal(periodStart) {
+			current := usage.QuotaUsage
+			result = append(result, &current)
+		}
+	}
+
+	sort.Slice(result, func(i, j int) bool {
+		return result[i].SubjectId < result[j].SubjectId
+	})
+
+	return result
+}
+
+func (self *quotaTracker) getExceededQuota(identityId, serviceId string) *Quota {
+	self.lock.Lock()
+	defer self.lock.Unlock()
+
+	self.ensureLoadedAlreadyLocked()
+
+	now := time.Now()
+	for _, quota := range self.quotas {
+		if quota.HardLimit == 0 || !quota.matches(identityId, serviceId) {
+			continue
+		}
+		usage := quota.usage[quota.GetSubjectId(identityId, serviceId)]
+		if usage != nil && usage.PeriodStart.Equal(quota.GetPeriodStart(now)) && usage.Bytes >= quota.HardLimit {
+			return quota.Quota
+		}
+	}
+	return nil
+}
+
+func (self *quotaTracker) isAffected(quotaId, subjectId, identityId, serviceId string) bool {
+	self.lock.Lock()
+	defer self.lock.Unlock()
+
+	quota, ok := self.quotas[quotaId]
+	return ok && quota.matches(identityId, serviceId) && quota.GetSubjectId(identityId, serviceId) == subjectId
+}
+
+func (self *quotaTracker) flush() {
+	if !self.env.GetCommandDispatcher().IsLeaderOrLeaderless() {
+		return
+	}
+
+	pending := map[string][]*db.QuotaUsage{}
+
+	self.lock.Lock()
+	for quotaId, quota := range self.quotas {
+		for _, usage := range quota.usage {
+			if usage.changed {
+				usage.changed = false
+				current := usage.QuotaUsage
+				pending[quotaId] = append(pending[quotaId], &current)
+			}
+		}
+	}
+	self.lock.Unlock()
+
+	for quotaId, usage := range pending {
+		cmd := &UpdateQuotaUsageCmd{
+			manager: self.env.GetManagers().Quota,
+			QuotaId: quotaId,
+			Usage:   usage,
+			Context: change.New().SetSourceType("quota.flush").SetChangeAuthorType(change.AuthorTypeController),
+		}
+
+		if err := self.env.GetCommandDispatcher().Dispatch(cmd); err != nil {
+			pfxlog.Logger().WithError(err).WithField("quotaId", quotaId).Error("failed to persist quota usage")
+			self.markChanged(quotaId, usage)
+		}
+	}
+}
+
+func (self *quotaTracker) markChanged(quotaId string, usage []*db.QuotaUsage) {
+	self.lock.Lock()
+	defer self.lock.Unlock()
+
+	if quota, ok := self.quotas[quotaId]; ok {
+		for _, failed := range usage {
+			if current, ok := quota.usage[failed.SubjectId]; ok && current.PeriodStart.Equal(failed.PeriodStart) {
+				current.changed = true
+			}
+		}
+	}
+}
@@ -0,0 +1,205 @@
+/*
+	Copyright NetFoundry Inc.
+
+	Licensed under the Apache License, Version 2.0 (the "License");
+	you may not use this file except in compliance with the License.
+	You may obtain a copy of the License at
+
+	https://www.apache.org/licenses/LICENSE-2.0
+
+	Unless required by applicable law or agreed to in writing, software
+	distributed under the License is distributed on an "AS IS" BASIS,
+	WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+	See the License for the specific language governing permissions and
+	limitations under the License.
+*/
+
+package model
+
+import (
+	"slices"
+	"sort"
+	"strings"
+
+	"github.com/openziti/storage/ast"
+	"github.com/openziti/storage/boltz"
+	"github.com/openziti/ziti/controller/config"
+	"github.com/openziti/ziti/controller/db"
+	cmap "github.com/orcaman/concurrent-map/v2"
+	"go.etcd.io/bbolt"
+)
+
+// RouterConstraint is the set of routers which circuits for a service may traverse, as resolved from the residency
+// constraints which match the service. A nil RouterConstraint allows all routers
+type RouterConstraint struct {
+	Constraints []string
+	routerIds   map[string]struct{}
+}
+
+// Allows returns true if circuits may traverse the given router
+func (self *RouterConstraint) Allows(routerId string) bool {
+	if self == nil {
+		return true
+	}
+	_, found := self.routerIds[routerId]
+	return found
+}
+
+// AllowsPath returns true if every router on the path is allowed
+func (self *RouterConstraint) AllowsPath(path *Path) bool {
+	if self == nil {
+		return true
+	}
+	for _, router := range path.Nodes {
+		if !self.Allows(router.Id) {
+			return false
+		}
+	}
+	return true
+}
+
+func (self *RouterConstraint) String() string {
+	if self == nil {
+		return "[]"
+	}
+	return "[" + strings.Join(self.Constraints, ", ") + "]"
+}
+
+// ResidencyManager resolves the residency constraints from the controller configuration into the routers each
+// service may use. Resolved constraints are cached per service, and the cache is cleared whenever services or
+// routers change
+type ResidencyManager struct {
+	env   Env
+	cache cmap.ConcurrentMap[string, *RouterConstraint]
+}
+
+func NewResidencyManager(env Env) *ResidencyManager {
+	result := &ResidencyManager{
+		env:   env,
+		cache: cmap.New[*RouterConstraint](),
+	}
+
+	stores := env.GetStores()
+	for _, store := range []boltz.Store{stores.Service, stores.EdgeService, stores.Router, stores.EdgeRouter} {
+		store.AddEntityIdListener(result.clearCache, boltz.EntityCreatedAsync, boltz.EntityUpdatedAsync, boltz.EntityDeletedAsync)
+	}
+
+	return result
+}
+
+func (self *ResidencyManager) IsEnabled() bool {
+	return len(self.env.GetConfig().Residency.Constraints) > 0
+}
+
+func (self *ResidencyManager) clearCache(string) {
+	self.cache.Clear()
+}
+
+// GetRouterConstraint returns the routers which circuits for the given service may traverse, or nil if the service
+// isn't constrained
+func (self *ResidencyManager) GetRouterConstraint(serviceId string) (*RouterConstraint, error) {
+	if !self.IsEnabled() {
+		return nil, nil
+	}
+
+	if result, found := self.cache.Get(serviceId); found {
+		return result, nil
+	}
+
+	var result *RouterConstraint
+	err := self.env.GetDb().View(func(tx *bbolt.Tx) error {
+		var err error
+		result, err = self.resolve(tx, serviceId)
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	self.cache.Set(serviceId, result)
+	return result, nil
+}
+
+func (self *ResidencyManager) resolve(tx *bbolt.Tx, serviceId string) (*RouterConstraint, error) {
+	stores := self.env.GetStores()
+
+	service, found, err := stores.Service.FindById(tx, serviceId)
+	if err != nil {
+		return nil, err
+	}
+	if !found {
+		return nil, boltz.NewNotFoundError(db.EntityTypeServices, "id", serviceId)
+	}
+
+	var serviceAttributes []string
+	if edgeService, found, _ := stores.EdgeService.FindById(tx, serviceId); found {
+		serviceAttributes = edgeService.RoleAttributes
+	}
+
+	var constraints []*config.ResidencyConstraintConfig
+	for _, constraint := range self.env.GetConfig().Residency.Constraints {
+		if residencyRolesMatch(constraint.Services, service.Id, service.Name, serviceAttributes) {
+			constraints = append(constraints, constraint)
+		}
+	}
+
+	if len(constraints) == 0 {
+		return nil, nil
+	}
+
+	result := &RouterConstraint{
+		routerIds: map[string]struct{}{},
+	}
+
+	for _, constraint := range constraints {
+		result.Constraints = append(result.Constraints, constraint.Name)
+	}
+	sort.Strings(result.Constraints)
+
+	for cursor := stores.Router.IterateIds(tx, ast.BoolNodeTrue); cursor.IsValid(); cursor.Next() {
+		routerId := string(cursor.Current())
+		router, found, err := stores.Router.FindById(tx, routerId)
+		if err != nil {
+			return nil, err
+		}
+		if !found {
+			continue
+		}
+
+		var routerAttributes []string
+		if edgeRouter, found, _ := stores.EdgeRouter.FindById(tx, routerId); found {
+			routerAttributes = edgeRouter.RoleAttributes
+		}
+
+		allowed := true
+		for _, constraint := range constraints {
+			if !residencyRolesMatch(constraint.Routers, router.Id, router.Name, routerAttributes) {
+				allowed = false
+				break
+			}
+		}
+
+		if allowed {
+			result.routerIds[routerId] = struct{}{}
+		}
+	}
+
+	return result, nil
+}
+
+func residencyRolesMatch(roles []string, id, name string, attributes []string) bool {
+	for _, role := range roles {
+		if role == db.AllRole {
+			return true
+		}
+		if strings.HasPrefix(role, db.EntityPrefix) {
+			idOrName := strings.TrimPrefix(role, db.EntityPrefix)
+			if idOrName == id || idOrName == name {
+				return true
+			}
+		} else if slices.Contains(attributes, strings.TrimPrefix(role, db.RolePrefix)) {
+			return true
+		}
+	}
+	return false
+}
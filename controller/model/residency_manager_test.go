@@ -0,0 +1,133 @@
+/*
+	Copyright NetFoundry Inc.
+
+	Licensed under the Apache License, Version 2.0 (the "License");
+	you may not use this file except in compliance with the License.
+	You may obtain a copy of the License at
+
+	https://www.apache.org/licenses/LICENSE-2.0
+
+	Unless required by applicable law or agreed to in writing, software
+	distributed under the License is distributed on an "AS IS" BASIS,
+	WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+	See the License for the specific language governing permissions and
+	limitations under the License.
+*/
+
+package model
+
+import (
+	"testing"
+	"time"
+
+	"github.com/openziti/foundation/v2/stringz"
+	"github.com/openziti/ziti/common/eid"
+	"github.com/openziti/ziti/controller/change"
+	"github.com/openziti/ziti/controller/config"
+	"github.com/openziti/ziti/controller/fields"
+)
+
+func TestResidencyConstraints(t *testing.T) {
+	ctx := NewTestContext(t)
+	defer ctx.Cleanup()
+	ctx.Init()
+
+	manager := ctx.managers.Residency
+
+	euRouter := ctx.requireNewEdgeRouter()
+	euRouter.RoleAttributes = []string{"eu"}
+	ctx.NoError(ctx.managers.EdgeRouter.Update(euRouter, false, fields.UpdatedFieldsMap{"roleAttributes": struct{}{}}, change.New()))
+
+	euCoreRouter := ctx.requireNewEdgeRouter()
+	euCoreRouter.RoleAttributes = []string{"eu", "core"}
+	ctx.NoError(ctx.managers.EdgeRouter.Update(euCoreRouter, false, fields.UpdatedFieldsMap{"roleAttributes": struct{}{}}, change.New()))
+
+	usRouter := ctx.requireNewEdgeRouter()
+
+	transitRouter := &Router{Name: eid.New()}
+	ctx.NoError(ctx.managers.Router.Create(transitRouter, change.New()))
+
+	euService := ctx.requireNewService()
+	euService.RoleAttributes = []string{"eu-data"}
+	ctx.NoError(ctx.managers.EdgeService.Update(euService, fields.UpdatedFieldsMap{"roleAttributes": struct{}{}}, change.New()))
+
+	namedService := ctx.requireNewService()
+	otherService := ctx.requireNewService()
+
+	t.Run("services are unconstrained if no constraints are configured", func(t *testing.T) {
+		constraint, err := manager.GetRouterConstraint(euService.Id)
+		ctx.NoError(err)
+		ctx.Nil(constraint)
+		ctx.True(constraint.Allows(usRouter.Id))
+	})
+
+	ctx.config.Residency = config.ResidencyConfig{
+		Constraints: []*config.ResidencyConstraintConfig{
+			{
+				Name:     "eu-only",
+				Services: []string{"#eu-data", "@" + namedService.Name},
+				Routers:  []string{"#eu", "@" + transitRouter.Name},
+			},
+			{
+				Name:     "core-only",
+				Services: []string{"@" + namedService.Id},
+				Routers:  []string{"#core"},
+			},
+		},
+	}
+
+	t.Run("routers are resolved by role attribute and name", func(t *testing.T) {
+		constraint, err := manager.GetRouterConstraint(euService.Id)
+		ctx.NoError(err)
+		ctx.NotNil(constraint)
+		ctx.Equal([]string{"eu-only"}, constraint.Constraints)
+		ctx.True(constraint.Allows(euRouter.Id))
+		ctx.True(constraint.Allows(euCoreRouter.Id))
+		ctx.True(constraint.Allows(transitRouter.Id))
+		ctx.False(constraint.Allows(usRouter.Id))
+
+		ctx.True(constraint.AllowsPath(&Path{Nodes: []*Router{{BaseEntity: euRouter.BaseEntity}, {BaseEntity: transitRouter.BaseEntity}}}))
+		ctx.False(constraint.AllowsPath(&Path{Nodes: []*Router{{BaseEntity: euRouter.BaseEntity}, {BaseEntity: usRouter.BaseEntity}}}))
+	})
+
+	t.Run("multiple matching constraints are all enforced", func(t *testing.T) {
+		constraint, err := manager.GetRouterConstraint(namedService.Id)
+		ctx.NoError(err)
+		ctx.Equal([]string{"core-only", "eu-only"}, constraint.Constraints)
+		ctx.True(constraint.Allows(euCoreRouter.Id))
+		ctx.False(constraint.Allows(euRouter.Id))
+		ctx.False(constraint.Allows(transitRouter.Id))
+		ctx.False(constraint.Allows(usRouter.Id))
+	})
+
+	t.Run("unmatched services are unconstrained", func(t *testing.T) {
+		constraint, err := manager.GetRouterConstraint(otherService.Id)
+		ctx.NoError(err)
+		ctx.Nil(constraint)
+	})
+
+	t.Run("unknown services are reported", func(t *testing.T) {
+		_, err := manager.GetRouterConstraint(eid.New())
+		ctx.Error(err)
+	})
+
+	t.Run("router changes are picked up", func(t *testing.T) {
+		usRouter.RoleAttributes = []string{"eu"}
+		ctx.NoError(ctx.managers.EdgeRouter.Update(usRouter, false, fields.UpdatedFieldsMap{"roleAttributes": struct{}{}}, change.New()))
+
+		ctx.Eventually(func() bool {
+			constraint, err := manager.GetRouterConstraint(euService.Id)
+			return err == nil && constraint.Allows(usRouter.Id)
+		}, time.Second, 10*time.Millisecond)
+	})
+
+	t.Run("service changes are picked up", func(t *testing.T) {
+		otherService.RoleAttributes = []string{"eu-data", "other"}
+		ctx.NoError(ctx.managers.EdgeService.Update(otherService, fields.UpdatedFieldsMap{"roleAttributes": struct{}{}}, change.New()))
+
+		ctx.Eventually(func() bool {
+			constraint, err := manager.GetRouterConstraint(otherService.Id)
+			return err == nil && constraint != nil && stringz.Contains(constraint.Constraints, "eu-only")
+		}, time.Second, 10*time.Millisecond)
+	})
+}
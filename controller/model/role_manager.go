@@ -0,0 +1,125 @@
+/*
+	(c) Copyright NetFoundry Inc.
+
+	Licensed under the Apache License, Version 2.0 (the "License");
+	you may not use this file except in compliance with the License.
+	You may obtain a copy of the License at
+
+	https://www.apache.org/licenses/LICENSE-2.0
+
+	Unless required by applicable law or agreed to in writing, software
+	distributed under the License is distributed on an "AS IS" BASIS,
+	WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+	See the License for the specific language governing permissions and
+	limitations under the License.
+*/
+
+package model
+
+import (
+	"github.com/openziti/storage/boltz"
+	"github.com/openziti/ziti/common/pb/edge_cmd_pb"
+	"github.com/openziti/ziti/controller/change"
+	"github.com/openziti/ziti/controller/command"
+	"github.com/openziti/ziti/controller/db"
+	"github.com/openziti/ziti/controller/fields"
+	"github.com/openziti/ziti/controller/models"
+	"google.golang.org/protobuf/proto"
+)
+
+func NewRoleManager(env Env) *RoleManager {
+	manager := &RoleManager{
+		baseEntityManager: newBaseEntityManager[*Role, *db.Role](env, env.GetStores().Role),
+	}
+	manager.impl = manager
+
+	RegisterManagerDecoder[*Role](env, manager)
+
+	return manager
+}
+
+type RoleManager struct {
+	baseEntityManager[*Role, *db.Role]
+}
+
+func (self *RoleManager) NewModelEntity() *Role {
+	return &Role{}
+}
+
+func (self *RoleManager) Create(entity *Role, ctx *change.Context) error {
+	return DispatchCreate[*Role](self, entity, ctx)
+}
+
+func (self *RoleManager) ApplyCreate(cmd *command.CreateEntityCommand[*Role], ctx boltz.MutateContext) error {
+	_, err := self.createEntity(cmd.Entity, ctx)
+	return err
+}
+
+func (self *RoleManager) Update(entity *Role, checker fields.UpdatedFields, ctx *change.Context) error {
+	return DispatchUpdate[*Role](self, entity, checker, ctx)
+}
+
+func (self *RoleManager) ApplyUpdate(cmd *command.UpdateEntityCommand[*Role], ctx boltz.MutateContext) error {
+	return self.updateEntity(cmd.Entity, cmd.UpdatedFields, ctx)
+}
+
+func (self *RoleManager) ReadByName(name string) (*Role, error) {
+	modelEntity := &Role{}
+	nameIndex := self.env.GetStores().Role.GetNameIndex()
+	if err := self.readEntityWithIndex("name", []byte(name), nameIndex, modelEntity); err != nil {
+		return nil, err
+	}
+	return modelEntity, nil
+}
+
+// GetGrantsForIdentity returns the grants given to the identity by all roles matching it
+func (self *RoleManager) GetGrantsForIdentity(identity *Identity) ([]string, error) {
+	result, err := self.BaseList("true limit none")
+	if err != nil {
+		return nil, err
+	}
+
+	var grants []string
+	for _, role := range result.GetEntities() {
+		if role.Matches(identity) {
+			grants = append(grants, role.GetGrants()...)
+		}
+	}
+	return grants, nil
+}
+
+func (self *RoleManager) Marshall(entity *Role) ([]byte, error) {
+	tags, err := edge_cmd_pb.EncodeTags(entity.Tags)
+	if err != nil {
+		return nil, err
+	}
+
+	msg := &edge_cmd_pb.Role{
+		Id:            entity.Id,
+		Name:          entity.Name,
+		Tags:          tags,
+		IdentityRoles: entity.IdentityRoles,
+		Permissions:   entity.Permissions,
+		ScopeRoles:    entity.ScopeRoles,
+	}
+
+	return proto.Marshal(msg)
+}
+
+func (self *RoleManager) Unmarshall(bytes []byte) (*Role, error) {
+	msg := &edge_cmd_pb.Role{}
+	if err := proto.Unmarshal(bytes, msg); err != nil {
+		return nil, err
+	}
+
+	return &Role{
+		BaseEntity: models.BaseEntity{
+			Id:   msg.Id,
+			Tags: edge_cmd_pb.DecodeTags(msg.Tags),
+		},
+		Name:          msg.Name,
+		IdentityRoles: msg.IdentityRoles,
+		Permissions:   msg.Permissions,
+		ScopeRoles:    msg.ScopeRoles,
+	}, nil
+}
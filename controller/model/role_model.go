@@ -0,0 +1,145 @@
+/*
+	(c) Copyright NetFoundry Inc.
+
+	Licensed under the Apache License, Version 2.0 (the "License");
+	you may not use this file except in compliance with the License.
+	You may obtain a copy of the License at
+
+	https://www.apache.org/licenses/LICENSE-2.0
+
+	Unless required by applicable law or agreed to in writing, software
+	distributed under the License is distributed on an "AS IS" BASIS,
+	WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+	See the License for the specific language governing permissions and
+	limitations under the License.
+*/
+
+package model
+
+import (
+	"fmt"
+	"github.com/openziti/foundation/v2/errorz"
+	"github.com/openziti/storage/boltz"
+	"github.com/openziti/ziti/controller/db"
+	"github.com/openziti/ziti/controller/internal/permissions"
+	"github.com/openziti/ziti/controller/models"
+	"go.etcd.io/bbolt"
+	"strings"
+)
+
+type Role struct {
+	models.BaseEntity
+	Name          string
+	IdentityRoles []string
+	Permissions   []string
+	ScopeRoles    []string
+}
+
+func (entity *Role) validate() error {
+	for _, identityRole := range entity.IdentityRoles {
+		if !strings.HasPrefix(identityRole, "@") && !strings.HasPrefix(identityRole, "#") {
+			return errorz.NewFieldError("identity roles must be of the form @<identity id>, #<role attribute> or #all", db.FieldIdentityRoles, identityRole)
+		}
+	}
+
+	if len(entity.Permissions) == 0 {
+		return errorz.NewFieldError("at least one permission is required", db.FieldRolePermissions, entity.Permissions)
+	}
+
+	for _, permission := range entity.Permissions {
+		if err := permissions.ValidatePermission(permission); err != nil {
+			return errorz.NewFieldError(err.Error(), db.FieldRolePermissions, permission)
+		}
+	}
+
+	for _, scopeRole := range entity.ScopeRoles {
+		if !strings.HasPrefix(scopeRole, "#") || len(scopeRole) == 1 || scopeRole == "#all" {
+			return errorz.NewFieldError("scope roles must be of the form #<role attribute>", db.FieldRoleScopeRoles, scopeRole)
+		}
+	}
+
+	if len(entity.ScopeRoles) > 0 {
+		for _, permission := range entity.Permissions {
+			grant, _ := permissions.ParseGrant(permission)
+			if !permissions.IsScopable(grant.EntityType) {
+				msg := fmt.Sprintf("%s entities don't have role attributes, so permissions on them can't be scoped", grant.EntityType)
+				return errorz.NewFieldError(msg, db.FieldRolePermissions, permission)
+			}
+		}
+	}
+
+	return nil
+}
+
+// Matches returns true if the role applies to the given identity
+func (entity *Role) Matches(identity *Identity) bool {
+	for _, identityRole := range entity.IdentityRoles {
+		if identityRole == "#all" || identityRole == "@"+identity.Id {
+			return true
+		}
+		if strings.HasPrefix(identityRole, "#") {
+			for _, attr := range identity.RoleAttributes {
+				if identityRole[1:] == attr {
+					return true
+				}
+			}
+		}
+	}
+	return false
+}
+
+// GetGrants returns the role's permissions in the form carried by a request's active permissions
+func (entity *Role) GetGrants() []string {
+	return permissions.ScopedGrants(entity.Permissions, entity.ScopeRoles)
+}
+
+func (entity *Role) toBoltEntity() (*db.Role, error) {
+	if err := entity.validate(); err != nil {
+		return nil, err
+	}
+
+	return &db.Role{
+		BaseExtEntity: *boltz.NewExtEntity(entity.Id, entity.Tags),
+		Name:          entity.Name,
+		IdentityRoles: entity.IdentityRoles,
+		Permissions:   entity.Permissions,
+		ScopeRoles:    entity.ScopeRoles,
+	}, nil
+}
+
+func (entity *Role) toBoltEntityForCreate(*bbolt.Tx, Env) (*db.Role, error) {
+	return entity.toBoltEntity()
+}
+
+func (entity *Role) toBoltEntityForUpdate(tx *bbolt.Tx, env Env, checker boltz.FieldChecker) (*db.Role, error) {
+	if checker == nil {
+		return entity.toBoltEntity()
+	}
+
+	// patches only carry the updated fields, so fill in the rest before validating
+	existing, err := env.GetStores().Role.LoadById(tx, entity.Id)
+	if err != nil {
+		return nil, err
+	}
+
+	merged := *entity
+	if !checker.IsUpdated(db.FieldIdentityRoles) {
+		merged.IdentityRoles = existing.IdentityRoles
+	}
+	if !checker.IsUpdated(db.FieldRolePermissions) {
+		merged.Permissions = existing.Permissions
+	}
+	if !checker.IsUpdated(db.FieldRoleScopeRoles) {
+		merged.ScopeRoles = existing.ScopeRoles
+	}
+	return merged.toBoltEntity()
+}
+
+func (entity *Role) fillFrom(_ Env, _ *bbolt.Tx, boltRole *db.Role) error {
+	entity.FillCommon(boltRole)
+	entity.Name = boltRole.Name
+	entity.IdentityRoles = boltRole.IdentityRoles
+	entity.Permissions = boltRole.Permissions
+	entity.ScopeRoles = boltRole.ScopeRoles
+	return nil
+}
@@ -0,0 +1,94 @@
+/*
+	(c) Copyright NetFoundry Inc.
+
+	Licensed under the Apache License, Version 2.0 (the "License");
+	you may not use this file except in compliance with the License.
+	You may obtain a copy of the License at
+
+	https://www.apache.org/licenses/LICENSE-2.0
+
+	Unless required by applicable law or agreed to in writing, software
+	distributed under the License is distributed on an "AS IS" BASIS,
+	WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+	See the License for the specific language governing permissions and
+	limitations under the License.
+*/
+
+package model
+
+import (
+	"testing"
+
+	"github.com/openziti/ziti/controller/change"
+	"github.com/openziti/ziti/controller/db"
+	"github.com/openziti/ziti/controller/fields"
+	"github.com/openziti/ziti/controller/models"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRole(t *testing.T) {
+	t.Run("validation", func(t *testing.T) {
+		req := require.New(t)
+
+		role := &Role{Name: "payments", IdentityRoles: []string{"#payments-admins"}, Permissions: []string{"services.*", "configs.read"}}
+		req.NoError(role.validate())
+
+		role.ScopeRoles = []string{"#team-payments"}
+		req.Error(role.validate(), "configs don't have role attributes")
+
+		role.Permissions = []string{"services.*", "identities.read"}
+		req.NoError(role.validate())
+
+		role.ScopeRoles = []string{"team-payments"}
+		req.Error(role.validate())
+
+		role.ScopeRoles = nil
+		role.IdentityRoles = []string{"payments-admins"}
+		req.Error(role.validate())
+
+		role.IdentityRoles = nil
+		role.Permissions = nil
+		req.Error(role.validate())
+	})
+
+	t.Run("matching", func(t *testing.T) {
+		req := require.New(t)
+
+		identity := &Identity{BaseEntity: models.BaseEntity{Id: "abc"}, RoleAttributes: []string{"payments-admins"}}
+
+		req.True((&Role{IdentityRoles: []string{"#payments-admins"}}).Matches(identity))
+		req.True((&Role{IdentityRoles: []string{"@abc"}}).Matches(identity))
+		req.True((&Role{IdentityRoles: []string{"#all"}}).Matches(identity))
+		req.False((&Role{IdentityRoles: []string{"#billing-admins", "@def"}}).Matches(identity))
+	})
+
+	t.Run("grants", func(t *testing.T) {
+		req := require.New(t)
+		role := &Role{Permissions: []string{"services.update"}, ScopeRoles: []string{"#team-payments"}}
+		req.Equal([]string{"services.update#team-payments"}, role.GetGrants())
+	})
+
+	t.Run("patch", func(t *testing.T) {
+		ctx := NewTestContext(t)
+		defer ctx.Cleanup()
+		ctx.Init()
+
+		role := &Role{Name: "payments", IdentityRoles: []string{"#payments-admins"}, Permissions: []string{"services.*"}}
+		ctx.NoError(ctx.managers.Role.Create(role, change.New()))
+
+		patch := &Role{BaseEntity: models.BaseEntity{Id: role.Id}, Name: "payments-team"}
+		ctx.NoError(ctx.managers.Role.Update(patch, fields.UpdatedFieldsMap{db.FieldName: struct{}{}}, change.New()))
+
+		updated, err := ctx.managers.Role.Read(role.Id)
+		ctx.NoError(err)
+		ctx.Equal("payments-team", updated.Name)
+		ctx.Equal([]string{"services.*"}, updated.Permissions)
+
+		patch = &Role{BaseEntity: models.BaseEntity{Id: role.Id}, ScopeRoles: []string{"#team-payments"}}
+		ctx.NoError(ctx.managers.Role.Update(patch, fields.UpdatedFieldsMap{db.FieldRoleScopeRoles: struct{}{}}, change.New()))
+
+		patch = &Role{BaseEntity: models.BaseEntity{Id: role.Id}, Permissions: []string{"configs.read"}}
+		err = ctx.managers.Role.Update(patch, fields.UpdatedFieldsMap{db.FieldRolePermissions: struct{}{}}, change.New())
+		ctx.Error(err, "patched permissions must be validated against the existing scope roles")
+	})
+}
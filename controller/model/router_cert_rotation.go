@@ -0,0 +1,391 @@
+/*
+	Copyright NetFoundry Inc.
+
+	Licensed under the Apache License, Version 2.0 (the "License");
+	you may not use this file except in compliance with the License.
+	You may obtain a copy of the License at
+
+	https://www.apache.org/licenses/LICENSE-2.0
+
+	Unless required by applicable law or agreed to in writing, software
+	distributed under the License is distributed on an "AS IS" BASIS,
+	WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+	See the License for the specific language governing permissions and
+	limitations under the License.
+*/
+
+package model
+
+import (
+	"fmt"
+	"slices"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/michaelquigley/pfxlog"
+	"github.com/openziti/channel/v4/protobufs"
+	"github.com/openziti/foundation/v2/errorz"
+	"github.com/openziti/storage/boltz"
+	"github.com/openziti/ziti/common/eid"
+	"github.com/openziti/ziti/common/pb/edge_ctrl_pb"
+	"github.com/openziti/ziti/controller/db"
+	"github.com/pkg/errors"
+	"go.etcd.io/bbolt"
+)
+
+const (
+	RouterCertRotationPending   = "pending"
+	RouterCertRotationRequested = "requested"
+	RouterCertRotationCompleted = "completed"
+	RouterCertRotationFailed    = "failed"
+
+	DefaultRouterCertRotationBatchSize = 10
+	MaxRouterCertRotationBatchSize     = 100
+	DefaultRouterCertRotationTimeout   = 2 * time.Minute
+	MinRouterCertRotationTimeout       = 10 * time.Second
+
+	// maxRetainedRouterCertRotations is the number of rotation campaigns whose status is kept in memory. Once
+	// exceeded, the oldest completed campaigns are discarded
+	maxRetainedRouterCertRotations = 20
+)
+
+// RouterCertRotationSpec describes which routers a certificate rotation campaign applies to and how it proceeds.
+// Routers are selected either by id or with a filter, but not both
+type RouterCertRotationSpec struct {
+	RouterIds []string
+	Filter    string
+	BatchSize int
+	Timeout   time.Duration
+}
+
+// RouterCertRotationStatus reports the progress of a single router in a certificate rotation campaign
+type RouterCertRotationStatus struct {
+	RouterId    string
+	RouterName  string
+	State       string
+	Error       string
+	RequestedAt *time.Time
+	CompletedAt *time.Time
+}
+
+// RouterCertRotation is a certificate rotation campaign, which asks routers to renew their certificates a batch
+// at a time. A router has rotated its certificates once its fingerprint changes. Instances returned by the
+// RouterCertRotationManager are copies and won't be updated as the campaign progresses
+type RouterCertRotation struct {
+	Id          string
+	BatchSize   int
+	Timeout     time.Duration
+	Routers     []*RouterCertRotationStatus
+	StartedAt   time.Time
+	CompletedAt *time.Time
+}
+
+func (self *RouterCertRotation) IsComplete() bool {
+	return self.CompletedAt != nil
+}
+
+// CountByState returns the number of routers in the given state
+func (self *RouterCertRotation) CountByState(state string) int {
+	count := 0
+	for _, status := range self.Routers {
+		if status.State == state {
+			count++
+		}
+	}
+	return count
+}
+
+func (self *RouterCertRotation) copy() *RouterCertRotation {
+	result := *self
+	result.Routers = make([]*RouterCertRotationStatus, 0, len(self.Routers))
+	for _, status := range self.Routers {
+		statusCopy := *status
+		result.Routers = append(result.Routers, &statusCopy)
+	}
+	return &result
+}
+
+// RouterCertRotationManager runs router certificate rotation campaigns, such as after an intermediate CA has been
+// rotated. Campaigns run on the controller which accepted the request and only routers connected to that controller
+// can be rotated. Campaign status is kept in memory.
+type RouterCertRotationManager struct {
+	env          Env
+	lock         sync.Mutex
+	rotations    map[string]*RouterCertRotation
+	pollInterval time.Duration
+}
+
+func NewRouterCertRotationManager(env Env) *RouterCertRotationManager {
+	return &RouterCertRotationManager{
+		env:          env,
+		rotations:    map[string]*RouterCertRotation{},
+		pollInterval: time.Second,
+	}
+}
+
+// Start resolves the routers the campaign applies to and starts the campaign in the background. Routers which don't
+// exist are recorded as failed.
+func (self *RouterCertRotationManager) Start(spec *RouterCertRotationSpec) (*RouterCertRotation, error) {
+	if err := self.validate(spec); err != nil {
+		return nil, err
+	}
+
+	rotation := &RouterCertRotation{
+		Id:        eid.New(),
+		BatchSize: spec.BatchSize,
+		Timeout:   spec.Timeout,
+		StartedAt: time.Now(),
+	}
+
+	if rotation.BatchSize == 0 {
+		rotation.BatchSize = DefaultRouterCertRotationBatchSize
+	}
+
+	if rotation.Timeout == 0 {
+		rotation.Timeout = DefaultRouterCertRotationTimeout
+	}
+
+	err := self.env.GetDb().View(func(tx *bbolt.Tx) error {
+		store := self.env.GetStores().Router
+		routerIds := spec.RouterIds
+		if spec.Filter != "" {
+			var err error
+			if routerIds, _, err = store.QueryIds(tx, spec.Filter); err != nil {
+				return err
+			}
+		}
+
+		for _, routerId := range routerIds {
+			if slices.ContainsFunc(rotation.Routers, func(status *RouterCertRotationStatus) bool {
+				return status.RouterId == routerId
+			}) {
+				continue
+			}
+
+			status := &RouterCertRotationStatus{
+				RouterId: routerId,
+				State:    RouterCertRotationPending,
+			}
+
+			if router, _ := store.LoadById(tx, routerId); router != nil {
+				status.RouterName = router.Name
+			} else {
+				status.State = RouterCertRotationFailed
+				status.Error = boltz.NewNotFoundError(db.EntityTypeRouters, "id", routerId).Error()
+			}
+
+			rotation.Routers = append(rotation.Routers, status)
+		}
+		return nil
+	})
+
+	if err != nil {
+		return nil, err
+	}
+
+	self.add(rotation)
+	result, err := self.Get(rotation.Id)
+	if err != nil {
+		return nil, err
+	}
+
+	go self.run(rotation)
+
+	return result, nil
+}
+
+func (self *RouterCertRotationManager) validate(spec *RouterCertRotationSpec) error {
+	if (len(spec.RouterIds) == 0) == (spec.Filter == "") {
+		return errorz.NewFieldError("exactly one of routerIds or filter must be provided", "routerIds", spec.RouterIds)
+	}
+
+	if spec.BatchSize < 0 || spec.BatchSize > MaxRouterCertRotationBatchSize {
+		return errorz.NewFieldError(fmt.Sprintf("batch size must be between 1 and %d", MaxRouterCertRotationBatchSize),
+			"batchSize", spec.BatchSize)
+	}
+
+	if spec.Timeout != 0 && spec.Timeout < MinRouterCertRotationTimeout {
+		return errorz.NewFieldError(fmt.Sprintf("timeout must be at least %v", MinRouterCertRotationTimeout),
+			"timeout", spec.Timeout)
+	}
+
+	return nil
+}
+
+func (self *RouterCertRotationManager) Get(id string) (*RouterCertRotation, error) {
+	self.lock.Lock()
+	defer self.lock.Unlock()
+
+	if rotation, ok := self.rotations[id]; ok {
+		return rotation.copy(), nil
+	}
+	return nil, boltz.NewNotFoundError("router-cert-rotation", "id", id)
+}
+
+// List returns the known campaigns, most recent first
+func (self *RouterCertRotationManager) List() []*RouterCertRotation {
+	self.lock.Lock()
+	defer self.lock.Unlock()
+
+	var result []*RouterCertRotation
+	for _, rotation := range self.rotations {
+		result = append(result, rotation.copy())
+	}
+	sort.Slice(result, func(i, j int) bool {
+		return result[i].StartedAt.After(result[j].StartedAt)
+	})
+	return result
+}
+
+func (self *RouterCertRotationManager) add(rotation *RouterCertRotation) {
+	self.lock.Lock()
+	defer self.lock.Unlock()
+
+	self.rotations[rotation.Id] = rotation
+
+	if len(self.rotations) > maxRetainedRouterCertRotations {
+		var completed []*RouterCertRotation
+		for _, v := range self.rotations {
+			if v.IsComplete() {
+				completed = append(completed, v)
+			}
+		}
+		sort.Slice(completed, func(i, j int) bool {
+			return completed[i].StartedAt.Before(completed[j].StartedAt)
+		})
+		for i := 0; i < len(completed) && len(self.rotations) > maxRetainedRouterCertRotations; i++ {
+			delete(self.rotations, completed[i].Id)
+		}
+	}
+}
+
+func (self *RouterCertRotationManager) setState(status *RouterCertRotationStatus, state string, err error) {
+	self.lock.Lock()
+	defer self.lock.Unlock()
+
+	now := time.Now()
+	status.State = state
+	switch state {
+	case RouterCertRotationRequested:
+		status.RequestedAt = &now
+	case RouterCertRotationCompleted:
+		status.CompletedAt = &now
+	case RouterCertRotationFailed:
+		status.CompletedAt = &now
+		status.Error = err.Error()
+	}
+}
+
+func (self *RouterCertRotationManager) run(rotation *RouterCertRotation) {
+	log := pfxlog.Logger().WithField("rotationId", rotation.Id)
+	log.WithField("routers", len(rotation.Routers)).Info("starting router certificate rotation")
+
+	var pending []*RouterCertRotationStatus
+	for _, status := range rotation.Routers {
+		if status.State == RouterCertRotationPending {
+			pending = append(pending, status)
+		}
+	}
+
+	for len(pending) > 0 {
+		batch := pending[:min(rotation.BatchSize, len(pending))]
+		pending = pending[len(batch):]
+
+		if !self.rotateBatch(rotation, batch) {
+			for _, status := range pending {
+				self.setState(status, RouterCertRotationFailed, errors.New("controller shutting down"))
+			}
+			break
+		}
+	}
+
+	self.lock.Lock()
+	now := time.Now()
+	rotation.CompletedAt = &now
+	completed := rotation.CountByState(RouterCertRotationCompleted)
+	failed := rotation.CountByState(RouterCertRotationFailed)
+	self.lock.Unlock()
+
+	log.WithField("completed", completed).WithField("failed", failed).Info("router certificate rotation complete")
+}
+
+// rotateBatch asks each router in the batch to renew its certificates, then waits for the routers to do so. Returns
+// false if the controller is shutting down
+func (self *RouterCertRotationManager) rotateBatch(rotation *RouterCertRotation, batch []*RouterCertRotationStatus) bool {
+	fingerprints := map[string]string{}
+	var requested []*RouterCertRotationStatus
+
+	for _, status := range batch {
+		fingerprint, err := self.requestRenewal(rotation, status.RouterId)
+		if err != nil {
+			self.setState(status, RouterCertRotationFailed, err)
+			continue
+		}
+		fingerprints[status.RouterId] = fingerprint
+		self.setState(status, RouterCertRotationRequested, nil)
+		requested = append(requested, status)
+	}
+
+	ticker := time.NewTicker(self.pollInterval)
+	defer ticker.Stop()
+
+	deadline := time.After(rotation.Timeout)
+
+	for len(requested) > 0 {
+		select {
+		case <-ticker.C:
+			requested = slices.DeleteFunc(requested, func(status *RouterCertRotationStatus) bool {
+				router, err := self.env.GetManagers().Router.readUncached(status.RouterId)
+				if err != nil {
+					self.setState(status, RouterCertRotationFailed, err)
+					return true
+				}
+				if router.Fingerprint != nil && *router.Fingerprint != fingerprints[status.RouterId] {
+					self.setState(status, RouterCertRotationCompleted, nil)
+					return true
+				}
+				return false
+			})
+		case <-deadline:
+			for _, status := range requested {
+				self.setState(status, RouterCertRotationFailed, errors.Errorf("router did not renew its certificates within %v", rotation.Timeout))
+			}
+			return true
+		case <-self.env.GetCloseNotifyChannel():
+			for _, status := range requested {
+				self.setState(status, RouterCertRotationFailed, errors.New("controller shutting down"))
+			}
+			return false
+		}
+	}
+
+	return true
+}
+
+// requestRenewal sends a renewal request to the given router, returning the router's current fingerprint
+func (self *RouterCertRotationManager) requestRenewal(rotation *RouterCertRotation, routerId string) (string, error) {
+	connected := self.env.GetManagers().Router.GetConnected(routerId)
+	if connected == nil || connected.Control == nil || connected.Control.IsClosed() {
+		return "", errors.New("router is not connected to this controller")
+	}
+
+	router, err := self.env.GetManagers().Router.readUncached(routerId)
+	if err != nil {
+		return "", err
+	}
+
+	if router.Fingerprint == nil {
+		return "", errors.New("router has no certificate fingerprint, it may not be enrolled")
+	}
+
+	trigger := &edge_ctrl_pb.EnrollmentExtendRouterTrigger{
+		CampaignId: rotation.Id,
+	}
+
+	if err = protobufs.MarshalTyped(trigger).WithTimeout(10 * time.Second).SendAndWaitForWire(connected.Control); err != nil {
+		return "", errors.Wrap(err, "unable to send renewal request to router")
+	}
+
+	return *router.Fingerprint, nil
+}
@@ -0,0 +1,97 @@
+/*
+	Copyright NetFoundry Inc.
+
+	Licensed under the Apache License, Version 2.0 (the "License");
+	you may not use this file except in compliance with the License.
+	You may obtain a copy of the License at
+
+	https://www.apache.org/licenses/LICENSE-2.0
+
+	Unless required by applicable law or agreed to in writing, software
+	distributed under the License is distributed on an "AS IS" BASIS,
+	WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+	See the License for the specific language governing permissions and
+	limitations under the License.
+*/
+
+package model
+
+import (
+	"testing"
+	"time"
+
+	"github.com/openziti/foundation/v2/errorz"
+	"github.com/openziti/ziti/common/eid"
+	"github.com/openziti/ziti/controller/change"
+	"github.com/openziti/ziti/controller/models"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRouterCertRotation(t *testing.T) {
+	ctx := NewTestContext(t)
+	defer ctx.Cleanup()
+	ctx.Init()
+
+	manager := ctx.managers.RouterCertRotation
+
+	requireComplete := func(rotation *RouterCertRotation, err error) *RouterCertRotation {
+		ctx.NoError(err)
+		var result *RouterCertRotation
+		require.Eventually(t, func() bool {
+			result, err = manager.Get(rotation.Id)
+			ctx.NoError(err)
+			return result.IsComplete()
+		}, 10*time.Second, 10*time.Millisecond)
+		return result
+	}
+
+	t.Run("invalid campaigns are rejected", func(t *testing.T) {
+		var fieldErr *errorz.FieldError
+
+		_, err := manager.Start(&RouterCertRotationSpec{})
+		ctx.ErrorAs(err, &fieldErr)
+
+		_, err = manager.Start(&RouterCertRotationSpec{RouterIds: ss(eid.New()), Filter: "true"})
+		ctx.ErrorAs(err, &fieldErr)
+
+		_, err = manager.Start(&RouterCertRotationSpec{RouterIds: ss(eid.New()), BatchSize: MaxRouterCertRotationBatchSize + 1})
+		ctx.ErrorAs(err, &fieldErr)
+
+		_, err = manager.Start(&RouterCertRotationSpec{RouterIds: ss(eid.New()), Timeout: time.Second})
+		ctx.ErrorAs(err, &fieldErr)
+	})
+
+	t.Run("missing and disconnected routers fail", func(t *testing.T) {
+		fingerprint := eid.New()
+		router := &Router{
+			BaseEntity:  models.BaseEntity{Id: eid.New()},
+			Name:        eid.New(),
+			Fingerprint: &fingerprint,
+		}
+		ctx.NoError(ctx.managers.Router.Create(router, change.New()))
+
+		missingId := eid.New()
+		rotation := requireComplete(manager.Start(&RouterCertRotationSpec{
+			RouterIds: ss(router.Id, missingId, router.Id),
+		}))
+
+		ctx.Equal(DefaultRouterCertRotationBatchSize, rotation.BatchSize)
+		ctx.Equal(DefaultRouterCertRotationTimeout, rotation.Timeout)
+		ctx.Len(rotation.Routers, 2)
+		ctx.Equal(2, rotation.CountByState(RouterCertRotationFailed))
+
+		ctx.Equal(router.Id, rotation.Routers[0].RouterId)
+		ctx.Equal(router.Name, rotation.Routers[0].RouterName)
+		ctx.Contains(rotation.Routers[0].Error, "not connected")
+
+		ctx.Equal(missingId, rotation.Routers[1].RouterId)
+		ctx.NotEmpty(rotation.Routers[1].Error)
+
+		_, err := manager.Get(eid.New())
+		ctx.Error(err)
+
+		list := manager.List()
+		ctx.NotEmpty(list)
+		ctx.Equal(rotation.Id, list[0].Id)
+	})
+}
@@ -197,6 +197,10 @@ func (self *SessionManager) CreateJwt(entity *Session, isLegacy bool) (string, e
 		return "", apierror.NewNoEdgeRoutersAvailable()
 	}
 
+	if quota := self.env.GetManagers().Quota.GetExceededQuota(entity.IdentityId, entity.ServiceId); quota != nil {
+		return "", apierror.NewQuotaExceededError(quota.Name)
+	}
+
 	claims := common.ServiceAccessClaims{
 		RegisteredClaims: jwt.RegisteredClaims{
 			Issuer:    self.env.RootIssuer(),
@@ -288,6 +292,10 @@ func (self *SessionManager) Create(entity *Session, ctx *change.Context) (string
 		return "", apierror.NewNoEdgeRoutersAvailable()
 	}
 
+	if quota := self.env.GetManagers().Quota.GetExceededQuota(apiSession.IdentityId, entity.ServiceId); quota != nil {
+		return "", apierror.NewQuotaExceededError(quota.Name)
+	}
+
 	entity.ServicePolicies = policyResult.PassingPolicyIds
 
 	return self.createSessionEntity(entity, ctx)
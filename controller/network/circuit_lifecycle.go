@@ -92,6 +92,8 @@ const (
 	CircuitFailureRouterErrMisconfiguredTerminator CircuitFailureCause = "ROUTER_ERR_MISCONFIGURED_TERMINATOR"
 	CircuitFailureRouterErrDialTimedOut            CircuitFailureCause = "ROUTER_ERR_DIAL_TIMED_OUT"
 	CircuitFailureRouterErrDialConnRefused         CircuitFailureCause = "ROUTER_ERR_CONN_REFUSED"
+	CircuitFailureQuotaExceeded                    CircuitFailureCause = "QUOTA_EXCEEDED"
+	CircuitFailureResidencyConstraint              CircuitFailureCause = "RESIDENCY_CONSTRAINT"
 )
 
 type CircuitError interface {
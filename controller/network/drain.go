@@ -0,0 +1,358 @@
+/*
+	Copyright NetFoundry Inc.
+
+	Licensed under the Apache License, Version 2.0 (the "License");
+	you may not use this file except in compliance with the License.
+	You may obtain a copy of the License at
+
+	https://www.apache.org/licenses/LICENSE-2.0
+
+	Unless required by applicable law or agreed to in writing, software
+	distributed under the License is distributed on an "AS IS" BASIS,
+	WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+	See the License for the specific language governing permissions and
+	limitations under the License.
+*/
+
+package network
+
+import (
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/michaelquigley/pfxlog"
+	"github.com/openziti/foundation/v2/errorz"
+	"github.com/openziti/storage/boltz"
+	"github.com/openziti/ziti/controller/change"
+	"github.com/openziti/ziti/controller/config"
+	"github.com/openziti/ziti/controller/model"
+	"github.com/pkg/errors"
+)
+
+const (
+	RouterDrainRerouting   = "rerouting"
+	RouterDrainWaitingEdge = "waiting-for-edge-circuits"
+	RouterDrainDrained     = "drained"
+
+	RouterDrainStragglerTransit = "transit"
+	RouterDrainStragglerEdge    = "edge"
+
+	MaxRouterDrainEdgeCircuitTimeout = 24 * time.Hour
+
+	routerDrainRerouteAttempts = 2
+	routerDrainMaxPasses       = 3
+)
+
+type RouterDrainSpec struct {
+	RouterId           string
+	Quiesce            bool
+	EdgeCircuitTimeout time.Duration
+}
+
+// RouterDrainStraggler is a circuit which the drain couldn't move off the router
+type RouterDrainStraggler struct {
+	CircuitId string
+	Kind      string
+	Error     string
+}
+
+// RouterDrain reports the progress of draining a router. Transit circuits are circuits which pass through the router.
+// Edge circuits start or end at the router, so can't be rerouted
+type RouterDrain struct {
+	RouterId           string
+	RouterName         string
+	State              string
+	Quiesce            bool
+	EdgeCircuitTimeout time.Duration
+	StartedAt          time.Time
+	CompletedAt        *time.Time
+	TransitCircuits    int
+	TransitRerouted    int
+	TransitEnded       int
+	EdgeCircuits       int
+	Stragglers         []*RouterDrainStraggler
+}
+
+func (self *RouterDrain) copy() *RouterDrain {
+	result := *self
+	result.Stragglers = append([]*RouterDrainStraggler(nil), self.Stragglers...)
+	return &result
+}
+
+// RouterDrainManager drains routers before maintenance. A router being drained isn't used as a transit router when
+// paths are calculated, and the circuits which pass through it are rerouted. The drain can optionally quiesce the
+// router, so its terminators aren't used for new circuits, and wait for the circuits which start or end at the router
+// to finish. A drained router isn't used for transit until the drain is cancelled. Drains are tracked in memory by the
+// controller which started them.
+type RouterDrainManager struct {
+	network      *Network
+	lock         sync.Mutex
+	drains       map[string]*RouterDrain
+	reroute      func(circuit *model.Circuit, deadline time.Time) error
+	pollInterval time.Duration
+}
+
+func NewRouterDrainManager(network *Network) *RouterDrainManager {
+	return &RouterDrainManager{
+		network:      network,
+		drains:       map[string]*RouterDrain{},
+		reroute:      network.rerouteCircuit,
+		pollInterval: time.Second,
+	}
+}
+
+// IsDraining returns true if the router is being drained, or has been drained and the drain hasn't been cancelled
+func (self *RouterDrainManager) IsDraining(routerId string) bool {
+	self.lock.Lock()
+	defer self.lock.Unlock()
+	_, found := self.drains[routerId]
+	return found
+}
+
+// Start begins draining the given router. A router can't be drained while a drain of it is in progress, but a
+// drained router may be drained again
+func (self *RouterDrainManager) Start(spec *RouterDrainSpec, ctx *change.Context) (*RouterDrain, error) {
+	if spec.EdgeCircuitTimeout < 0 || spec.EdgeCircuitTimeout > MaxRouterDrainEdgeCircuitTimeout {
+		return nil, errorz.NewFieldError("edge circuit timeout must be between 0 and 24h", "edgeCircuitTimeout", spec.EdgeCircuitTimeout)
+	}
+
+	router := self.network.Router.GetConnected(spec.RouterId)
+	if router == nil {
+		if _, err := self.network.Router.Read(spec.RouterId); err != nil {
+			return nil, err
+		}
+		return nil, errorz.NewFieldError("router is not connected", "routerId", spec.RouterId)
+	}
+
+	drain := &RouterDrain{
+		RouterId:           router.Id,
+		RouterName:         router.Name,
+		State:              RouterDrainRerouting,
+		Quiesce:            spec.Quiesce,
+		EdgeCircuitTimeout: spec.EdgeCircuitTimeout,
+		StartedAt:          time.Now(),
+	}
+
+	self.lock.Lock()
+	if current, found := self.drains[router.Id]; found && current.State != RouterDrainDrained {
+		self.lock.Unlock()
+		return nil, errorz.NewFieldError("router is already being drained", "routerId", router.Id)
+	}
+	self.drains[router.Id] = drain
+	self.lock.Unlock()
+
+	if spec.Quiesce {
+		if err := self.network.Router.QuiesceRouter(router, ctx); err != nil {
+			self.lock.Lock()
+			delete(self.drains, router.Id)
+			self.lock.Unlock()
+			return nil, err
+		}
+	}
+
+	pfxlog.Logger().WithField("routerId", router.Id).Info("draining router")
+
+	go self.run(drain)
+
+	return drain.copy(), nil
+}
+
+func (self *RouterDrainManager) Get(routerId string) (*RouterDrain, error) {
+	self.lock.Lock()
+	defer self.lock.Unlock()
+
+	if drain, found := self.drains[routerId]; found {
+		return drain.copy(), nil
+	}
+	return nil, boltz.NewNotFoundError("router-drain", "routerId", routerId)
+}
+
+// List returns the in progress and completed drains, most recently started first
+func (self *RouterDrainManager) List() []*RouterDrain {
+	self.lock.Lock()
+	defer self.lock.Unlock()
+
+	var result []*RouterDrain
+	for _, drain := range self.drains {
+		result = append(result, drain.copy())
+	}
+
+	sort.Slice(result, func(i, j int) bool {
+		return result[i].StartedAt.After(result[j].StartedAt)
+	})
+
+	return result
+}
+
+// Cancel stops draining the router, allowing it to be used for transit again. Cancelling doesn't dequiesce the router
+func (self *RouterDrainManager) Cancel(routerId string) error {
+	self.lock.Lock()
+	defer self.lock.Unlock()
+
+	if _, found := self.drains[routerId]; !found {
+		return boltz.NewNotFoundError("router-drain", "routerId", routerId)
+	}
+
+	delete(self.drains, routerId)
+	pfxlog.Logger().WithField("routerId", routerId).Info("router drain cancelled")
+	return nil
+}
+
+// update applies the given change to the drain, returning false if the drain has been cancelled or replaced
+func (self *RouterDrainManager) update(drain *RouterDrain, f func()) bool {
+	self.lock.Lock()
+	defer self.lock.Unlock()
+
+	if self.drains[drain.RouterId] != drain {
+		return false
+	}
+	f()
+	return true
+}
+
+func (self *RouterDrainManager) run(drain *RouterDrain) {
+	log := pfxlog.Logger().WithField("routerId", drain.RouterId)
+
+	attempted := map[string]struct{}{}
+	for pass := 0; pass < routerDrainMaxPasses; pass++ {
+		var circuits []*model.Circuit
+		for _, circuit := range self.getCircuits(drain.RouterId, true) {
+			if _, found := attempted[circuit.Id]; !found {
+				attempted[circuit.Id] = struct{}{}
+				circuits = append(circuits, circuit)
+			}
+		}
+
+		if len(circuits) == 0 {
+			break
+		}
+
+		if !self.update(drain, func() { drain.TransitCircuits += len(circuits) }) {
+			return
+		}
+
+		for _, circuit := range circuits {
+			err := self.rerouteCircuit(circuit, drain.RouterId)
+
+			ok := self.update(drain, func() {
+				if err == nil {
+					drain.TransitRerouted++
+				} else if errors.Is(err, errCircuitEnded) {
+					drain.TransitEnded++
+				} else {
+					drain.Stragglers = append(drain.Stragglers, &RouterDrainStraggler{
+						CircuitId: circuit.Id,
+						Kind:      RouterDrainStragglerTransit,
+						Error:     err.Error(),
+					})
+				}
+			})
+
+			if !ok {
+				return
+			}
+
+			if err != nil && !errors.Is(err, errCircuitEnded) {
+				log.WithError(err).WithField("circuitId", circuit.Id).Warn("unable to reroute circuit off draining router")
+			}
+		}
+	}
+
+	edgeCircuits := self.getCircuits(drain.RouterId, false)
+
+	if drain.EdgeCircuitTimeout > 0 && len(edgeCircuits) > 0 {
+		if !self.update(drain, func() {
+			drain.State = RouterDrainWaitingEdge
+			drain.EdgeCircuits = len(edgeCircuits)
+		}) {
+			return
+		}
+
+		deadline := time.Now().Add(drain.EdgeCircuitTimeout)
+		for len(edgeCircuits) > 0 && time.Now().Before(deadline) {
+			select {
+			case <-time.After(self.pollInterval):
+			case <-self.network.closeNotify:
+				return
+			}
+
+			edgeCircuits = self.getCircuits(drain.RouterId, false)
+			if !self.update(drain, func() { drain.EdgeCircuits = len(edgeCircuits) }) {
+				return
+			}
+		}
+	}
+
+	self.update(drain, func() {
+		now := time.Now()
+		drain.State = RouterDrainDrained
+		drain.CompletedAt = &now
+		drain.EdgeCircuits = len(edgeCircuits)
+		if drain.EdgeCircuitTimeout > 0 {
+			for _, circuit := range edgeCircuits {
+				drain.Stragglers = append(drain.Stragglers, &RouterDrainStraggler{
+					CircuitId: circuit.Id,
+					Kind:      RouterDrainStragglerEdge,
+					Error:     "circuit didn't finish before the edge circuit timeout",
+				})
+			}
+		}
+	})
+
+	log.WithField("stragglers", len(drain.Stragglers)).Info("router drained")
+}
+
+var errCircuitEnded = errors.New("circuit ended")
+
+func (self *RouterDrainManager) rerouteCircuit(circuit *model.Circuit, routerId string) error {
+	var err error
+	for i := 0; i < routerDrainRerouteAttempts; i++ {
+		current, found := self.network.Circuit.Get(circuit.Id)
+		if !found {
+			return errCircuitEnded
+		}
+
+		if !isTransitRouter(current.Path, routerId) {
+			return nil
+		}
+
+		if err = self.reroute(current, time.Now().Add(config.DefaultOptionsRouteTimeout)); err == nil {
+			if !isTransitRouter(current.Path, routerId) {
+				return nil
+			}
+			err = errors.New("no path avoiding the router is available")
+		}
+	}
+	return err
+}
+
+// getCircuits returns the circuits which pass through the router if transit is true, and the circuits which start or
+// end at the router otherwise
+func (self *RouterDrainManager) getCircuits(routerId string, transit bool) []*model.Circuit {
+	var result []*model.Circuit
+	for _, circuit := range self.network.Circuit.All() {
+		if circuit.Path == nil || len(circuit.Path.Nodes) == 0 {
+			continue
+		}
+		if transit && isTransitRouter(circuit.Path, routerId) {
+			result = append(result, circuit)
+		} else if !transit && isEdgeRouter(circuit.Path, routerId) {
+			result = append(result, circuit)
+		}
+	}
+	return result
+}
+
+func isTransitRouter(path *model.Path, routerId string) bool {
+	for i := 1; i < len(path.Nodes)-1; i++ {
+		if path.Nodes[i].Id == routerId {
+			return true
+		}
+	}
+	return false
+}
+
+func isEdgeRouter(path *model.Path, routerId string) bool {
+	return len(path.Nodes) > 0 && (path.Nodes[0].Id == routerId || path.Nodes[len(path.Nodes)-1].Id == routerId)
+}
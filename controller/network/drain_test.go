@@ -0,0 +1,169 @@
+/*
+	Copyright NetFoundry Inc.
+
+	Licensed under the Apache License, Version 2.0 (the "License");
+	you may not use this file except in compliance with the License.
+	You may obtain a copy of the License at
+
+	https://www.apache.org/licenses/LICENSE-2.0
+
+	Unless required by applicable law or agreed to in writing, software
+	distributed under the License is distributed on an "AS IS" BASIS,
+	WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+	See the License for the specific language governing permissions and
+	limitations under the License.
+*/
+
+package network
+
+import (
+	"testing"
+	"time"
+
+	"github.com/openziti/storage/boltz"
+	"github.com/openziti/transport/v2/tcp"
+	"github.com/openziti/ziti/controller/change"
+	"github.com/openziti/ziti/controller/model"
+	"github.com/pkg/errors"
+)
+
+func newDrainTestNetwork(t *testing.T, ctx *model.TestContext) (*Network, []*model.Router) {
+	config := newTestConfig(ctx)
+	t.Cleanup(func() { close(config.closeNotify) })
+
+	network, err := NewNetwork(config, ctx)
+	ctx.NoError(err)
+
+	transportAddr, err := tcp.AddressParser{}.Parse("tcp:0.0.0.0:0")
+	ctx.NoError(err)
+
+	// r1 is the cheaper transit router, so it's used unless it's being drained
+	var routers []*model.Router
+	for i, cost := range []uint16{1, 1, 10, 1} {
+		r := model.NewRouterForTest("r"+string(rune('0'+i)), "", transportAddr, nil, cost, false)
+		network.Router.MarkConnected(r)
+		routers = append(routers, r)
+	}
+
+	for i, pair := range [][2]int{{0, 1}, {0, 2}, {1, 3}, {2, 3}} {
+		link := model.NewTestLink("l"+string(rune('0'+i)), routers[pair[0]], routers[pair[1]])
+		link.SetStaticCost(1)
+		link.SetState(model.Connected)
+		network.Link.Add(link)
+	}
+
+	return network, routers
+}
+
+func TestShortestPathAvoidsDrainingRouter(t *testing.T) {
+	ctx := model.NewTestContext(t)
+	defer ctx.Cleanup()
+
+	network, routers := newDrainTestNetwork(t, ctx)
+
+	path, _, err := network.shortestPath(routers[0], routers[3])
+	ctx.NoError(err)
+	ctx.Equal([]*model.Router{routers[0], routers[1], routers[3]}, path)
+
+	network.Drains.drains[routers[1].Id] = &RouterDrain{RouterId: routers[1].Id, State: RouterDrainDrained}
+
+	path, _, err = network.shortestPath(routers[0], routers[3])
+	ctx.NoError(err)
+	ctx.Equal([]*model.Router{routers[0], routers[2], routers[3]}, path)
+
+	// a draining router may still be used as the first or last router of a path
+	path, _, err = network.shortestPath(routers[1], routers[3])
+	ctx.NoError(err)
+	ctx.Equal([]*model.Router{routers[1], routers[3]}, path)
+
+	ctx.NoError(network.Drains.Cancel(routers[1].Id))
+
+	path, _, err = network.shortestPath(routers[0], routers[3])
+	ctx.NoError(err)
+	ctx.Equal([]*model.Router{routers[0], routers[1], routers[3]}, path)
+}
+
+func TestRouterDrain(t *testing.T) {
+	ctx := model.NewTestContext(t)
+	defer ctx.Cleanup()
+
+	network, routers := newDrainTestNetwork(t, ctx)
+	r0, r1, r2, r3 := routers[0], routers[1], routers[2], routers[3]
+
+	newCircuit := func(id string, nodes ...*model.Router) *model.Circuit {
+		circuit := &model.Circuit{Id: id, Path: &model.Path{Nodes: nodes}}
+		network.Circuit.Add(circuit)
+		return circuit
+	}
+
+	newCircuit("rerouted", r0, r1, r3)
+	newCircuit("stuck", r0, r1, r3)
+	newCircuit("ended", r0, r1, r3)
+	edge := newCircuit("edge", r1, r3)
+	newCircuit("unrelated", r0, r2, r3)
+
+	network.Drains.pollInterval = 10 * time.Millisecond
+	network.Drains.reroute = func(circuit *model.Circuit, deadline time.Time) error {
+		switch circuit.Id {
+		case "rerouted":
+			circuit.Path = &model.Path{Nodes: []*model.Router{r0, r2, r3}}
+			return nil
+		case "ended":
+			network.Circuit.Remove(circuit)
+			return errors.New("circuit removed")
+		}
+		return errors.New("no path")
+	}
+
+	t.Run("unknown router", func(t *testing.T) {
+		_, err := network.Drains.Start(&RouterDrainSpec{RouterId: "unknown"}, change.New())
+		ctx.Error(err)
+		ctx.True(boltz.IsErrNotFoundErr(err))
+	})
+
+	t.Run("drain", func(t *testing.T) {
+		drain, err := network.Drains.Start(&RouterDrainSpec{
+			RouterId:           r1.Id,
+			EdgeCircuitTimeout: 50 * time.Millisecond,
+		}, change.New())
+		ctx.NoError(err)
+		ctx.Equal(RouterDrainRerouting, drain.State)
+
+		// the drain waits for the edge circuit, so is still in progress
+		_, err = network.Drains.Start(&RouterDrainSpec{RouterId: r1.Id}, change.New())
+		ctx.Error(err)
+
+		ctx.Eventually(func() bool {
+			drain, err = network.Drains.Get(r1.Id)
+			return err == nil && drain.State == RouterDrainDrained
+		}, 5*time.Second, 10*time.Millisecond)
+
+		ctx.Equal(3, drain.TransitCircuits)
+		ctx.Equal(1, drain.TransitRerouted)
+		ctx.Equal(1, drain.TransitEnded)
+		ctx.Equal(1, drain.EdgeCircuits)
+		ctx.NotNil(drain.CompletedAt)
+
+		stragglers := map[string]string{}
+		for _, straggler := range drain.Stragglers {
+			stragglers[straggler.CircuitId] = straggler.Kind
+		}
+		ctx.Equal(map[string]string{"stuck": RouterDrainStragglerTransit, edge.Id: RouterDrainStragglerEdge}, stragglers)
+
+		circuit, found := network.Circuit.Get("stuck")
+		ctx.True(found, "drain must not remove circuits it can't reroute")
+		ctx.Equal(r1, circuit.Path.Nodes[1])
+
+		ctx.Len(network.Drains.List(), 1)
+		ctx.True(network.Drains.IsDraining(r1.Id))
+	})
+
+	t.Run("cancel", func(t *testing.T) {
+		ctx.NoError(network.Drains.Cancel(r1.Id))
+		ctx.False(network.Drains.IsDraining(r1.Id))
+
+		_, err := network.Drains.Get(r1.Id)
+		ctx.True(boltz.IsErrNotFoundErr(err))
+		ctx.True(boltz.IsErrNotFoundErr(network.Drains.Cancel(r1.Id)))
+	})
+}
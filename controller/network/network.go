@@ -50,6 +50,7 @@ import (
 	"github.com/openziti/ziti/common/pb/ctrl_pb"
 	"github.com/openziti/ziti/common/pb/mgmt_pb"
 	"github.com/openziti/ziti/common/trace"
+	"github.com/openziti/ziti/controller/backup"
 	"github.com/openziti/ziti/controller/command"
 	"github.com/openziti/ziti/controller/config"
 	"github.com/openziti/ziti/controller/db"
@@ -114,6 +115,9 @@ type Network struct {
 
 	Inspections       *InspectionsManager
 	RouterMessaging   *RouterMessaging
+	Backups           *backup.Manager
+	Drains            *RouterDrainManager
+	Rehoming          *CircuitRehomeManager
 	inspectionTargets concurrenz.CopyOnWriteSlice[InspectTarget]
 }
 
@@ -163,9 +167,12 @@ func NewNetwork(config Config, env model.Env) (*Network, error) {
 		return nil, err
 	}
 	network.Inspections = NewInspectionsManager(network)
+	network.Drains = NewRouterDrainManager(network)
+	network.Rehoming = NewCircuitRehomeManager(network)
 	network.RouterMessaging = NewRouterMessaging(env, routerCommPool)
 
 	env.GetManagers().Router.Store.AddEntityIdListener(network.HandleRouterDelete, boltz.EntityDeletedAsync)
+	env.GetManagers().Quota.AddHardLimitHandler(network)
 
 	network.AddCapability("ziti.fabric")
 	network.showOptions()
@@ -181,6 +188,22 @@ func (self *Network) HandleRouterDelete(id string) {
 	self.RouterMessaging.RouterDeleted(id)
 }
 
+// QuotaHardLimitReached tears down the circuits belonging to the subject of a quota which has
+// reached its hard limit
+func (self *Network) QuotaHardLimitReached(quota *model.Quota, subjectId string) {
+	for _, circuit := range self.Circuit.All() {
+		if self.Quota.IsAffected(quota.Id, subjectId, circuit.Tags["clientId"], circuit.ServiceId) {
+			pfxlog.Logger().WithField("circuitId", circuit.Id).
+				WithField("quotaId", quota.Id).
+				WithField("subjectId", subjectId).
+				Info("removing circuit, quota hard limit reached")
+			if err := self.RemoveCircuit(circuit.Id, true); err != nil {
+				pfxlog.Logger().WithError(err).WithField("circuitId", circuit.Id).Error("failed to remove circuit")
+			}
+		}
+	}
+}
+
 func (self *Network) decodeSyncSnapshotCommand(_ int32, data []byte) (command.Command, error) {
 	msg := &cmd_pb.SyncSnapshotCommand{}
 	if err := proto.Unmarshal(data, msg); err != nil {
@@ -605,6 +628,12 @@ func (network *Network) CreateCircuit(params model.CreateCircuitParams) (*model.
 		ServiceId: serviceId,
 	}
 
+	if quota := network.Quota.GetExceededQuota(params.GetCircuitTags(nil)["clientId"], serviceId); quota != nil {
+		network.CircuitFailedEvent(circuitId, params, startTime, nil, nil, CircuitFailureQuotaExceeded)
+		network.ServiceDialOtherError(serviceId)
+		return circuit, newCircuitErrorf(CircuitFailureQuotaExceeded, "hard limit of quota '%s' reached", quota.Name)
+	}
+
 	attempt := uint32(0)
 	allCleanups := make(map[string]struct{})
 	rs := network.newRouteSender(circuitId)
@@ -768,7 +797,18 @@ func (network *Network) selectPath(params model.CreateCircuitParams, svc *model.
 
 	log := pfxlog.ChannelLogger(logcontext.SelectPath).Wire(ctx)
 
+	constraint, err := network.Residency.GetRouterConstraint(svc.Id)
+	if err != nil {
+		return nil, nil, nil, nil, newCircuitErrWrap(CircuitFailureInvalidService, err)
+	}
+
+	if srcR := params.GetSourceRouter(); srcR != nil && !constraint.Allows(srcR.Id) {
+		return nil, nil, nil, nil, newCircuitErrorf(CircuitFailureResidencyConstraint,
+			"router %v may not carry circuits for service %v, it is not allowed by residency constraints %v", srcR.Id, svc.Id, constraint)
+	}
+
 	hasOfflineRouters := false
+	hasDisallowedRouters := false
 	pathError := false
 
 	for _, terminator := range svc.Terminators {
@@ -776,6 +816,13 @@ func (network *Network) selectPath(params model.CreateCircuitParams, svc *model.
 			continue
 		}
 
+		if !constraint.Allows(terminator.GetRouterId()) {
+			log.Debugf("skipping terminator %v for service %v, router %v is not allowed by residency constraints %v",
+				terminator.GetId(), svc.Id, terminator.GetRouterId(), constraint)
+			hasDisallowedRouters = true
+			continue
+		}
+
 		pathAndCost, found := paths[terminator.Router]
 		if !found {
 			dstR := network.Router.GetConnected(terminator.GetRouterId())
@@ -789,7 +836,7 @@ func (network *Network) selectPath(params model.CreateCircuitParams, svc *model.
 				continue
 			}
 
-			path, cost, err := network.shortestPath(params.GetSourceRouter(), dstR)
+			path, cost, err := network.constrainedShortestPath(params.GetSourceRouter(), dstR, constraint)
 			if err != nil {
 				log.Debugf("error while calculating path for service %v: %v", svc.Id, err)
 				errList = append(errList, err)
@@ -816,6 +863,11 @@ func (network *Network) selectPath(params model.CreateCircuitParams, svc *model.
 	}
 
 	if len(weightedTerminators) == 0 {
+		if hasDisallowedRouters || (pathError && constraint != nil) {
+			errList = append([]error{fmt.Errorf("service %v has no terminators reachable within residency constraints %v", svc.Id, constraint)}, errList...)
+			return nil, nil, nil, nil, newCircuitErrWrap(CircuitFailureResidencyConstraint, errors.Join(errList...))
+		}
+
 		if pathError {
 			return nil, nil, nil, nil, newCircuitErrWrap(CircuitFailureNoPath, errors.Join(errList...))
 		}
@@ -1130,7 +1182,7 @@ func (network *Network) rerouteCircuit(circuit *model.Circuit, deadline time.Tim
 
 		log.Warn("rerouting circuit")
 
-		if cq, err := network.UpdatePath(circuit.Path); err == nil {
+		if cq, err := network.updateCircuitPath(circuit); err == nil {
 			circuit.Path = cq
 			circuit.UpdatedAt = time.Now()
 
@@ -1161,6 +1213,11 @@ func (network *Network) smartReroute(circuit *model.Circuit, cq *model.Path, dea
 	if circuit.Rerouting.CompareAndSwap(false, true) {
 		defer circuit.Rerouting.Store(false)
 
+		if constraint, err := network.Residency.GetRouterConstraint(circuit.ServiceId); err != nil || !constraint.AllowsPath(cq) {
+			log.WithError(err).WithField("path", cq.String()).Warn("not rerouting circuit, path isn't allowed by residency constraints")
+			return false
+		}
+
 		circuit.Path = cq
 		circuit.UpdatedAt = time.Now()
 
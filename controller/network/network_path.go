@@ -116,9 +116,23 @@ func (network *Network) CreatePathWithNodes(nodes []*model.Router) (*model.Path,
 }
 
 func (network *Network) UpdatePath(path *model.Path) (*model.Path, error) {
+	return network.updatePath(path, nil)
+}
+
+// updateCircuitPath computes the current best path for the circuit, using only the routers allowed by the residency
+// constraints of the circuit's service
+func (network *Network) updateCircuitPath(circuit *model.Circuit) (*model.Path, error) {
+	constraint, err := network.Residency.GetRouterConstraint(circuit.ServiceId)
+	if err != nil {
+		return nil, err
+	}
+	return network.updatePath(circuit.Path, constraint)
+}
+
+func (network *Network) updatePath(path *model.Path, constraint *model.RouterConstraint) (*model.Path, error) {
 	srcR := path.Nodes[0]
 	dstR := path.Nodes[len(path.Nodes)-1]
-	nodes, _, err := network.shortestPath(srcR, dstR)
+	nodes, _, err := network.constrainedShortestPath(srcR, dstR, constraint)
 	if err != nil {
 		return nil, err
 	}
@@ -139,10 +153,22 @@ func (network *Network) UpdatePath(path *model.Path) (*model.Path, error) {
 }
 
 func (network *Network) shortestPath(srcR *model.Router, dstR *model.Router) ([]*model.Router, int64, error) {
+	return network.constrainedShortestPath(srcR, dstR, nil)
+}
+
+// constrainedShortestPath finds the least expensive path between the routers, which only traverses routers allowed by
+// the given constraint. A nil constraint allows all routers
+func (network *Network) constrainedShortestPath(srcR *model.Router, dstR *model.Router, constraint *model.RouterConstraint) ([]*model.Router, int64, error) {
 	if srcR == nil || dstR == nil {
 		return nil, 0, errors.New("not routable (!srcR||!dstR)")
 	}
 
+	for _, r := range []*model.Router{srcR, dstR} {
+		if !constraint.Allows(r.Id) {
+			return nil, 0, newCircuitErrorf(CircuitFailureResidencyConstraint, "router %v is not allowed by residency constraints %v", r.Id, constraint)
+		}
+	}
+
 	if srcR == dstR {
 		return []*model.Router{srcR}, 0, nil
 	}
@@ -152,6 +178,9 @@ func (network *Network) shortestPath(srcR *model.Router, dstR *model.Router) ([]
 	unvisited := make(map[*model.Router]bool)
 
 	for _, r := range network.Router.AllConnected() {
+		if !constraint.Allows(r.Id) {
+			continue
+		}
 		dist[r] = math.MaxInt32
 		unvisited[r] = true
 	}
@@ -171,7 +200,7 @@ func (network *Network) shortestPath(srcR *model.Router, dstR *model.Router) ([]
 			if _, found := unvisited[r]; found {
 				var cost int64 = math.MaxInt32 + 1
 				if l, found := network.Link.LeastExpensiveLink(r, u); found {
-					if !r.NoTraversal || r == srcR || r == dstR {
+					if (!r.NoTraversal && !network.Drains.IsDraining(r.Id)) || r == srcR || r == dstR {
 						cost = l.GetCost() + int64(max(r.Cost, minRouterCost))
 					}
 				}
@@ -0,0 +1,343 @@
+/*
+	Copyright NetFoundry Inc.
+
+	Licensed under the Apache License, Version 2.0 (the "License");
+	you may not use this file except in compliance with the License.
+	You may obtain a copy of the License at
+
+	https://www.apache.org/licenses/LICENSE-2.0
+
+	Unless required by applicable law or agreed to in writing, software
+	distributed under the License is distributed on an "AS IS" BASIS,
+	WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+	See the License for the specific language governing permissions and
+	limitations under the License.
+*/
+
+package network
+
+import (
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/michaelquigley/pfxlog"
+	"github.com/openziti/channel/v4/protobufs"
+	"github.com/openziti/ziti/common/pb/ctrl_pb"
+	"github.com/openziti/ziti/controller/event"
+	"github.com/openziti/ziti/controller/model"
+	"github.com/pkg/errors"
+)
+
+const (
+	maxRehomePathLength  = 32
+	rehomeRequestTimeout = 10 * time.Second
+)
+
+// CircuitRehomeManager takes ownership of circuits created by a controller which is no longer available. The ingress
+// router of each circuit reports its part of the circuit, and the rest of the path is collected from the routers
+// along it, one link at a time. Once the path is known, the circuit is added to the circuit store, where it's picked
+// up by fault handling and smart rerouting like any other circuit.
+type CircuitRehomeManager struct {
+	network         *Network
+	lock            sync.Mutex
+	inProgress      map[string]struct{}
+	queryFragments  func(r *model.Router, circuitIds []string) ([]*ctrl_pb.CircuitFragment, error)
+	notifyOwnership func(r *model.Router, circuitIds []string) error
+}
+
+func NewCircuitRehomeManager(network *Network) *CircuitRehomeManager {
+	return &CircuitRehomeManager{
+		network:         network,
+		inProgress:      map[string]struct{}{},
+		queryFragments:  queryCircuitFragments,
+		notifyOwnership: sendCircuitOwnershipUpdate,
+	}
+}
+
+// RehomeCircuits attempts to take ownership of the given circuits, reported by their ingress router. Circuits this
+// controller already owns are accepted as is.
+func (self *CircuitRehomeManager) RehomeCircuits(router *model.Router, fragments []*ctrl_pb.CircuitFragment) *ctrl_pb.CircuitRehomeResponse {
+	response := &ctrl_pb.CircuitRehomeResponse{
+		Rejected: map[string]string{},
+	}
+
+	var walks []*circuitPathWalk
+	for _, fragment := range fragments {
+		if _, found := self.network.Circuit.Get(fragment.CircuitId); found {
+			response.Accepted = append(response.Accepted, fragment.CircuitId)
+			continue
+		}
+
+		if !self.start(fragment.CircuitId) {
+			response.Rejected[fragment.CircuitId] = "circuit re-home already in progress"
+			continue
+		}
+
+		walk, err := newCircuitPathWalk(router, fragment)
+		if err != nil {
+			self.finish(fragment.CircuitId)
+			response.Rejected[fragment.CircuitId] = err.Error()
+			continue
+		}
+		walks = append(walks, walk)
+	}
+
+	defer func() {
+		for _, walk := range walks {
+			self.finish(walk.circuitId)
+		}
+	}()
+
+	var completed []*circuitPathWalk
+	active := walks
+	for len(active) > 0 {
+		byRouter := map[string][]*circuitPathWalk{}
+		for _, walk := range active {
+			nextRouterId, err := walk.step(self.network)
+			if err != nil {
+				response.Rejected[walk.circuitId] = err.Error()
+			} else if nextRouterId == "" {
+				completed = append(completed, walk)
+			} else {
+				byRouter[nextRouterId] = append(byRouter[nextRouterId], walk)
+			}
+		}
+
+		active = nil
+		for routerId, routerWalks := range byRouter {
+			active = append(active, self.collectFragments(routerId, routerWalks, response)...)
+		}
+	}
+
+	owners := map[string][]string{}
+	for _, walk := range completed {
+		if err := self.addCircuit(walk); err != nil {
+			response.Rejected[walk.circuitId] = err.Error()
+			continue
+		}
+		response.Accepted = append(response.Accepted, walk.circuitId)
+		for _, node := range walk.path.Nodes[1:] {
+			owners[node.Id] = append(owners[node.Id], walk.circuitId)
+		}
+	}
+
+	// the ingress router learns of the new owner from the response, the rest of the path is told separately
+	for routerId, circuitIds := range owners {
+		if r := self.network.GetConnectedRouter(routerId); r != nil {
+			if err := self.notifyOwnership(r, circuitIds); err != nil {
+				pfxlog.Logger().WithField("routerId", routerId).WithError(err).
+					Error("unable to notify router of re-homed circuit ownership")
+			}
+		}
+	}
+
+	pfxlog.Logger().WithField("routerId", router.Id).
+		Infof("took ownership of [%d] re-homed circuits, rejected [%d]", len(response.Accepted), len(response.Rejected))
+
+	return response
+}
+
+func (self *CircuitRehomeManager) start(circuitId string) bool {
+	self.lock.Lock()
+	defer self.lock.Unlock()
+	if _, found := self.inProgress[circuitId]; found {
+		return false
+	}
+	self.inProgress[circuitId] = struct{}{}
+	return true
+}
+
+func (self *CircuitRehomeManager) finish(circuitId string) {
+	self.lock.Lock()
+	defer self.lock.Unlock()
+	delete(self.inProgress, circuitId)
+}
+
+func (self *CircuitRehomeManager) collectFragments(routerId string, walks []*circuitPathWalk, response *ctrl_pb.CircuitRehomeResponse) []*circuitPathWalk {
+	r := self.network.GetConnectedRouter(routerId)
+	if r == nil {
+		for _, walk := range walks {
+			response.Rejected[walk.circuitId] = fmt.Sprintf("router %v on circuit path is not connected", routerId)
+		}
+		return nil
+	}
+
+	var circuitIds []string
+	for _, walk := range walks {
+		circuitIds = append(circuitIds, walk.circuitId)
+	}
+
+	fragments, err := self.queryFragments(r, circuitIds)
+	if err != nil {
+		for _, walk := range walks {
+			response.Rejected[walk.circuitId] = fmt.Sprintf("unable to get circuit details from router %v (%v)", routerId, err)
+		}
+		return nil
+	}
+
+	fragmentMap := map[string]*ctrl_pb.CircuitFragment{}
+	for _, fragment := range fragments {
+		fragmentMap[fragment.CircuitId] = fragment
+	}
+
+	var result []*circuitPathWalk
+	for _, walk := range walks {
+		if fragment, found := fragmentMap[walk.circuitId]; found {
+			walk.visit(r, fragment)
+			result = append(result, walk)
+		} else {
+			response.Rejected[walk.circuitId] = fmt.Sprintf("router %v on circuit path has no forwarding table for circuit", routerId)
+		}
+	}
+	return result
+}
+
+func (self *CircuitRehomeManager) addCircuit(walk *circuitPathWalk) error {
+	egress := walk.current
+	serviceTag := egress.Tags["serviceId"]
+	if serviceTag == "" {
+		return errors.New("circuit has no service tag")
+	}
+
+	_, serviceId := parseInstanceIdAndService(serviceTag)
+	svc, err := self.network.Service.Read(serviceId)
+	if err != nil {
+		return fmt.Errorf("unable to load circuit service %v (%w)", serviceId, err)
+	}
+
+	egressRouter := walk.path.Nodes[len(walk.path.Nodes)-1]
+	var terminator *model.Terminator
+	for _, t := range svc.Terminators {
+		if t.Router == egressRouter.Id && t.Binding == egress.EgressBinding && t.Address == egress.EgressDestination {
+			terminator = t
+			break
+		}
+	}
+
+	if terminator == nil {
+		return fmt.Errorf("no terminator for service %v found on router %v with binding %v and address %v",
+			serviceId, egressRouter.Id, egress.EgressBinding, egress.EgressDestination)
+	}
+
+	circuit := &model.Circuit{
+		Id:         walk.circuitId,
+		ServiceId:  serviceId,
+		Terminator: &model.RoutingTerminator{Terminator: terminator},
+		Path:       walk.path,
+		Tags:       egress.Tags,
+		CreatedAt:  walk.createdAt,
+		UpdatedAt:  time.Now(),
+	}
+
+	self.network.Circuit.Add(circuit)
+	self.network.CircuitEvent(event.CircuitUpdated, circuit, nil)
+
+	pfxlog.Logger().WithField("circuitId", circuit.Id).WithField("path", circuit.Path).Info("took ownership of re-homed circuit")
+	return nil
+}
+
+// circuitPathWalk rebuilds the path of a circuit by following the forwarding tables of the routers on the circuit,
+// starting at the ingress router
+type circuitPathWalk struct {
+	circuitId string
+	path      *model.Path
+	current   *ctrl_pb.CircuitFragment
+	srcAddr   string
+	createdAt time.Time
+}
+
+func newCircuitPathWalk(router *model.Router, fragment *ctrl_pb.CircuitFragment) (*circuitPathWalk, error) {
+	for _, forward := range fragment.Forwards {
+		if forward.DstType == ctrl_pb.DestType_Start {
+			return &circuitPathWalk{
+				circuitId: fragment.CircuitId,
+				path: &model.Path{
+					Nodes:     []*model.Router{router},
+					IngressId: forward.DstAddress,
+				},
+				current:   fragment,
+				srcAddr:   forward.DstAddress,
+				createdAt: time.UnixMilli(fragment.CreatedAt),
+			}, nil
+		}
+	}
+	return nil, fmt.Errorf("router %v is not the ingress router for the circuit", router.Id)
+}
+
+// step follows the current router's forwarding table towards the egress. It returns the id of the next router on the
+// path, or an empty string if the current router is the egress router
+func (self *circuitPathWalk) step(network *Network) (string, error) {
+	currentRouter := self.path.Nodes[len(self.path.Nodes)-1]
+
+	var next *ctrl_pb.Route_Forward
+	for _, forward := range self.current.Forwards {
+		if forward.SrcAddress == self.srcAddr {
+			next = forward
+			break
+		}
+	}
+
+	if next == nil {
+		return "", fmt.Errorf("router %v has no forward for address %v", currentRouter.Id, self.srcAddr)
+	}
+
+	switch next.DstType {
+	case ctrl_pb.DestType_End:
+		self.path.EgressId = next.DstAddress
+		return "", nil
+	case ctrl_pb.DestType_Link:
+		link, found := network.Link.Get(next.DstAddress)
+		if !found {
+			return "", fmt.Errorf("link %v on circuit path is unknown", next.DstAddress)
+		}
+
+		nextRouterId := link.DstId
+		if link.Src.Id != currentRouter.Id {
+			nextRouterId = link.Src.Id
+		}
+
+		for _, node := range self.path.Nodes {
+			if node.Id == nextRouterId {
+				return "", fmt.Errorf("circuit path loops back to router %v", nextRouterId)
+			}
+		}
+
+		if len(self.path.Links) >= maxRehomePathLength {
+			return "", fmt.Errorf("circuit path exceeds maximum length of %d links", maxRehomePathLength)
+		}
+
+		self.path.Links = append(self.path.Links, link)
+		self.srcAddr = link.Id
+		return nextRouterId, nil
+	default:
+		return "", fmt.Errorf("unexpected forward from %v to %v on router %v", next.SrcAddress, next.DstAddress, currentRouter.Id)
+	}
+}
+
+func (self *circuitPathWalk) visit(router *model.Router, fragment *ctrl_pb.CircuitFragment) {
+	self.path.Nodes = append(self.path.Nodes, router)
+	self.current = fragment
+	if createdAt := time.UnixMilli(fragment.CreatedAt); createdAt.Before(self.createdAt) {
+		self.createdAt = createdAt
+	}
+}
+
+func queryCircuitFragments(r *model.Router, circuitIds []string) ([]*ctrl_pb.CircuitFragment, error) {
+	request := &ctrl_pb.CircuitFragmentsRequest{
+		CircuitIds: circuitIds,
+	}
+	response := &ctrl_pb.CircuitFragmentsResponse{}
+	respMsg, err := protobufs.MarshalTyped(request).WithTimeout(rehomeRequestTimeout).SendForReply(r.Control)
+	if err = protobufs.TypedResponse(response).Unmarshall(respMsg, err); err != nil {
+		return nil, err
+	}
+	return response.Fragments, nil
+}
+
+func sendCircuitOwnershipUpdate(r *model.Router, circuitIds []string) error {
+	update := &ctrl_pb.CircuitOwnershipUpdate{
+		CircuitIds: circuitIds,
+	}
+	return protobufs.MarshalTyped(update).Send(r.Control)
+}
@@ -0,0 +1,179 @@
+/*
+	Copyright NetFoundry Inc.
+
+	Licensed under the Apache License, Version 2.0 (the "License");
+	you may not use this file except in compliance with the License.
+	You may obtain a copy of the License at
+
+	https://www.apache.org/licenses/LICENSE-2.0
+
+	Unless required by applicable law or agreed to in writing, software
+	distributed under the License is distributed on an "AS IS" BASIS,
+	WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+	See the License for the specific language governing permissions and
+	limitations under the License.
+*/
+
+package network
+
+import (
+	"testing"
+	"time"
+
+	"github.com/openziti/ziti/common/pb/ctrl_pb"
+	"github.com/openziti/ziti/controller/change"
+	"github.com/openziti/ziti/controller/model"
+	"github.com/openziti/ziti/controller/models"
+)
+
+func newRehomeTestFragment(circuitId string, createdAt time.Time, forwards ...*ctrl_pb.Route_Forward) *ctrl_pb.CircuitFragment {
+	return &ctrl_pb.CircuitFragment{
+		CircuitId: circuitId,
+		CtrlId:    "lost-ctrl",
+		Forwards:  forwards,
+		Tags:      map[string]string{"serviceId": "open"},
+		CreatedAt: createdAt.UnixMilli(),
+	}
+}
+
+func newRehomeTestForward(src, dst string, dstType ctrl_pb.DestType) *ctrl_pb.Route_Forward {
+	return &ctrl_pb.Route_Forward{
+		SrcAddress: src,
+		DstAddress: dst,
+		DstType:    dstType,
+	}
+}
+
+func withRehomeTestEgress(fragment *ctrl_pb.CircuitFragment) *ctrl_pb.CircuitFragment {
+	fragment.EgressBinding = "transport"
+	fragment.EgressDestination = "tcp:localhost:1001"
+	return fragment
+}
+
+func TestRehomeCircuits(t *testing.T) {
+	ctx := model.NewTestContext(t)
+	defer ctx.Cleanup()
+
+	network, routers := newResidencyTestNetwork(t, ctx)
+
+	ctx.NoError(network.Terminator.Create(&model.Terminator{
+		BaseEntity: models.BaseEntity{Id: "open-r3"},
+		Service:    "open",
+		Router:     "r3",
+		Binding:    "transport",
+		Address:    "tcp:localhost:1001",
+	}, change.New()))
+
+	createdAt := time.Now().Add(-time.Hour).Truncate(time.Millisecond)
+
+	// c0 runs r0 -> l0 -> r1 -> l2 -> r3, c1 is hosted entirely on r3
+	routerFragments := map[string][]*ctrl_pb.CircuitFragment{
+		"r1": {
+			newRehomeTestFragment("c0", createdAt.Add(time.Second),
+				newRehomeTestForward("l0", "l2", ctrl_pb.DestType_Link),
+				newRehomeTestForward("l2", "l0", ctrl_pb.DestType_Link)),
+		},
+		"r3": {
+			withRehomeTestEgress(newRehomeTestFragment("c0", createdAt,
+				newRehomeTestForward("c0-egress", "l2", ctrl_pb.DestType_Link),
+				newRehomeTestForward("l2", "c0-egress", ctrl_pb.DestType_End))),
+		},
+	}
+
+	var queried []string
+	owners := map[string][]string{}
+	network.Rehoming.queryFragments = func(r *model.Router, circuitIds []string) ([]*ctrl_pb.CircuitFragment, error) {
+		queried = append(queried, r.Id)
+		var result []*ctrl_pb.CircuitFragment
+		for _, fragment := range routerFragments[r.Id] {
+			for _, circuitId := range circuitIds {
+				if fragment.CircuitId == circuitId {
+					result = append(result, fragment)
+				}
+			}
+		}
+		return result, nil
+	}
+	network.Rehoming.notifyOwnership = func(r *model.Router, circuitIds []string) error {
+		owners[r.Id] = append(owners[r.Id], circuitIds...)
+		return nil
+	}
+
+	ingress := newRehomeTestFragment("c0", createdAt.Add(2*time.Second),
+		newRehomeTestForward("c0-ingress", "l0", ctrl_pb.DestType_Link),
+		newRehomeTestForward("l0", "c0-ingress", ctrl_pb.DestType_Start))
+
+	single := withRehomeTestEgress(newRehomeTestFragment("c1", createdAt,
+		newRehomeTestForward("c1-ingress", "c1-egress", ctrl_pb.DestType_End),
+		newRehomeTestForward("c1-egress", "c1-ingress", ctrl_pb.DestType_Start)))
+
+	t.Run("circuits are rebuilt from the routers on the path", func(t *testing.T) {
+		response := network.Rehoming.RehomeCircuits(routers[0], []*ctrl_pb.CircuitFragment{ingress})
+		ctx.Equal([]string{"c0"}, response.Accepted)
+		ctx.Empty(response.Rejected)
+		ctx.Equal([]string{"r1", "r3"}, queried)
+
+		circuit, found := network.GetCircuit("c0")
+		ctx.True(found)
+		ctx.Equal("open", circuit.ServiceId)
+		ctx.Equal("open-r3", circuit.Terminator.GetId())
+		ctx.Equal([]*model.Router{routers[0], routers[1], routers[3]}, circuit.Path.Nodes)
+		ctx.Len(circuit.Path.Links, 2)
+		ctx.Equal("l0", circuit.Path.Links[0].Id)
+		ctx.Equal("l2", circuit.Path.Links[1].Id)
+		ctx.Equal("c0-ingress", circuit.Path.IngressId)
+		ctx.Equal("c0-egress", circuit.Path.EgressId)
+		ctx.Equal(createdAt, circuit.CreatedAt)
+
+		ctx.Equal(map[string][]string{"r1": {"c0"}, "r3": {"c0"}}, owners)
+	})
+
+	t.Run("circuits already owned are accepted", func(t *testing.T) {
+		queried = nil
+		response := network.Rehoming.RehomeCircuits(routers[0], []*ctrl_pb.CircuitFragment{ingress})
+		ctx.Equal([]string{"c0"}, response.Accepted)
+		ctx.Empty(queried)
+	})
+
+	t.Run("single router circuits are rebuilt", func(t *testing.T) {
+		response := network.Rehoming.RehomeCircuits(routers[3], []*ctrl_pb.CircuitFragment{single})
+		ctx.Equal([]string{"c1"}, response.Accepted)
+
+		circuit, found := network.GetCircuit("c1")
+		ctx.True(found)
+		ctx.Equal([]*model.Router{routers[3]}, circuit.Path.Nodes)
+		ctx.Empty(circuit.Path.Links)
+		ctx.Equal("c1-egress", circuit.Path.EgressId)
+	})
+
+	t.Run("incomplete circuits are rejected", func(t *testing.T) {
+		notIngress := newRehomeTestFragment("c2", createdAt,
+			newRehomeTestForward("l0", "l2", ctrl_pb.DestType_Link))
+
+		missingFragment := newRehomeTestFragment("c3", createdAt,
+			newRehomeTestForward("c3-ingress", "l0", ctrl_pb.DestType_Link),
+			newRehomeTestForward("l0", "c3-ingress", ctrl_pb.DestType_Start))
+
+		unknownLink := newRehomeTestFragment("c4", createdAt,
+			newRehomeTestForward("c4-ingress", "l9", ctrl_pb.DestType_Link),
+			newRehomeTestForward("l9", "c4-ingress", ctrl_pb.DestType_Start))
+
+		noTerminator := newRehomeTestFragment("c5", createdAt,
+			newRehomeTestForward("c5-ingress", "c5-egress", ctrl_pb.DestType_End),
+			newRehomeTestForward("c5-egress", "c5-ingress", ctrl_pb.DestType_Start))
+
+		response := network.Rehoming.RehomeCircuits(routers[0], []*ctrl_pb.CircuitFragment{notIngress, missingFragment, unknownLink, noTerminator})
+		ctx.Empty(response.Accepted)
+		ctx.Len(response.Rejected, 4)
+		ctx.Contains(response.Rejected["c2"], "not the ingress router")
+		ctx.Contains(response.Rejected["c3"], "has no forwarding table")
+		ctx.Contains(response.Rejected["c4"], "link l9 on circuit path is unknown")
+		ctx.Contains(response.Rejected["c5"], "no terminator")
+
+		for _, circuitId := range []string{"c2", "c3", "c4", "c5"} {
+			_, found := network.GetCircuit(circuitId)
+			ctx.False(found)
+		}
+		ctx.Empty(network.Rehoming.inProgress)
+	})
+}
@@ -0,0 +1,138 @@
+/*
+	Copyright NetFoundry Inc.
+
+	Licensed under the Apache License, Version 2.0 (the "License");
+	you may not use this file except in compliance with the License.
+	You may obtain a copy of the License at
+
+	https://www.apache.org/licenses/LICENSE-2.0
+
+	Unless required by applicable law or agreed to in writing, software
+	distributed under the License is distributed on an "AS IS" BASIS,
+	WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+	See the License for the specific language governing permissions and
+	limitations under the License.
+*/
+
+package network
+
+import (
+	"fmt"
+
+	"github.com/openziti/ziti/common/pb/mgmt_pb"
+	"github.com/openziti/ziti/controller/model"
+)
+
+type ResidencyValidationCallback func(detail *mgmt_pb.ServiceResidencyDetail)
+
+// ValidateResidency checks the terminators of each service matching the filter which has residency constraints. A
+// terminator is compliant if its router is allowed, online and, if there are other allowed routers online, reachable
+// from at least one of them without leaving the allowed routers. Services which have terminators, but no compliant
+// ones, are reported as invalid, since circuits for them will always fail. Returns the number of services which will
+// be reported
+func (network *Network) ValidateResidency(filter string, cb ResidencyValidationCallback) (uint64, error) {
+	if filter == "" {
+		filter = "true limit none"
+	}
+
+	result, err := network.Service.BaseList(filter)
+	if err != nil {
+		return 0, err
+	}
+
+	var details []*mgmt_pb.ServiceResidencyDetail
+	for _, listed := range result.Entities {
+		constraint, err := network.Residency.GetRouterConstraint(listed.Id)
+		if err != nil {
+			return 0, err
+		}
+		if constraint == nil {
+			continue
+		}
+
+		svc, err := network.Service.Read(listed.Id)
+		if err != nil {
+			return 0, err
+		}
+
+		details = append(details, network.validateServiceResidency(svc, constraint))
+	}
+
+	go func() {
+		for _, detail := range details {
+			cb(detail)
+		}
+	}()
+
+	return uint64(len(details)), nil
+}
+
+func (network *Network) validateServiceResidency(svc *model.Service, constraint *model.RouterConstraint) *mgmt_pb.ServiceResidencyDetail {
+	detail := &mgmt_pb.ServiceResidencyDetail{
+		ServiceId:   svc.Id,
+		ServiceName: svc.Name,
+		Constraints: constraint.Constraints,
+	}
+
+	var allowedRouters []*model.Router
+	for _, router := range network.Router.AllConnected() {
+		if constraint.Allows(router.Id) {
+			allowedRouters = append(allowedRouters, router)
+		}
+	}
+
+	compliant := 0
+	for _, terminator := range svc.Terminators {
+		terminatorDetail := &mgmt_pb.ResidencyTerminatorDetail{
+			TerminatorId: terminator.Id,
+			RouterId:     terminator.Router,
+		}
+		if router, _ := network.Router.Read(terminator.Router); router != nil {
+			terminatorDetail.RouterName = router.Name
+		}
+
+		dstR := network.Router.GetConnected(terminator.Router)
+
+		if !constraint.Allows(terminator.Router) {
+			terminatorDetail.State = mgmt_pb.ResidencyTerminatorState_ResidencyRouterNotAllowed
+			terminatorDetail.Detail = fmt.Sprintf("router is not allowed by residency constraints %v", constraint)
+		} else if dstR == nil {
+			terminatorDetail.State = mgmt_pb.ResidencyTerminatorState_ResidencyRouterOffline
+			terminatorDetail.Detail = "router is offline"
+		} else if !network.isReachableWithin(dstR, allowedRouters, constraint) {
+			terminatorDetail.State = mgmt_pb.ResidencyTerminatorState_ResidencyRouterUnreachable
+			terminatorDetail.Detail = fmt.Sprintf("router can't be reached from any other router allowed by residency constraints %v", constraint)
+		} else {
+			terminatorDetail.State = mgmt_pb.ResidencyTerminatorState_ResidencyCompliant
+			compliant++
+		}
+
+		detail.Terminators = append(detail.Terminators, terminatorDetail)
+	}
+
+	if len(svc.Terminators) == 0 {
+		detail.Valid = true
+		detail.Message = "service has no terminators"
+	} else if compliant == 0 {
+		detail.Message = fmt.Sprintf("none of the %d terminators can be reached within residency constraints %v", len(svc.Terminators), constraint)
+	} else {
+		detail.Valid = true
+		detail.Message = fmt.Sprintf("%d of %d terminators can be reached within residency constraints %v", compliant, len(svc.Terminators), constraint)
+	}
+
+	return detail
+}
+
+func (network *Network) isReachableWithin(dstR *model.Router, allowedRouters []*model.Router, constraint *model.RouterConstraint) bool {
+	hasOtherRouters := false
+	for _, srcR := range allowedRouters {
+		if srcR.Id == dstR.Id {
+			continue
+		}
+		hasOtherRouters = true
+		if _, _, err := network.constrainedShortestPath(srcR, dstR, constraint); err == nil {
+			return true
+		}
+	}
+	return !hasOtherRouters
+}
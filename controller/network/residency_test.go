@@ -0,0 +1,283 @@
+/*
+	Copyright NetFoundry Inc.
+
+	Licensed under the Apache License, Version 2.0 (the "License");
+	you may not use this file except in compliance with the License.
+	You may obtain a copy of the License at
+
+	https://www.apache.org/licenses/LICENSE-2.0
+
+	Unless required by applicable law or agreed to in writing, software
+	distributed under the License is distributed on an "AS IS" BASIS,
+	WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+	See the License for the specific language governing permissions and
+	limitations under the License.
+*/
+
+package network
+
+import (
+	"testing"
+	"time"
+
+	"github.com/openziti/transport/v2/tcp"
+	"github.com/openziti/ziti/common/logcontext"
+	"github.com/openziti/ziti/common/pb/mgmt_pb"
+	"github.com/openziti/ziti/controller/change"
+	"github.com/openziti/ziti/controller/config"
+	"github.com/openziti/ziti/controller/model"
+	"github.com/openziti/ziti/controller/models"
+	"github.com/openziti/ziti/controller/xt"
+	"github.com/openziti/ziti/controller/xt_smartrouting"
+)
+
+// newResidencyTestNetwork creates the same topology as newDrainTestNetwork, with the routers and services stored, so
+// that residency constraints can be resolved against them. The eu constraint excludes r1, the cheaper transit router,
+// and the direct constraint allows only r0 and r3, which aren't linked to each other
+func newResidencyTestNetwork(t *testing.T, ctx *model.TestContext) (*Network, []*model.Router) {
+	testConfig := newTestConfig(ctx)
+	t.Cleanup(func() { close(testConfig.closeNotify) })
+
+	network, err := NewNetwork(testConfig, ctx)
+	ctx.NoError(err)
+
+	transportAddr, err := tcp.AddressParser{}.Parse("tcp:0.0.0.0:0")
+	ctx.NoError(err)
+
+	var routers []*model.Router
+	for i, cost := range []uint16{1, 1, 10, 1} {
+		r := model.NewRouterForTest("r"+string(rune('0'+i)), "", transportAddr, nil, cost, false)
+		ctx.NoError(network.Router.Create(r, change.New()))
+		network.Router.MarkConnected(r)
+		routers = append(routers, r)
+	}
+
+	for i, pair := range [][2]int{{0, 1}, {0, 2}, {1, 3}, {2, 3}} {
+		link := model.NewTestLink("l"+string(rune('0'+i)), routers[pair[0]], routers[pair[1]])
+		link.SetStaticCost(1)
+		link.SetState(model.Connected)
+		network.Link.Add(link)
+	}
+
+	for _, id := range []string{"eu", "direct", "open"} {
+		ctx.NoError(network.Service.Create(&model.Service{
+			BaseEntity:         models.BaseEntity{Id: id},
+			Name:               id,
+			TerminatorStrategy: xt_smartrouting.Name,
+		}, change.New()))
+	}
+
+	ctx.GetConfig().Residency = config.ResidencyConfig{
+		Constraints: []*config.ResidencyConstraintConfig{
+			{
+				Name:     "eu",
+				Services: []string{"@eu"},
+				Routers:  []string{"@r0", "@r2", "@r3"},
+			},
+			{
+				Name:     "direct",
+				Services: []string{"@direct"},
+				Routers:  []string{"@r0", "@r3"},
+			},
+		},
+	}
+
+	return network, routers
+}
+
+func newResidencyTestService(ctx *model.TestContext, network *Network, serviceId string, routers ...*model.Router) *model.Service {
+	stored, err := network.Service.Read(serviceId)
+	ctx.NoError(err)
+
+	svc := &model.Service{
+		BaseEntity:         stored.BaseEntity,
+		Name:               stored.Name,
+		TerminatorStrategy: stored.TerminatorStrategy,
+	}
+	for _, router := range routers {
+		svc.Terminators = append(svc.Terminators, &model.Terminator{
+			BaseEntity: models.BaseEntity{Id: serviceId + "-" + router.Id},
+			Service:    serviceId,
+			Router:     router.Id,
+			Binding:    "transport",
+			Address:    "tcp:localhost:1001",
+			Precedence: xt.Precedences.Default,
+		})
+	}
+	return svc
+}
+
+func TestConstrainedShortestPath(t *testing.T) {
+	ctx := model.NewTestContext(t)
+	defer ctx.Cleanup()
+
+	network, routers := newResidencyTestNetwork(t, ctx)
+
+	path, _, err := network.shortestPath(routers[0], routers[3])
+	ctx.NoError(err)
+	ctx.Equal([]*model.Router{routers[0], routers[1], routers[3]}, path)
+
+	constraint, err := network.Residency.GetRouterConstraint("eu")
+	ctx.NoError(err)
+
+	path, _, err = network.constrainedShortestPath(routers[0], routers[3], constraint)
+	ctx.NoError(err)
+	ctx.Equal([]*model.Router{routers[0], routers[2], routers[3]}, path)
+
+	_, _, err = network.constrainedShortestPath(routers[1], routers[3], constraint)
+	ctx.Error(err)
+	ctx.Equal(CircuitFailureResidencyConstraint, err.(CircuitError).Cause())
+
+	constraint, err = network.Residency.GetRouterConstraint("direct")
+	ctx.NoError(err)
+
+	_, _, err = network.constrainedShortestPath(routers[0], routers[3], constraint)
+	ctx.Error(err)
+}
+
+func TestSelectPathEnforcesResidency(t *testing.T) {
+	ctx := model.NewTestContext(t)
+	defer ctx.Cleanup()
+
+	network, routers := newResidencyTestNetwork(t, ctx)
+	lc := logcontext.NewContext()
+
+	t.Run("paths avoid routers outside the constraint", func(t *testing.T) {
+		svc := newResidencyTestService(ctx, network, "eu", routers[3])
+		_, terminator, path, _, cerr := network.selectPath(newCircuitParams(svc, routers[0]), svc, "", lc)
+		ctx.NoError(cerr)
+		ctx.Equal(routers[3].Id, terminator.GetRouterId())
+		ctx.Equal([]*model.Router{routers[0], routers[2], routers[3]}, path)
+	})
+
+	t.Run("terminators on routers outside the constraint are skipped", func(t *testing.T) {
+		svc := newResidencyTestService(ctx, network, "eu", routers[1], routers[3])
+		for i := 0; i < 10; i++ {
+			_, terminator, _, _, cerr := network.selectPath(newCircuitParams(svc, routers[0]), svc, "", lc)
+			ctx.NoError(cerr)
+			ctx.Equal(routers[3].Id, terminator.GetRouterId())
+		}
+	})
+
+	t.Run("circuits fail if only terminators outside the constraint exist", func(t *testing.T) {
+		svc := newResidencyTestService(ctx, network, "eu", routers[1])
+		_, _, _, _, cerr := network.selectPath(newCircuitParams(svc, routers[0]), svc, "", lc)
+		ctx.Error(cerr)
+		ctx.Equal(CircuitFailureResidencyConstraint, cerr.Cause())
+	})
+
+	t.Run("circuits fail if the initiating router is outside the constraint", func(t *testing.T) {
+		svc := newResidencyTestService(ctx, network, "eu", routers[3])
+		_, _, _, _, cerr := network.selectPath(newCircuitParams(svc, routers[1]), svc, "", lc)
+		ctx.Error(cerr)
+		ctx.Equal(CircuitFailureResidencyConstraint, cerr.Cause())
+	})
+
+	t.Run("circuits fail if no path within the constraint exists", func(t *testing.T) {
+		svc := newResidencyTestService(ctx, network, "direct", routers[3])
+		_, _, _, _, cerr := network.selectPath(newCircuitParams(svc, routers[0]), svc, "", lc)
+		ctx.Error(cerr)
+		ctx.Equal(CircuitFailureResidencyConstraint, cerr.Cause())
+	})
+
+	t.Run("unconstrained services use any router", func(t *testing.T) {
+		svc := newResidencyTestService(ctx, network, "open", routers[3])
+		_, _, path, _, cerr := network.selectPath(newCircuitParams(svc, routers[0]), svc, "", lc)
+		ctx.NoError(cerr)
+		ctx.Equal([]*model.Router{routers[0], routers[1], routers[3]}, path)
+	})
+}
+
+func TestReroutesRespectResidency(t *testing.T) {
+	ctx := model.NewTestContext(t)
+	defer ctx.Cleanup()
+
+	network, routers := newResidencyTestNetwork(t, ctx)
+
+	unconstrainedPath, err := network.CreatePath(routers[0], routers[3])
+	ctx.NoError(err)
+	ctx.Equal([]*model.Router{routers[0], routers[1], routers[3]}, unconstrainedPath.Nodes)
+
+	circuit := &model.Circuit{
+		Id:        "c0",
+		ServiceId: "eu",
+		Path:      unconstrainedPath,
+		CreatedAt: time.Now(),
+	}
+
+	path, err := network.updateCircuitPath(circuit)
+	ctx.NoError(err)
+	ctx.Equal([]*model.Router{routers[0], routers[2], routers[3]}, path.Nodes)
+
+	circuit.Path = path
+	ctx.False(network.smartReroute(circuit, unconstrainedPath, time.Now().Add(time.Second)))
+	ctx.Equal(path, circuit.Path)
+
+	circuit.ServiceId = "direct"
+	_, err = network.updateCircuitPath(circuit)
+	ctx.Error(err)
+}
+
+func TestValidateResidency(t *testing.T) {
+	ctx := model.NewTestContext(t)
+	defer ctx.Cleanup()
+
+	network, routers := newResidencyTestNetwork(t, ctx)
+
+	for _, terminator := range []struct{ service, router string }{{"eu", "r1"}, {"eu", "r3"}, {"direct", "r3"}, {"open", "r1"}} {
+		ctx.NoError(network.Terminator.Create(&model.Terminator{
+			BaseEntity: models.BaseEntity{Id: terminator.service + "-" + terminator.router},
+			Service:    terminator.service,
+			Router:     terminator.router,
+			Binding:    "transport",
+			Address:    "tcp:localhost:1001",
+		}, change.New()))
+	}
+
+	validate := func() map[string]*mgmt_pb.ServiceResidencyDetail {
+		detailC := make(chan *mgmt_pb.ServiceResidencyDetail, 10)
+		count, err := network.ValidateResidency("", func(detail *mgmt_pb.ServiceResidencyDetail) {
+			detailC <- detail
+		})
+		ctx.NoError(err)
+		ctx.Equal(uint64(2), count)
+
+		result := map[string]*mgmt_pb.ServiceResidencyDetail{}
+		for i := 0; i < int(count); i++ {
+			select {
+			case detail := <-detailC:
+				result[detail.ServiceId] = detail
+			case <-time.After(time.Second):
+				ctx.Fail("timed out waiting for residency details")
+			}
+		}
+		return result
+	}
+
+	details := validate()
+
+	eu := details["eu"]
+	ctx.NotNil(eu)
+	ctx.True(eu.Valid)
+	ctx.Equal([]string{"eu"}, eu.Constraints)
+	ctx.Len(eu.Terminators, 2)
+	for _, terminator := range eu.Terminators {
+		if terminator.RouterId == "r1" {
+			ctx.Equal(mgmt_pb.ResidencyTerminatorState_ResidencyRouterNotAllowed, terminator.State)
+		} else {
+			ctx.Equal(mgmt_pb.ResidencyTerminatorState_ResidencyCompliant, terminator.State)
+		}
+	}
+
+	direct := details["direct"]
+	ctx.NotNil(direct)
+	ctx.False(direct.Valid)
+	ctx.Len(direct.Terminators, 1)
+	ctx.Equal(mgmt_pb.ResidencyTerminatorState_ResidencyRouterUnreachable, direct.Terminators[0].State)
+
+	network.Router.MarkDisconnected(routers[3])
+	details = validate()
+	ctx.False(details["eu"].Valid)
+	ctx.False(details["direct"].Valid)
+	ctx.Equal(mgmt_pb.ResidencyTerminatorState_ResidencyRouterOffline, details["direct"].Terminators[0].State)
+}
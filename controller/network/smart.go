@@ -96,7 +96,7 @@ func (network *Network) getRerouteCandidates() []*newCircuitPath {
 	log.Tracef("smart reroute ceiling [%d]", ceiling)
 	for _, circuitId := range orderedCircuits {
 		if circuit, found := network.GetCircuit(circuitId); found {
-			if updatedPath, err := network.UpdatePath(circuit.Path); err == nil {
+			if updatedPath, err := network.updateCircuitPath(circuit); err == nil {
 				pathChanged := !updatedPath.EqualPath(circuit.Path)
 				oldCost := circuitCosts[circuitId]
 				newCost := updatedPath.Cost(minRouterCost)
@@ -0,0 +1,165 @@
+/*
+	Copyright NetFoundry Inc.
+
+	Licensed under the Apache License, Version 2.0 (the "License");
+	you may not use this file except in compliance with the License.
+	You may obtain a copy of the License at
+
+	https://www.apache.org/licenses/LICENSE-2.0
+
+	Unless required by applicable law or agreed to in writing, software
+	distributed under the License is distributed on an "AS IS" BASIS,
+	WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+	See the License for the specific language governing permissions and
+	limitations under the License.
+*/
+
+package network
+
+import (
+	"sort"
+	"time"
+
+	"github.com/openziti/ziti/controller/model"
+)
+
+// Topology is a snapshot of the overlay graph as seen by one controller. It includes every router known to the
+// controller, whether connected or not, and the links the controller currently knows about
+type Topology struct {
+	ControllerId string
+	Timestamp    time.Time
+	Routers      []*TopologyRouter
+	Links        []*TopologyLink
+}
+
+// TopologyRouter is a node in the overlay graph. ConnectedController is set to the id of the controller which
+// produced the topology if the router is connected to it. CircuitCount is the number of circuits whose path includes
+// the router
+type TopologyRouter struct {
+	Id                  string
+	Name                string
+	Connected           bool
+	ConnectedController string
+	ConnectedSince      *time.Time
+	Version             string
+	Cost                uint16
+	NoTraversal         bool
+	Disabled            bool
+	Draining            bool
+	CircuitCount        int
+}
+
+// TopologyLink is an edge in the overlay graph. Latencies are in nanoseconds. CircuitCount is the number of circuits
+// routed over the link
+type TopologyLink struct {
+	Id           string
+	SrcRouterId  string
+	DstRouterId  string
+	Protocol     string
+	State        string
+	Down         bool
+	StaticCost   int32
+	Cost         int64
+	SrcLatency   int64
+	DstLatency   int64
+	CircuitCount int
+}
+
+// GetTopology returns a snapshot of the routers and links known to this controller, with per-router and per-link
+// circuit counts
+func (network *Network) GetTopology() (*Topology, error) {
+	result := &Topology{
+		ControllerId: network.GetAppId(),
+		Timestamp:    time.Now(),
+	}
+
+	routerCircuits := map[string]int{}
+	linkCircuits := map[string]int{}
+	for _, circuit := range network.Circuit.All() {
+		if circuit.Path == nil {
+			continue
+		}
+		for _, node := range circuit.Path.Nodes {
+			routerCircuits[node.Id]++
+		}
+		for _, link := range circuit.Path.Links {
+			linkCircuits[link.Id]++
+		}
+	}
+
+	routers := map[string]*TopologyRouter{}
+
+	listResult, err := network.Router.BaseList("true limit none")
+	if err != nil {
+		return nil, err
+	}
+
+	for _, router := range listResult.Entities {
+		routers[router.Id] = network.newTopologyRouter(router, routerCircuits)
+	}
+
+	// connected routers are authoritative, as they carry the version and connection details
+	for _, router := range network.Router.AllConnected() {
+		routers[router.Id] = network.newTopologyRouter(router, routerCircuits)
+	}
+
+	for _, router := range routers {
+		result.Routers = append(result.Routers, router)
+	}
+
+	sort.Slice(result.Routers, func(i, j int) bool {
+		if result.Routers[i].Name == result.Routers[j].Name {
+			return result.Routers[i].Id < result.Routers[j].Id
+		}
+		return result.Routers[i].Name < result.Routers[j].Name
+	})
+
+	for _, link := range network.Link.All() {
+		result.Links = append(result.Links, &TopologyLink{
+			Id:           link.Id,
+			SrcRouterId:  link.Src.Id,
+			DstRouterId:  link.DstId,
+			Protocol:     link.Protocol,
+			State:        link.CurrentState().Mode.String(),
+			Down:         link.IsDown(),
+			StaticCost:   link.GetStaticCost(),
+			Cost:         link.GetCost(),
+			SrcLatency:   link.GetSrcLatency(),
+			DstLatency:   link.GetDstLatency(),
+			CircuitCount: linkCircuits[link.Id],
+		})
+	}
+
+	sort.Slice(result.Links, func(i, j int) bool {
+		return result.Links[i].Id < result.Links[j].Id
+	})
+
+	return result, nil
+}
+
+func (network *Network) newTopologyRouter(router *model.Router, routerCircuits map[string]int) *TopologyRouter {
+	result := &TopologyRouter{
+		Id:           router.Id,
+		Name:         router.Name,
+		Connected:    router.Connected.Load(),
+		Cost:         router.Cost,
+		NoTraversal:  router.NoTraversal,
+		Disabled:     router.Disabled,
+		Draining:     network.Drains.IsDraining(router.Id),
+		CircuitCount: routerCircuits[router.Id],
+	}
+
+	if result.Connected {
+		result.ConnectedController = network.GetAppId()
+		if !router.ConnectTime.IsZero() {
+			connectTime := router.ConnectTime
+			result.ConnectedSince = &connectTime
+		}
+	}
+
+	if router.VersionInfo != nil {
+		result.Version = router.VersionInfo.Version
+	}
+
+	return result
+}
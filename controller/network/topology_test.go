@@ -0,0 +1,79 @@
+/*
+	Copyright NetFoundry Inc.
+
+	Licensed under the Apache License, Version 2.0 (the "License");
+	you may not use this file except in compliance with the License.
+	You may obtain a copy of the License at
+
+	https://www.apache.org/licenses/LICENSE-2.0
+
+	Unless required by applicable law or agreed to in writing, software
+	distributed under the License is distributed on an "AS IS" BASIS,
+	WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+	See the License for the specific language governing permissions and
+	limitations under the License.
+*/
+
+package network
+
+import (
+	"testing"
+
+	"github.com/openziti/ziti/controller/change"
+	"github.com/openziti/ziti/controller/model"
+	"github.com/openziti/ziti/controller/models"
+)
+
+func TestGetTopology(t *testing.T) {
+	ctx := model.NewTestContext(t)
+	defer ctx.Cleanup()
+
+	network, routers := newDrainTestNetwork(t, ctx)
+	r0, r1, r3 := routers[0], routers[1], routers[3]
+
+	offline := &model.Router{
+		BaseEntity: models.BaseEntity{Id: "offline"},
+		Name:       "offline",
+		Cost:       5,
+	}
+	ctx.NoError(network.Router.Create(offline, change.New()))
+
+	l0, _ := network.Link.Get("l0")
+	l2, _ := network.Link.Get("l2")
+	network.Circuit.Add(&model.Circuit{Id: "c1", Path: &model.Path{Nodes: []*model.Router{r0, r1, r3}, Links: []*model.Link{l0, l2}}})
+	network.Circuit.Add(&model.Circuit{Id: "c2", Path: &model.Path{Nodes: []*model.Router{r0, r1}, Links: []*model.Link{l0}}})
+
+	network.Drains.drains[r1.Id] = &RouterDrain{RouterId: r1.Id, State: RouterDrainRerouting}
+
+	topology, err := network.GetTopology()
+	ctx.NoError(err)
+	ctx.Equal(network.GetAppId(), topology.ControllerId)
+
+	ctx.Len(topology.Routers, 5)
+	routersById := map[string]*TopologyRouter{}
+	for _, router := range topology.Routers {
+		routersById[router.Id] = router
+	}
+
+	ctx.Equal("offline", topology.Routers[0].Name)
+	ctx.False(routersById["offline"].Connected)
+	ctx.Equal("", routersById["offline"].ConnectedController)
+	ctx.Equal(uint16(5), routersById["offline"].Cost)
+
+	ctx.True(routersById[r0.Id].Connected)
+	ctx.Equal(network.GetAppId(), routersById[r0.Id].ConnectedController)
+	ctx.Equal(2, routersById[r0.Id].CircuitCount)
+	ctx.Equal(2, routersById[r1.Id].CircuitCount)
+	ctx.Equal(1, routersById[r3.Id].CircuitCount)
+	ctx.True(routersById[r1.Id].Draining)
+	ctx.False(routersById[r0.Id].Draining)
+
+	ctx.Len(topology.Links, 4)
+	ctx.Equal("l0", topology.Links[0].Id)
+	ctx.Equal(r0.Id, topology.Links[0].SrcRouterId)
+	ctx.Equal(r1.Id, topology.Links[0].DstRouterId)
+	ctx.Equal(model.Connected.String(), topology.Links[0].State)
+	ctx.Equal(2, topology.Links[0].CircuitCount)
+	ctx.Equal(0, topology.Links[1].CircuitCount)
+	ctx.Equal(1, topology.Links[2].CircuitCount)
+}
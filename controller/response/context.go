@@ -49,8 +49,10 @@ type RequestContext struct {
 	AuthPolicy        *model.AuthPolicy
 	AuthQueries       rest_model.AuthQueryList
 	ActivePermissions []string
-	ResponseWriter    http.ResponseWriter
-	Request           *http.Request
+	// ListScope, if set, limits list results to entities with at least one of the given role attributes
+	ListScope      []string
+	ResponseWriter http.ResponseWriter
+	Request        *http.Request
 
 	entityId    string
 	entitySubId string
@@ -122,3 +124,7 @@ func (rc *RequestContext) NewChangeContext() *change.Context {
 	}
 	return changeCtx
 }
+
+func (rc *RequestContext) GetActivePermissions() []string {
+	return rc.ActivePermissions
+}
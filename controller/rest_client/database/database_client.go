@@ -56,6 +56,8 @@ type ClientOption func(*runtime.ClientOperation)
 type ClientService interface {
 	CheckDataIntegrity(params *CheckDataIntegrityParams, authInfo runtime.ClientAuthInfoWriter, opts ...ClientOption) (*CheckDataIntegrityAccepted, error)
 
+	CreateDatabaseBackup(params *CreateDatabaseBackupParams, authInfo runtime.ClientAuthInfoWriter, opts ...ClientOption) (*CreateDatabaseBackupOK, error)
+
 	CreateDatabaseSnapshot(params *CreateDatabaseSnapshotParams, authInfo runtime.ClientAuthInfoWriter, opts ...ClientOption) (*CreateDatabaseSnapshotOK, error)
 
 	CreateDatabaseSnapshotWithPath(params *CreateDatabaseSnapshotWithPathParams, opts ...ClientOption) (*CreateDatabaseSnapshotWithPathOK, error)
@@ -64,13 +66,15 @@ type ClientService interface {
 
 	FixDataIntegrity(params *FixDataIntegrityParams, authInfo runtime.ClientAuthInfoWriter, opts ...ClientOption) (*FixDataIntegrityAccepted, error)
 
+	ListDatabaseBackups(params *ListDatabaseBackupsParams, authInfo runtime.ClientAuthInfoWriter, opts ...ClientOption) (*ListDatabaseBackupsOK, error)
+
 	SetTransport(transport runtime.ClientTransport)
 }
 
 /*
-  CheckDataIntegrity starts a data integrity scan on the datastore
+CheckDataIntegrity starts a data integrity scan on the datastore
 
-  Starts a data integrity scan on the datastore. Requires admin access. Only once instance may run at a time, including runs of fixDataIntegrity.
+Starts a data integrity scan on the datastore. Requires admin access. Only once instance may run at a time, including runs of fixDataIntegrity.
 */
 func (a *Client) CheckDataIntegrity(params *CheckDataIntegrityParams, authInfo runtime.ClientAuthInfoWriter, opts ...ClientOption) (*CheckDataIntegrityAccepted, error) {
 	// TODO: Validate the params before sending
@@ -109,9 +113,52 @@ func (a *Client) CheckDataIntegrity(params *CheckDataIntegrityParams, authInfo r
 }
 
 /*
-  CreateDatabaseSnapshot creates a new database snapshot
+	CreateDatabaseBackup takes a database backup now
+
+	Takes a database backup and writes it to the configured backup target, then removes backups beyond the
+
+retention limit. Returns once the backup has been written. Requires admin access.
+*/
+func (a *Client) CreateDatabaseBackup(params *CreateDatabaseBackupParams, authInfo runtime.ClientAuthInfoWriter, opts ...ClientOption) (*CreateDatabaseBackupOK, error) {
+	// TODO: Validate the params before sending
+	if params == nil {
+		params = NewCreateDatabaseBackupParams()
+	}
+	op := &runtime.ClientOperation{
+		ID:                 "createDatabaseBackup",
+		Method:             "POST",
+		PathPattern:        "/database/backups",
+		ProducesMediaTypes: []string{"application/json"},
+		ConsumesMediaTypes: []string{"application/json"},
+		Schemes:            []string{"https"},
+		Params:             params,
+		Reader:             &CreateDatabaseBackupReader{formats: a.formats},
+		AuthInfo:           authInfo,
+		Context:            params.Context,
+		Client:             params.HTTPClient,
+	}
+	for _, opt := range opts {
+		opt(op)
+	}
+
+	result, err := a.transport.Submit(op)
+	if err != nil {
+		return nil, err
+	}
+	success, ok := result.(*CreateDatabaseBackupOK)
+	if ok {
+		return success, nil
+	}
+	// unexpected success response
+	// safeguard: normally, absent a default response, unknown success responses return an error above: so this is a codegen issue
+	msg := fmt.Sprintf("unexpected success response for createDatabaseBackup: API contract not enforced by server. Client expected to get an error, but got: %T", result)
+	panic(msg)
+}
+
+/*
+CreateDatabaseSnapshot creates a new database snapshot
 
-  Create a new database snapshot. Requires admin access.
+Create a new database snapshot. Requires admin access.
 */
 func (a *Client) CreateDatabaseSnapshot(params *CreateDatabaseSnapshotParams, authInfo runtime.ClientAuthInfoWriter, opts ...ClientOption) (*CreateDatabaseSnapshotOK, error) {
 	// TODO: Validate the params before sending
@@ -150,9 +197,9 @@ func (a *Client) CreateDatabaseSnapshot(params *CreateDatabaseSnapshotParams, au
 }
 
 /*
-  CreateDatabaseSnapshotWithPath creates a new database snapshot with path
+CreateDatabaseSnapshotWithPath creates a new database snapshot with path
 
-  Create a new database snapshot with path. Requires admin access.
+Create a new database snapshot with path. Requires admin access.
 */
 func (a *Client) CreateDatabaseSnapshotWithPath(params *CreateDatabaseSnapshotWithPathParams, opts ...ClientOption) (*CreateDatabaseSnapshotWithPathOK, error) {
 	// TODO: Validate the params before sending
@@ -190,9 +237,9 @@ func (a *Client) CreateDatabaseSnapshotWithPath(params *CreateDatabaseSnapshotWi
 }
 
 /*
-  DataIntegrityResults returns any results found from in progress integrity checks
+DataIntegrityResults returns any results found from in progress integrity checks
 
-  Returns any results found from in-progress integrity checks. Requires admin access.
+Returns any results found from in-progress integrity checks. Requires admin access.
 */
 func (a *Client) DataIntegrityResults(params *DataIntegrityResultsParams, authInfo runtime.ClientAuthInfoWriter, opts ...ClientOption) (*DataIntegrityResultsOK, error) {
 	// TODO: Validate the params before sending
@@ -231,9 +278,9 @@ func (a *Client) DataIntegrityResults(params *DataIntegrityResultsParams, authIn
 }
 
 /*
-  FixDataIntegrity runs a data integrity scan on the datastore attempts to fix any issues it can and returns any found issues
+FixDataIntegrity runs a data integrity scan on the datastore attempts to fix any issues it can and returns any found issues
 
-  Runs a data integrity scan on the datastore, attempts to fix any issues it can, and returns any found issues. Requires admin access. Only once instance may run at a time, including runs of checkDataIntegrity.
+Runs a data integrity scan on the datastore, attempts to fix any issues it can, and returns any found issues. Requires admin access. Only once instance may run at a time, including runs of checkDataIntegrity.
 */
 func (a *Client) FixDataIntegrity(params *FixDataIntegrityParams, authInfo runtime.ClientAuthInfoWriter, opts ...ClientOption) (*FixDataIntegrityAccepted, error) {
 	// TODO: Validate the params before sending
@@ -271,6 +318,49 @@ func (a *Client) FixDataIntegrity(params *FixDataIntegrityParams, authInfo runti
 	panic(msg)
 }
 
+/*
+	ListDatabaseBackups returns the status of scheduled database backups and the backups currently retained
+
+	Returns the backup schedule, the outcome of the most recent backup taken by this controller and the backups
+
+currently stored in the backup target, newest first. Requires admin access.
+*/
+func (a *Client) ListDatabaseBackups(params *ListDatabaseBackupsParams, authInfo runtime.ClientAuthInfoWriter, opts ...ClientOption) (*ListDatabaseBackupsOK, error) {
+	// TODO: Validate the params before sending
+	if params == nil {
+		params = NewListDatabaseBackupsParams()
+	}
+	op := &runtime.ClientOperation{
+		ID:                 "listDatabaseBackups",
+		Method:             "GET",
+		PathPattern:        "/database/backups",
+		ProducesMediaTypes: []string{"application/json"},
+		ConsumesMediaTypes: []string{"application/json"},
+		Schemes:            []string{"https"},
+		Params:             params,
+		Reader:             &ListDatabaseBackupsReader{formats: a.formats},
+		AuthInfo:           authInfo,
+		Context:            params.Context,
+		Client:             params.HTTPClient,
+	}
+	for _, opt := range opts {
+		opt(op)
+	}
+
+	result, err := a.transport.Submit(op)
+	if err != nil {
+		return nil, err
+	}
+	success, ok := result.(*ListDatabaseBackupsOK)
+	if ok {
+		return success, nil
+	}
+	// unexpected success response
+	// safeguard: normally, absent a default response, unknown success responses return an error above: so this is a codegen issue
+	msg := fmt.Sprintf("unexpected success response for listDatabaseBackups: API contract not enforced by server. Client expected to get an error, but got: %T", result)
+	panic(msg)
+}
+
 // SetTransport changes the transport on the client
 func (a *Client) SetTransport(transport runtime.ClientTransport) {
 	a.transport = transport
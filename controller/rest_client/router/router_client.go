@@ -54,12 +54,20 @@ type ClientOption func(*runtime.ClientOperation)
 
 // ClientService is the interface for Client methods
 type ClientService interface {
+	CancelRouterDrain(params *CancelRouterDrainParams, opts ...ClientOption) (*CancelRouterDrainOK, error)
+
 	CreateRouter(params *CreateRouterParams, opts ...ClientOption) (*CreateRouterCreated, error)
 
 	DeleteRouter(params *DeleteRouterParams, opts ...ClientOption) (*DeleteRouterOK, error)
 
 	DetailRouter(params *DetailRouterParams, opts ...ClientOption) (*DetailRouterOK, error)
 
+	DetailRouterDrain(params *DetailRouterDrainParams, opts ...ClientOption) (*DetailRouterDrainOK, error)
+
+	DrainRouter(params *DrainRouterParams, opts ...ClientOption) (*DrainRouterAccepted, error)
+
+	ListRouterDrains(params *ListRouterDrainsParams, opts ...ClientOption) (*ListRouterDrainsOK, error)
+
 	ListRouterTerminators(params *ListRouterTerminatorsParams, opts ...ClientOption) (*ListRouterTerminatorsOK, error)
 
 	ListRouters(params *ListRoutersParams, opts ...ClientOption) (*ListRoutersOK, error)
@@ -72,9 +80,49 @@ type ClientService interface {
 }
 
 /*
-  CreateRouter creates a router resource
+CancelRouterDrain cancels a router drain
 
-  Create a router resource. Requires admin access.
+Cancels the drain of the given router, allowing it to be used for transit again. Doesn't dequiesce the router. Requires admin access.
+*/
+func (a *Client) CancelRouterDrain(params *CancelRouterDrainParams, opts ...ClientOption) (*CancelRouterDrainOK, error) {
+	// TODO: Validate the params before sending
+	if params == nil {
+		params = NewCancelRouterDrainParams()
+	}
+	op := &runtime.ClientOperation{
+		ID:                 "cancelRouterDrain",
+		Method:             "DELETE",
+		PathPattern:        "/routers/{id}/drain",
+		ProducesMediaTypes: []string{"application/json"},
+		ConsumesMediaTypes: []string{"application/json"},
+		Schemes:            []string{"https"},
+		Params:             params,
+		Reader:             &CancelRouterDrainReader{formats: a.formats},
+		Context:            params.Context,
+		Client:             params.HTTPClient,
+	}
+	for _, opt := range opts {
+		opt(op)
+	}
+
+	result, err := a.transport.Submit(op)
+	if err != nil {
+		return nil, err
+	}
+	success, ok := result.(*CancelRouterDrainOK)
+	if ok {
+		return success, nil
+	}
+	// unexpected success response
+	// safeguard: normally, absent a default response, unknown success responses return an error above: so this is a codegen issue
+	msg := fmt.Sprintf("unexpected success response for cancelRouterDrain: API contract not enforced by server. Client expected to get an error, but got: %T", result)
+	panic(msg)
+}
+
+/*
+CreateRouter creates a router resource
+
+Create a router resource. Requires admin access.
 */
 func (a *Client) CreateRouter(params *CreateRouterParams, opts ...ClientOption) (*CreateRouterCreated, error) {
 	// TODO: Validate the params before sending
@@ -112,9 +160,9 @@ func (a *Client) CreateRouter(params *CreateRouterParams, opts ...ClientOption)
 }
 
 /*
-  DeleteRouter deletes a router
+DeleteRouter deletes a router
 
-  Delete a router by id. Requires admin access.
+Delete a router by id. Requires admin access.
 */
 func (a *Client) DeleteRouter(params *DeleteRouterParams, opts ...ClientOption) (*DeleteRouterOK, error) {
 	// TODO: Validate the params before sending
@@ -152,9 +200,9 @@ func (a *Client) DeleteRouter(params *DeleteRouterParams, opts ...ClientOption)
 }
 
 /*
-  DetailRouter retrieves a single router
+DetailRouter retrieves a single router
 
-  Retrieves a single router by id. Requires admin access.
+Retrieves a single router by id. Requires admin access.
 */
 func (a *Client) DetailRouter(params *DetailRouterParams, opts ...ClientOption) (*DetailRouterOK, error) {
 	// TODO: Validate the params before sending
@@ -192,10 +240,133 @@ func (a *Client) DetailRouter(params *DetailRouterParams, opts ...ClientOption)
 }
 
 /*
-  ListRouterTerminators lists of terminators assigned to a router
+DetailRouterDrain retrieves the drain status of a router
+
+Retrieves the progress of the drain of the given router, including circuits which couldn't be moved. Requires admin access.
+*/
+func (a *Client) DetailRouterDrain(params *DetailRouterDrainParams, opts ...ClientOption) (*DetailRouterDrainOK, error) {
+	// TODO: Validate the params before sending
+	if params == nil {
+		params = NewDetailRouterDrainParams()
+	}
+	op := &runtime.ClientOperation{
+		ID:                 "detailRouterDrain",
+		Method:             "GET",
+		PathPattern:        "/routers/{id}/drain",
+		ProducesMediaTypes: []string{"application/json"},
+		ConsumesMediaTypes: []string{"application/json"},
+		Schemes:            []string{"https"},
+		Params:             params,
+		Reader:             &DetailRouterDrainReader{formats: a.formats},
+		Context:            params.Context,
+		Client:             params.HTTPClient,
+	}
+	for _, opt := range opts {
+		opt(op)
+	}
+
+	result, err := a.transport.Submit(op)
+	if err != nil {
+		return nil, err
+	}
+	success, ok := result.(*DetailRouterDrainOK)
+	if ok {
+		return success, nil
+	}
+	// unexpected success response
+	// safeguard: normally, absent a default response, unknown success responses return an error above: so this is a codegen issue
+	msg := fmt.Sprintf("unexpected success response for detailRouterDrain: API contract not enforced by server. Client expected to get an error, but got: %T", result)
+	panic(msg)
+}
 
-  Retrieves a list of terminator resources that are assigned specific router; supports filtering, sorting, and pagination.
+/*
+	DrainRouter drains a router
 
+	Starts draining the given router. The router stops being used for transit when paths are calculated and the
+
+circuits which pass through it are rerouted. The drain can optionally quiesce the router and wait for circuits
+which start or end at the router to finish. Drains are tracked in memory by the controller which accepted the
+request. Requires admin access.
+*/
+func (a *Client) DrainRouter(params *DrainRouterParams, opts ...ClientOption) (*DrainRouterAccepted, error) {
+	// TODO: Validate the params before sending
+	if params == nil {
+		params = NewDrainRouterParams()
+	}
+	op := &runtime.ClientOperation{
+		ID:                 "drainRouter",
+		Method:             "POST",
+		PathPattern:        "/routers/{id}/drain",
+		ProducesMediaTypes: []string{"application/json"},
+		ConsumesMediaTypes: []string{"application/json"},
+		Schemes:            []string{"https"},
+		Params:             params,
+		Reader:             &DrainRouterReader{formats: a.formats},
+		Context:            params.Context,
+		Client:             params.HTTPClient,
+	}
+	for _, opt := range opts {
+		opt(op)
+	}
+
+	result, err := a.transport.Submit(op)
+	if err != nil {
+		return nil, err
+	}
+	success, ok := result.(*DrainRouterAccepted)
+	if ok {
+		return success, nil
+	}
+	// unexpected success response
+	// safeguard: normally, absent a default response, unknown success responses return an error above: so this is a codegen issue
+	msg := fmt.Sprintf("unexpected success response for drainRouter: API contract not enforced by server. Client expected to get an error, but got: %T", result)
+	panic(msg)
+}
+
+/*
+ListRouterDrains lists router drains
+
+Retrieves the in progress and completed router drains on this controller. Requires admin access.
+*/
+func (a *Client) ListRouterDrains(params *ListRouterDrainsParams, opts ...ClientOption) (*ListRouterDrainsOK, error) {
+	// TODO: Validate the params before sending
+	if params == nil {
+		params = NewListRouterDrainsParams()
+	}
+	op := &runtime.ClientOperation{
+		ID:                 "listRouterDrains",
+		Method:             "GET",
+		PathPattern:        "/router-drains",
+		ProducesMediaTypes: []string{"application/json"},
+		ConsumesMediaTypes: []string{"application/json"},
+		Schemes:            []string{"https"},
+		Params:             params,
+		Reader:             &ListRouterDrainsReader{formats: a.formats},
+		Context:            params.Context,
+		Client:             params.HTTPClient,
+	}
+	for _, opt := range opts {
+		opt(op)
+	}
+
+	result, err := a.transport.Submit(op)
+	if err != nil {
+		return nil, err
+	}
+	success, ok := result.(*ListRouterDrainsOK)
+	if ok {
+		return success, nil
+	}
+	// unexpected success response
+	// safeguard: normally, absent a default response, unknown success responses return an error above: so this is a codegen issue
+	msg := fmt.Sprintf("unexpected success response for listRouterDrains: API contract not enforced by server. Client expected to get an error, but got: %T", result)
+	panic(msg)
+}
+
+/*
+ListRouterTerminators lists of terminators assigned to a router
+
+Retrieves a list of terminator resources that are assigned specific router; supports filtering, sorting, and pagination.
 */
 func (a *Client) ListRouterTerminators(params *ListRouterTerminatorsParams, opts ...ClientOption) (*ListRouterTerminatorsOK, error) {
 	// TODO: Validate the params before sending
@@ -233,10 +404,9 @@ func (a *Client) ListRouterTerminators(params *ListRouterTerminatorsParams, opts
 }
 
 /*
-  ListRouters lists routers
-
-  Retrieves a list of router resources; supports filtering, sorting, and pagination. Requires admin access.
+ListRouters lists routers
 
+Retrieves a list of router resources; supports filtering, sorting, and pagination. Requires admin access.
 */
 func (a *Client) ListRouters(params *ListRoutersParams, opts ...ClientOption) (*ListRoutersOK, error) {
 	// TODO: Validate the params before sending
@@ -274,9 +444,9 @@ func (a *Client) ListRouters(params *ListRoutersParams, opts ...ClientOption) (*
 }
 
 /*
-  PatchRouter updates the supplied fields on a router
+PatchRouter updates the supplied fields on a router
 
-  Update the supplied fields on a router. Requires admin access.
+Update the supplied fields on a router. Requires admin access.
 */
 func (a *Client) PatchRouter(params *PatchRouterParams, opts ...ClientOption) (*PatchRouterOK, error) {
 	// TODO: Validate the params before sending
@@ -314,9 +484,9 @@ func (a *Client) PatchRouter(params *PatchRouterParams, opts ...ClientOption) (*
 }
 
 /*
-  UpdateRouter updates all fields on a router
+UpdateRouter updates all fields on a router
 
-  Update all fields on a router by id. Requires admin access.
+Update all fields on a router by id. Requires admin access.
 */
 func (a *Client) UpdateRouter(params *UpdateRouterParams, opts ...ClientOption) (*UpdateRouterOK, error) {
 	// TODO: Validate the params before sending
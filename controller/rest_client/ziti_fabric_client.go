@@ -34,14 +34,26 @@ import (
 	httptransport "github.com/go-openapi/runtime/client"
 	"github.com/go-openapi/strfmt"
 
+	"github.com/openziti/ziti/controller/rest_client/alert"
+	"github.com/openziti/ziti/controller/rest_client/audit"
+	"github.com/openziti/ziti/controller/rest_client/bandwidth_limit"
+	"github.com/openziti/ziti/controller/rest_client/bulk_operation"
 	"github.com/openziti/ziti/controller/rest_client/circuit"
+	"github.com/openziti/ziti/controller/rest_client/circuit_history"
 	"github.com/openziti/ziti/controller/rest_client/cluster"
 	"github.com/openziti/ziti/controller/rest_client/database"
+	"github.com/openziti/ziti/controller/rest_client/enrollment_signer"
 	"github.com/openziti/ziti/controller/rest_client/inspect"
 	"github.com/openziti/ziti/controller/rest_client/link"
+	"github.com/openziti/ziti/controller/rest_client/policy_simulation"
+	"github.com/openziti/ziti/controller/rest_client/probe"
+	"github.com/openziti/ziti/controller/rest_client/quota"
+	"github.com/openziti/ziti/controller/rest_client/role"
 	"github.com/openziti/ziti/controller/rest_client/router"
+	"github.com/openziti/ziti/controller/rest_client/router_cert_rotation"
 	"github.com/openziti/ziti/controller/rest_client/service"
 	"github.com/openziti/ziti/controller/rest_client/terminator"
+	"github.com/openziti/ziti/controller/rest_client/topology"
 )
 
 // Default ziti fabric HTTP client.
@@ -86,14 +98,26 @@ func New(transport runtime.ClientTransport, formats strfmt.Registry) *ZitiFabric
 
 	cli := new(ZitiFabric)
 	cli.Transport = transport
+	cli.Alert = alert.New(transport, formats)
+	cli.Audit = audit.New(transport, formats)
+	cli.BandwidthLimit = bandwidth_limit.New(transport, formats)
+	cli.BulkOperation = bulk_operation.New(transport, formats)
 	cli.Circuit = circuit.New(transport, formats)
+	cli.CircuitHistory = circuit_history.New(transport, formats)
 	cli.Cluster = cluster.New(transport, formats)
 	cli.Database = database.New(transport, formats)
+	cli.EnrollmentSigner = enrollment_signer.New(transport, formats)
 	cli.Inspect = inspect.New(transport, formats)
 	cli.Link = link.New(transport, formats)
+	cli.PolicySimulation = policy_simulation.New(transport, formats)
+	cli.Probe = probe.New(transport, formats)
+	cli.Quota = quota.New(transport, formats)
+	cli.Role = role.New(transport, formats)
 	cli.Router = router.New(transport, formats)
+	cli.RouterCertRotation = router_cert_rotation.New(transport, formats)
 	cli.Service = service.New(transport, formats)
 	cli.Terminator = terminator.New(transport, formats)
+	cli.Topology = topology.New(transport, formats)
 	return cli
 }
 
@@ -138,34 +162,70 @@ func (cfg *TransportConfig) WithSchemes(schemes []string) *TransportConfig {
 
 // ZitiFabric is a client for ziti fabric
 type ZitiFabric struct {
+	Alert alert.ClientService
+
+	Audit audit.ClientService
+
+	BandwidthLimit bandwidth_limit.ClientService
+
+	BulkOperation bulk_operation.ClientService
+
 	Circuit circuit.ClientService
 
+	CircuitHistory circuit_history.ClientService
+
 	Cluster cluster.ClientService
 
 	Database database.ClientService
 
+	EnrollmentSigner enrollment_signer.ClientService
+
 	Inspect inspect.ClientService
 
 	Link link.ClientService
 
+	PolicySimulation policy_simulation.ClientService
+
+	Probe probe.ClientService
+
+	Quota quota.ClientService
+
+	Role role.ClientService
+
 	Router router.ClientService
 
+	RouterCertRotation router_cert_rotation.ClientService
+
 	Service service.ClientService
 
 	Terminator terminator.ClientService
 
+	Topology topology.ClientService
+
 	Transport runtime.ClientTransport
 }
 
 // SetTransport changes the transport on the client and all its subresources
 func (c *ZitiFabric) SetTransport(transport runtime.ClientTransport) {
 	c.Transport = transport
+	c.Alert.SetTransport(transport)
+	c.Audit.SetTransport(transport)
+	c.BandwidthLimit.SetTransport(transport)
+	c.BulkOperation.SetTransport(transport)
 	c.Circuit.SetTransport(transport)
+	c.CircuitHistory.SetTransport(transport)
 	c.Cluster.SetTransport(transport)
 	c.Database.SetTransport(transport)
+	c.EnrollmentSigner.SetTransport(transport)
 	c.Inspect.SetTransport(transport)
 	c.Link.SetTransport(transport)
+	c.PolicySimulation.SetTransport(transport)
+	c.Probe.SetTransport(transport)
+	c.Quota.SetTransport(transport)
+	c.Role.SetTransport(transport)
 	c.Router.SetTransport(transport)
+	c.RouterCertRotation.SetTransport(transport)
 	c.Service.SetTransport(transport)
 	c.Terminator.SetTransport(transport)
+	c.Topology.SetTransport(transport)
 }
@@ -43,11 +43,23 @@ import (
 // swagger:model path
 type Path struct {
 
+	// The local address of the initiating connection, for intercepted connections the intercepted address
+	InitiatorLocalAddr string `json:"initiatorLocalAddr,omitempty"`
+
+	// The remote address of the initiating connection
+	InitiatorRemoteAddr string `json:"initiatorRemoteAddr,omitempty"`
+
 	// links
 	Links []*EntityRef `json:"links"`
 
 	// nodes
 	Nodes []*EntityRef `json:"nodes"`
+
+	// The local address of the terminating connection
+	TerminatorLocalAddr string `json:"terminatorLocalAddr,omitempty"`
+
+	// The remote address of the terminating connection
+	TerminatorRemoteAddr string `json:"terminatorRemoteAddr,omitempty"`
 }
 
 // Validate validates this path
@@ -35,14 +35,26 @@ import (
 	"github.com/go-openapi/runtime/middleware"
 
 	"github.com/openziti/ziti/controller/rest_server/operations"
+	"github.com/openziti/ziti/controller/rest_server/operations/alert"
+	"github.com/openziti/ziti/controller/rest_server/operations/audit"
+	"github.com/openziti/ziti/controller/rest_server/operations/bandwidth_limit"
+	"github.com/openziti/ziti/controller/rest_server/operations/bulk_operation"
 	"github.com/openziti/ziti/controller/rest_server/operations/circuit"
+	"github.com/openziti/ziti/controller/rest_server/operations/circuit_history"
 	"github.com/openziti/ziti/controller/rest_server/operations/cluster"
 	"github.com/openziti/ziti/controller/rest_server/operations/database"
+	"github.com/openziti/ziti/controller/rest_server/operations/enrollment_signer"
 	"github.com/openziti/ziti/controller/rest_server/operations/inspect"
 	"github.com/openziti/ziti/controller/rest_server/operations/link"
+	"github.com/openziti/ziti/controller/rest_server/operations/policy_simulation"
+	"github.com/openziti/ziti/controller/rest_server/operations/probe"
+	"github.com/openziti/ziti/controller/rest_server/operations/quota"
+	"github.com/openziti/ziti/controller/rest_server/operations/role"
 	"github.com/openziti/ziti/controller/rest_server/operations/router"
+	"github.com/openziti/ziti/controller/rest_server/operations/router_cert_rotation"
 	"github.com/openziti/ziti/controller/rest_server/operations/service"
 	"github.com/openziti/ziti/controller/rest_server/operations/terminator"
+	"github.com/openziti/ziti/controller/rest_server/operations/topology"
 )
 
 //go:generate swagger generate server --target ../../controller --name ZitiFabric --spec ../specs/swagger.yml --model-package rest_model --server-package rest_server --principal interface{} --exclude-main
@@ -69,6 +81,11 @@ func configureAPI(api *operations.ZitiFabricAPI) http.Handler {
 
 	api.JSONProducer = runtime.JSONProducer()
 
+	if api.RouterCancelRouterDrainHandler == nil {
+		api.RouterCancelRouterDrainHandler = router.CancelRouterDrainHandlerFunc(func(params router.CancelRouterDrainParams) middleware.Responder {
+			return middleware.NotImplemented("operation router.CancelRouterDrain has not yet been implemented")
+		})
+	}
 	if api.DatabaseCheckDataIntegrityHandler == nil {
 		api.DatabaseCheckDataIntegrityHandler = database.CheckDataIntegrityHandlerFunc(func(params database.CheckDataIntegrityParams, principal interface{}) middleware.Responder {
 			return middleware.NotImplemented("operation database.CheckDataIntegrity has not yet been implemented")
@@ -94,6 +111,26 @@ func configureAPI(api *operations.ZitiFabricAPI) http.Handler {
 			return middleware.NotImplemented("operation cluster.ClusterTransferLeadership has not yet been implemented")
 		})
 	}
+	if api.AlertCreateAlertSilenceHandler == nil {
+		api.AlertCreateAlertSilenceHandler = alert.CreateAlertSilenceHandlerFunc(func(params alert.CreateAlertSilenceParams) middleware.Responder {
+			return middleware.NotImplemented("operation alert.CreateAlertSilence has not yet been implemented")
+		})
+	}
+	if api.BandwidthLimitCreateBandwidthLimitHandler == nil {
+		api.BandwidthLimitCreateBandwidthLimitHandler = bandwidth_limit.CreateBandwidthLimitHandlerFunc(func(params bandwidth_limit.CreateBandwidthLimitParams) middleware.Responder {
+			return middleware.NotImplemented("operation bandwidth_limit.CreateBandwidthLimit has not yet been implemented")
+		})
+	}
+	if api.BulkOperationCreateBulkOperationHandler == nil {
+		api.BulkOperationCreateBulkOperationHandler = bulk_operation.CreateBulkOperationHandlerFunc(func(params bulk_operation.CreateBulkOperationParams) middleware.Responder {
+			return middleware.NotImplemented("operation bulk_operation.CreateBulkOperation has not yet been implemented")
+		})
+	}
+	if api.DatabaseCreateDatabaseBackupHandler == nil {
+		api.DatabaseCreateDatabaseBackupHandler = database.CreateDatabaseBackupHandlerFunc(func(params database.CreateDatabaseBackupParams, principal interface{}) middleware.Responder {
+			return middleware.NotImplemented("operation database.CreateDatabaseBackup has not yet been implemented")
+		})
+	}
 	if api.DatabaseCreateDatabaseSnapshotHandler == nil {
 		api.DatabaseCreateDatabaseSnapshotHandler = database.CreateDatabaseSnapshotHandlerFunc(func(params database.CreateDatabaseSnapshotParams, principal interface{}) middleware.Responder {
 			return middleware.NotImplemented("operation database.CreateDatabaseSnapshot has not yet been implemented")
@@ -104,11 +141,26 @@ func configureAPI(api *operations.ZitiFabricAPI) http.Handler {
 			return middleware.NotImplemented("operation database.CreateDatabaseSnapshotWithPath has not yet been implemented")
 		})
 	}
+	if api.QuotaCreateQuotaHandler == nil {
+		api.QuotaCreateQuotaHandler = quota.CreateQuotaHandlerFunc(func(params quota.CreateQuotaParams) middleware.Responder {
+			return middleware.NotImplemented("operation quota.CreateQuota has not yet been implemented")
+		})
+	}
+	if api.RoleCreateRoleHandler == nil {
+		api.RoleCreateRoleHandler = role.CreateRoleHandlerFunc(func(params role.CreateRoleParams) middleware.Responder {
+			return middleware.NotImplemented("operation role.CreateRole has not yet been implemented")
+		})
+	}
 	if api.RouterCreateRouterHandler == nil {
 		api.RouterCreateRouterHandler = router.CreateRouterHandlerFunc(func(params router.CreateRouterParams) middleware.Responder {
 			return middleware.NotImplemented("operation router.CreateRouter has not yet been implemented")
 		})
 	}
+	if api.RouterCertRotationCreateRouterCertRotationHandler == nil {
+		api.RouterCertRotationCreateRouterCertRotationHandler = router_cert_rotation.CreateRouterCertRotationHandlerFunc(func(params router_cert_rotation.CreateRouterCertRotationParams) middleware.Responder {
+			return middleware.NotImplemented("operation router_cert_rotation.CreateRouterCertRotation has not yet been implemented")
+		})
+	}
 	if api.ServiceCreateServiceHandler == nil {
 		api.ServiceCreateServiceHandler = service.CreateServiceHandlerFunc(func(params service.CreateServiceParams) middleware.Responder {
 			return middleware.NotImplemented("operation service.CreateService has not yet been implemented")
@@ -124,6 +176,16 @@ func configureAPI(api *operations.ZitiFabricAPI) http.Handler {
 			return middleware.NotImplemented("operation database.DataIntegrityResults has not yet been implemented")
 		})
 	}
+	if api.AlertDeleteAlertSilenceHandler == nil {
+		api.AlertDeleteAlertSilenceHandler = alert.DeleteAlertSilenceHandlerFunc(func(params alert.DeleteAlertSilenceParams) middleware.Responder {
+			return middleware.NotImplemented("operation alert.DeleteAlertSilence has not yet been implemented")
+		})
+	}
+	if api.BandwidthLimitDeleteBandwidthLimitHandler == nil {
+		api.BandwidthLimitDeleteBandwidthLimitHandler = bandwidth_limit.DeleteBandwidthLimitHandlerFunc(func(params bandwidth_limit.DeleteBandwidthLimitParams) middleware.Responder {
+			return middleware.NotImplemented("operation bandwidth_limit.DeleteBandwidthLimit has not yet been implemented")
+		})
+	}
 	if api.CircuitDeleteCircuitHandler == nil {
 		api.CircuitDeleteCircuitHandler = circuit.DeleteCircuitHandlerFunc(func(params circuit.DeleteCircuitParams) middleware.Responder {
 			return middleware.NotImplemented("operation circuit.DeleteCircuit has not yet been implemented")
@@ -134,6 +196,16 @@ func configureAPI(api *operations.ZitiFabricAPI) http.Handler {
 			return middleware.NotImplemented("operation link.DeleteLink has not yet been implemented")
 		})
 	}
+	if api.QuotaDeleteQuotaHandler == nil {
+		api.QuotaDeleteQuotaHandler = quota.DeleteQuotaHandlerFunc(func(params quota.DeleteQuotaParams) middleware.Responder {
+			return middleware.NotImplemented("operation quota.DeleteQuota has not yet been implemented")
+		})
+	}
+	if api.RoleDeleteRoleHandler == nil {
+		api.RoleDeleteRoleHandler = role.DeleteRoleHandlerFunc(func(params role.DeleteRoleParams) middleware.Responder {
+			return middleware.NotImplemented("operation role.DeleteRole has not yet been implemented")
+		})
+	}
 	if api.RouterDeleteRouterHandler == nil {
 		api.RouterDeleteRouterHandler = router.DeleteRouterHandlerFunc(func(params router.DeleteRouterParams) middleware.Responder {
 			return middleware.NotImplemented("operation router.DeleteRouter has not yet been implemented")
@@ -149,21 +221,71 @@ func configureAPI(api *operations.ZitiFabricAPI) http.Handler {
 			return middleware.NotImplemented("operation terminator.DeleteTerminator has not yet been implemented")
 		})
 	}
+	if api.BandwidthLimitDetailBandwidthLimitHandler == nil {
+		api.BandwidthLimitDetailBandwidthLimitHandler = bandwidth_limit.DetailBandwidthLimitHandlerFunc(func(params bandwidth_limit.DetailBandwidthLimitParams) middleware.Responder {
+			return middleware.NotImplemented("operation bandwidth_limit.DetailBandwidthLimit has not yet been implemented")
+		})
+	}
+	if api.BulkOperationDetailBulkOperationHandler == nil {
+		api.BulkOperationDetailBulkOperationHandler = bulk_operation.DetailBulkOperationHandlerFunc(func(params bulk_operation.DetailBulkOperationParams) middleware.Responder {
+			return middleware.NotImplemented("operation bulk_operation.DetailBulkOperation has not yet been implemented")
+		})
+	}
 	if api.CircuitDetailCircuitHandler == nil {
 		api.CircuitDetailCircuitHandler = circuit.DetailCircuitHandlerFunc(func(params circuit.DetailCircuitParams) middleware.Responder {
 			return middleware.NotImplemented("operation circuit.DetailCircuit has not yet been implemented")
 		})
 	}
+	if api.CircuitHistoryDetailCircuitHistoryHandler == nil {
+		api.CircuitHistoryDetailCircuitHistoryHandler = circuit_history.DetailCircuitHistoryHandlerFunc(func(params circuit_history.DetailCircuitHistoryParams) middleware.Responder {
+			return middleware.NotImplemented("operation circuit_history.DetailCircuitHistory has not yet been implemented")
+		})
+	}
+	if api.RoleDetailCurrentIdentityPermissionsHandler == nil {
+		api.RoleDetailCurrentIdentityPermissionsHandler = role.DetailCurrentIdentityPermissionsHandlerFunc(func(params role.DetailCurrentIdentityPermissionsParams) middleware.Responder {
+			return middleware.NotImplemented("operation role.DetailCurrentIdentityPermissions has not yet been implemented")
+		})
+	}
+	if api.EnrollmentSignerDetailEnrollmentSignerHandler == nil {
+		api.EnrollmentSignerDetailEnrollmentSignerHandler = enrollment_signer.DetailEnrollmentSignerHandlerFunc(func(params enrollment_signer.DetailEnrollmentSignerParams) middleware.Responder {
+			return middleware.NotImplemented("operation enrollment_signer.DetailEnrollmentSigner has not yet been implemented")
+		})
+	}
 	if api.LinkDetailLinkHandler == nil {
 		api.LinkDetailLinkHandler = link.DetailLinkHandlerFunc(func(params link.DetailLinkParams) middleware.Responder {
 			return middleware.NotImplemented("operation link.DetailLink has not yet been implemented")
 		})
 	}
+	if api.ProbeDetailProbeHandler == nil {
+		api.ProbeDetailProbeHandler = probe.DetailProbeHandlerFunc(func(params probe.DetailProbeParams) middleware.Responder {
+			return middleware.NotImplemented("operation probe.DetailProbe has not yet been implemented")
+		})
+	}
+	if api.QuotaDetailQuotaHandler == nil {
+		api.QuotaDetailQuotaHandler = quota.DetailQuotaHandlerFunc(func(params quota.DetailQuotaParams) middleware.Responder {
+			return middleware.NotImplemented("operation quota.DetailQuota has not yet been implemented")
+		})
+	}
+	if api.RoleDetailRoleHandler == nil {
+		api.RoleDetailRoleHandler = role.DetailRoleHandlerFunc(func(params role.DetailRoleParams) middleware.Responder {
+			return middleware.NotImplemented("operation role.DetailRole has not yet been implemented")
+		})
+	}
 	if api.RouterDetailRouterHandler == nil {
 		api.RouterDetailRouterHandler = router.DetailRouterHandlerFunc(func(params router.DetailRouterParams) middleware.Responder {
 			return middleware.NotImplemented("operation router.DetailRouter has not yet been implemented")
 		})
 	}
+	if api.RouterCertRotationDetailRouterCertRotationHandler == nil {
+		api.RouterCertRotationDetailRouterCertRotationHandler = router_cert_rotation.DetailRouterCertRotationHandlerFunc(func(params router_cert_rotation.DetailRouterCertRotationParams) middleware.Responder {
+			return middleware.NotImplemented("operation router_cert_rotation.DetailRouterCertRotation has not yet been implemented")
+		})
+	}
+	if api.RouterDetailRouterDrainHandler == nil {
+		api.RouterDetailRouterDrainHandler = router.DetailRouterDrainHandlerFunc(func(params router.DetailRouterDrainParams) middleware.Responder {
+			return middleware.NotImplemented("operation router.DetailRouterDrain has not yet been implemented")
+		})
+	}
 	if api.ServiceDetailServiceHandler == nil {
 		api.ServiceDetailServiceHandler = service.DetailServiceHandlerFunc(func(params service.DetailServiceParams) middleware.Responder {
 			return middleware.NotImplemented("operation service.DetailService has not yet been implemented")
@@ -174,6 +296,16 @@ func configureAPI(api *operations.ZitiFabricAPI) http.Handler {
 			return middleware.NotImplemented("operation terminator.DetailTerminator has not yet been implemented")
 		})
 	}
+	if api.TopologyDetailTopologyHandler == nil {
+		api.TopologyDetailTopologyHandler = topology.DetailTopologyHandlerFunc(func(params topology.DetailTopologyParams) middleware.Responder {
+			return middleware.NotImplemented("operation topology.DetailTopology has not yet been implemented")
+		})
+	}
+	if api.RouterDrainRouterHandler == nil {
+		api.RouterDrainRouterHandler = router.DrainRouterHandlerFunc(func(params router.DrainRouterParams) middleware.Responder {
+			return middleware.NotImplemented("operation router.DrainRouter has not yet been implemented")
+		})
+	}
 	if api.DatabaseFixDataIntegrityHandler == nil {
 		api.DatabaseFixDataIntegrityHandler = database.FixDataIntegrityHandlerFunc(func(params database.FixDataIntegrityParams, principal interface{}) middleware.Responder {
 			return middleware.NotImplemented("operation database.FixDataIntegrity has not yet been implemented")
@@ -184,16 +316,96 @@ func configureAPI(api *operations.ZitiFabricAPI) http.Handler {
 			return middleware.NotImplemented("operation inspect.Inspect has not yet been implemented")
 		})
 	}
+	if api.AlertListAlertRulesHandler == nil {
+		api.AlertListAlertRulesHandler = alert.ListAlertRulesHandlerFunc(func(params alert.ListAlertRulesParams) middleware.Responder {
+			return middleware.NotImplemented("operation alert.ListAlertRules has not yet been implemented")
+		})
+	}
+	if api.AlertListAlertSilencesHandler == nil {
+		api.AlertListAlertSilencesHandler = alert.ListAlertSilencesHandlerFunc(func(params alert.ListAlertSilencesParams) middleware.Responder {
+			return middleware.NotImplemented("operation alert.ListAlertSilences has not yet been implemented")
+		})
+	}
+	if api.AlertListAlertsHandler == nil {
+		api.AlertListAlertsHandler = alert.ListAlertsHandlerFunc(func(params alert.ListAlertsParams) middleware.Responder {
+			return middleware.NotImplemented("operation alert.ListAlerts has not yet been implemented")
+		})
+	}
+	if api.AuditListAuditEntriesHandler == nil {
+		api.AuditListAuditEntriesHandler = audit.ListAuditEntriesHandlerFunc(func(params audit.ListAuditEntriesParams) middleware.Responder {
+			return middleware.NotImplemented("operation audit.ListAuditEntries has not yet been implemented")
+		})
+	}
+	if api.BandwidthLimitListBandwidthLimitsHandler == nil {
+		api.BandwidthLimitListBandwidthLimitsHandler = bandwidth_limit.ListBandwidthLimitsHandlerFunc(func(params bandwidth_limit.ListBandwidthLimitsParams) middleware.Responder {
+			return middleware.NotImplemented("operation bandwidth_limit.ListBandwidthLimits has not yet been implemented")
+		})
+	}
+	if api.BulkOperationListBulkOperationsHandler == nil {
+		api.BulkOperationListBulkOperationsHandler = bulk_operation.ListBulkOperationsHandlerFunc(func(params bulk_operation.ListBulkOperationsParams) middleware.Responder {
+			return middleware.NotImplemented("operation bulk_operation.ListBulkOperations has not yet been implemented")
+		})
+	}
+	if api.CircuitHistoryListCircuitHistoryHandler == nil {
+		api.CircuitHistoryListCircuitHistoryHandler = circuit_history.ListCircuitHistoryHandlerFunc(func(params circuit_history.ListCircuitHistoryParams) middleware.Responder {
+			return middleware.NotImplemented("operation circuit_history.ListCircuitHistory has not yet been implemented")
+		})
+	}
 	if api.CircuitListCircuitsHandler == nil {
 		api.CircuitListCircuitsHandler = circuit.ListCircuitsHandlerFunc(func(params circuit.ListCircuitsParams) middleware.Responder {
 			return middleware.NotImplemented("operation circuit.ListCircuits has not yet been implemented")
 		})
 	}
+	if api.DatabaseListDatabaseBackupsHandler == nil {
+		api.DatabaseListDatabaseBackupsHandler = database.ListDatabaseBackupsHandlerFunc(func(params database.ListDatabaseBackupsParams, principal interface{}) middleware.Responder {
+			return middleware.NotImplemented("operation database.ListDatabaseBackups has not yet been implemented")
+		})
+	}
+	if api.EnrollmentSignerListEnrollmentSignersHandler == nil {
+		api.EnrollmentSignerListEnrollmentSignersHandler = enrollment_signer.ListEnrollmentSignersHandlerFunc(func(params enrollment_signer.ListEnrollmentSignersParams) middleware.Responder {
+			return middleware.NotImplemented("operation enrollment_signer.ListEnrollmentSigners has not yet been implemented")
+		})
+	}
 	if api.LinkListLinksHandler == nil {
 		api.LinkListLinksHandler = link.ListLinksHandlerFunc(func(params link.ListLinksParams) middleware.Responder {
 			return middleware.NotImplemented("operation link.ListLinks has not yet been implemented")
 		})
 	}
+	if api.ProbeListProbeResultsHandler == nil {
+		api.ProbeListProbeResultsHandler = probe.ListProbeResultsHandlerFunc(func(params probe.ListProbeResultsParams) middleware.Responder {
+			return middleware.NotImplemented("operation probe.ListProbeResults has not yet been implemented")
+		})
+	}
+	if api.ProbeListProbesHandler == nil {
+		api.ProbeListProbesHandler = probe.ListProbesHandlerFunc(func(params probe.ListProbesParams) middleware.Responder {
+			return middleware.NotImplemented("operation probe.ListProbes has not yet been implemented")
+		})
+	}
+	if api.QuotaListQuotaUsageHandler == nil {
+		api.QuotaListQuotaUsageHandler = quota.ListQuotaUsageHandlerFunc(func(params quota.ListQuotaUsageParams) middleware.Responder {
+			return middleware.NotImplemented("operation quota.ListQuotaUsage has not yet been implemented")
+		})
+	}
+	if api.QuotaListQuotasHandler == nil {
+		api.QuotaListQuotasHandler = quota.ListQuotasHandlerFunc(func(params quota.ListQuotasParams) middleware.Responder {
+			return middleware.NotImplemented("operation quota.ListQuotas has not yet been implemented")
+		})
+	}
+	if api.RoleListRolesHandler == nil {
+		api.RoleListRolesHandler = role.ListRolesHandlerFunc(func(params role.ListRolesParams) middleware.Responder {
+			return middleware.NotImplemented("operation role.ListRoles has not yet been implemented")
+		})
+	}
+	if api.RouterCertRotationListRouterCertRotationsHandler == nil {
+		api.RouterCertRotationListRouterCertRotationsHandler = router_cert_rotation.ListRouterCertRotationsHandlerFunc(func(params router_cert_rotation.ListRouterCertRotationsParams) middleware.Responder {
+			return middleware.NotImplemented("operation router_cert_rotation.ListRouterCertRotations has not yet been implemented")
+		})
+	}
+	if api.RouterListRouterDrainsHandler == nil {
+		api.RouterListRouterDrainsHandler = router.ListRouterDrainsHandlerFunc(func(params router.ListRouterDrainsParams) middleware.Responder {
+			return middleware.NotImplemented("operation router.ListRouterDrains has not yet been implemented")
+		})
+	}
 	if api.RouterListRouterTerminatorsHandler == nil {
 		api.RouterListRouterTerminatorsHandler = router.ListRouterTerminatorsHandlerFunc(func(params router.ListRouterTerminatorsParams) middleware.Responder {
 			return middleware.NotImplemented("operation router.ListRouterTerminators has not yet been implemented")
@@ -204,6 +416,11 @@ func configureAPI(api *operations.ZitiFabricAPI) http.Handler {
 			return middleware.NotImplemented("operation router.ListRouters has not yet been implemented")
 		})
 	}
+	if api.AuditListServiceHistoryHandler == nil {
+		api.AuditListServiceHistoryHandler = audit.ListServiceHistoryHandlerFunc(func(params audit.ListServiceHistoryParams) middleware.Responder {
+			return middleware.NotImplemented("operation audit.ListServiceHistory has not yet been implemented")
+		})
+	}
 	if api.ServiceListServiceTerminatorsHandler == nil {
 		api.ServiceListServiceTerminatorsHandler = service.ListServiceTerminatorsHandlerFunc(func(params service.ListServiceTerminatorsParams) middleware.Responder {
 			return middleware.NotImplemented("operation service.ListServiceTerminators has not yet been implemented")
@@ -219,11 +436,26 @@ func configureAPI(api *operations.ZitiFabricAPI) http.Handler {
 			return middleware.NotImplemented("operation terminator.ListTerminators has not yet been implemented")
 		})
 	}
+	if api.BandwidthLimitPatchBandwidthLimitHandler == nil {
+		api.BandwidthLimitPatchBandwidthLimitHandler = bandwidth_limit.PatchBandwidthLimitHandlerFunc(func(params bandwidth_limit.PatchBandwidthLimitParams) middleware.Responder {
+			return middleware.NotImplemented("operation bandwidth_limit.PatchBandwidthLimit has not yet been implemented")
+		})
+	}
 	if api.LinkPatchLinkHandler == nil {
 		api.LinkPatchLinkHandler = link.PatchLinkHandlerFunc(func(params link.PatchLinkParams) middleware.Responder {
 			return middleware.NotImplemented("operation link.PatchLink has not yet been implemented")
 		})
 	}
+	if api.QuotaPatchQuotaHandler == nil {
+		api.QuotaPatchQuotaHandler = quota.PatchQuotaHandlerFunc(func(params quota.PatchQuotaParams) middleware.Responder {
+			return middleware.NotImplemented("operation quota.PatchQuota has not yet been implemented")
+		})
+	}
+	if api.RolePatchRoleHandler == nil {
+		api.RolePatchRoleHandler = role.PatchRoleHandlerFunc(func(params role.PatchRoleParams) middleware.Responder {
+			return middleware.NotImplemented("operation role.PatchRole has not yet been implemented")
+		})
+	}
 	if api.RouterPatchRouterHandler == nil {
 		api.RouterPatchRouterHandler = router.PatchRouterHandlerFunc(func(params router.PatchRouterParams) middleware.Responder {
 			return middleware.NotImplemented("operation router.PatchRouter has not yet been implemented")
@@ -239,6 +471,36 @@ func configureAPI(api *operations.ZitiFabricAPI) http.Handler {
 			return middleware.NotImplemented("operation terminator.PatchTerminator has not yet been implemented")
 		})
 	}
+	if api.EnrollmentSignerReissueEnrollmentSignerHandler == nil {
+		api.EnrollmentSignerReissueEnrollmentSignerHandler = enrollment_signer.ReissueEnrollmentSignerHandlerFunc(func(params enrollment_signer.ReissueEnrollmentSignerParams) middleware.Responder {
+			return middleware.NotImplemented("operation enrollment_signer.ReissueEnrollmentSigner has not yet been implemented")
+		})
+	}
+	if api.AuditRevertServiceHandler == nil {
+		api.AuditRevertServiceHandler = audit.RevertServiceHandlerFunc(func(params audit.RevertServiceParams) middleware.Responder {
+			return middleware.NotImplemented("operation audit.RevertService has not yet been implemented")
+		})
+	}
+	if api.PolicySimulationSimulatePolicyChangesHandler == nil {
+		api.PolicySimulationSimulatePolicyChangesHandler = policy_simulation.SimulatePolicyChangesHandlerFunc(func(params policy_simulation.SimulatePolicyChangesParams) middleware.Responder {
+			return middleware.NotImplemented("operation policy_simulation.SimulatePolicyChanges has not yet been implemented")
+		})
+	}
+	if api.BandwidthLimitUpdateBandwidthLimitHandler == nil {
+		api.BandwidthLimitUpdateBandwidthLimitHandler = bandwidth_limit.UpdateBandwidthLimitHandlerFunc(func(params bandwidth_limit.UpdateBandwidthLimitParams) middleware.Responder {
+			return middleware.NotImplemented("operation bandwidth_limit.UpdateBandwidthLimit has not yet been implemented")
+		})
+	}
+	if api.QuotaUpdateQuotaHandler == nil {
+		api.QuotaUpdateQuotaHandler = quota.UpdateQuotaHandlerFunc(func(params quota.UpdateQuotaParams) middleware.Responder {
+			return middleware.NotImplemented("operation quota.UpdateQuota has not yet been implemented")
+		})
+	}
+	if api.RoleUpdateRoleHandler == nil {
+		api.RoleUpdateRoleHandler = role.UpdateRoleHandlerFunc(func(params role.UpdateRoleParams) middleware.Responder {
+			return middleware.NotImplemented("operation role.UpdateRole has not yet been implemented")
+		})
+	}
 	if api.RouterUpdateRouterHandler == nil {
 		api.RouterUpdateRouterHandler = router.UpdateRouterHandlerFunc(func(params router.UpdateRouterParams) middleware.Responder {
 			return middleware.NotImplemented("operation router.UpdateRouter has not yet been implemented")
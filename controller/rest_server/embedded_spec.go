@@ -69,28 +69,17 @@ func init() {
   "host": "demo.ziti.dev",
   "basePath": "/fabric/v1",
   "paths": {
-    "/circuits": {
+    "/alert-rules": {
       "get": {
-        "description": "Retrieves a list of circuit resources; does not supports filtering, sorting, or pagination. Requires admin access.\n",
+        "description": "Retrieves the alert rules configured on this controller. Requires admin access.",
         "tags": [
-          "Circuit"
-        ],
-        "summary": "List circuits",
-        "operationId": "listCircuits",
-        "parameters": [
-          {
-            "$ref": "#/parameters/limit"
-          },
-          {
-            "$ref": "#/parameters/offset"
-          },
-          {
-            "$ref": "#/parameters/filter"
-          }
+          "Alert"
         ],
+        "summary": "List alert rules",
+        "operationId": "listAlertRules",
         "responses": {
           "200": {
-            "$ref": "#/responses/listCircuits"
+            "$ref": "#/responses/listAlertRules"
           },
           "401": {
             "$ref": "#/responses/unauthorizedResponse"
@@ -101,49 +90,47 @@ func init() {
         }
       }
     },
-    "/circuits/{id}": {
+    "/alert-silences": {
       "get": {
-        "description": "Retrieves a single circuit by id. Requires admin access.",
+        "description": "Retrieves the unexpired alert silences on this controller. Requires admin access.",
         "tags": [
-          "Circuit"
+          "Alert"
         ],
-        "summary": "Retrieves a single circuit",
-        "operationId": "detailCircuit",
+        "summary": "List alert silences",
+        "operationId": "listAlertSilences",
         "responses": {
           "200": {
-            "$ref": "#/responses/detailCircuit"
+            "$ref": "#/responses/listAlertSilences"
           },
           "401": {
             "$ref": "#/responses/unauthorizedResponse"
           },
-          "404": {
-            "$ref": "#/responses/notFoundResponse"
-          },
           "429": {
             "$ref": "#/responses/rateLimitedResponse"
           }
         }
       },
-      "delete": {
-        "description": "Delete a circuit by id. Requires admin access.",
+      "post": {
+        "description": "Silences alerts matching the given rule and/or entity until the silence expires. Silenced alerts are still\nreported as active, but don't emit alert events. Silences are kept in memory on the controller which accepted\nthe request. Requires admin access.\n",
         "tags": [
-          "Circuit"
+          "Alert"
         ],
-        "summary": "Delete a circuit",
-        "operationId": "deleteCircuit",
+        "summary": "Create an alert silence",
+        "operationId": "createAlertSilence",
         "parameters": [
           {
-            "description": "A circuit delete object",
-            "name": "options",
+            "description": "The alerts to silence and when the silence expires",
+            "name": "silence",
             "in": "body",
+            "required": true,
             "schema": {
-              "$ref": "#/definitions/circuitDelete"
+              "$ref": "#/definitions/alertSilenceCreate"
             }
           }
         ],
         "responses": {
-          "200": {
-            "$ref": "#/responses/deleteResponse"
+          "201": {
+            "$ref": "#/responses/detailAlertSilence"
           },
           "400": {
             "$ref": "#/responses/badRequestResponse"
@@ -151,14 +138,32 @@ func init() {
           "401": {
             "$ref": "#/responses/unauthorizedResponse"
           },
-          "409": {
-            "$ref": "#/responses/cannotDeleteReferencedResourceResponse"
-          },
           "429": {
             "$ref": "#/responses/rateLimitedResponse"
+          }
+        }
+      }
+    },
+    "/alert-silences/{id}": {
+      "delete": {
+        "description": "Removes an alert silence. Firing alerts which it silenced will emit their alert events. Requires admin access.",
+        "tags": [
+          "Alert"
+        ],
+        "summary": "Delete an alert silence",
+        "operationId": "deleteAlertSilence",
+        "responses": {
+          "200": {
+            "$ref": "#/responses/deleteResponse"
           },
-          "503": {
-            "$ref": "#/responses/serverUnavailableResponse"
+          "401": {
+            "$ref": "#/responses/unauthorizedResponse"
+          },
+          "404": {
+            "$ref": "#/responses/notFoundResponse"
+          },
+          "429": {
+            "$ref": "#/responses/rateLimitedResponse"
           }
         }
       },
@@ -168,28 +173,129 @@ func init() {
         }
       ]
     },
-    "/cluster/add-member": {
+    "/alerts": {
+      "get": {
+        "description": "Retrieves the pending and firing alerts from the alert rules evaluated by this controller. Each controller\nevaluates the alert rules independently. Requires admin access.\n",
+        "tags": [
+          "Alert"
+        ],
+        "summary": "List active alerts",
+        "operationId": "listAlerts",
+        "responses": {
+          "200": {
+            "$ref": "#/responses/listAlerts"
+          },
+          "401": {
+            "$ref": "#/responses/unauthorizedResponse"
+          },
+          "429": {
+            "$ref": "#/responses/rateLimitedResponse"
+          }
+        }
+      }
+    },
+    "/audit": {
+      "get": {
+        "description": "Retrieves entity changes recorded in the audit log, most recent first. Requires the audit log to be enabled\nand admin access.\n",
+        "tags": [
+          "Audit"
+        ],
+        "summary": "List audit log entries",
+        "operationId": "listAuditEntries",
+        "parameters": [
+          {
+            "type": "string",
+            "name": "entityType",
+            "in": "query"
+          },
+          {
+            "type": "string",
+            "name": "entityId",
+            "in": "query"
+          },
+          {
+            "type": "string",
+            "name": "authorId",
+            "in": "query"
+          },
+          {
+            "type": "string",
+            "format": "date-time",
+            "name": "since",
+            "in": "query"
+          },
+          {
+            "$ref": "#/parameters/limit"
+          }
+        ],
+        "responses": {
+          "200": {
+            "$ref": "#/responses/listAuditEntries"
+          },
+          "401": {
+            "$ref": "#/responses/unauthorizedResponse"
+          },
+          "409": {
+            "$ref": "#/responses/auditNotEnabledResponse"
+          },
+          "429": {
+            "$ref": "#/responses/rateLimitedResponse"
+          }
+        }
+      }
+    },
+    "/bandwidth-limits": {
+      "get": {
+        "description": "Retrieves a list of bandwidth limit resources; supports filtering, sorting, and pagination. Requires admin access.\n",
+        "tags": [
+          "Bandwidth Limit"
+        ],
+        "summary": "List bandwidth limits",
+        "operationId": "listBandwidthLimits",
+        "parameters": [
+          {
+            "$ref": "#/parameters/limit"
+          },
+          {
+            "$ref": "#/parameters/offset"
+          },
+          {
+            "$ref": "#/parameters/filter"
+          }
+        ],
+        "responses": {
+          "200": {
+            "$ref": "#/responses/listBandwidthLimits"
+          },
+          "401": {
+            "$ref": "#/responses/unauthorizedResponse"
+          },
+          "429": {
+            "$ref": "#/responses/rateLimitedResponse"
+          }
+        }
+      },
       "post": {
-        "description": "Add a member to the controller cluster. Requires admin access.",
+        "description": "Create a bandwidth limit resource. Requires admin access.",
         "tags": [
-          "Cluster"
+          "Bandwidth Limit"
         ],
-        "summary": "Add a member to the controller cluster",
-        "operationId": "clusterMemberAdd",
+        "summary": "Create a bandwidth limit resource",
+        "operationId": "createBandwidthLimit",
         "parameters": [
           {
-            "description": "member parameters",
-            "name": "member",
+            "description": "A bandwidth limit to create",
+            "name": "bandwidthLimit",
             "in": "body",
             "required": true,
             "schema": {
-              "$ref": "#/definitions/clusterMemberAdd"
+              "$ref": "#/definitions/bandwidthLimitCreate"
             }
           }
         ],
         "responses": {
-          "200": {
-            "$ref": "#/responses/emptyResponse"
+          "201": {
+            "$ref": "#/responses/createResponse"
           },
           "400": {
             "$ref": "#/responses/badRequestResponse"
@@ -206,52 +312,50 @@ func init() {
         }
       }
     },
-    "/cluster/list-members": {
+    "/bandwidth-limits/{id}": {
       "get": {
-        "description": "Returns all members of a cluster and their current status",
+        "description": "Retrieves a single bandwidth limit by id. Requires admin access.",
         "tags": [
-          "Cluster"
+          "Bandwidth Limit"
         ],
-        "summary": "Returns all members of a cluster and their current status",
-        "operationId": "clusterListMembers",
+        "summary": "Retrieves a single bandwidth limit",
+        "operationId": "detailBandwidthLimit",
         "responses": {
           "200": {
-            "$ref": "#/responses/clusterListMembersResponse"
-          },
-          "400": {
-            "$ref": "#/responses/badRequestResponse"
+            "$ref": "#/responses/detailBandwidthLimit"
           },
           "401": {
             "$ref": "#/responses/unauthorizedResponse"
           },
+          "404": {
+            "$ref": "#/responses/notFoundResponse"
+          },
           "429": {
             "$ref": "#/responses/rateLimitedResponse"
           }
         }
-      }
-    },
-    "/cluster/remove-member": {
-      "post": {
-        "description": "Remove a member from the controller cluster. Requires admin access.",
+      },
+      "put": {
+        "description": "Update all fields on a bandwidth limit by id. Requires admin access.",
         "tags": [
-          "Cluster"
+          "Bandwidth Limit"
         ],
-        "summary": "Remove a member from the controller cluster",
-        "operationId": "clusterMemberRemove",
+        "summary": "Update all fields on a bandwidth limit",
+        "operationId": "updateBandwidthLimit",
         "parameters": [
           {
-            "description": "member parameters",
-            "name": "member",
+            "description": "A bandwidth limit update object",
+            "name": "bandwidthLimit",
             "in": "body",
             "required": true,
             "schema": {
-              "$ref": "#/definitions/clusterMemberRemove"
+              "$ref": "#/definitions/bandwidthLimitUpdate"
             }
           }
         ],
         "responses": {
           "200": {
-            "$ref": "#/responses/emptyResponse"
+            "$ref": "#/responses/updateResponse"
           },
           "400": {
             "$ref": "#/responses/badRequestResponse"
@@ -269,30 +373,56 @@ func init() {
             "$ref": "#/responses/serverUnavailableResponse"
           }
         }
-      }
-    },
-    "/cluster/transfer-leadership": {
-      "post": {
-        "description": "Attempts to transfer leadership to a different member of the cluster. Requires admin access.",
+      },
+      "delete": {
+        "description": "Delete a bandwidth limit by id. Requires admin access.",
         "tags": [
-          "Cluster"
+          "Bandwidth Limit"
         ],
-        "summary": "Attempts to transfer leadership to a different member of the cluster",
-        "operationId": "clusterTransferLeadership",
+        "summary": "Delete a bandwidth limit",
+        "operationId": "deleteBandwidthLimit",
+        "responses": {
+          "200": {
+            "$ref": "#/responses/deleteResponse"
+          },
+          "400": {
+            "$ref": "#/responses/badRequestResponse"
+          },
+          "401": {
+            "$ref": "#/responses/unauthorizedResponse"
+          },
+          "429": {
+            "$ref": "#/responses/rateLimitedResponse"
+          },
+          "503": {
+            "$ref": "#/responses/serverUnavailableResponse"
+          }
+        }
+      },
+      "patch": {
+        "description": "Update the supplied fields on a bandwidth limit. Requires admin access.",
+        "tags": [
+          "Bandwidth Limit"
+        ],
+        "summary": "Update the supplied fields on a bandwidth limit",
+        "operationId": "patchBandwidthLimit",
         "parameters": [
           {
-            "description": "transfer operation parameters",
-            "name": "member",
+            "description": "A bandwidth limit patch object",
+            "name": "bandwidthLimit",
             "in": "body",
             "required": true,
             "schema": {
-              "$ref": "#/definitions/clusterTransferLeadership"
+              "$ref": "#/definitions/bandwidthLimitPatch"
             }
           }
         ],
         "responses": {
           "200": {
-            "$ref": "#/responses/emptyResponse"
+            "$ref": "#/responses/patchResponse"
+          },
+          "400": {
+            "$ref": "#/responses/badRequestResponse"
           },
           "401": {
             "$ref": "#/responses/unauthorizedResponse"
@@ -303,31 +433,28 @@ func init() {
           "429": {
             "$ref": "#/responses/rateLimitedResponse"
           },
-          "500": {
-            "$ref": "#/responses/badRequestResponse"
-          },
           "503": {
             "$ref": "#/responses/serverUnavailableResponse"
           }
         }
-      }
+      },
+      "parameters": [
+        {
+          "$ref": "#/parameters/id"
+        }
+      ]
     },
-    "/database": {
-      "post": {
-        "security": [
-          {
-            "ztSession": []
-          }
-        ],
-        "description": "Create a new database snapshot. Requires admin access.",
+    "/bulk-operations": {
+      "get": {
+        "description": "Retrieves the bulk operations started on this controller, most recent first. Only the most recent operations\nare retained. Requires admin access.\n",
         "tags": [
-          "Database"
+          "Bulk Operation"
         ],
-        "summary": "Create a new database snapshot",
-        "operationId": "createDatabaseSnapshot",
+        "summary": "List bulk operations",
+        "operationId": "listBulkOperations",
         "responses": {
           "200": {
-            "$ref": "#/responses/emptyResponse"
+            "$ref": "#/responses/listBulkOperations"
           },
           "401": {
             "$ref": "#/responses/unauthorizedResponse"
@@ -336,24 +463,31 @@ func init() {
             "$ref": "#/responses/rateLimitedResponse"
           }
         }
-      }
-    },
-    "/database/check-data-integrity": {
+      },
       "post": {
-        "security": [
+        "description": "Applies an operation to the identities selected by id or by filter. The operation runs in the background,\nupdating identities in batches. Its progress can be retrieved using the returned id. Requires admin access.\n",
+        "tags": [
+          "Bulk Operation"
+        ],
+        "summary": "Start a bulk identity operation",
+        "operationId": "createBulkOperation",
+        "parameters": [
           {
-            "ztSession": []
+            "description": "The operation to apply and the identities to apply it to",
+            "name": "operation",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/bulkOperationCreate"
+            }
           }
         ],
-        "description": "Starts a data integrity scan on the datastore. Requires admin access. Only once instance may run at a time, including runs of fixDataIntegrity.",
-        "tags": [
-          "Database"
-        ],
-        "summary": "Starts a data integrity scan on the datastore",
-        "operationId": "checkDataIntegrity",
         "responses": {
           "202": {
-            "$ref": "#/responses/emptyResponse"
+            "$ref": "#/responses/detailBulkOperation"
+          },
+          "400": {
+            "$ref": "#/responses/badRequestResponse"
           },
           "401": {
             "$ref": "#/responses/unauthorizedResponse"
@@ -364,130 +498,161 @@ func init() {
         }
       }
     },
-    "/database/data-integrity-results": {
+    "/bulk-operations/{id}": {
       "get": {
-        "security": [
-          {
-            "ztSession": []
-          }
-        ],
-        "description": "Returns any results found from in-progress integrity checks. Requires admin access.",
+        "description": "Retrieves the progress of a bulk operation, including any failures. Requires admin access.",
         "tags": [
-          "Database"
+          "Bulk Operation"
         ],
-        "summary": "Returns any results found from in-progress integrity checks",
-        "operationId": "dataIntegrityResults",
+        "summary": "Retrieves the progress of a bulk operation",
+        "operationId": "detailBulkOperation",
         "responses": {
           "200": {
-            "$ref": "#/responses/dataIntegrityCheckResult"
+            "$ref": "#/responses/detailBulkOperation"
           },
           "401": {
             "$ref": "#/responses/unauthorizedResponse"
           },
-          "429": {
-            "$ref": "#/responses/rateLimitedResponse"
-          }
-        }
-      }
-    },
-    "/database/fix-data-integrity": {
-      "post": {
-        "security": [
-          {
-            "ztSession": []
-          }
-        ],
-        "description": "Runs a data integrity scan on the datastore, attempts to fix any issues it can, and returns any found issues. Requires admin access. Only once instance may run at a time, including runs of checkDataIntegrity.",
-        "tags": [
-          "Database"
-        ],
-        "summary": "Runs a data integrity scan on the datastore, attempts to fix any issues it can and returns any found issues",
-        "operationId": "fixDataIntegrity",
-        "responses": {
-          "202": {
-            "$ref": "#/responses/emptyResponse"
-          },
-          "401": {
-            "$ref": "#/responses/unauthorizedResponse"
+          "404": {
+            "$ref": "#/responses/notFoundResponse"
           },
           "429": {
             "$ref": "#/responses/rateLimitedResponse"
           }
         }
-      }
+      },
+      "parameters": [
+        {
+          "$ref": "#/parameters/id"
+        }
+      ]
     },
-    "/database/snapshot": {
-      "post": {
-        "description": "Create a new database snapshot with path. Requires admin access.",
+    "/circuit-history": {
+      "get": {
+        "description": "Retrieves circuits from this controller's circuit history, newest first, including circuits which failed to\nbe created. Since and until select circuits which were active at some point in the given range. Requires the\ncircuit history to be enabled and admin access.\n",
         "tags": [
-          "Database"
+          "Circuit History"
         ],
-        "summary": "Create a new database snapshot with path",
-        "operationId": "createDatabaseSnapshotWithPath",
+        "summary": "List circuit history",
+        "operationId": "listCircuitHistory",
         "parameters": [
           {
-            "description": "snapshot parameters",
-            "name": "snapshot",
-            "in": "body",
-            "required": true,
-            "schema": {
-              "$ref": "#/definitions/databaseSnapshotCreate"
-            }
+            "type": "string",
+            "name": "identityId",
+            "in": "query"
+          },
+          {
+            "type": "string",
+            "name": "serviceId",
+            "in": "query"
+          },
+          {
+            "type": "string",
+            "name": "routerId",
+            "in": "query"
+          },
+          {
+            "type": "string",
+            "name": "linkId",
+            "in": "query"
+          },
+          {
+            "type": "string",
+            "name": "terminatorId",
+            "in": "query"
+          },
+          {
+            "type": "string",
+            "name": "hostId",
+            "in": "query"
+          },
+          {
+            "enum": [
+              "active",
+              "ended",
+              "failed"
+            ],
+            "type": "string",
+            "name": "state",
+            "in": "query"
+          },
+          {
+            "type": "string",
+            "name": "failureCause",
+            "in": "query"
+          },
+          {
+            "type": "string",
+            "format": "date-time",
+            "name": "since",
+            "in": "query"
+          },
+          {
+            "type": "string",
+            "format": "date-time",
+            "name": "until",
+            "in": "query"
+          },
+          {
+            "$ref": "#/parameters/limit"
           }
         ],
         "responses": {
           "200": {
-            "$ref": "#/responses/databaseSnapshotCreateResult"
+            "$ref": "#/responses/listCircuitHistory"
           },
           "401": {
             "$ref": "#/responses/unauthorizedResponse"
           },
+          "409": {
+            "$ref": "#/responses/circuitHistoryNotEnabledResponse"
+          },
           "429": {
             "$ref": "#/responses/rateLimitedResponse"
           }
         }
       }
     },
-    "/inspections": {
-      "post": {
-        "description": "Requests system information, such as stack dumps or information about capabilities. Requires admin access.\n",
+    "/circuit-history/{id}": {
+      "get": {
+        "description": "Retrieves a single circuit from this controller's circuit history. Requires the circuit history to be enabled and admin access.",
         "tags": [
-          "Inspect"
-        ],
-        "summary": "Inspect system values",
-        "operationId": "inspect",
-        "parameters": [
-          {
-            "description": "An inspect request",
-            "name": "request",
-            "in": "body",
-            "required": true,
-            "schema": {
-              "$ref": "#/definitions/inspectRequest"
-            }
-          }
+          "Circuit History"
         ],
+        "summary": "Retrieve a circuit from the circuit history",
+        "operationId": "detailCircuitHistory",
         "responses": {
           "200": {
-            "$ref": "#/responses/inspectResponse"
+            "$ref": "#/responses/detailCircuitHistory"
           },
           "401": {
             "$ref": "#/responses/unauthorizedResponse"
           },
+          "404": {
+            "$ref": "#/responses/notFoundResponse"
+          },
+          "409": {
+            "$ref": "#/responses/circuitHistoryNotEnabledResponse"
+          },
           "429": {
             "$ref": "#/responses/rateLimitedResponse"
           }
         }
-      }
+      },
+      "parameters": [
+        {
+          "$ref": "#/parameters/id"
+        }
+      ]
     },
-    "/links": {
+    "/circuits": {
       "get": {
-        "description": "Retrieves a list of link resources; does not supports filtering, sorting, or pagination. Requires admin access.\n",
+        "description": "Retrieves a list of circuit resources; does not supports filtering, sorting, or pagination. Requires admin access.\n",
         "tags": [
-          "Link"
+          "Circuit"
         ],
-        "summary": "List links",
-        "operationId": "listLinks",
+        "summary": "List circuits",
+        "operationId": "listCircuits",
         "parameters": [
           {
             "$ref": "#/parameters/limit"
@@ -501,7 +666,7 @@ func init() {
         ],
         "responses": {
           "200": {
-            "$ref": "#/responses/listLinks"
+            "$ref": "#/responses/listCircuits"
           },
           "401": {
             "$ref": "#/responses/unauthorizedResponse"
@@ -512,17 +677,17 @@ func init() {
         }
       }
     },
-    "/links/{id}": {
+    "/circuits/{id}": {
       "get": {
-        "description": "Retrieves a single link by id. Requires admin access.",
+        "description": "Retrieves a single circuit by id. Requires admin access.",
         "tags": [
-          "Link"
+          "Circuit"
         ],
-        "summary": "Retrieves a single link",
-        "operationId": "detailLink",
+        "summary": "Retrieves a single circuit",
+        "operationId": "detailCircuit",
         "responses": {
           "200": {
-            "$ref": "#/responses/detailLink"
+            "$ref": "#/responses/detailCircuit"
           },
           "401": {
             "$ref": "#/responses/unauthorizedResponse"
@@ -536,12 +701,22 @@ func init() {
         }
       },
       "delete": {
-        "description": "Delete a link by id. Requires admin access.",
+        "description": "Delete a circuit by id. Requires admin access.",
         "tags": [
-          "Link"
+          "Circuit"
+        ],
+        "summary": "Delete a circuit",
+        "operationId": "deleteCircuit",
+        "parameters": [
+          {
+            "description": "A circuit delete object",
+            "name": "options",
+            "in": "body",
+            "schema": {
+              "$ref": "#/definitions/circuitDelete"
+            }
+          }
         ],
-        "summary": "Delete a link",
-        "operationId": "deleteLink",
         "responses": {
           "200": {
             "$ref": "#/responses/deleteResponse"
@@ -552,6 +727,9 @@ func init() {
           "401": {
             "$ref": "#/responses/unauthorizedResponse"
           },
+          "409": {
+            "$ref": "#/responses/cannotDeleteReferencedResourceResponse"
+          },
           "429": {
             "$ref": "#/responses/rateLimitedResponse"
           },
@@ -560,27 +738,34 @@ func init() {
           }
         }
       },
-      "patch": {
-        "description": "Update the supplied fields on a link. Requires admin access.",
+      "parameters": [
+        {
+          "$ref": "#/parameters/id"
+        }
+      ]
+    },
+    "/cluster/add-member": {
+      "post": {
+        "description": "Add a member to the controller cluster. Requires admin access.",
         "tags": [
-          "Link"
+          "Cluster"
         ],
-        "summary": "Update the supplied fields on a link",
-        "operationId": "patchLink",
+        "summary": "Add a member to the controller cluster",
+        "operationId": "clusterMemberAdd",
         "parameters": [
           {
-            "description": "A link patch object",
-            "name": "link",
+            "description": "member parameters",
+            "name": "member",
             "in": "body",
             "required": true,
             "schema": {
-              "$ref": "#/definitions/linkPatch"
+              "$ref": "#/definitions/clusterMemberAdd"
             }
           }
         ],
         "responses": {
           "200": {
-            "$ref": "#/responses/patchResponse"
+            "$ref": "#/responses/emptyResponse"
           },
           "400": {
             "$ref": "#/responses/badRequestResponse"
@@ -588,9 +773,6 @@ func init() {
           "401": {
             "$ref": "#/responses/unauthorizedResponse"
           },
-          "404": {
-            "$ref": "#/responses/notFoundResponse"
-          },
           "429": {
             "$ref": "#/responses/rateLimitedResponse"
           },
@@ -598,35 +780,22 @@ func init() {
             "$ref": "#/responses/serverUnavailableResponse"
           }
         }
-      },
-      "parameters": [
-        {
-          "$ref": "#/parameters/id"
-        }
-      ]
+      }
     },
-    "/routers": {
+    "/cluster/list-members": {
       "get": {
-        "description": "Retrieves a list of router resources; supports filtering, sorting, and pagination. Requires admin access.\n",
+        "description": "Returns all members of a cluster and their current status",
         "tags": [
-          "Router"
-        ],
-        "summary": "List routers",
-        "operationId": "listRouters",
-        "parameters": [
-          {
-            "$ref": "#/parameters/limit"
-          },
-          {
-            "$ref": "#/parameters/offset"
-          },
-          {
-            "$ref": "#/parameters/filter"
-          }
+          "Cluster"
         ],
+        "summary": "Returns all members of a cluster and their current status",
+        "operationId": "clusterListMembers",
         "responses": {
           "200": {
-            "$ref": "#/responses/listRouters"
+            "$ref": "#/responses/clusterListMembersResponse"
+          },
+          "400": {
+            "$ref": "#/responses/badRequestResponse"
           },
           "401": {
             "$ref": "#/responses/unauthorizedResponse"
@@ -635,28 +804,30 @@ func init() {
             "$ref": "#/responses/rateLimitedResponse"
           }
         }
-      },
+      }
+    },
+    "/cluster/remove-member": {
       "post": {
-        "description": "Create a router resource. Requires admin access.",
+        "description": "Remove a member from the controller cluster. Requires admin access.",
         "tags": [
-          "Router"
+          "Cluster"
         ],
-        "summary": "Create a router resource",
-        "operationId": "createRouter",
+        "summary": "Remove a member from the controller cluster",
+        "operationId": "clusterMemberRemove",
         "parameters": [
           {
-            "description": "A router to create",
-            "name": "router",
+            "description": "member parameters",
+            "name": "member",
             "in": "body",
             "required": true,
             "schema": {
-              "$ref": "#/definitions/routerCreate"
+              "$ref": "#/definitions/clusterMemberRemove"
             }
           }
         ],
         "responses": {
-          "201": {
-            "$ref": "#/responses/createResponse"
+          "200": {
+            "$ref": "#/responses/emptyResponse"
           },
           "400": {
             "$ref": "#/responses/badRequestResponse"
@@ -664,6 +835,9 @@ func init() {
           "401": {
             "$ref": "#/responses/unauthorizedResponse"
           },
+          "404": {
+            "$ref": "#/responses/notFoundResponse"
+          },
           "429": {
             "$ref": "#/responses/rateLimitedResponse"
           },
@@ -673,53 +847,28 @@ func init() {
         }
       }
     },
-    "/routers/{id}": {
-      "get": {
-        "description": "Retrieves a single router by id. Requires admin access.",
-        "tags": [
-          "Router"
-        ],
-        "summary": "Retrieves a single router",
-        "operationId": "detailRouter",
-        "responses": {
-          "200": {
-            "$ref": "#/responses/detailRouter"
-          },
-          "401": {
-            "$ref": "#/responses/unauthorizedResponse"
-          },
-          "404": {
-            "$ref": "#/responses/notFoundResponse"
-          },
-          "429": {
-            "$ref": "#/responses/rateLimitedResponse"
-          }
-        }
-      },
-      "put": {
-        "description": "Update all fields on a router by id. Requires admin access.",
+    "/cluster/transfer-leadership": {
+      "post": {
+        "description": "Attempts to transfer leadership to a different member of the cluster. Requires admin access.",
         "tags": [
-          "Router"
+          "Cluster"
         ],
-        "summary": "Update all fields on a router",
-        "operationId": "updateRouter",
+        "summary": "Attempts to transfer leadership to a different member of the cluster",
+        "operationId": "clusterTransferLeadership",
         "parameters": [
           {
-            "description": "A router update object",
-            "name": "router",
+            "description": "transfer operation parameters",
+            "name": "member",
             "in": "body",
             "required": true,
             "schema": {
-              "$ref": "#/definitions/routerUpdate"
+              "$ref": "#/definitions/clusterTransferLeadership"
             }
           }
         ],
         "responses": {
           "200": {
-            "$ref": "#/responses/updateResponse"
-          },
-          "400": {
-            "$ref": "#/responses/badRequestResponse"
+            "$ref": "#/responses/emptyResponse"
           },
           "401": {
             "$ref": "#/responses/unauthorizedResponse"
@@ -730,106 +879,78 @@ func init() {
           "429": {
             "$ref": "#/responses/rateLimitedResponse"
           },
+          "500": {
+            "$ref": "#/responses/badRequestResponse"
+          },
           "503": {
             "$ref": "#/responses/serverUnavailableResponse"
           }
         }
-      },
-      "delete": {
-        "description": "Delete a router by id. Requires admin access.",
+      }
+    },
+    "/current-identity/permissions": {
+      "get": {
+        "description": "Returns whether the current identity is an admin, along with the grants it has been given by roles.\nRequires an authenticated identity.\n",
         "tags": [
-          "Router"
+          "Role"
         ],
-        "summary": "Delete a router",
-        "operationId": "deleteRouter",
+        "summary": "Returns the permissions of the current identity",
+        "operationId": "detailCurrentIdentityPermissions",
         "responses": {
           "200": {
-            "$ref": "#/responses/deleteResponse"
-          },
-          "400": {
-            "$ref": "#/responses/badRequestResponse"
+            "$ref": "#/responses/detailCurrentIdentityPermissions"
           },
           "401": {
             "$ref": "#/responses/unauthorizedResponse"
           },
-          "409": {
-            "$ref": "#/responses/cannotDeleteReferencedResourceResponse"
-          },
           "429": {
             "$ref": "#/responses/rateLimitedResponse"
-          },
-          "503": {
-            "$ref": "#/responses/serverUnavailableResponse"
           }
         }
-      },
-      "patch": {
-        "description": "Update the supplied fields on a router. Requires admin access.",
-        "tags": [
-          "Router"
-        ],
-        "summary": "Update the supplied fields on a router",
-        "operationId": "patchRouter",
-        "parameters": [
+      }
+    },
+    "/database": {
+      "post": {
+        "security": [
           {
-            "description": "A router patch object",
-            "name": "router",
-            "in": "body",
-            "required": true,
-            "schema": {
-              "$ref": "#/definitions/routerPatch"
-            }
+            "ztSession": []
           }
         ],
+        "description": "Create a new database snapshot. Requires admin access.",
+        "tags": [
+          "Database"
+        ],
+        "summary": "Create a new database snapshot",
+        "operationId": "createDatabaseSnapshot",
         "responses": {
           "200": {
-            "$ref": "#/responses/patchResponse"
-          },
-          "400": {
-            "$ref": "#/responses/badRequestResponse"
+            "$ref": "#/responses/emptyResponse"
           },
           "401": {
             "$ref": "#/responses/unauthorizedResponse"
           },
-          "404": {
-            "$ref": "#/responses/notFoundResponse"
-          },
           "429": {
             "$ref": "#/responses/rateLimitedResponse"
-          },
-          "503": {
-            "$ref": "#/responses/serverUnavailableResponse"
           }
         }
-      },
-      "parameters": [
-        {
-          "$ref": "#/parameters/id"
-        }
-      ]
+      }
     },
-    "/routers/{id}/terminators": {
+    "/database/backups": {
       "get": {
-        "description": "Retrieves a list of terminator resources that are assigned specific router; supports filtering, sorting, and pagination.\n",
-        "tags": [
-          "Router"
-        ],
-        "summary": "List of terminators assigned to a router",
-        "operationId": "listRouterTerminators",
-        "parameters": [
-          {
-            "$ref": "#/parameters/limit"
-          },
-          {
-            "$ref": "#/parameters/offset"
-          },
+        "security": [
           {
-            "$ref": "#/parameters/filter"
+            "ztSession": []
           }
         ],
+        "description": "Returns the backup schedule, the outcome of the most recent backup taken by this controller and the backups\ncurrently stored in the backup target, newest first. Requires admin access.\n",
+        "tags": [
+          "Database"
+        ],
+        "summary": "Returns the status of scheduled database backups and the backups currently retained",
+        "operationId": "listDatabaseBackups",
         "responses": {
           "200": {
-            "$ref": "#/responses/listTerminators"
+            "$ref": "#/responses/databaseBackupStatus"
           },
           "400": {
             "$ref": "#/responses/badRequestResponse"
@@ -837,199 +958,187 @@ func init() {
           "401": {
             "$ref": "#/responses/unauthorizedResponse"
           },
+          "409": {
+            "$ref": "#/responses/backupNotConfiguredResponse"
+          },
           "429": {
             "$ref": "#/responses/rateLimitedResponse"
           }
         }
       },
-      "parameters": [
-        {
-          "$ref": "#/parameters/id"
-        }
-      ]
-    },
-    "/services": {
-      "get": {
-        "description": "Retrieves a list of service resources; supports filtering, sorting, and pagination. Requires admin access.\n",
-        "tags": [
-          "Service"
-        ],
-        "summary": "List services",
-        "operationId": "listServices",
-        "parameters": [
-          {
-            "$ref": "#/parameters/limit"
-          },
-          {
-            "$ref": "#/parameters/offset"
-          },
+      "post": {
+        "security": [
           {
-            "$ref": "#/parameters/filter"
+            "ztSession": []
           }
         ],
+        "description": "Takes a database backup and writes it to the configured backup target, then removes backups beyond the\nretention limit. Returns once the backup has been written. Requires admin access.\n",
+        "tags": [
+          "Database"
+        ],
+        "summary": "Takes a database backup now",
+        "operationId": "createDatabaseBackup",
         "responses": {
           "200": {
-            "$ref": "#/responses/listServices"
+            "$ref": "#/responses/databaseBackupCreateResult"
+          },
+          "400": {
+            "$ref": "#/responses/badRequestResponse"
           },
           "401": {
             "$ref": "#/responses/unauthorizedResponse"
           },
+          "409": {
+            "$ref": "#/responses/backupNotConfiguredResponse"
+          },
           "429": {
             "$ref": "#/responses/rateLimitedResponse"
           }
         }
-      },
+      }
+    },
+    "/database/check-data-integrity": {
       "post": {
-        "description": "Create a service resource. Requires admin access.",
-        "tags": [
-          "Service"
-        ],
-        "summary": "Create a service resource",
-        "operationId": "createService",
-        "parameters": [
+        "security": [
           {
-            "description": "A service to create",
-            "name": "service",
-            "in": "body",
-            "required": true,
-            "schema": {
-              "$ref": "#/definitions/serviceCreate"
-            }
+            "ztSession": []
           }
         ],
+        "description": "Starts a data integrity scan on the datastore. Requires admin access. Only once instance may run at a time, including runs of fixDataIntegrity.",
+        "tags": [
+          "Database"
+        ],
+        "summary": "Starts a data integrity scan on the datastore",
+        "operationId": "checkDataIntegrity",
         "responses": {
-          "201": {
-            "$ref": "#/responses/createResponse"
-          },
-          "400": {
-            "$ref": "#/responses/badRequestResponse"
+          "202": {
+            "$ref": "#/responses/emptyResponse"
           },
           "401": {
             "$ref": "#/responses/unauthorizedResponse"
           },
           "429": {
             "$ref": "#/responses/rateLimitedResponse"
-          },
-          "503": {
-            "$ref": "#/responses/serverUnavailableResponse"
           }
         }
       }
     },
-    "/services/{id}": {
+    "/database/data-integrity-results": {
       "get": {
-        "description": "Retrieves a single service by id. Requires admin access.",
+        "security": [
+          {
+            "ztSession": []
+          }
+        ],
+        "description": "Returns any results found from in-progress integrity checks. Requires admin access.",
         "tags": [
-          "Service"
+          "Database"
         ],
-        "summary": "Retrieves a single service",
-        "operationId": "detailService",
+        "summary": "Returns any results found from in-progress integrity checks",
+        "operationId": "dataIntegrityResults",
         "responses": {
           "200": {
-            "$ref": "#/responses/detailService"
+            "$ref": "#/responses/dataIntegrityCheckResult"
           },
           "401": {
             "$ref": "#/responses/unauthorizedResponse"
           },
-          "404": {
-            "$ref": "#/responses/notFoundResponse"
-          },
           "429": {
             "$ref": "#/responses/rateLimitedResponse"
           }
         }
-      },
-      "put": {
-        "description": "Update all fields on a service by id. Requires admin access.",
-        "tags": [
-          "Service"
+      }
+    },
+    "/database/fix-data-integrity": {
+      "post": {
+        "security": [
+          {
+            "ztSession": []
+          }
         ],
-        "summary": "Update all fields on a service",
-        "operationId": "updateService",
+        "description": "Runs a data integrity scan on the datastore, attempts to fix any issues it can, and returns any found issues. Requires admin access. Only once instance may run at a time, including runs of checkDataIntegrity.",
+        "tags": [
+          "Database"
+        ],
+        "summary": "Runs a data integrity scan on the datastore, attempts to fix any issues it can and returns any found issues",
+        "operationId": "fixDataIntegrity",
+        "responses": {
+          "202": {
+            "$ref": "#/responses/emptyResponse"
+          },
+          "401": {
+            "$ref": "#/responses/unauthorizedResponse"
+          },
+          "429": {
+            "$ref": "#/responses/rateLimitedResponse"
+          }
+        }
+      }
+    },
+    "/database/snapshot": {
+      "post": {
+        "description": "Create a new database snapshot with path. Requires admin access.",
+        "tags": [
+          "Database"
+        ],
+        "summary": "Create a new database snapshot with path",
+        "operationId": "createDatabaseSnapshotWithPath",
         "parameters": [
           {
-            "description": "A service update object",
-            "name": "service",
+            "description": "snapshot parameters",
+            "name": "snapshot",
             "in": "body",
             "required": true,
             "schema": {
-              "$ref": "#/definitions/serviceUpdate"
+              "$ref": "#/definitions/databaseSnapshotCreate"
             }
           }
         ],
         "responses": {
           "200": {
-            "$ref": "#/responses/updateResponse"
-          },
-          "400": {
-            "$ref": "#/responses/badRequestResponse"
+            "$ref": "#/responses/databaseSnapshotCreateResult"
           },
           "401": {
             "$ref": "#/responses/unauthorizedResponse"
           },
-          "404": {
-            "$ref": "#/responses/notFoundResponse"
-          },
           "429": {
             "$ref": "#/responses/rateLimitedResponse"
-          },
-          "503": {
-            "$ref": "#/responses/serverUnavailableResponse"
           }
         }
-      },
-      "delete": {
-        "description": "Delete a service by id. Requires admin access.",
+      }
+    },
+    "/enrollment-signers": {
+      "get": {
+        "description": "Retrieves the enrollment signers configured on this controller, along with how many identity and edge router\ncertificates issued by each signer are still in use. The active signer issues new certificates. Trusted\nsigners are published in the CA bundle, but don't issue certificates. Requires admin access.\n",
         "tags": [
-          "Service"
+          "Enrollment Signer"
         ],
-        "summary": "Delete a service",
-        "operationId": "deleteService",
+        "summary": "List enrollment signers",
+        "operationId": "listEnrollmentSigners",
         "responses": {
           "200": {
-            "$ref": "#/responses/deleteResponse"
-          },
-          "400": {
-            "$ref": "#/responses/badRequestResponse"
+            "$ref": "#/responses/listEnrollmentSigners"
           },
           "401": {
             "$ref": "#/responses/unauthorizedResponse"
           },
-          "409": {
-            "$ref": "#/responses/cannotDeleteReferencedResourceResponse"
-          },
           "429": {
             "$ref": "#/responses/rateLimitedResponse"
-          },
-          "503": {
-            "$ref": "#/responses/serverUnavailableResponse"
           }
         }
-      },
-      "patch": {
-        "description": "Update the supplied fields on a service. Requires admin access.",
+      }
+    },
+    "/enrollment-signers/{id}": {
+      "get": {
+        "description": "Retrieves a single enrollment signer by fingerprint. Requires admin access.",
         "tags": [
-          "Service"
-        ],
-        "summary": "Update the supplied fields on a service",
-        "operationId": "patchService",
-        "parameters": [
-          {
-            "description": "A service patch object",
-            "name": "service",
-            "in": "body",
-            "required": true,
-            "schema": {
-              "$ref": "#/definitions/servicePatch"
-            }
-          }
+          "Enrollment Signer"
         ],
+        "summary": "Retrieves a single enrollment signer",
+        "operationId": "detailEnrollmentSigner",
         "responses": {
           "200": {
-            "$ref": "#/responses/patchResponse"
-          },
-          "400": {
-            "$ref": "#/responses/badRequestResponse"
+            "$ref": "#/responses/detailEnrollmentSigner"
           },
           "401": {
             "$ref": "#/responses/unauthorizedResponse"
@@ -1039,9 +1148,6 @@ func init() {
           },
           "429": {
             "$ref": "#/responses/rateLimitedResponse"
-          },
-          "503": {
-            "$ref": "#/responses/serverUnavailableResponse"
           }
         }
       },
@@ -1051,28 +1157,17 @@ func init() {
         }
       ]
     },
-    "/services/{id}/terminators": {
-      "get": {
-        "description": "Retrieves a list of terminator resources that are assigned specific service; supports filtering, sorting, and pagination.\n",
+    "/enrollment-signers/{id}/reissue": {
+      "post": {
+        "description": "Asks for the certificates issued by a trusted signer to be re-issued by the active signer. Identities are asked\nto extend their certificates the next time they authenticate. Edge routers are asked to renew their\ncertificates using a router certificate rotation, whose id is returned. Requires admin access.\n",
         "tags": [
-          "Service"
-        ],
-        "summary": "List of terminators assigned to a service",
-        "operationId": "listServiceTerminators",
-        "parameters": [
-          {
-            "$ref": "#/parameters/limit"
-          },
-          {
-            "$ref": "#/parameters/offset"
-          },
-          {
-            "$ref": "#/parameters/filter"
-          }
+          "Enrollment Signer"
         ],
+        "summary": "Re-issue the certificates issued by a trusted enrollment signer",
+        "operationId": "reissueEnrollmentSigner",
         "responses": {
-          "200": {
-            "$ref": "#/responses/listTerminators"
+          "202": {
+            "$ref": "#/responses/reissueEnrollmentSigner"
           },
           "400": {
             "$ref": "#/responses/badRequestResponse"
@@ -1080,6 +1175,9 @@ func init() {
           "401": {
             "$ref": "#/responses/unauthorizedResponse"
           },
+          "404": {
+            "$ref": "#/responses/notFoundResponse"
+          },
           "429": {
             "$ref": "#/responses/rateLimitedResponse"
           }
@@ -1091,31 +1189,28 @@ func init() {
         }
       ]
     },
-    "/terminators": {
-      "get": {
-        "description": "Retrieves a list of terminator resources; supports filtering, sorting, and pagination. Requires admin access.\n",
+    "/inspections": {
+      "post": {
+        "description": "Requests system information, such as stack dumps or information about capabilities. Requires admin access.\n",
         "tags": [
-          "Terminator"
+          "Inspect"
         ],
-        "summary": "List terminators",
-        "operationId": "listTerminators",
+        "summary": "Inspect system values",
+        "operationId": "inspect",
         "parameters": [
           {
-            "$ref": "#/parameters/limit"
-          },
-          {
-            "$ref": "#/parameters/offset"
-          },
-          {
-            "$ref": "#/parameters/filter"
+            "description": "An inspect request",
+            "name": "request",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/inspectRequest"
+            }
           }
         ],
         "responses": {
           "200": {
-            "$ref": "#/responses/listTerminators"
-          },
-          "400": {
-            "$ref": "#/responses/badRequestResponse"
+            "$ref": "#/responses/inspectResponse"
           },
           "401": {
             "$ref": "#/responses/unauthorizedResponse"
@@ -1124,55 +1219,51 @@ func init() {
             "$ref": "#/responses/rateLimitedResponse"
           }
         }
-      },
-      "post": {
-        "description": "Create a terminator resource. Requires admin access.",
+      }
+    },
+    "/links": {
+      "get": {
+        "description": "Retrieves a list of link resources; does not supports filtering, sorting, or pagination. Requires admin access.\n",
         "tags": [
-          "Terminator"
+          "Link"
         ],
-        "summary": "Create a terminator resource",
-        "operationId": "createTerminator",
+        "summary": "List links",
+        "operationId": "listLinks",
         "parameters": [
           {
-            "description": "A terminator to create",
-            "name": "terminator",
-            "in": "body",
-            "required": true,
-            "schema": {
-              "$ref": "#/definitions/terminatorCreate"
-            }
+            "$ref": "#/parameters/limit"
+          },
+          {
+            "$ref": "#/parameters/offset"
+          },
+          {
+            "$ref": "#/parameters/filter"
           }
         ],
         "responses": {
-          "201": {
-            "$ref": "#/responses/createResponse"
-          },
-          "400": {
-            "$ref": "#/responses/badRequestResponse"
+          "200": {
+            "$ref": "#/responses/listLinks"
           },
           "401": {
             "$ref": "#/responses/unauthorizedResponse"
           },
           "429": {
             "$ref": "#/responses/rateLimitedResponse"
-          },
-          "503": {
-            "$ref": "#/responses/serverUnavailableResponse"
           }
         }
       }
     },
-    "/terminators/{id}": {
+    "/links/{id}": {
       "get": {
-        "description": "Retrieves a single terminator by id. Requires admin access.",
+        "description": "Retrieves a single link by id. Requires admin access.",
         "tags": [
-          "Terminator"
+          "Link"
         ],
-        "summary": "Retrieves a single terminator",
-        "operationId": "detailTerminator",
+        "summary": "Retrieves a single link",
+        "operationId": "detailLink",
         "responses": {
           "200": {
-            "$ref": "#/responses/detailTerminator"
+            "$ref": "#/responses/detailLink"
           },
           "401": {
             "$ref": "#/responses/unauthorizedResponse"
@@ -1185,27 +1276,16 @@ func init() {
           }
         }
       },
-      "put": {
-        "description": "Update all fields on a terminator by id. Requires admin access.",
+      "delete": {
+        "description": "Delete a link by id. Requires admin access.",
         "tags": [
-          "Terminator"
-        ],
-        "summary": "Update all fields on a terminator",
-        "operationId": "updateTerminator",
-        "parameters": [
-          {
-            "description": "A terminator update object",
-            "name": "terminator",
-            "in": "body",
-            "required": true,
-            "schema": {
-              "$ref": "#/definitions/terminatorUpdate"
-            }
-          }
+          "Link"
         ],
+        "summary": "Delete a link",
+        "operationId": "deleteLink",
         "responses": {
           "200": {
-            "$ref": "#/responses/updateResponse"
+            "$ref": "#/responses/deleteResponse"
           },
           "400": {
             "$ref": "#/responses/badRequestResponse"
@@ -1213,9 +1293,6 @@ func init() {
           "401": {
             "$ref": "#/responses/unauthorizedResponse"
           },
-          "404": {
-            "$ref": "#/responses/notFoundResponse"
-          },
           "429": {
             "$ref": "#/responses/rateLimitedResponse"
           },
@@ -1224,16 +1301,27 @@ func init() {
           }
         }
       },
-      "delete": {
-        "description": "Delete a terminator by id. Requires admin access.",
+      "patch": {
+        "description": "Update the supplied fields on a link. Requires admin access.",
         "tags": [
-          "Terminator"
+          "Link"
+        ],
+        "summary": "Update the supplied fields on a link",
+        "operationId": "patchLink",
+        "parameters": [
+          {
+            "description": "A link patch object",
+            "name": "link",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/linkPatch"
+            }
+          }
         ],
-        "summary": "Delete a terminator",
-        "operationId": "deleteTerminator",
         "responses": {
           "200": {
-            "$ref": "#/responses/deleteResponse"
+            "$ref": "#/responses/patchResponse"
           },
           "400": {
             "$ref": "#/responses/badRequestResponse"
@@ -1241,8 +1329,8 @@ func init() {
           "401": {
             "$ref": "#/responses/unauthorizedResponse"
           },
-          "409": {
-            "$ref": "#/responses/cannotDeleteReferencedResourceResponse"
+          "404": {
+            "$ref": "#/responses/notFoundResponse"
           },
           "429": {
             "$ref": "#/responses/rateLimitedResponse"
@@ -1252,27 +1340,34 @@ func init() {
           }
         }
       },
-      "patch": {
-        "description": "Update the supplied fields on a terminator. Requires admin access.",
-        "tags": [
-          "Terminator"
-        ],
-        "summary": "Update the supplied fields on a terminator",
-        "operationId": "patchTerminator",
+      "parameters": [
+        {
+          "$ref": "#/parameters/id"
+        }
+      ]
+    },
+    "/policy-simulations": {
+      "post": {
+        "description": "Reports how identity to service access would change if the given policy and role attribute changes were made,\nwithout making them. Access is reported per identity, service and policy type, along with the edge routers\nwhich could be used and the posture checks of the granting policies. Requires admin access.\n",
+        "tags": [
+          "Policy Simulation"
+        ],
+        "summary": "Simulate policy changes",
+        "operationId": "simulatePolicyChanges",
         "parameters": [
           {
-            "description": "A terminator patch object",
-            "name": "terminator",
+            "description": "The hypothetical changes to evaluate",
+            "name": "simulation",
             "in": "body",
             "required": true,
             "schema": {
-              "$ref": "#/definitions/terminatorPatch"
+              "$ref": "#/definitions/policySimulationCreate"
             }
           }
         ],
         "responses": {
           "200": {
-            "$ref": "#/responses/patchResponse"
+            "$ref": "#/responses/policySimulationResult"
           },
           "400": {
             "$ref": "#/responses/badRequestResponse"
@@ -1285,3707 +1380,14599 @@ func init() {
           },
           "429": {
             "$ref": "#/responses/rateLimitedResponse"
-          },
-          "503": {
-            "$ref": "#/responses/serverUnavailableResponse"
           }
         }
-      },
-      "parameters": [
-        {
-          "$ref": "#/parameters/id"
-        }
-      ]
-    }
-  },
-  "definitions": {
-    "apiError": {
-      "type": "object",
-      "properties": {
-        "args": {
-          "$ref": "#/definitions/apiErrorArgs"
-        },
-        "cause": {
-          "$ref": "#/definitions/apiErrorCause"
-        },
-        "causeMessage": {
-          "type": "string"
-        },
-        "code": {
-          "type": "string"
-        },
-        "data": {
-          "type": "object",
-          "additionalProperties": true
-        },
-        "message": {
-          "type": "string"
-        },
-        "requestId": {
-          "type": "string"
-        }
       }
     },
-    "apiErrorArgs": {
-      "type": "object",
-      "properties": {
-        "urlVars": {
-          "type": "object",
-          "additionalProperties": {
-            "type": "string"
+    "/probes": {
+      "get": {
+        "description": "Retrieves the synthetic probes configured on this controller, along with the SLO summary of each probe over the\nprobe results window. Each controller schedules the probes independently. Requires admin access.\n",
+        "tags": [
+          "Probe"
+        ],
+        "summary": "List synthetic probes",
+        "operationId": "listProbes",
+        "responses": {
+          "200": {
+            "$ref": "#/responses/listProbes"
+          },
+          "401": {
+            "$ref": "#/responses/unauthorizedResponse"
+          },
+          "429": {
+            "$ref": "#/responses/rateLimitedResponse"
           }
         }
       }
     },
-    "apiErrorCause": {
-      "allOf": [
-        {
-          "$ref": "#/definitions/apiFieldError"
-        },
+    "/probes/{name}": {
+      "get": {
+        "description": "Retrieves a single synthetic probe and its SLO summary over the probe results window. Requires admin access.",
+        "tags": [
+          "Probe"
+        ],
+        "summary": "Retrieve a synthetic probe",
+        "operationId": "detailProbe",
+        "responses": {
+          "200": {
+            "$ref": "#/responses/detailProbe"
+          },
+          "401": {
+            "$ref": "#/responses/unauthorizedResponse"
+          },
+          "404": {
+            "$ref": "#/responses/notFoundResponse"
+          },
+          "429": {
+            "$ref": "#/responses/rateLimitedResponse"
+          }
+        }
+      },
+      "parameters": [
         {
-          "$ref": "#/definitions/apiError"
+          "type": "string",
+          "description": "The name of the probe",
+          "name": "name",
+          "in": "path",
+          "required": true
         }
       ]
     },
-    "apiErrorEnvelope": {
-      "type": "object",
-      "required": [
-        "meta",
-        "error"
-      ],
-      "properties": {
-        "error": {
-          "$ref": "#/definitions/apiError"
-        },
-        "meta": {
-          "$ref": "#/definitions/meta"
-        }
-      }
-    },
-    "apiFieldError": {
-      "type": "object",
-      "properties": {
-        "field": {
-          "type": "string"
-        },
-        "reason": {
-          "type": "string"
-        },
-        "value": {
-          "type": "string"
+    "/probes/{name}/results": {
+      "get": {
+        "description": "Retrieves the results of the given probe in the probe results window, newest first. Requires admin access.",
+        "tags": [
+          "Probe"
+        ],
+        "summary": "List the results of a synthetic probe",
+        "operationId": "listProbeResults",
+        "responses": {
+          "200": {
+            "$ref": "#/responses/listProbeResults"
+          },
+          "401": {
+            "$ref": "#/responses/unauthorizedResponse"
+          },
+          "404": {
+            "$ref": "#/responses/notFoundResponse"
+          },
+          "429": {
+            "$ref": "#/responses/rateLimitedResponse"
+          }
         }
-      }
-    },
-    "baseEntity": {
-      "description": "Fields shared by all Edge API entities",
-      "type": "object",
-      "required": [
-        "id",
-        "createdAt",
-        "updatedAt",
-        "_links",
-        "tags"
-      ],
-      "properties": {
-        "_links": {
-          "$ref": "#/definitions/links"
-        },
-        "createdAt": {
-          "type": "string",
-          "format": "date-time"
-        },
-        "id": {
-          "type": "string"
-        },
-        "tags": {
-          "$ref": "#/definitions/tags"
-        },
-        "updatedAt": {
+      },
+      "parameters": [
+        {
           "type": "string",
-          "format": "date-time"
+          "description": "The name of the probe",
+          "name": "name",
+          "in": "path",
+          "required": true
         }
-      }
+      ]
     },
-    "circuitDelete": {
-      "type": "object",
-      "properties": {
-        "immediate": {
-          "type": "boolean"
+    "/quotas": {
+      "get": {
+        "description": "Retrieves a list of quota resources; supports filtering, sorting, and pagination. Requires admin access.\n",
+        "tags": [
+          "Quota"
+        ],
+        "summary": "List quotas",
+        "operationId": "listQuotas",
+        "parameters": [
+          {
+            "$ref": "#/parameters/limit"
+          },
+          {
+            "$ref": "#/parameters/offset"
+          },
+          {
+            "$ref": "#/parameters/filter"
+          }
+        ],
+        "responses": {
+          "200": {
+            "$ref": "#/responses/listQuotas"
+          },
+          "401": {
+            "$ref": "#/responses/unauthorizedResponse"
+          },
+          "429": {
+            "$ref": "#/responses/rateLimitedResponse"
+          }
         }
-      }
-    },
-    "circuitDetail": {
-      "allOf": [
-        {
-          "$ref": "#/definitions/baseEntity"
-        },
-        {
-          "type": "object",
-          "required": [
-            "service",
-            "terminator",
-            "path"
-          ],
-          "properties": {
-            "clientId": {
-              "type": "string"
-            },
-            "path": {
-              "$ref": "#/definitions/path"
-            },
-            "service": {
-              "$ref": "#/definitions/entityRef"
-            },
-            "terminator": {
-              "$ref": "#/definitions/entityRef"
+      },
+      "post": {
+        "description": "Create a quota resource. Requires admin access.",
+        "tags": [
+          "Quota"
+        ],
+        "summary": "Create a quota resource",
+        "operationId": "createQuota",
+        "parameters": [
+          {
+            "description": "A quota to create",
+            "name": "quota",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/quotaCreate"
             }
           }
+        ],
+        "responses": {
+          "201": {
+            "$ref": "#/responses/createResponse"
+          },
+          "400": {
+            "$ref": "#/responses/badRequestResponse"
+          },
+          "401": {
+            "$ref": "#/responses/unauthorizedResponse"
+          },
+          "429": {
+            "$ref": "#/responses/rateLimitedResponse"
+          },
+          "503": {
+            "$ref": "#/responses/serverUnavailableResponse"
+          }
         }
-      ]
+      }
     },
-    "circuitList": {
-      "type": "array",
-      "items": {
-        "$ref": "#/definitions/circuitDetail"
-      }
-    },
-    "clusterMemberAdd": {
-      "type": "object",
-      "required": [
-        "address",
-        "isVoter"
-      ],
-      "properties": {
-        "address": {
-          "type": "string"
-        },
-        "isVoter": {
-          "type": "boolean"
+    "/quotas/{id}": {
+      "get": {
+        "description": "Retrieves a single quota by id. Requires admin access.",
+        "tags": [
+          "Quota"
+        ],
+        "summary": "Retrieves a single quota",
+        "operationId": "detailQuota",
+        "responses": {
+          "200": {
+            "$ref": "#/responses/detailQuota"
+          },
+          "401": {
+            "$ref": "#/responses/unauthorizedResponse"
+          },
+          "404": {
+            "$ref": "#/responses/notFoundResponse"
+          },
+          "429": {
+            "$ref": "#/responses/rateLimitedResponse"
+          }
         }
-      }
-    },
-    "clusterMemberListResponse": {
-      "type": "object",
-      "properties": {
-        "data": {
-          "type": "array",
-          "items": {
-            "$ref": "#/definitions/clusterMemberListValue"
+      },
+      "put": {
+        "description": "Update all fields on a quota by id. Requires admin access.",
+        "tags": [
+          "Quota"
+        ],
+        "summary": "Update all fields on a quota",
+        "operationId": "updateQuota",
+        "parameters": [
+          {
+            "description": "A quota update object",
+            "name": "quota",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/quotaUpdate"
+            }
+          }
+        ],
+        "responses": {
+          "200": {
+            "$ref": "#/responses/updateResponse"
+          },
+          "400": {
+            "$ref": "#/responses/badRequestResponse"
+          },
+          "401": {
+            "$ref": "#/responses/unauthorizedResponse"
+          },
+          "404": {
+            "$ref": "#/responses/notFoundResponse"
+          },
+          "429": {
+            "$ref": "#/responses/rateLimitedResponse"
+          },
+          "503": {
+            "$ref": "#/responses/serverUnavailableResponse"
           }
         }
-      }
-    },
-    "clusterMemberListValue": {
-      "type": "object",
-      "required": [
-        "id",
-        "address",
-        "voter",
-        "leader",
-        "version",
-        "connected",
-        "readOnly"
-      ],
-      "properties": {
-        "address": {
-          "type": "string"
-        },
-        "connected": {
-          "type": "boolean"
-        },
-        "id": {
-          "type": "string"
-        },
-        "leader": {
-          "type": "boolean"
-        },
-        "readOnly": {
-          "type": "boolean"
-        },
-        "version": {
-          "type": "string"
-        },
-        "voter": {
-          "type": "boolean"
+      },
+      "delete": {
+        "description": "Delete a quota by id. Requires admin access.",
+        "tags": [
+          "Quota"
+        ],
+        "summary": "Delete a quota",
+        "operationId": "deleteQuota",
+        "responses": {
+          "200": {
+            "$ref": "#/responses/deleteResponse"
+          },
+          "400": {
+            "$ref": "#/responses/badRequestResponse"
+          },
+          "401": {
+            "$ref": "#/responses/unauthorizedResponse"
+          },
+          "429": {
+            "$ref": "#/responses/rateLimitedResponse"
+          },
+          "503": {
+            "$ref": "#/responses/serverUnavailableResponse"
+          }
         }
-      }
-    },
-    "clusterMemberRemove": {
-      "type": "object",
-      "required": [
-        "id"
-      ],
-      "properties": {
-        "id": {
-          "type": "string"
+      },
+      "patch": {
+        "description": "Update the supplied fields on a quota. Requires admin access.",
+        "tags": [
+          "Quota"
+        ],
+        "summary": "Update the supplied fields on a quota",
+        "operationId": "patchQuota",
+        "parameters": [
+          {
+            "description": "A quota patch object",
+            "name": "quota",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/quotaPatch"
+            }
+          }
+        ],
+        "responses": {
+          "200": {
+            "$ref": "#/responses/patchResponse"
+          },
+          "400": {
+            "$ref": "#/responses/badRequestResponse"
+          },
+          "401": {
+            "$ref": "#/responses/unauthorizedResponse"
+          },
+          "404": {
+            "$ref": "#/responses/notFoundResponse"
+          },
+          "429": {
+            "$ref": "#/responses/rateLimitedResponse"
+          },
+          "503": {
+            "$ref": "#/responses/serverUnavailableResponse"
+          }
         }
-      }
-    },
-    "clusterTransferLeadership": {
-      "type": "object",
-      "properties": {
-        "newLeaderId": {
-          "type": "string"
+      },
+      "parameters": [
+        {
+          "$ref": "#/parameters/id"
         }
-      }
+      ]
     },
-    "createEnvelope": {
-      "type": "object",
-      "properties": {
-        "data": {
-          "$ref": "#/definitions/createLocation"
-        },
-        "meta": {
-          "$ref": "#/definitions/meta"
+    "/quotas/{id}/usage": {
+      "get": {
+        "description": "Retrieves the consumption of each identity or service tracked by a quota for the current period. Requires\nadmin access.\n",
+        "tags": [
+          "Quota"
+        ],
+        "summary": "Retrieves the current consumption of a quota",
+        "operationId": "listQuotaUsage",
+        "responses": {
+          "200": {
+            "$ref": "#/responses/listQuotaUsage"
+          },
+          "401": {
+            "$ref": "#/responses/unauthorizedResponse"
+          },
+          "404": {
+            "$ref": "#/responses/notFoundResponse"
+          },
+          "429": {
+            "$ref": "#/responses/rateLimitedResponse"
+          }
         }
-      }
-    },
-    "createLocation": {
-      "type": "object",
-      "properties": {
-        "_links": {
-          "$ref": "#/definitions/links"
-        },
-        "id": {
-          "type": "string"
+      },
+      "parameters": [
+        {
+          "$ref": "#/parameters/id"
         }
-      }
+      ]
     },
-    "dataIntegrityCheckDetail": {
-      "type": "object",
-      "required": [
-        "description",
-        "fixed"
-      ],
-      "properties": {
-        "description": {
-          "type": "string"
-        },
-        "fixed": {
-          "type": "boolean"
+    "/roles": {
+      "get": {
+        "description": "Retrieves a list of role resources; supports filtering, sorting, and pagination. Requires admin access.\n",
+        "tags": [
+          "Role"
+        ],
+        "summary": "List roles",
+        "operationId": "listRoles",
+        "parameters": [
+          {
+            "$ref": "#/parameters/limit"
+          },
+          {
+            "$ref": "#/parameters/offset"
+          },
+          {
+            "$ref": "#/parameters/filter"
+          }
+        ],
+        "responses": {
+          "200": {
+            "$ref": "#/responses/listRoles"
+          },
+          "401": {
+            "$ref": "#/responses/unauthorizedResponse"
+          },
+          "429": {
+            "$ref": "#/responses/rateLimitedResponse"
+          }
         }
-      }
-    },
-    "dataIntegrityCheckDetailList": {
-      "type": "array",
-      "items": {
-        "$ref": "#/definitions/dataIntegrityCheckDetail"
-      }
-    },
-    "dataIntegrityCheckDetails": {
-      "type": "object",
-      "required": [
-        "inProgress",
-        "fixingErrors",
-        "tooManyErrors",
-        "startTime",
-        "endTime",
-        "error",
-        "results"
-      ],
-      "properties": {
-        "endTime": {
-          "type": "string",
-          "format": "date-time"
-        },
-        "error": {
-          "type": "string"
-        },
-        "fixingErrors": {
-          "type": "boolean"
-        },
-        "inProgress": {
-          "type": "boolean"
-        },
-        "results": {
-          "$ref": "#/definitions/dataIntegrityCheckDetailList"
-        },
-        "startTime": {
-          "type": "string",
-          "format": "date-time"
-        },
-        "tooManyErrors": {
-          "type": "boolean"
+      },
+      "post": {
+        "description": "Create a role resource. Requires admin access.",
+        "tags": [
+          "Role"
+        ],
+        "summary": "Create a role resource",
+        "operationId": "createRole",
+        "parameters": [
+          {
+            "description": "A role to create",
+            "name": "role",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/roleCreate"
+            }
+          }
+        ],
+        "responses": {
+          "201": {
+            "$ref": "#/responses/createResponse"
+          },
+          "400": {
+            "$ref": "#/responses/badRequestResponse"
+          },
+          "401": {
+            "$ref": "#/responses/unauthorizedResponse"
+          },
+          "429": {
+            "$ref": "#/responses/rateLimitedResponse"
+          },
+          "503": {
+            "$ref": "#/responses/serverUnavailableResponse"
+          }
         }
       }
     },
-    "dataIntegrityCheckResultEnvelope": {
-      "type": "object",
-      "required": [
-        "meta",
-        "data"
-      ],
-      "properties": {
-        "data": {
-          "$ref": "#/definitions/dataIntegrityCheckDetails"
-        },
-        "meta": {
-          "$ref": "#/definitions/meta"
+    "/roles/{id}": {
+      "get": {
+        "description": "Retrieves a single role by id. Requires admin access.",
+        "tags": [
+          "Role"
+        ],
+        "summary": "Retrieves a single role",
+        "operationId": "detailRole",
+        "responses": {
+          "200": {
+            "$ref": "#/responses/detailRole"
+          },
+          "401": {
+            "$ref": "#/responses/unauthorizedResponse"
+          },
+          "404": {
+            "$ref": "#/responses/notFoundResponse"
+          },
+          "429": {
+            "$ref": "#/responses/rateLimitedResponse"
+          }
         }
-      }
-    },
-    "databaseSnapshotCreate": {
-      "type": "object",
-      "properties": {
-        "path": {
-          "type": "string"
+      },
+      "put": {
+        "description": "Update all fields on a role by id. Requires admin access.",
+        "tags": [
+          "Role"
+        ],
+        "summary": "Update all fields on a role",
+        "operationId": "updateRole",
+        "parameters": [
+          {
+            "description": "A role update object",
+            "name": "role",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/roleUpdate"
+            }
+          }
+        ],
+        "responses": {
+          "200": {
+            "$ref": "#/responses/updateResponse"
+          },
+          "400": {
+            "$ref": "#/responses/badRequestResponse"
+          },
+          "401": {
+            "$ref": "#/responses/unauthorizedResponse"
+          },
+          "404": {
+            "$ref": "#/responses/notFoundResponse"
+          },
+          "429": {
+            "$ref": "#/responses/rateLimitedResponse"
+          },
+          "503": {
+            "$ref": "#/responses/serverUnavailableResponse"
+          }
         }
-      }
-    },
-    "databaseSnapshotCreateDetails": {
-      "type": "object",
-      "required": [
-        "path"
-      ],
-      "properties": {
-        "path": {
-          "type": "string"
+      },
+      "delete": {
+        "description": "Delete a role by id. Requires admin access.",
+        "tags": [
+          "Role"
+        ],
+        "summary": "Delete a role",
+        "operationId": "deleteRole",
+        "responses": {
+          "200": {
+            "$ref": "#/responses/deleteResponse"
+          },
+          "400": {
+            "$ref": "#/responses/badRequestResponse"
+          },
+          "401": {
+            "$ref": "#/responses/unauthorizedResponse"
+          },
+          "429": {
+            "$ref": "#/responses/rateLimitedResponse"
+          },
+          "503": {
+            "$ref": "#/responses/serverUnavailableResponse"
+          }
         }
-      }
-    },
-    "databaseSnapshotCreateResultEnvelope": {
-      "type": "object",
-      "required": [
-        "meta",
-        "data"
-      ],
-      "properties": {
-        "data": {
-          "$ref": "#/definitions/databaseSnapshotCreateDetails"
-        },
-        "meta": {
-          "$ref": "#/definitions/meta"
+      },
+      "patch": {
+        "description": "Update the supplied fields on a role. Requires admin access.",
+        "tags": [
+          "Role"
+        ],
+        "summary": "Update the supplied fields on a role",
+        "operationId": "patchRole",
+        "parameters": [
+          {
+            "description": "A role patch object",
+            "name": "role",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/rolePatch"
+            }
+          }
+        ],
+        "responses": {
+          "200": {
+            "$ref": "#/responses/patchResponse"
+          },
+          "400": {
+            "$ref": "#/responses/badRequestResponse"
+          },
+          "401": {
+            "$ref": "#/responses/unauthorizedResponse"
+          },
+          "404": {
+            "$ref": "#/responses/notFoundResponse"
+          },
+          "429": {
+            "$ref": "#/responses/rateLimitedResponse"
+          },
+          "503": {
+            "$ref": "#/responses/serverUnavailableResponse"
+          }
         }
-      }
-    },
-    "detailCircuitEnvelope": {
-      "type": "object",
-      "required": [
-        "meta",
-        "data"
-      ],
-      "properties": {
-        "data": {
-          "$ref": "#/definitions/circuitDetail"
-        },
-        "meta": {
-          "$ref": "#/definitions/meta"
+      },
+      "parameters": [
+        {
+          "$ref": "#/parameters/id"
         }
-      }
+      ]
     },
-    "detailLinkEnvelope": {
-      "type": "object",
-      "required": [
-        "meta",
-        "data"
-      ],
-      "properties": {
-        "data": {
-          "$ref": "#/definitions/linkDetail"
-        },
-        "meta": {
-          "$ref": "#/definitions/meta"
+    "/router-cert-rotations": {
+      "get": {
+        "description": "Retrieves the router certificate rotation campaigns started on this controller, most recent first. Only the\nmost recent campaigns are retained. Requires admin access.\n",
+        "tags": [
+          "Router Cert Rotation"
+        ],
+        "summary": "List router certificate rotations",
+        "operationId": "listRouterCertRotations",
+        "responses": {
+          "200": {
+            "$ref": "#/responses/listRouterCertRotations"
+          },
+          "401": {
+            "$ref": "#/responses/unauthorizedResponse"
+          },
+          "429": {
+            "$ref": "#/responses/rateLimitedResponse"
+          }
         }
-      }
-    },
-    "detailRouterEnvelope": {
-      "type": "object",
-      "required": [
-        "meta",
-        "data"
-      ],
-      "properties": {
-        "data": {
-          "$ref": "#/definitions/routerDetail"
-        },
-        "meta": {
-          "$ref": "#/definitions/meta"
-        }
-      }
-    },
-    "detailServiceEnvelope": {
-      "type": "object",
-      "required": [
-        "meta",
-        "data"
-      ],
-      "properties": {
-        "data": {
-          "$ref": "#/definitions/serviceDetail"
-        },
-        "meta": {
-          "$ref": "#/definitions/meta"
-        }
-      }
-    },
-    "detailTerminatorEnvelope": {
-      "type": "object",
-      "required": [
-        "meta",
-        "data"
-      ],
-      "properties": {
-        "data": {
-          "$ref": "#/definitions/terminatorDetail"
-        },
-        "meta": {
-          "$ref": "#/definitions/meta"
+      },
+      "post": {
+        "description": "Asks the routers selected by id or by filter to renew their certificates, a batch at a time. A router has\nrotated its certificates once its fingerprint changes. Only routers connected to this controller can be\nrotated. The campaign runs in the background. Its progress can be retrieved using the returned id. Requires\nadmin access.\n",
+        "tags": [
+          "Router Cert Rotation"
+        ],
+        "summary": "Start a router certificate rotation",
+        "operationId": "createRouterCertRotation",
+        "parameters": [
+          {
+            "description": "The routers to rotate and how to proceed",
+            "name": "rotation",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/routerCertRotationCreate"
+            }
+          }
+        ],
+        "responses": {
+          "202": {
+            "$ref": "#/responses/detailRouterCertRotation"
+          },
+          "400": {
+            "$ref": "#/responses/badRequestResponse"
+          },
+          "401": {
+            "$ref": "#/responses/unauthorizedResponse"
+          },
+          "429": {
+            "$ref": "#/responses/rateLimitedResponse"
+          }
         }
       }
     },
-    "empty": {
-      "type": "object",
-      "required": [
-        "meta",
-        "data"
-      ],
-      "properties": {
-        "data": {
-          "type": "object",
-          "example": {}
-        },
-        "meta": {
-          "$ref": "#/definitions/meta"
+    "/router-cert-rotations/{id}": {
+      "get": {
+        "description": "Retrieves the progress of a router certificate rotation, including the status of each router. Requires admin access.",
+        "tags": [
+          "Router Cert Rotation"
+        ],
+        "summary": "Retrieves the progress of a router certificate rotation",
+        "operationId": "detailRouterCertRotation",
+        "responses": {
+          "200": {
+            "$ref": "#/responses/detailRouterCertRotation"
+          },
+          "401": {
+            "$ref": "#/responses/unauthorizedResponse"
+          },
+          "404": {
+            "$ref": "#/responses/notFoundResponse"
+          },
+          "429": {
+            "$ref": "#/responses/rateLimitedResponse"
+          }
         }
-      }
-    },
-    "entityRef": {
-      "description": "A reference to another resource and links to interact with it",
-      "type": "object",
-      "properties": {
-        "_links": {
-          "$ref": "#/definitions/links"
-        },
-        "entity": {
-          "type": "string"
-        },
-        "id": {
-          "type": "string"
-        },
-        "name": {
-          "type": "string"
+      },
+      "parameters": [
+        {
+          "$ref": "#/parameters/id"
         }
-      }
+      ]
     },
-    "inspectRequest": {
-      "type": "object",
-      "required": [
-        "appRegex",
-        "requestedValues"
-      ],
-      "properties": {
-        "appRegex": {
-          "type": "string"
-        },
-        "requestedValues": {
-          "type": "array",
-          "items": {
-            "type": "string"
+    "/router-drains": {
+      "get": {
+        "description": "Retrieves the in progress and completed router drains on this controller. Requires admin access.",
+        "tags": [
+          "Router"
+        ],
+        "summary": "List router drains",
+        "operationId": "listRouterDrains",
+        "responses": {
+          "200": {
+            "$ref": "#/responses/listRouterDrains"
+          },
+          "401": {
+            "$ref": "#/responses/unauthorizedResponse"
+          },
+          "429": {
+            "$ref": "#/responses/rateLimitedResponse"
           }
         }
       }
     },
-    "inspectResponse": {
-      "type": "object",
-      "required": [
-        "success"
-      ],
-      "properties": {
-        "errors": {
-          "type": "array",
-          "items": {
-            "type": "string"
+    "/routers": {
+      "get": {
+        "description": "Retrieves a list of router resources; supports filtering, sorting, and pagination. Requires admin access.\n",
+        "tags": [
+          "Router"
+        ],
+        "summary": "List routers",
+        "operationId": "listRouters",
+        "parameters": [
+          {
+            "$ref": "#/parameters/limit"
+          },
+          {
+            "$ref": "#/parameters/offset"
+          },
+          {
+            "$ref": "#/parameters/filter"
           }
-        },
-        "success": {
-          "type": "boolean"
-        },
-        "values": {
-          "type": "array",
-          "items": {
-            "$ref": "#/definitions/inspectResponseValue"
+        ],
+        "responses": {
+          "200": {
+            "$ref": "#/responses/listRouters"
+          },
+          "401": {
+            "$ref": "#/responses/unauthorizedResponse"
+          },
+          "429": {
+            "$ref": "#/responses/rateLimitedResponse"
           }
         }
-      }
-    },
-    "inspectResponseValue": {
-      "type": "object",
-      "required": [
-        "appId",
-        "name",
-        "value"
-      ],
-      "properties": {
-        "appId": {
-          "type": "string"
-        },
-        "name": {
-          "type": "string"
-        },
-        "value": {
-          "type": [
-            "object",
-            "string",
-            "number",
-            "boolean"
-          ]
+      },
+      "post": {
+        "description": "Create a router resource. Requires admin access.",
+        "tags": [
+          "Router"
+        ],
+        "summary": "Create a router resource",
+        "operationId": "createRouter",
+        "parameters": [
+          {
+            "description": "A router to create",
+            "name": "router",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/routerCreate"
+            }
+          }
+        ],
+        "responses": {
+          "201": {
+            "$ref": "#/responses/createResponse"
+          },
+          "400": {
+            "$ref": "#/responses/badRequestResponse"
+          },
+          "401": {
+            "$ref": "#/responses/unauthorizedResponse"
+          },
+          "429": {
+            "$ref": "#/responses/rateLimitedResponse"
+          },
+          "503": {
+            "$ref": "#/responses/serverUnavailableResponse"
+          }
         }
       }
     },
-    "interface": {
-      "description": "A resource describing a network interface",
-      "type": "object",
-      "required": [
-        "name",
-        "hardwareAddress",
-        "mtu",
-        "index",
-        "isUp",
-        "isRunning",
-        "isLoopback",
-        "isBroadcast",
-        "isMulticast",
-        "addresses"
-      ],
-      "properties": {
-        "addresses": {
-          "type": "array",
-          "items": {
-            "type": "string"
+    "/routers/{id}": {
+      "get": {
+        "description": "Retrieves a single router by id. Requires admin access.",
+        "tags": [
+          "Router"
+        ],
+        "summary": "Retrieves a single router",
+        "operationId": "detailRouter",
+        "responses": {
+          "200": {
+            "$ref": "#/responses/detailRouter"
+          },
+          "401": {
+            "$ref": "#/responses/unauthorizedResponse"
+          },
+          "404": {
+            "$ref": "#/responses/notFoundResponse"
+          },
+          "429": {
+            "$ref": "#/responses/rateLimitedResponse"
           }
-        },
-        "hardwareAddress": {
-          "type": "string"
-        },
-        "index": {
-          "type": "integer"
-        },
-        "isBroadcast": {
-          "type": "boolean"
-        },
-        "isLoopback": {
-          "type": "boolean"
-        },
-        "isMulticast": {
-          "type": "boolean"
-        },
-        "isRunning": {
-          "type": "boolean"
-        },
-        "isUp": {
-          "type": "boolean"
-        },
-        "mtu": {
-          "type": "integer"
-        },
-        "name": {
-          "type": "string"
         }
-      }
-    },
-    "link": {
-      "description": "A link to another resource",
-      "type": "object",
-      "required": [
-        "href"
-      ],
-      "properties": {
-        "comment": {
-          "type": "string"
-        },
-        "href": {
-          "type": "string",
-          "format": "uri"
-        },
-        "method": {
-          "type": "string"
+      },
+      "put": {
+        "description": "Update all fields on a router by id. Requires admin access.",
+        "tags": [
+          "Router"
+        ],
+        "summary": "Update all fields on a router",
+        "operationId": "updateRouter",
+        "parameters": [
+          {
+            "description": "A router update object",
+            "name": "router",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/routerUpdate"
+            }
+          }
+        ],
+        "responses": {
+          "200": {
+            "$ref": "#/responses/updateResponse"
+          },
+          "400": {
+            "$ref": "#/responses/badRequestResponse"
+          },
+          "401": {
+            "$ref": "#/responses/unauthorizedResponse"
+          },
+          "404": {
+            "$ref": "#/responses/notFoundResponse"
+          },
+          "429": {
+            "$ref": "#/responses/rateLimitedResponse"
+          },
+          "503": {
+            "$ref": "#/responses/serverUnavailableResponse"
+          }
         }
-      }
-    },
-    "linkConnection": {
-      "type": "object",
-      "properties": {
-        "dest": {
-          "type": "string"
-        },
-        "source": {
-          "type": "string"
-        },
-        "type": {
-          "type": "string"
+      },
+      "delete": {
+        "description": "Delete a router by id. Requires admin access.",
+        "tags": [
+          "Router"
+        ],
+        "summary": "Delete a router",
+        "operationId": "deleteRouter",
+        "responses": {
+          "200": {
+            "$ref": "#/responses/deleteResponse"
+          },
+          "400": {
+            "$ref": "#/responses/badRequestResponse"
+          },
+          "401": {
+            "$ref": "#/responses/unauthorizedResponse"
+          },
+          "409": {
+            "$ref": "#/responses/cannotDeleteReferencedResourceResponse"
+          },
+          "429": {
+            "$ref": "#/responses/rateLimitedResponse"
+          },
+          "503": {
+            "$ref": "#/responses/serverUnavailableResponse"
+          }
         }
-      }
-    },
-    "linkDetail": {
-      "type": "object",
-      "required": [
-        "id",
-        "protocol",
-        "sourceRouter",
-        "destRouter",
-        "state",
-        "staticCost",
-        "sourceLatency",
-        "destLatency",
-        "cost",
-        "down",
-        "iteration"
-      ],
-      "properties": {
-        "connections": {
-          "type": "array",
-          "items": {
-            "$ref": "#/definitions/linkConnection"
+      },
+      "patch": {
+        "description": "Update the supplied fields on a router. Requires admin access.",
+        "tags": [
+          "Router"
+        ],
+        "summary": "Update the supplied fields on a router",
+        "operationId": "patchRouter",
+        "parameters": [
+          {
+            "description": "A router patch object",
+            "name": "router",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/routerPatch"
+            }
+          }
+        ],
+        "responses": {
+          "200": {
+            "$ref": "#/responses/patchResponse"
+          },
+          "400": {
+            "$ref": "#/responses/badRequestResponse"
+          },
+          "401": {
+            "$ref": "#/responses/unauthorizedResponse"
+          },
+          "404": {
+            "$ref": "#/responses/notFoundResponse"
+          },
+          "429": {
+            "$ref": "#/responses/rateLimitedResponse"
+          },
+          "503": {
+            "$ref": "#/responses/serverUnavailableResponse"
           }
-        },
-        "cost": {
-          "type": "integer"
-        },
-        "destLatency": {
-          "type": "integer"
-        },
-        "destRouter": {
-          "$ref": "#/definitions/entityRef"
-        },
-        "down": {
-          "type": "boolean"
-        },
-        "id": {
-          "type": "string"
-        },
-        "iteration": {
-          "type": "integer"
-        },
-        "protocol": {
-          "type": "string"
-        },
-        "sourceLatency": {
-          "type": "integer"
-        },
-        "sourceRouter": {
-          "$ref": "#/definitions/entityRef"
-        },
-        "state": {
-          "type": "string"
-        },
-        "staticCost": {
-          "type": "integer"
         }
-      }
-    },
-    "linkList": {
-      "type": "array",
-      "items": {
-        "$ref": "#/definitions/linkDetail"
-      }
+      },
+      "parameters": [
+        {
+          "$ref": "#/parameters/id"
+        }
+      ]
     },
-    "linkPatch": {
-      "type": "object",
-      "properties": {
-        "down": {
-          "type": "boolean"
-        },
-        "staticCost": {
-          "type": "integer"
+    "/routers/{id}/drain": {
+      "get": {
+        "description": "Retrieves the progress of the drain of the given router, including circuits which couldn't be moved. Requires admin access.",
+        "tags": [
+          "Router"
+        ],
+        "summary": "Retrieve the drain status of a router",
+        "operationId": "detailRouterDrain",
+        "responses": {
+          "200": {
+            "$ref": "#/responses/detailRouterDrain"
+          },
+          "401": {
+            "$ref": "#/responses/unauthorizedResponse"
+          },
+          "404": {
+            "$ref": "#/responses/notFoundResponse"
+          },
+          "429": {
+            "$ref": "#/responses/rateLimitedResponse"
+          }
         }
-      }
-    },
-    "links": {
-      "description": "A map of named links",
-      "type": "object",
-      "additionalProperties": {
-        "$ref": "#/definitions/link"
       },
-      "x-omitempty": false
-    },
-    "listCircuitsEnvelope": {
-      "type": "object",
-      "required": [
-        "meta",
-        "data"
-      ],
-      "properties": {
-        "data": {
-          "$ref": "#/definitions/circuitList"
-        },
-        "meta": {
-          "$ref": "#/definitions/meta"
-        }
-      }
-    },
-    "listLinksEnvelope": {
-      "type": "object",
-      "required": [
-        "meta",
-        "data"
-      ],
-      "properties": {
-        "data": {
-          "$ref": "#/definitions/linkList"
-        },
-        "meta": {
-          "$ref": "#/definitions/meta"
-        }
-      }
-    },
-    "listRoutersEnvelope": {
-      "type": "object",
-      "required": [
-        "meta",
-        "data"
-      ],
-      "properties": {
-        "data": {
-          "$ref": "#/definitions/routerList"
-        },
-        "meta": {
-          "$ref": "#/definitions/meta"
-        }
-      }
-    },
-    "listServicesEnvelope": {
-      "type": "object",
-      "required": [
-        "meta",
-        "data"
-      ],
-      "properties": {
-        "data": {
-          "$ref": "#/definitions/serviceList"
-        },
-        "meta": {
-          "$ref": "#/definitions/meta"
-        }
-      }
-    },
-    "listTerminatorsEnvelope": {
-      "type": "object",
-      "required": [
-        "meta",
-        "data"
-      ],
-      "properties": {
-        "data": {
-          "$ref": "#/definitions/terminatorList"
-        },
-        "meta": {
-          "$ref": "#/definitions/meta"
+      "post": {
+        "description": "Starts draining the given router. The router stops being used for transit when paths are calculated and the\ncircuits which pass through it are rerouted. The drain can optionally quiesce the router and wait for circuits\nwhich start or end at the router to finish. Drains are tracked in memory by the controller which accepted the\nrequest. Requires admin access.\n",
+        "tags": [
+          "Router"
+        ],
+        "summary": "Drain a router",
+        "operationId": "drainRouter",
+        "parameters": [
+          {
+            "description": "The drain options",
+            "name": "drain",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/routerDrainCreate"
+            }
+          }
+        ],
+        "responses": {
+          "202": {
+            "$ref": "#/responses/detailRouterDrain"
+          },
+          "400": {
+            "$ref": "#/responses/badRequestResponse"
+          },
+          "401": {
+            "$ref": "#/responses/unauthorizedResponse"
+          },
+          "404": {
+            "$ref": "#/responses/notFoundResponse"
+          },
+          "429": {
+            "$ref": "#/responses/rateLimitedResponse"
+          }
         }
-      }
-    },
-    "meta": {
-      "type": "object",
-      "properties": {
-        "apiEnrollmentVersion": {
-          "type": "string"
-        },
-        "apiVersion": {
-          "type": "string"
-        },
-        "filterableFields": {
-          "type": "array",
-          "items": {
-            "type": "string"
+      },
+      "delete": {
+        "description": "Cancels the drain of the given router, allowing it to be used for transit again. Doesn't dequiesce the router. Requires admin access.",
+        "tags": [
+          "Router"
+        ],
+        "summary": "Cancel a router drain",
+        "operationId": "cancelRouterDrain",
+        "responses": {
+          "200": {
+            "$ref": "#/responses/deleteResponse"
           },
-          "x-omitempty": true
-        },
-        "pagination": {
-          "$ref": "#/definitions/pagination"
+          "401": {
+            "$ref": "#/responses/unauthorizedResponse"
+          },
+          "404": {
+            "$ref": "#/responses/notFoundResponse"
+          },
+          "429": {
+            "$ref": "#/responses/rateLimitedResponse"
+          }
         }
-      }
-    },
-    "pagination": {
-      "type": "object",
-      "required": [
-        "limit",
-        "offset",
-        "totalCount"
-      ],
-      "properties": {
-        "limit": {
-          "type": "number",
-          "format": "int64"
-        },
-        "offset": {
-          "type": "number",
-          "format": "int64"
-        },
-        "totalCount": {
-          "type": "number",
-          "format": "int64"
+      },
+      "parameters": [
+        {
+          "$ref": "#/parameters/id"
         }
-      }
+      ]
     },
-    "path": {
-      "type": "object",
-      "properties": {
-        "links": {
-          "type": "array",
-          "items": {
-            "$ref": "#/definitions/entityRef"
+    "/routers/{id}/terminators": {
+      "get": {
+        "description": "Retrieves a list of terminator resources that are assigned specific router; supports filtering, sorting, and pagination.\n",
+        "tags": [
+          "Router"
+        ],
+        "summary": "List of terminators assigned to a router",
+        "operationId": "listRouterTerminators",
+        "parameters": [
+          {
+            "$ref": "#/parameters/limit"
+          },
+          {
+            "$ref": "#/parameters/offset"
+          },
+          {
+            "$ref": "#/parameters/filter"
           }
-        },
-        "nodes": {
-          "type": "array",
-          "items": {
-            "$ref": "#/definitions/entityRef"
+        ],
+        "responses": {
+          "200": {
+            "$ref": "#/responses/listTerminators"
+          },
+          "400": {
+            "$ref": "#/responses/badRequestResponse"
+          },
+          "401": {
+            "$ref": "#/responses/unauthorizedResponse"
+          },
+          "429": {
+            "$ref": "#/responses/rateLimitedResponse"
           }
         }
-      }
+      },
+      "parameters": [
+        {
+          "$ref": "#/parameters/id"
+        }
+      ]
     },
-    "routerCreate": {
-      "type": "object",
-      "required": [
-        "id",
-        "name",
-        "cost",
-        "noTraversal"
-      ],
-      "properties": {
-        "cost": {
-          "type": "integer",
-          "maximum": 65535
-        },
-        "disabled": {
-          "type": "boolean",
-          "x-nullable": true
-        },
-        "fingerprint": {
-          "type": "string",
-          "x-nullable": true
-        },
-        "id": {
-          "type": "string"
-        },
-        "name": {
-          "type": "string"
-        },
-        "noTraversal": {
-          "type": "boolean"
-        },
-        "tags": {
-          "$ref": "#/definitions/tags"
+    "/services": {
+      "get": {
+        "description": "Retrieves a list of service resources; supports filtering, sorting, and pagination. Requires admin access.\n",
+        "tags": [
+          "Service"
+        ],
+        "summary": "List services",
+        "operationId": "listServices",
+        "parameters": [
+          {
+            "$ref": "#/parameters/limit"
+          },
+          {
+            "$ref": "#/parameters/offset"
+          },
+          {
+            "$ref": "#/parameters/filter"
+          }
+        ],
+        "responses": {
+          "200": {
+            "$ref": "#/responses/listServices"
+          },
+          "401": {
+            "$ref": "#/responses/unauthorizedResponse"
+          },
+          "429": {
+            "$ref": "#/responses/rateLimitedResponse"
+          }
         }
-      }
-    },
-    "routerDetail": {
-      "type": "object",
-      "allOf": [
-        {
-          "$ref": "#/definitions/baseEntity"
-        },
-        {
-          "type": "object",
-          "required": [
-            "name",
-            "fingerprint",
-            "connected",
-            "cost",
-            "noTraversal",
-            "disabled"
-          ],
-          "properties": {
-            "connected": {
-              "type": "boolean"
-            },
-            "cost": {
-              "type": "integer",
-              "maximum": 65535
-            },
-            "disabled": {
-              "type": "boolean"
-            },
-            "fingerprint": {
-              "type": "string"
-            },
-            "interfaces": {
-              "type": "array",
-              "items": {
-                "$ref": "#/definitions/interface"
-              }
-            },
-            "listenerAddresses": {
-              "type": "array",
-              "items": {
-                "$ref": "#/definitions/routerListener"
-              }
-            },
-            "name": {
-              "type": "string"
-            },
-            "noTraversal": {
-              "type": "boolean"
-            },
-            "versionInfo": {
-              "$ref": "#/definitions/versionInfo"
+      },
+      "post": {
+        "description": "Create a service resource. Requires admin access.",
+        "tags": [
+          "Service"
+        ],
+        "summary": "Create a service resource",
+        "operationId": "createService",
+        "parameters": [
+          {
+            "description": "A service to create",
+            "name": "service",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/serviceCreate"
             }
           }
+        ],
+        "responses": {
+          "201": {
+            "$ref": "#/responses/createResponse"
+          },
+          "400": {
+            "$ref": "#/responses/badRequestResponse"
+          },
+          "401": {
+            "$ref": "#/responses/unauthorizedResponse"
+          },
+          "429": {
+            "$ref": "#/responses/rateLimitedResponse"
+          },
+          "503": {
+            "$ref": "#/responses/serverUnavailableResponse"
+          }
         }
-      ]
-    },
-    "routerList": {
-      "type": "array",
-      "items": {
-        "$ref": "#/definitions/routerDetail"
       }
     },
-    "routerListener": {
-      "type": "object",
-      "required": [
-        "address",
-        "protocol"
-      ],
-      "properties": {
-        "address": {
-          "type": "string"
-        },
-        "protocol": {
-          "type": "string"
+    "/services/{id}": {
+      "get": {
+        "description": "Retrieves a single service by id. Requires admin access.",
+        "tags": [
+          "Service"
+        ],
+        "summary": "Retrieves a single service",
+        "operationId": "detailService",
+        "responses": {
+          "200": {
+            "$ref": "#/responses/detailService"
+          },
+          "401": {
+            "$ref": "#/responses/unauthorizedResponse"
+          },
+          "404": {
+            "$ref": "#/responses/notFoundResponse"
+          },
+          "429": {
+            "$ref": "#/responses/rateLimitedResponse"
+          }
         }
-      }
-    },
-    "routerPatch": {
-      "type": "object",
-      "properties": {
-        "cost": {
-          "type": "integer",
-          "maximum": 65535,
-          "x-nullable": true
-        },
-        "disabled": {
-          "type": "boolean",
-          "x-nullable": true
-        },
-        "fingerprint": {
-          "type": "string",
-          "x-nullable": true
-        },
-        "name": {
-          "type": "string"
-        },
-        "noTraversal": {
-          "type": "boolean",
-          "x-nullable": true
-        },
-        "tags": {
-          "$ref": "#/definitions/tags"
+      },
+      "put": {
+        "description": "Update all fields on a service by id. Requires admin access.",
+        "tags": [
+          "Service"
+        ],
+        "summary": "Update all fields on a service",
+        "operationId": "updateService",
+        "parameters": [
+          {
+            "description": "A service update object",
+            "name": "service",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/serviceUpdate"
+            }
+          }
+        ],
+        "responses": {
+          "200": {
+            "$ref": "#/responses/updateResponse"
+          },
+          "400": {
+            "$ref": "#/responses/badRequestResponse"
+          },
+          "401": {
+            "$ref": "#/responses/unauthorizedResponse"
+          },
+          "404": {
+            "$ref": "#/responses/notFoundResponse"
+          },
+          "429": {
+            "$ref": "#/responses/rateLimitedResponse"
+          },
+          "503": {
+            "$ref": "#/responses/serverUnavailableResponse"
+          }
         }
-      }
-    },
-    "routerUpdate": {
-      "type": "object",
-      "required": [
-        "name",
-        "fingerprint",
-        "cost",
-        "noTraversal"
-      ],
-      "properties": {
-        "cost": {
-          "type": "integer",
-          "maximum": 65535
-        },
-        "disabled": {
-          "type": "boolean",
-          "x-nullable": true
-        },
-        "fingerprint": {
-          "type": "string"
-        },
-        "name": {
-          "type": "string"
-        },
-        "noTraversal": {
-          "type": "boolean"
-        },
-        "tags": {
-          "$ref": "#/definitions/tags"
-        }
-      }
-    },
-    "serviceCreate": {
-      "type": "object",
-      "required": [
-        "name"
-      ],
-      "properties": {
-        "name": {
-          "type": "string"
-        },
-        "tags": {
-          "$ref": "#/definitions/tags"
-        },
-        "terminatorStrategy": {
-          "type": "string"
+      },
+      "delete": {
+        "description": "Delete a service by id. Requires admin access.",
+        "tags": [
+          "Service"
+        ],
+        "summary": "Delete a service",
+        "operationId": "deleteService",
+        "responses": {
+          "200": {
+            "$ref": "#/responses/deleteResponse"
+          },
+          "400": {
+            "$ref": "#/responses/badRequestResponse"
+          },
+          "401": {
+            "$ref": "#/responses/unauthorizedResponse"
+          },
+          "409": {
+            "$ref": "#/responses/cannotDeleteReferencedResourceResponse"
+          },
+          "429": {
+            "$ref": "#/responses/rateLimitedResponse"
+          },
+          "503": {
+            "$ref": "#/responses/serverUnavailableResponse"
+          }
         }
-      }
-    },
-    "serviceDetail": {
-      "type": "object",
-      "allOf": [
-        {
-          "$ref": "#/definitions/baseEntity"
-        },
-        {
-          "type": "object",
-          "required": [
-            "name",
-            "terminatorStrategy"
-          ],
-          "properties": {
-            "name": {
-              "type": "string"
-            },
-            "terminatorStrategy": {
-              "type": "string"
+      },
+      "patch": {
+        "description": "Update the supplied fields on a service. Requires admin access.",
+        "tags": [
+          "Service"
+        ],
+        "summary": "Update the supplied fields on a service",
+        "operationId": "patchService",
+        "parameters": [
+          {
+            "description": "A service patch object",
+            "name": "service",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/servicePatch"
             }
           }
+        ],
+        "responses": {
+          "200": {
+            "$ref": "#/responses/patchResponse"
+          },
+          "400": {
+            "$ref": "#/responses/badRequestResponse"
+          },
+          "401": {
+            "$ref": "#/responses/unauthorizedResponse"
+          },
+          "404": {
+            "$ref": "#/responses/notFoundResponse"
+          },
+          "429": {
+            "$ref": "#/responses/rateLimitedResponse"
+          },
+          "503": {
+            "$ref": "#/responses/serverUnavailableResponse"
+          }
+        }
+      },
+      "parameters": [
+        {
+          "$ref": "#/parameters/id"
         }
       ]
     },
-    "serviceList": {
-      "type": "array",
-      "items": {
-        "$ref": "#/definitions/serviceDetail"
-      }
-    },
-    "servicePatch": {
-      "type": "object",
-      "properties": {
-        "name": {
-          "type": "string"
-        },
-        "tags": {
-          "$ref": "#/definitions/tags"
-        },
-        "terminatorStrategy": {
-          "type": "string"
+    "/services/{id}/history": {
+      "get": {
+        "description": "Retrieves the audit log entries for a service, oldest first. Requires the audit log to be enabled and\nread access to services.\n",
+        "tags": [
+          "Audit"
+        ],
+        "summary": "List the recorded versions of a service",
+        "operationId": "listServiceHistory",
+        "responses": {
+          "200": {
+            "$ref": "#/responses/listAuditEntries"
+          },
+          "401": {
+            "$ref": "#/responses/unauthorizedResponse"
+          },
+          "409": {
+            "$ref": "#/responses/auditNotEnabledResponse"
+          },
+          "429": {
+            "$ref": "#/responses/rateLimitedResponse"
+          }
         }
-      }
+      },
+      "parameters": [
+        {
+          "$ref": "#/parameters/id"
+        }
+      ]
     },
-    "serviceUpdate": {
-      "type": "object",
-      "required": [
-        "name"
-      ],
-      "properties": {
-        "name": {
-          "type": "string"
-        },
-        "tags": {
-          "$ref": "#/definitions/tags"
+    "/services/{id}/history/{version}/revert": {
+      "post": {
+        "description": "Restores the service to the state recorded by the given version. The service is recreated if it has been\ndeleted. Requires the audit log to be enabled and update access to services.\n",
+        "tags": [
+          "Audit"
+        ],
+        "summary": "Revert a service to an earlier version",
+        "operationId": "revertService",
+        "responses": {
+          "200": {
+            "$ref": "#/responses/emptyResponse"
+          },
+          "400": {
+            "$ref": "#/responses/badRequestResponse"
+          },
+          "401": {
+            "$ref": "#/responses/unauthorizedResponse"
+          },
+          "404": {
+            "$ref": "#/responses/notFoundResponse"
+          },
+          "409": {
+            "$ref": "#/responses/auditNotEnabledResponse"
+          },
+          "429": {
+            "$ref": "#/responses/rateLimitedResponse"
+          },
+          "503": {
+            "$ref": "#/responses/serverUnavailableResponse"
+          }
+        }
+      },
+      "parameters": [
+        {
+          "$ref": "#/parameters/id"
         },
-        "terminatorStrategy": {
-          "type": "string"
+        {
+          "type": "integer",
+          "description": "The audit log version to revert to",
+          "name": "version",
+          "in": "path",
+          "required": true
         }
-      }
-    },
-    "subTags": {
-      "type": "object",
-      "additionalProperties": {
-        "type": "object"
-      }
+      ]
     },
-    "tags": {
-      "description": "A map of user defined fields and values. The values are limited to the following types/values: null, string, boolean",
-      "allOf": [
+    "/services/{id}/terminators": {
+      "get": {
+        "description": "Retrieves a list of terminator resources that are assigned specific service; supports filtering, sorting, and pagination.\n",
+        "tags": [
+          "Service"
+        ],
+        "summary": "List of terminators assigned to a service",
+        "operationId": "listServiceTerminators",
+        "parameters": [
+          {
+            "$ref": "#/parameters/limit"
+          },
+          {
+            "$ref": "#/parameters/offset"
+          },
+          {
+            "$ref": "#/parameters/filter"
+          }
+        ],
+        "responses": {
+          "200": {
+            "$ref": "#/responses/listTerminators"
+          },
+          "400": {
+            "$ref": "#/responses/badRequestResponse"
+          },
+          "401": {
+            "$ref": "#/responses/unauthorizedResponse"
+          },
+          "429": {
+            "$ref": "#/responses/rateLimitedResponse"
+          }
+        }
+      },
+      "parameters": [
         {
-          "$ref": "#/definitions/subTags"
+          "$ref": "#/parameters/id"
         }
-      ],
-      "x-nullable": true
-    },
-    "terminatorCost": {
-      "type": "integer",
-      "maximum": 65535
+      ]
     },
-    "terminatorCostMap": {
-      "type": "object",
-      "additionalProperties": {
-        "$ref": "#/definitions/terminatorCost"
+    "/terminators": {
+      "get": {
+        "description": "Retrieves a list of terminator resources; supports filtering, sorting, and pagination. Requires admin access.\n",
+        "tags": [
+          "Terminator"
+        ],
+        "summary": "List terminators",
+        "operationId": "listTerminators",
+        "parameters": [
+          {
+            "$ref": "#/parameters/limit"
+          },
+          {
+            "$ref": "#/parameters/offset"
+          },
+          {
+            "$ref": "#/parameters/filter"
+          }
+        ],
+        "responses": {
+          "200": {
+            "$ref": "#/responses/listTerminators"
+          },
+          "400": {
+            "$ref": "#/responses/badRequestResponse"
+          },
+          "401": {
+            "$ref": "#/responses/unauthorizedResponse"
+          },
+          "429": {
+            "$ref": "#/responses/rateLimitedResponse"
+          }
+        }
+      },
+      "post": {
+        "description": "Create a terminator resource. Requires admin access.",
+        "tags": [
+          "Terminator"
+        ],
+        "summary": "Create a terminator resource",
+        "operationId": "createTerminator",
+        "parameters": [
+          {
+            "description": "A terminator to create",
+            "name": "terminator",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/terminatorCreate"
+            }
+          }
+        ],
+        "responses": {
+          "201": {
+            "$ref": "#/responses/createResponse"
+          },
+          "400": {
+            "$ref": "#/responses/badRequestResponse"
+          },
+          "401": {
+            "$ref": "#/responses/unauthorizedResponse"
+          },
+          "429": {
+            "$ref": "#/responses/rateLimitedResponse"
+          },
+          "503": {
+            "$ref": "#/responses/serverUnavailableResponse"
+          }
+        }
       }
     },
-    "terminatorCreate": {
+    "/terminators/{id}": {
+      "get": {
+        "description": "Retrieves a single terminator by id. Requires admin access.",
+        "tags": [
+          "Terminator"
+        ],
+        "summary": "Retrieves a single terminator",
+        "operationId": "detailTerminator",
+        "responses": {
+          "200": {
+            "$ref": "#/responses/detailTerminator"
+          },
+          "401": {
+            "$ref": "#/responses/unauthorizedResponse"
+          },
+          "404": {
+            "$ref": "#/responses/notFoundResponse"
+          },
+          "429": {
+            "$ref": "#/responses/rateLimitedResponse"
+          }
+        }
+      },
+      "put": {
+        "description": "Update all fields on a terminator by id. Requires admin access.",
+        "tags": [
+          "Terminator"
+        ],
+        "summary": "Update all fields on a terminator",
+        "operationId": "updateTerminator",
+        "parameters": [
+          {
+            "description": "A terminator update object",
+            "name": "terminator",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/terminatorUpdate"
+            }
+          }
+        ],
+        "responses": {
+          "200": {
+            "$ref": "#/responses/updateResponse"
+          },
+          "400": {
+            "$ref": "#/responses/badRequestResponse"
+          },
+          "401": {
+            "$ref": "#/responses/unauthorizedResponse"
+          },
+          "404": {
+            "$ref": "#/responses/notFoundResponse"
+          },
+          "429": {
+            "$ref": "#/responses/rateLimitedResponse"
+          },
+          "503": {
+            "$ref": "#/responses/serverUnavailableResponse"
+          }
+        }
+      },
+      "delete": {
+        "description": "Delete a terminator by id. Requires admin access.",
+        "tags": [
+          "Terminator"
+        ],
+        "summary": "Delete a terminator",
+        "operationId": "deleteTerminator",
+        "responses": {
+          "200": {
+            "$ref": "#/responses/deleteResponse"
+          },
+          "400": {
+            "$ref": "#/responses/badRequestResponse"
+          },
+          "401": {
+            "$ref": "#/responses/unauthorizedResponse"
+          },
+          "409": {
+            "$ref": "#/responses/cannotDeleteReferencedResourceResponse"
+          },
+          "429": {
+            "$ref": "#/responses/rateLimitedResponse"
+          },
+          "503": {
+            "$ref": "#/responses/serverUnavailableResponse"
+          }
+        }
+      },
+      "patch": {
+        "description": "Update the supplied fields on a terminator. Requires admin access.",
+        "tags": [
+          "Terminator"
+        ],
+        "summary": "Update the supplied fields on a terminator",
+        "operationId": "patchTerminator",
+        "parameters": [
+          {
+            "description": "A terminator patch object",
+            "name": "terminator",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/terminatorPatch"
+            }
+          }
+        ],
+        "responses": {
+          "200": {
+            "$ref": "#/responses/patchResponse"
+          },
+          "400": {
+            "$ref": "#/responses/badRequestResponse"
+          },
+          "401": {
+            "$ref": "#/responses/unauthorizedResponse"
+          },
+          "404": {
+            "$ref": "#/responses/notFoundResponse"
+          },
+          "429": {
+            "$ref": "#/responses/rateLimitedResponse"
+          },
+          "503": {
+            "$ref": "#/responses/serverUnavailableResponse"
+          }
+        }
+      },
+      "parameters": [
+        {
+          "$ref": "#/parameters/id"
+        }
+      ]
+    },
+    "/topology": {
+      "get": {
+        "description": "Retrieves a snapshot of the overlay graph as seen by this controller: the routers, whether they're connected,\nthe links between them with their costs and latencies, and the number of circuits using each router and link.\nRequires admin access.\n",
+        "tags": [
+          "Topology"
+        ],
+        "summary": "Retrieve the network topology",
+        "operationId": "detailTopology",
+        "responses": {
+          "200": {
+            "$ref": "#/responses/detailTopology"
+          },
+          "401": {
+            "$ref": "#/responses/unauthorizedResponse"
+          },
+          "429": {
+            "$ref": "#/responses/rateLimitedResponse"
+          }
+        }
+      }
+    }
+  },
+  "definitions": {
+    "alertDetail": {
       "type": "object",
       "required": [
-        "service",
-        "router",
-        "address",
-        "binding"
+        "id",
+        "rule",
+        "severity",
+        "state",
+        "entityType",
+        "entityId",
+        "message",
+        "pendingSince"
       ],
       "properties": {
-        "address": {
+        "entityId": {
           "type": "string"
         },
-        "binding": {
+        "entityName": {
           "type": "string"
         },
-        "cost": {
-          "$ref": "#/definitions/terminatorCost"
+        "entityType": {
+          "type": "string"
         },
-        "hostId": {
+        "firingSince": {
+          "type": "string",
+          "format": "date-time"
+        },
+        "id": {
+          "description": "The alert id, shared with the alert events emitted when the alert fires and resolves",
           "type": "string"
         },
-        "instanceId": {
+        "message": {
           "type": "string"
         },
-        "instanceSecret": {
+        "pendingSince": {
           "type": "string",
-          "format": "byte"
-        },
-        "precedence": {
-          "$ref": "#/definitions/terminatorPrecedence"
+          "format": "date-time"
         },
-        "router": {
+        "rule": {
           "type": "string"
         },
-        "service": {
+        "severity": {
+          "type": "string",
+          "enum": [
+            "info",
+            "warning",
+            "error",
+            "critical"
+          ]
+        },
+        "silencedBy": {
+          "description": "The id of the silence suppressing the alert's events, if any",
           "type": "string"
         },
-        "tags": {
-          "$ref": "#/definitions/tags"
-        }
-      }
-    },
-    "terminatorDetail": {
-      "type": "object",
-      "allOf": [
-        {
-          "$ref": "#/definitions/baseEntity"
-        },
-        {
-          "type": "object",
-          "required": [
-            "serviceId",
-            "service",
-            "routerId",
-            "router",
-            "binding",
-            "address",
-            "instanceId",
-            "cost",
-            "precedence",
-            "dynamicCost",
-            "hostId"
-          ],
-          "properties": {
-            "address": {
-              "type": "string"
-            },
-            "binding": {
-              "type": "string"
-            },
-            "cost": {
-              "$ref": "#/definitions/terminatorCost"
-            },
-            "dynamicCost": {
-              "$ref": "#/definitions/terminatorCost"
-            },
-            "hostId": {
-              "type": "string"
-            },
-            "instanceId": {
-              "type": "string"
-            },
-            "precedence": {
-              "$ref": "#/definitions/terminatorPrecedence"
-            },
-            "router": {
-              "$ref": "#/definitions/entityRef"
-            },
-            "routerId": {
-              "type": "string"
-            },
-            "service": {
-              "$ref": "#/definitions/entityRef"
-            },
-            "serviceId": {
-              "type": "string"
-            }
-          }
+        "state": {
+          "type": "string",
+          "enum": [
+            "pending",
+            "firing"
+          ]
         }
-      ]
+      }
     },
-    "terminatorList": {
+    "alertList": {
       "type": "array",
       "items": {
-        "$ref": "#/definitions/terminatorDetail"
+        "$ref": "#/definitions/alertDetail"
       }
     },
-    "terminatorPatch": {
+    "alertRuleDetail": {
       "type": "object",
+      "required": [
+        "name",
+        "type",
+        "severity",
+        "forSeconds"
+      ],
       "properties": {
-        "address": {
-          "type": "string"
+        "expiresWithinSeconds": {
+          "description": "For certExpiry rules, how long before expiry the rule fires",
+          "type": "integer"
         },
-        "binding": {
+        "filter": {
+          "description": "Selects the services or routers the rule applies to. Not used by linkLatency rules",
           "type": "string"
         },
-        "cost": {
-          "$ref": "#/definitions/terminatorCost"
+        "forSeconds": {
+          "description": "How long the rule condition must hold before the alert fires",
+          "type": "integer"
         },
-        "hostId": {
-          "type": "string"
+        "latencyMillis": {
+          "description": "For linkLatency rules, the latency above which the rule fires",
+          "type": "integer"
         },
-        "precedence": {
-          "$ref": "#/definitions/terminatorPrecedence"
+        "minTerminators": {
+          "description": "For serviceTerminators rules, the number of terminators below which the rule fires",
+          "type": "integer"
         },
-        "router": {
+        "name": {
           "type": "string"
         },
-        "service": {
+        "percentile": {
+          "description": "For linkLatency rules, the percentile of the latency samples which is compared",
+          "type": "number"
+        },
+        "severity": {
           "type": "string"
         },
-        "tags": {
-          "$ref": "#/definitions/tags"
+        "type": {
+          "type": "string",
+          "enum": [
+            "linkLatency",
+            "serviceTerminators",
+            "routerOffline",
+            "certExpiry"
+          ]
+        },
+        "windowSeconds": {
+          "description": "For linkLatency rules, the window over which latency samples are collected",
+          "type": "integer"
         }
       }
     },
-    "terminatorPrecedence": {
-      "type": "string",
-      "enum": [
-        "default",
-        "required",
-        "failed"
-      ]
-    },
-    "terminatorPrecedenceMap": {
-      "type": "object",
-      "additionalProperties": {
-        "$ref": "#/definitions/terminatorPrecedence"
+    "alertRuleList": {
+      "type": "array",
+      "items": {
+        "$ref": "#/definitions/alertRuleDetail"
       }
     },
-    "terminatorUpdate": {
+    "alertSilenceCreate": {
       "type": "object",
       "required": [
-        "service",
-        "router",
-        "address",
-        "binding"
+        "expiresAt"
       ],
       "properties": {
-        "address": {
+        "comment": {
           "type": "string"
         },
-        "binding": {
+        "entityId": {
+          "description": "The id of the entity whose alerts are silenced. At least one of rule and entityId must be provided",
           "type": "string"
         },
-        "cost": {
-          "$ref": "#/definitions/terminatorCost"
+        "expiresAt": {
+          "type": "string",
+          "format": "date-time"
         },
-        "hostId": {
+        "rule": {
+          "description": "The name of the rule whose alerts are silenced. At least one of rule and entityId must be provided",
+          "type": "string"
+        }
+      }
+    },
+    "alertSilenceDetail": {
+      "type": "object",
+      "required": [
+        "id",
+        "createdAt",
+        "expiresAt"
+      ],
+      "properties": {
+        "comment": {
           "type": "string"
         },
-        "precedence": {
-          "$ref": "#/definitions/terminatorPrecedence"
+        "createdAt": {
+          "type": "string",
+          "format": "date-time"
         },
-        "router": {
+        "entityId": {
           "type": "string"
         },
-        "service": {
+        "expiresAt": {
+          "type": "string",
+          "format": "date-time"
+        },
+        "id": {
           "type": "string"
         },
-        "tags": {
-          "$ref": "#/definitions/tags"
+        "rule": {
+          "type": "string"
         }
       }
     },
-    "versionInfo": {
-      "description": "Application build information",
+    "alertSilenceEnvelope": {
       "type": "object",
+      "required": [
+        "meta",
+        "data"
+      ],
       "properties": {
-        "arch": {
-          "type": "string"
+        "data": {
+          "$ref": "#/definitions/alertSilenceDetail"
         },
-        "buildDate": {
+        "meta": {
+          "$ref": "#/definitions/meta"
+        }
+      }
+    },
+    "alertSilenceList": {
+      "type": "array",
+      "items": {
+        "$ref": "#/definitions/alertSilenceDetail"
+      }
+    },
+    "apiError": {
+      "type": "object",
+      "properties": {
+        "args": {
+          "$ref": "#/definitions/apiErrorArgs"
+        },
+        "cause": {
+          "$ref": "#/definitions/apiErrorCause"
+        },
+        "causeMessage": {
           "type": "string"
         },
-        "os": {
+        "code": {
           "type": "string"
         },
-        "revision": {
+        "data": {
+          "type": "object",
+          "additionalProperties": true
+        },
+        "message": {
           "type": "string"
         },
-        "version": {
+        "requestId": {
           "type": "string"
         }
       }
-    }
-  },
-  "parameters": {
-    "filter": {
-      "type": "string",
-      "name": "filter",
-      "in": "query"
-    },
-    "id": {
-      "type": "string",
-      "description": "The id of the requested resource",
-      "name": "id",
-      "in": "path",
-      "required": true
-    },
-    "limit": {
-      "type": "integer",
-      "name": "limit",
-      "in": "query"
     },
-    "offset": {
-      "type": "integer",
-      "name": "offset",
-      "in": "query"
-    }
-  },
-  "responses": {
-    "badRequestResponse": {
-      "description": "The supplied request contains invalid fields or could not be parsed (json and non-json bodies). The error's code, message, and cause fields can be inspected for further information",
-      "schema": {
-        "$ref": "#/definitions/apiErrorEnvelope"
-      },
-      "examples": {
-        "application/json": {
-          "error": {
-            "args": {
-              "urlVars": {}
-            },
-            "cause": {
-              "details": {
-                "context": "(root)",
-                "field": "(root)",
-                "property": "fooField3"
-              },
-              "field": "(root)",
-              "message": "(root): fooField3 is required",
-              "type": "required",
-              "value": {
-                "fooField": "abc",
-                "fooField2": "def"
-              }
-            },
-            "causeMessage": "schema validation failed",
-            "code": "COULD_NOT_VALIDATE",
-            "message": "The supplied request contains an invalid document",
-            "requestId": "ac6766d6-3a09-44b3-8d8a-1b541d97fdd9"
-          },
-          "meta": {
-            "apiEnrollmentVersion": "0.0.1",
-            "apiVersion": "0.0.1"
+    "apiErrorArgs": {
+      "type": "object",
+      "properties": {
+        "urlVars": {
+          "type": "object",
+          "additionalProperties": {
+            "type": "string"
           }
         }
       }
     },
-    "cannotDeleteReferencedResourceResponse": {
-      "description": "The resource requested to be removed/altered cannot be as it is referenced by another object.",
-      "schema": {
-        "$ref": "#/definitions/apiErrorEnvelope"
-      },
-      "examples": {
-        "application/json": {
-          "error": {
-            "args": {
-              "urlVars": {
-                "id": "71a3000f-7dda-491a-9b90-a19f4ee6c406"
-              }
-            },
-            "causeMessage": "referenced by /some-resource/05f4f710-c155-4a74-86d5-77558eb9cb42",
-            "code": "CONFLICT_CANNOT_MODIFY_REFERENCED",
-            "message": "The resource cannot be deleted/modified. Remove all referencing resources first.",
-            "requestId": "270908d6-f2ef-4577-b973-67bec18ae376"
-          },
-          "meta": {
-            "apiEnrollmentVersion": "0.0.1",
-            "apiVersion": "0.0.1"
-          }
+    "apiErrorCause": {
+      "allOf": [
+        {
+          "$ref": "#/definitions/apiFieldError"
+        },
+        {
+          "$ref": "#/definitions/apiError"
         }
-      }
-    },
-    "clusterListMembersResponse": {
-      "description": "A response to a cluster list-members request",
-      "schema": {
-        "$ref": "#/definitions/clusterMemberListResponse"
-      }
-    },
-    "createResponse": {
-      "description": "The create request was successful and the resource has been added at the following location",
-      "schema": {
-        "$ref": "#/definitions/createEnvelope"
-      }
-    },
-    "dataIntegrityCheckResult": {
-      "description": "A list of data integrity issues found",
-      "schema": {
-        "$ref": "#/definitions/dataIntegrityCheckResultEnvelope"
-      }
-    },
-    "databaseSnapshotCreateResult": {
-      "description": "The path to the created snapshot",
-      "schema": {
-        "$ref": "#/definitions/databaseSnapshotCreateResultEnvelope"
-      }
+      ]
     },
-    "deleteResponse": {
-      "description": "The delete request was successful and the resource has been removed",
-      "schema": {
-        "$ref": "#/definitions/empty"
+    "apiErrorEnvelope": {
+      "type": "object",
+      "required": [
+        "meta",
+        "error"
+      ],
+      "properties": {
+        "error": {
+          "$ref": "#/definitions/apiError"
+        },
+        "meta": {
+          "$ref": "#/definitions/meta"
+        }
       }
     },
-    "detailCircuit": {
-      "description": "A single circuit",
-      "schema": {
-        "$ref": "#/definitions/detailCircuitEnvelope"
+    "apiFieldError": {
+      "type": "object",
+      "properties": {
+        "field": {
+          "type": "string"
+        },
+        "reason": {
+          "type": "string"
+        },
+        "value": {
+          "type": "string"
+        }
       }
     },
-    "detailLink": {
-      "description": "A single link",
-      "schema": {
-        "$ref": "#/definitions/detailLinkEnvelope"
+    "auditAuthor": {
+      "type": "object",
+      "properties": {
+        "id": {
+          "type": "string"
+        },
+        "name": {
+          "type": "string"
+        },
+        "type": {
+          "type": "string"
+        }
       }
     },
-    "detailRouter": {
-      "description": "A single router",
-      "schema": {
-        "$ref": "#/definitions/detailRouterEnvelope"
+    "auditEntry": {
+      "type": "object",
+      "required": [
+        "sequence",
+        "entityType",
+        "entityId",
+        "version",
+        "changeType",
+        "timestamp"
+      ],
+      "properties": {
+        "author": {
+          "$ref": "#/definitions/auditAuthor"
+        },
+        "changeType": {
+          "type": "string",
+          "enum": [
+            "created",
+            "updated",
+            "deleted"
+          ]
+        },
+        "entityId": {
+          "type": "string"
+        },
+        "entityType": {
+          "type": "string"
+        },
+        "eventId": {
+          "type": "string"
+        },
+        "finalState": {
+          "type": "object"
+        },
+        "initialState": {
+          "type": "object"
+        },
+        "sequence": {
+          "type": "integer"
+        },
+        "source": {
+          "$ref": "#/definitions/auditSource"
+        },
+        "timestamp": {
+          "type": "string",
+          "format": "date-time"
+        },
+        "traceId": {
+          "type": "string"
+        },
+        "version": {
+          "type": "integer"
+        }
       }
     },
-    "detailService": {
-      "description": "A single service",
-      "schema": {
-        "$ref": "#/definitions/detailServiceEnvelope"
+    "auditEntryList": {
+      "type": "array",
+      "items": {
+        "$ref": "#/definitions/auditEntry"
       }
     },
-    "detailTerminator": {
-      "description": "A single terminator",
-      "schema": {
-        "$ref": "#/definitions/detailTerminatorEnvelope"
+    "auditSource": {
+      "type": "object",
+      "properties": {
+        "auth": {
+          "type": "string"
+        },
+        "localAddr": {
+          "type": "string"
+        },
+        "method": {
+          "type": "string"
+        },
+        "remoteAddr": {
+          "type": "string"
+        },
+        "type": {
+          "type": "string"
+        }
       }
     },
-    "emptyResponse": {
-      "description": "Base empty response",
-      "schema": {
-        "$ref": "#/definitions/empty"
-      }
+    "bandwidthBurst": {
+      "description": "The number of bytes which may be sent at once, above the sustained rate. Defaults to one second of traffic",
+      "type": "integer",
+      "format": "int64"
     },
-    "inspectResponse": {
-      "description": "A response to an inspect request",
-      "schema": {
-        "$ref": "#/definitions/inspectResponse"
+    "bandwidthLimitCreate": {
+      "type": "object",
+      "required": [
+        "name",
+        "rate"
+      ],
+      "properties": {
+        "burst": {
+          "$ref": "#/definitions/bandwidthBurst"
+        },
+        "edgeRouterPolicies": {
+          "$ref": "#/definitions/edgeRouterPolicyIds"
+        },
+        "identityRoles": {
+          "$ref": "#/definitions/roles"
+        },
+        "name": {
+          "type": "string"
+        },
+        "rate": {
+          "$ref": "#/definitions/bandwidthRate"
+        },
+        "serviceRoles": {
+          "$ref": "#/definitions/roles"
+        },
+        "shared": {
+          "type": "boolean"
+        },
+        "tags": {
+          "$ref": "#/definitions/tags"
+        }
       }
     },
-    "invalidAuthResponse": {
-      "description": "The authentication request could not be processed as the credentials are invalid",
-      "schema": {
-        "$ref": "#/definitions/apiErrorEnvelope"
-      },
-      "examples": {
-        "application/json": {
-          "error": {
-            "args": {
-              "urlVars": {}
+    "bandwidthLimitDetail": {
+      "type": "object",
+      "allOf": [
+        {
+          "$ref": "#/definitions/baseEntity"
+        },
+        {
+          "type": "object",
+          "required": [
+            "name",
+            "rate",
+            "burst",
+            "shared",
+            "identityRoles",
+            "serviceRoles",
+            "edgeRouterPolicies"
+          ],
+          "properties": {
+            "burst": {
+              "$ref": "#/definitions/bandwidthBurst"
             },
-            "cause": "",
-            "causeMessage": "",
-            "code": "INVALID_AUTH",
-            "message": "The authentication request failed",
-            "requestId": "5952ed10-3091-474f-a691-47ebab6990dc"
-          },
-          "meta": {
-            "apiEnrollmentVersion": "0.0.1",
-            "apiVersion": "0.0.1"
+            "edgeRouterPolicies": {
+              "$ref": "#/definitions/edgeRouterPolicyIds"
+            },
+            "identityRoles": {
+              "$ref": "#/definitions/roles"
+            },
+            "name": {
+              "type": "string"
+            },
+            "rate": {
+              "$ref": "#/definitions/bandwidthRate"
+            },
+            "serviceRoles": {
+              "$ref": "#/definitions/roles"
+            },
+            "shared": {
+              "type": "boolean"
+            }
           }
         }
-      }
-    },
-    "listCircuits": {
-      "description": "A list of circuits",
-      "schema": {
-        "$ref": "#/definitions/listCircuitsEnvelope"
-      }
+      ]
     },
-    "listLinks": {
-      "description": "A list of links",
-      "schema": {
-        "$ref": "#/definitions/listLinksEnvelope"
+    "bandwidthLimitList": {
+      "type": "array",
+      "items": {
+        "$ref": "#/definitions/bandwidthLimitDetail"
       }
     },
-    "listRouters": {
-      "description": "A list of routers",
-      "schema": {
-        "$ref": "#/definitions/listRoutersEnvelope"
+    "bandwidthLimitPatch": {
+      "type": "object",
+      "properties": {
+        "burst": {
+          "$ref": "#/definitions/bandwidthBurst"
+        },
+        "edgeRouterPolicies": {
+          "$ref": "#/definitions/edgeRouterPolicyIds"
+        },
+        "identityRoles": {
+          "$ref": "#/definitions/roles"
+        },
+        "name": {
+          "type": "string"
+        },
+        "rate": {
+          "$ref": "#/definitions/bandwidthRate"
+        },
+        "serviceRoles": {
+          "$ref": "#/definitions/roles"
+        },
+        "shared": {
+          "type": "boolean"
+        },
+        "tags": {
+          "$ref": "#/definitions/tags"
+        }
       }
     },
-    "listServices": {
-      "description": "A list of services",
-      "schema": {
-        "$ref": "#/definitions/listServicesEnvelope"
+    "bandwidthLimitUpdate": {
+      "type": "object",
+      "required": [
+        "name",
+        "rate"
+      ],
+      "properties": {
+        "burst": {
+          "$ref": "#/definitions/bandwidthBurst"
+        },
+        "edgeRouterPolicies": {
+          "$ref": "#/definitions/edgeRouterPolicyIds"
+        },
+        "identityRoles": {
+          "$ref": "#/definitions/roles"
+        },
+        "name": {
+          "type": "string"
+        },
+        "rate": {
+          "$ref": "#/definitions/bandwidthRate"
+        },
+        "serviceRoles": {
+          "$ref": "#/definitions/roles"
+        },
+        "shared": {
+          "type": "boolean"
+        },
+        "tags": {
+          "$ref": "#/definitions/tags"
+        }
       }
     },
-    "listTerminators": {
-      "description": "A list of terminators",
-      "schema": {
-        "$ref": "#/definitions/listTerminatorsEnvelope"
-      }
+    "bandwidthRate": {
+      "description": "The sustained rate, in bytes per second",
+      "type": "integer",
+      "format": "int64"
     },
-    "notFoundResponse": {
-      "description": "The requested resource does not exist",
-      "schema": {
-        "$ref": "#/definitions/apiErrorEnvelope"
-      },
-      "examples": {
-        "application/json": {
-          "error": {
-            "args": {
-              "urlVars": {
-                "id": "71a3000f-7dda-491a-9b90-a19f4ee6c406"
-              }
-            },
-            "cause": null,
-            "causeMessage": "",
-            "code": "NOT_FOUND",
-            "message": "The resource requested was not found or is no longer available",
-            "requestId": "270908d6-f2ef-4577-b973-67bec18ae376"
-          },
-          "meta": {
-            "apiEnrollmentVersion": "0.0.1",
-            "apiVersion": "0.0.1"
-          }
+    "baseEntity": {
+      "description": "Fields shared by all Edge API entities",
+      "type": "object",
+      "required": [
+        "id",
+        "createdAt",
+        "updatedAt",
+        "_links",
+        "tags"
+      ],
+      "properties": {
+        "_links": {
+          "$ref": "#/definitions/links"
+        },
+        "createdAt": {
+          "type": "string",
+          "format": "date-time"
+        },
+        "id": {
+          "type": "string"
+        },
+        "tags": {
+          "$ref": "#/definitions/tags"
+        },
+        "updatedAt": {
+          "type": "string",
+          "format": "date-time"
         }
       }
     },
-    "patchResponse": {
-      "description": "The patch request was successful and the resource has been altered",
-      "schema": {
-        "$ref": "#/definitions/empty"
+    "bulkOperationCreate": {
+      "type": "object",
+      "required": [
+        "operation"
+      ],
+      "properties": {
+        "authPolicyId": {
+          "type": "string"
+        },
+        "batchSize": {
+          "description": "The number of identities updated per command. Defaults to 100, may be at most 1000",
+          "type": "integer"
+        },
+        "durationMinutes": {
+          "description": "How long identities are disabled for. If zero, they are disabled until enabled",
+          "type": "integer"
+        },
+        "expiresAt": {
+          "description": "When identities expire. If not provided, the expiry date is cleared",
+          "type": "string",
+          "format": "date-time",
+          "x-nullable": true
+        },
+        "filter": {
+          "description": "A filter selecting the identities to apply the operation to, ex. 'anyOf(roleAttributes) = \"sales\"'",
+          "type": "string"
+        },
+        "identityIds": {
+          "description": "The identities to apply the operation to. Exactly one of identityIds or filter must be provided",
+          "type": "array",
+          "items": {
+            "type": "string"
+          }
+        },
+        "operation": {
+          "type": "string",
+          "enum": [
+            "addRoleAttributes",
+            "removeRoleAttributes",
+            "setAuthPolicy",
+            "disable",
+            "enable",
+            "delete",
+            "setExpiresAt"
+          ]
+        },
+        "roleAttributes": {
+          "description": "The role attributes to add or remove",
+          "type": "array",
+          "items": {
+            "type": "string"
+          }
+        }
       }
     },
-    "rateLimitedResponse": {
-      "description": "The resource requested is rate limited and the rate limit has been exceeded",
-      "schema": {
-        "$ref": "#/definitions/apiErrorEnvelope"
-      },
-      "examples": {
-        "application/json": {
-          "error": {
-            "args": {
-              "urlVars": {}
-            },
-            "causeMessage": "you have hit a rate limit in the requested operation",
-            "code": "RATE_LIMITED",
-            "message": "The resource is rate limited and the rate limit has been exceeded. Please try again later",
-            "requestId": "270908d6-f2ef-4577-b973-67bec18ae376"
-          },
-          "meta": {
-            "apiEnrollmentVersion": "0.0.1",
-            "apiVersion": "0.0.1"
+    "bulkOperationDetail": {
+      "type": "object",
+      "required": [
+        "id",
+        "operation",
+        "total",
+        "processed",
+        "succeeded",
+        "failed",
+        "complete",
+        "startedAt"
+      ],
+      "properties": {
+        "complete": {
+          "type": "boolean"
+        },
+        "completedAt": {
+          "type": "string",
+          "format": "date-time"
+        },
+        "failed": {
+          "type": "integer"
+        },
+        "failures": {
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/bulkOperationFailure"
           }
+        },
+        "id": {
+          "type": "string"
+        },
+        "operation": {
+          "type": "string"
+        },
+        "processed": {
+          "type": "integer"
+        },
+        "startedAt": {
+          "type": "string",
+          "format": "date-time"
+        },
+        "succeeded": {
+          "type": "integer"
+        },
+        "total": {
+          "type": "integer"
         }
       }
     },
-    "serverUnavailableResponse": {
-      "description": "The request could not be completed due to the server being busy or in a temporarily bad state",
-      "schema": {
-        "$ref": "#/definitions/apiErrorEnvelope"
+    "bulkOperationEnvelope": {
+      "type": "object",
+      "required": [
+        "meta",
+        "data"
+      ],
+      "properties": {
+        "data": {
+          "$ref": "#/definitions/bulkOperationDetail"
+        },
+        "meta": {
+          "$ref": "#/definitions/meta"
+        }
       }
     },
-    "unauthorizedResponse": {
-      "description": "The currently supplied session does not have the correct access rights to request this resource",
-      "schema": {
-        "$ref": "#/definitions/apiErrorEnvelope"
-      },
-      "examples": {
-        "application/json": {
-          "error": {
-            "args": {
-              "urlVars": {}
-            },
-            "cause": "",
-            "causeMessage": "",
-            "code": "UNAUTHORIZED",
-            "message": "The request could not be completed. The session is not authorized or the credentials are invalid",
-            "requestId": "0bfe7a04-9229-4b7a-812c-9eb3cc0eac0f"
-          },
-          "meta": {
-            "apiEnrollmentVersion": "0.0.1",
-            "apiVersion": "0.0.1"
-          }
+    "bulkOperationFailure": {
+      "type": "object",
+      "required": [
+        "identityId",
+        "error"
+      ],
+      "properties": {
+        "error": {
+          "type": "string"
+        },
+        "identityId": {
+          "type": "string"
         }
       }
     },
-    "updateResponse": {
-      "description": "The update request was successful and the resource has been altered",
-      "schema": {
-        "$ref": "#/definitions/empty"
+    "bulkOperationList": {
+      "type": "array",
+      "items": {
+        "$ref": "#/definitions/bulkOperationDetail"
       }
-    }
-  }
-}`))
-	FlatSwaggerJSON = json.RawMessage([]byte(`{
-  "consumes": [
-    "application/json"
-  ],
-  "produces": [
-    "application/json"
-  ],
-  "schemes": [
-    "https"
-  ],
-  "swagger": "2.0",
-  "info": {
-    "description": "OpenZiti Fabric API",
-    "title": "Ziti Fabric",
-    "contact": {
-      "name": "OpenZiti",
-      "url": "https://openziti.discourse.group",
-      "email": "help@openziti.org"
     },
-    "license": {
-      "name": "Apache 2.0",
-      "url": "http://www.apache.org/licenses/LICENSE-2.0.html"
+    "circuitDelete": {
+      "type": "object",
+      "properties": {
+        "immediate": {
+          "type": "boolean"
+        }
+      }
     },
-    "version": "0.16.54"
-  },
-  "host": "demo.ziti.dev",
-  "basePath": "/fabric/v1",
-  "paths": {
-    "/circuits": {
-      "get": {
-        "description": "Retrieves a list of circuit resources; does not supports filtering, sorting, or pagination. Requires admin access.\n",
-        "tags": [
-          "Circuit"
-        ],
-        "summary": "List circuits",
-        "operationId": "listCircuits",
-        "parameters": [
-          {
-            "type": "integer",
-            "name": "limit",
-            "in": "query"
-          },
-          {
-            "type": "integer",
-            "name": "offset",
-            "in": "query"
-          },
-          {
-            "type": "string",
-            "name": "filter",
-            "in": "query"
-          }
-        ],
-        "responses": {
-          "200": {
-            "description": "A list of circuits",
-            "schema": {
-              "$ref": "#/definitions/listCircuitsEnvelope"
-            }
-          },
-          "401": {
-            "description": "The currently supplied session does not have the correct access rights to request this resource",
-            "schema": {
-              "$ref": "#/definitions/apiErrorEnvelope"
+    "circuitDetail": {
+      "allOf": [
+        {
+          "$ref": "#/definitions/baseEntity"
+        },
+        {
+          "type": "object",
+          "required": [
+            "service",
+            "terminator",
+            "path"
+          ],
+          "properties": {
+            "clientId": {
+              "type": "string"
             },
-            "examples": {
-              "application/json": {
-                "error": {
-                  "args": {
-                    "urlVars": {}
-                  },
-                  "cause": "",
-                  "causeMessage": "",
-                  "code": "UNAUTHORIZED",
-                  "message": "The request could not be completed. The session is not authorized or the credentials are invalid",
-                  "requestId": "0bfe7a04-9229-4b7a-812c-9eb3cc0eac0f"
-                },
-                "meta": {
-                  "apiEnrollmentVersion": "0.0.1",
-                  "apiVersion": "0.0.1"
-                }
-              }
-            }
-          },
-          "429": {
-            "description": "The resource requested is rate limited and the rate limit has been exceeded",
-            "schema": {
-              "$ref": "#/definitions/apiErrorEnvelope"
+            "path": {
+              "$ref": "#/definitions/path"
             },
-            "examples": {
-              "application/json": {
-                "error": {
-                  "args": {
-                    "urlVars": {}
-                  },
-                  "causeMessage": "you have hit a rate limit in the requested operation",
-                  "code": "RATE_LIMITED",
-                  "message": "The resource is rate limited and the rate limit has been exceeded. Please try again later",
-                  "requestId": "270908d6-f2ef-4577-b973-67bec18ae376"
-                },
-                "meta": {
-                  "apiEnrollmentVersion": "0.0.1",
-                  "apiVersion": "0.0.1"
-                }
-              }
+            "service": {
+              "$ref": "#/definitions/entityRef"
+            },
+            "terminator": {
+              "$ref": "#/definitions/entityRef"
             }
           }
         }
-      }
+      ]
     },
-    "/circuits/{id}": {
-      "get": {
-        "description": "Retrieves a single circuit by id. Requires admin access.",
-        "tags": [
-          "Circuit"
-        ],
-        "summary": "Retrieves a single circuit",
-        "operationId": "detailCircuit",
-        "responses": {
-          "200": {
-            "description": "A single circuit",
-            "schema": {
-              "$ref": "#/definitions/detailCircuitEnvelope"
-            }
-          },
-          "401": {
-            "description": "The currently supplied session does not have the correct access rights to request this resource",
-            "schema": {
-              "$ref": "#/definitions/apiErrorEnvelope"
-            },
-            "examples": {
-              "application/json": {
-                "error": {
-                  "args": {
-                    "urlVars": {}
-                  },
-                  "cause": "",
-                  "causeMessage": "",
-                  "code": "UNAUTHORIZED",
-                  "message": "The request could not be completed. The session is not authorized or the credentials are invalid",
-                  "requestId": "0bfe7a04-9229-4b7a-812c-9eb3cc0eac0f"
-                },
-                "meta": {
-                  "apiEnrollmentVersion": "0.0.1",
-                  "apiVersion": "0.0.1"
-                }
-              }
-            }
-          },
-          "404": {
-            "description": "The requested resource does not exist",
-            "schema": {
-              "$ref": "#/definitions/apiErrorEnvelope"
-            },
-            "examples": {
-              "application/json": {
-                "error": {
-                  "args": {
-                    "urlVars": {
-                      "id": "71a3000f-7dda-491a-9b90-a19f4ee6c406"
-                    }
-                  },
-                  "cause": null,
-                  "causeMessage": "",
-                  "code": "NOT_FOUND",
-                  "message": "The resource requested was not found or is no longer available",
-                  "requestId": "270908d6-f2ef-4577-b973-67bec18ae376"
-                },
-                "meta": {
-                  "apiEnrollmentVersion": "0.0.1",
-                  "apiVersion": "0.0.1"
-                }
-              }
-            }
-          },
-          "429": {
-            "description": "The resource requested is rate limited and the rate limit has been exceeded",
-            "schema": {
-              "$ref": "#/definitions/apiErrorEnvelope"
-            },
-            "examples": {
-              "application/json": {
-                "error": {
-                  "args": {
-                    "urlVars": {}
-                  },
-                  "causeMessage": "you have hit a rate limit in the requested operation",
-                  "code": "RATE_LIMITED",
-                  "message": "The resource is rate limited and the rate limit has been exceeded. Please try again later",
-                  "requestId": "270908d6-f2ef-4577-b973-67bec18ae376"
-                },
-                "meta": {
-                  "apiEnrollmentVersion": "0.0.1",
-                  "apiVersion": "0.0.1"
-                }
-              }
-            }
+    "circuitHistoryEntry": {
+      "type": "object",
+      "required": [
+        "circuitId",
+        "serviceId",
+        "state",
+        "createdAt",
+        "durationMillis",
+        "routers",
+        "links",
+        "pathUpdates",
+        "ingressRxBytes",
+        "ingressTxBytes",
+        "egressRxBytes",
+        "egressTxBytes"
+      ],
+      "properties": {
+        "circuitId": {
+          "type": "string"
+        },
+        "createdAt": {
+          "type": "string",
+          "format": "date-time"
+        },
+        "creationTimespanMillis": {
+          "type": "number",
+          "x-nullable": true
+        },
+        "durationMillis": {
+          "type": "number"
+        },
+        "egressRxBytes": {
+          "type": "integer",
+          "format": "int64"
+        },
+        "egressTxBytes": {
+          "type": "integer",
+          "format": "int64"
+        },
+        "endedAt": {
+          "type": "string",
+          "format": "date-time",
+          "x-nullable": true
+        },
+        "failureCause": {
+          "type": "string"
+        },
+        "hostId": {
+          "type": "string"
+        },
+        "identityId": {
+          "type": "string"
+        },
+        "ingressRxBytes": {
+          "type": "integer",
+          "format": "int64"
+        },
+        "ingressTxBytes": {
+          "type": "integer",
+          "format": "int64"
+        },
+        "instanceId": {
+          "type": "string"
+        },
+        "links": {
+          "type": "array",
+          "items": {
+            "type": "string"
           }
-        }
-      },
-      "delete": {
-        "description": "Delete a circuit by id. Requires admin access.",
-        "tags": [
-          "Circuit"
-        ],
-        "summary": "Delete a circuit",
-        "operationId": "deleteCircuit",
-        "parameters": [
-          {
-            "description": "A circuit delete object",
-            "name": "options",
-            "in": "body",
-            "schema": {
-              "$ref": "#/definitions/circuitDelete"
-            }
+        },
+        "pathUpdates": {
+          "type": "integer"
+        },
+        "routers": {
+          "type": "array",
+          "items": {
+            "type": "string"
           }
-        ],
-        "responses": {
-          "200": {
-            "description": "The delete request was successful and the resource has been removed",
-            "schema": {
-              "$ref": "#/definitions/empty"
-            }
-          },
-          "400": {
-            "description": "The supplied request contains invalid fields or could not be parsed (json and non-json bodies). The error's code, message, and cause fields can be inspected for further information",
-            "schema": {
-              "$ref": "#/definitions/apiErrorEnvelope"
-            },
-            "examples": {
-              "application/json": {
-                "error": {
-                  "args": {
-                    "urlVars": {}
-                  },
-                  "cause": {
-                    "details": {
-                      "context": "(root)",
-                      "field": "(root)",
-                      "property": "fooField3"
-                    },
-                    "field": "(root)",
-                    "message": "(root): fooField3 is required",
-                    "type": "required",
-                    "value": {
-                      "fooField": "abc",
-                      "fooField2": "def"
-                    }
-                  },
-                  "causeMessage": "schema validation failed",
-                  "code": "COULD_NOT_VALIDATE",
-                  "message": "The supplied request contains an invalid document",
-                  "requestId": "ac6766d6-3a09-44b3-8d8a-1b541d97fdd9"
-                },
-                "meta": {
-                  "apiEnrollmentVersion": "0.0.1",
-                  "apiVersion": "0.0.1"
-                }
-              }
-            }
-          },
-          "401": {
-            "description": "The currently supplied session does not have the correct access rights to request this resource",
-            "schema": {
-              "$ref": "#/definitions/apiErrorEnvelope"
-            },
-            "examples": {
-              "application/json": {
-                "error": {
-                  "args": {
-                    "urlVars": {}
-                  },
-                  "cause": "",
-                  "causeMessage": "",
-                  "code": "UNAUTHORIZED",
-                  "message": "The request could not be completed. The session is not authorized or the credentials are invalid",
-                  "requestId": "0bfe7a04-9229-4b7a-812c-9eb3cc0eac0f"
-                },
-                "meta": {
-                  "apiEnrollmentVersion": "0.0.1",
-                  "apiVersion": "0.0.1"
-                }
-              }
-            }
-          },
-          "409": {
-            "description": "The resource requested to be removed/altered cannot be as it is referenced by another object.",
-            "schema": {
-              "$ref": "#/definitions/apiErrorEnvelope"
-            },
-            "examples": {
-              "application/json": {
-                "error": {
-                  "args": {
-                    "urlVars": {
-                      "id": "71a3000f-7dda-491a-9b90-a19f4ee6c406"
-                    }
-                  },
-                  "causeMessage": "referenced by /some-resource/05f4f710-c155-4a74-86d5-77558eb9cb42",
-                  "code": "CONFLICT_CANNOT_MODIFY_REFERENCED",
-                  "message": "The resource cannot be deleted/modified. Remove all referencing resources first.",
-                  "requestId": "270908d6-f2ef-4577-b973-67bec18ae376"
-                },
-                "meta": {
-                  "apiEnrollmentVersion": "0.0.1",
-                  "apiVersion": "0.0.1"
-                }
-              }
-            }
-          },
-          "429": {
-            "description": "The resource requested is rate limited and the rate limit has been exceeded",
-            "schema": {
-              "$ref": "#/definitions/apiErrorEnvelope"
-            },
-            "examples": {
-              "application/json": {
-                "error": {
-                  "args": {
-                    "urlVars": {}
-                  },
-                  "causeMessage": "you have hit a rate limit in the requested operation",
-                  "code": "RATE_LIMITED",
-                  "message": "The resource is rate limited and the rate limit has been exceeded. Please try again later",
-                  "requestId": "270908d6-f2ef-4577-b973-67bec18ae376"
-                },
-                "meta": {
-                  "apiEnrollmentVersion": "0.0.1",
-                  "apiVersion": "0.0.1"
-                }
-              }
-            }
-          },
-          "503": {
-            "description": "The request could not be completed due to the server being busy or in a temporarily bad state",
-            "schema": {
-              "$ref": "#/definitions/apiErrorEnvelope"
-            }
-          }
-        }
-      },
-      "parameters": [
-        {
+        },
+        "serviceId": {
+          "type": "string"
+        },
+        "state": {
           "type": "string",
-          "description": "The id of the requested resource",
-          "name": "id",
-          "in": "path",
-          "required": true
-        }
-      ]
-    },
-    "/cluster/add-member": {
-      "post": {
-        "description": "Add a member to the controller cluster. Requires admin access.",
-        "tags": [
-          "Cluster"
-        ],
-        "summary": "Add a member to the controller cluster",
-        "operationId": "clusterMemberAdd",
-        "parameters": [
-          {
-            "description": "member parameters",
-            "name": "member",
-            "in": "body",
-            "required": true,
-            "schema": {
-              "$ref": "#/definitions/clusterMemberAdd"
-            }
-          }
-        ],
-        "responses": {
-          "200": {
-            "description": "Base empty response",
-            "schema": {
-              "$ref": "#/definitions/empty"
-            }
-          },
-          "400": {
-            "description": "The supplied request contains invalid fields or could not be parsed (json and non-json bodies). The error's code, message, and cause fields can be inspected for further information",
-            "schema": {
-              "$ref": "#/definitions/apiErrorEnvelope"
-            },
-            "examples": {
-              "application/json": {
-                "error": {
-                  "args": {
-                    "urlVars": {}
-                  },
-                  "cause": {
-                    "details": {
-                      "context": "(root)",
-                      "field": "(root)",
-                      "property": "fooField3"
-                    },
-                    "field": "(root)",
-                    "message": "(root): fooField3 is required",
-                    "type": "required",
-                    "value": {
-                      "fooField": "abc",
-                      "fooField2": "def"
-                    }
-                  },
-                  "causeMessage": "schema validation failed",
-                  "code": "COULD_NOT_VALIDATE",
-                  "message": "The supplied request contains an invalid document",
-                  "requestId": "ac6766d6-3a09-44b3-8d8a-1b541d97fdd9"
-                },
-                "meta": {
-                  "apiEnrollmentVersion": "0.0.1",
-                  "apiVersion": "0.0.1"
-                }
-              }
-            }
-          },
-          "401": {
-            "description": "The currently supplied session does not have the correct access rights to request this resource",
-            "schema": {
-              "$ref": "#/definitions/apiErrorEnvelope"
-            },
-            "examples": {
-              "application/json": {
-                "error": {
-                  "args": {
-                    "urlVars": {}
-                  },
-                  "cause": "",
-                  "causeMessage": "",
-                  "code": "UNAUTHORIZED",
-                  "message": "The request could not be completed. The session is not authorized or the credentials are invalid",
-                  "requestId": "0bfe7a04-9229-4b7a-812c-9eb3cc0eac0f"
-                },
-                "meta": {
-                  "apiEnrollmentVersion": "0.0.1",
-                  "apiVersion": "0.0.1"
-                }
-              }
-            }
-          },
-          "429": {
-            "description": "The resource requested is rate limited and the rate limit has been exceeded",
-            "schema": {
-              "$ref": "#/definitions/apiErrorEnvelope"
-            },
-            "examples": {
-              "application/json": {
-                "error": {
-                  "args": {
-                    "urlVars": {}
-                  },
-                  "causeMessage": "you have hit a rate limit in the requested operation",
-                  "code": "RATE_LIMITED",
-                  "message": "The resource is rate limited and the rate limit has been exceeded. Please try again later",
-                  "requestId": "270908d6-f2ef-4577-b973-67bec18ae376"
-                },
-                "meta": {
-                  "apiEnrollmentVersion": "0.0.1",
-                  "apiVersion": "0.0.1"
-                }
-              }
-            }
-          },
-          "503": {
-            "description": "The request could not be completed due to the server being busy or in a temporarily bad state",
-            "schema": {
-              "$ref": "#/definitions/apiErrorEnvelope"
-            }
+          "enum": [
+            "active",
+            "ended",
+            "failed"
+          ]
+        },
+        "tags": {
+          "type": "object",
+          "additionalProperties": {
+            "type": "string"
           }
+        },
+        "terminatorId": {
+          "type": "string"
         }
       }
     },
-    "/cluster/list-members": {
-      "get": {
-        "description": "Returns all members of a cluster and their current status",
-        "tags": [
-          "Cluster"
-        ],
-        "summary": "Returns all members of a cluster and their current status",
-        "operationId": "clusterListMembers",
-        "responses": {
-          "200": {
-            "description": "A response to a cluster list-members request",
-            "schema": {
-              "$ref": "#/definitions/clusterMemberListResponse"
-            }
-          },
-          "400": {
-            "description": "The supplied request contains invalid fields or could not be parsed (json and non-json bodies). The error's code, message, and cause fields can be inspected for further information",
-            "schema": {
-              "$ref": "#/definitions/apiErrorEnvelope"
-            },
-            "examples": {
-              "application/json": {
-                "error": {
-                  "args": {
-                    "urlVars": {}
-                  },
-                  "cause": {
-                    "details": {
-                      "context": "(root)",
-                      "field": "(root)",
-                      "property": "fooField3"
-                    },
-                    "field": "(root)",
-                    "message": "(root): fooField3 is required",
-                    "type": "required",
-                    "value": {
-                      "fooField": "abc",
-                      "fooField2": "def"
-                    }
-                  },
-                  "causeMessage": "schema validation failed",
-                  "code": "COULD_NOT_VALIDATE",
-                  "message": "The supplied request contains an invalid document",
-                  "requestId": "ac6766d6-3a09-44b3-8d8a-1b541d97fdd9"
-                },
-                "meta": {
-                  "apiEnrollmentVersion": "0.0.1",
-                  "apiVersion": "0.0.1"
-                }
-              }
-            }
-          },
-          "401": {
-            "description": "The currently supplied session does not have the correct access rights to request this resource",
-            "schema": {
-              "$ref": "#/definitions/apiErrorEnvelope"
-            },
-            "examples": {
-              "application/json": {
-                "error": {
-                  "args": {
-                    "urlVars": {}
-                  },
-                  "cause": "",
-                  "causeMessage": "",
-                  "code": "UNAUTHORIZED",
-                  "message": "The request could not be completed. The session is not authorized or the credentials are invalid",
-                  "requestId": "0bfe7a04-9229-4b7a-812c-9eb3cc0eac0f"
-                },
-                "meta": {
-                  "apiEnrollmentVersion": "0.0.1",
-                  "apiVersion": "0.0.1"
-                }
-              }
-            }
-          },
-          "429": {
-            "description": "The resource requested is rate limited and the rate limit has been exceeded",
-            "schema": {
-              "$ref": "#/definitions/apiErrorEnvelope"
-            },
-            "examples": {
-              "application/json": {
-                "error": {
-                  "args": {
-                    "urlVars": {}
-                  },
-                  "causeMessage": "you have hit a rate limit in the requested operation",
-                  "code": "RATE_LIMITED",
-                  "message": "The resource is rate limited and the rate limit has been exceeded. Please try again later",
-                  "requestId": "270908d6-f2ef-4577-b973-67bec18ae376"
-                },
-                "meta": {
-                  "apiEnrollmentVersion": "0.0.1",
-                  "apiVersion": "0.0.1"
-                }
-              }
-            }
-          }
-        }
+    "circuitHistoryList": {
+      "type": "array",
+      "items": {
+        "$ref": "#/definitions/circuitHistoryEntry"
       }
     },
-    "/cluster/remove-member": {
-      "post": {
-        "description": "Remove a member from the controller cluster. Requires admin access.",
-        "tags": [
-          "Cluster"
-        ],
-        "summary": "Remove a member from the controller cluster",
-        "operationId": "clusterMemberRemove",
-        "parameters": [
-          {
-            "description": "member parameters",
-            "name": "member",
-            "in": "body",
-            "required": true,
-            "schema": {
-              "$ref": "#/definitions/clusterMemberRemove"
-            }
-          }
-        ],
-        "responses": {
-          "200": {
-            "description": "Base empty response",
-            "schema": {
-              "$ref": "#/definitions/empty"
-            }
-          },
-          "400": {
-            "description": "The supplied request contains invalid fields or could not be parsed (json and non-json bodies). The error's code, message, and cause fields can be inspected for further information",
-            "schema": {
-              "$ref": "#/definitions/apiErrorEnvelope"
-            },
-            "examples": {
-              "application/json": {
-                "error": {
-                  "args": {
-                    "urlVars": {}
-                  },
-                  "cause": {
-                    "details": {
-                      "context": "(root)",
-                      "field": "(root)",
-                      "property": "fooField3"
-                    },
-                    "field": "(root)",
-                    "message": "(root): fooField3 is required",
-                    "type": "required",
-                    "value": {
-                      "fooField": "abc",
-                      "fooField2": "def"
-                    }
-                  },
-                  "causeMessage": "schema validation failed",
-                  "code": "COULD_NOT_VALIDATE",
-                  "message": "The supplied request contains an invalid document",
-                  "requestId": "ac6766d6-3a09-44b3-8d8a-1b541d97fdd9"
-                },
-                "meta": {
-                  "apiEnrollmentVersion": "0.0.1",
-                  "apiVersion": "0.0.1"
-                }
-              }
-            }
-          },
-          "401": {
-            "description": "The currently supplied session does not have the correct access rights to request this resource",
-            "schema": {
-              "$ref": "#/definitions/apiErrorEnvelope"
-            },
-            "examples": {
-              "application/json": {
-                "error": {
-                  "args": {
-                    "urlVars": {}
-                  },
-                  "cause": "",
-                  "causeMessage": "",
-                  "code": "UNAUTHORIZED",
-                  "message": "The request could not be completed. The session is not authorized or the credentials are invalid",
-                  "requestId": "0bfe7a04-9229-4b7a-812c-9eb3cc0eac0f"
-                },
-                "meta": {
-                  "apiEnrollmentVersion": "0.0.1",
-                  "apiVersion": "0.0.1"
-                }
-              }
-            }
-          },
-          "404": {
-            "description": "The requested resource does not exist",
-            "schema": {
-              "$ref": "#/definitions/apiErrorEnvelope"
-            },
-            "examples": {
-              "application/json": {
-                "error": {
-                  "args": {
-                    "urlVars": {
-                      "id": "71a3000f-7dda-491a-9b90-a19f4ee6c406"
-                    }
-                  },
-                  "cause": null,
-                  "causeMessage": "",
-                  "code": "NOT_FOUND",
-                  "message": "The resource requested was not found or is no longer available",
-                  "requestId": "270908d6-f2ef-4577-b973-67bec18ae376"
-                },
-                "meta": {
-                  "apiEnrollmentVersion": "0.0.1",
-                  "apiVersion": "0.0.1"
-                }
-              }
-            }
-          },
-          "429": {
-            "description": "The resource requested is rate limited and the rate limit has been exceeded",
-            "schema": {
-              "$ref": "#/definitions/apiErrorEnvelope"
-            },
-            "examples": {
-              "application/json": {
-                "error": {
-                  "args": {
-                    "urlVars": {}
-                  },
-                  "causeMessage": "you have hit a rate limit in the requested operation",
-                  "code": "RATE_LIMITED",
-                  "message": "The resource is rate limited and the rate limit has been exceeded. Please try again later",
-                  "requestId": "270908d6-f2ef-4577-b973-67bec18ae376"
-                },
-                "meta": {
-                  "apiEnrollmentVersion": "0.0.1",
-                  "apiVersion": "0.0.1"
-                }
-              }
-            }
-          },
-          "503": {
-            "description": "The request could not be completed due to the server being busy or in a temporarily bad state",
-            "schema": {
-              "$ref": "#/definitions/apiErrorEnvelope"
-            }
-          }
+    "circuitList": {
+      "type": "array",
+      "items": {
+        "$ref": "#/definitions/circuitDetail"
+      }
+    },
+    "clusterMemberAdd": {
+      "type": "object",
+      "required": [
+        "address",
+        "isVoter"
+      ],
+      "properties": {
+        "address": {
+          "type": "string"
+        },
+        "isVoter": {
+          "type": "boolean"
         }
       }
     },
-    "/cluster/transfer-leadership": {
-      "post": {
-        "description": "Attempts to transfer leadership to a different member of the cluster. Requires admin access.",
-        "tags": [
-          "Cluster"
-        ],
-        "summary": "Attempts to transfer leadership to a different member of the cluster",
-        "operationId": "clusterTransferLeadership",
-        "parameters": [
-          {
-            "description": "transfer operation parameters",
-            "name": "member",
-            "in": "body",
-            "required": true,
-            "schema": {
-              "$ref": "#/definitions/clusterTransferLeadership"
-            }
-          }
-        ],
-        "responses": {
-          "200": {
-            "description": "Base empty response",
-            "schema": {
-              "$ref": "#/definitions/empty"
-            }
-          },
-          "401": {
-            "description": "The currently supplied session does not have the correct access rights to request this resource",
-            "schema": {
-              "$ref": "#/definitions/apiErrorEnvelope"
-            },
-            "examples": {
-              "application/json": {
-                "error": {
-                  "args": {
-                    "urlVars": {}
-                  },
-                  "cause": "",
-                  "causeMessage": "",
-                  "code": "UNAUTHORIZED",
-                  "message": "The request could not be completed. The session is not authorized or the credentials are invalid",
-                  "requestId": "0bfe7a04-9229-4b7a-812c-9eb3cc0eac0f"
-                },
-                "meta": {
-                  "apiEnrollmentVersion": "0.0.1",
-                  "apiVersion": "0.0.1"
-                }
-              }
-            }
-          },
-          "404": {
-            "description": "The requested resource does not exist",
-            "schema": {
-              "$ref": "#/definitions/apiErrorEnvelope"
-            },
-            "examples": {
-              "application/json": {
-                "error": {
-                  "args": {
-                    "urlVars": {
-                      "id": "71a3000f-7dda-491a-9b90-a19f4ee6c406"
-                    }
-                  },
-                  "cause": null,
-                  "causeMessage": "",
-                  "code": "NOT_FOUND",
-                  "message": "The resource requested was not found or is no longer available",
-                  "requestId": "270908d6-f2ef-4577-b973-67bec18ae376"
-                },
-                "meta": {
-                  "apiEnrollmentVersion": "0.0.1",
-                  "apiVersion": "0.0.1"
-                }
-              }
-            }
-          },
-          "429": {
-            "description": "The resource requested is rate limited and the rate limit has been exceeded",
-            "schema": {
-              "$ref": "#/definitions/apiErrorEnvelope"
-            },
-            "examples": {
-              "application/json": {
-                "error": {
-                  "args": {
-                    "urlVars": {}
-                  },
-                  "causeMessage": "you have hit a rate limit in the requested operation",
-                  "code": "RATE_LIMITED",
-                  "message": "The resource is rate limited and the rate limit has been exceeded. Please try again later",
-                  "requestId": "270908d6-f2ef-4577-b973-67bec18ae376"
-                },
-                "meta": {
-                  "apiEnrollmentVersion": "0.0.1",
-                  "apiVersion": "0.0.1"
-                }
-              }
-            }
-          },
-          "500": {
-            "description": "The supplied request contains invalid fields or could not be parsed (json and non-json bodies). The error's code, message, and cause fields can be inspected for further information",
-            "schema": {
-              "$ref": "#/definitions/apiErrorEnvelope"
-            },
-            "examples": {
-              "application/json": {
-                "error": {
-                  "args": {
-                    "urlVars": {}
-                  },
-                  "cause": {
-                    "details": {
-                      "context": "(root)",
-                      "field": "(root)",
-                      "property": "fooField3"
-                    },
-                    "field": "(root)",
-                    "message": "(root): fooField3 is required",
-                    "type": "required",
-                    "value": {
-                      "fooField": "abc",
-                      "fooField2": "def"
-                    }
-                  },
-                  "causeMessage": "schema validation failed",
-                  "code": "COULD_NOT_VALIDATE",
-                  "message": "The supplied request contains an invalid document",
-                  "requestId": "ac6766d6-3a09-44b3-8d8a-1b541d97fdd9"
-                },
-                "meta": {
-                  "apiEnrollmentVersion": "0.0.1",
-                  "apiVersion": "0.0.1"
-                }
-              }
-            }
-          },
-          "503": {
-            "description": "The request could not be completed due to the server being busy or in a temporarily bad state",
-            "schema": {
-              "$ref": "#/definitions/apiErrorEnvelope"
-            }
+    "clusterMemberListResponse": {
+      "type": "object",
+      "properties": {
+        "data": {
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/clusterMemberListValue"
           }
         }
       }
     },
-    "/database": {
-      "post": {
-        "security": [
-          {
-            "ztSession": []
-          }
-        ],
-        "description": "Create a new database snapshot. Requires admin access.",
-        "tags": [
-          "Database"
-        ],
-        "summary": "Create a new database snapshot",
-        "operationId": "createDatabaseSnapshot",
-        "responses": {
-          "200": {
-            "description": "Base empty response",
-            "schema": {
-              "$ref": "#/definitions/empty"
-            }
-          },
-          "401": {
-            "description": "The currently supplied session does not have the correct access rights to request this resource",
-            "schema": {
-              "$ref": "#/definitions/apiErrorEnvelope"
-            },
-            "examples": {
-              "application/json": {
-                "error": {
-                  "args": {
-                    "urlVars": {}
-                  },
-                  "cause": "",
-                  "causeMessage": "",
-                  "code": "UNAUTHORIZED",
-                  "message": "The request could not be completed. The session is not authorized or the credentials are invalid",
-                  "requestId": "0bfe7a04-9229-4b7a-812c-9eb3cc0eac0f"
-                },
-                "meta": {
-                  "apiEnrollmentVersion": "0.0.1",
-                  "apiVersion": "0.0.1"
-                }
-              }
-            }
-          },
-          "429": {
-            "description": "The resource requested is rate limited and the rate limit has been exceeded",
-            "schema": {
-              "$ref": "#/definitions/apiErrorEnvelope"
-            },
-            "examples": {
-              "application/json": {
-                "error": {
-                  "args": {
-                    "urlVars": {}
-                  },
-                  "causeMessage": "you have hit a rate limit in the requested operation",
-                  "code": "RATE_LIMITED",
-                  "message": "The resource is rate limited and the rate limit has been exceeded. Please try again later",
-                  "requestId": "270908d6-f2ef-4577-b973-67bec18ae376"
-                },
-                "meta": {
-                  "apiEnrollmentVersion": "0.0.1",
-                  "apiVersion": "0.0.1"
-                }
-              }
-            }
-          }
+    "clusterMemberListValue": {
+      "type": "object",
+      "required": [
+        "id",
+        "address",
+        "voter",
+        "leader",
+        "version",
+        "connected",
+        "readOnly"
+      ],
+      "properties": {
+        "address": {
+          "type": "string"
+        },
+        "connected": {
+          "type": "boolean"
+        },
+        "id": {
+          "type": "string"
+        },
+        "leader": {
+          "type": "boolean"
+        },
+        "readOnly": {
+          "type": "boolean"
+        },
+        "version": {
+          "type": "string"
+        },
+        "voter": {
+          "type": "boolean"
         }
       }
     },
-    "/database/check-data-integrity": {
-      "post": {
-        "security": [
-          {
-            "ztSession": []
-          }
-        ],
-        "description": "Starts a data integrity scan on the datastore. Requires admin access. Only once instance may run at a time, including runs of fixDataIntegrity.",
-        "tags": [
-          "Database"
-        ],
-        "summary": "Starts a data integrity scan on the datastore",
-        "operationId": "checkDataIntegrity",
-        "responses": {
-          "202": {
-            "description": "Base empty response",
-            "schema": {
-              "$ref": "#/definitions/empty"
-            }
-          },
-          "401": {
-            "description": "The currently supplied session does not have the correct access rights to request this resource",
-            "schema": {
-              "$ref": "#/definitions/apiErrorEnvelope"
-            },
-            "examples": {
-              "application/json": {
-                "error": {
-                  "args": {
-                    "urlVars": {}
-                  },
-                  "cause": "",
-                  "causeMessage": "",
-                  "code": "UNAUTHORIZED",
-                  "message": "The request could not be completed. The session is not authorized or the credentials are invalid",
-                  "requestId": "0bfe7a04-9229-4b7a-812c-9eb3cc0eac0f"
-                },
-                "meta": {
-                  "apiEnrollmentVersion": "0.0.1",
-                  "apiVersion": "0.0.1"
-                }
-              }
-            }
-          },
-          "429": {
-            "description": "The resource requested is rate limited and the rate limit has been exceeded",
-            "schema": {
-              "$ref": "#/definitions/apiErrorEnvelope"
-            },
-            "examples": {
-              "application/json": {
-                "error": {
-                  "args": {
-                    "urlVars": {}
-                  },
-                  "causeMessage": "you have hit a rate limit in the requested operation",
-                  "code": "RATE_LIMITED",
-                  "message": "The resource is rate limited and the rate limit has been exceeded. Please try again later",
-                  "requestId": "270908d6-f2ef-4577-b973-67bec18ae376"
-                },
-                "meta": {
-                  "apiEnrollmentVersion": "0.0.1",
-                  "apiVersion": "0.0.1"
-                }
-              }
-            }
-          }
+    "clusterMemberRemove": {
+      "type": "object",
+      "required": [
+        "id"
+      ],
+      "properties": {
+        "id": {
+          "type": "string"
         }
       }
     },
-    "/database/data-integrity-results": {
-      "get": {
-        "security": [
-          {
-            "ztSession": []
-          }
-        ],
-        "description": "Returns any results found from in-progress integrity checks. Requires admin access.",
-        "tags": [
-          "Database"
-        ],
-        "summary": "Returns any results found from in-progress integrity checks",
-        "operationId": "dataIntegrityResults",
-        "responses": {
-          "200": {
-            "description": "A list of data integrity issues found",
-            "schema": {
-              "$ref": "#/definitions/dataIntegrityCheckResultEnvelope"
-            }
-          },
-          "401": {
-            "description": "The currently supplied session does not have the correct access rights to request this resource",
-            "schema": {
-              "$ref": "#/definitions/apiErrorEnvelope"
-            },
-            "examples": {
-              "application/json": {
-                "error": {
-                  "args": {
-                    "urlVars": {}
-                  },
-                  "cause": "",
-                  "causeMessage": "",
-                  "code": "UNAUTHORIZED",
-                  "message": "The request could not be completed. The session is not authorized or the credentials are invalid",
-                  "requestId": "0bfe7a04-9229-4b7a-812c-9eb3cc0eac0f"
-                },
-                "meta": {
-                  "apiEnrollmentVersion": "0.0.1",
-                  "apiVersion": "0.0.1"
-                }
-              }
-            }
-          },
-          "429": {
-            "description": "The resource requested is rate limited and the rate limit has been exceeded",
-            "schema": {
-              "$ref": "#/definitions/apiErrorEnvelope"
-            },
-            "examples": {
-              "application/json": {
-                "error": {
-                  "args": {
-                    "urlVars": {}
-                  },
-                  "causeMessage": "you have hit a rate limit in the requested operation",
-                  "code": "RATE_LIMITED",
-                  "message": "The resource is rate limited and the rate limit has been exceeded. Please try again later",
-                  "requestId": "270908d6-f2ef-4577-b973-67bec18ae376"
-                },
-                "meta": {
-                  "apiEnrollmentVersion": "0.0.1",
-                  "apiVersion": "0.0.1"
-                }
-              }
-            }
-          }
+    "clusterTransferLeadership": {
+      "type": "object",
+      "properties": {
+        "newLeaderId": {
+          "type": "string"
         }
       }
     },
-    "/database/fix-data-integrity": {
-      "post": {
-        "security": [
-          {
-            "ztSession": []
-          }
-        ],
-        "description": "Runs a data integrity scan on the datastore, attempts to fix any issues it can, and returns any found issues. Requires admin access. Only once instance may run at a time, including runs of checkDataIntegrity.",
-        "tags": [
-          "Database"
-        ],
-        "summary": "Runs a data integrity scan on the datastore, attempts to fix any issues it can and returns any found issues",
-        "operationId": "fixDataIntegrity",
-        "responses": {
-          "202": {
-            "description": "Base empty response",
-            "schema": {
-              "$ref": "#/definitions/empty"
-            }
-          },
-          "401": {
-            "description": "The currently supplied session does not have the correct access rights to request this resource",
-            "schema": {
-              "$ref": "#/definitions/apiErrorEnvelope"
-            },
-            "examples": {
-              "application/json": {
-                "error": {
-                  "args": {
-                    "urlVars": {}
-                  },
-                  "cause": "",
-                  "causeMessage": "",
-                  "code": "UNAUTHORIZED",
-                  "message": "The request could not be completed. The session is not authorized or the credentials are invalid",
-                  "requestId": "0bfe7a04-9229-4b7a-812c-9eb3cc0eac0f"
-                },
-                "meta": {
-                  "apiEnrollmentVersion": "0.0.1",
-                  "apiVersion": "0.0.1"
-                }
-              }
-            }
-          },
-          "429": {
-            "description": "The resource requested is rate limited and the rate limit has been exceeded",
-            "schema": {
-              "$ref": "#/definitions/apiErrorEnvelope"
-            },
-            "examples": {
-              "application/json": {
-                "error": {
-                  "args": {
-                    "urlVars": {}
-                  },
-                  "causeMessage": "you have hit a rate limit in the requested operation",
-                  "code": "RATE_LIMITED",
-                  "message": "The resource is rate limited and the rate limit has been exceeded. Please try again later",
-                  "requestId": "270908d6-f2ef-4577-b973-67bec18ae376"
-                },
-                "meta": {
-                  "apiEnrollmentVersion": "0.0.1",
-                  "apiVersion": "0.0.1"
-                }
-              }
-            }
-          }
+    "createEnvelope": {
+      "type": "object",
+      "properties": {
+        "data": {
+          "$ref": "#/definitions/createLocation"
+        },
+        "meta": {
+          "$ref": "#/definitions/meta"
         }
       }
     },
-    "/database/snapshot": {
-      "post": {
-        "description": "Create a new database snapshot with path. Requires admin access.",
-        "tags": [
-          "Database"
-        ],
-        "summary": "Create a new database snapshot with path",
-        "operationId": "createDatabaseSnapshotWithPath",
-        "parameters": [
-          {
-            "description": "snapshot parameters",
-            "name": "snapshot",
-            "in": "body",
-            "required": true,
-            "schema": {
-              "$ref": "#/definitions/databaseSnapshotCreate"
-            }
-          }
-        ],
-        "responses": {
-          "200": {
-            "description": "The path to the created snapshot",
-            "schema": {
-              "$ref": "#/definitions/databaseSnapshotCreateResultEnvelope"
-            }
-          },
-          "401": {
-            "description": "The currently supplied session does not have the correct access rights to request this resource",
-            "schema": {
-              "$ref": "#/definitions/apiErrorEnvelope"
-            },
-            "examples": {
-              "application/json": {
-                "error": {
-                  "args": {
-                    "urlVars": {}
-                  },
-                  "cause": "",
-                  "causeMessage": "",
-                  "code": "UNAUTHORIZED",
-                  "message": "The request could not be completed. The session is not authorized or the credentials are invalid",
-                  "requestId": "0bfe7a04-9229-4b7a-812c-9eb3cc0eac0f"
-                },
-                "meta": {
-                  "apiEnrollmentVersion": "0.0.1",
-                  "apiVersion": "0.0.1"
-                }
-              }
-            }
-          },
-          "429": {
-            "description": "The resource requested is rate limited and the rate limit has been exceeded",
-            "schema": {
-              "$ref": "#/definitions/apiErrorEnvelope"
-            },
-            "examples": {
-              "application/json": {
-                "error": {
-                  "args": {
-                    "urlVars": {}
-                  },
-                  "causeMessage": "you have hit a rate limit in the requested operation",
-                  "code": "RATE_LIMITED",
-                  "message": "The resource is rate limited and the rate limit has been exceeded. Please try again later",
-                  "requestId": "270908d6-f2ef-4577-b973-67bec18ae376"
-                },
-                "meta": {
-                  "apiEnrollmentVersion": "0.0.1",
-                  "apiVersion": "0.0.1"
-                }
-              }
-            }
-          }
+    "createLocation": {
+      "type": "object",
+      "properties": {
+        "_links": {
+          "$ref": "#/definitions/links"
+        },
+        "id": {
+          "type": "string"
         }
       }
     },
-    "/inspections": {
-      "post": {
-        "description": "Requests system information, such as stack dumps or information about capabilities. Requires admin access.\n",
-        "tags": [
-          "Inspect"
-        ],
-        "summary": "Inspect system values",
-        "operationId": "inspect",
-        "parameters": [
-          {
-            "description": "An inspect request",
-            "name": "request",
-            "in": "body",
-            "required": true,
-            "schema": {
-              "$ref": "#/definitions/inspectRequest"
-            }
-          }
-        ],
-        "responses": {
-          "200": {
-            "description": "A response to an inspect request",
-            "schema": {
-              "$ref": "#/definitions/inspectResponse"
-            }
-          },
-          "401": {
-            "description": "The currently supplied session does not have the correct access rights to request this resource",
-            "schema": {
-              "$ref": "#/definitions/apiErrorEnvelope"
-            },
-            "examples": {
-              "application/json": {
-                "error": {
-                  "args": {
-                    "urlVars": {}
-                  },
-                  "cause": "",
-                  "causeMessage": "",
-                  "code": "UNAUTHORIZED",
-                  "message": "The request could not be completed. The session is not authorized or the credentials are invalid",
-                  "requestId": "0bfe7a04-9229-4b7a-812c-9eb3cc0eac0f"
-                },
-                "meta": {
-                  "apiEnrollmentVersion": "0.0.1",
-                  "apiVersion": "0.0.1"
-                }
-              }
-            }
-          },
-          "429": {
-            "description": "The resource requested is rate limited and the rate limit has been exceeded",
-            "schema": {
-              "$ref": "#/definitions/apiErrorEnvelope"
-            },
-            "examples": {
-              "application/json": {
-                "error": {
-                  "args": {
-                    "urlVars": {}
-                  },
-                  "causeMessage": "you have hit a rate limit in the requested operation",
-                  "code": "RATE_LIMITED",
-                  "message": "The resource is rate limited and the rate limit has been exceeded. Please try again later",
-                  "requestId": "270908d6-f2ef-4577-b973-67bec18ae376"
-                },
-                "meta": {
-                  "apiEnrollmentVersion": "0.0.1",
-                  "apiVersion": "0.0.1"
-                }
-              }
-            }
-          }
+    "currentIdentityPermissions": {
+      "type": "object",
+      "required": [
+        "isAdmin",
+        "grants"
+      ],
+      "properties": {
+        "grants": {
+          "$ref": "#/definitions/permissions"
+        },
+        "isAdmin": {
+          "type": "boolean"
         }
       }
     },
-    "/links": {
-      "get": {
-        "description": "Retrieves a list of link resources; does not supports filtering, sorting, or pagination. Requires admin access.\n",
-        "tags": [
-          "Link"
-        ],
-        "summary": "List links",
-        "operationId": "listLinks",
-        "parameters": [
-          {
-            "type": "integer",
-            "name": "limit",
-            "in": "query"
-          },
-          {
-            "type": "integer",
-            "name": "offset",
-            "in": "query"
-          },
-          {
-            "type": "string",
-            "name": "filter",
-            "in": "query"
-          }
-        ],
-        "responses": {
-          "200": {
-            "description": "A list of links",
-            "schema": {
-              "$ref": "#/definitions/listLinksEnvelope"
-            }
-          },
-          "401": {
-            "description": "The currently supplied session does not have the correct access rights to request this resource",
-            "schema": {
-              "$ref": "#/definitions/apiErrorEnvelope"
-            },
-            "examples": {
-              "application/json": {
-                "error": {
-                  "args": {
-                    "urlVars": {}
-                  },
-                  "cause": "",
-                  "causeMessage": "",
-                  "code": "UNAUTHORIZED",
-                  "message": "The request could not be completed. The session is not authorized or the credentials are invalid",
-                  "requestId": "0bfe7a04-9229-4b7a-812c-9eb3cc0eac0f"
-                },
-                "meta": {
-                  "apiEnrollmentVersion": "0.0.1",
-                  "apiVersion": "0.0.1"
-                }
-              }
-            }
-          },
-          "429": {
-            "description": "The resource requested is rate limited and the rate limit has been exceeded",
-            "schema": {
-              "$ref": "#/definitions/apiErrorEnvelope"
-            },
-            "examples": {
-              "application/json": {
-                "error": {
-                  "args": {
-                    "urlVars": {}
-                  },
-                  "causeMessage": "you have hit a rate limit in the requested operation",
-                  "code": "RATE_LIMITED",
-                  "message": "The resource is rate limited and the rate limit has been exceeded. Please try again later",
-                  "requestId": "270908d6-f2ef-4577-b973-67bec18ae376"
-                },
-                "meta": {
-                  "apiEnrollmentVersion": "0.0.1",
-                  "apiVersion": "0.0.1"
-                }
-              }
-            }
-          }
+    "currentIdentityPermissionsEnvelope": {
+      "type": "object",
+      "required": [
+        "meta",
+        "data"
+      ],
+      "properties": {
+        "data": {
+          "$ref": "#/definitions/currentIdentityPermissions"
+        },
+        "meta": {
+          "$ref": "#/definitions/meta"
         }
       }
     },
-    "/links/{id}": {
-      "get": {
-        "description": "Retrieves a single link by id. Requires admin access.",
-        "tags": [
-          "Link"
-        ],
-        "summary": "Retrieves a single link",
-        "operationId": "detailLink",
-        "responses": {
-          "200": {
-            "description": "A single link",
-            "schema": {
-              "$ref": "#/definitions/detailLinkEnvelope"
-            }
-          },
-          "401": {
-            "description": "The currently supplied session does not have the correct access rights to request this resource",
-            "schema": {
-              "$ref": "#/definitions/apiErrorEnvelope"
-            },
-            "examples": {
-              "application/json": {
-                "error": {
-                  "args": {
-                    "urlVars": {}
-                  },
-                  "cause": "",
-                  "causeMessage": "",
-                  "code": "UNAUTHORIZED",
-                  "message": "The request could not be completed. The session is not authorized or the credentials are invalid",
-                  "requestId": "0bfe7a04-9229-4b7a-812c-9eb3cc0eac0f"
-                },
-                "meta": {
-                  "apiEnrollmentVersion": "0.0.1",
-                  "apiVersion": "0.0.1"
-                }
-              }
-            }
-          },
-          "404": {
-            "description": "The requested resource does not exist",
-            "schema": {
-              "$ref": "#/definitions/apiErrorEnvelope"
-            },
-            "examples": {
-              "application/json": {
-                "error": {
-                  "args": {
-                    "urlVars": {
-                      "id": "71a3000f-7dda-491a-9b90-a19f4ee6c406"
-                    }
-                  },
-                  "cause": null,
-                  "causeMessage": "",
-                  "code": "NOT_FOUND",
-                  "message": "The resource requested was not found or is no longer available",
-                  "requestId": "270908d6-f2ef-4577-b973-67bec18ae376"
-                },
-                "meta": {
-                  "apiEnrollmentVersion": "0.0.1",
-                  "apiVersion": "0.0.1"
-                }
-              }
-            }
-          },
-          "429": {
-            "description": "The resource requested is rate limited and the rate limit has been exceeded",
-            "schema": {
-              "$ref": "#/definitions/apiErrorEnvelope"
-            },
-            "examples": {
-              "application/json": {
-                "error": {
-                  "args": {
-                    "urlVars": {}
-                  },
-                  "causeMessage": "you have hit a rate limit in the requested operation",
-                  "code": "RATE_LIMITED",
-                  "message": "The resource is rate limited and the rate limit has been exceeded. Please try again later",
-                  "requestId": "270908d6-f2ef-4577-b973-67bec18ae376"
-                },
-                "meta": {
-                  "apiEnrollmentVersion": "0.0.1",
-                  "apiVersion": "0.0.1"
-                }
-              }
-            }
-          }
+    "dataIntegrityCheckDetail": {
+      "type": "object",
+      "required": [
+        "description",
+        "fixed"
+      ],
+      "properties": {
+        "description": {
+          "type": "string"
+        },
+        "fixed": {
+          "type": "boolean"
         }
-      },
-      "delete": {
-        "description": "Delete a link by id. Requires admin access.",
-        "tags": [
-          "Link"
-        ],
-        "summary": "Delete a link",
-        "operationId": "deleteLink",
-        "responses": {
-          "200": {
-            "description": "The delete request was successful and the resource has been removed",
-            "schema": {
-              "$ref": "#/definitions/empty"
-            }
-          },
-          "400": {
-            "description": "The supplied request contains invalid fields or could not be parsed (json and non-json bodies). The error's code, message, and cause fields can be inspected for further information",
-            "schema": {
-              "$ref": "#/definitions/apiErrorEnvelope"
-            },
-            "examples": {
-              "application/json": {
-                "error": {
-                  "args": {
-                    "urlVars": {}
-                  },
-                  "cause": {
-                    "details": {
-                      "context": "(root)",
-                      "field": "(root)",
-                      "property": "fooField3"
-                    },
-                    "field": "(root)",
-                    "message": "(root): fooField3 is required",
-                    "type": "required",
-                    "value": {
-                      "fooField": "abc",
-                      "fooField2": "def"
-                    }
-                  },
-                  "causeMessage": "schema validation failed",
-                  "code": "COULD_NOT_VALIDATE",
-                  "message": "The supplied request contains an invalid document",
-                  "requestId": "ac6766d6-3a09-44b3-8d8a-1b541d97fdd9"
-                },
-                "meta": {
-                  "apiEnrollmentVersion": "0.0.1",
-                  "apiVersion": "0.0.1"
-                }
-              }
-            }
-          },
-          "401": {
-            "description": "The currently supplied session does not have the correct access rights to request this resource",
-            "schema": {
-              "$ref": "#/definitions/apiErrorEnvelope"
-            },
-            "examples": {
-              "application/json": {
-                "error": {
-                  "args": {
-                    "urlVars": {}
-                  },
-                  "cause": "",
-                  "causeMessage": "",
-                  "code": "UNAUTHORIZED",
-                  "message": "The request could not be completed. The session is not authorized or the credentials are invalid",
-                  "requestId": "0bfe7a04-9229-4b7a-812c-9eb3cc0eac0f"
-                },
-                "meta": {
-                  "apiEnrollmentVersion": "0.0.1",
-                  "apiVersion": "0.0.1"
-                }
-              }
-            }
-          },
-          "429": {
-            "description": "The resource requested is rate limited and the rate limit has been exceeded",
-            "schema": {
-              "$ref": "#/definitions/apiErrorEnvelope"
-            },
-            "examples": {
-              "application/json": {
-                "error": {
-                  "args": {
-                    "urlVars": {}
-                  },
-                  "causeMessage": "you have hit a rate limit in the requested operation",
-                  "code": "RATE_LIMITED",
-                  "message": "The resource is rate limited and the rate limit has been exceeded. Please try again later",
-                  "requestId": "270908d6-f2ef-4577-b973-67bec18ae376"
-                },
-                "meta": {
-                  "apiEnrollmentVersion": "0.0.1",
-                  "apiVersion": "0.0.1"
-                }
-              }
-            }
-          },
-          "503": {
-            "description": "The request could not be completed due to the server being busy or in a temporarily bad state",
-            "schema": {
-              "$ref": "#/definitions/apiErrorEnvelope"
-            }
-          }
+      }
+    },
+    "dataIntegrityCheckDetailList": {
+      "type": "array",
+      "items": {
+        "$ref": "#/definitions/dataIntegrityCheckDetail"
+      }
+    },
+    "dataIntegrityCheckDetails": {
+      "type": "object",
+      "required": [
+        "inProgress",
+        "fixingErrors",
+        "tooManyErrors",
+        "startTime",
+        "endTime",
+        "error",
+        "results"
+      ],
+      "properties": {
+        "endTime": {
+          "type": "string",
+          "format": "date-time"
+        },
+        "error": {
+          "type": "string"
+        },
+        "fixingErrors": {
+          "type": "boolean"
+        },
+        "inProgress": {
+          "type": "boolean"
+        },
+        "results": {
+          "$ref": "#/definitions/dataIntegrityCheckDetailList"
+        },
+        "startTime": {
+          "type": "string",
+          "format": "date-time"
+        },
+        "tooManyErrors": {
+          "type": "boolean"
         }
-      },
-      "patch": {
-        "description": "Update the supplied fields on a link. Requires admin access.",
-        "tags": [
-          "Link"
-        ],
-        "summary": "Update the supplied fields on a link",
-        "operationId": "patchLink",
-        "parameters": [
-          {
-            "description": "A link patch object",
-            "name": "link",
-            "in": "body",
-            "required": true,
-            "schema": {
-              "$ref": "#/definitions/linkPatch"
-            }
-          }
-        ],
-        "responses": {
-          "200": {
-            "description": "The patch request was successful and the resource has been altered",
-            "schema": {
-              "$ref": "#/definitions/empty"
-            }
-          },
-          "400": {
-            "description": "The supplied request contains invalid fields or could not be parsed (json and non-json bodies). The error's code, message, and cause fields can be inspected for further information",
-            "schema": {
-              "$ref": "#/definitions/apiErrorEnvelope"
-            },
-            "examples": {
-              "application/json": {
-                "error": {
-                  "args": {
-                    "urlVars": {}
-                  },
-                  "cause": {
-                    "details": {
-                      "context": "(root)",
-                      "field": "(root)",
-                      "property": "fooField3"
-                    },
-                    "field": "(root)",
-                    "message": "(root): fooField3 is required",
-                    "type": "required",
-                    "value": {
-                      "fooField": "abc",
-                      "fooField2": "def"
-                    }
-                  },
-                  "causeMessage": "schema validation failed",
-                  "code": "COULD_NOT_VALIDATE",
-                  "message": "The supplied request contains an invalid document",
-                  "requestId": "ac6766d6-3a09-44b3-8d8a-1b541d97fdd9"
-                },
-                "meta": {
-                  "apiEnrollmentVersion": "0.0.1",
-                  "apiVersion": "0.0.1"
-                }
-              }
-            }
-          },
-          "401": {
-            "description": "The currently supplied session does not have the correct access rights to request this resource",
-            "schema": {
-              "$ref": "#/definitions/apiErrorEnvelope"
-            },
-            "examples": {
-              "application/json": {
-                "error": {
-                  "args": {
-                    "urlVars": {}
-                  },
-                  "cause": "",
-                  "causeMessage": "",
-                  "code": "UNAUTHORIZED",
-                  "message": "The request could not be completed. The session is not authorized or the credentials are invalid",
-                  "requestId": "0bfe7a04-9229-4b7a-812c-9eb3cc0eac0f"
-                },
-                "meta": {
-                  "apiEnrollmentVersion": "0.0.1",
-                  "apiVersion": "0.0.1"
-                }
-              }
-            }
-          },
-          "404": {
-            "description": "The requested resource does not exist",
-            "schema": {
-              "$ref": "#/definitions/apiErrorEnvelope"
-            },
-            "examples": {
-              "application/json": {
-                "error": {
-                  "args": {
-                    "urlVars": {
-                      "id": "71a3000f-7dda-491a-9b90-a19f4ee6c406"
-                    }
-                  },
-                  "cause": null,
-                  "causeMessage": "",
-                  "code": "NOT_FOUND",
-                  "message": "The resource requested was not found or is no longer available",
-                  "requestId": "270908d6-f2ef-4577-b973-67bec18ae376"
-                },
-                "meta": {
-                  "apiEnrollmentVersion": "0.0.1",
-                  "apiVersion": "0.0.1"
-                }
-              }
-            }
-          },
-          "429": {
-            "description": "The resource requested is rate limited and the rate limit has been exceeded",
-            "schema": {
-              "$ref": "#/definitions/apiErrorEnvelope"
-            },
-            "examples": {
-              "application/json": {
-                "error": {
-                  "args": {
-                    "urlVars": {}
-                  },
-                  "causeMessage": "you have hit a rate limit in the requested operation",
-                  "code": "RATE_LIMITED",
-                  "message": "The resource is rate limited and the rate limit has been exceeded. Please try again later",
-                  "requestId": "270908d6-f2ef-4577-b973-67bec18ae376"
-                },
-                "meta": {
-                  "apiEnrollmentVersion": "0.0.1",
-                  "apiVersion": "0.0.1"
-                }
-              }
-            }
-          },
-          "503": {
-            "description": "The request could not be completed due to the server being busy or in a temporarily bad state",
-            "schema": {
-              "$ref": "#/definitions/apiErrorEnvelope"
-            }
+      }
+    },
+    "dataIntegrityCheckResultEnvelope": {
+      "type": "object",
+      "required": [
+        "meta",
+        "data"
+      ],
+      "properties": {
+        "data": {
+          "$ref": "#/definitions/dataIntegrityCheckDetails"
+        },
+        "meta": {
+          "$ref": "#/definitions/meta"
+        }
+      }
+    },
+    "databaseBackupCreateDetails": {
+      "type": "object",
+      "required": [
+        "name",
+        "size",
+        "durationMs"
+      ],
+      "properties": {
+        "durationMs": {
+          "type": "integer",
+          "format": "int64"
+        },
+        "name": {
+          "type": "string"
+        },
+        "raftIndex": {
+          "type": "integer",
+          "format": "int64"
+        },
+        "removed": {
+          "type": "array",
+          "items": {
+            "type": "string"
           }
+        },
+        "size": {
+          "type": "integer",
+          "format": "int64"
         }
-      },
-      "parameters": [
-        {
-          "type": "string",
-          "description": "The id of the requested resource",
-          "name": "id",
-          "in": "path",
-          "required": true
+      }
+    },
+    "databaseBackupCreateResultEnvelope": {
+      "type": "object",
+      "required": [
+        "meta",
+        "data"
+      ],
+      "properties": {
+        "data": {
+          "$ref": "#/definitions/databaseBackupCreateDetails"
+        },
+        "meta": {
+          "$ref": "#/definitions/meta"
         }
-      ]
+      }
     },
-    "/routers": {
-      "get": {
-        "description": "Retrieves a list of router resources; supports filtering, sorting, and pagination. Requires admin access.\n",
-        "tags": [
-          "Router"
-        ],
-        "summary": "List routers",
-        "operationId": "listRouters",
-        "parameters": [
-          {
-            "type": "integer",
-            "name": "limit",
-            "in": "query"
-          },
-          {
-            "type": "integer",
-            "name": "offset",
-            "in": "query"
-          },
-          {
-            "type": "string",
-            "name": "filter",
-            "in": "query"
-          }
-        ],
-        "responses": {
-          "200": {
-            "description": "A list of routers",
-            "schema": {
-              "$ref": "#/definitions/listRoutersEnvelope"
-            }
-          },
-          "401": {
-            "description": "The currently supplied session does not have the correct access rights to request this resource",
-            "schema": {
-              "$ref": "#/definitions/apiErrorEnvelope"
-            },
-            "examples": {
-              "application/json": {
-                "error": {
-                  "args": {
-                    "urlVars": {}
-                  },
-                  "cause": "",
-                  "causeMessage": "",
-                  "code": "UNAUTHORIZED",
-                  "message": "The request could not be completed. The session is not authorized or the credentials are invalid",
-                  "requestId": "0bfe7a04-9229-4b7a-812c-9eb3cc0eac0f"
-                },
-                "meta": {
-                  "apiEnrollmentVersion": "0.0.1",
-                  "apiVersion": "0.0.1"
-                }
-              }
-            }
-          },
-          "429": {
-            "description": "The resource requested is rate limited and the rate limit has been exceeded",
-            "schema": {
-              "$ref": "#/definitions/apiErrorEnvelope"
-            },
-            "examples": {
-              "application/json": {
-                "error": {
-                  "args": {
-                    "urlVars": {}
-                  },
-                  "causeMessage": "you have hit a rate limit in the requested operation",
-                  "code": "RATE_LIMITED",
-                  "message": "The resource is rate limited and the rate limit has been exceeded. Please try again later",
-                  "requestId": "270908d6-f2ef-4577-b973-67bec18ae376"
-                },
-                "meta": {
-                  "apiEnrollmentVersion": "0.0.1",
-                  "apiVersion": "0.0.1"
-                }
-              }
-            }
-          }
+    "databaseBackupDetails": {
+      "type": "object",
+      "required": [
+        "name",
+        "size",
+        "createdAt"
+      ],
+      "properties": {
+        "createdAt": {
+          "type": "string",
+          "format": "date-time"
+        },
+        "name": {
+          "type": "string"
+        },
+        "size": {
+          "type": "integer",
+          "format": "int64"
         }
-      },
-      "post": {
-        "description": "Create a router resource. Requires admin access.",
-        "tags": [
-          "Router"
-        ],
-        "summary": "Create a router resource",
-        "operationId": "createRouter",
-        "parameters": [
-          {
-            "description": "A router to create",
-            "name": "router",
-            "in": "body",
-            "required": true,
-            "schema": {
-              "$ref": "#/definitions/routerCreate"
-            }
-          }
-        ],
-        "responses": {
-          "201": {
-            "description": "The create request was successful and the resource has been added at the following location",
-            "schema": {
-              "$ref": "#/definitions/createEnvelope"
-            }
-          },
-          "400": {
-            "description": "The supplied request contains invalid fields or could not be parsed (json and non-json bodies). The error's code, message, and cause fields can be inspected for further information",
-            "schema": {
-              "$ref": "#/definitions/apiErrorEnvelope"
-            },
-            "examples": {
-              "application/json": {
-                "error": {
-                  "args": {
-                    "urlVars": {}
-                  },
-                  "cause": {
-                    "details": {
-                      "context": "(root)",
-                      "field": "(root)",
-                      "property": "fooField3"
-                    },
-                    "field": "(root)",
-                    "message": "(root): fooField3 is required",
-                    "type": "required",
-                    "value": {
-                      "fooField": "abc",
-                      "fooField2": "def"
-                    }
-                  },
-                  "causeMessage": "schema validation failed",
-                  "code": "COULD_NOT_VALIDATE",
-                  "message": "The supplied request contains an invalid document",
-                  "requestId": "ac6766d6-3a09-44b3-8d8a-1b541d97fdd9"
-                },
-                "meta": {
-                  "apiEnrollmentVersion": "0.0.1",
-                  "apiVersion": "0.0.1"
-                }
-              }
-            }
-          },
-          "401": {
-            "description": "The currently supplied session does not have the correct access rights to request this resource",
-            "schema": {
-              "$ref": "#/definitions/apiErrorEnvelope"
-            },
-            "examples": {
-              "application/json": {
-                "error": {
-                  "args": {
-                    "urlVars": {}
-                  },
-                  "cause": "",
-                  "causeMessage": "",
-                  "code": "UNAUTHORIZED",
-                  "message": "The request could not be completed. The session is not authorized or the credentials are invalid",
-                  "requestId": "0bfe7a04-9229-4b7a-812c-9eb3cc0eac0f"
-                },
-                "meta": {
-                  "apiEnrollmentVersion": "0.0.1",
-                  "apiVersion": "0.0.1"
-                }
-              }
-            }
-          },
-          "429": {
-            "description": "The resource requested is rate limited and the rate limit has been exceeded",
-            "schema": {
-              "$ref": "#/definitions/apiErrorEnvelope"
-            },
-            "examples": {
-              "application/json": {
-                "error": {
-                  "args": {
-                    "urlVars": {}
-                  },
-                  "causeMessage": "you have hit a rate limit in the requested operation",
-                  "code": "RATE_LIMITED",
-                  "message": "The resource is rate limited and the rate limit has been exceeded. Please try again later",
-                  "requestId": "270908d6-f2ef-4577-b973-67bec18ae376"
-                },
-                "meta": {
-                  "apiEnrollmentVersion": "0.0.1",
-                  "apiVersion": "0.0.1"
-                }
-              }
-            }
-          },
-          "503": {
-            "description": "The request could not be completed due to the server being busy or in a temporarily bad state",
-            "schema": {
-              "$ref": "#/definitions/apiErrorEnvelope"
-            }
-          }
+      }
+    },
+    "databaseBackupList": {
+      "type": "array",
+      "items": {
+        "$ref": "#/definitions/databaseBackupDetails"
+      }
+    },
+    "databaseBackupStatusDetails": {
+      "type": "object",
+      "required": [
+        "interval",
+        "retain",
+        "target",
+        "compressed",
+        "encrypted",
+        "inProgress",
+        "backups"
+      ],
+      "properties": {
+        "backups": {
+          "$ref": "#/definitions/databaseBackupList"
+        },
+        "compressed": {
+          "type": "boolean"
+        },
+        "encrypted": {
+          "type": "boolean"
+        },
+        "inProgress": {
+          "description": "Set if this controller is currently taking a backup",
+          "type": "boolean"
+        },
+        "interval": {
+          "description": "How often scheduled backups are taken, as a duration such as 24h0m0s",
+          "type": "string"
+        },
+        "lastAttempt": {
+          "type": "string",
+          "format": "date-time",
+          "x-nullable": true
+        },
+        "lastBackup": {
+          "description": "The name of the most recent backup taken by this controller",
+          "type": "string"
+        },
+        "lastError": {
+          "description": "Why the most recent backup attempt failed. Empty if it succeeded",
+          "type": "string"
+        },
+        "lastSuccess": {
+          "type": "string",
+          "format": "date-time",
+          "x-nullable": true
+        },
+        "nextScheduled": {
+          "description": "When the next scheduled backup is due. Only set on the controller which takes scheduled backups",
+          "type": "string",
+          "format": "date-time",
+          "x-nullable": true
+        },
+        "retain": {
+          "description": "How many backups are kept in the target",
+          "type": "integer"
+        },
+        "target": {
+          "description": "Where backups are written",
+          "type": "string"
         }
       }
     },
-    "/routers/{id}": {
-      "get": {
-        "description": "Retrieves a single router by id. Requires admin access.",
-        "tags": [
-          "Router"
-        ],
-        "summary": "Retrieves a single router",
-        "operationId": "detailRouter",
-        "responses": {
-          "200": {
-            "description": "A single router",
-            "schema": {
-              "$ref": "#/definitions/detailRouterEnvelope"
-            }
-          },
-          "401": {
-            "description": "The currently supplied session does not have the correct access rights to request this resource",
-            "schema": {
-              "$ref": "#/definitions/apiErrorEnvelope"
-            },
-            "examples": {
-              "application/json": {
-                "error": {
-                  "args": {
-                    "urlVars": {}
-                  },
-                  "cause": "",
-                  "causeMessage": "",
-                  "code": "UNAUTHORIZED",
-                  "message": "The request could not be completed. The session is not authorized or the credentials are invalid",
-                  "requestId": "0bfe7a04-9229-4b7a-812c-9eb3cc0eac0f"
-                },
-                "meta": {
-                  "apiEnrollmentVersion": "0.0.1",
-                  "apiVersion": "0.0.1"
-                }
-              }
-            }
-          },
-          "404": {
-            "description": "The requested resource does not exist",
-            "schema": {
-              "$ref": "#/definitions/apiErrorEnvelope"
-            },
-            "examples": {
-              "application/json": {
-                "error": {
-                  "args": {
-                    "urlVars": {
-                      "id": "71a3000f-7dda-491a-9b90-a19f4ee6c406"
-                    }
-                  },
-                  "cause": null,
-                  "causeMessage": "",
-                  "code": "NOT_FOUND",
-                  "message": "The resource requested was not found or is no longer available",
-                  "requestId": "270908d6-f2ef-4577-b973-67bec18ae376"
-                },
-                "meta": {
-                  "apiEnrollmentVersion": "0.0.1",
-                  "apiVersion": "0.0.1"
-                }
-              }
-            }
-          },
-          "429": {
-            "description": "The resource requested is rate limited and the rate limit has been exceeded",
-            "schema": {
-              "$ref": "#/definitions/apiErrorEnvelope"
-            },
-            "examples": {
-              "application/json": {
-                "error": {
-                  "args": {
-                    "urlVars": {}
-                  },
-                  "causeMessage": "you have hit a rate limit in the requested operation",
-                  "code": "RATE_LIMITED",
-                  "message": "The resource is rate limited and the rate limit has been exceeded. Please try again later",
-                  "requestId": "270908d6-f2ef-4577-b973-67bec18ae376"
-                },
-                "meta": {
-                  "apiEnrollmentVersion": "0.0.1",
-                  "apiVersion": "0.0.1"
-                }
-              }
-            }
-          }
+    "databaseBackupStatusEnvelope": {
+      "type": "object",
+      "required": [
+        "meta",
+        "data"
+      ],
+      "properties": {
+        "data": {
+          "$ref": "#/definitions/databaseBackupStatusDetails"
+        },
+        "meta": {
+          "$ref": "#/definitions/meta"
         }
-      },
-      "put": {
-        "description": "Update all fields on a router by id. Requires admin access.",
-        "tags": [
-          "Router"
-        ],
-        "summary": "Update all fields on a router",
-        "operationId": "updateRouter",
-        "parameters": [
-          {
-            "description": "A router update object",
-            "name": "router",
-            "in": "body",
-            "required": true,
-            "schema": {
-              "$ref": "#/definitions/routerUpdate"
-            }
-          }
-        ],
-        "responses": {
-          "200": {
-            "description": "The update request was successful and the resource has been altered",
-            "schema": {
-              "$ref": "#/definitions/empty"
-            }
-          },
-          "400": {
-            "description": "The supplied request contains invalid fields or could not be parsed (json and non-json bodies). The error's code, message, and cause fields can be inspected for further information",
-            "schema": {
-              "$ref": "#/definitions/apiErrorEnvelope"
-            },
-            "examples": {
+      }
+    },
+    "databaseSnapshotCreate": {
+      "type": "object",
+      "properties": {
+        "path": {
+          "type": "string"
+        }
+      }
+    },
+    "databaseSnapshotCreateDetails": {
+      "type": "object",
+      "required": [
+        "path"
+      ],
+      "properties": {
+        "path": {
+          "type": "string"
+        }
+      }
+    },
+    "databaseSnapshotCreateResultEnvelope": {
+      "type": "object",
+      "required": [
+        "meta",
+        "data"
+      ],
+      "properties": {
+        "data": {
+          "$ref": "#/definitions/databaseSnapshotCreateDetails"
+        },
+        "meta": {
+          "$ref": "#/definitions/meta"
+        }
+      }
+    },
+    "detailBandwidthLimitEnvelope": {
+      "type": "object",
+      "required": [
+        "meta",
+        "data"
+      ],
+      "properties": {
+        "data": {
+          "$ref": "#/definitions/bandwidthLimitDetail"
+        },
+        "meta": {
+          "$ref": "#/definitions/meta"
+        }
+      }
+    },
+    "detailCircuitEnvelope": {
+      "type": "object",
+      "required": [
+        "meta",
+        "data"
+      ],
+      "properties": {
+        "data": {
+          "$ref": "#/definitions/circuitDetail"
+        },
+        "meta": {
+          "$ref": "#/definitions/meta"
+        }
+      }
+    },
+    "detailCircuitHistoryEnvelope": {
+      "type": "object",
+      "required": [
+        "meta",
+        "data"
+      ],
+      "properties": {
+        "data": {
+          "$ref": "#/definitions/circuitHistoryEntry"
+        },
+        "meta": {
+          "$ref": "#/definitions/meta"
+        }
+      }
+    },
+    "detailLinkEnvelope": {
+      "type": "object",
+      "required": [
+        "meta",
+        "data"
+      ],
+      "properties": {
+        "data": {
+          "$ref": "#/definitions/linkDetail"
+        },
+        "meta": {
+          "$ref": "#/definitions/meta"
+        }
+      }
+    },
+    "detailQuotaEnvelope": {
+      "type": "object",
+      "required": [
+        "meta",
+        "data"
+      ],
+      "properties": {
+        "data": {
+          "$ref": "#/definitions/quotaDetail"
+        },
+        "meta": {
+          "$ref": "#/definitions/meta"
+        }
+      }
+    },
+    "detailRoleEnvelope": {
+      "type": "object",
+      "required": [
+        "meta",
+        "data"
+      ],
+      "properties": {
+        "data": {
+          "$ref": "#/definitions/roleDetail"
+        },
+        "meta": {
+          "$ref": "#/definitions/meta"
+        }
+      }
+    },
+    "detailRouterEnvelope": {
+      "type": "object",
+      "required": [
+        "meta",
+        "data"
+      ],
+      "properties": {
+        "data": {
+          "$ref": "#/definitions/routerDetail"
+        },
+        "meta": {
+          "$ref": "#/definitions/meta"
+        }
+      }
+    },
+    "detailServiceEnvelope": {
+      "type": "object",
+      "required": [
+        "meta",
+        "data"
+      ],
+      "properties": {
+        "data": {
+          "$ref": "#/definitions/serviceDetail"
+        },
+        "meta": {
+          "$ref": "#/definitions/meta"
+        }
+      }
+    },
+    "detailTerminatorEnvelope": {
+      "type": "object",
+      "required": [
+        "meta",
+        "data"
+      ],
+      "properties": {
+        "data": {
+          "$ref": "#/definitions/terminatorDetail"
+        },
+        "meta": {
+          "$ref": "#/definitions/meta"
+        }
+      }
+    },
+    "dialBind": {
+      "type": "string",
+      "enum": [
+        "Dial",
+        "Bind"
+      ]
+    },
+    "edgeRouterPolicyIds": {
+      "description": "A list of edge router policy ids. The limit only applies on the edge routers granted by these policies",
+      "type": "array",
+      "items": {
+        "type": "string"
+      }
+    },
+    "empty": {
+      "type": "object",
+      "required": [
+        "meta",
+        "data"
+      ],
+      "properties": {
+        "data": {
+          "type": "object",
+          "example": {}
+        },
+        "meta": {
+          "$ref": "#/definitions/meta"
+        }
+      }
+    },
+    "enrollmentSignerDetail": {
+      "type": "object",
+      "required": [
+        "id",
+        "subject",
+        "issuer",
+        "notBefore",
+        "notAfter",
+        "state",
+        "identityCerts",
+        "routerCerts"
+      ],
+      "properties": {
+        "id": {
+          "description": "The sha1 fingerprint of the signer certificate",
+          "type": "string"
+        },
+        "identityCerts": {
+          "description": "The number of identity certificate authenticators whose certificate was issued by this signer",
+          "type": "integer"
+        },
+        "issuer": {
+          "type": "string"
+        },
+        "notAfter": {
+          "type": "string",
+          "format": "date-time"
+        },
+        "notBefore": {
+          "type": "string",
+          "format": "date-time"
+        },
+        "routerCerts": {
+          "description": "The number of edge routers whose certificate was issued by this signer",
+          "type": "integer"
+        },
+        "state": {
+          "type": "string",
+          "enum": [
+            "active",
+            "trusted"
+          ]
+        },
+        "subject": {
+          "type": "string"
+        }
+      }
+    },
+    "enrollmentSignerEnvelope": {
+      "type": "object",
+      "required": [
+        "meta",
+        "data"
+      ],
+      "properties": {
+        "data": {
+          "$ref": "#/definitions/enrollmentSignerDetail"
+        },
+        "meta": {
+          "$ref": "#/definitions/meta"
+        }
+      }
+    },
+    "enrollmentSignerList": {
+      "type": "array",
+      "items": {
+        "$ref": "#/definitions/enrollmentSignerDetail"
+      }
+    },
+    "enrollmentSignerReissue": {
+      "type": "object",
+      "required": [
+        "signerId",
+        "identityCertsRequested",
+        "identityCertsFailed"
+      ],
+      "properties": {
+        "identityCertsFailed": {
+          "description": "The number of identity certificates which could not be flagged for extension",
+          "type": "integer"
+        },
+        "identityCertsRequested": {
+          "description": "The number of identity certificates which will be extended the next time the identity authenticates",
+          "type": "integer"
+        },
+        "routerCertRotationId": {
+          "description": "The id of the router certificate rotation renewing the edge router certificates, if any",
+          "type": "string"
+        },
+        "signerId": {
+          "type": "string"
+        }
+      }
+    },
+    "enrollmentSignerReissueEnvelope": {
+      "type": "object",
+      "required": [
+        "meta",
+        "data"
+      ],
+      "properties": {
+        "data": {
+          "$ref": "#/definitions/enrollmentSignerReissue"
+        },
+        "meta": {
+          "$ref": "#/definitions/meta"
+        }
+      }
+    },
+    "entityRef": {
+      "description": "A reference to another resource and links to interact with it",
+      "type": "object",
+      "properties": {
+        "_links": {
+          "$ref": "#/definitions/links"
+        },
+        "entity": {
+          "type": "string"
+        },
+        "id": {
+          "type": "string"
+        },
+        "name": {
+          "type": "string"
+        }
+      }
+    },
+    "inspectRequest": {
+      "type": "object",
+      "required": [
+        "appRegex",
+        "requestedValues"
+      ],
+      "properties": {
+        "appRegex": {
+          "type": "string"
+        },
+        "requestedValues": {
+          "type": "array",
+          "items": {
+            "type": "string"
+          }
+        }
+      }
+    },
+    "inspectResponse": {
+      "type": "object",
+      "required": [
+        "success"
+      ],
+      "properties": {
+        "errors": {
+          "type": "array",
+          "items": {
+            "type": "string"
+          }
+        },
+        "success": {
+          "type": "boolean"
+        },
+        "values": {
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/inspectResponseValue"
+          }
+        }
+      }
+    },
+    "inspectResponseValue": {
+      "type": "object",
+      "required": [
+        "appId",
+        "name",
+        "value"
+      ],
+      "properties": {
+        "appId": {
+          "type": "string"
+        },
+        "name": {
+          "type": "string"
+        },
+        "value": {
+          "type": [
+            "object",
+            "string",
+            "number",
+            "boolean"
+          ]
+        }
+      }
+    },
+    "interface": {
+      "description": "A resource describing a network interface",
+      "type": "object",
+      "required": [
+        "name",
+        "hardwareAddress",
+        "mtu",
+        "index",
+        "isUp",
+        "isRunning",
+        "isLoopback",
+        "isBroadcast",
+        "isMulticast",
+        "addresses"
+      ],
+      "properties": {
+        "addresses": {
+          "type": "array",
+          "items": {
+            "type": "string"
+          }
+        },
+        "hardwareAddress": {
+          "type": "string"
+        },
+        "index": {
+          "type": "integer"
+        },
+        "isBroadcast": {
+          "type": "boolean"
+        },
+        "isLoopback": {
+          "type": "boolean"
+        },
+        "isMulticast": {
+          "type": "boolean"
+        },
+        "isRunning": {
+          "type": "boolean"
+        },
+        "isUp": {
+          "type": "boolean"
+        },
+        "mtu": {
+          "type": "integer"
+        },
+        "name": {
+          "type": "string"
+        }
+      }
+    },
+    "link": {
+      "description": "A link to another resource",
+      "type": "object",
+      "required": [
+        "href"
+      ],
+      "properties": {
+        "comment": {
+          "type": "string"
+        },
+        "href": {
+          "type": "string",
+          "format": "uri"
+        },
+        "method": {
+          "type": "string"
+        }
+      }
+    },
+    "linkConnection": {
+      "type": "object",
+      "properties": {
+        "dest": {
+          "type": "string"
+        },
+        "source": {
+          "type": "string"
+        },
+        "type": {
+          "type": "string"
+        }
+      }
+    },
+    "linkDetail": {
+      "type": "object",
+      "required": [
+        "id",
+        "protocol",
+        "sourceRouter",
+        "destRouter",
+        "state",
+        "staticCost",
+        "sourceLatency",
+        "destLatency",
+        "cost",
+        "down",
+        "iteration"
+      ],
+      "properties": {
+        "connections": {
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/linkConnection"
+          }
+        },
+        "cost": {
+          "type": "integer"
+        },
+        "destLatency": {
+          "type": "integer"
+        },
+        "destRouter": {
+          "$ref": "#/definitions/entityRef"
+        },
+        "down": {
+          "type": "boolean"
+        },
+        "id": {
+          "type": "string"
+        },
+        "iteration": {
+          "type": "integer"
+        },
+        "protocol": {
+          "type": "string"
+        },
+        "sourceLatency": {
+          "type": "integer"
+        },
+        "sourceRouter": {
+          "$ref": "#/definitions/entityRef"
+        },
+        "state": {
+          "type": "string"
+        },
+        "staticCost": {
+          "type": "integer"
+        }
+      }
+    },
+    "linkList": {
+      "type": "array",
+      "items": {
+        "$ref": "#/definitions/linkDetail"
+      }
+    },
+    "linkPatch": {
+      "type": "object",
+      "properties": {
+        "down": {
+          "type": "boolean"
+        },
+        "staticCost": {
+          "type": "integer"
+        }
+      }
+    },
+    "links": {
+      "description": "A map of named links",
+      "type": "object",
+      "additionalProperties": {
+        "$ref": "#/definitions/link"
+      },
+      "x-omitempty": false
+    },
+    "listAlertRulesEnvelope": {
+      "type": "object",
+      "required": [
+        "meta",
+        "data"
+      ],
+      "properties": {
+        "data": {
+          "$ref": "#/definitions/alertRuleList"
+        },
+        "meta": {
+          "$ref": "#/definitions/meta"
+        }
+      }
+    },
+    "listAlertSilencesEnvelope": {
+      "type": "object",
+      "required": [
+        "meta",
+        "data"
+      ],
+      "properties": {
+        "data": {
+          "$ref": "#/definitions/alertSilenceList"
+        },
+        "meta": {
+          "$ref": "#/definitions/meta"
+        }
+      }
+    },
+    "listAlertsEnvelope": {
+      "type": "object",
+      "required": [
+        "meta",
+        "data"
+      ],
+      "properties": {
+        "data": {
+          "$ref": "#/definitions/alertList"
+        },
+        "meta": {
+          "$ref": "#/definitions/meta"
+        }
+      }
+    },
+    "listAuditEntriesEnvelope": {
+      "type": "object",
+      "required": [
+        "meta",
+        "data"
+      ],
+      "properties": {
+        "data": {
+          "$ref": "#/definitions/auditEntryList"
+        },
+        "meta": {
+          "$ref": "#/definitions/meta"
+        }
+      }
+    },
+    "listBandwidthLimitsEnvelope": {
+      "type": "object",
+      "required": [
+        "meta",
+        "data"
+      ],
+      "properties": {
+        "data": {
+          "$ref": "#/definitions/bandwidthLimitList"
+        },
+        "meta": {
+          "$ref": "#/definitions/meta"
+        }
+      }
+    },
+    "listBulkOperationsEnvelope": {
+      "type": "object",
+      "required": [
+        "meta",
+        "data"
+      ],
+      "properties": {
+        "data": {
+          "$ref": "#/definitions/bulkOperationList"
+        },
+        "meta": {
+          "$ref": "#/definitions/meta"
+        }
+      }
+    },
+    "listCircuitHistoryEnvelope": {
+      "type": "object",
+      "required": [
+        "meta",
+        "data"
+      ],
+      "properties": {
+        "data": {
+          "$ref": "#/definitions/circuitHistoryList"
+        },
+        "meta": {
+          "$ref": "#/definitions/meta"
+        }
+      }
+    },
+    "listCircuitsEnvelope": {
+      "type": "object",
+      "required": [
+        "meta",
+        "data"
+      ],
+      "properties": {
+        "data": {
+          "$ref": "#/definitions/circuitList"
+        },
+        "meta": {
+          "$ref": "#/definitions/meta"
+        }
+      }
+    },
+    "listEnrollmentSignersEnvelope": {
+      "type": "object",
+      "required": [
+        "meta",
+        "data"
+      ],
+      "properties": {
+        "data": {
+          "$ref": "#/definitions/enrollmentSignerList"
+        },
+        "meta": {
+          "$ref": "#/definitions/meta"
+        }
+      }
+    },
+    "listLinksEnvelope": {
+      "type": "object",
+      "required": [
+        "meta",
+        "data"
+      ],
+      "properties": {
+        "data": {
+          "$ref": "#/definitions/linkList"
+        },
+        "meta": {
+          "$ref": "#/definitions/meta"
+        }
+      }
+    },
+    "listProbeResultsEnvelope": {
+      "type": "object",
+      "required": [
+        "meta",
+        "data"
+      ],
+      "properties": {
+        "data": {
+          "$ref": "#/definitions/probeResultList"
+        },
+        "meta": {
+          "$ref": "#/definitions/meta"
+        }
+      }
+    },
+    "listProbesEnvelope": {
+      "type": "object",
+      "required": [
+        "meta",
+        "data"
+      ],
+      "properties": {
+        "data": {
+          "$ref": "#/definitions/probeList"
+        },
+        "meta": {
+          "$ref": "#/definitions/meta"
+        }
+      }
+    },
+    "listQuotaUsageEnvelope": {
+      "type": "object",
+      "required": [
+        "meta",
+        "data"
+      ],
+      "properties": {
+        "data": {
+          "$ref": "#/definitions/quotaUsageList"
+        },
+        "meta": {
+          "$ref": "#/definitions/meta"
+        }
+      }
+    },
+    "listQuotasEnvelope": {
+      "type": "object",
+      "required": [
+        "meta",
+        "data"
+      ],
+      "properties": {
+        "data": {
+          "$ref": "#/definitions/quotaList"
+        },
+        "meta": {
+          "$ref": "#/definitions/meta"
+        }
+      }
+    },
+    "listRolesEnvelope": {
+      "type": "object",
+      "required": [
+        "meta",
+        "data"
+      ],
+      "properties": {
+        "data": {
+          "$ref": "#/definitions/roleList"
+        },
+        "meta": {
+          "$ref": "#/definitions/meta"
+        }
+      }
+    },
+    "listRouterCertRotationsEnvelope": {
+      "type": "object",
+      "required": [
+        "meta",
+        "data"
+      ],
+      "properties": {
+        "data": {
+          "$ref": "#/definitions/routerCertRotationList"
+        },
+        "meta": {
+          "$ref": "#/definitions/meta"
+        }
+      }
+    },
+    "listRouterDrainsEnvelope": {
+      "type": "object",
+      "required": [
+        "meta",
+        "data"
+      ],
+      "properties": {
+        "data": {
+          "$ref": "#/definitions/routerDrainList"
+        },
+        "meta": {
+          "$ref": "#/definitions/meta"
+        }
+      }
+    },
+    "listRoutersEnvelope": {
+      "type": "object",
+      "required": [
+        "meta",
+        "data"
+      ],
+      "properties": {
+        "data": {
+          "$ref": "#/definitions/routerList"
+        },
+        "meta": {
+          "$ref": "#/definitions/meta"
+        }
+      }
+    },
+    "listServicesEnvelope": {
+      "type": "object",
+      "required": [
+        "meta",
+        "data"
+      ],
+      "properties": {
+        "data": {
+          "$ref": "#/definitions/serviceList"
+        },
+        "meta": {
+          "$ref": "#/definitions/meta"
+        }
+      }
+    },
+    "listTerminatorsEnvelope": {
+      "type": "object",
+      "required": [
+        "meta",
+        "data"
+      ],
+      "properties": {
+        "data": {
+          "$ref": "#/definitions/terminatorList"
+        },
+        "meta": {
+          "$ref": "#/definitions/meta"
+        }
+      }
+    },
+    "meta": {
+      "type": "object",
+      "properties": {
+        "apiEnrollmentVersion": {
+          "type": "string"
+        },
+        "apiVersion": {
+          "type": "string"
+        },
+        "filterableFields": {
+          "type": "array",
+          "items": {
+            "type": "string"
+          },
+          "x-omitempty": true
+        },
+        "pagination": {
+          "$ref": "#/definitions/pagination"
+        }
+      }
+    },
+    "pagination": {
+      "type": "object",
+      "required": [
+        "limit",
+        "offset",
+        "totalCount"
+      ],
+      "properties": {
+        "limit": {
+          "type": "number",
+          "format": "int64"
+        },
+        "offset": {
+          "type": "number",
+          "format": "int64"
+        },
+        "totalCount": {
+          "type": "number",
+          "format": "int64"
+        }
+      }
+    },
+    "path": {
+      "type": "object",
+      "properties": {
+        "initiatorLocalAddr": {
+          "description": "The local address of the initiating connection, for intercepted connections the intercepted address",
+          "type": "string"
+        },
+        "initiatorRemoteAddr": {
+          "description": "The remote address of the initiating connection",
+          "type": "string"
+        },
+        "links": {
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/entityRef"
+          }
+        },
+        "nodes": {
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/entityRef"
+          }
+        },
+        "terminatorLocalAddr": {
+          "description": "The local address of the terminating connection",
+          "type": "string"
+        },
+        "terminatorRemoteAddr": {
+          "description": "The remote address of the terminating connection",
+          "type": "string"
+        }
+      }
+    },
+    "permissions": {
+      "description": "A list of \u003centity type\u003e.\u003cverb\u003e grants, where the verb is read, create, update, delete or *",
+      "type": "array",
+      "items": {
+        "type": "string"
+      }
+    },
+    "policySimulationAction": {
+      "type": "string",
+      "enum": [
+        "create",
+        "update",
+        "delete"
+      ]
+    },
+    "policySimulationChange": {
+      "type": "object",
+      "required": [
+        "identityId",
+        "identityName",
+        "serviceId",
+        "serviceName",
+        "policyType",
+        "changeType"
+      ],
+      "properties": {
+        "addedEdgeRouters": {
+          "type": "array",
+          "items": {
+            "type": "string"
+          }
+        },
+        "changeType": {
+          "type": "string",
+          "enum": [
+            "granted",
+            "revoked",
+            "changed"
+          ]
+        },
+        "edgeRouters": {
+          "description": "The edge routers which could be used after the change",
+          "type": "array",
+          "items": {
+            "type": "string"
+          }
+        },
+        "identityId": {
+          "type": "string"
+        },
+        "identityName": {
+          "type": "string"
+        },
+        "policyType": {
+          "$ref": "#/definitions/dialBind"
+        },
+        "postureChecksAfter": {
+          "$ref": "#/definitions/postureCheckSets"
+        },
+        "postureChecksBefore": {
+          "$ref": "#/definitions/postureCheckSets"
+        },
+        "removedEdgeRouters": {
+          "type": "array",
+          "items": {
+            "type": "string"
+          }
+        },
+        "serviceId": {
+          "type": "string"
+        },
+        "serviceName": {
+          "type": "string"
+        }
+      }
+    },
+    "policySimulationCreate": {
+      "description": "Hypothetical changes to evaluate. For updates, omitted fields keep their current values, while an empty role\nlist clears the roles.\n",
+      "type": "object",
+      "properties": {
+        "edgeRouterPolicies": {
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/simulatedEdgeRouterPolicy"
+          }
+        },
+        "edgeRouterRoleAttributes": {
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/simulatedRoleAttributes"
+          }
+        },
+        "identityRoleAttributes": {
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/simulatedRoleAttributes"
+          }
+        },
+        "maxChanges": {
+          "description": "The maximum number of changes to list. Defaults to 1000. Counts always cover every change",
+          "type": "integer"
+        },
+        "removedEdgeRouters": {
+          "description": "Ids of edge routers to treat as removed",
+          "type": "array",
+          "items": {
+            "type": "string"
+          }
+        },
+        "serviceEdgeRouterPolicies": {
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/simulatedServiceEdgeRouterPolicy"
+          }
+        },
+        "servicePolicies": {
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/simulatedServicePolicy"
+          }
+        },
+        "serviceRoleAttributes": {
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/simulatedRoleAttributes"
+          }
+        }
+      }
+    },
+    "policySimulationResultDetail": {
+      "type": "object",
+      "required": [
+        "identitiesAffected",
+        "servicesAffected",
+        "granted",
+        "revoked",
+        "changed",
+        "tuplesAdded",
+        "tuplesRemoved",
+        "truncated",
+        "changes"
+      ],
+      "properties": {
+        "changed": {
+          "description": "Identity, service and policy type combinations whose edge routers or posture checks would change",
+          "type": "integer"
+        },
+        "changes": {
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/policySimulationChange"
+          }
+        },
+        "granted": {
+          "description": "Identity, service and policy type combinations which would gain access",
+          "type": "integer"
+        },
+        "identitiesAffected": {
+          "type": "integer"
+        },
+        "revoked": {
+          "description": "Identity, service and policy type combinations which would lose access",
+          "type": "integer"
+        },
+        "servicesAffected": {
+          "type": "integer"
+        },
+        "truncated": {
+          "type": "boolean"
+        },
+        "tuplesAdded": {
+          "description": "Identity, service, policy type and edge router combinations which would be added",
+          "type": "integer"
+        },
+        "tuplesRemoved": {
+          "description": "Identity, service, policy type and edge router combinations which would be removed",
+          "type": "integer"
+        }
+      }
+    },
+    "policySimulationResultEnvelope": {
+      "type": "object",
+      "required": [
+        "meta",
+        "data"
+      ],
+      "properties": {
+        "data": {
+          "$ref": "#/definitions/policySimulationResultDetail"
+        },
+        "meta": {
+          "$ref": "#/definitions/meta"
+        }
+      }
+    },
+    "postureCheckSets": {
+      "description": "The posture check ids required by each granting policy. Access requires all checks of any one set",
+      "type": "array",
+      "items": {
+        "type": "array",
+        "items": {
+          "type": "string"
+        }
+      }
+    },
+    "probeDetail": {
+      "type": "object",
+      "required": [
+        "name",
+        "type",
+        "router",
+        "intervalSeconds",
+        "timeoutMillis",
+        "slo",
+        "summary"
+      ],
+      "properties": {
+        "expect": {
+          "description": "For service probes, the response the service is expected to send",
+          "type": "string"
+        },
+        "intervalSeconds": {
+          "type": "integer"
+        },
+        "name": {
+          "type": "string"
+        },
+        "router": {
+          "description": "The id or name of the router which executes the probe",
+          "type": "string"
+        },
+        "send": {
+          "description": "For service probes, the payload written once connected",
+          "type": "string"
+        },
+        "service": {
+          "description": "For service probes, the service which is dialed",
+          "type": "string"
+        },
+        "slo": {
+          "$ref": "#/definitions/probeSlo"
+        },
+        "summary": {
+          "$ref": "#/definitions/probeSummary"
+        },
+        "targetRouter": {
+          "description": "For routerPath probes, the id or name of the router at the other end of the path",
+          "type": "string"
+        },
+        "timeoutMillis": {
+          "type": "integer"
+        },
+        "type": {
+          "type": "string",
+          "enum": [
+            "routerPath",
+            "service"
+          ]
+        }
+      }
+    },
+    "probeEnvelope": {
+      "type": "object",
+      "required": [
+        "meta",
+        "data"
+      ],
+      "properties": {
+        "data": {
+          "$ref": "#/definitions/probeDetail"
+        },
+        "meta": {
+          "$ref": "#/definitions/meta"
+        }
+      }
+    },
+    "probeList": {
+      "type": "array",
+      "items": {
+        "$ref": "#/definitions/probeDetail"
+      }
+    },
+    "probeResult": {
+      "type": "object",
+      "required": [
+        "timestamp",
+        "routerId",
+        "success"
+      ],
+      "properties": {
+        "error": {
+          "type": "string"
+        },
+        "latencyMillis": {
+          "type": "number",
+          "x-nullable": true
+        },
+        "routerId": {
+          "type": "string"
+        },
+        "success": {
+          "type": "boolean"
+        },
+        "targetRouterId": {
+          "type": "string"
+        },
+        "timestamp": {
+          "type": "string",
+          "format": "date-time"
+        }
+      }
+    },
+    "probeResultList": {
+      "type": "array",
+      "items": {
+        "$ref": "#/definitions/probeResult"
+      }
+    },
+    "probeSlo": {
+      "type": "object",
+      "properties": {
+        "availability": {
+          "description": "The percentage of probes which must succeed. Not set if the probe has no availability objective",
+          "type": "number",
+          "x-nullable": true
+        },
+        "latencyMillis": {
+          "description": "The upper bound for the given percentile of probe latency. Not set if the probe has no latency objective",
+          "type": "number",
+          "x-nullable": true
+        },
+        "percentile": {
+          "type": "number"
+        }
+      }
+    },
+    "probeSummary": {
+      "type": "object",
+      "required": [
+        "windowSeconds",
+        "total",
+        "succeeded"
+      ],
+      "properties": {
+        "availability": {
+          "description": "The percentage of probes in the window which succeeded. Not set if there are no results in the window",
+          "type": "number",
+          "x-nullable": true
+        },
+        "lastFailure": {
+          "$ref": "#/definitions/probeResult"
+        },
+        "lastResult": {
+          "$ref": "#/definitions/probeResult"
+        },
+        "latencyP50Millis": {
+          "type": "number",
+          "x-nullable": true
+        },
+        "latencyP95Millis": {
+          "type": "number",
+          "x-nullable": true
+        },
+        "latencyP99Millis": {
+          "type": "number",
+          "x-nullable": true
+        },
+        "sloLatencyMillis": {
+          "description": "The latency at the SLO percentile",
+          "type": "number",
+          "x-nullable": true
+        },
+        "sloMet": {
+          "description": "Whether the probe met its objectives over the window. Not set if the probe has no objectives or no results",
+          "type": "boolean",
+          "x-nullable": true
+        },
+        "succeeded": {
+          "type": "integer"
+        },
+        "total": {
+          "type": "integer"
+        },
+        "windowSeconds": {
+          "description": "The window over which the summary is computed",
+          "type": "integer"
+        }
+      }
+    },
+    "quotaBytes": {
+      "description": "A number of bytes. Zero means no limit",
+      "type": "integer",
+      "format": "int64"
+    },
+    "quotaCreate": {
+      "type": "object",
+      "required": [
+        "name",
+        "scope",
+        "period"
+      ],
+      "properties": {
+        "hardLimit": {
+          "$ref": "#/definitions/quotaBytes"
+        },
+        "hardLimitAction": {
+          "$ref": "#/definitions/quotaHardLimitAction"
+        },
+        "identityRoles": {
+          "$ref": "#/definitions/roles"
+        },
+        "name": {
+          "type": "string"
+        },
+        "period": {
+          "$ref": "#/definitions/quotaPeriod"
+        },
+        "scope": {
+          "$ref": "#/definitions/quotaScope"
+        },
+        "serviceRoles": {
+          "$ref": "#/definitions/roles"
+        },
+        "softLimit": {
+          "$ref": "#/definitions/quotaBytes"
+        },
+        "tags": {
+          "$ref": "#/definitions/tags"
+        }
+      }
+    },
+    "quotaDetail": {
+      "type": "object",
+      "allOf": [
+        {
+          "$ref": "#/definitions/baseEntity"
+        },
+        {
+          "type": "object",
+          "required": [
+            "name",
+            "scope",
+            "period",
+            "softLimit",
+            "hardLimit",
+            "hardLimitAction",
+            "identityRoles",
+            "serviceRoles"
+          ],
+          "properties": {
+            "hardLimit": {
+              "$ref": "#/definitions/quotaBytes"
+            },
+            "hardLimitAction": {
+              "$ref": "#/definitions/quotaHardLimitAction"
+            },
+            "identityRoles": {
+              "$ref": "#/definitions/roles"
+            },
+            "name": {
+              "type": "string"
+            },
+            "period": {
+              "$ref": "#/definitions/quotaPeriod"
+            },
+            "scope": {
+              "$ref": "#/definitions/quotaScope"
+            },
+            "serviceRoles": {
+              "$ref": "#/definitions/roles"
+            },
+            "softLimit": {
+              "$ref": "#/definitions/quotaBytes"
+            }
+          }
+        }
+      ]
+    },
+    "quotaHardLimitAction": {
+      "description": "What happens when the hard limit is reached. deny refuses new sessions and circuits, teardown additionally\nremoves existing circuits. Defaults to deny\n",
+      "type": "string",
+      "enum": [
+        "deny",
+        "teardown"
+      ]
+    },
+    "quotaList": {
+      "type": "array",
+      "items": {
+        "$ref": "#/definitions/quotaDetail"
+      }
+    },
+    "quotaPatch": {
+      "type": "object",
+      "properties": {
+        "hardLimit": {
+          "$ref": "#/definitions/quotaBytes"
+        },
+        "hardLimitAction": {
+          "$ref": "#/definitions/quotaHardLimitAction"
+        },
+        "identityRoles": {
+          "$ref": "#/definitions/roles"
+        },
+        "name": {
+          "type": "string"
+        },
+        "period": {
+          "$ref": "#/definitions/quotaPeriod"
+        },
+        "scope": {
+          "$ref": "#/definitions/quotaScope"
+        },
+        "serviceRoles": {
+          "$ref": "#/definitions/roles"
+        },
+        "softLimit": {
+          "$ref": "#/definitions/quotaBytes"
+        },
+        "tags": {
+          "$ref": "#/definitions/tags"
+        }
+      }
+    },
+    "quotaPeriod": {
+      "description": "The period over which consumption is counted. Periods start at midnight UTC",
+      "type": "string",
+      "enum": [
+        "day",
+        "month"
+      ]
+    },
+    "quotaScope": {
+      "description": "Whether consumption is tracked separately for each matching identity or for each matching service",
+      "type": "string",
+      "enum": [
+        "identity",
+        "service"
+      ]
+    },
+    "quotaUpdate": {
+      "type": "object",
+      "required": [
+        "name",
+        "scope",
+        "period"
+      ],
+      "properties": {
+        "hardLimit": {
+          "$ref": "#/definitions/quotaBytes"
+        },
+        "hardLimitAction": {
+          "$ref": "#/definitions/quotaHardLimitAction"
+        },
+        "identityRoles": {
+          "$ref": "#/definitions/roles"
+        },
+        "name": {
+          "type": "string"
+        },
+        "period": {
+          "$ref": "#/definitions/quotaPeriod"
+        },
+        "scope": {
+          "$ref": "#/definitions/quotaScope"
+        },
+        "serviceRoles": {
+          "$ref": "#/definitions/roles"
+        },
+        "softLimit": {
+          "$ref": "#/definitions/quotaBytes"
+        },
+        "tags": {
+          "$ref": "#/definitions/tags"
+        }
+      }
+    },
+    "quotaUsage": {
+      "type": "object",
+      "required": [
+        "subjectId",
+        "periodStart",
+        "bytes",
+        "softLimitExceeded",
+        "hardLimitExceeded"
+      ],
+      "properties": {
+        "bytes": {
+          "type": "integer",
+          "format": "int64"
+        },
+        "hardLimitExceeded": {
+          "type": "boolean"
+        },
+        "periodStart": {
+          "type": "string",
+          "format": "date-time"
+        },
+        "softLimitExceeded": {
+          "type": "boolean"
+        },
+        "subjectId": {
+          "description": "The id of the identity or service, depending on the quota scope",
+          "type": "string"
+        }
+      }
+    },
+    "quotaUsageList": {
+      "type": "array",
+      "items": {
+        "$ref": "#/definitions/quotaUsage"
+      }
+    },
+    "roleCreate": {
+      "type": "object",
+      "required": [
+        "name",
+        "permissions"
+      ],
+      "properties": {
+        "identityRoles": {
+          "$ref": "#/definitions/roles"
+        },
+        "name": {
+          "type": "string"
+        },
+        "permissions": {
+          "$ref": "#/definitions/permissions"
+        },
+        "scopeRoles": {
+          "$ref": "#/definitions/roles"
+        },
+        "tags": {
+          "$ref": "#/definitions/tags"
+        }
+      }
+    },
+    "roleDetail": {
+      "type": "object",
+      "allOf": [
+        {
+          "$ref": "#/definitions/baseEntity"
+        },
+        {
+          "type": "object",
+          "required": [
+            "name",
+            "identityRoles",
+            "permissions",
+            "scopeRoles"
+          ],
+          "properties": {
+            "identityRoles": {
+              "$ref": "#/definitions/roles"
+            },
+            "name": {
+              "type": "string"
+            },
+            "permissions": {
+              "$ref": "#/definitions/permissions"
+            },
+            "scopeRoles": {
+              "$ref": "#/definitions/roles"
+            }
+          }
+        }
+      ]
+    },
+    "roleList": {
+      "type": "array",
+      "items": {
+        "$ref": "#/definitions/roleDetail"
+      }
+    },
+    "rolePatch": {
+      "type": "object",
+      "properties": {
+        "identityRoles": {
+          "$ref": "#/definitions/roles"
+        },
+        "name": {
+          "type": "string"
+        },
+        "permissions": {
+          "$ref": "#/definitions/permissions"
+        },
+        "scopeRoles": {
+          "$ref": "#/definitions/roles"
+        },
+        "tags": {
+          "$ref": "#/definitions/tags"
+        }
+      }
+    },
+    "roleUpdate": {
+      "type": "object",
+      "required": [
+        "name",
+        "permissions"
+      ],
+      "properties": {
+        "identityRoles": {
+          "$ref": "#/definitions/roles"
+        },
+        "name": {
+          "type": "string"
+        },
+        "permissions": {
+          "$ref": "#/definitions/permissions"
+        },
+        "scopeRoles": {
+          "$ref": "#/definitions/roles"
+        },
+        "tags": {
+          "$ref": "#/definitions/tags"
+        }
+      }
+    },
+    "roles": {
+      "description": "A list of @\u003cid\u003e,",
+      "type": "array",
+      "items": {
+        "type": "string"
+      }
+    },
+    "routerCertRotationCreate": {
+      "type": "object",
+      "properties": {
+        "batchSize": {
+          "description": "The number of routers rotated at a time. Defaults to 10, may be at most 100",
+          "type": "integer"
+        },
+        "filter": {
+          "description": "A filter selecting the routers to rotate, ex. 'name contains \"us-east\"'",
+          "type": "string"
+        },
+        "routerIds": {
+          "description": "The routers to rotate. Exactly one of routerIds or filter must be provided",
+          "type": "array",
+          "items": {
+            "type": "string"
+          }
+        },
+        "timeoutSeconds": {
+          "description": "How long to wait for each router to renew its certificates. Defaults to 120, must be at least 10",
+          "type": "integer"
+        }
+      }
+    },
+    "routerCertRotationDetail": {
+      "type": "object",
+      "required": [
+        "id",
+        "batchSize",
+        "timeoutSeconds",
+        "total",
+        "completed",
+        "failed",
+        "complete",
+        "startedAt",
+        "routers"
+      ],
+      "properties": {
+        "batchSize": {
+          "type": "integer"
+        },
+        "complete": {
+          "type": "boolean"
+        },
+        "completed": {
+          "type": "integer"
+        },
+        "completedAt": {
+          "type": "string",
+          "format": "date-time"
+        },
+        "failed": {
+          "type": "integer"
+        },
+        "id": {
+          "type": "string"
+        },
+        "routers": {
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/routerCertRotationStatus"
+          }
+        },
+        "startedAt": {
+          "type": "string",
+          "format": "date-time"
+        },
+        "timeoutSeconds": {
+          "type": "integer"
+        },
+        "total": {
+          "type": "integer"
+        }
+      }
+    },
+    "routerCertRotationEnvelope": {
+      "type": "object",
+      "required": [
+        "meta",
+        "data"
+      ],
+      "properties": {
+        "data": {
+          "$ref": "#/definitions/routerCertRotationDetail"
+        },
+        "meta": {
+          "$ref": "#/definitions/meta"
+        }
+      }
+    },
+    "routerCertRotationList": {
+      "type": "array",
+      "items": {
+        "$ref": "#/definitions/routerCertRotationDetail"
+      }
+    },
+    "routerCertRotationStatus": {
+      "type": "object",
+      "required": [
+        "routerId",
+        "state"
+      ],
+      "properties": {
+        "completedAt": {
+          "type": "string",
+          "format": "date-time"
+        },
+        "error": {
+          "type": "string"
+        },
+        "requestedAt": {
+          "type": "string",
+          "format": "date-time"
+        },
+        "routerId": {
+          "type": "string"
+        },
+        "routerName": {
+          "type": "string"
+        },
+        "state": {
+          "type": "string",
+          "enum": [
+            "pending",
+            "requested",
+            "completed",
+            "failed"
+          ]
+        }
+      }
+    },
+    "routerCreate": {
+      "type": "object",
+      "required": [
+        "id",
+        "name",
+        "cost",
+        "noTraversal"
+      ],
+      "properties": {
+        "cost": {
+          "type": "integer",
+          "maximum": 65535
+        },
+        "disabled": {
+          "type": "boolean",
+          "x-nullable": true
+        },
+        "fingerprint": {
+          "type": "string",
+          "x-nullable": true
+        },
+        "id": {
+          "type": "string"
+        },
+        "name": {
+          "type": "string"
+        },
+        "noTraversal": {
+          "type": "boolean"
+        },
+        "tags": {
+          "$ref": "#/definitions/tags"
+        }
+      }
+    },
+    "routerDetail": {
+      "type": "object",
+      "allOf": [
+        {
+          "$ref": "#/definitions/baseEntity"
+        },
+        {
+          "type": "object",
+          "required": [
+            "name",
+            "fingerprint",
+            "connected",
+            "cost",
+            "noTraversal",
+            "disabled"
+          ],
+          "properties": {
+            "connected": {
+              "type": "boolean"
+            },
+            "cost": {
+              "type": "integer",
+              "maximum": 65535
+            },
+            "disabled": {
+              "type": "boolean"
+            },
+            "fingerprint": {
+              "type": "string"
+            },
+            "interfaces": {
+              "type": "array",
+              "items": {
+                "$ref": "#/definitions/interface"
+              }
+            },
+            "listenerAddresses": {
+              "type": "array",
+              "items": {
+                "$ref": "#/definitions/routerListener"
+              }
+            },
+            "name": {
+              "type": "string"
+            },
+            "noTraversal": {
+              "type": "boolean"
+            },
+            "versionInfo": {
+              "$ref": "#/definitions/versionInfo"
+            }
+          }
+        }
+      ]
+    },
+    "routerDrainCreate": {
+      "type": "object",
+      "properties": {
+        "edgeCircuitTimeoutSeconds": {
+          "description": "How long to wait for circuits which start or end at the router to finish. Zero doesn't wait",
+          "type": "integer"
+        },
+        "quiesce": {
+          "description": "If true, the router is quiesced so its terminators aren't used for new circuits",
+          "type": "boolean"
+        }
+      }
+    },
+    "routerDrainDetail": {
+      "type": "object",
+      "required": [
+        "routerId",
+        "state",
+        "startedAt",
+        "transitCircuits",
+        "transitRerouted",
+        "transitEnded",
+        "edgeCircuits",
+        "stragglers"
+      ],
+      "properties": {
+        "completedAt": {
+          "type": "string",
+          "format": "date-time",
+          "x-nullable": true
+        },
+        "edgeCircuitTimeoutSeconds": {
+          "type": "integer"
+        },
+        "edgeCircuits": {
+          "description": "The number of circuits which start or end at the router",
+          "type": "integer"
+        },
+        "quiesce": {
+          "type": "boolean"
+        },
+        "routerId": {
+          "type": "string"
+        },
+        "routerName": {
+          "type": "string"
+        },
+        "startedAt": {
+          "type": "string",
+          "format": "date-time"
+        },
+        "state": {
+          "type": "string",
+          "enum": [
+            "rerouting",
+            "waiting-for-edge-circuits",
+            "drained"
+          ]
+        },
+        "stragglers": {
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/routerDrainStraggler"
+          }
+        },
+        "transitCircuits": {
+          "description": "The number of circuits passing through the router which the drain tried to reroute",
+          "type": "integer"
+        },
+        "transitEnded": {
+          "description": "The number of transit circuits which ended before they were rerouted",
+          "type": "integer"
+        },
+        "transitRerouted": {
+          "type": "integer"
+        }
+      }
+    },
+    "routerDrainEnvelope": {
+      "type": "object",
+      "required": [
+        "meta",
+        "data"
+      ],
+      "properties": {
+        "data": {
+          "$ref": "#/definitions/routerDrainDetail"
+        },
+        "meta": {
+          "$ref": "#/definitions/meta"
+        }
+      }
+    },
+    "routerDrainList": {
+      "type": "array",
+      "items": {
+        "$ref": "#/definitions/routerDrainDetail"
+      }
+    },
+    "routerDrainStraggler": {
+      "type": "object",
+      "required": [
+        "circuitId",
+        "kind"
+      ],
+      "properties": {
+        "circuitId": {
+          "type": "string"
+        },
+        "error": {
+          "type": "string"
+        },
+        "kind": {
+          "type": "string",
+          "enum": [
+            "transit",
+            "edge"
+          ]
+        }
+      }
+    },
+    "routerList": {
+      "type": "array",
+      "items": {
+        "$ref": "#/definitions/routerDetail"
+      }
+    },
+    "routerListener": {
+      "type": "object",
+      "required": [
+        "address",
+        "protocol"
+      ],
+      "properties": {
+        "address": {
+          "type": "string"
+        },
+        "protocol": {
+          "type": "string"
+        }
+      }
+    },
+    "routerPatch": {
+      "type": "object",
+      "properties": {
+        "cost": {
+          "type": "integer",
+          "maximum": 65535,
+          "x-nullable": true
+        },
+        "disabled": {
+          "type": "boolean",
+          "x-nullable": true
+        },
+        "fingerprint": {
+          "type": "string",
+          "x-nullable": true
+        },
+        "name": {
+          "type": "string"
+        },
+        "noTraversal": {
+          "type": "boolean",
+          "x-nullable": true
+        },
+        "tags": {
+          "$ref": "#/definitions/tags"
+        }
+      }
+    },
+    "routerUpdate": {
+      "type": "object",
+      "required": [
+        "name",
+        "fingerprint",
+        "cost",
+        "noTraversal"
+      ],
+      "properties": {
+        "cost": {
+          "type": "integer",
+          "maximum": 65535
+        },
+        "disabled": {
+          "type": "boolean",
+          "x-nullable": true
+        },
+        "fingerprint": {
+          "type": "string"
+        },
+        "name": {
+          "type": "string"
+        },
+        "noTraversal": {
+          "type": "boolean"
+        },
+        "tags": {
+          "$ref": "#/definitions/tags"
+        }
+      }
+    },
+    "semantic": {
+      "type": "string",
+      "enum": [
+        "AllOf",
+        "AnyOf"
+      ]
+    },
+    "serviceCreate": {
+      "type": "object",
+      "required": [
+        "name"
+      ],
+      "properties": {
+        "name": {
+          "type": "string"
+        },
+        "tags": {
+          "$ref": "#/definitions/tags"
+        },
+        "terminatorStrategy": {
+          "type": "string"
+        }
+      }
+    },
+    "serviceDetail": {
+      "type": "object",
+      "allOf": [
+        {
+          "$ref": "#/definitions/baseEntity"
+        },
+        {
+          "type": "object",
+          "required": [
+            "name",
+            "terminatorStrategy"
+          ],
+          "properties": {
+            "name": {
+              "type": "string"
+            },
+            "terminatorStrategy": {
+              "type": "string"
+            }
+          }
+        }
+      ]
+    },
+    "serviceList": {
+      "type": "array",
+      "items": {
+        "$ref": "#/definitions/serviceDetail"
+      }
+    },
+    "servicePatch": {
+      "type": "object",
+      "properties": {
+        "name": {
+          "type": "string"
+        },
+        "tags": {
+          "$ref": "#/definitions/tags"
+        },
+        "terminatorStrategy": {
+          "type": "string"
+        }
+      }
+    },
+    "serviceUpdate": {
+      "type": "object",
+      "required": [
+        "name"
+      ],
+      "properties": {
+        "name": {
+          "type": "string"
+        },
+        "tags": {
+          "$ref": "#/definitions/tags"
+        },
+        "terminatorStrategy": {
+          "type": "string"
+        }
+      }
+    },
+    "simulatedEdgeRouterPolicy": {
+      "type": "object",
+      "required": [
+        "action"
+      ],
+      "properties": {
+        "action": {
+          "$ref": "#/definitions/policySimulationAction"
+        },
+        "edgeRouterRoles": {
+          "$ref": "#/definitions/roles"
+        },
+        "id": {
+          "description": "Required for update and delete. Optional for create",
+          "type": "string"
+        },
+        "identityRoles": {
+          "$ref": "#/definitions/roles"
+        },
+        "name": {
+          "type": "string"
+        },
+        "semantic": {
+          "$ref": "#/definitions/semantic"
+        }
+      }
+    },
+    "simulatedRoleAttributes": {
+      "type": "object",
+      "required": [
+        "id",
+        "roleAttributes"
+      ],
+      "properties": {
+        "id": {
+          "type": "string"
+        },
+        "roleAttributes": {
+          "type": "array",
+          "items": {
+            "type": "string"
+          }
+        }
+      }
+    },
+    "simulatedServiceEdgeRouterPolicy": {
+      "type": "object",
+      "required": [
+        "action"
+      ],
+      "properties": {
+        "action": {
+          "$ref": "#/definitions/policySimulationAction"
+        },
+        "edgeRouterRoles": {
+          "$ref": "#/definitions/roles"
+        },
+        "id": {
+          "description": "Required for update and delete. Optional for create",
+          "type": "string"
+        },
+        "name": {
+          "type": "string"
+        },
+        "semantic": {
+          "$ref": "#/definitions/semantic"
+        },
+        "serviceRoles": {
+          "$ref": "#/definitions/roles"
+        }
+      }
+    },
+    "simulatedServicePolicy": {
+      "type": "object",
+      "required": [
+        "action"
+      ],
+      "properties": {
+        "action": {
+          "$ref": "#/definitions/policySimulationAction"
+        },
+        "id": {
+          "description": "Required for update and delete. Optional for create",
+          "type": "string"
+        },
+        "identityRoles": {
+          "$ref": "#/definitions/roles"
+        },
+        "name": {
+          "type": "string"
+        },
+        "postureCheckRoles": {
+          "$ref": "#/definitions/roles"
+        },
+        "semantic": {
+          "$ref": "#/definitions/semantic"
+        },
+        "serviceRoles": {
+          "$ref": "#/definitions/roles"
+        },
+        "type": {
+          "$ref": "#/definitions/dialBind"
+        }
+      }
+    },
+    "subTags": {
+      "type": "object",
+      "additionalProperties": {
+        "type": "object"
+      }
+    },
+    "tags": {
+      "description": "A map of user defined fields and values. The values are limited to the following types/values: null, string, boolean",
+      "allOf": [
+        {
+          "$ref": "#/definitions/subTags"
+        }
+      ],
+      "x-nullable": true
+    },
+    "terminatorCost": {
+      "type": "integer",
+      "maximum": 65535
+    },
+    "terminatorCostMap": {
+      "type": "object",
+      "additionalProperties": {
+        "$ref": "#/definitions/terminatorCost"
+      }
+    },
+    "terminatorCreate": {
+      "type": "object",
+      "required": [
+        "service",
+        "router",
+        "address",
+        "binding"
+      ],
+      "properties": {
+        "address": {
+          "type": "string"
+        },
+        "binding": {
+          "type": "string"
+        },
+        "cost": {
+          "$ref": "#/definitions/terminatorCost"
+        },
+        "hostId": {
+          "type": "string"
+        },
+        "instanceId": {
+          "type": "string"
+        },
+        "instanceSecret": {
+          "type": "string",
+          "format": "byte"
+        },
+        "precedence": {
+          "$ref": "#/definitions/terminatorPrecedence"
+        },
+        "router": {
+          "type": "string"
+        },
+        "service": {
+          "type": "string"
+        },
+        "tags": {
+          "$ref": "#/definitions/tags"
+        }
+      }
+    },
+    "terminatorDetail": {
+      "type": "object",
+      "allOf": [
+        {
+          "$ref": "#/definitions/baseEntity"
+        },
+        {
+          "type": "object",
+          "required": [
+            "serviceId",
+            "service",
+            "routerId",
+            "router",
+            "binding",
+            "address",
+            "instanceId",
+            "cost",
+            "precedence",
+            "dynamicCost",
+            "hostId"
+          ],
+          "properties": {
+            "address": {
+              "type": "string"
+            },
+            "binding": {
+              "type": "string"
+            },
+            "cost": {
+              "$ref": "#/definitions/terminatorCost"
+            },
+            "dynamicCost": {
+              "$ref": "#/definitions/terminatorCost"
+            },
+            "hostId": {
+              "type": "string"
+            },
+            "instanceId": {
+              "type": "string"
+            },
+            "precedence": {
+              "$ref": "#/definitions/terminatorPrecedence"
+            },
+            "router": {
+              "$ref": "#/definitions/entityRef"
+            },
+            "routerId": {
+              "type": "string"
+            },
+            "service": {
+              "$ref": "#/definitions/entityRef"
+            },
+            "serviceId": {
+              "type": "string"
+            }
+          }
+        }
+      ]
+    },
+    "terminatorList": {
+      "type": "array",
+      "items": {
+        "$ref": "#/definitions/terminatorDetail"
+      }
+    },
+    "terminatorPatch": {
+      "type": "object",
+      "properties": {
+        "address": {
+          "type": "string"
+        },
+        "binding": {
+          "type": "string"
+        },
+        "cost": {
+          "$ref": "#/definitions/terminatorCost"
+        },
+        "hostId": {
+          "type": "string"
+        },
+        "precedence": {
+          "$ref": "#/definitions/terminatorPrecedence"
+        },
+        "router": {
+          "type": "string"
+        },
+        "service": {
+          "type": "string"
+        },
+        "tags": {
+          "$ref": "#/definitions/tags"
+        }
+      }
+    },
+    "terminatorPrecedence": {
+      "type": "string",
+      "enum": [
+        "default",
+        "required",
+        "failed"
+      ]
+    },
+    "terminatorPrecedenceMap": {
+      "type": "object",
+      "additionalProperties": {
+        "$ref": "#/definitions/terminatorPrecedence"
+      }
+    },
+    "terminatorUpdate": {
+      "type": "object",
+      "required": [
+        "service",
+        "router",
+        "address",
+        "binding"
+      ],
+      "properties": {
+        "address": {
+          "type": "string"
+        },
+        "binding": {
+          "type": "string"
+        },
+        "cost": {
+          "$ref": "#/definitions/terminatorCost"
+        },
+        "hostId": {
+          "type": "string"
+        },
+        "precedence": {
+          "$ref": "#/definitions/terminatorPrecedence"
+        },
+        "router": {
+          "type": "string"
+        },
+        "service": {
+          "type": "string"
+        },
+        "tags": {
+          "$ref": "#/definitions/tags"
+        }
+      }
+    },
+    "topology": {
+      "type": "object",
+      "required": [
+        "controllerId",
+        "timestamp",
+        "routers",
+        "links"
+      ],
+      "properties": {
+        "controllerId": {
+          "description": "The id of the controller which produced the snapshot",
+          "type": "string"
+        },
+        "links": {
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/topologyLink"
+          }
+        },
+        "routers": {
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/topologyRouter"
+          }
+        },
+        "timestamp": {
+          "type": "string",
+          "format": "date-time"
+        }
+      }
+    },
+    "topologyEnvelope": {
+      "type": "object",
+      "required": [
+        "meta",
+        "data"
+      ],
+      "properties": {
+        "data": {
+          "$ref": "#/definitions/topology"
+        },
+        "meta": {
+          "$ref": "#/definitions/meta"
+        }
+      }
+    },
+    "topologyLink": {
+      "type": "object",
+      "required": [
+        "id",
+        "sourceRouterId",
+        "destRouterId",
+        "protocol",
+        "state",
+        "down",
+        "staticCost",
+        "cost",
+        "sourceLatency",
+        "destLatency",
+        "circuitCount"
+      ],
+      "properties": {
+        "circuitCount": {
+          "description": "The number of circuits routed over the link",
+          "type": "integer"
+        },
+        "cost": {
+          "type": "integer"
+        },
+        "destLatency": {
+          "description": "The latency measured by the destination router, in nanoseconds",
+          "type": "integer"
+        },
+        "destRouterId": {
+          "type": "string"
+        },
+        "down": {
+          "type": "boolean"
+        },
+        "id": {
+          "type": "string"
+        },
+        "protocol": {
+          "type": "string"
+        },
+        "sourceLatency": {
+          "description": "The latency measured by the source router, in nanoseconds",
+          "type": "integer"
+        },
+        "sourceRouterId": {
+          "type": "string"
+        },
+        "state": {
+          "type": "string"
+        },
+        "staticCost": {
+          "type": "integer"
+        }
+      }
+    },
+    "topologyRouter": {
+      "type": "object",
+      "required": [
+        "id",
+        "name",
+        "connected",
+        "cost",
+        "noTraversal",
+        "disabled",
+        "draining",
+        "circuitCount"
+      ],
+      "properties": {
+        "circuitCount": {
+          "description": "The number of circuits whose path includes the router",
+          "type": "integer"
+        },
+        "connected": {
+          "type": "boolean"
+        },
+        "connectedController": {
+          "description": "The id of the controller the router is connected to, if it's connected to the controller which produced the snapshot",
+          "type": "string"
+        },
+        "connectedSince": {
+          "type": "string",
+          "format": "date-time",
+          "x-nullable": true
+        },
+        "cost": {
+          "type": "integer"
+        },
+        "disabled": {
+          "type": "boolean"
+        },
+        "draining": {
+          "type": "boolean"
+        },
+        "id": {
+          "type": "string"
+        },
+        "name": {
+          "type": "string"
+        },
+        "noTraversal": {
+          "type": "boolean"
+        },
+        "version": {
+          "type": "string"
+        }
+      }
+    },
+    "versionInfo": {
+      "description": "Application build information",
+      "type": "object",
+      "properties": {
+        "arch": {
+          "type": "string"
+        },
+        "buildDate": {
+          "type": "string"
+        },
+        "os": {
+          "type": "string"
+        },
+        "revision": {
+          "type": "string"
+        },
+        "version": {
+          "type": "string"
+        }
+      }
+    }
+  },
+  "parameters": {
+    "filter": {
+      "type": "string",
+      "name": "filter",
+      "in": "query"
+    },
+    "id": {
+      "type": "string",
+      "description": "The id of the requested resource",
+      "name": "id",
+      "in": "path",
+      "required": true
+    },
+    "limit": {
+      "type": "integer",
+      "name": "limit",
+      "in": "query"
+    },
+    "offset": {
+      "type": "integer",
+      "name": "offset",
+      "in": "query"
+    }
+  },
+  "responses": {
+    "auditNotEnabledResponse": {
+      "description": "The audit log is not enabled on this controller",
+      "schema": {
+        "$ref": "#/definitions/apiErrorEnvelope"
+      }
+    },
+    "backupNotConfiguredResponse": {
+      "description": "Database backups are not configured on this controller",
+      "schema": {
+        "$ref": "#/definitions/apiErrorEnvelope"
+      }
+    },
+    "badRequestResponse": {
+      "description": "The supplied request contains invalid fields or could not be parsed (json and non-json bodies). The error's code, message, and cause fields can be inspected for further information",
+      "schema": {
+        "$ref": "#/definitions/apiErrorEnvelope"
+      },
+      "examples": {
+        "application/json": {
+          "error": {
+            "args": {
+              "urlVars": {}
+            },
+            "cause": {
+              "details": {
+                "context": "(root)",
+                "field": "(root)",
+                "property": "fooField3"
+              },
+              "field": "(root)",
+              "message": "(root): fooField3 is required",
+              "type": "required",
+              "value": {
+                "fooField": "abc",
+                "fooField2": "def"
+              }
+            },
+            "causeMessage": "schema validation failed",
+            "code": "COULD_NOT_VALIDATE",
+            "message": "The supplied request contains an invalid document",
+            "requestId": "ac6766d6-3a09-44b3-8d8a-1b541d97fdd9"
+          },
+          "meta": {
+            "apiEnrollmentVersion": "0.0.1",
+            "apiVersion": "0.0.1"
+          }
+        }
+      }
+    },
+    "cannotDeleteReferencedResourceResponse": {
+      "description": "The resource requested to be removed/altered cannot be as it is referenced by another object.",
+      "schema": {
+        "$ref": "#/definitions/apiErrorEnvelope"
+      },
+      "examples": {
+        "application/json": {
+          "error": {
+            "args": {
+              "urlVars": {
+                "id": "71a3000f-7dda-491a-9b90-a19f4ee6c406"
+              }
+            },
+            "causeMessage": "referenced by /some-resource/05f4f710-c155-4a74-86d5-77558eb9cb42",
+            "code": "CONFLICT_CANNOT_MODIFY_REFERENCED",
+            "message": "The resource cannot be deleted/modified. Remove all referencing resources first.",
+            "requestId": "270908d6-f2ef-4577-b973-67bec18ae376"
+          },
+          "meta": {
+            "apiEnrollmentVersion": "0.0.1",
+            "apiVersion": "0.0.1"
+          }
+        }
+      }
+    },
+    "circuitHistoryNotEnabledResponse": {
+      "description": "The circuit history is not enabled on this controller",
+      "schema": {
+        "$ref": "#/definitions/apiErrorEnvelope"
+      }
+    },
+    "clusterListMembersResponse": {
+      "description": "A response to a cluster list-members request",
+      "schema": {
+        "$ref": "#/definitions/clusterMemberListResponse"
+      }
+    },
+    "createResponse": {
+      "description": "The create request was successful and the resource has been added at the following location",
+      "schema": {
+        "$ref": "#/definitions/createEnvelope"
+      }
+    },
+    "dataIntegrityCheckResult": {
+      "description": "A list of data integrity issues found",
+      "schema": {
+        "$ref": "#/definitions/dataIntegrityCheckResultEnvelope"
+      }
+    },
+    "databaseBackupCreateResult": {
+      "description": "The backup which was taken",
+      "schema": {
+        "$ref": "#/definitions/databaseBackupCreateResultEnvelope"
+      }
+    },
+    "databaseBackupStatus": {
+      "description": "The status of scheduled database backups",
+      "schema": {
+        "$ref": "#/definitions/databaseBackupStatusEnvelope"
+      }
+    },
+    "databaseSnapshotCreateResult": {
+      "description": "The path to the created snapshot",
+      "schema": {
+        "$ref": "#/definitions/databaseSnapshotCreateResultEnvelope"
+      }
+    },
+    "deleteResponse": {
+      "description": "The delete request was successful and the resource has been removed",
+      "schema": {
+        "$ref": "#/definitions/empty"
+      }
+    },
+    "detailAlertSilence": {
+      "description": "A single alert silence",
+      "schema": {
+        "$ref": "#/definitions/alertSilenceEnvelope"
+      }
+    },
+    "detailBandwidthLimit": {
+      "description": "A single bandwidth limit",
+      "schema": {
+        "$ref": "#/definitions/detailBandwidthLimitEnvelope"
+      }
+    },
+    "detailBulkOperation": {
+      "description": "The progress of a bulk operation",
+      "schema": {
+        "$ref": "#/definitions/bulkOperationEnvelope"
+      }
+    },
+    "detailCircuit": {
+      "description": "A single circuit",
+      "schema": {
+        "$ref": "#/definitions/detailCircuitEnvelope"
+      }
+    },
+    "detailCircuitHistory": {
+      "description": "A single circuit from the circuit history",
+      "schema": {
+        "$ref": "#/definitions/detailCircuitHistoryEnvelope"
+      }
+    },
+    "detailCurrentIdentityPermissions": {
+      "description": "The permissions of the current identity",
+      "schema": {
+        "$ref": "#/definitions/currentIdentityPermissionsEnvelope"
+      }
+    },
+    "detailEnrollmentSigner": {
+      "description": "A single enrollment signer",
+      "schema": {
+        "$ref": "#/definitions/enrollmentSignerEnvelope"
+      }
+    },
+    "detailLink": {
+      "description": "A single link",
+      "schema": {
+        "$ref": "#/definitions/detailLinkEnvelope"
+      }
+    },
+    "detailProbe": {
+      "description": "A single synthetic probe",
+      "schema": {
+        "$ref": "#/definitions/probeEnvelope"
+      }
+    },
+    "detailQuota": {
+      "description": "A single quota",
+      "schema": {
+        "$ref": "#/definitions/detailQuotaEnvelope"
+      }
+    },
+    "detailRole": {
+      "description": "A single role",
+      "schema": {
+        "$ref": "#/definitions/detailRoleEnvelope"
+      }
+    },
+    "detailRouter": {
+      "description": "A single router",
+      "schema": {
+        "$ref": "#/definitions/detailRouterEnvelope"
+      }
+    },
+    "detailRouterCertRotation": {
+      "description": "The progress of a router certificate rotation",
+      "schema": {
+        "$ref": "#/definitions/routerCertRotationEnvelope"
+      }
+    },
+    "detailRouterDrain": {
+      "description": "A single router drain",
+      "schema": {
+        "$ref": "#/definitions/routerDrainEnvelope"
+      }
+    },
+    "detailService": {
+      "description": "A single service",
+      "schema": {
+        "$ref": "#/definitions/detailServiceEnvelope"
+      }
+    },
+    "detailTerminator": {
+      "description": "A single terminator",
+      "schema": {
+        "$ref": "#/definitions/detailTerminatorEnvelope"
+      }
+    },
+    "detailTopology": {
+      "description": "A snapshot of the network topology",
+      "schema": {
+        "$ref": "#/definitions/topologyEnvelope"
+      }
+    },
+    "emptyResponse": {
+      "description": "Base empty response",
+      "schema": {
+        "$ref": "#/definitions/empty"
+      }
+    },
+    "inspectResponse": {
+      "description": "A response to an inspect request",
+      "schema": {
+        "$ref": "#/definitions/inspectResponse"
+      }
+    },
+    "invalidAuthResponse": {
+      "description": "The authentication request could not be processed as the credentials are invalid",
+      "schema": {
+        "$ref": "#/definitions/apiErrorEnvelope"
+      },
+      "examples": {
+        "application/json": {
+          "error": {
+            "args": {
+              "urlVars": {}
+            },
+            "cause": "",
+            "causeMessage": "",
+            "code": "INVALID_AUTH",
+            "message": "The authentication request failed",
+            "requestId": "5952ed10-3091-474f-a691-47ebab6990dc"
+          },
+          "meta": {
+            "apiEnrollmentVersion": "0.0.1",
+            "apiVersion": "0.0.1"
+          }
+        }
+      }
+    },
+    "listAlertRules": {
+      "description": "A list of alert rules",
+      "schema": {
+        "$ref": "#/definitions/listAlertRulesEnvelope"
+      }
+    },
+    "listAlertSilences": {
+      "description": "A list of alert silences",
+      "schema": {
+        "$ref": "#/definitions/listAlertSilencesEnvelope"
+      }
+    },
+    "listAlerts": {
+      "description": "A list of active alerts",
+      "schema": {
+        "$ref": "#/definitions/listAlertsEnvelope"
+      }
+    },
+    "listAuditEntries": {
+      "description": "A list of audit log entries",
+      "schema": {
+        "$ref": "#/definitions/listAuditEntriesEnvelope"
+      }
+    },
+    "listBandwidthLimits": {
+      "description": "A list of bandwidth limits",
+      "schema": {
+        "$ref": "#/definitions/listBandwidthLimitsEnvelope"
+      }
+    },
+    "listBulkOperations": {
+      "description": "A list of bulk operations",
+      "schema": {
+        "$ref": "#/definitions/listBulkOperationsEnvelope"
+      }
+    },
+    "listCircuitHistory": {
+      "description": "A list of circuits from the circuit history",
+      "schema": {
+        "$ref": "#/definitions/listCircuitHistoryEnvelope"
+      }
+    },
+    "listCircuits": {
+      "description": "A list of circuits",
+      "schema": {
+        "$ref": "#/definitions/listCircuitsEnvelope"
+      }
+    },
+    "listEnrollmentSigners": {
+      "description": "A list of enrollment signers",
+      "schema": {
+        "$ref": "#/definitions/listEnrollmentSignersEnvelope"
+      }
+    },
+    "listLinks": {
+      "description": "A list of links",
+      "schema": {
+        "$ref": "#/definitions/listLinksEnvelope"
+      }
+    },
+    "listProbeResults": {
+      "description": "A list of probe results",
+      "schema": {
+        "$ref": "#/definitions/listProbeResultsEnvelope"
+      }
+    },
+    "listProbes": {
+      "description": "A list of synthetic probes",
+      "schema": {
+        "$ref": "#/definitions/listProbesEnvelope"
+      }
+    },
+    "listQuotaUsage": {
+      "description": "The current consumption of a quota",
+      "schema": {
+        "$ref": "#/definitions/listQuotaUsageEnvelope"
+      }
+    },
+    "listQuotas": {
+      "description": "A list of quotas",
+      "schema": {
+        "$ref": "#/definitions/listQuotasEnvelope"
+      }
+    },
+    "listRoles": {
+      "description": "A list of roles",
+      "schema": {
+        "$ref": "#/definitions/listRolesEnvelope"
+      }
+    },
+    "listRouterCertRotations": {
+      "description": "A list of router certificate rotations",
+      "schema": {
+        "$ref": "#/definitions/listRouterCertRotationsEnvelope"
+      }
+    },
+    "listRouterDrains": {
+      "description": "A list of router drains",
+      "schema": {
+        "$ref": "#/definitions/listRouterDrainsEnvelope"
+      }
+    },
+    "listRouters": {
+      "description": "A list of routers",
+      "schema": {
+        "$ref": "#/definitions/listRoutersEnvelope"
+      }
+    },
+    "listServices": {
+      "description": "A list of services",
+      "schema": {
+        "$ref": "#/definitions/listServicesEnvelope"
+      }
+    },
+    "listTerminators": {
+      "description": "A list of terminators",
+      "schema": {
+        "$ref": "#/definitions/listTerminatorsEnvelope"
+      }
+    },
+    "notFoundResponse": {
+      "description": "The requested resource does not exist",
+      "schema": {
+        "$ref": "#/definitions/apiErrorEnvelope"
+      },
+      "examples": {
+        "application/json": {
+          "error": {
+            "args": {
+              "urlVars": {
+                "id": "71a3000f-7dda-491a-9b90-a19f4ee6c406"
+              }
+            },
+            "cause": null,
+            "causeMessage": "",
+            "code": "NOT_FOUND",
+            "message": "The resource requested was not found or is no longer available",
+            "requestId": "270908d6-f2ef-4577-b973-67bec18ae376"
+          },
+          "meta": {
+            "apiEnrollmentVersion": "0.0.1",
+            "apiVersion": "0.0.1"
+          }
+        }
+      }
+    },
+    "patchResponse": {
+      "description": "The patch request was successful and the resource has been altered",
+      "schema": {
+        "$ref": "#/definitions/empty"
+      }
+    },
+    "policySimulationResult": {
+      "description": "The access changes the simulated changes would cause",
+      "schema": {
+        "$ref": "#/definitions/policySimulationResultEnvelope"
+      }
+    },
+    "rateLimitedResponse": {
+      "description": "The resource requested is rate limited and the rate limit has been exceeded",
+      "schema": {
+        "$ref": "#/definitions/apiErrorEnvelope"
+      },
+      "examples": {
+        "application/json": {
+          "error": {
+            "args": {
+              "urlVars": {}
+            },
+            "causeMessage": "you have hit a rate limit in the requested operation",
+            "code": "RATE_LIMITED",
+            "message": "The resource is rate limited and the rate limit has been exceeded. Please try again later",
+            "requestId": "270908d6-f2ef-4577-b973-67bec18ae376"
+          },
+          "meta": {
+            "apiEnrollmentVersion": "0.0.1",
+            "apiVersion": "0.0.1"
+          }
+        }
+      }
+    },
+    "reissueEnrollmentSigner": {
+      "description": "The certificates which were asked to be re-issued",
+      "schema": {
+        "$ref": "#/definitions/enrollmentSignerReissueEnvelope"
+      }
+    },
+    "serverUnavailableResponse": {
+      "description": "The request could not be completed due to the server being busy or in a temporarily bad state",
+      "schema": {
+        "$ref": "#/definitions/apiErrorEnvelope"
+      }
+    },
+    "unauthorizedResponse": {
+      "description": "The currently supplied session does not have the correct access rights to request this resource",
+      "schema": {
+        "$ref": "#/definitions/apiErrorEnvelope"
+      },
+      "examples": {
+        "application/json": {
+          "error": {
+            "args": {
+              "urlVars": {}
+            },
+            "cause": "",
+            "causeMessage": "",
+            "code": "UNAUTHORIZED",
+            "message": "The request could not be completed. The session is not authorized or the credentials are invalid",
+            "requestId": "0bfe7a04-9229-4b7a-812c-9eb3cc0eac0f"
+          },
+          "meta": {
+            "apiEnrollmentVersion": "0.0.1",
+            "apiVersion": "0.0.1"
+          }
+        }
+      }
+    },
+    "updateResponse": {
+      "description": "The update request was successful and the resource has been altered",
+      "schema": {
+        "$ref": "#/definitions/empty"
+      }
+    }
+  }
+}`))
+	FlatSwaggerJSON = json.RawMessage([]byte(`{
+  "consumes": [
+    "application/json"
+  ],
+  "produces": [
+    "application/json"
+  ],
+  "schemes": [
+    "https"
+  ],
+  "swagger": "2.0",
+  "info": {
+    "description": "OpenZiti Fabric API",
+    "title": "Ziti Fabric",
+    "contact": {
+      "name": "OpenZiti",
+      "url": "https://openziti.discourse.group",
+      "email": "help@openziti.org"
+    },
+    "license": {
+      "name": "Apache 2.0",
+      "url": "http://www.apache.org/licenses/LICENSE-2.0.html"
+    },
+    "version": "0.16.54"
+  },
+  "host": "demo.ziti.dev",
+  "basePath": "/fabric/v1",
+  "paths": {
+    "/alert-rules": {
+      "get": {
+        "description": "Retrieves the alert rules configured on this controller. Requires admin access.",
+        "tags": [
+          "Alert"
+        ],
+        "summary": "List alert rules",
+        "operationId": "listAlertRules",
+        "responses": {
+          "200": {
+            "description": "A list of alert rules",
+            "schema": {
+              "$ref": "#/definitions/listAlertRulesEnvelope"
+            }
+          },
+          "401": {
+            "description": "The currently supplied session does not have the correct access rights to request this resource",
+            "schema": {
+              "$ref": "#/definitions/apiErrorEnvelope"
+            },
+            "examples": {
+              "application/json": {
+                "error": {
+                  "args": {
+                    "urlVars": {}
+                  },
+                  "cause": "",
+                  "causeMessage": "",
+                  "code": "UNAUTHORIZED",
+                  "message": "The request could not be completed. The session is not authorized or the credentials are invalid",
+                  "requestId": "0bfe7a04-9229-4b7a-812c-9eb3cc0eac0f"
+                },
+                "meta": {
+                  "apiEnrollmentVersion": "0.0.1",
+                  "apiVersion": "0.0.1"
+                }
+              }
+            }
+          },
+          "429": {
+            "description": "The resource requested is rate limited and the rate limit has been exceeded",
+            "schema": {
+              "$ref": "#/definitions/apiErrorEnvelope"
+            },
+            "examples": {
+              "application/json": {
+                "error": {
+                  "args": {
+                    "urlVars": {}
+                  },
+                  "causeMessage": "you have hit a rate limit in the requested operation",
+                  "code": "RATE_LIMITED",
+                  "message": "The resource is rate limited and the rate limit has been exceeded. Please try again later",
+                  "requestId": "270908d6-f2ef-4577-b973-67bec18ae376"
+                },
+                "meta": {
+                  "apiEnrollmentVersion": "0.0.1",
+                  "apiVersion": "0.0.1"
+                }
+              }
+            }
+          }
+        }
+      }
+    },
+    "/alert-silences": {
+      "get": {
+        "description": "Retrieves the unexpired alert silences on this controller. Requires admin access.",
+        "tags": [
+          "Alert"
+        ],
+        "summary": "List alert silences",
+        "operationId": "listAlertSilences",
+        "responses": {
+          "200": {
+            "description": "A list of alert silences",
+            "schema": {
+              "$ref": "#/definitions/listAlertSilencesEnvelope"
+            }
+          },
+          "401": {
+            "description": "The currently supplied session does not have the correct access rights to request this resource",
+            "schema": {
+              "$ref": "#/definitions/apiErrorEnvelope"
+            },
+            "examples": {
+              "application/json": {
+                "error": {
+                  "args": {
+                    "urlVars": {}
+                  },
+                  "cause": "",
+                  "causeMessage": "",
+                  "code": "UNAUTHORIZED",
+                  "message": "The request could not be completed. The session is not authorized or the credentials are invalid",
+                  "requestId": "0bfe7a04-9229-4b7a-812c-9eb3cc0eac0f"
+                },
+                "meta": {
+                  "apiEnrollmentVersion": "0.0.1",
+                  "apiVersion": "0.0.1"
+                }
+              }
+            }
+          },
+          "429": {
+            "description": "The resource requested is rate limited and the rate limit has been exceeded",
+            "schema": {
+              "$ref": "#/definitions/apiErrorEnvelope"
+            },
+            "examples": {
+              "application/json": {
+                "error": {
+                  "args": {
+                    "urlVars": {}
+                  },
+                  "causeMessage": "you have hit a rate limit in the requested operation",
+                  "code": "RATE_LIMITED",
+                  "message": "The resource is rate limited and the rate limit has been exceeded. Please try again later",
+                  "requestId": "270908d6-f2ef-4577-b973-67bec18ae376"
+                },
+                "meta": {
+                  "apiEnrollmentVersion": "0.0.1",
+                  "apiVersion": "0.0.1"
+                }
+              }
+            }
+          }
+        }
+      },
+      "post": {
+        "description": "Silences alerts matching the given rule and/or entity until the silence expires. Silenced alerts are still\nreported as active, but don't emit alert events. Silences are kept in memory on the controller which accepted\nthe request. Requires admin access.\n",
+        "tags": [
+          "Alert"
+        ],
+        "summary": "Create an alert silence",
+        "operationId": "createAlertSilence",
+        "parameters": [
+          {
+            "description": "The alerts to silence and when the silence expires",
+            "name": "silence",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/alertSilenceCreate"
+            }
+          }
+        ],
+        "responses": {
+          "201": {
+            "description": "A single alert silence",
+            "schema": {
+              "$ref": "#/definitions/alertSilenceEnvelope"
+            }
+          },
+          "400": {
+            "description": "The supplied request contains invalid fields or could not be parsed (json and non-json bodies). The error's code, message, and cause fields can be inspected for further information",
+            "schema": {
+              "$ref": "#/definitions/apiErrorEnvelope"
+            },
+            "examples": {
+              "application/json": {
+                "error": {
+                  "args": {
+                    "urlVars": {}
+                  },
+                  "cause": {
+                    "details": {
+                      "context": "(root)",
+                      "field": "(root)",
+                      "property": "fooField3"
+                    },
+                    "field": "(root)",
+                    "message": "(root): fooField3 is required",
+                    "type": "required",
+                    "value": {
+                      "fooField": "abc",
+                      "fooField2": "def"
+                    }
+                  },
+                  "causeMessage": "schema validation failed",
+                  "code": "COULD_NOT_VALIDATE",
+                  "message": "The supplied request contains an invalid document",
+                  "requestId": "ac6766d6-3a09-44b3-8d8a-1b541d97fdd9"
+                },
+                "meta": {
+                  "apiEnrollmentVersion": "0.0.1",
+                  "apiVersion": "0.0.1"
+                }
+              }
+            }
+          },
+          "401": {
+            "description": "The currently supplied session does not have the correct access rights to request this resource",
+            "schema": {
+              "$ref": "#/definitions/apiErrorEnvelope"
+            },
+            "examples": {
+              "application/json": {
+                "error": {
+                  "args": {
+                    "urlVars": {}
+                  },
+                  "cause": "",
+                  "causeMessage": "",
+                  "code": "UNAUTHORIZED",
+                  "message": "The request could not be completed. The session is not authorized or the credentials are invalid",
+                  "requestId": "0bfe7a04-9229-4b7a-812c-9eb3cc0eac0f"
+                },
+                "meta": {
+                  "apiEnrollmentVersion": "0.0.1",
+                  "apiVersion": "0.0.1"
+                }
+              }
+            }
+          },
+          "429": {
+            "description": "The resource requested is rate limited and the rate limit has been exceeded",
+            "schema": {
+              "$ref": "#/definitions/apiErrorEnvelope"
+            },
+            "examples": {
+              "application/json": {
+                "error": {
+                  "args": {
+                    "urlVars": {}
+                  },
+                  "causeMessage": "you have hit a rate limit in the requested operation",
+                  "code": "RATE_LIMITED",
+                  "message": "The resource is rate limited and the rate limit has been exceeded. Please try again later",
+                  "requestId": "270908d6-f2ef-4577-b973-67bec18ae376"
+                },
+                "meta": {
+                  "apiEnrollmentVersion": "0.0.1",
+                  "apiVersion": "0.0.1"
+                }
+              }
+            }
+          }
+        }
+      }
+    },
+    "/alert-silences/{id}": {
+      "delete": {
+        "description": "Removes an alert silence. Firing alerts which it silenced will emit their alert events. Requires admin access.",
+        "tags": [
+          "Alert"
+        ],
+        "summary": "Delete an alert silence",
+        "operationId": "deleteAlertSilence",
+        "responses": {
+          "200": {
+            "description": "The delete request was successful and the resource has been removed",
+            "schema": {
+              "$ref": "#/definitions/empty"
+            }
+          },
+          "401": {
+            "description": "The currently supplied session does not have the correct access rights to request this resource",
+            "schema": {
+              "$ref": "#/definitions/apiErrorEnvelope"
+            },
+            "examples": {
+              "application/json": {
+                "error": {
+                  "args": {
+                    "urlVars": {}
+                  },
+                  "cause": "",
+                  "causeMessage": "",
+                  "code": "UNAUTHORIZED",
+                  "message": "The request could not be completed. The session is not authorized or the credentials are invalid",
+                  "requestId": "0bfe7a04-9229-4b7a-812c-9eb3cc0eac0f"
+                },
+                "meta": {
+                  "apiEnrollmentVersion": "0.0.1",
+                  "apiVersion": "0.0.1"
+                }
+              }
+            }
+          },
+          "404": {
+            "description": "The requested resource does not exist",
+            "schema": {
+              "$ref": "#/definitions/apiErrorEnvelope"
+            },
+            "examples": {
+              "application/json": {
+                "error": {
+                  "args": {
+                    "urlVars": {
+                      "id": "71a3000f-7dda-491a-9b90-a19f4ee6c406"
+                    }
+                  },
+                  "cause": null,
+                  "causeMessage": "",
+                  "code": "NOT_FOUND",
+                  "message": "The resource requested was not found or is no longer available",
+                  "requestId": "270908d6-f2ef-4577-b973-67bec18ae376"
+                },
+                "meta": {
+                  "apiEnrollmentVersion": "0.0.1",
+                  "apiVersion": "0.0.1"
+                }
+              }
+            }
+          },
+          "429": {
+            "description": "The resource requested is rate limited and the rate limit has been exceeded",
+            "schema": {
+              "$ref": "#/definitions/apiErrorEnvelope"
+            },
+            "examples": {
+              "application/json": {
+                "error": {
+                  "args": {
+                    "urlVars": {}
+                  },
+                  "causeMessage": "you have hit a rate limit in the requested operation",
+                  "code": "RATE_LIMITED",
+                  "message": "The resource is rate limited and the rate limit has been exceeded. Please try again later",
+                  "requestId": "270908d6-f2ef-4577-b973-67bec18ae376"
+                },
+                "meta": {
+                  "apiEnrollmentVersion": "0.0.1",
+                  "apiVersion": "0.0.1"
+                }
+              }
+            }
+          }
+        }
+      },
+      "parameters": [
+        {
+          "type": "string",
+          "description": "The id of the requested resource",
+          "name": "id",
+          "in": "path",
+          "required": true
+        }
+      ]
+    },
+    "/alerts": {
+      "get": {
+        "description": "Retrieves the pending and firing alerts from the alert rules evaluated by this controller. Each controller\nevaluates the alert rules independently. Requires admin access.\n",
+        "tags": [
+          "Alert"
+        ],
+        "summary": "List active alerts",
+        "operationId": "listAlerts",
+        "responses": {
+          "200": {
+            "description": "A list of active alerts",
+            "schema": {
+              "$ref": "#/definitions/listAlertsEnvelope"
+            }
+          },
+          "401": {
+            "description": "The currently supplied session does not have the correct access rights to request this resource",
+            "schema": {
+              "$ref": "#/definitions/apiErrorEnvelope"
+            },
+            "examples": {
+              "application/json": {
+                "error": {
+                  "args": {
+                    "urlVars": {}
+                  },
+                  "cause": "",
+                  "causeMessage": "",
+                  "code": "UNAUTHORIZED",
+                  "message": "The request could not be completed. The session is not authorized or the credentials are invalid",
+                  "requestId": "0bfe7a04-9229-4b7a-812c-9eb3cc0eac0f"
+                },
+                "meta": {
+                  "apiEnrollmentVersion": "0.0.1",
+                  "apiVersion": "0.0.1"
+                }
+              }
+            }
+          },
+          "429": {
+            "description": "The resource requested is rate limited and the rate limit has been exceeded",
+            "schema": {
+              "$ref": "#/definitions/apiErrorEnvelope"
+            },
+            "examples": {
+              "application/json": {
+                "error": {
+                  "args": {
+                    "urlVars": {}
+                  },
+                  "causeMessage": "you have hit a rate limit in the requested operation",
+                  "code": "RATE_LIMITED",
+                  "message": "The resource is rate limited and the rate limit has been exceeded. Please try again later",
+                  "requestId": "270908d6-f2ef-4577-b973-67bec18ae376"
+                },
+                "meta": {
+                  "apiEnrollmentVersion": "0.0.1",
+                  "apiVersion": "0.0.1"
+                }
+              }
+            }
+          }
+        }
+      }
+    },
+    "/audit": {
+      "get": {
+        "description": "Retrieves entity changes recorded in the audit log, most recent first. Requires the audit log to be enabled\nand admin access.\n",
+        "tags": [
+          "Audit"
+        ],
+        "summary": "List audit log entries",
+        "operationId": "listAuditEntries",
+        "parameters": [
+          {
+            "type": "string",
+            "name": "entityType",
+            "in": "query"
+          },
+          {
+            "type": "string",
+            "name": "entityId",
+            "in": "query"
+          },
+          {
+            "type": "string",
+            "name": "authorId",
+            "in": "query"
+          },
+          {
+            "type": "string",
+            "format": "date-time",
+            "name": "since",
+            "in": "query"
+          },
+          {
+            "type": "integer",
+            "name": "limit",
+            "in": "query"
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "A list of audit log entries",
+            "schema": {
+              "$ref": "#/definitions/listAuditEntriesEnvelope"
+            }
+          },
+          "401": {
+            "description": "The currently supplied session does not have the correct access rights to request this resource",
+            "schema": {
+              "$ref": "#/definitions/apiErrorEnvelope"
+            },
+            "examples": {
+              "application/json": {
+                "error": {
+                  "args": {
+                    "urlVars": {}
+                  },
+                  "cause": "",
+                  "causeMessage": "",
+                  "code": "UNAUTHORIZED",
+                  "message": "The request could not be completed. The session is not authorized or the credentials are invalid",
+                  "requestId": "0bfe7a04-9229-4b7a-812c-9eb3cc0eac0f"
+                },
+                "meta": {
+                  "apiEnrollmentVersion": "0.0.1",
+                  "apiVersion": "0.0.1"
+                }
+              }
+            }
+          },
+          "409": {
+            "description": "The audit log is not enabled on this controller",
+            "schema": {
+              "$ref": "#/definitions/apiErrorEnvelope"
+            }
+          },
+          "429": {
+            "description": "The resource requested is rate limited and the rate limit has been exceeded",
+            "schema": {
+              "$ref": "#/definitions/apiErrorEnvelope"
+            },
+            "examples": {
+              "application/json": {
+                "error": {
+                  "args": {
+                    "urlVars": {}
+                  },
+                  "causeMessage": "you have hit a rate limit in the requested operation",
+                  "code": "RATE_LIMITED",
+                  "message": "The resource is rate limited and the rate limit has been exceeded. Please try again later",
+                  "requestId": "270908d6-f2ef-4577-b973-67bec18ae376"
+                },
+                "meta": {
+                  "apiEnrollmentVersion": "0.0.1",
+                  "apiVersion": "0.0.1"
+                }
+              }
+            }
+          }
+        }
+      }
+    },
+    "/bandwidth-limits": {
+      "get": {
+        "description": "Retrieves a list of bandwidth limit resources; supports filtering, sorting, and pagination. Requires admin access.\n",
+        "tags": [
+          "Bandwidth Limit"
+        ],
+        "summary": "List bandwidth limits",
+        "operationId": "listBandwidthLimits",
+        "parameters": [
+          {
+            "type": "integer",
+            "name": "limit",
+            "in": "query"
+          },
+          {
+            "type": "integer",
+            "name": "offset",
+            "in": "query"
+          },
+          {
+            "type": "string",
+            "name": "filter",
+            "in": "query"
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "A list of bandwidth limits",
+            "schema": {
+              "$ref": "#/definitions/listBandwidthLimitsEnvelope"
+            }
+          },
+          "401": {
+            "description": "The currently supplied session does not have the correct access rights to request this resource",
+            "schema": {
+              "$ref": "#/definitions/apiErrorEnvelope"
+            },
+            "examples": {
+              "application/json": {
+                "error": {
+                  "args": {
+                    "urlVars": {}
+                  },
+                  "cause": "",
+                  "causeMessage": "",
+                  "code": "UNAUTHORIZED",
+                  "message": "The request could not be completed. The session is not authorized or the credentials are invalid",
+                  "requestId": "0bfe7a04-9229-4b7a-812c-9eb3cc0eac0f"
+                },
+                "meta": {
+                  "apiEnrollmentVersion": "0.0.1",
+                  "apiVersion": "0.0.1"
+                }
+              }
+            }
+          },
+          "429": {
+            "description": "The resource requested is rate limited and the rate limit has been exceeded",
+            "schema": {
+              "$ref": "#/definitions/apiErrorEnvelope"
+            },
+            "examples": {
+              "application/json": {
+                "error": {
+                  "args": {
+                    "urlVars": {}
+                  },
+                  "causeMessage": "you have hit a rate limit in the requested operation",
+                  "code": "RATE_LIMITED",
+                  "message": "The resource is rate limited and the rate limit has been exceeded. Please try again later",
+                  "requestId": "270908d6-f2ef-4577-b973-67bec18ae376"
+                },
+                "meta": {
+                  "apiEnrollmentVersion": "0.0.1",
+                  "apiVersion": "0.0.1"
+                }
+              }
+            }
+          }
+        }
+      },
+      "post": {
+        "description": "Create a bandwidth limit resource. Requires admin access.",
+        "tags": [
+          "Bandwidth Limit"
+        ],
+        "summary": "Create a bandwidth limit resource",
+        "operationId": "createBandwidthLimit",
+        "parameters": [
+          {
+            "description": "A bandwidth limit to create",
+            "name": "bandwidthLimit",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/bandwidthLimitCreate"
+            }
+          }
+        ],
+        "responses": {
+          "201": {
+            "description": "The create request was successful and the resource has been added at the following location",
+            "schema": {
+              "$ref": "#/definitions/createEnvelope"
+            }
+          },
+          "400": {
+            "description": "The supplied request contains invalid fields or could not be parsed (json and non-json bodies). The error's code, message, and cause fields can be inspected for further information",
+            "schema": {
+              "$ref": "#/definitions/apiErrorEnvelope"
+            },
+            "examples": {
+              "application/json": {
+                "error": {
+                  "args": {
+                    "urlVars": {}
+                  },
+                  "cause": {
+                    "details": {
+                      "context": "(root)",
+                      "field": "(root)",
+                      "property": "fooField3"
+                    },
+                    "field": "(root)",
+                    "message": "(root): fooField3 is required",
+                    "type": "required",
+                    "value": {
+                      "fooField": "abc",
+                      "fooField2": "def"
+                    }
+                  },
+                  "causeMessage": "schema validation failed",
+                  "code": "COULD_NOT_VALIDATE",
+                  "message": "The supplied request contains an invalid document",
+                  "requestId": "ac6766d6-3a09-44b3-8d8a-1b541d97fdd9"
+                },
+                "meta": {
+                  "apiEnrollmentVersion": "0.0.1",
+                  "apiVersion": "0.0.1"
+                }
+              }
+            }
+          },
+          "401": {
+            "description": "The currently supplied session does not have the correct access rights to request this resource",
+            "schema": {
+              "$ref": "#/definitions/apiErrorEnvelope"
+            },
+            "examples": {
+              "application/json": {
+                "error": {
+                  "args": {
+                    "urlVars": {}
+                  },
+                  "cause": "",
+                  "causeMessage": "",
+                  "code": "UNAUTHORIZED",
+                  "message": "The request could not be completed. The session is not authorized or the credentials are invalid",
+                  "requestId": "0bfe7a04-9229-4b7a-812c-9eb3cc0eac0f"
+                },
+                "meta": {
+                  "apiEnrollmentVersion": "0.0.1",
+                  "apiVersion": "0.0.1"
+                }
+              }
+            }
+          },
+          "429": {
+            "description": "The resource requested is rate limited and the rate limit has been exceeded",
+            "schema": {
+              "$ref": "#/definitions/apiErrorEnvelope"
+            },
+            "examples": {
+              "application/json": {
+                "error": {
+                  "args": {
+                    "urlVars": {}
+                  },
+                  "causeMessage": "you have hit a rate limit in the requested operation",
+                  "code": "RATE_LIMITED",
+                  "message": "The resource is rate limited and the rate limit has been exceeded. Please try again later",
+                  "requestId": "270908d6-f2ef-4577-b973-67bec18ae376"
+                },
+                "meta": {
+                  "apiEnrollmentVersion": "0.0.1",
+                  "apiVersion": "0.0.1"
+                }
+              }
+            }
+          },
+          "503": {
+            "description": "The request could not be completed due to the server being busy or in a temporarily bad state",
+            "schema": {
+              "$ref": "#/definitions/apiErrorEnvelope"
+            }
+          }
+        }
+      }
+    },
+    "/bandwidth-limits/{id}": {
+      "get": {
+        "description": "Retrieves a single bandwidth limit by id. Requires admin access.",
+        "tags": [
+          "Bandwidth Limit"
+        ],
+        "summary": "Retrieves a single bandwidth limit",
+        "operationId": "detailBandwidthLimit",
+        "responses": {
+          "200": {
+            "description": "A single bandwidth limit",
+            "schema": {
+              "$ref": "#/definitions/detailBandwidthLimitEnvelope"
+            }
+          },
+          "401": {
+            "description": "The currently supplied session does not have the correct access rights to request this resource",
+            "schema": {
+              "$ref": "#/definitions/apiErrorEnvelope"
+            },
+            "examples": {
+              "application/json": {
+                "error": {
+                  "args": {
+                    "urlVars": {}
+                  },
+                  "cause": "",
+                  "causeMessage": "",
+                  "code": "UNAUTHORIZED",
+                  "message": "The request could not be completed. The session is not authorized or the credentials are invalid",
+                  "requestId": "0bfe7a04-9229-4b7a-812c-9eb3cc0eac0f"
+                },
+                "meta": {
+                  "apiEnrollmentVersion": "0.0.1",
+                  "apiVersion": "0.0.1"
+                }
+              }
+            }
+          },
+          "404": {
+            "description": "The requested resource does not exist",
+            "schema": {
+              "$ref": "#/definitions/apiErrorEnvelope"
+            },
+            "examples": {
+              "application/json": {
+                "error": {
+                  "args": {
+                    "urlVars": {
+                      "id": "71a3000f-7dda-491a-9b90-a19f4ee6c406"
+                    }
+                  },
+                  "cause": null,
+                  "causeMessage": "",
+                  "code": "NOT_FOUND",
+                  "message": "The resource requested was not found or is no longer available",
+                  "requestId": "270908d6-f2ef-4577-b973-67bec18ae376"
+                },
+                "meta": {
+                  "apiEnrollmentVersion": "0.0.1",
+                  "apiVersion": "0.0.1"
+                }
+              }
+            }
+          },
+          "429": {
+            "description": "The resource requested is rate limited and the rate limit has been exceeded",
+            "schema": {
+              "$ref": "#/definitions/apiErrorEnvelope"
+            },
+            "examples": {
+              "application/json": {
+                "error": {
+                  "args": {
+                    "urlVars": {}
+                  },
+                  "causeMessage": "you have hit a rate limit in the requested operation",
+                  "code": "RATE_LIMITED",
+                  "message": "The resource is rate limited and the rate limit has been exceeded. Please try again later",
+                  "requestId": "270908d6-f2ef-4577-b973-67bec18ae376"
+                },
+                "meta": {
+                  "apiEnrollmentVersion": "0.0.1",
+                  "apiVersion": "0.0.1"
+                }
+              }
+            }
+          }
+        }
+      },
+      "put": {
+        "description": "Update all fields on a bandwidth limit by id. Requires admin access.",
+        "tags": [
+          "Bandwidth Limit"
+        ],
+        "summary": "Update all fields on a bandwidth limit",
+        "operationId": "updateBandwidthLimit",
+        "parameters": [
+          {
+            "description": "A bandwidth limit update object",
+            "name": "bandwidthLimit",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/bandwidthLimitUpdate"
+            }
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "The update request was successful and the resource has been altered",
+            "schema": {
+              "$ref": "#/definitions/empty"
+            }
+          },
+          "400": {
+            "description": "The supplied request contains invalid fields or could not be parsed (json and non-json bodies). The error's code, message, and cause fields can be inspected for further information",
+            "schema": {
+              "$ref": "#/definitions/apiErrorEnvelope"
+            },
+            "examples": {
+              "application/json": {
+                "error": {
+                  "args": {
+                    "urlVars": {}
+                  },
+                  "cause": {
+                    "details": {
+                      "context": "(root)",
+                      "field": "(root)",
+                      "property": "fooField3"
+                    },
+                    "field": "(root)",
+                    "message": "(root): fooField3 is required",
+                    "type": "required",
+                    "value": {
+                      "fooField": "abc",
+                      "fooField2": "def"
+                    }
+                  },
+                  "causeMessage": "schema validation failed",
+                  "code": "COULD_NOT_VALIDATE",
+                  "message": "The supplied request contains an invalid document",
+                  "requestId": "ac6766d6-3a09-44b3-8d8a-1b541d97fdd9"
+                },
+                "meta": {
+                  "apiEnrollmentVersion": "0.0.1",
+                  "apiVersion": "0.0.1"
+                }
+              }
+            }
+          },
+          "401": {
+            "description": "The currently supplied session does not have the correct access rights to request this resource",
+            "schema": {
+              "$ref": "#/definitions/apiErrorEnvelope"
+            },
+            "examples": {
+              "application/json": {
+                "error": {
+                  "args": {
+                    "urlVars": {}
+                  },
+                  "cause": "",
+                  "causeMessage": "",
+                  "code": "UNAUTHORIZED",
+                  "message": "The request could not be completed. The session is not authorized or the credentials are invalid",
+                  "requestId": "0bfe7a04-9229-4b7a-812c-9eb3cc0eac0f"
+                },
+                "meta": {
+                  "apiEnrollmentVersion": "0.0.1",
+                  "apiVersion": "0.0.1"
+                }
+              }
+            }
+          },
+          "404": {
+            "description": "The requested resource does not exist",
+            "schema": {
+              "$ref": "#/definitions/apiErrorEnvelope"
+            },
+            "examples": {
+              "application/json": {
+                "error": {
+                  "args": {
+                    "urlVars": {
+                      "id": "71a3000f-7dda-491a-9b90-a19f4ee6c406"
+                    }
+                  },
+                  "cause": null,
+                  "causeMessage": "",
+                  "code": "NOT_FOUND",
+                  "message": "The resource requested was not found or is no longer available",
+                  "requestId": "270908d6-f2ef-4577-b973-67bec18ae376"
+                },
+                "meta": {
+                  "apiEnrollmentVersion": "0.0.1",
+                  "apiVersion": "0.0.1"
+                }
+              }
+            }
+          },
+          "429": {
+            "description": "The resource requested is rate limited and the rate limit has been exceeded",
+            "schema": {
+              "$ref": "#/definitions/apiErrorEnvelope"
+            },
+            "examples": {
+              "application/json": {
+                "error": {
+                  "args": {
+                    "urlVars": {}
+                  },
+                  "causeMessage": "you have hit a rate limit in the requested operation",
+                  "code": "RATE_LIMITED",
+                  "message": "The resource is rate limited and the rate limit has been exceeded. Please try again later",
+                  "requestId": "270908d6-f2ef-4577-b973-67bec18ae376"
+                },
+                "meta": {
+                  "apiEnrollmentVersion": "0.0.1",
+                  "apiVersion": "0.0.1"
+                }
+              }
+            }
+          },
+          "503": {
+            "description": "The request could not be completed due to the server being busy or in a temporarily bad state",
+            "schema": {
+              "$ref": "#/definitions/apiErrorEnvelope"
+            }
+          }
+        }
+      },
+      "delete": {
+        "description": "Delete a bandwidth limit by id. Requires admin access.",
+        "tags": [
+          "Bandwidth Limit"
+        ],
+        "summary": "Delete a bandwidth limit",
+        "operationId": "deleteBandwidthLimit",
+        "responses": {
+          "200": {
+            "description": "The delete request was successful and the resource has been removed",
+            "schema": {
+              "$ref": "#/definitions/empty"
+            }
+          },
+          "400": {
+            "description": "The supplied request contains invalid fields or could not be parsed (json and non-json bodies). The error's code, message, and cause fields can be inspected for further information",
+            "schema": {
+              "$ref": "#/definitions/apiErrorEnvelope"
+            },
+            "examples": {
+              "application/json": {
+                "error": {
+                  "args": {
+                    "urlVars": {}
+                  },
+                  "cause": {
+                    "details": {
+                      "context": "(root)",
+                      "field": "(root)",
+                      "property": "fooField3"
+                    },
+                    "field": "(root)",
+                    "message": "(root): fooField3 is required",
+                    "type": "required",
+                    "value": {
+                      "fooField": "abc",
+                      "fooField2": "def"
+                    }
+                  },
+                  "causeMessage": "schema validation failed",
+                  "code": "COULD_NOT_VALIDATE",
+                  "message": "The supplied request contains an invalid document",
+                  "requestId": "ac6766d6-3a09-44b3-8d8a-1b541d97fdd9"
+                },
+                "meta": {
+                  "apiEnrollmentVersion": "0.0.1",
+                  "apiVersion": "0.0.1"
+                }
+              }
+            }
+          },
+          "401": {
+            "description": "The currently supplied session does not have the correct access rights to request this resource",
+            "schema": {
+              "$ref": "#/definitions/apiErrorEnvelope"
+            },
+            "examples": {
+              "application/json": {
+                "error": {
+                  "args": {
+                    "urlVars": {}
+                  },
+                  "cause": "",
+                  "causeMessage": "",
+                  "code": "UNAUTHORIZED",
+                  "message": "The request could not be completed. The session is not authorized or the credentials are invalid",
+                  "requestId": "0bfe7a04-9229-4b7a-812c-9eb3cc0eac0f"
+                },
+                "meta": {
+                  "apiEnrollmentVersion": "0.0.1",
+                  "apiVersion": "0.0.1"
+                }
+              }
+            }
+          },
+          "429": {
+            "description": "The resource requested is rate limited and the rate limit has been exceeded",
+            "schema": {
+              "$ref": "#/definitions/apiErrorEnvelope"
+            },
+            "examples": {
+              "application/json": {
+                "error": {
+                  "args": {
+                    "urlVars": {}
+                  },
+                  "causeMessage": "you have hit a rate limit in the requested operation",
+                  "code": "RATE_LIMITED",
+                  "message": "The resource is rate limited and the rate limit has been exceeded. Please try again later",
+                  "requestId": "270908d6-f2ef-4577-b973-67bec18ae376"
+                },
+                "meta": {
+                  "apiEnrollmentVersion": "0.0.1",
+                  "apiVersion": "0.0.1"
+                }
+              }
+            }
+          },
+          "503": {
+            "description": "The request could not be completed due to the server being busy or in a temporarily bad state",
+            "schema": {
+              "$ref": "#/definitions/apiErrorEnvelope"
+            }
+          }
+        }
+      },
+      "patch": {
+        "description": "Update the supplied fields on a bandwidth limit. Requires admin access.",
+        "tags": [
+          "Bandwidth Limit"
+        ],
+        "summary": "Update the supplied fields on a bandwidth limit",
+        "operationId": "patchBandwidthLimit",
+        "parameters": [
+          {
+            "description": "A bandwidth limit patch object",
+            "name": "bandwidthLimit",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/bandwidthLimitPatch"
+            }
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "The patch request was successful and the resource has been altered",
+            "schema": {
+              "$ref": "#/definitions/empty"
+            }
+          },
+          "400": {
+            "description": "The supplied request contains invalid fields or could not be parsed (json and non-json bodies). The error's code, message, and cause fields can be inspected for further information",
+            "schema": {
+              "$ref": "#/definitions/apiErrorEnvelope"
+            },
+            "examples": {
+              "application/json": {
+                "error": {
+                  "args": {
+                    "urlVars": {}
+                  },
+                  "cause": {
+                    "details": {
+                      "context": "(root)",
+                      "field": "(root)",
+                      "property": "fooField3"
+                    },
+                    "field": "(root)",
+                    "message": "(root): fooField3 is required",
+                    "type": "required",
+                    "value": {
+                      "fooField": "abc",
+                      "fooField2": "def"
+                    }
+                  },
+                  "causeMessage": "schema validation failed",
+                  "code": "COULD_NOT_VALIDATE",
+                  "message": "The supplied request contains an invalid document",
+                  "requestId": "ac6766d6-3a09-44b3-8d8a-1b541d97fdd9"
+                },
+                "meta": {
+                  "apiEnrollmentVersion": "0.0.1",
+                  "apiVersion": "0.0.1"
+                }
+              }
+            }
+          },
+          "401": {
+            "description": "The currently supplied session does not have the correct access rights to request this resource",
+            "schema": {
+              "$ref": "#/definitions/apiErrorEnvelope"
+            },
+            "examples": {
+              "application/json": {
+                "error": {
+                  "args": {
+                    "urlVars": {}
+                  },
+                  "cause": "",
+                  "causeMessage": "",
+                  "code": "UNAUTHORIZED",
+                  "message": "The request could not be completed. The session is not authorized or the credentials are invalid",
+                  "requestId": "0bfe7a04-9229-4b7a-812c-9eb3cc0eac0f"
+                },
+                "meta": {
+                  "apiEnrollmentVersion": "0.0.1",
+                  "apiVersion": "0.0.1"
+                }
+              }
+            }
+          },
+          "404": {
+            "description": "The requested resource does not exist",
+            "schema": {
+              "$ref": "#/definitions/apiErrorEnvelope"
+            },
+            "examples": {
+              "application/json": {
+                "error": {
+                  "args": {
+                    "urlVars": {
+                      "id": "71a3000f-7dda-491a-9b90-a19f4ee6c406"
+                    }
+                  },
+                  "cause": null,
+                  "causeMessage": "",
+                  "code": "NOT_FOUND",
+                  "message": "The resource requested was not found or is no longer available",
+                  "requestId": "270908d6-f2ef-4577-b973-67bec18ae376"
+                },
+                "meta": {
+                  "apiEnrollmentVersion": "0.0.1",
+                  "apiVersion": "0.0.1"
+                }
+              }
+            }
+          },
+          "429": {
+            "description": "The resource requested is rate limited and the rate limit has been exceeded",
+            "schema": {
+              "$ref": "#/definitions/apiErrorEnvelope"
+            },
+            "examples": {
+              "application/json": {
+                "error": {
+                  "args": {
+                    "urlVars": {}
+                  },
+                  "causeMessage": "you have hit a rate limit in the requested operation",
+                  "code": "RATE_LIMITED",
+                  "message": "The resource is rate limited and the rate limit has been exceeded. Please try again later",
+                  "requestId": "270908d6-f2ef-4577-b973-67bec18ae376"
+                },
+                "meta": {
+                  "apiEnrollmentVersion": "0.0.1",
+                  "apiVersion": "0.0.1"
+                }
+              }
+            }
+          },
+          "503": {
+            "description": "The request could not be completed due to the server being busy or in a temporarily bad state",
+            "schema": {
+              "$ref": "#/definitions/apiErrorEnvelope"
+            }
+          }
+        }
+      },
+      "parameters": [
+        {
+          "type": "string",
+          "description": "The id of the requested resource",
+          "name": "id",
+          "in": "path",
+          "required": true
+        }
+      ]
+    },
+    "/bulk-operations": {
+      "get": {
+        "description": "Retrieves the bulk operations started on this controller, most recent first. Only the most recent operations\nare retained. Requires admin access.\n",
+        "tags": [
+          "Bulk Operation"
+        ],
+        "summary": "List bulk operations",
+        "operationId": "listBulkOperations",
+        "responses": {
+          "200": {
+            "description": "A list of bulk operations",
+            "schema": {
+              "$ref": "#/definitions/listBulkOperationsEnvelope"
+            }
+          },
+          "401": {
+            "description": "The currently supplied session does not have the correct access rights to request this resource",
+            "schema": {
+              "$ref": "#/definitions/apiErrorEnvelope"
+            },
+            "examples": {
+              "application/json": {
+                "error": {
+                  "args": {
+                    "urlVars": {}
+                  },
+                  "cause": "",
+                  "causeMessage": "",
+                  "code": "UNAUTHORIZED",
+                  "message": "The request could not be completed. The session is not authorized or the credentials are invalid",
+                  "requestId": "0bfe7a04-9229-4b7a-812c-9eb3cc0eac0f"
+                },
+                "meta": {
+                  "apiEnrollmentVersion": "0.0.1",
+                  "apiVersion": "0.0.1"
+                }
+              }
+            }
+          },
+          "429": {
+            "description": "The resource requested is rate limited and the rate limit has been exceeded",
+            "schema": {
+              "$ref": "#/definitions/apiErrorEnvelope"
+            },
+            "examples": {
+              "application/json": {
+                "error": {
+                  "args": {
+                    "urlVars": {}
+                  },
+                  "causeMessage": "you have hit a rate limit in the requested operation",
+                  "code": "RATE_LIMITED",
+                  "message": "The resource is rate limited and the rate limit has been exceeded. Please try again later",
+                  "requestId": "270908d6-f2ef-4577-b973-67bec18ae376"
+                },
+                "meta": {
+                  "apiEnrollmentVersion": "0.0.1",
+                  "apiVersion": "0.0.1"
+                }
+              }
+            }
+          }
+        }
+      },
+      "post": {
+        "description": "Applies an operation to the identities selected by id or by filter. The operation runs in the background,\nupdating identities in batches. Its progress can be retrieved using the returned id. Requires admin access.\n",
+        "tags": [
+          "Bulk Operation"
+        ],
+        "summary": "Start a bulk identity operation",
+        "operationId": "createBulkOperation",
+        "parameters": [
+          {
+            "description": "The operation to apply and the identities to apply it to",
+            "name": "operation",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/bulkOperationCreate"
+            }
+          }
+        ],
+        "responses": {
+          "202": {
+            "description": "The progress of a bulk operation",
+            "schema": {
+              "$ref": "#/definitions/bulkOperationEnvelope"
+            }
+          },
+          "400": {
+            "description": "The supplied request contains invalid fields or could not be parsed (json and non-json bodies). The error's code, message, and cause fields can be inspected for further information",
+            "schema": {
+              "$ref": "#/definitions/apiErrorEnvelope"
+            },
+            "examples": {
+              "application/json": {
+                "error": {
+                  "args": {
+                    "urlVars": {}
+                  },
+                  "cause": {
+                    "details": {
+                      "context": "(root)",
+                      "field": "(root)",
+                      "property": "fooField3"
+                    },
+                    "field": "(root)",
+                    "message": "(root): fooField3 is required",
+                    "type": "required",
+                    "value": {
+                      "fooField": "abc",
+                      "fooField2": "def"
+                    }
+                  },
+                  "causeMessage": "schema validation failed",
+                  "code": "COULD_NOT_VALIDATE",
+                  "message": "The supplied request contains an invalid document",
+                  "requestId": "ac6766d6-3a09-44b3-8d8a-1b541d97fdd9"
+                },
+                "meta": {
+                  "apiEnrollmentVersion": "0.0.1",
+                  "apiVersion": "0.0.1"
+                }
+              }
+            }
+          },
+          "401": {
+            "description": "The currently supplied session does not have the correct access rights to request this resource",
+            "schema": {
+              "$ref": "#/definitions/apiErrorEnvelope"
+            },
+            "examples": {
+              "application/json": {
+                "error": {
+                  "args": {
+                    "urlVars": {}
+                  },
+                  "cause": "",
+                  "causeMessage": "",
+                  "code": "UNAUTHORIZED",
+                  "message": "The request could not be completed. The session is not authorized or the credentials are invalid",
+                  "requestId": "0bfe7a04-9229-4b7a-812c-9eb3cc0eac0f"
+                },
+                "meta": {
+                  "apiEnrollmentVersion": "0.0.1",
+                  "apiVersion": "0.0.1"
+                }
+              }
+            }
+          },
+          "429": {
+            "description": "The resource requested is rate limited and the rate limit has been exceeded",
+            "schema": {
+              "$ref": "#/definitions/apiErrorEnvelope"
+            },
+            "examples": {
+              "application/json": {
+                "error": {
+                  "args": {
+                    "urlVars": {}
+                  },
+                  "causeMessage": "you have hit a rate limit in the requested operation",
+                  "code": "RATE_LIMITED",
+                  "message": "The resource is rate limited and the rate limit has been exceeded. Please try again later",
+                  "requestId": "270908d6-f2ef-4577-b973-67bec18ae376"
+                },
+                "meta": {
+                  "apiEnrollmentVersion": "0.0.1",
+                  "apiVersion": "0.0.1"
+                }
+              }
+            }
+          }
+        }
+      }
+    },
+    "/bulk-operations/{id}": {
+      "get": {
+        "description": "Retrieves the progress of a bulk operation, including any failures. Requires admin access.",
+        "tags": [
+          "Bulk Operation"
+        ],
+        "summary": "Retrieves the progress of a bulk operation",
+        "operationId": "detailBulkOperation",
+        "responses": {
+          "200": {
+            "description": "The progress of a bulk operation",
+            "schema": {
+              "$ref": "#/definitions/bulkOperationEnvelope"
+            }
+          },
+          "401": {
+            "description": "The currently supplied session does not have the correct access rights to request this resource",
+            "schema": {
+              "$ref": "#/definitions/apiErrorEnvelope"
+            },
+            "examples": {
+              "application/json": {
+                "error": {
+                  "args": {
+                    "urlVars": {}
+                  },
+                  "cause": "",
+                  "causeMessage": "",
+                  "code": "UNAUTHORIZED",
+                  "message": "The request could not be completed. The session is not authorized or the credentials are invalid",
+                  "requestId": "0bfe7a04-9229-4b7a-812c-9eb3cc0eac0f"
+                },
+                "meta": {
+                  "apiEnrollmentVersion": "0.0.1",
+                  "apiVersion": "0.0.1"
+                }
+              }
+            }
+          },
+          "404": {
+            "description": "The requested resource does not exist",
+            "schema": {
+              "$ref": "#/definitions/apiErrorEnvelope"
+            },
+            "examples": {
+              "application/json": {
+                "error": {
+                  "args": {
+                    "urlVars": {
+                      "id": "71a3000f-7dda-491a-9b90-a19f4ee6c406"
+                    }
+                  },
+                  "cause": null,
+                  "causeMessage": "",
+                  "code": "NOT_FOUND",
+                  "message": "The resource requested was not found or is no longer available",
+                  "requestId": "270908d6-f2ef-4577-b973-67bec18ae376"
+                },
+                "meta": {
+                  "apiEnrollmentVersion": "0.0.1",
+                  "apiVersion": "0.0.1"
+                }
+              }
+            }
+          },
+          "429": {
+            "description": "The resource requested is rate limited and the rate limit has been exceeded",
+            "schema": {
+              "$ref": "#/definitions/apiErrorEnvelope"
+            },
+            "examples": {
+              "application/json": {
+                "error": {
+                  "args": {
+                    "urlVars": {}
+                  },
+                  "causeMessage": "you have hit a rate limit in the requested operation",
+                  "code": "RATE_LIMITED",
+                  "message": "The resource is rate limited and the rate limit has been exceeded. Please try again later",
+                  "requestId": "270908d6-f2ef-4577-b973-67bec18ae376"
+                },
+                "meta": {
+                  "apiEnrollmentVersion": "0.0.1",
+                  "apiVersion": "0.0.1"
+                }
+              }
+            }
+          }
+        }
+      },
+      "parameters": [
+        {
+          "type": "string",
+          "description": "The id of the requested resource",
+          "name": "id",
+          "in": "path",
+          "required": true
+        }
+      ]
+    },
+    "/circuit-history": {
+      "get": {
+        "description": "Retrieves circuits from this controller's circuit history, newest first, including circuits which failed to\nbe created. Since and until select circuits which were active at some point in the given range. Requires the\ncircuit history to be enabled and admin access.\n",
+        "tags": [
+          "Circuit History"
+        ],
+        "summary": "List circuit history",
+        "operationId": "listCircuitHistory",
+        "parameters": [
+          {
+            "type": "string",
+            "name": "identityId",
+            "in": "query"
+          },
+          {
+            "type": "string",
+            "name": "serviceId",
+            "in": "query"
+          },
+          {
+            "type": "string",
+            "name": "routerId",
+            "in": "query"
+          },
+          {
+            "type": "string",
+            "name": "linkId",
+            "in": "query"
+          },
+          {
+            "type": "string",
+            "name": "terminatorId",
+            "in": "query"
+          },
+          {
+            "type": "string",
+            "name": "hostId",
+            "in": "query"
+          },
+          {
+            "enum": [
+              "active",
+              "ended",
+              "failed"
+            ],
+            "type": "string",
+            "name": "state",
+            "in": "query"
+          },
+          {
+            "type": "string",
+            "name": "failureCause",
+            "in": "query"
+          },
+          {
+            "type": "string",
+            "format": "date-time",
+            "name": "since",
+            "in": "query"
+          },
+          {
+            "type": "string",
+            "format": "date-time",
+            "name": "until",
+            "in": "query"
+          },
+          {
+            "type": "integer",
+            "name": "limit",
+            "in": "query"
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "A list of circuits from the circuit history",
+            "schema": {
+              "$ref": "#/definitions/listCircuitHistoryEnvelope"
+            }
+          },
+          "401": {
+            "description": "The currently supplied session does not have the correct access rights to request this resource",
+            "schema": {
+              "$ref": "#/definitions/apiErrorEnvelope"
+            },
+            "examples": {
+              "application/json": {
+                "error": {
+                  "args": {
+                    "urlVars": {}
+                  },
+                  "cause": "",
+                  "causeMessage": "",
+                  "code": "UNAUTHORIZED",
+                  "message": "The request could not be completed. The session is not authorized or the credentials are invalid",
+                  "requestId": "0bfe7a04-9229-4b7a-812c-9eb3cc0eac0f"
+                },
+                "meta": {
+                  "apiEnrollmentVersion": "0.0.1",
+                  "apiVersion": "0.0.1"
+                }
+              }
+            }
+          },
+          "409": {
+            "description": "The circuit history is not enabled on this controller",
+            "schema": {
+              "$ref": "#/definitions/apiErrorEnvelope"
+            }
+          },
+          "429": {
+            "description": "The resource requested is rate limited and the rate limit has been exceeded",
+            "schema": {
+              "$ref": "#/definitions/apiErrorEnvelope"
+            },
+            "examples": {
+              "application/json": {
+                "error": {
+                  "args": {
+                    "urlVars": {}
+                  },
+                  "causeMessage": "you have hit a rate limit in the requested operation",
+                  "code": "RATE_LIMITED",
+                  "message": "The resource is rate limited and the rate limit has been exceeded. Please try again later",
+                  "requestId": "270908d6-f2ef-4577-b973-67bec18ae376"
+                },
+                "meta": {
+                  "apiEnrollmentVersion": "0.0.1",
+                  "apiVersion": "0.0.1"
+                }
+              }
+            }
+          }
+        }
+      }
+    },
+    "/circuit-history/{id}": {
+      "get": {
+        "description": "Retrieves a single circuit from this controller's circuit history. Requires the circuit history to be enabled and admin access.",
+        "tags": [
+          "Circuit History"
+        ],
+        "summary": "Retrieve a circuit from the circuit history",
+        "operationId": "detailCircuitHistory",
+        "responses": {
+          "200": {
+            "description": "A single circuit from the circuit history",
+            "schema": {
+              "$ref": "#/definitions/detailCircuitHistoryEnvelope"
+            }
+          },
+          "401": {
+            "description": "The currently supplied session does not have the correct access rights to request this resource",
+            "schema": {
+              "$ref": "#/definitions/apiErrorEnvelope"
+            },
+            "examples": {
+              "application/json": {
+                "error": {
+                  "args": {
+                    "urlVars": {}
+                  },
+                  "cause": "",
+                  "causeMessage": "",
+                  "code": "UNAUTHORIZED",
+                  "message": "The request could not be completed. The session is not authorized or the credentials are invalid",
+                  "requestId": "0bfe7a04-9229-4b7a-812c-9eb3cc0eac0f"
+                },
+                "meta": {
+                  "apiEnrollmentVersion": "0.0.1",
+                  "apiVersion": "0.0.1"
+                }
+              }
+            }
+          },
+          "404": {
+            "description": "The requested resource does not exist",
+            "schema": {
+              "$ref": "#/definitions/apiErrorEnvelope"
+            },
+            "examples": {
+              "application/json": {
+                "error": {
+                  "args": {
+                    "urlVars": {
+                      "id": "71a3000f-7dda-491a-9b90-a19f4ee6c406"
+                    }
+                  },
+                  "cause": null,
+                  "causeMessage": "",
+                  "code": "NOT_FOUND",
+                  "message": "The resource requested was not found or is no longer available",
+                  "requestId": "270908d6-f2ef-4577-b973-67bec18ae376"
+                },
+                "meta": {
+                  "apiEnrollmentVersion": "0.0.1",
+                  "apiVersion": "0.0.1"
+                }
+              }
+            }
+          },
+          "409": {
+            "description": "The circuit history is not enabled on this controller",
+            "schema": {
+              "$ref": "#/definitions/apiErrorEnvelope"
+            }
+          },
+          "429": {
+            "description": "The resource requested is rate limited and the rate limit has been exceeded",
+            "schema": {
+              "$ref": "#/definitions/apiErrorEnvelope"
+            },
+            "examples": {
+              "application/json": {
+                "error": {
+                  "args": {
+                    "urlVars": {}
+                  },
+                  "causeMessage": "you have hit a rate limit in the requested operation",
+                  "code": "RATE_LIMITED",
+                  "message": "The resource is rate limited and the rate limit has been exceeded. Please try again later",
+                  "requestId": "270908d6-f2ef-4577-b973-67bec18ae376"
+                },
+                "meta": {
+                  "apiEnrollmentVersion": "0.0.1",
+                  "apiVersion": "0.0.1"
+                }
+              }
+            }
+          }
+        }
+      },
+      "parameters": [
+        {
+          "type": "string",
+          "description": "The id of the requested resource",
+          "name": "id",
+          "in": "path",
+          "required": true
+        }
+      ]
+    },
+    "/circuits": {
+      "get": {
+        "description": "Retrieves a list of circuit resources; does not supports filtering, sorting, or pagination. Requires admin access.\n",
+        "tags": [
+          "Circuit"
+        ],
+        "summary": "List circuits",
+        "operationId": "listCircuits",
+        "parameters": [
+          {
+            "type": "integer",
+            "name": "limit",
+            "in": "query"
+          },
+          {
+            "type": "integer",
+            "name": "offset",
+            "in": "query"
+          },
+          {
+            "type": "string",
+            "name": "filter",
+            "in": "query"
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "A list of circuits",
+            "schema": {
+              "$ref": "#/definitions/listCircuitsEnvelope"
+            }
+          },
+          "401": {
+            "description": "The currently supplied session does not have the correct access rights to request this resource",
+            "schema": {
+              "$ref": "#/definitions/apiErrorEnvelope"
+            },
+            "examples": {
+              "application/json": {
+                "error": {
+                  "args": {
+                    "urlVars": {}
+                  },
+                  "cause": "",
+                  "causeMessage": "",
+                  "code": "UNAUTHORIZED",
+                  "message": "The request could not be completed. The session is not authorized or the credentials are invalid",
+                  "requestId": "0bfe7a04-9229-4b7a-812c-9eb3cc0eac0f"
+                },
+                "meta": {
+                  "apiEnrollmentVersion": "0.0.1",
+                  "apiVersion": "0.0.1"
+                }
+              }
+            }
+          },
+          "429": {
+            "description": "The resource requested is rate limited and the rate limit has been exceeded",
+            "schema": {
+              "$ref": "#/definitions/apiErrorEnvelope"
+            },
+            "examples": {
+              "application/json": {
+                "error": {
+                  "args": {
+                    "urlVars": {}
+                  },
+                  "causeMessage": "you have hit a rate limit in the requested operation",
+                  "code": "RATE_LIMITED",
+                  "message": "The resource is rate limited and the rate limit has been exceeded. Please try again later",
+                  "requestId": "270908d6-f2ef-4577-b973-67bec18ae376"
+                },
+                "meta": {
+                  "apiEnrollmentVersion": "0.0.1",
+                  "apiVersion": "0.0.1"
+                }
+              }
+            }
+          }
+        }
+      }
+    },
+    "/circuits/{id}": {
+      "get": {
+        "description": "Retrieves a single circuit by id. Requires admin access.",
+        "tags": [
+          "Circuit"
+        ],
+        "summary": "Retrieves a single circuit",
+        "operationId": "detailCircuit",
+        "responses": {
+          "200": {
+            "description": "A single circuit",
+            "schema": {
+              "$ref": "#/definitions/detailCircuitEnvelope"
+            }
+          },
+          "401": {
+            "description": "The currently supplied session does not have the correct access rights to request this resource",
+            "schema": {
+              "$ref": "#/definitions/apiErrorEnvelope"
+            },
+            "examples": {
+              "application/json": {
+                "error": {
+                  "args": {
+                    "urlVars": {}
+                  },
+                  "cause": "",
+                  "causeMessage": "",
+                  "code": "UNAUTHORIZED",
+                  "message": "The request could not be completed. The session is not authorized or the credentials are invalid",
+                  "requestId": "0bfe7a04-9229-4b7a-812c-9eb3cc0eac0f"
+                },
+                "meta": {
+                  "apiEnrollmentVersion": "0.0.1",
+                  "apiVersion": "0.0.1"
+                }
+              }
+            }
+          },
+          "404": {
+            "description": "The requested resource does not exist",
+            "schema": {
+              "$ref": "#/definitions/apiErrorEnvelope"
+            },
+            "examples": {
+              "application/json": {
+                "error": {
+                  "args": {
+                    "urlVars": {
+                      "id": "71a3000f-7dda-491a-9b90-a19f4ee6c406"
+                    }
+                  },
+                  "cause": null,
+                  "causeMessage": "",
+                  "code": "NOT_FOUND",
+                  "message": "The resource requested was not found or is no longer available",
+                  "requestId": "270908d6-f2ef-4577-b973-67bec18ae376"
+                },
+                "meta": {
+                  "apiEnrollmentVersion": "0.0.1",
+                  "apiVersion": "0.0.1"
+                }
+              }
+            }
+          },
+          "429": {
+            "description": "The resource requested is rate limited and the rate limit has been exceeded",
+            "schema": {
+              "$ref": "#/definitions/apiErrorEnvelope"
+            },
+            "examples": {
+              "application/json": {
+                "error": {
+                  "args": {
+                    "urlVars": {}
+                  },
+                  "causeMessage": "you have hit a rate limit in the requested operation",
+                  "code": "RATE_LIMITED",
+                  "message": "The resource is rate limited and the rate limit has been exceeded. Please try again later",
+                  "requestId": "270908d6-f2ef-4577-b973-67bec18ae376"
+                },
+                "meta": {
+                  "apiEnrollmentVersion": "0.0.1",
+                  "apiVersion": "0.0.1"
+                }
+              }
+            }
+          }
+        }
+      },
+      "delete": {
+        "description": "Delete a circuit by id. Requires admin access.",
+        "tags": [
+          "Circuit"
+        ],
+        "summary": "Delete a circuit",
+        "operationId": "deleteCircuit",
+        "parameters": [
+          {
+            "description": "A circuit delete object",
+            "name": "options",
+            "in": "body",
+            "schema": {
+              "$ref": "#/definitions/circuitDelete"
+            }
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "The delete request was successful and the resource has been removed",
+            "schema": {
+              "$ref": "#/definitions/empty"
+            }
+          },
+          "400": {
+            "description": "The supplied request contains invalid fields or could not be parsed (json and non-json bodies). The error's code, message, and cause fields can be inspected for further information",
+            "schema": {
+              "$ref": "#/definitions/apiErrorEnvelope"
+            },
+            "examples": {
+              "application/json": {
+                "error": {
+                  "args": {
+                    "urlVars": {}
+                  },
+                  "cause": {
+                    "details": {
+                      "context": "(root)",
+                      "field": "(root)",
+                      "property": "fooField3"
+                    },
+                    "field": "(root)",
+                    "message": "(root): fooField3 is required",
+                    "type": "required",
+                    "value": {
+                      "fooField": "abc",
+                      "fooField2": "def"
+                    }
+                  },
+                  "causeMessage": "schema validation failed",
+                  "code": "COULD_NOT_VALIDATE",
+                  "message": "The supplied request contains an invalid document",
+                  "requestId": "ac6766d6-3a09-44b3-8d8a-1b541d97fdd9"
+                },
+                "meta": {
+                  "apiEnrollmentVersion": "0.0.1",
+                  "apiVersion": "0.0.1"
+                }
+              }
+            }
+          },
+          "401": {
+            "description": "The currently supplied session does not have the correct access rights to request this resource",
+            "schema": {
+              "$ref": "#/definitions/apiErrorEnvelope"
+            },
+            "examples": {
+              "application/json": {
+                "error": {
+                  "args": {
+                    "urlVars": {}
+                  },
+                  "cause": "",
+                  "causeMessage": "",
+                  "code": "UNAUTHORIZED",
+                  "message": "The request could not be completed. The session is not authorized or the credentials are invalid",
+                  "requestId": "0bfe7a04-9229-4b7a-812c-9eb3cc0eac0f"
+                },
+                "meta": {
+                  "apiEnrollmentVersion": "0.0.1",
+                  "apiVersion": "0.0.1"
+                }
+              }
+            }
+          },
+          "409": {
+            "description": "The resource requested to be removed/altered cannot be as it is referenced by another object.",
+            "schema": {
+              "$ref": "#/definitions/apiErrorEnvelope"
+            },
+            "examples": {
+              "application/json": {
+                "error": {
+                  "args": {
+                    "urlVars": {
+                      "id": "71a3000f-7dda-491a-9b90-a19f4ee6c406"
+                    }
+                  },
+                  "causeMessage": "referenced by /some-resource/05f4f710-c155-4a74-86d5-77558eb9cb42",
+                  "code": "CONFLICT_CANNOT_MODIFY_REFERENCED",
+                  "message": "The resource cannot be deleted/modified. Remove all referencing resources first.",
+                  "requestId": "270908d6-f2ef-4577-b973-67bec18ae376"
+                },
+                "meta": {
+                  "apiEnrollmentVersion": "0.0.1",
+                  "apiVersion": "0.0.1"
+                }
+              }
+            }
+          },
+          "429": {
+            "description": "The resource requested is rate limited and the rate limit has been exceeded",
+            "schema": {
+              "$ref": "#/definitions/apiErrorEnvelope"
+            },
+            "examples": {
+              "application/json": {
+                "error": {
+                  "args": {
+                    "urlVars": {}
+                  },
+                  "causeMessage": "you have hit a rate limit in the requested operation",
+                  "code": "RATE_LIMITED",
+                  "message": "The resource is rate limited and the rate limit has been exceeded. Please try again later",
+                  "requestId": "270908d6-f2ef-4577-b973-67bec18ae376"
+                },
+                "meta": {
+                  "apiEnrollmentVersion": "0.0.1",
+                  "apiVersion": "0.0.1"
+                }
+              }
+            }
+          },
+          "503": {
+            "description": "The request could not be completed due to the server being busy or in a temporarily bad state",
+            "schema": {
+              "$ref": "#/definitions/apiErrorEnvelope"
+            }
+          }
+        }
+      },
+      "parameters": [
+        {
+          "type": "string",
+          "description": "The id of the requested resource",
+          "name": "id",
+          "in": "path",
+          "required": true
+        }
+      ]
+    },
+    "/cluster/add-member": {
+      "post": {
+        "description": "Add a member to the controller cluster. Requires admin access.",
+        "tags": [
+          "Cluster"
+        ],
+        "summary": "Add a member to the controller cluster",
+        "operationId": "clusterMemberAdd",
+        "parameters": [
+          {
+            "description": "member parameters",
+            "name": "member",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/clusterMemberAdd"
+            }
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "Base empty response",
+            "schema": {
+              "$ref": "#/definitions/empty"
+            }
+          },
+          "400": {
+            "description": "The supplied request contains invalid fields or could not be parsed (json and non-json bodies). The error's code, message, and cause fields can be inspected for further information",
+            "schema": {
+              "$ref": "#/definitions/apiErrorEnvelope"
+            },
+            "examples": {
+              "application/json": {
+                "error": {
+                  "args": {
+                    "urlVars": {}
+                  },
+                  "cause": {
+                    "details": {
+                      "context": "(root)",
+                      "field": "(root)",
+                      "property": "fooField3"
+                    },
+                    "field": "(root)",
+                    "message": "(root): fooField3 is required",
+                    "type": "required",
+                    "value": {
+                      "fooField": "abc",
+                      "fooField2": "def"
+                    }
+                  },
+                  "causeMessage": "schema validation failed",
+                  "code": "COULD_NOT_VALIDATE",
+                  "message": "The supplied request contains an invalid document",
+                  "requestId": "ac6766d6-3a09-44b3-8d8a-1b541d97fdd9"
+                },
+                "meta": {
+                  "apiEnrollmentVersion": "0.0.1",
+                  "apiVersion": "0.0.1"
+                }
+              }
+            }
+          },
+          "401": {
+            "description": "The currently supplied session does not have the correct access rights to request this resource",
+            "schema": {
+              "$ref": "#/definitions/apiErrorEnvelope"
+            },
+            "examples": {
+              "application/json": {
+                "error": {
+                  "args": {
+                    "urlVars": {}
+                  },
+                  "cause": "",
+                  "causeMessage": "",
+                  "code": "UNAUTHORIZED",
+                  "message": "The request could not be completed. The session is not authorized or the credentials are invalid",
+                  "requestId": "0bfe7a04-9229-4b7a-812c-9eb3cc0eac0f"
+                },
+                "meta": {
+                  "apiEnrollmentVersion": "0.0.1",
+                  "apiVersion": "0.0.1"
+                }
+              }
+            }
+          },
+          "429": {
+            "description": "The resource requested is rate limited and the rate limit has been exceeded",
+            "schema": {
+              "$ref": "#/definitions/apiErrorEnvelope"
+            },
+            "examples": {
+              "application/json": {
+                "error": {
+                  "args": {
+                    "urlVars": {}
+                  },
+                  "causeMessage": "you have hit a rate limit in the requested operation",
+                  "code": "RATE_LIMITED",
+                  "message": "The resource is rate limited and the rate limit has been exceeded. Please try again later",
+                  "requestId": "270908d6-f2ef-4577-b973-67bec18ae376"
+                },
+                "meta": {
+                  "apiEnrollmentVersion": "0.0.1",
+                  "apiVersion": "0.0.1"
+                }
+              }
+            }
+          },
+          "503": {
+            "description": "The request could not be completed due to the server being busy or in a temporarily bad state",
+            "schema": {
+              "$ref": "#/definitions/apiErrorEnvelope"
+            }
+          }
+        }
+      }
+    },
+    "/cluster/list-members": {
+      "get": {
+        "description": "Returns all members of a cluster and their current status",
+        "tags": [
+          "Cluster"
+        ],
+        "summary": "Returns all members of a cluster and their current status",
+        "operationId": "clusterListMembers",
+        "responses": {
+          "200": {
+            "description": "A response to a cluster list-members request",
+            "schema": {
+              "$ref": "#/definitions/clusterMemberListResponse"
+            }
+          },
+          "400": {
+            "description": "The supplied request contains invalid fields or could not be parsed (json and non-json bodies). The error's code, message, and cause fields can be inspected for further information",
+            "schema": {
+              "$ref": "#/definitions/apiErrorEnvelope"
+            },
+            "examples": {
+              "application/json": {
+                "error": {
+                  "args": {
+                    "urlVars": {}
+                  },
+                  "cause": {
+                    "details": {
+                      "context": "(root)",
+                      "field": "(root)",
+                      "property": "fooField3"
+                    },
+                    "field": "(root)",
+                    "message": "(root): fooField3 is required",
+                    "type": "required",
+                    "value": {
+                      "fooField": "abc",
+                      "fooField2": "def"
+                    }
+                  },
+                  "causeMessage": "schema validation failed",
+                  "code": "COULD_NOT_VALIDATE",
+                  "message": "The supplied request contains an invalid document",
+                  "requestId": "ac6766d6-3a09-44b3-8d8a-1b541d97fdd9"
+                },
+                "meta": {
+                  "apiEnrollmentVersion": "0.0.1",
+                  "apiVersion": "0.0.1"
+                }
+              }
+            }
+          },
+          "401": {
+            "description": "The currently supplied session does not have the correct access rights to request this resource",
+            "schema": {
+              "$ref": "#/definitions/apiErrorEnvelope"
+            },
+            "examples": {
+              "application/json": {
+                "error": {
+                  "args": {
+                    "urlVars": {}
+                  },
+                  "cause": "",
+                  "causeMessage": "",
+                  "code": "UNAUTHORIZED",
+                  "message": "The request could not be completed. The session is not authorized or the credentials are invalid",
+                  "requestId": "0bfe7a04-9229-4b7a-812c-9eb3cc0eac0f"
+                },
+                "meta": {
+                  "apiEnrollmentVersion": "0.0.1",
+                  "apiVersion": "0.0.1"
+                }
+              }
+            }
+          },
+          "429": {
+            "description": "The resource requested is rate limited and the rate limit has been exceeded",
+            "schema": {
+              "$ref": "#/definitions/apiErrorEnvelope"
+            },
+            "examples": {
+              "application/json": {
+                "error": {
+                  "args": {
+                    "urlVars": {}
+                  },
+                  "causeMessage": "you have hit a rate limit in the requested operation",
+                  "code": "RATE_LIMITED",
+                  "message": "The resource is rate limited and the rate limit has been exceeded. Please try again later",
+                  "requestId": "270908d6-f2ef-4577-b973-67bec18ae376"
+                },
+                "meta": {
+                  "apiEnrollmentVersion": "0.0.1",
+                  "apiVersion": "0.0.1"
+                }
+              }
+            }
+          }
+        }
+      }
+    },
+    "/cluster/remove-member": {
+      "post": {
+        "description": "Remove a member from the controller cluster. Requires admin access.",
+        "tags": [
+          "Cluster"
+        ],
+        "summary": "Remove a member from the controller cluster",
+        "operationId": "clusterMemberRemove",
+        "parameters": [
+          {
+            "description": "member parameters",
+            "name": "member",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/clusterMemberRemove"
+            }
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "Base empty response",
+            "schema": {
+              "$ref": "#/definitions/empty"
+            }
+          },
+          "400": {
+            "description": "The supplied request contains invalid fields or could not be parsed (json and non-json bodies). The error's code, message, and cause fields can be inspected for further information",
+            "schema": {
+              "$ref": "#/definitions/apiErrorEnvelope"
+            },
+            "examples": {
+              "application/json": {
+                "error": {
+                  "args": {
+                    "urlVars": {}
+                  },
+                  "cause": {
+                    "details": {
+                      "context": "(root)",
+                      "field": "(root)",
+                      "property": "fooField3"
+                    },
+                    "field": "(root)",
+                    "message": "(root): fooField3 is required",
+                    "type": "required",
+                    "value": {
+                      "fooField": "abc",
+                      "fooField2": "def"
+                    }
+                  },
+                  "causeMessage": "schema validation failed",
+                  "code": "COULD_NOT_VALIDATE",
+                  "message": "The supplied request contains an invalid document",
+                  "requestId": "ac6766d6-3a09-44b3-8d8a-1b541d97fdd9"
+                },
+                "meta": {
+                  "apiEnrollmentVersion": "0.0.1",
+                  "apiVersion": "0.0.1"
+                }
+              }
+            }
+          },
+          "401": {
+            "description": "The currently supplied session does not have the correct access rights to request this resource",
+            "schema": {
+              "$ref": "#/definitions/apiErrorEnvelope"
+            },
+            "examples": {
+              "application/json": {
+                "error": {
+                  "args": {
+                    "urlVars": {}
+                  },
+                  "cause": "",
+                  "causeMessage": "",
+                  "code": "UNAUTHORIZED",
+                  "message": "The request could not be completed. The session is not authorized or the credentials are invalid",
+                  "requestId": "0bfe7a04-9229-4b7a-812c-9eb3cc0eac0f"
+                },
+                "meta": {
+                  "apiEnrollmentVersion": "0.0.1",
+                  "apiVersion": "0.0.1"
+                }
+              }
+            }
+          },
+          "404": {
+            "description": "The requested resource does not exist",
+            "schema": {
+              "$ref": "#/definitions/apiErrorEnvelope"
+            },
+            "examples": {
+              "application/json": {
+                "error": {
+                  "args": {
+                    "urlVars": {
+                      "id": "71a3000f-7dda-491a-9b90-a19f4ee6c406"
+                    }
+                  },
+                  "cause": null,
+                  "causeMessage": "",
+                  "code": "NOT_FOUND",
+                  "message": "The resource requested was not found or is no longer available",
+                  "requestId": "270908d6-f2ef-4577-b973-67bec18ae376"
+                },
+                "meta": {
+                  "apiEnrollmentVersion": "0.0.1",
+                  "apiVersion": "0.0.1"
+                }
+              }
+            }
+          },
+          "429": {
+            "description": "The resource requested is rate limited and the rate limit has been exceeded",
+            "schema": {
+              "$ref": "#/definitions/apiErrorEnvelope"
+            },
+            "examples": {
+              "application/json": {
+                "error": {
+                  "args": {
+                    "urlVars": {}
+                  },
+                  "causeMessage": "you have hit a rate limit in the requested operation",
+                  "code": "RATE_LIMITED",
+                  "message": "The resource is rate limited and the rate limit has been exceeded. Please try again later",
+                  "requestId": "270908d6-f2ef-4577-b973-67bec18ae376"
+                },
+                "meta": {
+                  "apiEnrollmentVersion": "0.0.1",
+                  "apiVersion": "0.0.1"
+                }
+              }
+            }
+          },
+          "503": {
+            "description": "The request could not be completed due to the server being busy or in a temporarily bad state",
+            "schema": {
+              "$ref": "#/definitions/apiErrorEnvelope"
+            }
+          }
+        }
+      }
+    },
+    "/cluster/transfer-leadership": {
+      "post": {
+        "description": "Attempts to transfer leadership to a different member of the cluster. Requires admin access.",
+        "tags": [
+          "Cluster"
+        ],
+        "summary": "Attempts to transfer leadership to a different member of the cluster",
+        "operationId": "clusterTransferLeadership",
+        "parameters": [
+          {
+            "description": "transfer operation parameters",
+            "name": "member",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/clusterTransferLeadership"
+            }
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "Base empty response",
+            "schema": {
+              "$ref": "#/definitions/empty"
+            }
+          },
+          "401": {
+            "description": "The currently supplied session does not have the correct access rights to request this resource",
+            "schema": {
+              "$ref": "#/definitions/apiErrorEnvelope"
+            },
+            "examples": {
+              "application/json": {
+                "error": {
+                  "args": {
+                    "urlVars": {}
+                  },
+                  "cause": "",
+                  "causeMessage": "",
+                  "code": "UNAUTHORIZED",
+                  "message": "The request could not be completed. The session is not authorized or the credentials are invalid",
+                  "requestId": "0bfe7a04-9229-4b7a-812c-9eb3cc0eac0f"
+                },
+                "meta": {
+                  "apiEnrollmentVersion": "0.0.1",
+                  "apiVersion": "0.0.1"
+                }
+              }
+            }
+          },
+          "404": {
+            "description": "The requested resource does not exist",
+            "schema": {
+              "$ref": "#/definitions/apiErrorEnvelope"
+            },
+            "examples": {
+              "application/json": {
+                "error": {
+                  "args": {
+                    "urlVars": {
+                      "id": "71a3000f-7dda-491a-9b90-a19f4ee6c406"
+                    }
+                  },
+                  "cause": null,
+                  "causeMessage": "",
+                  "code": "NOT_FOUND",
+                  "message": "The resource requested was not found or is no longer available",
+                  "requestId": "270908d6-f2ef-4577-b973-67bec18ae376"
+                },
+                "meta": {
+                  "apiEnrollmentVersion": "0.0.1",
+                  "apiVersion": "0.0.1"
+                }
+              }
+            }
+          },
+          "429": {
+            "description": "The resource requested is rate limited and the rate limit has been exceeded",
+            "schema": {
+              "$ref": "#/definitions/apiErrorEnvelope"
+            },
+            "examples": {
+              "application/json": {
+                "error": {
+                  "args": {
+                    "urlVars": {}
+                  },
+                  "causeMessage": "you have hit a rate limit in the requested operation",
+                  "code": "RATE_LIMITED",
+                  "message": "The resource is rate limited and the rate limit has been exceeded. Please try again later",
+                  "requestId": "270908d6-f2ef-4577-b973-67bec18ae376"
+                },
+                "meta": {
+                  "apiEnrollmentVersion": "0.0.1",
+                  "apiVersion": "0.0.1"
+                }
+              }
+            }
+          },
+          "500": {
+            "description": "The supplied request contains invalid fields or could not be parsed (json and non-json bodies). The error's code, message, and cause fields can be inspected for further information",
+            "schema": {
+              "$ref": "#/definitions/apiErrorEnvelope"
+            },
+            "examples": {
+              "application/json": {
+                "error": {
+                  "args": {
+                    "urlVars": {}
+                  },
+                  "cause": {
+                    "details": {
+                      "context": "(root)",
+                      "field": "(root)",
+                      "property": "fooField3"
+                    },
+                    "field": "(root)",
+                    "message": "(root): fooField3 is required",
+                    "type": "required",
+                    "value": {
+                      "fooField": "abc",
+                      "fooField2": "def"
+                    }
+                  },
+                  "causeMessage": "schema validation failed",
+                  "code": "COULD_NOT_VALIDATE",
+                  "message": "The supplied request contains an invalid document",
+                  "requestId": "ac6766d6-3a09-44b3-8d8a-1b541d97fdd9"
+                },
+                "meta": {
+                  "apiEnrollmentVersion": "0.0.1",
+                  "apiVersion": "0.0.1"
+                }
+              }
+            }
+          },
+          "503": {
+            "description": "The request could not be completed due to the server being busy or in a temporarily bad state",
+            "schema": {
+              "$ref": "#/definitions/apiErrorEnvelope"
+            }
+          }
+        }
+      }
+    },
+    "/current-identity/permissions": {
+      "get": {
+        "description": "Returns whether the current identity is an admin, along with the grants it has been given by roles.\nRequires an authenticated identity.\n",
+        "tags": [
+          "Role"
+        ],
+        "summary": "Returns the permissions of the current identity",
+        "operationId": "detailCurrentIdentityPermissions",
+        "responses": {
+          "200": {
+            "description": "The permissions of the current identity",
+            "schema": {
+              "$ref": "#/definitions/currentIdentityPermissionsEnvelope"
+            }
+          },
+          "401": {
+            "description": "The currently supplied session does not have the correct access rights to request this resource",
+            "schema": {
+              "$ref": "#/definitions/apiErrorEnvelope"
+            },
+            "examples": {
+              "application/json": {
+                "error": {
+                  "args": {
+                    "urlVars": {}
+                  },
+                  "cause": "",
+                  "causeMessage": "",
+                  "code": "UNAUTHORIZED",
+                  "message": "The request could not be completed. The session is not authorized or the credentials are invalid",
+                  "requestId": "0bfe7a04-9229-4b7a-812c-9eb3cc0eac0f"
+                },
+                "meta": {
+                  "apiEnrollmentVersion": "0.0.1",
+                  "apiVersion": "0.0.1"
+                }
+              }
+            }
+          },
+          "429": {
+            "description": "The resource requested is rate limited and the rate limit has been exceeded",
+            "schema": {
+              "$ref": "#/definitions/apiErrorEnvelope"
+            },
+            "examples": {
+              "application/json": {
+                "error": {
+                  "args": {
+                    "urlVars": {}
+                  },
+                  "causeMessage": "you have hit a rate limit in the requested operation",
+                  "code": "RATE_LIMITED",
+                  "message": "The resource is rate limited and the rate limit has been exceeded. Please try again later",
+                  "requestId": "270908d6-f2ef-4577-b973-67bec18ae376"
+                },
+                "meta": {
+                  "apiEnrollmentVersion": "0.0.1",
+                  "apiVersion": "0.0.1"
+                }
+              }
+            }
+          }
+        }
+      }
+    },
+    "/database": {
+      "post": {
+        "security": [
+          {
+            "ztSession": []
+          }
+        ],
+        "description": "Create a new database snapshot. Requires admin access.",
+        "tags": [
+          "Database"
+        ],
+        "summary": "Create a new database snapshot",
+        "operationId": "createDatabaseSnapshot",
+        "responses": {
+          "200": {
+            "description": "Base empty response",
+            "schema": {
+              "$ref": "#/definitions/empty"
+            }
+          },
+          "401": {
+            "description": "The currently supplied session does not have the correct access rights to request this resource",
+            "schema": {
+              "$ref": "#/definitions/apiErrorEnvelope"
+            },
+            "examples": {
+              "application/json": {
+                "error": {
+                  "args": {
+                    "urlVars": {}
+                  },
+                  "cause": "",
+                  "causeMessage": "",
+                  "code": "UNAUTHORIZED",
+                  "message": "The request could not be completed. The session is not authorized or the credentials are invalid",
+                  "requestId": "0bfe7a04-9229-4b7a-812c-9eb3cc0eac0f"
+                },
+                "meta": {
+                  "apiEnrollmentVersion": "0.0.1",
+                  "apiVersion": "0.0.1"
+                }
+              }
+            }
+          },
+          "429": {
+            "description": "The resource requested is rate limited and the rate limit has been exceeded",
+            "schema": {
+              "$ref": "#/definitions/apiErrorEnvelope"
+            },
+            "examples": {
+              "application/json": {
+                "error": {
+                  "args": {
+                    "urlVars": {}
+                  },
+                  "causeMessage": "you have hit a rate limit in the requested operation",
+                  "code": "RATE_LIMITED",
+                  "message": "The resource is rate limited and the rate limit has been exceeded. Please try again later",
+                  "requestId": "270908d6-f2ef-4577-b973-67bec18ae376"
+                },
+                "meta": {
+                  "apiEnrollmentVersion": "0.0.1",
+                  "apiVersion": "0.0.1"
+                }
+              }
+            }
+          }
+        }
+      }
+    },
+    "/database/backups": {
+      "get": {
+        "security": [
+          {
+            "ztSession": []
+          }
+        ],
+        "description": "Returns the backup schedule, the outcome of the most recent backup taken by this controller and the backups\ncurrently stored in the backup target, newest first. Requires admin access.\n",
+        "tags": [
+          "Database"
+        ],
+        "summary": "Returns the status of scheduled database backups and the backups currently retained",
+        "operationId": "listDatabaseBackups",
+        "responses": {
+          "200": {
+            "description": "The status of scheduled database backups",
+            "schema": {
+              "$ref": "#/definitions/databaseBackupStatusEnvelope"
+            }
+          },
+          "400": {
+            "description": "The supplied request contains invalid fields or could not be parsed (json and non-json bodies). The error's code, message, and cause fields can be inspected for further information",
+            "schema": {
+              "$ref": "#/definitions/apiErrorEnvelope"
+            },
+            "examples": {
+              "application/json": {
+                "error": {
+                  "args": {
+                    "urlVars": {}
+                  },
+                  "cause": {
+                    "details": {
+                      "context": "(root)",
+                      "field": "(root)",
+                      "property": "fooField3"
+                    },
+                    "field": "(root)",
+                    "message": "(root): fooField3 is required",
+                    "type": "required",
+                    "value": {
+                      "fooField": "abc",
+                      "fooField2": "def"
+                    }
+                  },
+                  "causeMessage": "schema validation failed",
+                  "code": "COULD_NOT_VALIDATE",
+                  "message": "The supplied request contains an invalid document",
+                  "requestId": "ac6766d6-3a09-44b3-8d8a-1b541d97fdd9"
+                },
+                "meta": {
+                  "apiEnrollmentVersion": "0.0.1",
+                  "apiVersion": "0.0.1"
+                }
+              }
+            }
+          },
+          "401": {
+            "description": "The currently supplied session does not have the correct access rights to request this resource",
+            "schema": {
+              "$ref": "#/definitions/apiErrorEnvelope"
+            },
+            "examples": {
+              "application/json": {
+                "error": {
+                  "args": {
+                    "urlVars": {}
+                  },
+                  "cause": "",
+                  "causeMessage": "",
+                  "code": "UNAUTHORIZED",
+                  "message": "The request could not be completed. The session is not authorized or the credentials are invalid",
+                  "requestId": "0bfe7a04-9229-4b7a-812c-9eb3cc0eac0f"
+                },
+                "meta": {
+                  "apiEnrollmentVersion": "0.0.1",
+                  "apiVersion": "0.0.1"
+                }
+              }
+            }
+          },
+          "409": {
+            "description": "Database backups are not configured on this controller",
+            "schema": {
+              "$ref": "#/definitions/apiErrorEnvelope"
+            }
+          },
+          "429": {
+            "description": "The resource requested is rate limited and the rate limit has been exceeded",
+            "schema": {
+              "$ref": "#/definitions/apiErrorEnvelope"
+            },
+            "examples": {
+              "application/json": {
+                "error": {
+                  "args": {
+                    "urlVars": {}
+                  },
+                  "causeMessage": "you have hit a rate limit in the requested operation",
+                  "code": "RATE_LIMITED",
+                  "message": "The resource is rate limited and the rate limit has been exceeded. Please try again later",
+                  "requestId": "270908d6-f2ef-4577-b973-67bec18ae376"
+                },
+                "meta": {
+                  "apiEnrollmentVersion": "0.0.1",
+                  "apiVersion": "0.0.1"
+                }
+              }
+            }
+          }
+        }
+      },
+      "post": {
+        "security": [
+          {
+            "ztSession": []
+          }
+        ],
+        "description": "Takes a database backup and writes it to the configured backup target, then removes backups beyond the\nretention limit. Returns once the backup has been written. Requires admin access.\n",
+        "tags": [
+          "Database"
+        ],
+        "summary": "Takes a database backup now",
+        "operationId": "createDatabaseBackup",
+        "responses": {
+          "200": {
+            "description": "The backup which was taken",
+            "schema": {
+              "$ref": "#/definitions/databaseBackupCreateResultEnvelope"
+            }
+          },
+          "400": {
+            "description": "The supplied request contains invalid fields or could not be parsed (json and non-json bodies). The error's code, message, and cause fields can be inspected for further information",
+            "schema": {
+              "$ref": "#/definitions/apiErrorEnvelope"
+            },
+            "examples": {
+              "application/json": {
+                "error": {
+                  "args": {
+                    "urlVars": {}
+                  },
+                  "cause": {
+                    "details": {
+                      "context": "(root)",
+                      "field": "(root)",
+                      "property": "fooField3"
+                    },
+                    "field": "(root)",
+                    "message": "(root): fooField3 is required",
+                    "type": "required",
+                    "value": {
+                      "fooField": "abc",
+                      "fooField2": "def"
+                    }
+                  },
+                  "causeMessage": "schema validation failed",
+                  "code": "COULD_NOT_VALIDATE",
+                  "message": "The supplied request contains an invalid document",
+                  "requestId": "ac6766d6-3a09-44b3-8d8a-1b541d97fdd9"
+                },
+                "meta": {
+                  "apiEnrollmentVersion": "0.0.1",
+                  "apiVersion": "0.0.1"
+                }
+              }
+            }
+          },
+          "401": {
+            "description": "The currently supplied session does not have the correct access rights to request this resource",
+            "schema": {
+              "$ref": "#/definitions/apiErrorEnvelope"
+            },
+            "examples": {
+              "application/json": {
+                "error": {
+                  "args": {
+                    "urlVars": {}
+                  },
+                  "cause": "",
+                  "causeMessage": "",
+                  "code": "UNAUTHORIZED",
+                  "message": "The request could not be completed. The session is not authorized or the credentials are invalid",
+                  "requestId": "0bfe7a04-9229-4b7a-812c-9eb3cc0eac0f"
+                },
+                "meta": {
+                  "apiEnrollmentVersion": "0.0.1",
+                  "apiVersion": "0.0.1"
+                }
+              }
+            }
+          },
+          "409": {
+            "description": "Database backups are not configured on this controller",
+            "schema": {
+              "$ref": "#/definitions/apiErrorEnvelope"
+            }
+          },
+          "429": {
+            "description": "The resource requested is rate limited and the rate limit has been exceeded",
+            "schema": {
+              "$ref": "#/definitions/apiErrorEnvelope"
+            },
+            "examples": {
+              "application/json": {
+                "error": {
+                  "args": {
+                    "urlVars": {}
+                  },
+                  "causeMessage": "you have hit a rate limit in the requested operation",
+                  "code": "RATE_LIMITED",
+                  "message": "The resource is rate limited and the rate limit has been exceeded. Please try again later",
+                  "requestId": "270908d6-f2ef-4577-b973-67bec18ae376"
+                },
+                "meta": {
+                  "apiEnrollmentVersion": "0.0.1",
+                  "apiVersion": "0.0.1"
+                }
+              }
+            }
+          }
+        }
+      }
+    },
+    "/database/check-data-integrity": {
+      "post": {
+        "security": [
+          {
+            "ztSession": []
+          }
+        ],
+        "description": "Starts a data integrity scan on the datastore. Requires admin access. Only once instance may run at a time, including runs of fixDataIntegrity.",
+        "tags": [
+          "Database"
+        ],
+        "summary": "Starts a data integrity scan on the datastore",
+        "operationId": "checkDataIntegrity",
+        "responses": {
+          "202": {
+            "description": "Base empty response",
+            "schema": {
+              "$ref": "#/definitions/empty"
+            }
+          },
+          "401": {
+            "description": "The currently supplied session does not have the correct access rights to request this resource",
+            "schema": {
+              "$ref": "#/definitions/apiErrorEnvelope"
+            },
+            "examples": {
+              "application/json": {
+                "error": {
+                  "args": {
+                    "urlVars": {}
+                  },
+                  "cause": "",
+                  "causeMessage": "",
+                  "code": "UNAUTHORIZED",
+                  "message": "The request could not be completed. The session is not authorized or the credentials are invalid",
+                  "requestId": "0bfe7a04-9229-4b7a-812c-9eb3cc0eac0f"
+                },
+                "meta": {
+                  "apiEnrollmentVersion": "0.0.1",
+                  "apiVersion": "0.0.1"
+                }
+              }
+            }
+          },
+          "429": {
+            "description": "The resource requested is rate limited and the rate limit has been exceeded",
+            "schema": {
+              "$ref": "#/definitions/apiErrorEnvelope"
+            },
+            "examples": {
+              "application/json": {
+                "error": {
+                  "args": {
+                    "urlVars": {}
+                  },
+                  "causeMessage": "you have hit a rate limit in the requested operation",
+                  "code": "RATE_LIMITED",
+                  "message": "The resource is rate limited and the rate limit has been exceeded. Please try again later",
+                  "requestId": "270908d6-f2ef-4577-b973-67bec18ae376"
+                },
+                "meta": {
+                  "apiEnrollmentVersion": "0.0.1",
+                  "apiVersion": "0.0.1"
+                }
+              }
+            }
+          }
+        }
+      }
+    },
+    "/database/data-integrity-results": {
+      "get": {
+        "security": [
+          {
+            "ztSession": []
+          }
+        ],
+        "description": "Returns any results found from in-progress integrity checks. Requires admin access.",
+        "tags": [
+          "Database"
+        ],
+        "summary": "Returns any results found from in-progress integrity checks",
+        "operationId": "dataIntegrityResults",
+        "responses": {
+          "200": {
+            "description": "A list of data integrity issues found",
+            "schema": {
+              "$ref": "#/definitions/dataIntegrityCheckResultEnvelope"
+            }
+          },
+          "401": {
+            "description": "The currently supplied session does not have the correct access rights to request this resource",
+            "schema": {
+              "$ref": "#/definitions/apiErrorEnvelope"
+            },
+            "examples": {
+              "application/json": {
+                "error": {
+                  "args": {
+                    "urlVars": {}
+                  },
+                  "cause": "",
+                  "causeMessage": "",
+                  "code": "UNAUTHORIZED",
+                  "message": "The request could not be completed. The session is not authorized or the credentials are invalid",
+                  "requestId": "0bfe7a04-9229-4b7a-812c-9eb3cc0eac0f"
+                },
+                "meta": {
+                  "apiEnrollmentVersion": "0.0.1",
+                  "apiVersion": "0.0.1"
+                }
+              }
+            }
+          },
+          "429": {
+            "description": "The resource requested is rate limited and the rate limit has been exceeded",
+            "schema": {
+              "$ref": "#/definitions/apiErrorEnvelope"
+            },
+            "examples": {
+              "application/json": {
+                "error": {
+                  "args": {
+                    "urlVars": {}
+                  },
+                  "causeMessage": "you have hit a rate limit in the requested operation",
+                  "code": "RATE_LIMITED",
+                  "message": "The resource is rate limited and the rate limit has been exceeded. Please try again later",
+                  "requestId": "270908d6-f2ef-4577-b973-67bec18ae376"
+                },
+                "meta": {
+                  "apiEnrollmentVersion": "0.0.1",
+                  "apiVersion": "0.0.1"
+                }
+              }
+            }
+          }
+        }
+      }
+    },
+    "/database/fix-data-integrity": {
+      "post": {
+        "security": [
+          {
+            "ztSession": []
+          }
+        ],
+        "description": "Runs a data integrity scan on the datastore, attempts to fix any issues it can, and returns any found issues. Requires admin access. Only once instance may run at a time, including runs of checkDataIntegrity.",
+        "tags": [
+          "Database"
+        ],
+        "summary": "Runs a data integrity scan on the datastore, attempts to fix any issues it can and returns any found issues",
+        "operationId": "fixDataIntegrity",
+        "responses": {
+          "202": {
+            "description": "Base empty response",
+            "schema": {
+              "$ref": "#/definitions/empty"
+            }
+          },
+          "401": {
+            "description": "The currently supplied session does not have the correct access rights to request this resource",
+            "schema": {
+              "$ref": "#/definitions/apiErrorEnvelope"
+            },
+            "examples": {
+              "application/json": {
+                "error": {
+                  "args": {
+                    "urlVars": {}
+                  },
+                  "cause": "",
+                  "causeMessage": "",
+                  "code": "UNAUTHORIZED",
+                  "message": "The request could not be completed. The session is not authorized or the credentials are invalid",
+                  "requestId": "0bfe7a04-9229-4b7a-812c-9eb3cc0eac0f"
+                },
+                "meta": {
+                  "apiEnrollmentVersion": "0.0.1",
+                  "apiVersion": "0.0.1"
+                }
+              }
+            }
+          },
+          "429": {
+            "description": "The resource requested is rate limited and the rate limit has been exceeded",
+            "schema": {
+              "$ref": "#/definitions/apiErrorEnvelope"
+            },
+            "examples": {
+              "application/json": {
+                "error": {
+                  "args": {
+                    "urlVars": {}
+                  },
+                  "causeMessage": "you have hit a rate limit in the requested operation",
+                  "code": "RATE_LIMITED",
+                  "message": "The resource is rate limited and the rate limit has been exceeded. Please try again later",
+                  "requestId": "270908d6-f2ef-4577-b973-67bec18ae376"
+                },
+                "meta": {
+                  "apiEnrollmentVersion": "0.0.1",
+                  "apiVersion": "0.0.1"
+                }
+              }
+            }
+          }
+        }
+      }
+    },
+    "/database/snapshot": {
+      "post": {
+        "description": "Create a new database snapshot with path. Requires admin access.",
+        "tags": [
+          "Database"
+        ],
+        "summary": "Create a new database snapshot with path",
+        "operationId": "createDatabaseSnapshotWithPath",
+        "parameters": [
+          {
+            "description": "snapshot parameters",
+            "name": "snapshot",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/databaseSnapshotCreate"
+            }
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "The path to the created snapshot",
+            "schema": {
+              "$ref": "#/definitions/databaseSnapshotCreateResultEnvelope"
+            }
+          },
+          "401": {
+            "description": "The currently supplied session does not have the correct access rights to request this resource",
+            "schema": {
+              "$ref": "#/definitions/apiErrorEnvelope"
+            },
+            "examples": {
+              "application/json": {
+                "error": {
+                  "args": {
+                    "urlVars": {}
+                  },
+                  "cause": "",
+                  "causeMessage": "",
+                  "code": "UNAUTHORIZED",
+                  "message": "The request could not be completed. The session is not authorized or the credentials are invalid",
+                  "requestId": "0bfe7a04-9229-4b7a-812c-9eb3cc0eac0f"
+                },
+                "meta": {
+                  "apiEnrollmentVersion": "0.0.1",
+                  "apiVersion": "0.0.1"
+                }
+              }
+            }
+          },
+          "429": {
+            "description": "The resource requested is rate limited and the rate limit has been exceeded",
+            "schema": {
+              "$ref": "#/definitions/apiErrorEnvelope"
+            },
+            "examples": {
+              "application/json": {
+                "error": {
+                  "args": {
+                    "urlVars": {}
+                  },
+                  "causeMessage": "you have hit a rate limit in the requested operation",
+                  "code": "RATE_LIMITED",
+                  "message": "The resource is rate limited and the rate limit has been exceeded. Please try again later",
+                  "requestId": "270908d6-f2ef-4577-b973-67bec18ae376"
+                },
+                "meta": {
+                  "apiEnrollmentVersion": "0.0.1",
+                  "apiVersion": "0.0.1"
+                }
+              }
+            }
+          }
+        }
+      }
+    },
+    "/enrollment-signers": {
+      "get": {
+        "description": "Retrieves the enrollment signers configured on this controller, along with how many identity and edge router\ncertificates issued by each signer are still in use. The active signer issues new certificates. Trusted\nsigners are published in the CA bundle, but don't issue certificates. Requires admin access.\n",
+        "tags": [
+          "Enrollment Signer"
+        ],
+        "summary": "List enrollment signers",
+        "operationId": "listEnrollmentSigners",
+        "responses": {
+          "200": {
+            "description": "A list of enrollment signers",
+            "schema": {
+              "$ref": "#/definitions/listEnrollmentSignersEnvelope"
+            }
+          },
+          "401": {
+            "description": "The currently supplied session does not have the correct access rights to request this resource",
+            "schema": {
+              "$ref": "#/definitions/apiErrorEnvelope"
+            },
+            "examples": {
+              "application/json": {
+                "error": {
+                  "args": {
+                    "urlVars": {}
+                  },
+                  "cause": "",
+                  "causeMessage": "",
+                  "code": "UNAUTHORIZED",
+                  "message": "The request could not be completed. The session is not authorized or the credentials are invalid",
+                  "requestId": "0bfe7a04-9229-4b7a-812c-9eb3cc0eac0f"
+                },
+                "meta": {
+                  "apiEnrollmentVersion": "0.0.1",
+                  "apiVersion": "0.0.1"
+                }
+              }
+            }
+          },
+          "429": {
+            "description": "The resource requested is rate limited and the rate limit has been exceeded",
+            "schema": {
+              "$ref": "#/definitions/apiErrorEnvelope"
+            },
+            "examples": {
+              "application/json": {
+                "error": {
+                  "args": {
+                    "urlVars": {}
+                  },
+                  "causeMessage": "you have hit a rate limit in the requested operation",
+                  "code": "RATE_LIMITED",
+                  "message": "The resource is rate limited and the rate limit has been exceeded. Please try again later",
+                  "requestId": "270908d6-f2ef-4577-b973-67bec18ae376"
+                },
+                "meta": {
+                  "apiEnrollmentVersion": "0.0.1",
+                  "apiVersion": "0.0.1"
+                }
+              }
+            }
+          }
+        }
+      }
+    },
+    "/enrollment-signers/{id}": {
+      "get": {
+        "description": "Retrieves a single enrollment signer by fingerprint. Requires admin access.",
+        "tags": [
+          "Enrollment Signer"
+        ],
+        "summary": "Retrieves a single enrollment signer",
+        "operationId": "detailEnrollmentSigner",
+        "responses": {
+          "200": {
+            "description": "A single enrollment signer",
+            "schema": {
+              "$ref": "#/definitions/enrollmentSignerEnvelope"
+            }
+          },
+          "401": {
+            "description": "The currently supplied session does not have the correct access rights to request this resource",
+            "schema": {
+              "$ref": "#/definitions/apiErrorEnvelope"
+            },
+            "examples": {
+              "application/json": {
+                "error": {
+                  "args": {
+                    "urlVars": {}
+                  },
+                  "cause": "",
+                  "causeMessage": "",
+                  "code": "UNAUTHORIZED",
+                  "message": "The request could not be completed. The session is not authorized or the credentials are invalid",
+                  "requestId": "0bfe7a04-9229-4b7a-812c-9eb3cc0eac0f"
+                },
+                "meta": {
+                  "apiEnrollmentVersion": "0.0.1",
+                  "apiVersion": "0.0.1"
+                }
+              }
+            }
+          },
+          "404": {
+            "description": "The requested resource does not exist",
+            "schema": {
+              "$ref": "#/definitions/apiErrorEnvelope"
+            },
+            "examples": {
+              "application/json": {
+                "error": {
+                  "args": {
+                    "urlVars": {
+                      "id": "71a3000f-7dda-491a-9b90-a19f4ee6c406"
+                    }
+                  },
+                  "cause": null,
+                  "causeMessage": "",
+                  "code": "NOT_FOUND",
+                  "message": "The resource requested was not found or is no longer available",
+                  "requestId": "270908d6-f2ef-4577-b973-67bec18ae376"
+                },
+                "meta": {
+                  "apiEnrollmentVersion": "0.0.1",
+                  "apiVersion": "0.0.1"
+                }
+              }
+            }
+          },
+          "429": {
+            "description": "The resource requested is rate limited and the rate limit has been exceeded",
+            "schema": {
+              "$ref": "#/definitions/apiErrorEnvelope"
+            },
+            "examples": {
+              "application/json": {
+                "error": {
+                  "args": {
+                    "urlVars": {}
+                  },
+                  "causeMessage": "you have hit a rate limit in the requested operation",
+                  "code": "RATE_LIMITED",
+                  "message": "The resource is rate limited and the rate limit has been exceeded. Please try again later",
+                  "requestId": "270908d6-f2ef-4577-b973-67bec18ae376"
+                },
+                "meta": {
+                  "apiEnrollmentVersion": "0.0.1",
+                  "apiVersion": "0.0.1"
+                }
+              }
+            }
+          }
+        }
+      },
+      "parameters": [
+        {
+          "type": "string",
+          "description": "The id of the requested resource",
+          "name": "id",
+          "in": "path",
+          "required": true
+        }
+      ]
+    },
+    "/enrollment-signers/{id}/reissue": {
+      "post": {
+        "description": "Asks for the certificates issued by a trusted signer to be re-issued by the active signer. Identities are asked\nto extend their certificates the next time they authenticate. Edge routers are asked to renew their\ncertificates using a router certificate rotation, whose id is returned. Requires admin access.\n",
+        "tags": [
+          "Enrollment Signer"
+        ],
+        "summary": "Re-issue the certificates issued by a trusted enrollment signer",
+        "operationId": "reissueEnrollmentSigner",
+        "responses": {
+          "202": {
+            "description": "The certificates which were asked to be re-issued",
+            "schema": {
+              "$ref": "#/definitions/enrollmentSignerReissueEnvelope"
+            }
+          },
+          "400": {
+            "description": "The supplied request contains invalid fields or could not be parsed (json and non-json bodies). The error's code, message, and cause fields can be inspected for further information",
+            "schema": {
+              "$ref": "#/definitions/apiErrorEnvelope"
+            },
+            "examples": {
+              "application/json": {
+                "error": {
+                  "args": {
+                    "urlVars": {}
+                  },
+                  "cause": {
+                    "details": {
+                      "context": "(root)",
+                      "field": "(root)",
+                      "property": "fooField3"
+                    },
+                    "field": "(root)",
+                    "message": "(root): fooField3 is required",
+                    "type": "required",
+                    "value": {
+                      "fooField": "abc",
+                      "fooField2": "def"
+                    }
+                  },
+                  "causeMessage": "schema validation failed",
+                  "code": "COULD_NOT_VALIDATE",
+                  "message": "The supplied request contains an invalid document",
+                  "requestId": "ac6766d6-3a09-44b3-8d8a-1b541d97fdd9"
+                },
+                "meta": {
+                  "apiEnrollmentVersion": "0.0.1",
+                  "apiVersion": "0.0.1"
+                }
+              }
+            }
+          },
+          "401": {
+            "description": "The currently supplied session does not have the correct access rights to request this resource",
+            "schema": {
+              "$ref": "#/definitions/apiErrorEnvelope"
+            },
+            "examples": {
+              "application/json": {
+                "error": {
+                  "args": {
+                    "urlVars": {}
+                  },
+                  "cause": "",
+                  "causeMessage": "",
+                  "code": "UNAUTHORIZED",
+                  "message": "The request could not be completed. The session is not authorized or the credentials are invalid",
+                  "requestId": "0bfe7a04-9229-4b7a-812c-9eb3cc0eac0f"
+                },
+                "meta": {
+                  "apiEnrollmentVersion": "0.0.1",
+                  "apiVersion": "0.0.1"
+                }
+              }
+            }
+          },
+          "404": {
+            "description": "The requested resource does not exist",
+            "schema": {
+              "$ref": "#/definitions/apiErrorEnvelope"
+            },
+            "examples": {
+              "application/json": {
+                "error": {
+                  "args": {
+                    "urlVars": {
+                      "id": "71a3000f-7dda-491a-9b90-a19f4ee6c406"
+                    }
+                  },
+                  "cause": null,
+                  "causeMessage": "",
+                  "code": "NOT_FOUND",
+                  "message": "The resource requested was not found or is no longer available",
+                  "requestId": "270908d6-f2ef-4577-b973-67bec18ae376"
+                },
+                "meta": {
+                  "apiEnrollmentVersion": "0.0.1",
+                  "apiVersion": "0.0.1"
+                }
+              }
+            }
+          },
+          "429": {
+            "description": "The resource requested is rate limited and the rate limit has been exceeded",
+            "schema": {
+              "$ref": "#/definitions/apiErrorEnvelope"
+            },
+            "examples": {
+              "application/json": {
+                "error": {
+                  "args": {
+                    "urlVars": {}
+                  },
+                  "causeMessage": "you have hit a rate limit in the requested operation",
+                  "code": "RATE_LIMITED",
+                  "message": "The resource is rate limited and the rate limit has been exceeded. Please try again later",
+                  "requestId": "270908d6-f2ef-4577-b973-67bec18ae376"
+                },
+                "meta": {
+                  "apiEnrollmentVersion": "0.0.1",
+                  "apiVersion": "0.0.1"
+                }
+              }
+            }
+          }
+        }
+      },
+      "parameters": [
+        {
+          "type": "string",
+          "description": "The id of the requested resource",
+          "name": "id",
+          "in": "path",
+          "required": true
+        }
+      ]
+    },
+    "/inspections": {
+      "post": {
+        "description": "Requests system information, such as stack dumps or information about capabilities. Requires admin access.\n",
+        "tags": [
+          "Inspect"
+        ],
+        "summary": "Inspect system values",
+        "operationId": "inspect",
+        "parameters": [
+          {
+            "description": "An inspect request",
+            "name": "request",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/inspectRequest"
+            }
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "A response to an inspect request",
+            "schema": {
+              "$ref": "#/definitions/inspectResponse"
+            }
+          },
+          "401": {
+            "description": "The currently supplied session does not have the correct access rights to request this resource",
+            "schema": {
+              "$ref": "#/definitions/apiErrorEnvelope"
+            },
+            "examples": {
+              "application/json": {
+                "error": {
+                  "args": {
+                    "urlVars": {}
+                  },
+                  "cause": "",
+                  "causeMessage": "",
+                  "code": "UNAUTHORIZED",
+                  "message": "The request could not be completed. The session is not authorized or the credentials are invalid",
+                  "requestId": "0bfe7a04-9229-4b7a-812c-9eb3cc0eac0f"
+                },
+                "meta": {
+                  "apiEnrollmentVersion": "0.0.1",
+                  "apiVersion": "0.0.1"
+                }
+              }
+            }
+          },
+          "429": {
+            "description": "The resource requested is rate limited and the rate limit has been exceeded",
+            "schema": {
+              "$ref": "#/definitions/apiErrorEnvelope"
+            },
+            "examples": {
+              "application/json": {
+                "error": {
+                  "args": {
+                    "urlVars": {}
+                  },
+                  "causeMessage": "you have hit a rate limit in the requested operation",
+                  "code": "RATE_LIMITED",
+                  "message": "The resource is rate limited and the rate limit has been exceeded. Please try again later",
+                  "requestId": "270908d6-f2ef-4577-b973-67bec18ae376"
+                },
+                "meta": {
+                  "apiEnrollmentVersion": "0.0.1",
+                  "apiVersion": "0.0.1"
+                }
+              }
+            }
+          }
+        }
+      }
+    },
+    "/links": {
+      "get": {
+        "description": "Retrieves a list of link resources; does not supports filtering, sorting, or pagination. Requires admin access.\n",
+        "tags": [
+          "Link"
+        ],
+        "summary": "List links",
+        "operationId": "listLinks",
+        "parameters": [
+          {
+            "type": "integer",
+            "name": "limit",
+            "in": "query"
+          },
+          {
+            "type": "integer",
+            "name": "offset",
+            "in": "query"
+          },
+          {
+            "type": "string",
+            "name": "filter",
+            "in": "query"
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "A list of links",
+            "schema": {
+              "$ref": "#/definitions/listLinksEnvelope"
+            }
+          },
+          "401": {
+            "description": "The currently supplied session does not have the correct access rights to request this resource",
+            "schema": {
+              "$ref": "#/definitions/apiErrorEnvelope"
+            },
+            "examples": {
+              "application/json": {
+                "error": {
+                  "args": {
+                    "urlVars": {}
+                  },
+                  "cause": "",
+                  "causeMessage": "",
+                  "code": "UNAUTHORIZED",
+                  "message": "The request could not be completed. The session is not authorized or the credentials are invalid",
+                  "requestId": "0bfe7a04-9229-4b7a-812c-9eb3cc0eac0f"
+                },
+                "meta": {
+                  "apiEnrollmentVersion": "0.0.1",
+                  "apiVersion": "0.0.1"
+                }
+              }
+            }
+          },
+          "429": {
+            "description": "The resource requested is rate limited and the rate limit has been exceeded",
+            "schema": {
+              "$ref": "#/definitions/apiErrorEnvelope"
+            },
+            "examples": {
+              "application/json": {
+                "error": {
+                  "args": {
+                    "urlVars": {}
+                  },
+                  "causeMessage": "you have hit a rate limit in the requested operation",
+                  "code": "RATE_LIMITED",
+                  "message": "The resource is rate limited and the rate limit has been exceeded. Please try again later",
+                  "requestId": "270908d6-f2ef-4577-b973-67bec18ae376"
+                },
+                "meta": {
+                  "apiEnrollmentVersion": "0.0.1",
+                  "apiVersion": "0.0.1"
+                }
+              }
+            }
+          }
+        }
+      }
+    },
+    "/links/{id}": {
+      "get": {
+        "description": "Retrieves a single link by id. Requires admin access.",
+        "tags": [
+          "Link"
+        ],
+        "summary": "Retrieves a single link",
+        "operationId": "detailLink",
+        "responses": {
+          "200": {
+            "description": "A single link",
+            "schema": {
+              "$ref": "#/definitions/detailLinkEnvelope"
+            }
+          },
+          "401": {
+            "description": "The currently supplied session does not have the correct access rights to request this resource",
+            "schema": {
+              "$ref": "#/definitions/apiErrorEnvelope"
+            },
+            "examples": {
+              "application/json": {
+                "error": {
+                  "args": {
+                    "urlVars": {}
+                  },
+                  "cause": "",
+                  "causeMessage": "",
+                  "code": "UNAUTHORIZED",
+                  "message": "The request could not be completed. The session is not authorized or the credentials are invalid",
+                  "requestId": "0bfe7a04-9229-4b7a-812c-9eb3cc0eac0f"
+                },
+                "meta": {
+                  "apiEnrollmentVersion": "0.0.1",
+                  "apiVersion": "0.0.1"
+                }
+              }
+            }
+          },
+          "404": {
+            "description": "The requested resource does not exist",
+            "schema": {
+              "$ref": "#/definitions/apiErrorEnvelope"
+            },
+            "examples": {
+              "application/json": {
+                "error": {
+                  "args": {
+                    "urlVars": {
+                      "id": "71a3000f-7dda-491a-9b90-a19f4ee6c406"
+                    }
+                  },
+                  "cause": null,
+                  "causeMessage": "",
+                  "code": "NOT_FOUND",
+                  "message": "The resource requested was not found or is no longer available",
+                  "requestId": "270908d6-f2ef-4577-b973-67bec18ae376"
+                },
+                "meta": {
+                  "apiEnrollmentVersion": "0.0.1",
+                  "apiVersion": "0.0.1"
+                }
+              }
+            }
+          },
+          "429": {
+            "description": "The resource requested is rate limited and the rate limit has been exceeded",
+            "schema": {
+              "$ref": "#/definitions/apiErrorEnvelope"
+            },
+            "examples": {
+              "application/json": {
+                "error": {
+                  "args": {
+                    "urlVars": {}
+                  },
+                  "causeMessage": "you have hit a rate limit in the requested operation",
+                  "code": "RATE_LIMITED",
+                  "message": "The resource is rate limited and the rate limit has been exceeded. Please try again later",
+                  "requestId": "270908d6-f2ef-4577-b973-67bec18ae376"
+                },
+                "meta": {
+                  "apiEnrollmentVersion": "0.0.1",
+                  "apiVersion": "0.0.1"
+                }
+              }
+            }
+          }
+        }
+      },
+      "delete": {
+        "description": "Delete a link by id. Requires admin access.",
+        "tags": [
+          "Link"
+        ],
+        "summary": "Delete a link",
+        "operationId": "deleteLink",
+        "responses": {
+          "200": {
+            "description": "The delete request was successful and the resource has been removed",
+            "schema": {
+              "$ref": "#/definitions/empty"
+            }
+          },
+          "400": {
+            "description": "The supplied request contains invalid fields or could not be parsed (json and non-json bodies). The error's code, message, and cause fields can be inspected for further information",
+            "schema": {
+              "$ref": "#/definitions/apiErrorEnvelope"
+            },
+            "examples": {
+              "application/json": {
+                "error": {
+                  "args": {
+                    "urlVars": {}
+                  },
+                  "cause": {
+                    "details": {
+                      "context": "(root)",
+                      "field": "(root)",
+                      "property": "fooField3"
+                    },
+                    "field": "(root)",
+                    "message": "(root): fooField3 is required",
+                    "type": "required",
+                    "value": {
+                      "fooField": "abc",
+                      "fooField2": "def"
+                    }
+                  },
+                  "causeMessage": "schema validation failed",
+                  "code": "COULD_NOT_VALIDATE",
+                  "message": "The supplied request contains an invalid document",
+                  "requestId": "ac6766d6-3a09-44b3-8d8a-1b541d97fdd9"
+                },
+                "meta": {
+                  "apiEnrollmentVersion": "0.0.1",
+                  "apiVersion": "0.0.1"
+                }
+              }
+            }
+          },
+          "401": {
+            "description": "The currently supplied session does not have the correct access rights to request this resource",
+            "schema": {
+              "$ref": "#/definitions/apiErrorEnvelope"
+            },
+            "examples": {
+              "application/json": {
+                "error": {
+                  "args": {
+                    "urlVars": {}
+                  },
+                  "cause": "",
+                  "causeMessage": "",
+                  "code": "UNAUTHORIZED",
+                  "message": "The request could not be completed. The session is not authorized or the credentials are invalid",
+                  "requestId": "0bfe7a04-9229-4b7a-812c-9eb3cc0eac0f"
+                },
+                "meta": {
+                  "apiEnrollmentVersion": "0.0.1",
+                  "apiVersion": "0.0.1"
+                }
+              }
+            }
+          },
+          "429": {
+            "description": "The resource requested is rate limited and the rate limit has been exceeded",
+            "schema": {
+              "$ref": "#/definitions/apiErrorEnvelope"
+            },
+            "examples": {
+              "application/json": {
+                "error": {
+                  "args": {
+                    "urlVars": {}
+                  },
+                  "causeMessage": "you have hit a rate limit in the requested operation",
+                  "code": "RATE_LIMITED",
+                  "message": "The resource is rate limited and the rate limit has been exceeded. Please try again later",
+                  "requestId": "270908d6-f2ef-4577-b973-67bec18ae376"
+                },
+                "meta": {
+                  "apiEnrollmentVersion": "0.0.1",
+                  "apiVersion": "0.0.1"
+                }
+              }
+            }
+          },
+          "503": {
+            "description": "The request could not be completed due to the server being busy or in a temporarily bad state",
+            "schema": {
+              "$ref": "#/definitions/apiErrorEnvelope"
+            }
+          }
+        }
+      },
+      "patch": {
+        "description": "Update the supplied fields on a link. Requires admin access.",
+        "tags": [
+          "Link"
+        ],
+        "summary": "Update the supplied fields on a link",
+        "operationId": "patchLink",
+        "parameters": [
+          {
+            "description": "A link patch object",
+            "name": "link",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/linkPatch"
+            }
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "The patch request was successful and the resource has been altered",
+            "schema": {
+              "$ref": "#/definitions/empty"
+            }
+          },
+          "400": {
+            "description": "The supplied request contains invalid fields or could not be parsed (json and non-json bodies). The error's code, message, and cause fields can be inspected for further information",
+            "schema": {
+              "$ref": "#/definitions/apiErrorEnvelope"
+            },
+            "examples": {
+              "application/json": {
+                "error": {
+                  "args": {
+                    "urlVars": {}
+                  },
+                  "cause": {
+                    "details": {
+                      "context": "(root)",
+                      "field": "(root)",
+                      "property": "fooField3"
+                    },
+                    "field": "(root)",
+                    "message": "(root): fooField3 is required",
+                    "type": "required",
+                    "value": {
+                      "fooField": "abc",
+                      "fooField2": "def"
+                    }
+                  },
+                  "causeMessage": "schema validation failed",
+                  "code": "COULD_NOT_VALIDATE",
+                  "message": "The supplied request contains an invalid document",
+                  "requestId": "ac6766d6-3a09-44b3-8d8a-1b541d97fdd9"
+                },
+                "meta": {
+                  "apiEnrollmentVersion": "0.0.1",
+                  "apiVersion": "0.0.1"
+                }
+              }
+            }
+          },
+          "401": {
+            "description": "The currently supplied session does not have the correct access rights to request this resource",
+            "schema": {
+              "$ref": "#/definitions/apiErrorEnvelope"
+            },
+            "examples": {
+              "application/json": {
+                "error": {
+                  "args": {
+                    "urlVars": {}
+                  },
+                  "cause": "",
+                  "causeMessage": "",
+                  "code": "UNAUTHORIZED",
+                  "message": "The request could not be completed. The session is not authorized or the credentials are invalid",
+                  "requestId": "0bfe7a04-9229-4b7a-812c-9eb3cc0eac0f"
+                },
+                "meta": {
+                  "apiEnrollmentVersion": "0.0.1",
+                  "apiVersion": "0.0.1"
+                }
+              }
+            }
+          },
+          "404": {
+            "description": "The requested resource does not exist",
+            "schema": {
+              "$ref": "#/definitions/apiErrorEnvelope"
+            },
+            "examples": {
+              "application/json": {
+                "error": {
+                  "args": {
+                    "urlVars": {
+                      "id": "71a3000f-7dda-491a-9b90-a19f4ee6c406"
+                    }
+                  },
+                  "cause": null,
+                  "causeMessage": "",
+                  "code": "NOT_FOUND",
+                  "message": "The resource requested was not found or is no longer available",
+                  "requestId": "270908d6-f2ef-4577-b973-67bec18ae376"
+                },
+                "meta": {
+                  "apiEnrollmentVersion": "0.0.1",
+                  "apiVersion": "0.0.1"
+                }
+              }
+            }
+          },
+          "429": {
+            "description": "The resource requested is rate limited and the rate limit has been exceeded",
+            "schema": {
+              "$ref": "#/definitions/apiErrorEnvelope"
+            },
+            "examples": {
+              "application/json": {
+                "error": {
+                  "args": {
+                    "urlVars": {}
+                  },
+                  "causeMessage": "you have hit a rate limit in the requested operation",
+                  "code": "RATE_LIMITED",
+                  "message": "The resource is rate limited and the rate limit has been exceeded. Please try again later",
+                  "requestId": "270908d6-f2ef-4577-b973-67bec18ae376"
+                },
+                "meta": {
+                  "apiEnrollmentVersion": "0.0.1",
+                  "apiVersion": "0.0.1"
+                }
+              }
+            }
+          },
+          "503": {
+            "description": "The request could not be completed due to the server being busy or in a temporarily bad state",
+            "schema": {
+              "$ref": "#/definitions/apiErrorEnvelope"
+            }
+          }
+        }
+      },
+      "parameters": [
+        {
+          "type": "string",
+          "description": "The id of the requested resource",
+          "name": "id",
+          "in": "path",
+          "required": true
+        }
+      ]
+    },
+    "/policy-simulations": {
+      "post": {
+        "description": "Reports how identity to service access would change if the given policy and role attribute changes were made,\nwithout making them. Access is reported per identity, service and policy type, along with the edge routers\nwhich could be used and the posture checks of the granting policies. Requires admin access.\n",
+        "tags": [
+          "Policy Simulation"
+        ],
+        "summary": "Simulate policy changes",
+        "operationId": "simulatePolicyChanges",
+        "parameters": [
+          {
+            "description": "The hypothetical changes to evaluate",
+            "name": "simulation",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/policySimulationCreate"
+            }
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "The access changes the simulated changes would cause",
+            "schema": {
+              "$ref": "#/definitions/policySimulationResultEnvelope"
+            }
+          },
+          "400": {
+            "description": "The supplied request contains invalid fields or could not be parsed (json and non-json bodies). The error's code, message, and cause fields can be inspected for further information",
+            "schema": {
+              "$ref": "#/definitions/apiErrorEnvelope"
+            },
+            "examples": {
+              "application/json": {
+                "error": {
+                  "args": {
+                    "urlVars": {}
+                  },
+                  "cause": {
+                    "details": {
+                      "context": "(root)",
+                      "field": "(root)",
+                      "property": "fooField3"
+                    },
+                    "field": "(root)",
+                    "message": "(root): fooField3 is required",
+                    "type": "required",
+                    "value": {
+                      "fooField": "abc",
+                      "fooField2": "def"
+                    }
+                  },
+                  "causeMessage": "schema validation failed",
+                  "code": "COULD_NOT_VALIDATE",
+                  "message": "The supplied request contains an invalid document",
+                  "requestId": "ac6766d6-3a09-44b3-8d8a-1b541d97fdd9"
+                },
+                "meta": {
+                  "apiEnrollmentVersion": "0.0.1",
+                  "apiVersion": "0.0.1"
+                }
+              }
+            }
+          },
+          "401": {
+            "description": "The currently supplied session does not have the correct access rights to request this resource",
+            "schema": {
+              "$ref": "#/definitions/apiErrorEnvelope"
+            },
+            "examples": {
+              "application/json": {
+                "error": {
+                  "args": {
+                    "urlVars": {}
+                  },
+                  "cause": "",
+                  "causeMessage": "",
+                  "code": "UNAUTHORIZED",
+                  "message": "The request could not be completed. The session is not authorized or the credentials are invalid",
+                  "requestId": "0bfe7a04-9229-4b7a-812c-9eb3cc0eac0f"
+                },
+                "meta": {
+                  "apiEnrollmentVersion": "0.0.1",
+                  "apiVersion": "0.0.1"
+                }
+              }
+            }
+          },
+          "404": {
+            "description": "The requested resource does not exist",
+            "schema": {
+              "$ref": "#/definitions/apiErrorEnvelope"
+            },
+            "examples": {
+              "application/json": {
+                "error": {
+                  "args": {
+                    "urlVars": {
+                      "id": "71a3000f-7dda-491a-9b90-a19f4ee6c406"
+                    }
+                  },
+                  "cause": null,
+                  "causeMessage": "",
+                  "code": "NOT_FOUND",
+                  "message": "The resource requested was not found or is no longer available",
+                  "requestId": "270908d6-f2ef-4577-b973-67bec18ae376"
+                },
+                "meta": {
+                  "apiEnrollmentVersion": "0.0.1",
+                  "apiVersion": "0.0.1"
+                }
+              }
+            }
+          },
+          "429": {
+            "description": "The resource requested is rate limited and the rate limit has been exceeded",
+            "schema": {
+              "$ref": "#/definitions/apiErrorEnvelope"
+            },
+            "examples": {
+              "application/json": {
+                "error": {
+                  "args": {
+                    "urlVars": {}
+                  },
+                  "causeMessage": "you have hit a rate limit in the requested operation",
+                  "code": "RATE_LIMITED",
+                  "message": "The resource is rate limited and the rate limit has been exceeded. Please try again later",
+                  "requestId": "270908d6-f2ef-4577-b973-67bec18ae376"
+                },
+                "meta": {
+                  "apiEnrollmentVersion": "0.0.1",
+                  "apiVersion": "0.0.1"
+                }
+              }
+            }
+          }
+        }
+      }
+    },
+    "/probes": {
+      "get": {
+        "description": "Retrieves the synthetic probes configured on this controller, along with the SLO summary of each probe over the\nprobe results window. Each controller schedules the probes independently. Requires admin access.\n",
+        "tags": [
+          "Probe"
+        ],
+        "summary": "List synthetic probes",
+        "operationId": "listProbes",
+        "responses": {
+          "200": {
+            "description": "A list of synthetic probes",
+            "schema": {
+              "$ref": "#/definitions/listProbesEnvelope"
+            }
+          },
+          "401": {
+            "description": "The currently supplied session does not have the correct access rights to request this resource",
+            "schema": {
+              "$ref": "#/definitions/apiErrorEnvelope"
+            },
+            "examples": {
+              "application/json": {
+                "error": {
+                  "args": {
+                    "urlVars": {}
+                  },
+                  "cause": "",
+                  "causeMessage": "",
+                  "code": "UNAUTHORIZED",
+                  "message": "The request could not be completed. The session is not authorized or the credentials are invalid",
+                  "requestId": "0bfe7a04-9229-4b7a-812c-9eb3cc0eac0f"
+                },
+                "meta": {
+                  "apiEnrollmentVersion": "0.0.1",
+                  "apiVersion": "0.0.1"
+                }
+              }
+            }
+          },
+          "429": {
+            "description": "The resource requested is rate limited and the rate limit has been exceeded",
+            "schema": {
+              "$ref": "#/definitions/apiErrorEnvelope"
+            },
+            "examples": {
+              "application/json": {
+                "error": {
+                  "args": {
+                    "urlVars": {}
+                  },
+                  "causeMessage": "you have hit a rate limit in the requested operation",
+                  "code": "RATE_LIMITED",
+                  "message": "The resource is rate limited and the rate limit has been exceeded. Please try again later",
+                  "requestId": "270908d6-f2ef-4577-b973-67bec18ae376"
+                },
+                "meta": {
+                  "apiEnrollmentVersion": "0.0.1",
+                  "apiVersion": "0.0.1"
+                }
+              }
+            }
+          }
+        }
+      }
+    },
+    "/probes/{name}": {
+      "get": {
+        "description": "Retrieves a single synthetic probe and its SLO summary over the probe results window. Requires admin access.",
+        "tags": [
+          "Probe"
+        ],
+        "summary": "Retrieve a synthetic probe",
+        "operationId": "detailProbe",
+        "responses": {
+          "200": {
+            "description": "A single synthetic probe",
+            "schema": {
+              "$ref": "#/definitions/probeEnvelope"
+            }
+          },
+          "401": {
+            "description": "The currently supplied session does not have the correct access rights to request this resource",
+            "schema": {
+              "$ref": "#/definitions/apiErrorEnvelope"
+            },
+            "examples": {
+              "application/json": {
+                "error": {
+                  "args": {
+                    "urlVars": {}
+                  },
+                  "cause": "",
+                  "causeMessage": "",
+                  "code": "UNAUTHORIZED",
+                  "message": "The request could not be completed. The session is not authorized or the credentials are invalid",
+                  "requestId": "0bfe7a04-9229-4b7a-812c-9eb3cc0eac0f"
+                },
+                "meta": {
+                  "apiEnrollmentVersion": "0.0.1",
+                  "apiVersion": "0.0.1"
+                }
+              }
+            }
+          },
+          "404": {
+            "description": "The requested resource does not exist",
+            "schema": {
+              "$ref": "#/definitions/apiErrorEnvelope"
+            },
+            "examples": {
+              "application/json": {
+                "error": {
+                  "args": {
+                    "urlVars": {
+                      "id": "71a3000f-7dda-491a-9b90-a19f4ee6c406"
+                    }
+                  },
+                  "cause": null,
+                  "causeMessage": "",
+                  "code": "NOT_FOUND",
+                  "message": "The resource requested was not found or is no longer available",
+                  "requestId": "270908d6-f2ef-4577-b973-67bec18ae376"
+                },
+                "meta": {
+                  "apiEnrollmentVersion": "0.0.1",
+                  "apiVersion": "0.0.1"
+                }
+              }
+            }
+          },
+          "429": {
+            "description": "The resource requested is rate limited and the rate limit has been exceeded",
+            "schema": {
+              "$ref": "#/definitions/apiErrorEnvelope"
+            },
+            "examples": {
+              "application/json": {
+                "error": {
+                  "args": {
+                    "urlVars": {}
+                  },
+                  "causeMessage": "you have hit a rate limit in the requested operation",
+                  "code": "RATE_LIMITED",
+                  "message": "The resource is rate limited and the rate limit has been exceeded. Please try again later",
+                  "requestId": "270908d6-f2ef-4577-b973-67bec18ae376"
+                },
+                "meta": {
+                  "apiEnrollmentVersion": "0.0.1",
+                  "apiVersion": "0.0.1"
+                }
+              }
+            }
+          }
+        }
+      },
+      "parameters": [
+        {
+          "type": "string",
+          "description": "The name of the probe",
+          "name": "name",
+          "in": "path",
+          "required": true
+        }
+      ]
+    },
+    "/probes/{name}/results": {
+      "get": {
+        "description": "Retrieves the results of the given probe in the probe results window, newest first. Requires admin access.",
+        "tags": [
+          "Probe"
+        ],
+        "summary": "List the results of a synthetic probe",
+        "operationId": "listProbeResults",
+        "responses": {
+          "200": {
+            "description": "A list of probe results",
+            "schema": {
+              "$ref": "#/definitions/listProbeResultsEnvelope"
+            }
+          },
+          "401": {
+            "description": "The currently supplied session does not have the correct access rights to request this resource",
+            "schema": {
+              "$ref": "#/definitions/apiErrorEnvelope"
+            },
+            "examples": {
+              "application/json": {
+                "error": {
+                  "args": {
+                    "urlVars": {}
+                  },
+                  "cause": "",
+                  "causeMessage": "",
+                  "code": "UNAUTHORIZED",
+                  "message": "The request could not be completed. The session is not authorized or the credentials are invalid",
+                  "requestId": "0bfe7a04-9229-4b7a-812c-9eb3cc0eac0f"
+                },
+                "meta": {
+                  "apiEnrollmentVersion": "0.0.1",
+                  "apiVersion": "0.0.1"
+                }
+              }
+            }
+          },
+          "404": {
+            "description": "The requested resource does not exist",
+            "schema": {
+              "$ref": "#/definitions/apiErrorEnvelope"
+            },
+            "examples": {
+              "application/json": {
+                "error": {
+                  "args": {
+                    "urlVars": {
+                      "id": "71a3000f-7dda-491a-9b90-a19f4ee6c406"
+                    }
+                  },
+                  "cause": null,
+                  "causeMessage": "",
+                  "code": "NOT_FOUND",
+                  "message": "The resource requested was not found or is no longer available",
+                  "requestId": "270908d6-f2ef-4577-b973-67bec18ae376"
+                },
+                "meta": {
+                  "apiEnrollmentVersion": "0.0.1",
+                  "apiVersion": "0.0.1"
+                }
+              }
+            }
+          },
+          "429": {
+            "description": "The resource requested is rate limited and the rate limit has been exceeded",
+            "schema": {
+              "$ref": "#/definitions/apiErrorEnvelope"
+            },
+            "examples": {
+              "application/json": {
+                "error": {
+                  "args": {
+                    "urlVars": {}
+                  },
+                  "causeMessage": "you have hit a rate limit in the requested operation",
+                  "code": "RATE_LIMITED",
+                  "message": "The resource is rate limited and the rate limit has been exceeded. Please try again later",
+                  "requestId": "270908d6-f2ef-4577-b973-67bec18ae376"
+                },
+                "meta": {
+                  "apiEnrollmentVersion": "0.0.1",
+                  "apiVersion": "0.0.1"
+                }
+              }
+            }
+          }
+        }
+      },
+      "parameters": [
+        {
+          "type": "string",
+          "description": "The name of the probe",
+          "name": "name",
+          "in": "path",
+          "required": true
+        }
+      ]
+    },
+    "/quotas": {
+      "get": {
+        "description": "Retrieves a list of quota resources; supports filtering, sorting, and pagination. Requires admin access.\n",
+        "tags": [
+          "Quota"
+        ],
+        "summary": "List quotas",
+        "operationId": "listQuotas",
+        "parameters": [
+          {
+            "type": "integer",
+            "name": "limit",
+            "in": "query"
+          },
+          {
+            "type": "integer",
+            "name": "offset",
+            "in": "query"
+          },
+          {
+            "type": "string",
+            "name": "filter",
+            "in": "query"
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "A list of quotas",
+            "schema": {
+              "$ref": "#/definitions/listQuotasEnvelope"
+            }
+          },
+          "401": {
+            "description": "The currently supplied session does not have the correct access rights to request this resource",
+            "schema": {
+              "$ref": "#/definitions/apiErrorEnvelope"
+            },
+            "examples": {
+              "application/json": {
+                "error": {
+                  "args": {
+                    "urlVars": {}
+                  },
+                  "cause": "",
+                  "causeMessage": "",
+                  "code": "UNAUTHORIZED",
+                  "message": "The request could not be completed. The session is not authorized or the credentials are invalid",
+                  "requestId": "0bfe7a04-9229-4b7a-812c-9eb3cc0eac0f"
+                },
+                "meta": {
+                  "apiEnrollmentVersion": "0.0.1",
+                  "apiVersion": "0.0.1"
+                }
+              }
+            }
+          },
+          "429": {
+            "description": "The resource requested is rate limited and the rate limit has been exceeded",
+            "schema": {
+              "$ref": "#/definitions/apiErrorEnvelope"
+            },
+            "examples": {
+              "application/json": {
+                "error": {
+                  "args": {
+                    "urlVars": {}
+                  },
+                  "causeMessage": "you have hit a rate limit in the requested operation",
+                  "code": "RATE_LIMITED",
+                  "message": "The resource is rate limited and the rate limit has been exceeded. Please try again later",
+                  "requestId": "270908d6-f2ef-4577-b973-67bec18ae376"
+                },
+                "meta": {
+                  "apiEnrollmentVersion": "0.0.1",
+                  "apiVersion": "0.0.1"
+                }
+              }
+            }
+          }
+        }
+      },
+      "post": {
+        "description": "Create a quota resource. Requires admin access.",
+        "tags": [
+          "Quota"
+        ],
+        "summary": "Create a quota resource",
+        "operationId": "createQuota",
+        "parameters": [
+          {
+            "description": "A quota to create",
+            "name": "quota",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/quotaCreate"
+            }
+          }
+        ],
+        "responses": {
+          "201": {
+            "description": "The create request was successful and the resource has been added at the following location",
+            "schema": {
+              "$ref": "#/definitions/createEnvelope"
+            }
+          },
+          "400": {
+            "description": "The supplied request contains invalid fields or could not be parsed (json and non-json bodies). The error's code, message, and cause fields can be inspected for further information",
+            "schema": {
+              "$ref": "#/definitions/apiErrorEnvelope"
+            },
+            "examples": {
+              "application/json": {
+                "error": {
+                  "args": {
+                    "urlVars": {}
+                  },
+                  "cause": {
+                    "details": {
+                      "context": "(root)",
+                      "field": "(root)",
+                      "property": "fooField3"
+                    },
+                    "field": "(root)",
+                    "message": "(root): fooField3 is required",
+                    "type": "required",
+                    "value": {
+                      "fooField": "abc",
+                      "fooField2": "def"
+                    }
+                  },
+                  "causeMessage": "schema validation failed",
+                  "code": "COULD_NOT_VALIDATE",
+                  "message": "The supplied request contains an invalid document",
+                  "requestId": "ac6766d6-3a09-44b3-8d8a-1b541d97fdd9"
+                },
+                "meta": {
+                  "apiEnrollmentVersion": "0.0.1",
+                  "apiVersion": "0.0.1"
+                }
+              }
+            }
+          },
+          "401": {
+            "description": "The currently supplied session does not have the correct access rights to request this resource",
+            "schema": {
+              "$ref": "#/definitions/apiErrorEnvelope"
+            },
+            "examples": {
+              "application/json": {
+                "error": {
+                  "args": {
+                    "urlVars": {}
+                  },
+                  "cause": "",
+                  "causeMessage": "",
+                  "code": "UNAUTHORIZED",
+                  "message": "The request could not be completed. The session is not authorized or the credentials are invalid",
+                  "requestId": "0bfe7a04-9229-4b7a-812c-9eb3cc0eac0f"
+                },
+                "meta": {
+                  "apiEnrollmentVersion": "0.0.1",
+                  "apiVersion": "0.0.1"
+                }
+              }
+            }
+          },
+          "429": {
+            "description": "The resource requested is rate limited and the rate limit has been exceeded",
+            "schema": {
+              "$ref": "#/definitions/apiErrorEnvelope"
+            },
+            "examples": {
+              "application/json": {
+                "error": {
+                  "args": {
+                    "urlVars": {}
+                  },
+                  "causeMessage": "you have hit a rate limit in the requested operation",
+                  "code": "RATE_LIMITED",
+                  "message": "The resource is rate limited and the rate limit has been exceeded. Please try again later",
+                  "requestId": "270908d6-f2ef-4577-b973-67bec18ae376"
+                },
+                "meta": {
+                  "apiEnrollmentVersion": "0.0.1",
+                  "apiVersion": "0.0.1"
+                }
+              }
+            }
+          },
+          "503": {
+            "description": "The request could not be completed due to the server being busy or in a temporarily bad state",
+            "schema": {
+              "$ref": "#/definitions/apiErrorEnvelope"
+            }
+          }
+        }
+      }
+    },
+    "/quotas/{id}": {
+      "get": {
+        "description": "Retrieves a single quota by id. Requires admin access.",
+        "tags": [
+          "Quota"
+        ],
+        "summary": "Retrieves a single quota",
+        "operationId": "detailQuota",
+        "responses": {
+          "200": {
+            "description": "A single quota",
+            "schema": {
+              "$ref": "#/definitions/detailQuotaEnvelope"
+            }
+          },
+          "401": {
+            "description": "The currently supplied session does not have the correct access rights to request this resource",
+            "schema": {
+              "$ref": "#/definitions/apiErrorEnvelope"
+            },
+            "examples": {
+              "application/json": {
+                "error": {
+                  "args": {
+                    "urlVars": {}
+                  },
+                  "cause": "",
+                  "causeMessage": "",
+                  "code": "UNAUTHORIZED",
+                  "message": "The request could not be completed. The session is not authorized or the credentials are invalid",
+                  "requestId": "0bfe7a04-9229-4b7a-812c-9eb3cc0eac0f"
+                },
+                "meta": {
+                  "apiEnrollmentVersion": "0.0.1",
+                  "apiVersion": "0.0.1"
+                }
+              }
+            }
+          },
+          "404": {
+            "description": "The requested resource does not exist",
+            "schema": {
+              "$ref": "#/definitions/apiErrorEnvelope"
+            },
+            "examples": {
+              "application/json": {
+                "error": {
+                  "args": {
+                    "urlVars": {
+                      "id": "71a3000f-7dda-491a-9b90-a19f4ee6c406"
+                    }
+                  },
+                  "cause": null,
+                  "causeMessage": "",
+                  "code": "NOT_FOUND",
+                  "message": "The resource requested was not found or is no longer available",
+                  "requestId": "270908d6-f2ef-4577-b973-67bec18ae376"
+                },
+                "meta": {
+                  "apiEnrollmentVersion": "0.0.1",
+                  "apiVersion": "0.0.1"
+                }
+              }
+            }
+          },
+          "429": {
+            "description": "The resource requested is rate limited and the rate limit has been exceeded",
+            "schema": {
+              "$ref": "#/definitions/apiErrorEnvelope"
+            },
+            "examples": {
+              "application/json": {
+                "error": {
+                  "args": {
+                    "urlVars": {}
+                  },
+                  "causeMessage": "you have hit a rate limit in the requested operation",
+                  "code": "RATE_LIMITED",
+                  "message": "The resource is rate limited and the rate limit has been exceeded. Please try again later",
+                  "requestId": "270908d6-f2ef-4577-b973-67bec18ae376"
+                },
+                "meta": {
+                  "apiEnrollmentVersion": "0.0.1",
+                  "apiVersion": "0.0.1"
+                }
+              }
+            }
+          }
+        }
+      },
+      "put": {
+        "description": "Update all fields on a quota by id. Requires admin access.",
+        "tags": [
+          "Quota"
+        ],
+        "summary": "Update all fields on a quota",
+        "operationId": "updateQuota",
+        "parameters": [
+          {
+            "description": "A quota update object",
+            "name": "quota",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/quotaUpdate"
+            }
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "The update request was successful and the resource has been altered",
+            "schema": {
+              "$ref": "#/definitions/empty"
+            }
+          },
+          "400": {
+            "description": "The supplied request contains invalid fields or could not be parsed (json and non-json bodies). The error's code, message, and cause fields can be inspected for further information",
+            "schema": {
+              "$ref": "#/definitions/apiErrorEnvelope"
+            },
+            "examples": {
+              "application/json": {
+                "error": {
+                  "args": {
+                    "urlVars": {}
+                  },
+                  "cause": {
+                    "details": {
+                      "context": "(root)",
+                      "field": "(root)",
+                      "property": "fooField3"
+                    },
+                    "field": "(root)",
+                    "message": "(root): fooField3 is required",
+                    "type": "required",
+                    "value": {
+                      "fooField": "abc",
+                      "fooField2": "def"
+                    }
+                  },
+                  "causeMessage": "schema validation failed",
+                  "code": "COULD_NOT_VALIDATE",
+                  "message": "The supplied request contains an invalid document",
+                  "requestId": "ac6766d6-3a09-44b3-8d8a-1b541d97fdd9"
+                },
+                "meta": {
+                  "apiEnrollmentVersion": "0.0.1",
+                  "apiVersion": "0.0.1"
+                }
+              }
+            }
+          },
+          "401": {
+            "description": "The currently supplied session does not have the correct access rights to request this resource",
+            "schema": {
+              "$ref": "#/definitions/apiErrorEnvelope"
+            },
+            "examples": {
+              "application/json": {
+                "error": {
+                  "args": {
+                    "urlVars": {}
+                  },
+                  "cause": "",
+                  "causeMessage": "",
+                  "code": "UNAUTHORIZED",
+                  "message": "The request could not be completed. The session is not authorized or the credentials are invalid",
+                  "requestId": "0bfe7a04-9229-4b7a-812c-9eb3cc0eac0f"
+                },
+                "meta": {
+                  "apiEnrollmentVersion": "0.0.1",
+                  "apiVersion": "0.0.1"
+                }
+              }
+            }
+          },
+          "404": {
+            "description": "The requested resource does not exist",
+            "schema": {
+              "$ref": "#/definitions/apiErrorEnvelope"
+            },
+            "examples": {
+              "application/json": {
+                "error": {
+                  "args": {
+                    "urlVars": {
+                      "id": "71a3000f-7dda-491a-9b90-a19f4ee6c406"
+                    }
+                  },
+                  "cause": null,
+                  "causeMessage": "",
+                  "code": "NOT_FOUND",
+                  "message": "The resource requested was not found or is no longer available",
+                  "requestId": "270908d6-f2ef-4577-b973-67bec18ae376"
+                },
+                "meta": {
+                  "apiEnrollmentVersion": "0.0.1",
+                  "apiVersion": "0.0.1"
+                }
+              }
+            }
+          },
+          "429": {
+            "description": "The resource requested is rate limited and the rate limit has been exceeded",
+            "schema": {
+              "$ref": "#/definitions/apiErrorEnvelope"
+            },
+            "examples": {
+              "application/json": {
+                "error": {
+                  "args": {
+                    "urlVars": {}
+                  },
+                  "causeMessage": "you have hit a rate limit in the requested operation",
+                  "code": "RATE_LIMITED",
+                  "message": "The resource is rate limited and the rate limit has been exceeded. Please try again later",
+                  "requestId": "270908d6-f2ef-4577-b973-67bec18ae376"
+                },
+                "meta": {
+                  "apiEnrollmentVersion": "0.0.1",
+                  "apiVersion": "0.0.1"
+                }
+              }
+            }
+          },
+          "503": {
+            "description": "The request could not be completed due to the server being busy or in a temporarily bad state",
+            "schema": {
+              "$ref": "#/definitions/apiErrorEnvelope"
+            }
+          }
+        }
+      },
+      "delete": {
+        "description": "Delete a quota by id. Requires admin access.",
+        "tags": [
+          "Quota"
+        ],
+        "summary": "Delete a quota",
+        "operationId": "deleteQuota",
+        "responses": {
+          "200": {
+            "description": "The delete request was successful and the resource has been removed",
+            "schema": {
+              "$ref": "#/definitions/empty"
+            }
+          },
+          "400": {
+            "description": "The supplied request contains invalid fields or could not be parsed (json and non-json bodies). The error's code, message, and cause fields can be inspected for further information",
+            "schema": {
+              "$ref": "#/definitions/apiErrorEnvelope"
+            },
+            "examples": {
+              "application/json": {
+                "error": {
+                  "args": {
+                    "urlVars": {}
+                  },
+                  "cause": {
+                    "details": {
+                      "context": "(root)",
+                      "field": "(root)",
+                      "property": "fooField3"
+                    },
+                    "field": "(root)",
+                    "message": "(root): fooField3 is required",
+                    "type": "required",
+                    "value": {
+                      "fooField": "abc",
+                      "fooField2": "def"
+                    }
+                  },
+                  "causeMessage": "schema validation failed",
+                  "code": "COULD_NOT_VALIDATE",
+                  "message": "The supplied request contains an invalid document",
+                  "requestId": "ac6766d6-3a09-44b3-8d8a-1b541d97fdd9"
+                },
+                "meta": {
+                  "apiEnrollmentVersion": "0.0.1",
+                  "apiVersion": "0.0.1"
+                }
+              }
+            }
+          },
+          "401": {
+            "description": "The currently supplied session does not have the correct access rights to request this resource",
+            "schema": {
+              "$ref": "#/definitions/apiErrorEnvelope"
+            },
+            "examples": {
+              "application/json": {
+                "error": {
+                  "args": {
+                    "urlVars": {}
+                  },
+                  "cause": "",
+                  "causeMessage": "",
+                  "code": "UNAUTHORIZED",
+                  "message": "The request could not be completed. The session is not authorized or the credentials are invalid",
+                  "requestId": "0bfe7a04-9229-4b7a-812c-9eb3cc0eac0f"
+                },
+                "meta": {
+                  "apiEnrollmentVersion": "0.0.1",
+                  "apiVersion": "0.0.1"
+                }
+              }
+            }
+          },
+          "429": {
+            "description": "The resource requested is rate limited and the rate limit has been exceeded",
+            "schema": {
+              "$ref": "#/definitions/apiErrorEnvelope"
+            },
+            "examples": {
+              "application/json": {
+                "error": {
+                  "args": {
+                    "urlVars": {}
+                  },
+                  "causeMessage": "you have hit a rate limit in the requested operation",
+                  "code": "RATE_LIMITED",
+                  "message": "The resource is rate limited and the rate limit has been exceeded. Please try again later",
+                  "requestId": "270908d6-f2ef-4577-b973-67bec18ae376"
+                },
+                "meta": {
+                  "apiEnrollmentVersion": "0.0.1",
+                  "apiVersion": "0.0.1"
+                }
+              }
+            }
+          },
+          "503": {
+            "description": "The request could not be completed due to the server being busy or in a temporarily bad state",
+            "schema": {
+              "$ref": "#/definitions/apiErrorEnvelope"
+            }
+          }
+        }
+      },
+      "patch": {
+        "description": "Update the supplied fields on a quota. Requires admin access.",
+        "tags": [
+          "Quota"
+        ],
+        "summary": "Update the supplied fields on a quota",
+        "operationId": "patchQuota",
+        "parameters": [
+          {
+            "description": "A quota patch object",
+            "name": "quota",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/quotaPatch"
+            }
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "The patch request was successful and the resource has been altered",
+            "schema": {
+              "$ref": "#/definitions/empty"
+            }
+          },
+          "400": {
+            "description": "The supplied request contains invalid fields or could not be parsed (json and non-json bodies). The error's code, message, and cause fields can be inspected for further information",
+            "schema": {
+              "$ref": "#/definitions/apiErrorEnvelope"
+            },
+            "examples": {
+              "application/json": {
+                "error": {
+                  "args": {
+                    "urlVars": {}
+                  },
+                  "cause": {
+                    "details": {
+                      "context": "(root)",
+                      "field": "(root)",
+                      "property": "fooField3"
+                    },
+                    "field": "(root)",
+                    "message": "(root): fooField3 is required",
+                    "type": "required",
+                    "value": {
+                      "fooField": "abc",
+                      "fooField2": "def"
+                    }
+                  },
+                  "causeMessage": "schema validation failed",
+                  "code": "COULD_NOT_VALIDATE",
+                  "message": "The supplied request contains an invalid document",
+                  "requestId": "ac6766d6-3a09-44b3-8d8a-1b541d97fdd9"
+                },
+                "meta": {
+                  "apiEnrollmentVersion": "0.0.1",
+                  "apiVersion": "0.0.1"
+                }
+              }
+            }
+          },
+          "401": {
+            "description": "The currently supplied session does not have the correct access rights to request this resource",
+            "schema": {
+              "$ref": "#/definitions/apiErrorEnvelope"
+            },
+            "examples": {
+              "application/json": {
+                "error": {
+                  "args": {
+                    "urlVars": {}
+                  },
+                  "cause": "",
+                  "causeMessage": "",
+                  "code": "UNAUTHORIZED",
+                  "message": "The request could not be completed. The session is not authorized or the credentials are invalid",
+                  "requestId": "0bfe7a04-9229-4b7a-812c-9eb3cc0eac0f"
+                },
+                "meta": {
+                  "apiEnrollmentVersion": "0.0.1",
+                  "apiVersion": "0.0.1"
+                }
+              }
+            }
+          },
+          "404": {
+            "description": "The requested resource does not exist",
+            "schema": {
+              "$ref": "#/definitions/apiErrorEnvelope"
+            },
+            "examples": {
+              "application/json": {
+                "error": {
+                  "args": {
+                    "urlVars": {
+                      "id": "71a3000f-7dda-491a-9b90-a19f4ee6c406"
+                    }
+                  },
+                  "cause": null,
+                  "causeMessage": "",
+                  "code": "NOT_FOUND",
+                  "message": "The resource requested was not found or is no longer available",
+                  "requestId": "270908d6-f2ef-4577-b973-67bec18ae376"
+                },
+                "meta": {
+                  "apiEnrollmentVersion": "0.0.1",
+                  "apiVersion": "0.0.1"
+                }
+              }
+            }
+          },
+          "429": {
+            "description": "The resource requested is rate limited and the rate limit has been exceeded",
+            "schema": {
+              "$ref": "#/definitions/apiErrorEnvelope"
+            },
+            "examples": {
+              "application/json": {
+                "error": {
+                  "args": {
+                    "urlVars": {}
+                  },
+                  "causeMessage": "you have hit a rate limit in the requested operation",
+                  "code": "RATE_LIMITED",
+                  "message": "The resource is rate limited and the rate limit has been exceeded. Please try again later",
+                  "requestId": "270908d6-f2ef-4577-b973-67bec18ae376"
+                },
+                "meta": {
+                  "apiEnrollmentVersion": "0.0.1",
+                  "apiVersion": "0.0.1"
+                }
+              }
+            }
+          },
+          "503": {
+            "description": "The request could not be completed due to the server being busy or in a temporarily bad state",
+            "schema": {
+              "$ref": "#/definitions/apiErrorEnvelope"
+            }
+          }
+        }
+      },
+      "parameters": [
+        {
+          "type": "string",
+          "description": "The id of the requested resource",
+          "name": "id",
+          "in": "path",
+          "required": true
+        }
+      ]
+    },
+    "/quotas/{id}/usage": {
+      "get": {
+        "description": "Retrieves the consumption of each identity or service tracked by a quota for the current period. Requires\nadmin access.\n",
+        "tags": [
+          "Quota"
+        ],
+        "summary": "Retrieves the current consumption of a quota",
+        "operationId": "listQuotaUsage",
+        "responses": {
+          "200": {
+            "description": "The current consumption of a quota",
+            "schema": {
+              "$ref": "#/definitions/listQuotaUsageEnvelope"
+            }
+          },
+          "401": {
+            "description": "The currently supplied session does not have the correct access rights to request this resource",
+            "schema": {
+              "$ref": "#/definitions/apiErrorEnvelope"
+            },
+            "examples": {
+              "application/json": {
+                "error": {
+                  "args": {
+                    "urlVars": {}
+                  },
+                  "cause": "",
+                  "causeMessage": "",
+                  "code": "UNAUTHORIZED",
+                  "message": "The request could not be completed. The session is not authorized or the credentials are invalid",
+                  "requestId": "0bfe7a04-9229-4b7a-812c-9eb3cc0eac0f"
+                },
+                "meta": {
+                  "apiEnrollmentVersion": "0.0.1",
+                  "apiVersion": "0.0.1"
+                }
+              }
+            }
+          },
+          "404": {
+            "description": "The requested resource does not exist",
+            "schema": {
+              "$ref": "#/definitions/apiErrorEnvelope"
+            },
+            "examples": {
+              "application/json": {
+                "error": {
+                  "args": {
+                    "urlVars": {
+                      "id": "71a3000f-7dda-491a-9b90-a19f4ee6c406"
+                    }
+                  },
+                  "cause": null,
+                  "causeMessage": "",
+                  "code": "NOT_FOUND",
+                  "message": "The resource requested was not found or is no longer available",
+                  "requestId": "270908d6-f2ef-4577-b973-67bec18ae376"
+                },
+                "meta": {
+                  "apiEnrollmentVersion": "0.0.1",
+                  "apiVersion": "0.0.1"
+                }
+              }
+            }
+          },
+          "429": {
+            "description": "The resource requested is rate limited and the rate limit has been exceeded",
+            "schema": {
+              "$ref": "#/definitions/apiErrorEnvelope"
+            },
+            "examples": {
+              "application/json": {
+                "error": {
+                  "args": {
+                    "urlVars": {}
+                  },
+                  "causeMessage": "you have hit a rate limit in the requested operation",
+                  "code": "RATE_LIMITED",
+                  "message": "The resource is rate limited and the rate limit has been exceeded. Please try again later",
+                  "requestId": "270908d6-f2ef-4577-b973-67bec18ae376"
+                },
+                "meta": {
+                  "apiEnrollmentVersion": "0.0.1",
+                  "apiVersion": "0.0.1"
+                }
+              }
+            }
+          }
+        }
+      },
+      "parameters": [
+        {
+          "type": "string",
+          "description": "The id of the requested resource",
+          "name": "id",
+          "in": "path",
+          "required": true
+        }
+      ]
+    },
+    "/roles": {
+      "get": {
+        "description": "Retrieves a list of role resources; supports filtering, sorting, and pagination. Requires admin access.\n",
+        "tags": [
+          "Role"
+        ],
+        "summary": "List roles",
+        "operationId": "listRoles",
+        "parameters": [
+          {
+            "type": "integer",
+            "name": "limit",
+            "in": "query"
+          },
+          {
+            "type": "integer",
+            "name": "offset",
+            "in": "query"
+          },
+          {
+            "type": "string",
+            "name": "filter",
+            "in": "query"
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "A list of roles",
+            "schema": {
+              "$ref": "#/definitions/listRolesEnvelope"
+            }
+          },
+          "401": {
+            "description": "The currently supplied session does not have the correct access rights to request this resource",
+            "schema": {
+              "$ref": "#/definitions/apiErrorEnvelope"
+            },
+            "examples": {
+              "application/json": {
+                "error": {
+                  "args": {
+                    "urlVars": {}
+                  },
+                  "cause": "",
+                  "causeMessage": "",
+                  "code": "UNAUTHORIZED",
+                  "message": "The request could not be completed. The session is not authorized or the credentials are invalid",
+                  "requestId": "0bfe7a04-9229-4b7a-812c-9eb3cc0eac0f"
+                },
+                "meta": {
+                  "apiEnrollmentVersion": "0.0.1",
+                  "apiVersion": "0.0.1"
+                }
+              }
+            }
+          },
+          "429": {
+            "description": "The resource requested is rate limited and the rate limit has been exceeded",
+            "schema": {
+              "$ref": "#/definitions/apiErrorEnvelope"
+            },
+            "examples": {
+              "application/json": {
+                "error": {
+                  "args": {
+                    "urlVars": {}
+                  },
+                  "causeMessage": "you have hit a rate limit in the requested operation",
+                  "code": "RATE_LIMITED",
+                  "message": "The resource is rate limited and the rate limit has been exceeded. Please try again later",
+                  "requestId": "270908d6-f2ef-4577-b973-67bec18ae376"
+                },
+                "meta": {
+                  "apiEnrollmentVersion": "0.0.1",
+                  "apiVersion": "0.0.1"
+                }
+              }
+            }
+          }
+        }
+      },
+      "post": {
+        "description": "Create a role resource. Requires admin access.",
+        "tags": [
+          "Role"
+        ],
+        "summary": "Create a role resource",
+        "operationId": "createRole",
+        "parameters": [
+          {
+            "description": "A role to create",
+            "name": "role",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/roleCreate"
+            }
+          }
+        ],
+        "responses": {
+          "201": {
+            "description": "The create request was successful and the resource has been added at the following location",
+            "schema": {
+              "$ref": "#/definitions/createEnvelope"
+            }
+          },
+          "400": {
+            "description": "The supplied request contains invalid fields or could not be parsed (json and non-json bodies). The error's code, message, and cause fields can be inspected for further information",
+            "schema": {
+              "$ref": "#/definitions/apiErrorEnvelope"
+            },
+            "examples": {
+              "application/json": {
+                "error": {
+                  "args": {
+                    "urlVars": {}
+                  },
+                  "cause": {
+                    "details": {
+                      "context": "(root)",
+                      "field": "(root)",
+                      "property": "fooField3"
+                    },
+                    "field": "(root)",
+                    "message": "(root): fooField3 is required",
+                    "type": "required",
+                    "value": {
+                      "fooField": "abc",
+                      "fooField2": "def"
+                    }
+                  },
+                  "causeMessage": "schema validation failed",
+                  "code": "COULD_NOT_VALIDATE",
+                  "message": "The supplied request contains an invalid document",
+                  "requestId": "ac6766d6-3a09-44b3-8d8a-1b541d97fdd9"
+                },
+                "meta": {
+                  "apiEnrollmentVersion": "0.0.1",
+                  "apiVersion": "0.0.1"
+                }
+              }
+            }
+          },
+          "401": {
+            "description": "The currently supplied session does not have the correct access rights to request this resource",
+            "schema": {
+              "$ref": "#/definitions/apiErrorEnvelope"
+            },
+            "examples": {
+              "application/json": {
+                "error": {
+                  "args": {
+                    "urlVars": {}
+                  },
+                  "cause": "",
+                  "causeMessage": "",
+                  "code": "UNAUTHORIZED",
+                  "message": "The request could not be completed. The session is not authorized or the credentials are invalid",
+                  "requestId": "0bfe7a04-9229-4b7a-812c-9eb3cc0eac0f"
+                },
+                "meta": {
+                  "apiEnrollmentVersion": "0.0.1",
+                  "apiVersion": "0.0.1"
+                }
+              }
+            }
+          },
+          "429": {
+            "description": "The resource requested is rate limited and the rate limit has been exceeded",
+            "schema": {
+              "$ref": "#/definitions/apiErrorEnvelope"
+            },
+            "examples": {
+              "application/json": {
+                "error": {
+                  "args": {
+                    "urlVars": {}
+                  },
+                  "causeMessage": "you have hit a rate limit in the requested operation",
+                  "code": "RATE_LIMITED",
+                  "message": "The resource is rate limited and the rate limit has been exceeded. Please try again later",
+                  "requestId": "270908d6-f2ef-4577-b973-67bec18ae376"
+                },
+                "meta": {
+                  "apiEnrollmentVersion": "0.0.1",
+                  "apiVersion": "0.0.1"
+                }
+              }
+            }
+          },
+          "503": {
+            "description": "The request could not be completed due to the server being busy or in a temporarily bad state",
+            "schema": {
+              "$ref": "#/definitions/apiErrorEnvelope"
+            }
+          }
+        }
+      }
+    },
+    "/roles/{id}": {
+      "get": {
+        "description": "Retrieves a single role by id. Requires admin access.",
+        "tags": [
+          "Role"
+        ],
+        "summary": "Retrieves a single role",
+        "operationId": "detailRole",
+        "responses": {
+          "200": {
+            "description": "A single role",
+            "schema": {
+              "$ref": "#/definitions/detailRoleEnvelope"
+            }
+          },
+          "401": {
+            "description": "The currently supplied session does not have the correct access rights to request this resource",
+            "schema": {
+              "$ref": "#/definitions/apiErrorEnvelope"
+            },
+            "examples": {
+              "application/json": {
+                "error": {
+                  "args": {
+                    "urlVars": {}
+                  },
+                  "cause": "",
+                  "causeMessage": "",
+                  "code": "UNAUTHORIZED",
+                  "message": "The request could not be completed. The session is not authorized or the credentials are invalid",
+                  "requestId": "0bfe7a04-9229-4b7a-812c-9eb3cc0eac0f"
+                },
+                "meta": {
+                  "apiEnrollmentVersion": "0.0.1",
+                  "apiVersion": "0.0.1"
+                }
+              }
+            }
+          },
+          "404": {
+            "description": "The requested resource does not exist",
+            "schema": {
+              "$ref": "#/definitions/apiErrorEnvelope"
+            },
+            "examples": {
+              "application/json": {
+                "error": {
+                  "args": {
+                    "urlVars": {
+                      "id": "71a3000f-7dda-491a-9b90-a19f4ee6c406"
+                    }
+                  },
+                  "cause": null,
+                  "causeMessage": "",
+                  "code": "NOT_FOUND",
+                  "message": "The resource requested was not found or is no longer available",
+                  "requestId": "270908d6-f2ef-4577-b973-67bec18ae376"
+                },
+                "meta": {
+                  "apiEnrollmentVersion": "0.0.1",
+                  "apiVersion": "0.0.1"
+                }
+              }
+            }
+          },
+          "429": {
+            "description": "The resource requested is rate limited and the rate limit has been exceeded",
+            "schema": {
+              "$ref": "#/definitions/apiErrorEnvelope"
+            },
+            "examples": {
+              "application/json": {
+                "error": {
+                  "args": {
+                    "urlVars": {}
+                  },
+                  "causeMessage": "you have hit a rate limit in the requested operation",
+                  "code": "RATE_LIMITED",
+                  "message": "The resource is rate limited and the rate limit has been exceeded. Please try again later",
+                  "requestId": "270908d6-f2ef-4577-b973-67bec18ae376"
+                },
+                "meta": {
+                  "apiEnrollmentVersion": "0.0.1",
+                  "apiVersion": "0.0.1"
+                }
+              }
+            }
+          }
+        }
+      },
+      "put": {
+        "description": "Update all fields on a role by id. Requires admin access.",
+        "tags": [
+          "Role"
+        ],
+        "summary": "Update all fields on a role",
+        "operationId": "updateRole",
+        "parameters": [
+          {
+            "description": "A role update object",
+            "name": "role",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/roleUpdate"
+            }
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "The update request was successful and the resource has been altered",
+            "schema": {
+              "$ref": "#/definitions/empty"
+            }
+          },
+          "400": {
+            "description": "The supplied request contains invalid fields or could not be parsed (json and non-json bodies). The error's code, message, and cause fields can be inspected for further information",
+            "schema": {
+              "$ref": "#/definitions/apiErrorEnvelope"
+            },
+            "examples": {
+              "application/json": {
+                "error": {
+                  "args": {
+                    "urlVars": {}
+                  },
+                  "cause": {
+                    "details": {
+                      "context": "(root)",
+                      "field": "(root)",
+                      "property": "fooField3"
+                    },
+                    "field": "(root)",
+                    "message": "(root): fooField3 is required",
+                    "type": "required",
+                    "value": {
+                      "fooField": "abc",
+                      "fooField2": "def"
+                    }
+                  },
+                  "causeMessage": "schema validation failed",
+                  "code": "COULD_NOT_VALIDATE",
+                  "message": "The supplied request contains an invalid document",
+                  "requestId": "ac6766d6-3a09-44b3-8d8a-1b541d97fdd9"
+                },
+                "meta": {
+                  "apiEnrollmentVersion": "0.0.1",
+                  "apiVersion": "0.0.1"
+                }
+              }
+            }
+          },
+          "401": {
+            "description": "The currently supplied session does not have the correct access rights to request this resource",
+            "schema": {
+              "$ref": "#/definitions/apiErrorEnvelope"
+            },
+            "examples": {
+              "application/json": {
+                "error": {
+                  "args": {
+                    "urlVars": {}
+                  },
+                  "cause": "",
+                  "causeMessage": "",
+                  "code": "UNAUTHORIZED",
+                  "message": "The request could not be completed. The session is not authorized or the credentials are invalid",
+                  "requestId": "0bfe7a04-9229-4b7a-812c-9eb3cc0eac0f"
+                },
+                "meta": {
+                  "apiEnrollmentVersion": "0.0.1",
+                  "apiVersion": "0.0.1"
+                }
+              }
+            }
+          },
+          "404": {
+            "description": "The requested resource does not exist",
+            "schema": {
+              "$ref": "#/definitions/apiErrorEnvelope"
+            },
+            "examples": {
+              "application/json": {
+                "error": {
+                  "args": {
+                    "urlVars": {
+                      "id": "71a3000f-7dda-491a-9b90-a19f4ee6c406"
+                    }
+                  },
+                  "cause": null,
+                  "causeMessage": "",
+                  "code": "NOT_FOUND",
+                  "message": "The resource requested was not found or is no longer available",
+                  "requestId": "270908d6-f2ef-4577-b973-67bec18ae376"
+                },
+                "meta": {
+                  "apiEnrollmentVersion": "0.0.1",
+                  "apiVersion": "0.0.1"
+                }
+              }
+            }
+          },
+          "429": {
+            "description": "The resource requested is rate limited and the rate limit has been exceeded",
+            "schema": {
+              "$ref": "#/definitions/apiErrorEnvelope"
+            },
+            "examples": {
+              "application/json": {
+                "error": {
+                  "args": {
+                    "urlVars": {}
+                  },
+                  "causeMessage": "you have hit a rate limit in the requested operation",
+                  "code": "RATE_LIMITED",
+                  "message": "The resource is rate limited and the rate limit has been exceeded. Please try again later",
+                  "requestId": "270908d6-f2ef-4577-b973-67bec18ae376"
+                },
+                "meta": {
+                  "apiEnrollmentVersion": "0.0.1",
+                  "apiVersion": "0.0.1"
+                }
+              }
+            }
+          },
+          "503": {
+            "description": "The request could not be completed due to the server being busy or in a temporarily bad state",
+            "schema": {
+              "$ref": "#/definitions/apiErrorEnvelope"
+            }
+          }
+        }
+      },
+      "delete": {
+        "description": "Delete a role by id. Requires admin access.",
+        "tags": [
+          "Role"
+        ],
+        "summary": "Delete a role",
+        "operationId": "deleteRole",
+        "responses": {
+          "200": {
+            "description": "The delete request was successful and the resource has been removed",
+            "schema": {
+              "$ref": "#/definitions/empty"
+            }
+          },
+          "400": {
+            "description": "The supplied request contains invalid fields or could not be parsed (json and non-json bodies). The error's code, message, and cause fields can be inspected for further information",
+            "schema": {
+              "$ref": "#/definitions/apiErrorEnvelope"
+            },
+            "examples": {
+              "application/json": {
+                "error": {
+                  "args": {
+                    "urlVars": {}
+                  },
+                  "cause": {
+                    "details": {
+                      "context": "(root)",
+                      "field": "(root)",
+                      "property": "fooField3"
+                    },
+                    "field": "(root)",
+                    "message": "(root): fooField3 is required",
+                    "type": "required",
+                    "value": {
+                      "fooField": "abc",
+                      "fooField2": "def"
+                    }
+                  },
+                  "causeMessage": "schema validation failed",
+                  "code": "COULD_NOT_VALIDATE",
+                  "message": "The supplied request contains an invalid document",
+                  "requestId": "ac6766d6-3a09-44b3-8d8a-1b541d97fdd9"
+                },
+                "meta": {
+                  "apiEnrollmentVersion": "0.0.1",
+                  "apiVersion": "0.0.1"
+                }
+              }
+            }
+          },
+          "401": {
+            "description": "The currently supplied session does not have the correct access rights to request this resource",
+            "schema": {
+              "$ref": "#/definitions/apiErrorEnvelope"
+            },
+            "examples": {
+              "application/json": {
+                "error": {
+                  "args": {
+                    "urlVars": {}
+                  },
+                  "cause": "",
+                  "causeMessage": "",
+                  "code": "UNAUTHORIZED",
+                  "message": "The request could not be completed. The session is not authorized or the credentials are invalid",
+                  "requestId": "0bfe7a04-9229-4b7a-812c-9eb3cc0eac0f"
+                },
+                "meta": {
+                  "apiEnrollmentVersion": "0.0.1",
+                  "apiVersion": "0.0.1"
+                }
+              }
+            }
+          },
+          "429": {
+            "description": "The resource requested is rate limited and the rate limit has been exceeded",
+            "schema": {
+              "$ref": "#/definitions/apiErrorEnvelope"
+            },
+            "examples": {
+              "application/json": {
+                "error": {
+                  "args": {
+                    "urlVars": {}
+                  },
+                  "causeMessage": "you have hit a rate limit in the requested operation",
+                  "code": "RATE_LIMITED",
+                  "message": "The resource is rate limited and the rate limit has been exceeded. Please try again later",
+                  "requestId": "270908d6-f2ef-4577-b973-67bec18ae376"
+                },
+                "meta": {
+                  "apiEnrollmentVersion": "0.0.1",
+                  "apiVersion": "0.0.1"
+                }
+              }
+            }
+          },
+          "503": {
+            "description": "The request could not be completed due to the server being busy or in a temporarily bad state",
+            "schema": {
+              "$ref": "#/definitions/apiErrorEnvelope"
+            }
+          }
+        }
+      },
+      "patch": {
+        "description": "Update the supplied fields on a role. Requires admin access.",
+        "tags": [
+          "Role"
+        ],
+        "summary": "Update the supplied fields on a role",
+        "operationId": "patchRole",
+        "parameters": [
+          {
+            "description": "A role patch object",
+            "name": "role",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/rolePatch"
+            }
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "The patch request was successful and the resource has been altered",
+            "schema": {
+              "$ref": "#/definitions/empty"
+            }
+          },
+          "400": {
+            "description": "The supplied request contains invalid fields or could not be parsed (json and non-json bodies). The error's code, message, and cause fields can be inspected for further information",
+            "schema": {
+              "$ref": "#/definitions/apiErrorEnvelope"
+            },
+            "examples": {
+              "application/json": {
+                "error": {
+                  "args": {
+                    "urlVars": {}
+                  },
+                  "cause": {
+                    "details": {
+                      "context": "(root)",
+                      "field": "(root)",
+                      "property": "fooField3"
+                    },
+                    "field": "(root)",
+                    "message": "(root): fooField3 is required",
+                    "type": "required",
+                    "value": {
+                      "fooField": "abc",
+                      "fooField2": "def"
+                    }
+                  },
+                  "causeMessage": "schema validation failed",
+                  "code": "COULD_NOT_VALIDATE",
+                  "message": "The supplied request contains an invalid document",
+                  "requestId": "ac6766d6-3a09-44b3-8d8a-1b541d97fdd9"
+                },
+                "meta": {
+                  "apiEnrollmentVersion": "0.0.1",
+                  "apiVersion": "0.0.1"
+                }
+              }
+            }
+          },
+          "401": {
+            "description": "The currently supplied session does not have the correct access rights to request this resource",
+            "schema": {
+              "$ref": "#/definitions/apiErrorEnvelope"
+            },
+            "examples": {
+              "application/json": {
+                "error": {
+                  "args": {
+                    "urlVars": {}
+                  },
+                  "cause": "",
+                  "causeMessage": "",
+                  "code": "UNAUTHORIZED",
+                  "message": "The request could not be completed. The session is not authorized or the credentials are invalid",
+                  "requestId": "0bfe7a04-9229-4b7a-812c-9eb3cc0eac0f"
+                },
+                "meta": {
+                  "apiEnrollmentVersion": "0.0.1",
+                  "apiVersion": "0.0.1"
+                }
+              }
+            }
+          },
+          "404": {
+            "description": "The requested resource does not exist",
+            "schema": {
+              "$ref": "#/definitions/apiErrorEnvelope"
+            },
+            "examples": {
+              "application/json": {
+                "error": {
+                  "args": {
+                    "urlVars": {
+                      "id": "71a3000f-7dda-491a-9b90-a19f4ee6c406"
+                    }
+                  },
+                  "cause": null,
+                  "causeMessage": "",
+                  "code": "NOT_FOUND",
+                  "message": "The resource requested was not found or is no longer available",
+                  "requestId": "270908d6-f2ef-4577-b973-67bec18ae376"
+                },
+                "meta": {
+                  "apiEnrollmentVersion": "0.0.1",
+                  "apiVersion": "0.0.1"
+                }
+              }
+            }
+          },
+          "429": {
+            "description": "The resource requested is rate limited and the rate limit has been exceeded",
+            "schema": {
+              "$ref": "#/definitions/apiErrorEnvelope"
+            },
+            "examples": {
+              "application/json": {
+                "error": {
+                  "args": {
+                    "urlVars": {}
+                  },
+                  "causeMessage": "you have hit a rate limit in the requested operation",
+                  "code": "RATE_LIMITED",
+                  "message": "The resource is rate limited and the rate limit has been exceeded. Please try again later",
+                  "requestId": "270908d6-f2ef-4577-b973-67bec18ae376"
+                },
+                "meta": {
+                  "apiEnrollmentVersion": "0.0.1",
+                  "apiVersion": "0.0.1"
+                }
+              }
+            }
+          },
+          "503": {
+            "description": "The request could not be completed due to the server being busy or in a temporarily bad state",
+            "schema": {
+              "$ref": "#/definitions/apiErrorEnvelope"
+            }
+          }
+        }
+      },
+      "parameters": [
+        {
+          "type": "string",
+          "description": "The id of the requested resource",
+          "name": "id",
+          "in": "path",
+          "required": true
+        }
+      ]
+    },
+    "/router-cert-rotations": {
+      "get": {
+        "description": "Retrieves the router certificate rotation campaigns started on this controller, most recent first. Only the\nmost recent campaigns are retained. Requires admin access.\n",
+        "tags": [
+          "Router Cert Rotation"
+        ],
+        "summary": "List router certificate rotations",
+        "operationId": "listRouterCertRotations",
+        "responses": {
+          "200": {
+            "description": "A list of router certificate rotations",
+            "schema": {
+              "$ref": "#/definitions/listRouterCertRotationsEnvelope"
+            }
+          },
+          "401": {
+            "description": "The currently supplied session does not have the correct access rights to request this resource",
+            "schema": {
+              "$ref": "#/definitions/apiErrorEnvelope"
+            },
+            "examples": {
+              "application/json": {
+                "error": {
+                  "args": {
+                    "urlVars": {}
+                  },
+                  "cause": "",
+                  "causeMessage": "",
+                  "code": "UNAUTHORIZED",
+                  "message": "The request could not be completed. The session is not authorized or the credentials are invalid",
+                  "requestId": "0bfe7a04-9229-4b7a-812c-9eb3cc0eac0f"
+                },
+                "meta": {
+                  "apiEnrollmentVersion": "0.0.1",
+                  "apiVersion": "0.0.1"
+                }
+              }
+            }
+          },
+          "429": {
+            "description": "The resource requested is rate limited and the rate limit has been exceeded",
+            "schema": {
+              "$ref": "#/definitions/apiErrorEnvelope"
+            },
+            "examples": {
+              "application/json": {
+                "error": {
+                  "args": {
+                    "urlVars": {}
+                  },
+                  "causeMessage": "you have hit a rate limit in the requested operation",
+                  "code": "RATE_LIMITED",
+                  "message": "The resource is rate limited and the rate limit has been exceeded. Please try again later",
+                  "requestId": "270908d6-f2ef-4577-b973-67bec18ae376"
+                },
+                "meta": {
+                  "apiEnrollmentVersion": "0.0.1",
+                  "apiVersion": "0.0.1"
+                }
+              }
+            }
+          }
+        }
+      },
+      "post": {
+        "description": "Asks the routers selected by id or by filter to renew their certificates, a batch at a time. A router has\nrotated its certificates once its fingerprint changes. Only routers connected to this controller can be\nrotated. The campaign runs in the background. Its progress can be retrieved using the returned id. Requires\nadmin access.\n",
+        "tags": [
+          "Router Cert Rotation"
+        ],
+        "summary": "Start a router certificate rotation",
+        "operationId": "createRouterCertRotation",
+        "parameters": [
+          {
+            "description": "The routers to rotate and how to proceed",
+            "name": "rotation",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/routerCertRotationCreate"
+            }
+          }
+        ],
+        "responses": {
+          "202": {
+            "description": "The progress of a router certificate rotation",
+            "schema": {
+              "$ref": "#/definitions/routerCertRotationEnvelope"
+            }
+          },
+          "400": {
+            "description": "The supplied request contains invalid fields or could not be parsed (json and non-json bodies). The error's code, message, and cause fields can be inspected for further information",
+            "schema": {
+              "$ref": "#/definitions/apiErrorEnvelope"
+            },
+            "examples": {
+              "application/json": {
+                "error": {
+                  "args": {
+                    "urlVars": {}
+                  },
+                  "cause": {
+                    "details": {
+                      "context": "(root)",
+                      "field": "(root)",
+                      "property": "fooField3"
+                    },
+                    "field": "(root)",
+                    "message": "(root): fooField3 is required",
+                    "type": "required",
+                    "value": {
+                      "fooField": "abc",
+                      "fooField2": "def"
+                    }
+                  },
+                  "causeMessage": "schema validation failed",
+                  "code": "COULD_NOT_VALIDATE",
+                  "message": "The supplied request contains an invalid document",
+                  "requestId": "ac6766d6-3a09-44b3-8d8a-1b541d97fdd9"
+                },
+                "meta": {
+                  "apiEnrollmentVersion": "0.0.1",
+                  "apiVersion": "0.0.1"
+                }
+              }
+            }
+          },
+          "401": {
+            "description": "The currently supplied session does not have the correct access rights to request this resource",
+            "schema": {
+              "$ref": "#/definitions/apiErrorEnvelope"
+            },
+            "examples": {
+              "application/json": {
+                "error": {
+                  "args": {
+                    "urlVars": {}
+                  },
+                  "cause": "",
+                  "causeMessage": "",
+                  "code": "UNAUTHORIZED",
+                  "message": "The request could not be completed. The session is not authorized or the credentials are invalid",
+                  "requestId": "0bfe7a04-9229-4b7a-812c-9eb3cc0eac0f"
+                },
+                "meta": {
+                  "apiEnrollmentVersion": "0.0.1",
+                  "apiVersion": "0.0.1"
+                }
+              }
+            }
+          },
+          "429": {
+            "description": "The resource requested is rate limited and the rate limit has been exceeded",
+            "schema": {
+              "$ref": "#/definitions/apiErrorEnvelope"
+            },
+            "examples": {
+              "application/json": {
+                "error": {
+                  "args": {
+                    "urlVars": {}
+                  },
+                  "causeMessage": "you have hit a rate limit in the requested operation",
+                  "code": "RATE_LIMITED",
+                  "message": "The resource is rate limited and the rate limit has been exceeded. Please try again later",
+                  "requestId": "270908d6-f2ef-4577-b973-67bec18ae376"
+                },
+                "meta": {
+                  "apiEnrollmentVersion": "0.0.1",
+                  "apiVersion": "0.0.1"
+                }
+              }
+            }
+          }
+        }
+      }
+    },
+    "/router-cert-rotations/{id}": {
+      "get": {
+        "description": "Retrieves the progress of a router certificate rotation, including the status of each router. Requires admin access.",
+        "tags": [
+          "Router Cert Rotation"
+        ],
+        "summary": "Retrieves the progress of a router certificate rotation",
+        "operationId": "detailRouterCertRotation",
+        "responses": {
+          "200": {
+            "description": "The progress of a router certificate rotation",
+            "schema": {
+              "$ref": "#/definitions/routerCertRotationEnvelope"
+            }
+          },
+          "401": {
+            "description": "The currently supplied session does not have the correct access rights to request this resource",
+            "schema": {
+              "$ref": "#/definitions/apiErrorEnvelope"
+            },
+            "examples": {
+              "application/json": {
+                "error": {
+                  "args": {
+                    "urlVars": {}
+                  },
+                  "cause": "",
+                  "causeMessage": "",
+                  "code": "UNAUTHORIZED",
+                  "message": "The request could not be completed. The session is not authorized or the credentials are invalid",
+                  "requestId": "0bfe7a04-9229-4b7a-812c-9eb3cc0eac0f"
+                },
+                "meta": {
+                  "apiEnrollmentVersion": "0.0.1",
+                  "apiVersion": "0.0.1"
+                }
+              }
+            }
+          },
+          "404": {
+            "description": "The requested resource does not exist",
+            "schema": {
+              "$ref": "#/definitions/apiErrorEnvelope"
+            },
+            "examples": {
+              "application/json": {
+                "error": {
+                  "args": {
+                    "urlVars": {
+                      "id": "71a3000f-7dda-491a-9b90-a19f4ee6c406"
+                    }
+                  },
+                  "cause": null,
+                  "causeMessage": "",
+                  "code": "NOT_FOUND",
+                  "message": "The resource requested was not found or is no longer available",
+                  "requestId": "270908d6-f2ef-4577-b973-67bec18ae376"
+                },
+                "meta": {
+                  "apiEnrollmentVersion": "0.0.1",
+                  "apiVersion": "0.0.1"
+                }
+              }
+            }
+          },
+          "429": {
+            "description": "The resource requested is rate limited and the rate limit has been exceeded",
+            "schema": {
+              "$ref": "#/definitions/apiErrorEnvelope"
+            },
+            "examples": {
+              "application/json": {
+                "error": {
+                  "args": {
+                    "urlVars": {}
+                  },
+                  "causeMessage": "you have hit a rate limit in the requested operation",
+                  "code": "RATE_LIMITED",
+                  "message": "The resource is rate limited and the rate limit has been exceeded. Please try again later",
+                  "requestId": "270908d6-f2ef-4577-b973-67bec18ae376"
+                },
+                "meta": {
+                  "apiEnrollmentVersion": "0.0.1",
+                  "apiVersion": "0.0.1"
+                }
+              }
+            }
+          }
+        }
+      },
+      "parameters": [
+        {
+          "type": "string",
+          "description": "The id of the requested resource",
+          "name": "id",
+          "in": "path",
+          "required": true
+        }
+      ]
+    },
+    "/router-drains": {
+      "get": {
+        "description": "Retrieves the in progress and completed router drains on this controller. Requires admin access.",
+        "tags": [
+          "Router"
+        ],
+        "summary": "List router drains",
+        "operationId": "listRouterDrains",
+        "responses": {
+          "200": {
+            "description": "A list of router drains",
+            "schema": {
+              "$ref": "#/definitions/listRouterDrainsEnvelope"
+            }
+          },
+          "401": {
+            "description": "The currently supplied session does not have the correct access rights to request this resource",
+            "schema": {
+              "$ref": "#/definitions/apiErrorEnvelope"
+            },
+            "examples": {
+              "application/json": {
+                "error": {
+                  "args": {
+                    "urlVars": {}
+                  },
+                  "cause": "",
+                  "causeMessage": "",
+                  "code": "UNAUTHORIZED",
+                  "message": "The request could not be completed. The session is not authorized or the credentials are invalid",
+                  "requestId": "0bfe7a04-9229-4b7a-812c-9eb3cc0eac0f"
+                },
+                "meta": {
+                  "apiEnrollmentVersion": "0.0.1",
+                  "apiVersion": "0.0.1"
+                }
+              }
+            }
+          },
+          "429": {
+            "description": "The resource requested is rate limited and the rate limit has been exceeded",
+            "schema": {
+              "$ref": "#/definitions/apiErrorEnvelope"
+            },
+            "examples": {
+              "application/json": {
+                "error": {
+                  "args": {
+                    "urlVars": {}
+                  },
+                  "causeMessage": "you have hit a rate limit in the requested operation",
+                  "code": "RATE_LIMITED",
+                  "message": "The resource is rate limited and the rate limit has been exceeded. Please try again later",
+                  "requestId": "270908d6-f2ef-4577-b973-67bec18ae376"
+                },
+                "meta": {
+                  "apiEnrollmentVersion": "0.0.1",
+                  "apiVersion": "0.0.1"
+                }
+              }
+            }
+          }
+        }
+      }
+    },
+    "/routers": {
+      "get": {
+        "description": "Retrieves a list of router resources; supports filtering, sorting, and pagination. Requires admin access.\n",
+        "tags": [
+          "Router"
+        ],
+        "summary": "List routers",
+        "operationId": "listRouters",
+        "parameters": [
+          {
+            "type": "integer",
+            "name": "limit",
+            "in": "query"
+          },
+          {
+            "type": "integer",
+            "name": "offset",
+            "in": "query"
+          },
+          {
+            "type": "string",
+            "name": "filter",
+            "in": "query"
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "A list of routers",
+            "schema": {
+              "$ref": "#/definitions/listRoutersEnvelope"
+            }
+          },
+          "401": {
+            "description": "The currently supplied session does not have the correct access rights to request this resource",
+            "schema": {
+              "$ref": "#/definitions/apiErrorEnvelope"
+            },
+            "examples": {
+              "application/json": {
+                "error": {
+                  "args": {
+                    "urlVars": {}
+                  },
+                  "cause": "",
+                  "causeMessage": "",
+                  "code": "UNAUTHORIZED",
+                  "message": "The request could not be completed. The session is not authorized or the credentials are invalid",
+                  "requestId": "0bfe7a04-9229-4b7a-812c-9eb3cc0eac0f"
+                },
+                "meta": {
+                  "apiEnrollmentVersion": "0.0.1",
+                  "apiVersion": "0.0.1"
+                }
+              }
+            }
+          },
+          "429": {
+            "description": "The resource requested is rate limited and the rate limit has been exceeded",
+            "schema": {
+              "$ref": "#/definitions/apiErrorEnvelope"
+            },
+            "examples": {
+              "application/json": {
+                "error": {
+                  "args": {
+                    "urlVars": {}
+                  },
+                  "causeMessage": "you have hit a rate limit in the requested operation",
+                  "code": "RATE_LIMITED",
+                  "message": "The resource is rate limited and the rate limit has been exceeded. Please try again later",
+                  "requestId": "270908d6-f2ef-4577-b973-67bec18ae376"
+                },
+                "meta": {
+                  "apiEnrollmentVersion": "0.0.1",
+                  "apiVersion": "0.0.1"
+                }
+              }
+            }
+          }
+        }
+      },
+      "post": {
+        "description": "Create a router resource. Requires admin access.",
+        "tags": [
+          "Router"
+        ],
+        "summary": "Create a router resource",
+        "operationId": "createRouter",
+        "parameters": [
+          {
+            "description": "A router to create",
+            "name": "router",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/routerCreate"
+            }
+          }
+        ],
+        "responses": {
+          "201": {
+            "description": "The create request was successful and the resource has been added at the following location",
+            "schema": {
+              "$ref": "#/definitions/createEnvelope"
+            }
+          },
+          "400": {
+            "description": "The supplied request contains invalid fields or could not be parsed (json and non-json bodies). The error's code, message, and cause fields can be inspected for further information",
+            "schema": {
+              "$ref": "#/definitions/apiErrorEnvelope"
+            },
+            "examples": {
+              "application/json": {
+                "error": {
+                  "args": {
+                    "urlVars": {}
+                  },
+                  "cause": {
+                    "details": {
+                      "context": "(root)",
+                      "field": "(root)",
+                      "property": "fooField3"
+                    },
+                    "field": "(root)",
+                    "message": "(root): fooField3 is required",
+                    "type": "required",
+                    "value": {
+                      "fooField": "abc",
+                      "fooField2": "def"
+                    }
+                  },
+                  "causeMessage": "schema validation failed",
+                  "code": "COULD_NOT_VALIDATE",
+                  "message": "The supplied request contains an invalid document",
+                  "requestId": "ac6766d6-3a09-44b3-8d8a-1b541d97fdd9"
+                },
+                "meta": {
+                  "apiEnrollmentVersion": "0.0.1",
+                  "apiVersion": "0.0.1"
+                }
+              }
+            }
+          },
+          "401": {
+            "description": "The currently supplied session does not have the correct access rights to request this resource",
+            "schema": {
+              "$ref": "#/definitions/apiErrorEnvelope"
+            },
+            "examples": {
+              "application/json": {
+                "error": {
+                  "args": {
+                    "urlVars": {}
+                  },
+                  "cause": "",
+                  "causeMessage": "",
+                  "code": "UNAUTHORIZED",
+                  "message": "The request could not be completed. The session is not authorized or the credentials are invalid",
+                  "requestId": "0bfe7a04-9229-4b7a-812c-9eb3cc0eac0f"
+                },
+                "meta": {
+                  "apiEnrollmentVersion": "0.0.1",
+                  "apiVersion": "0.0.1"
+                }
+              }
+            }
+          },
+          "429": {
+            "description": "The resource requested is rate limited and the rate limit has been exceeded",
+            "schema": {
+              "$ref": "#/definitions/apiErrorEnvelope"
+            },
+            "examples": {
+              "application/json": {
+                "error": {
+                  "args": {
+                    "urlVars": {}
+                  },
+                  "causeMessage": "you have hit a rate limit in the requested operation",
+                  "code": "RATE_LIMITED",
+                  "message": "The resource is rate limited and the rate limit has been exceeded. Please try again later",
+                  "requestId": "270908d6-f2ef-4577-b973-67bec18ae376"
+                },
+                "meta": {
+                  "apiEnrollmentVersion": "0.0.1",
+                  "apiVersion": "0.0.1"
+                }
+              }
+            }
+          },
+          "503": {
+            "description": "The request could not be completed due to the server being busy or in a temporarily bad state",
+            "schema": {
+              "$ref": "#/definitions/apiErrorEnvelope"
+            }
+          }
+        }
+      }
+    },
+    "/routers/{id}": {
+      "get": {
+        "description": "Retrieves a single router by id. Requires admin access.",
+        "tags": [
+          "Router"
+        ],
+        "summary": "Retrieves a single router",
+        "operationId": "detailRouter",
+        "responses": {
+          "200": {
+            "description": "A single router",
+            "schema": {
+              "$ref": "#/definitions/detailRouterEnvelope"
+            }
+          },
+          "401": {
+            "description": "The currently supplied session does not have the correct access rights to request this resource",
+            "schema": {
+              "$ref": "#/definitions/apiErrorEnvelope"
+            },
+            "examples": {
+              "application/json": {
+                "error": {
+                  "args": {
+                    "urlVars": {}
+                  },
+                  "cause": "",
+                  "causeMessage": "",
+                  "code": "UNAUTHORIZED",
+                  "message": "The request could not be completed. The session is not authorized or the credentials are invalid",
+                  "requestId": "0bfe7a04-9229-4b7a-812c-9eb3cc0eac0f"
+                },
+                "meta": {
+                  "apiEnrollmentVersion": "0.0.1",
+                  "apiVersion": "0.0.1"
+                }
+              }
+            }
+          },
+          "404": {
+            "description": "The requested resource does not exist",
+            "schema": {
+              "$ref": "#/definitions/apiErrorEnvelope"
+            },
+            "examples": {
+              "application/json": {
+                "error": {
+                  "args": {
+                    "urlVars": {
+                      "id": "71a3000f-7dda-491a-9b90-a19f4ee6c406"
+                    }
+                  },
+                  "cause": null,
+                  "causeMessage": "",
+                  "code": "NOT_FOUND",
+                  "message": "The resource requested was not found or is no longer available",
+                  "requestId": "270908d6-f2ef-4577-b973-67bec18ae376"
+                },
+                "meta": {
+                  "apiEnrollmentVersion": "0.0.1",
+                  "apiVersion": "0.0.1"
+                }
+              }
+            }
+          },
+          "429": {
+            "description": "The resource requested is rate limited and the rate limit has been exceeded",
+            "schema": {
+              "$ref": "#/definitions/apiErrorEnvelope"
+            },
+            "examples": {
+              "application/json": {
+                "error": {
+                  "args": {
+                    "urlVars": {}
+                  },
+                  "causeMessage": "you have hit a rate limit in the requested operation",
+                  "code": "RATE_LIMITED",
+                  "message": "The resource is rate limited and the rate limit has been exceeded. Please try again later",
+                  "requestId": "270908d6-f2ef-4577-b973-67bec18ae376"
+                },
+                "meta": {
+                  "apiEnrollmentVersion": "0.0.1",
+                  "apiVersion": "0.0.1"
+                }
+              }
+            }
+          }
+        }
+      },
+      "put": {
+        "description": "Update all fields on a router by id. Requires admin access.",
+        "tags": [
+          "Router"
+        ],
+        "summary": "Update all fields on a router",
+        "operationId": "updateRouter",
+        "parameters": [
+          {
+            "description": "A router update object",
+            "name": "router",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/routerUpdate"
+            }
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "The update request was successful and the resource has been altered",
+            "schema": {
+              "$ref": "#/definitions/empty"
+            }
+          },
+          "400": {
+            "description": "The supplied request contains invalid fields or could not be parsed (json and non-json bodies). The error's code, message, and cause fields can be inspected for further information",
+            "schema": {
+              "$ref": "#/definitions/apiErrorEnvelope"
+            },
+            "examples": {
+              "application/json": {
+                "error": {
+                  "args": {
+                    "urlVars": {}
+                  },
+                  "cause": {
+                    "details": {
+                      "context": "(root)",
+                      "field": "(root)",
+                      "property": "fooField3"
+                    },
+                    "field": "(root)",
+                    "message": "(root): fooField3 is required",
+                    "type": "required",
+                    "value": {
+                      "fooField": "abc",
+                      "fooField2": "def"
+                    }
+                  },
+                  "causeMessage": "schema validation failed",
+                  "code": "COULD_NOT_VALIDATE",
+                  "message": "The supplied request contains an invalid document",
+                  "requestId": "ac6766d6-3a09-44b3-8d8a-1b541d97fdd9"
+                },
+                "meta": {
+                  "apiEnrollmentVersion": "0.0.1",
+                  "apiVersion": "0.0.1"
+                }
+              }
+            }
+          },
+          "401": {
+            "description": "The currently supplied session does not have the correct access rights to request this resource",
+            "schema": {
+              "$ref": "#/definitions/apiErrorEnvelope"
+            },
+            "examples": {
+              "application/json": {
+                "error": {
+                  "args": {
+                    "urlVars": {}
+                  },
+                  "cause": "",
+                  "causeMessage": "",
+                  "code": "UNAUTHORIZED",
+                  "message": "The request could not be completed. The session is not authorized or the credentials are invalid",
+                  "requestId": "0bfe7a04-9229-4b7a-812c-9eb3cc0eac0f"
+                },
+                "meta": {
+                  "apiEnrollmentVersion": "0.0.1",
+                  "apiVersion": "0.0.1"
+                }
+              }
+            }
+          },
+          "404": {
+            "description": "The requested resource does not exist",
+            "schema": {
+              "$ref": "#/definitions/apiErrorEnvelope"
+            },
+            "examples": {
+              "application/json": {
+                "error": {
+                  "args": {
+                    "urlVars": {
+                      "id": "71a3000f-7dda-491a-9b90-a19f4ee6c406"
+                    }
+                  },
+                  "cause": null,
+                  "causeMessage": "",
+                  "code": "NOT_FOUND",
+                  "message": "The resource requested was not found or is no longer available",
+                  "requestId": "270908d6-f2ef-4577-b973-67bec18ae376"
+                },
+                "meta": {
+                  "apiEnrollmentVersion": "0.0.1",
+                  "apiVersion": "0.0.1"
+                }
+              }
+            }
+          },
+          "429": {
+            "description": "The resource requested is rate limited and the rate limit has been exceeded",
+            "schema": {
+              "$ref": "#/definitions/apiErrorEnvelope"
+            },
+            "examples": {
+              "application/json": {
+                "error": {
+                  "args": {
+                    "urlVars": {}
+                  },
+                  "causeMessage": "you have hit a rate limit in the requested operation",
+                  "code": "RATE_LIMITED",
+                  "message": "The resource is rate limited and the rate limit has been exceeded. Please try again later",
+                  "requestId": "270908d6-f2ef-4577-b973-67bec18ae376"
+                },
+                "meta": {
+                  "apiEnrollmentVersion": "0.0.1",
+                  "apiVersion": "0.0.1"
+                }
+              }
+            }
+          },
+          "503": {
+            "description": "The request could not be completed due to the server being busy or in a temporarily bad state",
+            "schema": {
+              "$ref": "#/definitions/apiErrorEnvelope"
+            }
+          }
+        }
+      },
+      "delete": {
+        "description": "Delete a router by id. Requires admin access.",
+        "tags": [
+          "Router"
+        ],
+        "summary": "Delete a router",
+        "operationId": "deleteRouter",
+        "responses": {
+          "200": {
+            "description": "The delete request was successful and the resource has been removed",
+            "schema": {
+              "$ref": "#/definitions/empty"
+            }
+          },
+          "400": {
+            "description": "The supplied request contains invalid fields or could not be parsed (json and non-json bodies). The error's code, message, and cause fields can be inspected for further information",
+            "schema": {
+              "$ref": "#/definitions/apiErrorEnvelope"
+            },
+            "examples": {
+              "application/json": {
+                "error": {
+                  "args": {
+                    "urlVars": {}
+                  },
+                  "cause": {
+                    "details": {
+                      "context": "(root)",
+                      "field": "(root)",
+                      "property": "fooField3"
+                    },
+                    "field": "(root)",
+                    "message": "(root): fooField3 is required",
+                    "type": "required",
+                    "value": {
+                      "fooField": "abc",
+                      "fooField2": "def"
+                    }
+                  },
+                  "causeMessage": "schema validation failed",
+                  "code": "COULD_NOT_VALIDATE",
+                  "message": "The supplied request contains an invalid document",
+                  "requestId": "ac6766d6-3a09-44b3-8d8a-1b541d97fdd9"
+                },
+                "meta": {
+                  "apiEnrollmentVersion": "0.0.1",
+                  "apiVersion": "0.0.1"
+                }
+              }
+            }
+          },
+          "401": {
+            "description": "The currently supplied session does not have the correct access rights to request this resource",
+            "schema": {
+              "$ref": "#/definitions/apiErrorEnvelope"
+            },
+            "examples": {
+              "application/json": {
+                "error": {
+                  "args": {
+                    "urlVars": {}
+                  },
+                  "cause": "",
+                  "causeMessage": "",
+                  "code": "UNAUTHORIZED",
+                  "message": "The request could not be completed. The session is not authorized or the credentials are invalid",
+                  "requestId": "0bfe7a04-9229-4b7a-812c-9eb3cc0eac0f"
+                },
+                "meta": {
+                  "apiEnrollmentVersion": "0.0.1",
+                  "apiVersion": "0.0.1"
+                }
+              }
+            }
+          },
+          "409": {
+            "description": "The resource requested to be removed/altered cannot be as it is referenced by another object.",
+            "schema": {
+              "$ref": "#/definitions/apiErrorEnvelope"
+            },
+            "examples": {
+              "application/json": {
+                "error": {
+                  "args": {
+                    "urlVars": {
+                      "id": "71a3000f-7dda-491a-9b90-a19f4ee6c406"
+                    }
+                  },
+                  "causeMessage": "referenced by /some-resource/05f4f710-c155-4a74-86d5-77558eb9cb42",
+                  "code": "CONFLICT_CANNOT_MODIFY_REFERENCED",
+                  "message": "The resource cannot be deleted/modified. Remove all referencing resources first.",
+                  "requestId": "270908d6-f2ef-4577-b973-67bec18ae376"
+                },
+                "meta": {
+                  "apiEnrollmentVersion": "0.0.1",
+                  "apiVersion": "0.0.1"
+                }
+              }
+            }
+          },
+          "429": {
+            "description": "The resource requested is rate limited and the rate limit has been exceeded",
+            "schema": {
+              "$ref": "#/definitions/apiErrorEnvelope"
+            },
+            "examples": {
+              "application/json": {
+                "error": {
+                  "args": {
+                    "urlVars": {}
+                  },
+                  "causeMessage": "you have hit a rate limit in the requested operation",
+                  "code": "RATE_LIMITED",
+                  "message": "The resource is rate limited and the rate limit has been exceeded. Please try again later",
+                  "requestId": "270908d6-f2ef-4577-b973-67bec18ae376"
+                },
+                "meta": {
+                  "apiEnrollmentVersion": "0.0.1",
+                  "apiVersion": "0.0.1"
+                }
+              }
+            }
+          },
+          "503": {
+            "description": "The request could not be completed due to the server being busy or in a temporarily bad state",
+            "schema": {
+              "$ref": "#/definitions/apiErrorEnvelope"
+            }
+          }
+        }
+      },
+      "patch": {
+        "description": "Update the supplied fields on a router. Requires admin access.",
+        "tags": [
+          "Router"
+        ],
+        "summary": "Update the supplied fields on a router",
+        "operationId": "patchRouter",
+        "parameters": [
+          {
+            "description": "A router patch object",
+            "name": "router",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/routerPatch"
+            }
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "The patch request was successful and the resource has been altered",
+            "schema": {
+              "$ref": "#/definitions/empty"
+            }
+          },
+          "400": {
+            "description": "The supplied request contains invalid fields or could not be parsed (json and non-json bodies). The error's code, message, and cause fields can be inspected for further information",
+            "schema": {
+              "$ref": "#/definitions/apiErrorEnvelope"
+            },
+            "examples": {
+              "application/json": {
+                "error": {
+                  "args": {
+                    "urlVars": {}
+                  },
+                  "cause": {
+                    "details": {
+                      "context": "(root)",
+                      "field": "(root)",
+                      "property": "fooField3"
+                    },
+                    "field": "(root)",
+                    "message": "(root): fooField3 is required",
+                    "type": "required",
+                    "value": {
+                      "fooField": "abc",
+                      "fooField2": "def"
+                    }
+                  },
+                  "causeMessage": "schema validation failed",
+                  "code": "COULD_NOT_VALIDATE",
+                  "message": "The supplied request contains an invalid document",
+                  "requestId": "ac6766d6-3a09-44b3-8d8a-1b541d97fdd9"
+                },
+                "meta": {
+                  "apiEnrollmentVersion": "0.0.1",
+                  "apiVersion": "0.0.1"
+                }
+              }
+            }
+          },
+          "401": {
+            "description": "The currently supplied session does not have the correct access rights to request this resource",
+            "schema": {
+              "$ref": "#/definitions/apiErrorEnvelope"
+            },
+            "examples": {
+              "application/json": {
+                "error": {
+                  "args": {
+                    "urlVars": {}
+                  },
+                  "cause": "",
+                  "causeMessage": "",
+                  "code": "UNAUTHORIZED",
+                  "message": "The request could not be completed. The session is not authorized or the credentials are invalid",
+                  "requestId": "0bfe7a04-9229-4b7a-812c-9eb3cc0eac0f"
+                },
+                "meta": {
+                  "apiEnrollmentVersion": "0.0.1",
+                  "apiVersion": "0.0.1"
+                }
+              }
+            }
+          },
+          "404": {
+            "description": "The requested resource does not exist",
+            "schema": {
+              "$ref": "#/definitions/apiErrorEnvelope"
+            },
+            "examples": {
+              "application/json": {
+                "error": {
+                  "args": {
+                    "urlVars": {
+                      "id": "71a3000f-7dda-491a-9b90-a19f4ee6c406"
+                    }
+                  },
+                  "cause": null,
+                  "causeMessage": "",
+                  "code": "NOT_FOUND",
+                  "message": "The resource requested was not found or is no longer available",
+                  "requestId": "270908d6-f2ef-4577-b973-67bec18ae376"
+                },
+                "meta": {
+                  "apiEnrollmentVersion": "0.0.1",
+                  "apiVersion": "0.0.1"
+                }
+              }
+            }
+          },
+          "429": {
+            "description": "The resource requested is rate limited and the rate limit has been exceeded",
+            "schema": {
+              "$ref": "#/definitions/apiErrorEnvelope"
+            },
+            "examples": {
+              "application/json": {
+                "error": {
+                  "args": {
+                    "urlVars": {}
+                  },
+                  "causeMessage": "you have hit a rate limit in the requested operation",
+                  "code": "RATE_LIMITED",
+                  "message": "The resource is rate limited and the rate limit has been exceeded. Please try again later",
+                  "requestId": "270908d6-f2ef-4577-b973-67bec18ae376"
+                },
+                "meta": {
+                  "apiEnrollmentVersion": "0.0.1",
+                  "apiVersion": "0.0.1"
+                }
+              }
+            }
+          },
+          "503": {
+            "description": "The request could not be completed due to the server being busy or in a temporarily bad state",
+            "schema": {
+              "$ref": "#/definitions/apiErrorEnvelope"
+            }
+          }
+        }
+      },
+      "parameters": [
+        {
+          "type": "string",
+          "description": "The id of the requested resource",
+          "name": "id",
+          "in": "path",
+          "required": true
+        }
+      ]
+    },
+    "/routers/{id}/drain": {
+      "get": {
+        "description": "Retrieves the progress of the drain of the given router, including circuits which couldn't be moved. Requires admin access.",
+        "tags": [
+          "Router"
+        ],
+        "summary": "Retrieve the drain status of a router",
+        "operationId": "detailRouterDrain",
+        "responses": {
+          "200": {
+            "description": "A single router drain",
+            "schema": {
+              "$ref": "#/definitions/routerDrainEnvelope"
+            }
+          },
+          "401": {
+            "description": "The currently supplied session does not have the correct access rights to request this resource",
+            "schema": {
+              "$ref": "#/definitions/apiErrorEnvelope"
+            },
+            "examples": {
+              "application/json": {
+                "error": {
+                  "args": {
+                    "urlVars": {}
+                  },
+                  "cause": "",
+                  "causeMessage": "",
+                  "code": "UNAUTHORIZED",
+                  "message": "The request could not be completed. The session is not authorized or the credentials are invalid",
+                  "requestId": "0bfe7a04-9229-4b7a-812c-9eb3cc0eac0f"
+                },
+                "meta": {
+                  "apiEnrollmentVersion": "0.0.1",
+                  "apiVersion": "0.0.1"
+                }
+              }
+            }
+          },
+          "404": {
+            "description": "The requested resource does not exist",
+            "schema": {
+              "$ref": "#/definitions/apiErrorEnvelope"
+            },
+            "examples": {
+              "application/json": {
+                "error": {
+                  "args": {
+                    "urlVars": {
+                      "id": "71a3000f-7dda-491a-9b90-a19f4ee6c406"
+                    }
+                  },
+                  "cause": null,
+                  "causeMessage": "",
+                  "code": "NOT_FOUND",
+                  "message": "The resource requested was not found or is no longer available",
+                  "requestId": "270908d6-f2ef-4577-b973-67bec18ae376"
+                },
+                "meta": {
+                  "apiEnrollmentVersion": "0.0.1",
+                  "apiVersion": "0.0.1"
+                }
+              }
+            }
+          },
+          "429": {
+            "description": "The resource requested is rate limited and the rate limit has been exceeded",
+            "schema": {
+              "$ref": "#/definitions/apiErrorEnvelope"
+            },
+            "examples": {
+              "application/json": {
+                "error": {
+                  "args": {
+                    "urlVars": {}
+                  },
+                  "causeMessage": "you have hit a rate limit in the requested operation",
+                  "code": "RATE_LIMITED",
+                  "message": "The resource is rate limited and the rate limit has been exceeded. Please try again later",
+                  "requestId": "270908d6-f2ef-4577-b973-67bec18ae376"
+                },
+                "meta": {
+                  "apiEnrollmentVersion": "0.0.1",
+                  "apiVersion": "0.0.1"
+                }
+              }
+            }
+          }
+        }
+      },
+      "post": {
+        "description": "Starts draining the given router. The router stops being used for transit when paths are calculated and the\ncircuits which pass through it are rerouted. The drain can optionally quiesce the router and wait for circuits\nwhich start or end at the router to finish. Drains are tracked in memory by the controller which accepted the\nrequest. Requires admin access.\n",
+        "tags": [
+          "Router"
+        ],
+        "summary": "Drain a router",
+        "operationId": "drainRouter",
+        "parameters": [
+          {
+            "description": "The drain options",
+            "name": "drain",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/routerDrainCreate"
+            }
+          }
+        ],
+        "responses": {
+          "202": {
+            "description": "A single router drain",
+            "schema": {
+              "$ref": "#/definitions/routerDrainEnvelope"
+            }
+          },
+          "400": {
+            "description": "The supplied request contains invalid fields or could not be parsed (json and non-json bodies). The error's code, message, and cause fields can be inspected for further information",
+            "schema": {
+              "$ref": "#/definitions/apiErrorEnvelope"
+            },
+            "examples": {
+              "application/json": {
+                "error": {
+                  "args": {
+                    "urlVars": {}
+                  },
+                  "cause": {
+                    "details": {
+                      "context": "(root)",
+                      "field": "(root)",
+                      "property": "fooField3"
+                    },
+                    "field": "(root)",
+                    "message": "(root): fooField3 is required",
+                    "type": "required",
+                    "value": {
+                      "fooField": "abc",
+                      "fooField2": "def"
+                    }
+                  },
+                  "causeMessage": "schema validation failed",
+                  "code": "COULD_NOT_VALIDATE",
+                  "message": "The supplied request contains an invalid document",
+                  "requestId": "ac6766d6-3a09-44b3-8d8a-1b541d97fdd9"
+                },
+                "meta": {
+                  "apiEnrollmentVersion": "0.0.1",
+                  "apiVersion": "0.0.1"
+                }
+              }
+            }
+          },
+          "401": {
+            "description": "The currently supplied session does not have the correct access rights to request this resource",
+            "schema": {
+              "$ref": "#/definitions/apiErrorEnvelope"
+            },
+            "examples": {
+              "application/json": {
+                "error": {
+                  "args": {
+                    "urlVars": {}
+                  },
+                  "cause": "",
+                  "causeMessage": "",
+                  "code": "UNAUTHORIZED",
+                  "message": "The request could not be completed. The session is not authorized or the credentials are invalid",
+                  "requestId": "0bfe7a04-9229-4b7a-812c-9eb3cc0eac0f"
+                },
+                "meta": {
+                  "apiEnrollmentVersion": "0.0.1",
+                  "apiVersion": "0.0.1"
+                }
+              }
+            }
+          },
+          "404": {
+            "description": "The requested resource does not exist",
+            "schema": {
+              "$ref": "#/definitions/apiErrorEnvelope"
+            },
+            "examples": {
+              "application/json": {
+                "error": {
+                  "args": {
+                    "urlVars": {
+                      "id": "71a3000f-7dda-491a-9b90-a19f4ee6c406"
+                    }
+                  },
+                  "cause": null,
+                  "causeMessage": "",
+                  "code": "NOT_FOUND",
+                  "message": "The resource requested was not found or is no longer available",
+                  "requestId": "270908d6-f2ef-4577-b973-67bec18ae376"
+                },
+                "meta": {
+                  "apiEnrollmentVersion": "0.0.1",
+                  "apiVersion": "0.0.1"
+                }
+              }
+            }
+          },
+          "429": {
+            "description": "The resource requested is rate limited and the rate limit has been exceeded",
+            "schema": {
+              "$ref": "#/definitions/apiErrorEnvelope"
+            },
+            "examples": {
+              "application/json": {
+                "error": {
+                  "args": {
+                    "urlVars": {}
+                  },
+                  "causeMessage": "you have hit a rate limit in the requested operation",
+                  "code": "RATE_LIMITED",
+                  "message": "The resource is rate limited and the rate limit has been exceeded. Please try again later",
+                  "requestId": "270908d6-f2ef-4577-b973-67bec18ae376"
+                },
+                "meta": {
+                  "apiEnrollmentVersion": "0.0.1",
+                  "apiVersion": "0.0.1"
+                }
+              }
+            }
+          }
+        }
+      },
+      "delete": {
+        "description": "Cancels the drain of the given router, allowing it to be used for transit again. Doesn't dequiesce the router. Requires admin access.",
+        "tags": [
+          "Router"
+        ],
+        "summary": "Cancel a router drain",
+        "operationId": "cancelRouterDrain",
+        "responses": {
+          "200": {
+            "description": "The delete request was successful and the resource has been removed",
+            "schema": {
+              "$ref": "#/definitions/empty"
+            }
+          },
+          "401": {
+            "description": "The currently supplied session does not have the correct access rights to request this resource",
+            "schema": {
+              "$ref": "#/definitions/apiErrorEnvelope"
+            },
+            "examples": {
+              "application/json": {
+                "error": {
+                  "args": {
+                    "urlVars": {}
+                  },
+                  "cause": "",
+                  "causeMessage": "",
+                  "code": "UNAUTHORIZED",
+                  "message": "The request could not be completed. The session is not authorized or the credentials are invalid",
+                  "requestId": "0bfe7a04-9229-4b7a-812c-9eb3cc0eac0f"
+                },
+                "meta": {
+                  "apiEnrollmentVersion": "0.0.1",
+                  "apiVersion": "0.0.1"
+                }
+              }
+            }
+          },
+          "404": {
+            "description": "The requested resource does not exist",
+            "schema": {
+              "$ref": "#/definitions/apiErrorEnvelope"
+            },
+            "examples": {
+              "application/json": {
+                "error": {
+                  "args": {
+                    "urlVars": {
+                      "id": "71a3000f-7dda-491a-9b90-a19f4ee6c406"
+                    }
+                  },
+                  "cause": null,
+                  "causeMessage": "",
+                  "code": "NOT_FOUND",
+                  "message": "The resource requested was not found or is no longer available",
+                  "requestId": "270908d6-f2ef-4577-b973-67bec18ae376"
+                },
+                "meta": {
+                  "apiEnrollmentVersion": "0.0.1",
+                  "apiVersion": "0.0.1"
+                }
+              }
+            }
+          },
+          "429": {
+            "description": "The resource requested is rate limited and the rate limit has been exceeded",
+            "schema": {
+              "$ref": "#/definitions/apiErrorEnvelope"
+            },
+            "examples": {
+              "application/json": {
+                "error": {
+                  "args": {
+                    "urlVars": {}
+                  },
+                  "causeMessage": "you have hit a rate limit in the requested operation",
+                  "code": "RATE_LIMITED",
+                  "message": "The resource is rate limited and the rate limit has been exceeded. Please try again later",
+                  "requestId": "270908d6-f2ef-4577-b973-67bec18ae376"
+                },
+                "meta": {
+                  "apiEnrollmentVersion": "0.0.1",
+                  "apiVersion": "0.0.1"
+                }
+              }
+            }
+          }
+        }
+      },
+      "parameters": [
+        {
+          "type": "string",
+          "description": "The id of the requested resource",
+          "name": "id",
+          "in": "path",
+          "required": true
+        }
+      ]
+    },
+    "/routers/{id}/terminators": {
+      "get": {
+        "description": "Retrieves a list of terminator resources that are assigned specific router; supports filtering, sorting, and pagination.\n",
+        "tags": [
+          "Router"
+        ],
+        "summary": "List of terminators assigned to a router",
+        "operationId": "listRouterTerminators",
+        "parameters": [
+          {
+            "type": "integer",
+            "name": "limit",
+            "in": "query"
+          },
+          {
+            "type": "integer",
+            "name": "offset",
+            "in": "query"
+          },
+          {
+            "type": "string",
+            "name": "filter",
+            "in": "query"
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "A list of terminators",
+            "schema": {
+              "$ref": "#/definitions/listTerminatorsEnvelope"
+            }
+          },
+          "400": {
+            "description": "The supplied request contains invalid fields or could not be parsed (json and non-json bodies). The error's code, message, and cause fields can be inspected for further information",
+            "schema": {
+              "$ref": "#/definitions/apiErrorEnvelope"
+            },
+            "examples": {
+              "application/json": {
+                "error": {
+                  "args": {
+                    "urlVars": {}
+                  },
+                  "cause": {
+                    "details": {
+                      "context": "(root)",
+                      "field": "(root)",
+                      "property": "fooField3"
+                    },
+                    "field": "(root)",
+                    "message": "(root): fooField3 is required",
+                    "type": "required",
+                    "value": {
+                      "fooField": "abc",
+                      "fooField2": "def"
+                    }
+                  },
+                  "causeMessage": "schema validation failed",
+                  "code": "COULD_NOT_VALIDATE",
+                  "message": "The supplied request contains an invalid document",
+                  "requestId": "ac6766d6-3a09-44b3-8d8a-1b541d97fdd9"
+                },
+                "meta": {
+                  "apiEnrollmentVersion": "0.0.1",
+                  "apiVersion": "0.0.1"
+                }
+              }
+            }
+          },
+          "401": {
+            "description": "The currently supplied session does not have the correct access rights to request this resource",
+            "schema": {
+              "$ref": "#/definitions/apiErrorEnvelope"
+            },
+            "examples": {
+              "application/json": {
+                "error": {
+                  "args": {
+                    "urlVars": {}
+                  },
+                  "cause": "",
+                  "causeMessage": "",
+                  "code": "UNAUTHORIZED",
+                  "message": "The request could not be completed. The session is not authorized or the credentials are invalid",
+                  "requestId": "0bfe7a04-9229-4b7a-812c-9eb3cc0eac0f"
+                },
+                "meta": {
+                  "apiEnrollmentVersion": "0.0.1",
+                  "apiVersion": "0.0.1"
+                }
+              }
+            }
+          },
+          "429": {
+            "description": "The resource requested is rate limited and the rate limit has been exceeded",
+            "schema": {
+              "$ref": "#/definitions/apiErrorEnvelope"
+            },
+            "examples": {
+              "application/json": {
+                "error": {
+                  "args": {
+                    "urlVars": {}
+                  },
+                  "causeMessage": "you have hit a rate limit in the requested operation",
+                  "code": "RATE_LIMITED",
+                  "message": "The resource is rate limited and the rate limit has been exceeded. Please try again later",
+                  "requestId": "270908d6-f2ef-4577-b973-67bec18ae376"
+                },
+                "meta": {
+                  "apiEnrollmentVersion": "0.0.1",
+                  "apiVersion": "0.0.1"
+                }
+              }
+            }
+          }
+        }
+      },
+      "parameters": [
+        {
+          "type": "string",
+          "description": "The id of the requested resource",
+          "name": "id",
+          "in": "path",
+          "required": true
+        }
+      ]
+    },
+    "/services": {
+      "get": {
+        "description": "Retrieves a list of service resources; supports filtering, sorting, and pagination. Requires admin access.\n",
+        "tags": [
+          "Service"
+        ],
+        "summary": "List services",
+        "operationId": "listServices",
+        "parameters": [
+          {
+            "type": "integer",
+            "name": "limit",
+            "in": "query"
+          },
+          {
+            "type": "integer",
+            "name": "offset",
+            "in": "query"
+          },
+          {
+            "type": "string",
+            "name": "filter",
+            "in": "query"
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "A list of services",
+            "schema": {
+              "$ref": "#/definitions/listServicesEnvelope"
+            }
+          },
+          "401": {
+            "description": "The currently supplied session does not have the correct access rights to request this resource",
+            "schema": {
+              "$ref": "#/definitions/apiErrorEnvelope"
+            },
+            "examples": {
+              "application/json": {
+                "error": {
+                  "args": {
+                    "urlVars": {}
+                  },
+                  "cause": "",
+                  "causeMessage": "",
+                  "code": "UNAUTHORIZED",
+                  "message": "The request could not be completed. The session is not authorized or the credentials are invalid",
+                  "requestId": "0bfe7a04-9229-4b7a-812c-9eb3cc0eac0f"
+                },
+                "meta": {
+                  "apiEnrollmentVersion": "0.0.1",
+                  "apiVersion": "0.0.1"
+                }
+              }
+            }
+          },
+          "429": {
+            "description": "The resource requested is rate limited and the rate limit has been exceeded",
+            "schema": {
+              "$ref": "#/definitions/apiErrorEnvelope"
+            },
+            "examples": {
+              "application/json": {
+                "error": {
+                  "args": {
+                    "urlVars": {}
+                  },
+                  "causeMessage": "you have hit a rate limit in the requested operation",
+                  "code": "RATE_LIMITED",
+                  "message": "The resource is rate limited and the rate limit has been exceeded. Please try again later",
+                  "requestId": "270908d6-f2ef-4577-b973-67bec18ae376"
+                },
+                "meta": {
+                  "apiEnrollmentVersion": "0.0.1",
+                  "apiVersion": "0.0.1"
+                }
+              }
+            }
+          }
+        }
+      },
+      "post": {
+        "description": "Create a service resource. Requires admin access.",
+        "tags": [
+          "Service"
+        ],
+        "summary": "Create a service resource",
+        "operationId": "createService",
+        "parameters": [
+          {
+            "description": "A service to create",
+            "name": "service",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/serviceCreate"
+            }
+          }
+        ],
+        "responses": {
+          "201": {
+            "description": "The create request was successful and the resource has been added at the following location",
+            "schema": {
+              "$ref": "#/definitions/createEnvelope"
+            }
+          },
+          "400": {
+            "description": "The supplied request contains invalid fields or could not be parsed (json and non-json bodies). The error's code, message, and cause fields can be inspected for further information",
+            "schema": {
+              "$ref": "#/definitions/apiErrorEnvelope"
+            },
+            "examples": {
+              "application/json": {
+                "error": {
+                  "args": {
+                    "urlVars": {}
+                  },
+                  "cause": {
+                    "details": {
+                      "context": "(root)",
+                      "field": "(root)",
+                      "property": "fooField3"
+                    },
+                    "field": "(root)",
+                    "message": "(root): fooField3 is required",
+                    "type": "required",
+                    "value": {
+                      "fooField": "abc",
+                      "fooField2": "def"
+                    }
+                  },
+                  "causeMessage": "schema validation failed",
+                  "code": "COULD_NOT_VALIDATE",
+                  "message": "The supplied request contains an invalid document",
+                  "requestId": "ac6766d6-3a09-44b3-8d8a-1b541d97fdd9"
+                },
+                "meta": {
+                  "apiEnrollmentVersion": "0.0.1",
+                  "apiVersion": "0.0.1"
+                }
+              }
+            }
+          },
+          "401": {
+            "description": "The currently supplied session does not have the correct access rights to request this resource",
+            "schema": {
+              "$ref": "#/definitions/apiErrorEnvelope"
+            },
+            "examples": {
+              "application/json": {
+                "error": {
+                  "args": {
+                    "urlVars": {}
+                  },
+                  "cause": "",
+                  "causeMessage": "",
+                  "code": "UNAUTHORIZED",
+                  "message": "The request could not be completed. The session is not authorized or the credentials are invalid",
+                  "requestId": "0bfe7a04-9229-4b7a-812c-9eb3cc0eac0f"
+                },
+                "meta": {
+                  "apiEnrollmentVersion": "0.0.1",
+                  "apiVersion": "0.0.1"
+                }
+              }
+            }
+          },
+          "429": {
+            "description": "The resource requested is rate limited and the rate limit has been exceeded",
+            "schema": {
+              "$ref": "#/definitions/apiErrorEnvelope"
+            },
+            "examples": {
+              "application/json": {
+                "error": {
+                  "args": {
+                    "urlVars": {}
+                  },
+                  "causeMessage": "you have hit a rate limit in the requested operation",
+                  "code": "RATE_LIMITED",
+                  "message": "The resource is rate limited and the rate limit has been exceeded. Please try again later",
+                  "requestId": "270908d6-f2ef-4577-b973-67bec18ae376"
+                },
+                "meta": {
+                  "apiEnrollmentVersion": "0.0.1",
+                  "apiVersion": "0.0.1"
+                }
+              }
+            }
+          },
+          "503": {
+            "description": "The request could not be completed due to the server being busy or in a temporarily bad state",
+            "schema": {
+              "$ref": "#/definitions/apiErrorEnvelope"
+            }
+          }
+        }
+      }
+    },
+    "/services/{id}": {
+      "get": {
+        "description": "Retrieves a single service by id. Requires admin access.",
+        "tags": [
+          "Service"
+        ],
+        "summary": "Retrieves a single service",
+        "operationId": "detailService",
+        "responses": {
+          "200": {
+            "description": "A single service",
+            "schema": {
+              "$ref": "#/definitions/detailServiceEnvelope"
+            }
+          },
+          "401": {
+            "description": "The currently supplied session does not have the correct access rights to request this resource",
+            "schema": {
+              "$ref": "#/definitions/apiErrorEnvelope"
+            },
+            "examples": {
+              "application/json": {
+                "error": {
+                  "args": {
+                    "urlVars": {}
+                  },
+                  "cause": "",
+                  "causeMessage": "",
+                  "code": "UNAUTHORIZED",
+                  "message": "The request could not be completed. The session is not authorized or the credentials are invalid",
+                  "requestId": "0bfe7a04-9229-4b7a-812c-9eb3cc0eac0f"
+                },
+                "meta": {
+                  "apiEnrollmentVersion": "0.0.1",
+                  "apiVersion": "0.0.1"
+                }
+              }
+            }
+          },
+          "404": {
+            "description": "The requested resource does not exist",
+            "schema": {
+              "$ref": "#/definitions/apiErrorEnvelope"
+            },
+            "examples": {
+              "application/json": {
+                "error": {
+                  "args": {
+                    "urlVars": {
+                      "id": "71a3000f-7dda-491a-9b90-a19f4ee6c406"
+                    }
+                  },
+                  "cause": null,
+                  "causeMessage": "",
+                  "code": "NOT_FOUND",
+                  "message": "The resource requested was not found or is no longer available",
+                  "requestId": "270908d6-f2ef-4577-b973-67bec18ae376"
+                },
+                "meta": {
+                  "apiEnrollmentVersion": "0.0.1",
+                  "apiVersion": "0.0.1"
+                }
+              }
+            }
+          },
+          "429": {
+            "description": "The resource requested is rate limited and the rate limit has been exceeded",
+            "schema": {
+              "$ref": "#/definitions/apiErrorEnvelope"
+            },
+            "examples": {
+              "application/json": {
+                "error": {
+                  "args": {
+                    "urlVars": {}
+                  },
+                  "causeMessage": "you have hit a rate limit in the requested operation",
+                  "code": "RATE_LIMITED",
+                  "message": "The resource is rate limited and the rate limit has been exceeded. Please try again later",
+                  "requestId": "270908d6-f2ef-4577-b973-67bec18ae376"
+                },
+                "meta": {
+                  "apiEnrollmentVersion": "0.0.1",
+                  "apiVersion": "0.0.1"
+                }
+              }
+            }
+          }
+        }
+      },
+      "put": {
+        "description": "Update all fields on a service by id. Requires admin access.",
+        "tags": [
+          "Service"
+        ],
+        "summary": "Update all fields on a service",
+        "operationId": "updateService",
+        "parameters": [
+          {
+            "description": "A service update object",
+            "name": "service",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/serviceUpdate"
+            }
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "The update request was successful and the resource has been altered",
+            "schema": {
+              "$ref": "#/definitions/empty"
+            }
+          },
+          "400": {
+            "description": "The supplied request contains invalid fields or could not be parsed (json and non-json bodies). The error's code, message, and cause fields can be inspected for further information",
+            "schema": {
+              "$ref": "#/definitions/apiErrorEnvelope"
+            },
+            "examples": {
+              "application/json": {
+                "error": {
+                  "args": {
+                    "urlVars": {}
+                  },
+                  "cause": {
+                    "details": {
+                      "context": "(root)",
+                      "field": "(root)",
+                      "property": "fooField3"
+                    },
+                    "field": "(root)",
+                    "message": "(root): fooField3 is required",
+                    "type": "required",
+                    "value": {
+                      "fooField": "abc",
+                      "fooField2": "def"
+                    }
+                  },
+                  "causeMessage": "schema validation failed",
+                  "code": "COULD_NOT_VALIDATE",
+                  "message": "The supplied request contains an invalid document",
+                  "requestId": "ac6766d6-3a09-44b3-8d8a-1b541d97fdd9"
+                },
+                "meta": {
+                  "apiEnrollmentVersion": "0.0.1",
+                  "apiVersion": "0.0.1"
+                }
+              }
+            }
+          },
+          "401": {
+            "description": "The currently supplied session does not have the correct access rights to request this resource",
+            "schema": {
+              "$ref": "#/definitions/apiErrorEnvelope"
+            },
+            "examples": {
+              "application/json": {
+                "error": {
+                  "args": {
+                    "urlVars": {}
+                  },
+                  "cause": "",
+                  "causeMessage": "",
+                  "code": "UNAUTHORIZED",
+                  "message": "The request could not be completed. The session is not authorized or the credentials are invalid",
+                  "requestId": "0bfe7a04-9229-4b7a-812c-9eb3cc0eac0f"
+                },
+                "meta": {
+                  "apiEnrollmentVersion": "0.0.1",
+                  "apiVersion": "0.0.1"
+                }
+              }
+            }
+          },
+          "404": {
+            "description": "The requested resource does not exist",
+            "schema": {
+              "$ref": "#/definitions/apiErrorEnvelope"
+            },
+            "examples": {
+              "application/json": {
+                "error": {
+                  "args": {
+                    "urlVars": {
+                      "id": "71a3000f-7dda-491a-9b90-a19f4ee6c406"
+                    }
+                  },
+                  "cause": null,
+                  "causeMessage": "",
+                  "code": "NOT_FOUND",
+                  "message": "The resource requested was not found or is no longer available",
+                  "requestId": "270908d6-f2ef-4577-b973-67bec18ae376"
+                },
+                "meta": {
+                  "apiEnrollmentVersion": "0.0.1",
+                  "apiVersion": "0.0.1"
+                }
+              }
+            }
+          },
+          "429": {
+            "description": "The resource requested is rate limited and the rate limit has been exceeded",
+            "schema": {
+              "$ref": "#/definitions/apiErrorEnvelope"
+            },
+            "examples": {
+              "application/json": {
+                "error": {
+                  "args": {
+                    "urlVars": {}
+                  },
+                  "causeMessage": "you have hit a rate limit in the requested operation",
+                  "code": "RATE_LIMITED",
+                  "message": "The resource is rate limited and the rate limit has been exceeded. Please try again later",
+                  "requestId": "270908d6-f2ef-4577-b973-67bec18ae376"
+                },
+                "meta": {
+                  "apiEnrollmentVersion": "0.0.1",
+                  "apiVersion": "0.0.1"
+                }
+              }
+            }
+          },
+          "503": {
+            "description": "The request could not be completed due to the server being busy or in a temporarily bad state",
+            "schema": {
+              "$ref": "#/definitions/apiErrorEnvelope"
+            }
+          }
+        }
+      },
+      "delete": {
+        "description": "Delete a service by id. Requires admin access.",
+        "tags": [
+          "Service"
+        ],
+        "summary": "Delete a service",
+        "operationId": "deleteService",
+        "responses": {
+          "200": {
+            "description": "The delete request was successful and the resource has been removed",
+            "schema": {
+              "$ref": "#/definitions/empty"
+            }
+          },
+          "400": {
+            "description": "The supplied request contains invalid fields or could not be parsed (json and non-json bodies). The error's code, message, and cause fields can be inspected for further information",
+            "schema": {
+              "$ref": "#/definitions/apiErrorEnvelope"
+            },
+            "examples": {
+              "application/json": {
+                "error": {
+                  "args": {
+                    "urlVars": {}
+                  },
+                  "cause": {
+                    "details": {
+                      "context": "(root)",
+                      "field": "(root)",
+                      "property": "fooField3"
+                    },
+                    "field": "(root)",
+                    "message": "(root): fooField3 is required",
+                    "type": "required",
+                    "value": {
+                      "fooField": "abc",
+                      "fooField2": "def"
+                    }
+                  },
+                  "causeMessage": "schema validation failed",
+                  "code": "COULD_NOT_VALIDATE",
+                  "message": "The supplied request contains an invalid document",
+                  "requestId": "ac6766d6-3a09-44b3-8d8a-1b541d97fdd9"
+                },
+                "meta": {
+                  "apiEnrollmentVersion": "0.0.1",
+                  "apiVersion": "0.0.1"
+                }
+              }
+            }
+          },
+          "401": {
+            "description": "The currently supplied session does not have the correct access rights to request this resource",
+            "schema": {
+              "$ref": "#/definitions/apiErrorEnvelope"
+            },
+            "examples": {
+              "application/json": {
+                "error": {
+                  "args": {
+                    "urlVars": {}
+                  },
+                  "cause": "",
+                  "causeMessage": "",
+                  "code": "UNAUTHORIZED",
+                  "message": "The request could not be completed. The session is not authorized or the credentials are invalid",
+                  "requestId": "0bfe7a04-9229-4b7a-812c-9eb3cc0eac0f"
+                },
+                "meta": {
+                  "apiEnrollmentVersion": "0.0.1",
+                  "apiVersion": "0.0.1"
+                }
+              }
+            }
+          },
+          "409": {
+            "description": "The resource requested to be removed/altered cannot be as it is referenced by another object.",
+            "schema": {
+              "$ref": "#/definitions/apiErrorEnvelope"
+            },
+            "examples": {
+              "application/json": {
+                "error": {
+                  "args": {
+                    "urlVars": {
+                      "id": "71a3000f-7dda-491a-9b90-a19f4ee6c406"
+                    }
+                  },
+                  "causeMessage": "referenced by /some-resource/05f4f710-c155-4a74-86d5-77558eb9cb42",
+                  "code": "CONFLICT_CANNOT_MODIFY_REFERENCED",
+                  "message": "The resource cannot be deleted/modified. Remove all referencing resources first.",
+                  "requestId": "270908d6-f2ef-4577-b973-67bec18ae376"
+                },
+                "meta": {
+                  "apiEnrollmentVersion": "0.0.1",
+                  "apiVersion": "0.0.1"
+                }
+              }
+            }
+          },
+          "429": {
+            "description": "The resource requested is rate limited and the rate limit has been exceeded",
+            "schema": {
+              "$ref": "#/definitions/apiErrorEnvelope"
+            },
+            "examples": {
+              "application/json": {
+                "error": {
+                  "args": {
+                    "urlVars": {}
+                  },
+                  "causeMessage": "you have hit a rate limit in the requested operation",
+                  "code": "RATE_LIMITED",
+                  "message": "The resource is rate limited and the rate limit has been exceeded. Please try again later",
+                  "requestId": "270908d6-f2ef-4577-b973-67bec18ae376"
+                },
+                "meta": {
+                  "apiEnrollmentVersion": "0.0.1",
+                  "apiVersion": "0.0.1"
+                }
+              }
+            }
+          },
+          "503": {
+            "description": "The request could not be completed due to the server being busy or in a temporarily bad state",
+            "schema": {
+              "$ref": "#/definitions/apiErrorEnvelope"
+            }
+          }
+        }
+      },
+      "patch": {
+        "description": "Update the supplied fields on a service. Requires admin access.",
+        "tags": [
+          "Service"
+        ],
+        "summary": "Update the supplied fields on a service",
+        "operationId": "patchService",
+        "parameters": [
+          {
+            "description": "A service patch object",
+            "name": "service",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/servicePatch"
+            }
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "The patch request was successful and the resource has been altered",
+            "schema": {
+              "$ref": "#/definitions/empty"
+            }
+          },
+          "400": {
+            "description": "The supplied request contains invalid fields or could not be parsed (json and non-json bodies). The error's code, message, and cause fields can be inspected for further information",
+            "schema": {
+              "$ref": "#/definitions/apiErrorEnvelope"
+            },
+            "examples": {
+              "application/json": {
+                "error": {
+                  "args": {
+                    "urlVars": {}
+                  },
+                  "cause": {
+                    "details": {
+                      "context": "(root)",
+                      "field": "(root)",
+                      "property": "fooField3"
+                    },
+                    "field": "(root)",
+                    "message": "(root): fooField3 is required",
+                    "type": "required",
+                    "value": {
+                      "fooField": "abc",
+                      "fooField2": "def"
+                    }
+                  },
+                  "causeMessage": "schema validation failed",
+                  "code": "COULD_NOT_VALIDATE",
+                  "message": "The supplied request contains an invalid document",
+                  "requestId": "ac6766d6-3a09-44b3-8d8a-1b541d97fdd9"
+                },
+                "meta": {
+                  "apiEnrollmentVersion": "0.0.1",
+                  "apiVersion": "0.0.1"
+                }
+              }
+            }
+          },
+          "401": {
+            "description": "The currently supplied session does not have the correct access rights to request this resource",
+            "schema": {
+              "$ref": "#/definitions/apiErrorEnvelope"
+            },
+            "examples": {
+              "application/json": {
+                "error": {
+                  "args": {
+                    "urlVars": {}
+                  },
+                  "cause": "",
+                  "causeMessage": "",
+                  "code": "UNAUTHORIZED",
+                  "message": "The request could not be completed. The session is not authorized or the credentials are invalid",
+                  "requestId": "0bfe7a04-9229-4b7a-812c-9eb3cc0eac0f"
+                },
+                "meta": {
+                  "apiEnrollmentVersion": "0.0.1",
+                  "apiVersion": "0.0.1"
+                }
+              }
+            }
+          },
+          "404": {
+            "description": "The requested resource does not exist",
+            "schema": {
+              "$ref": "#/definitions/apiErrorEnvelope"
+            },
+            "examples": {
+              "application/json": {
+                "error": {
+                  "args": {
+                    "urlVars": {
+                      "id": "71a3000f-7dda-491a-9b90-a19f4ee6c406"
+                    }
+                  },
+                  "cause": null,
+                  "causeMessage": "",
+                  "code": "NOT_FOUND",
+                  "message": "The resource requested was not found or is no longer available",
+                  "requestId": "270908d6-f2ef-4577-b973-67bec18ae376"
+                },
+                "meta": {
+                  "apiEnrollmentVersion": "0.0.1",
+                  "apiVersion": "0.0.1"
+                }
+              }
+            }
+          },
+          "429": {
+            "description": "The resource requested is rate limited and the rate limit has been exceeded",
+            "schema": {
+              "$ref": "#/definitions/apiErrorEnvelope"
+            },
+            "examples": {
+              "application/json": {
+                "error": {
+                  "args": {
+                    "urlVars": {}
+                  },
+                  "causeMessage": "you have hit a rate limit in the requested operation",
+                  "code": "RATE_LIMITED",
+                  "message": "The resource is rate limited and the rate limit has been exceeded. Please try again later",
+                  "requestId": "270908d6-f2ef-4577-b973-67bec18ae376"
+                },
+                "meta": {
+                  "apiEnrollmentVersion": "0.0.1",
+                  "apiVersion": "0.0.1"
+                }
+              }
+            }
+          },
+          "503": {
+            "description": "The request could not be completed due to the server being busy or in a temporarily bad state",
+            "schema": {
+              "$ref": "#/definitions/apiErrorEnvelope"
+            }
+          }
+        }
+      },
+      "parameters": [
+        {
+          "type": "string",
+          "description": "The id of the requested resource",
+          "name": "id",
+          "in": "path",
+          "required": true
+        }
+      ]
+    },
+    "/services/{id}/history": {
+      "get": {
+        "description": "Retrieves the audit log entries for a service, oldest first. Requires the audit log to be enabled and\nread access to services.\n",
+        "tags": [
+          "Audit"
+        ],
+        "summary": "List the recorded versions of a service",
+        "operationId": "listServiceHistory",
+        "responses": {
+          "200": {
+            "description": "A list of audit log entries",
+            "schema": {
+              "$ref": "#/definitions/listAuditEntriesEnvelope"
+            }
+          },
+          "401": {
+            "description": "The currently supplied session does not have the correct access rights to request this resource",
+            "schema": {
+              "$ref": "#/definitions/apiErrorEnvelope"
+            },
+            "examples": {
+              "application/json": {
+                "error": {
+                  "args": {
+                    "urlVars": {}
+                  },
+                  "cause": "",
+                  "causeMessage": "",
+                  "code": "UNAUTHORIZED",
+                  "message": "The request could not be completed. The session is not authorized or the credentials are invalid",
+                  "requestId": "0bfe7a04-9229-4b7a-812c-9eb3cc0eac0f"
+                },
+                "meta": {
+                  "apiEnrollmentVersion": "0.0.1",
+                  "apiVersion": "0.0.1"
+                }
+              }
+            }
+          },
+          "409": {
+            "description": "The audit log is not enabled on this controller",
+            "schema": {
+              "$ref": "#/definitions/apiErrorEnvelope"
+            }
+          },
+          "429": {
+            "description": "The resource requested is rate limited and the rate limit has been exceeded",
+            "schema": {
+              "$ref": "#/definitions/apiErrorEnvelope"
+            },
+            "examples": {
+              "application/json": {
+                "error": {
+                  "args": {
+                    "urlVars": {}
+                  },
+                  "causeMessage": "you have hit a rate limit in the requested operation",
+                  "code": "RATE_LIMITED",
+                  "message": "The resource is rate limited and the rate limit has been exceeded. Please try again later",
+                  "requestId": "270908d6-f2ef-4577-b973-67bec18ae376"
+                },
+                "meta": {
+                  "apiEnrollmentVersion": "0.0.1",
+                  "apiVersion": "0.0.1"
+                }
+              }
+            }
+          }
+        }
+      },
+      "parameters": [
+        {
+          "type": "string",
+          "description": "The id of the requested resource",
+          "name": "id",
+          "in": "path",
+          "required": true
+        }
+      ]
+    },
+    "/services/{id}/history/{version}/revert": {
+      "post": {
+        "description": "Restores the service to the state recorded by the given version. The service is recreated if it has been\ndeleted. Requires the audit log to be enabled and update access to services.\n",
+        "tags": [
+          "Audit"
+        ],
+        "summary": "Revert a service to an earlier version",
+        "operationId": "revertService",
+        "responses": {
+          "200": {
+            "description": "Base empty response",
+            "schema": {
+              "$ref": "#/definitions/empty"
+            }
+          },
+          "400": {
+            "description": "The supplied request contains invalid fields or could not be parsed (json and non-json bodies). The error's code, message, and cause fields can be inspected for further information",
+            "schema": {
+              "$ref": "#/definitions/apiErrorEnvelope"
+            },
+            "examples": {
+              "application/json": {
+                "error": {
+                  "args": {
+                    "urlVars": {}
+                  },
+                  "cause": {
+                    "details": {
+                      "context": "(root)",
+                      "field": "(root)",
+                      "property": "fooField3"
+                    },
+                    "field": "(root)",
+                    "message": "(root): fooField3 is required",
+                    "type": "required",
+                    "value": {
+                      "fooField": "abc",
+                      "fooField2": "def"
+                    }
+                  },
+                  "causeMessage": "schema validation failed",
+                  "code": "COULD_NOT_VALIDATE",
+                  "message": "The supplied request contains an invalid document",
+                  "requestId": "ac6766d6-3a09-44b3-8d8a-1b541d97fdd9"
+                },
+                "meta": {
+                  "apiEnrollmentVersion": "0.0.1",
+                  "apiVersion": "0.0.1"
+                }
+              }
+            }
+          },
+          "401": {
+            "description": "The currently supplied session does not have the correct access rights to request this resource",
+            "schema": {
+              "$ref": "#/definitions/apiErrorEnvelope"
+            },
+            "examples": {
+              "application/json": {
+                "error": {
+                  "args": {
+                    "urlVars": {}
+                  },
+                  "cause": "",
+                  "causeMessage": "",
+                  "code": "UNAUTHORIZED",
+                  "message": "The request could not be completed. The session is not authorized or the credentials are invalid",
+                  "requestId": "0bfe7a04-9229-4b7a-812c-9eb3cc0eac0f"
+                },
+                "meta": {
+                  "apiEnrollmentVersion": "0.0.1",
+                  "apiVersion": "0.0.1"
+                }
+              }
+            }
+          },
+          "404": {
+            "description": "The requested resource does not exist",
+            "schema": {
+              "$ref": "#/definitions/apiErrorEnvelope"
+            },
+            "examples": {
+              "application/json": {
+                "error": {
+                  "args": {
+                    "urlVars": {
+                      "id": "71a3000f-7dda-491a-9b90-a19f4ee6c406"
+                    }
+                  },
+                  "cause": null,
+                  "causeMessage": "",
+                  "code": "NOT_FOUND",
+                  "message": "The resource requested was not found or is no longer available",
+                  "requestId": "270908d6-f2ef-4577-b973-67bec18ae376"
+                },
+                "meta": {
+                  "apiEnrollmentVersion": "0.0.1",
+                  "apiVersion": "0.0.1"
+                }
+              }
+            }
+          },
+          "409": {
+            "description": "The audit log is not enabled on this controller",
+            "schema": {
+              "$ref": "#/definitions/apiErrorEnvelope"
+            }
+          },
+          "429": {
+            "description": "The resource requested is rate limited and the rate limit has been exceeded",
+            "schema": {
+              "$ref": "#/definitions/apiErrorEnvelope"
+            },
+            "examples": {
+              "application/json": {
+                "error": {
+                  "args": {
+                    "urlVars": {}
+                  },
+                  "causeMessage": "you have hit a rate limit in the requested operation",
+                  "code": "RATE_LIMITED",
+                  "message": "The resource is rate limited and the rate limit has been exceeded. Please try again later",
+                  "requestId": "270908d6-f2ef-4577-b973-67bec18ae376"
+                },
+                "meta": {
+                  "apiEnrollmentVersion": "0.0.1",
+                  "apiVersion": "0.0.1"
+                }
+              }
+            }
+          },
+          "503": {
+            "description": "The request could not be completed due to the server being busy or in a temporarily bad state",
+            "schema": {
+              "$ref": "#/definitions/apiErrorEnvelope"
+            }
+          }
+        }
+      },
+      "parameters": [
+        {
+          "type": "string",
+          "description": "The id of the requested resource",
+          "name": "id",
+          "in": "path",
+          "required": true
+        },
+        {
+          "type": "integer",
+          "description": "The audit log version to revert to",
+          "name": "version",
+          "in": "path",
+          "required": true
+        }
+      ]
+    },
+    "/services/{id}/terminators": {
+      "get": {
+        "description": "Retrieves a list of terminator resources that are assigned specific service; supports filtering, sorting, and pagination.\n",
+        "tags": [
+          "Service"
+        ],
+        "summary": "List of terminators assigned to a service",
+        "operationId": "listServiceTerminators",
+        "parameters": [
+          {
+            "type": "integer",
+            "name": "limit",
+            "in": "query"
+          },
+          {
+            "type": "integer",
+            "name": "offset",
+            "in": "query"
+          },
+          {
+            "type": "string",
+            "name": "filter",
+            "in": "query"
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "A list of terminators",
+            "schema": {
+              "$ref": "#/definitions/listTerminatorsEnvelope"
+            }
+          },
+          "400": {
+            "description": "The supplied request contains invalid fields or could not be parsed (json and non-json bodies). The error's code, message, and cause fields can be inspected for further information",
+            "schema": {
+              "$ref": "#/definitions/apiErrorEnvelope"
+            },
+            "examples": {
+              "application/json": {
+                "error": {
+                  "args": {
+                    "urlVars": {}
+                  },
+                  "cause": {
+                    "details": {
+                      "context": "(root)",
+                      "field": "(root)",
+                      "property": "fooField3"
+                    },
+                    "field": "(root)",
+                    "message": "(root): fooField3 is required",
+                    "type": "required",
+                    "value": {
+                      "fooField": "abc",
+                      "fooField2": "def"
+                    }
+                  },
+                  "causeMessage": "schema validation failed",
+                  "code": "COULD_NOT_VALIDATE",
+                  "message": "The supplied request contains an invalid document",
+                  "requestId": "ac6766d6-3a09-44b3-8d8a-1b541d97fdd9"
+                },
+                "meta": {
+                  "apiEnrollmentVersion": "0.0.1",
+                  "apiVersion": "0.0.1"
+                }
+              }
+            }
+          },
+          "401": {
+            "description": "The currently supplied session does not have the correct access rights to request this resource",
+            "schema": {
+              "$ref": "#/definitions/apiErrorEnvelope"
+            },
+            "examples": {
+              "application/json": {
+                "error": {
+                  "args": {
+                    "urlVars": {}
+                  },
+                  "cause": "",
+                  "causeMessage": "",
+                  "code": "UNAUTHORIZED",
+                  "message": "The request could not be completed. The session is not authorized or the credentials are invalid",
+                  "requestId": "0bfe7a04-9229-4b7a-812c-9eb3cc0eac0f"
+                },
+                "meta": {
+                  "apiEnrollmentVersion": "0.0.1",
+                  "apiVersion": "0.0.1"
+                }
+              }
+            }
+          },
+          "429": {
+            "description": "The resource requested is rate limited and the rate limit has been exceeded",
+            "schema": {
+              "$ref": "#/definitions/apiErrorEnvelope"
+            },
+            "examples": {
+              "application/json": {
+                "error": {
+                  "args": {
+                    "urlVars": {}
+                  },
+                  "causeMessage": "you have hit a rate limit in the requested operation",
+                  "code": "RATE_LIMITED",
+                  "message": "The resource is rate limited and the rate limit has been exceeded. Please try again later",
+                  "requestId": "270908d6-f2ef-4577-b973-67bec18ae376"
+                },
+                "meta": {
+                  "apiEnrollmentVersion": "0.0.1",
+                  "apiVersion": "0.0.1"
+                }
+              }
+            }
+          }
+        }
+      },
+      "parameters": [
+        {
+          "type": "string",
+          "description": "The id of the requested resource",
+          "name": "id",
+          "in": "path",
+          "required": true
+        }
+      ]
+    },
+    "/terminators": {
+      "get": {
+        "description": "Retrieves a list of terminator resources; supports filtering, sorting, and pagination. Requires admin access.\n",
+        "tags": [
+          "Terminator"
+        ],
+        "summary": "List terminators",
+        "operationId": "listTerminators",
+        "parameters": [
+          {
+            "type": "integer",
+            "name": "limit",
+            "in": "query"
+          },
+          {
+            "type": "integer",
+            "name": "offset",
+            "in": "query"
+          },
+          {
+            "type": "string",
+            "name": "filter",
+            "in": "query"
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "A list of terminators",
+            "schema": {
+              "$ref": "#/definitions/listTerminatorsEnvelope"
+            }
+          },
+          "400": {
+            "description": "The supplied request contains invalid fields or could not be parsed (json and non-json bodies). The error's code, message, and cause fields can be inspected for further information",
+            "schema": {
+              "$ref": "#/definitions/apiErrorEnvelope"
+            },
+            "examples": {
+              "application/json": {
+                "error": {
+                  "args": {
+                    "urlVars": {}
+                  },
+                  "cause": {
+                    "details": {
+                      "context": "(root)",
+                      "field": "(root)",
+                      "property": "fooField3"
+                    },
+                    "field": "(root)",
+                    "message": "(root): fooField3 is required",
+                    "type": "required",
+                    "value": {
+                      "fooField": "abc",
+                      "fooField2": "def"
+                    }
+                  },
+                  "causeMessage": "schema validation failed",
+                  "code": "COULD_NOT_VALIDATE",
+                  "message": "The supplied request contains an invalid document",
+                  "requestId": "ac6766d6-3a09-44b3-8d8a-1b541d97fdd9"
+                },
+                "meta": {
+                  "apiEnrollmentVersion": "0.0.1",
+                  "apiVersion": "0.0.1"
+                }
+              }
+            }
+          },
+          "401": {
+            "description": "The currently supplied session does not have the correct access rights to request this resource",
+            "schema": {
+              "$ref": "#/definitions/apiErrorEnvelope"
+            },
+            "examples": {
+              "application/json": {
+                "error": {
+                  "args": {
+                    "urlVars": {}
+                  },
+                  "cause": "",
+                  "causeMessage": "",
+                  "code": "UNAUTHORIZED",
+                  "message": "The request could not be completed. The session is not authorized or the credentials are invalid",
+                  "requestId": "0bfe7a04-9229-4b7a-812c-9eb3cc0eac0f"
+                },
+                "meta": {
+                  "apiEnrollmentVersion": "0.0.1",
+                  "apiVersion": "0.0.1"
+                }
+              }
+            }
+          },
+          "429": {
+            "description": "The resource requested is rate limited and the rate limit has been exceeded",
+            "schema": {
+              "$ref": "#/definitions/apiErrorEnvelope"
+            },
+            "examples": {
+              "application/json": {
+                "error": {
+                  "args": {
+                    "urlVars": {}
+                  },
+                  "causeMessage": "you have hit a rate limit in the requested operation",
+                  "code": "RATE_LIMITED",
+                  "message": "The resource is rate limited and the rate limit has been exceeded. Please try again later",
+                  "requestId": "270908d6-f2ef-4577-b973-67bec18ae376"
+                },
+                "meta": {
+                  "apiEnrollmentVersion": "0.0.1",
+                  "apiVersion": "0.0.1"
+                }
+              }
+            }
+          }
+        }
+      },
+      "post": {
+        "description": "Create a terminator resource. Requires admin access.",
+        "tags": [
+          "Terminator"
+        ],
+        "summary": "Create a terminator resource",
+        "operationId": "createTerminator",
+        "parameters": [
+          {
+            "description": "A terminator to create",
+            "name": "terminator",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/terminatorCreate"
+            }
+          }
+        ],
+        "responses": {
+          "201": {
+            "description": "The create request was successful and the resource has been added at the following location",
+            "schema": {
+              "$ref": "#/definitions/createEnvelope"
+            }
+          },
+          "400": {
+            "description": "The supplied request contains invalid fields or could not be parsed (json and non-json bodies). The error's code, message, and cause fields can be inspected for further information",
+            "schema": {
+              "$ref": "#/definitions/apiErrorEnvelope"
+            },
+            "examples": {
+              "application/json": {
+                "error": {
+                  "args": {
+                    "urlVars": {}
+                  },
+                  "cause": {
+                    "details": {
+                      "context": "(root)",
+                      "field": "(root)",
+                      "property": "fooField3"
+                    },
+                    "field": "(root)",
+                    "message": "(root): fooField3 is required",
+                    "type": "required",
+                    "value": {
+                      "fooField": "abc",
+                      "fooField2": "def"
+                    }
+                  },
+                  "causeMessage": "schema validation failed",
+                  "code": "COULD_NOT_VALIDATE",
+                  "message": "The supplied request contains an invalid document",
+                  "requestId": "ac6766d6-3a09-44b3-8d8a-1b541d97fdd9"
+                },
+                "meta": {
+                  "apiEnrollmentVersion": "0.0.1",
+                  "apiVersion": "0.0.1"
+                }
+              }
+            }
+          },
+          "401": {
+            "description": "The currently supplied session does not have the correct access rights to request this resource",
+            "schema": {
+              "$ref": "#/definitions/apiErrorEnvelope"
+            },
+            "examples": {
+              "application/json": {
+                "error": {
+                  "args": {
+                    "urlVars": {}
+                  },
+                  "cause": "",
+                  "causeMessage": "",
+                  "code": "UNAUTHORIZED",
+                  "message": "The request could not be completed. The session is not authorized or the credentials are invalid",
+                  "requestId": "0bfe7a04-9229-4b7a-812c-9eb3cc0eac0f"
+                },
+                "meta": {
+                  "apiEnrollmentVersion": "0.0.1",
+                  "apiVersion": "0.0.1"
+                }
+              }
+            }
+          },
+          "429": {
+            "description": "The resource requested is rate limited and the rate limit has been exceeded",
+            "schema": {
+              "$ref": "#/definitions/apiErrorEnvelope"
+            },
+            "examples": {
               "application/json": {
                 "error": {
                   "args": {
                     "urlVars": {}
                   },
-                  "cause": {
-                    "details": {
-                      "context": "(root)",
-                      "field": "(root)",
-                      "property": "fooField3"
-                    },
-                    "field": "(root)",
-                    "message": "(root): fooField3 is required",
-                    "type": "required",
-                    "value": {
-                      "fooField": "abc",
-                      "fooField2": "def"
-                    }
-                  },
-                  "causeMessage": "schema validation failed",
-                  "code": "COULD_NOT_VALIDATE",
-                  "message": "The supplied request contains an invalid document",
-                  "requestId": "ac6766d6-3a09-44b3-8d8a-1b541d97fdd9"
+                  "causeMessage": "you have hit a rate limit in the requested operation",
+                  "code": "RATE_LIMITED",
+                  "message": "The resource is rate limited and the rate limit has been exceeded. Please try again later",
+                  "requestId": "270908d6-f2ef-4577-b973-67bec18ae376"
                 },
                 "meta": {
                   "apiEnrollmentVersion": "0.0.1",
@@ -4994,6 +15981,30 @@ func init() {
               }
             }
           },
+          "503": {
+            "description": "The request could not be completed due to the server being busy or in a temporarily bad state",
+            "schema": {
+              "$ref": "#/definitions/apiErrorEnvelope"
+            }
+          }
+        }
+      }
+    },
+    "/terminators/{id}": {
+      "get": {
+        "description": "Retrieves a single terminator by id. Requires admin access.",
+        "tags": [
+          "Terminator"
+        ],
+        "summary": "Retrieves a single terminator",
+        "operationId": "detailTerminator",
+        "responses": {
+          "200": {
+            "description": "A single terminator",
+            "schema": {
+              "$ref": "#/definitions/detailTerminatorEnvelope"
+            }
+          },
           "401": {
             "description": "The currently supplied session does not have the correct access rights to request this resource",
             "schema": {
@@ -5066,25 +16077,30 @@ func init() {
                 }
               }
             }
-          },
-          "503": {
-            "description": "The request could not be completed due to the server being busy or in a temporarily bad state",
-            "schema": {
-              "$ref": "#/definitions/apiErrorEnvelope"
-            }
           }
         }
       },
-      "delete": {
-        "description": "Delete a router by id. Requires admin access.",
+      "put": {
+        "description": "Update all fields on a terminator by id. Requires admin access.",
         "tags": [
-          "Router"
+          "Terminator"
+        ],
+        "summary": "Update all fields on a terminator",
+        "operationId": "updateTerminator",
+        "parameters": [
+          {
+            "description": "A terminator update object",
+            "name": "terminator",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/terminatorUpdate"
+            }
+          }
         ],
-        "summary": "Delete a router",
-        "operationId": "deleteRouter",
         "responses": {
           "200": {
-            "description": "The delete request was successful and the resource has been removed",
+            "description": "The update request was successful and the resource has been altered",
             "schema": {
               "$ref": "#/definitions/empty"
             }
@@ -5150,8 +16166,8 @@ func init() {
               }
             }
           },
-          "409": {
-            "description": "The resource requested to be removed/altered cannot be as it is referenced by another object.",
+          "404": {
+            "description": "The requested resource does not exist",
             "schema": {
               "$ref": "#/definitions/apiErrorEnvelope"
             },
@@ -5163,9 +16179,10 @@ func init() {
                       "id": "71a3000f-7dda-491a-9b90-a19f4ee6c406"
                     }
                   },
-                  "causeMessage": "referenced by /some-resource/05f4f710-c155-4a74-86d5-77558eb9cb42",
-                  "code": "CONFLICT_CANNOT_MODIFY_REFERENCED",
-                  "message": "The resource cannot be deleted/modified. Remove all referencing resources first.",
+                  "cause": null,
+                  "causeMessage": "",
+                  "code": "NOT_FOUND",
+                  "message": "The resource requested was not found or is no longer available",
                   "requestId": "270908d6-f2ef-4577-b973-67bec18ae376"
                 },
                 "meta": {
@@ -5206,27 +16223,16 @@ func init() {
           }
         }
       },
-      "patch": {
-        "description": "Update the supplied fields on a router. Requires admin access.",
+      "delete": {
+        "description": "Delete a terminator by id. Requires admin access.",
         "tags": [
-          "Router"
-        ],
-        "summary": "Update the supplied fields on a router",
-        "operationId": "patchRouter",
-        "parameters": [
-          {
-            "description": "A router patch object",
-            "name": "router",
-            "in": "body",
-            "required": true,
-            "schema": {
-              "$ref": "#/definitions/routerPatch"
-            }
-          }
+          "Terminator"
         ],
+        "summary": "Delete a terminator",
+        "operationId": "deleteTerminator",
         "responses": {
           "200": {
-            "description": "The patch request was successful and the resource has been altered",
+            "description": "The delete request was successful and the resource has been removed",
             "schema": {
               "$ref": "#/definitions/empty"
             }
@@ -5292,8 +16298,8 @@ func init() {
               }
             }
           },
-          "404": {
-            "description": "The requested resource does not exist",
+          "409": {
+            "description": "The resource requested to be removed/altered cannot be as it is referenced by another object.",
             "schema": {
               "$ref": "#/definitions/apiErrorEnvelope"
             },
@@ -5305,10 +16311,9 @@ func init() {
                       "id": "71a3000f-7dda-491a-9b90-a19f4ee6c406"
                     }
                   },
-                  "cause": null,
-                  "causeMessage": "",
-                  "code": "NOT_FOUND",
-                  "message": "The resource requested was not found or is no longer available",
+                  "causeMessage": "referenced by /some-resource/05f4f710-c155-4a74-86d5-77558eb9cb42",
+                  "code": "CONFLICT_CANNOT_MODIFY_REFERENCED",
+                  "message": "The resource cannot be deleted/modified. Remove all referencing resources first.",
                   "requestId": "270908d6-f2ef-4577-b973-67bec18ae376"
                 },
                 "meta": {
@@ -5349,46 +16354,29 @@ func init() {
           }
         }
       },
-      "parameters": [
-        {
-          "type": "string",
-          "description": "The id of the requested resource",
-          "name": "id",
-          "in": "path",
-          "required": true
-        }
-      ]
-    },
-    "/routers/{id}/terminators": {
-      "get": {
-        "description": "Retrieves a list of terminator resources that are assigned specific router; supports filtering, sorting, and pagination.\n",
+      "patch": {
+        "description": "Update the supplied fields on a terminator. Requires admin access.",
         "tags": [
-          "Router"
+          "Terminator"
         ],
-        "summary": "List of terminators assigned to a router",
-        "operationId": "listRouterTerminators",
+        "summary": "Update the supplied fields on a terminator",
+        "operationId": "patchTerminator",
         "parameters": [
           {
-            "type": "integer",
-            "name": "limit",
-            "in": "query"
-          },
-          {
-            "type": "integer",
-            "name": "offset",
-            "in": "query"
-          },
-          {
-            "type": "string",
-            "name": "filter",
-            "in": "query"
+            "description": "A terminator patch object",
+            "name": "terminator",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/terminatorPatch"
+            }
           }
         ],
         "responses": {
           "200": {
-            "description": "A list of terminators",
+            "description": "The patch request was successful and the resource has been altered",
             "schema": {
-              "$ref": "#/definitions/listTerminatorsEnvelope"
+              "$ref": "#/definitions/empty"
             }
           },
           "400": {
@@ -5452,75 +16440,8 @@ func init() {
               }
             }
           },
-          "429": {
-            "description": "The resource requested is rate limited and the rate limit has been exceeded",
-            "schema": {
-              "$ref": "#/definitions/apiErrorEnvelope"
-            },
-            "examples": {
-              "application/json": {
-                "error": {
-                  "args": {
-                    "urlVars": {}
-                  },
-                  "causeMessage": "you have hit a rate limit in the requested operation",
-                  "code": "RATE_LIMITED",
-                  "message": "The resource is rate limited and the rate limit has been exceeded. Please try again later",
-                  "requestId": "270908d6-f2ef-4577-b973-67bec18ae376"
-                },
-                "meta": {
-                  "apiEnrollmentVersion": "0.0.1",
-                  "apiVersion": "0.0.1"
-                }
-              }
-            }
-          }
-        }
-      },
-      "parameters": [
-        {
-          "type": "string",
-          "description": "The id of the requested resource",
-          "name": "id",
-          "in": "path",
-          "required": true
-        }
-      ]
-    },
-    "/services": {
-      "get": {
-        "description": "Retrieves a list of service resources; supports filtering, sorting, and pagination. Requires admin access.\n",
-        "tags": [
-          "Service"
-        ],
-        "summary": "List services",
-        "operationId": "listServices",
-        "parameters": [
-          {
-            "type": "integer",
-            "name": "limit",
-            "in": "query"
-          },
-          {
-            "type": "integer",
-            "name": "offset",
-            "in": "query"
-          },
-          {
-            "type": "string",
-            "name": "filter",
-            "in": "query"
-          }
-        ],
-        "responses": {
-          "200": {
-            "description": "A list of services",
-            "schema": {
-              "$ref": "#/definitions/listServicesEnvelope"
-            }
-          },
-          "401": {
-            "description": "The currently supplied session does not have the correct access rights to request this resource",
+          "404": {
+            "description": "The requested resource does not exist",
             "schema": {
               "$ref": "#/definitions/apiErrorEnvelope"
             },
@@ -5528,13 +16449,15 @@ func init() {
               "application/json": {
                 "error": {
                   "args": {
-                    "urlVars": {}
+                    "urlVars": {
+                      "id": "71a3000f-7dda-491a-9b90-a19f4ee6c406"
+                    }
                   },
-                  "cause": "",
+                  "cause": null,
                   "causeMessage": "",
-                  "code": "UNAUTHORIZED",
-                  "message": "The request could not be completed. The session is not authorized or the credentials are invalid",
-                  "requestId": "0bfe7a04-9229-4b7a-812c-9eb3cc0eac0f"
+                  "code": "NOT_FOUND",
+                  "message": "The resource requested was not found or is no longer available",
+                  "requestId": "270908d6-f2ef-4577-b973-67bec18ae376"
                 },
                 "meta": {
                   "apiEnrollmentVersion": "0.0.1",
@@ -5565,69 +16488,38 @@ func init() {
                 }
               }
             }
+          },
+          "503": {
+            "description": "The request could not be completed due to the server being busy or in a temporarily bad state",
+            "schema": {
+              "$ref": "#/definitions/apiErrorEnvelope"
+            }
           }
         }
       },
-      "post": {
-        "description": "Create a service resource. Requires admin access.",
+      "parameters": [
+        {
+          "type": "string",
+          "description": "The id of the requested resource",
+          "name": "id",
+          "in": "path",
+          "required": true
+        }
+      ]
+    },
+    "/topology": {
+      "get": {
+        "description": "Retrieves a snapshot of the overlay graph as seen by this controller: the routers, whether they're connected,\nthe links between them with their costs and latencies, and the number of circuits using each router and link.\nRequires admin access.\n",
         "tags": [
-          "Service"
-        ],
-        "summary": "Create a service resource",
-        "operationId": "createService",
-        "parameters": [
-          {
-            "description": "A service to create",
-            "name": "service",
-            "in": "body",
-            "required": true,
-            "schema": {
-              "$ref": "#/definitions/serviceCreate"
-            }
-          }
+          "Topology"
         ],
+        "summary": "Retrieve the network topology",
+        "operationId": "detailTopology",
         "responses": {
-          "201": {
-            "description": "The create request was successful and the resource has been added at the following location",
-            "schema": {
-              "$ref": "#/definitions/createEnvelope"
-            }
-          },
-          "400": {
-            "description": "The supplied request contains invalid fields or could not be parsed (json and non-json bodies). The error's code, message, and cause fields can be inspected for further information",
+          "200": {
+            "description": "A snapshot of the network topology",
             "schema": {
-              "$ref": "#/definitions/apiErrorEnvelope"
-            },
-            "examples": {
-              "application/json": {
-                "error": {
-                  "args": {
-                    "urlVars": {}
-                  },
-                  "cause": {
-                    "details": {
-                      "context": "(root)",
-                      "field": "(root)",
-                      "property": "fooField3"
-                    },
-                    "field": "(root)",
-                    "message": "(root): fooField3 is required",
-                    "type": "required",
-                    "value": {
-                      "fooField": "abc",
-                      "fooField2": "def"
-                    }
-                  },
-                  "causeMessage": "schema validation failed",
-                  "code": "COULD_NOT_VALIDATE",
-                  "message": "The supplied request contains an invalid document",
-                  "requestId": "ac6766d6-3a09-44b3-8d8a-1b541d97fdd9"
-                },
-                "meta": {
-                  "apiEnrollmentVersion": "0.0.1",
-                  "apiVersion": "0.0.1"
-                }
-              }
+              "$ref": "#/definitions/topologyEnvelope"
             }
           },
           "401": {
@@ -5676,1727 +16568,2333 @@ func init() {
                 }
               }
             }
-          },
-          "503": {
-            "description": "The request could not be completed due to the server being busy or in a temporarily bad state",
-            "schema": {
-              "$ref": "#/definitions/apiErrorEnvelope"
-            }
           }
         }
       }
+    }
+  },
+  "definitions": {
+    "alertDetail": {
+      "type": "object",
+      "required": [
+        "id",
+        "rule",
+        "severity",
+        "state",
+        "entityType",
+        "entityId",
+        "message",
+        "pendingSince"
+      ],
+      "properties": {
+        "entityId": {
+          "type": "string"
+        },
+        "entityName": {
+          "type": "string"
+        },
+        "entityType": {
+          "type": "string"
+        },
+        "firingSince": {
+          "type": "string",
+          "format": "date-time"
+        },
+        "id": {
+          "description": "The alert id, shared with the alert events emitted when the alert fires and resolves",
+          "type": "string"
+        },
+        "message": {
+          "type": "string"
+        },
+        "pendingSince": {
+          "type": "string",
+          "format": "date-time"
+        },
+        "rule": {
+          "type": "string"
+        },
+        "severity": {
+          "type": "string",
+          "enum": [
+            "info",
+            "warning",
+            "error",
+            "critical"
+          ]
+        },
+        "silencedBy": {
+          "description": "The id of the silence suppressing the alert's events, if any",
+          "type": "string"
+        },
+        "state": {
+          "type": "string",
+          "enum": [
+            "pending",
+            "firing"
+          ]
+        }
+      }
+    },
+    "alertList": {
+      "type": "array",
+      "items": {
+        "$ref": "#/definitions/alertDetail"
+      }
+    },
+    "alertRuleDetail": {
+      "type": "object",
+      "required": [
+        "name",
+        "type",
+        "severity",
+        "forSeconds"
+      ],
+      "properties": {
+        "expiresWithinSeconds": {
+          "description": "For certExpiry rules, how long before expiry the rule fires",
+          "type": "integer"
+        },
+        "filter": {
+          "description": "Selects the services or routers the rule applies to. Not used by linkLatency rules",
+          "type": "string"
+        },
+        "forSeconds": {
+          "description": "How long the rule condition must hold before the alert fires",
+          "type": "integer"
+        },
+        "latencyMillis": {
+          "description": "For linkLatency rules, the latency above which the rule fires",
+          "type": "integer"
+        },
+        "minTerminators": {
+          "description": "For serviceTerminators rules, the number of terminators below which the rule fires",
+          "type": "integer"
+        },
+        "name": {
+          "type": "string"
+        },
+        "percentile": {
+          "description": "For linkLatency rules, the percentile of the latency samples which is compared",
+          "type": "number"
+        },
+        "severity": {
+          "type": "string"
+        },
+        "type": {
+          "type": "string",
+          "enum": [
+            "linkLatency",
+            "serviceTerminators",
+            "routerOffline",
+            "certExpiry"
+          ]
+        },
+        "windowSeconds": {
+          "description": "For linkLatency rules, the window over which latency samples are collected",
+          "type": "integer"
+        }
+      }
+    },
+    "alertRuleList": {
+      "type": "array",
+      "items": {
+        "$ref": "#/definitions/alertRuleDetail"
+      }
+    },
+    "alertSilenceCreate": {
+      "type": "object",
+      "required": [
+        "expiresAt"
+      ],
+      "properties": {
+        "comment": {
+          "type": "string"
+        },
+        "entityId": {
+          "description": "The id of the entity whose alerts are silenced. At least one of rule and entityId must be provided",
+          "type": "string"
+        },
+        "expiresAt": {
+          "type": "string",
+          "format": "date-time"
+        },
+        "rule": {
+          "description": "The name of the rule whose alerts are silenced. At least one of rule and entityId must be provided",
+          "type": "string"
+        }
+      }
+    },
+    "alertSilenceDetail": {
+      "type": "object",
+      "required": [
+        "id",
+        "createdAt",
+        "expiresAt"
+      ],
+      "properties": {
+        "comment": {
+          "type": "string"
+        },
+        "createdAt": {
+          "type": "string",
+          "format": "date-time"
+        },
+        "entityId": {
+          "type": "string"
+        },
+        "expiresAt": {
+          "type": "string",
+          "format": "date-time"
+        },
+        "id": {
+          "type": "string"
+        },
+        "rule": {
+          "type": "string"
+        }
+      }
+    },
+    "alertSilenceEnvelope": {
+      "type": "object",
+      "required": [
+        "meta",
+        "data"
+      ],
+      "properties": {
+        "data": {
+          "$ref": "#/definitions/alertSilenceDetail"
+        },
+        "meta": {
+          "$ref": "#/definitions/meta"
+        }
+      }
+    },
+    "alertSilenceList": {
+      "type": "array",
+      "items": {
+        "$ref": "#/definitions/alertSilenceDetail"
+      }
+    },
+    "apiError": {
+      "type": "object",
+      "properties": {
+        "args": {
+          "$ref": "#/definitions/apiErrorArgs"
+        },
+        "cause": {
+          "$ref": "#/definitions/apiErrorCause"
+        },
+        "causeMessage": {
+          "type": "string"
+        },
+        "code": {
+          "type": "string"
+        },
+        "data": {
+          "type": "object",
+          "additionalProperties": true
+        },
+        "message": {
+          "type": "string"
+        },
+        "requestId": {
+          "type": "string"
+        }
+      }
+    },
+    "apiErrorArgs": {
+      "type": "object",
+      "properties": {
+        "urlVars": {
+          "type": "object",
+          "additionalProperties": {
+            "type": "string"
+          }
+        }
+      }
+    },
+    "apiErrorCause": {
+      "allOf": [
+        {
+          "$ref": "#/definitions/apiFieldError"
+        },
+        {
+          "$ref": "#/definitions/apiError"
+        }
+      ]
+    },
+    "apiErrorEnvelope": {
+      "type": "object",
+      "required": [
+        "meta",
+        "error"
+      ],
+      "properties": {
+        "error": {
+          "$ref": "#/definitions/apiError"
+        },
+        "meta": {
+          "$ref": "#/definitions/meta"
+        }
+      }
     },
-    "/services/{id}": {
-      "get": {
-        "description": "Retrieves a single service by id. Requires admin access.",
-        "tags": [
-          "Service"
-        ],
-        "summary": "Retrieves a single service",
-        "operationId": "detailService",
-        "responses": {
-          "200": {
-            "description": "A single service",
-            "schema": {
-              "$ref": "#/definitions/detailServiceEnvelope"
-            }
-          },
-          "401": {
-            "description": "The currently supplied session does not have the correct access rights to request this resource",
-            "schema": {
-              "$ref": "#/definitions/apiErrorEnvelope"
-            },
-            "examples": {
-              "application/json": {
-                "error": {
-                  "args": {
-                    "urlVars": {}
-                  },
-                  "cause": "",
-                  "causeMessage": "",
-                  "code": "UNAUTHORIZED",
-                  "message": "The request could not be completed. The session is not authorized or the credentials are invalid",
-                  "requestId": "0bfe7a04-9229-4b7a-812c-9eb3cc0eac0f"
-                },
-                "meta": {
-                  "apiEnrollmentVersion": "0.0.1",
-                  "apiVersion": "0.0.1"
-                }
-              }
-            }
-          },
-          "404": {
-            "description": "The requested resource does not exist",
-            "schema": {
-              "$ref": "#/definitions/apiErrorEnvelope"
-            },
-            "examples": {
-              "application/json": {
-                "error": {
-                  "args": {
-                    "urlVars": {
-                      "id": "71a3000f-7dda-491a-9b90-a19f4ee6c406"
-                    }
-                  },
-                  "cause": null,
-                  "causeMessage": "",
-                  "code": "NOT_FOUND",
-                  "message": "The resource requested was not found or is no longer available",
-                  "requestId": "270908d6-f2ef-4577-b973-67bec18ae376"
-                },
-                "meta": {
-                  "apiEnrollmentVersion": "0.0.1",
-                  "apiVersion": "0.0.1"
-                }
-              }
-            }
-          },
-          "429": {
-            "description": "The resource requested is rate limited and the rate limit has been exceeded",
-            "schema": {
-              "$ref": "#/definitions/apiErrorEnvelope"
-            },
-            "examples": {
-              "application/json": {
-                "error": {
-                  "args": {
-                    "urlVars": {}
-                  },
-                  "causeMessage": "you have hit a rate limit in the requested operation",
-                  "code": "RATE_LIMITED",
-                  "message": "The resource is rate limited and the rate limit has been exceeded. Please try again later",
-                  "requestId": "270908d6-f2ef-4577-b973-67bec18ae376"
-                },
-                "meta": {
-                  "apiEnrollmentVersion": "0.0.1",
-                  "apiVersion": "0.0.1"
-                }
-              }
-            }
-          }
+    "apiFieldError": {
+      "type": "object",
+      "properties": {
+        "field": {
+          "type": "string"
+        },
+        "reason": {
+          "type": "string"
+        },
+        "value": {
+          "type": "string"
         }
-      },
-      "put": {
-        "description": "Update all fields on a service by id. Requires admin access.",
-        "tags": [
-          "Service"
-        ],
-        "summary": "Update all fields on a service",
-        "operationId": "updateService",
-        "parameters": [
-          {
-            "description": "A service update object",
-            "name": "service",
-            "in": "body",
-            "required": true,
-            "schema": {
-              "$ref": "#/definitions/serviceUpdate"
-            }
-          }
-        ],
-        "responses": {
-          "200": {
-            "description": "The update request was successful and the resource has been altered",
-            "schema": {
-              "$ref": "#/definitions/empty"
-            }
-          },
-          "400": {
-            "description": "The supplied request contains invalid fields or could not be parsed (json and non-json bodies). The error's code, message, and cause fields can be inspected for further information",
-            "schema": {
-              "$ref": "#/definitions/apiErrorEnvelope"
-            },
-            "examples": {
-              "application/json": {
-                "error": {
-                  "args": {
-                    "urlVars": {}
-                  },
-                  "cause": {
-                    "details": {
-                      "context": "(root)",
-                      "field": "(root)",
-                      "property": "fooField3"
-                    },
-                    "field": "(root)",
-                    "message": "(root): fooField3 is required",
-                    "type": "required",
-                    "value": {
-                      "fooField": "abc",
-                      "fooField2": "def"
-                    }
-                  },
-                  "causeMessage": "schema validation failed",
-                  "code": "COULD_NOT_VALIDATE",
-                  "message": "The supplied request contains an invalid document",
-                  "requestId": "ac6766d6-3a09-44b3-8d8a-1b541d97fdd9"
-                },
-                "meta": {
-                  "apiEnrollmentVersion": "0.0.1",
-                  "apiVersion": "0.0.1"
-                }
-              }
-            }
-          },
-          "401": {
-            "description": "The currently supplied session does not have the correct access rights to request this resource",
-            "schema": {
-              "$ref": "#/definitions/apiErrorEnvelope"
-            },
-            "examples": {
-              "application/json": {
-                "error": {
-                  "args": {
-                    "urlVars": {}
-                  },
-                  "cause": "",
-                  "causeMessage": "",
-                  "code": "UNAUTHORIZED",
-                  "message": "The request could not be completed. The session is not authorized or the credentials are invalid",
-                  "requestId": "0bfe7a04-9229-4b7a-812c-9eb3cc0eac0f"
-                },
-                "meta": {
-                  "apiEnrollmentVersion": "0.0.1",
-                  "apiVersion": "0.0.1"
-                }
-              }
-            }
-          },
-          "404": {
-            "description": "The requested resource does not exist",
-            "schema": {
-              "$ref": "#/definitions/apiErrorEnvelope"
+      }
+    },
+    "auditAuthor": {
+      "type": "object",
+      "properties": {
+        "id": {
+          "type": "string"
+        },
+        "name": {
+          "type": "string"
+        },
+        "type": {
+          "type": "string"
+        }
+      }
+    },
+    "auditEntry": {
+      "type": "object",
+      "required": [
+        "sequence",
+        "entityType",
+        "entityId",
+        "version",
+        "changeType",
+        "timestamp"
+      ],
+      "properties": {
+        "author": {
+          "$ref": "#/definitions/auditAuthor"
+        },
+        "changeType": {
+          "type": "string",
+          "enum": [
+            "created",
+            "updated",
+            "deleted"
+          ]
+        },
+        "entityId": {
+          "type": "string"
+        },
+        "entityType": {
+          "type": "string"
+        },
+        "eventId": {
+          "type": "string"
+        },
+        "finalState": {
+          "type": "object"
+        },
+        "initialState": {
+          "type": "object"
+        },
+        "sequence": {
+          "type": "integer"
+        },
+        "source": {
+          "$ref": "#/definitions/auditSource"
+        },
+        "timestamp": {
+          "type": "string",
+          "format": "date-time"
+        },
+        "traceId": {
+          "type": "string"
+        },
+        "version": {
+          "type": "integer"
+        }
+      }
+    },
+    "auditEntryList": {
+      "type": "array",
+      "items": {
+        "$ref": "#/definitions/auditEntry"
+      }
+    },
+    "auditSource": {
+      "type": "object",
+      "properties": {
+        "auth": {
+          "type": "string"
+        },
+        "localAddr": {
+          "type": "string"
+        },
+        "method": {
+          "type": "string"
+        },
+        "remoteAddr": {
+          "type": "string"
+        },
+        "type": {
+          "type": "string"
+        }
+      }
+    },
+    "bandwidthBurst": {
+      "description": "The number of bytes which may be sent at once, above the sustained rate. Defaults to one second of traffic",
+      "type": "integer",
+      "format": "int64"
+    },
+    "bandwidthLimitCreate": {
+      "type": "object",
+      "required": [
+        "name",
+        "rate"
+      ],
+      "properties": {
+        "burst": {
+          "$ref": "#/definitions/bandwidthBurst"
+        },
+        "edgeRouterPolicies": {
+          "$ref": "#/definitions/edgeRouterPolicyIds"
+        },
+        "identityRoles": {
+          "$ref": "#/definitions/roles"
+        },
+        "name": {
+          "type": "string"
+        },
+        "rate": {
+          "$ref": "#/definitions/bandwidthRate"
+        },
+        "serviceRoles": {
+          "$ref": "#/definitions/roles"
+        },
+        "shared": {
+          "type": "boolean"
+        },
+        "tags": {
+          "$ref": "#/definitions/tags"
+        }
+      }
+    },
+    "bandwidthLimitDetail": {
+      "type": "object",
+      "allOf": [
+        {
+          "$ref": "#/definitions/baseEntity"
+        },
+        {
+          "type": "object",
+          "required": [
+            "name",
+            "rate",
+            "burst",
+            "shared",
+            "identityRoles",
+            "serviceRoles",
+            "edgeRouterPolicies"
+          ],
+          "properties": {
+            "burst": {
+              "$ref": "#/definitions/bandwidthBurst"
             },
-            "examples": {
-              "application/json": {
-                "error": {
-                  "args": {
-                    "urlVars": {
-                      "id": "71a3000f-7dda-491a-9b90-a19f4ee6c406"
-                    }
-                  },
-                  "cause": null,
-                  "causeMessage": "",
-                  "code": "NOT_FOUND",
-                  "message": "The resource requested was not found or is no longer available",
-                  "requestId": "270908d6-f2ef-4577-b973-67bec18ae376"
-                },
-                "meta": {
-                  "apiEnrollmentVersion": "0.0.1",
-                  "apiVersion": "0.0.1"
-                }
-              }
-            }
-          },
-          "429": {
-            "description": "The resource requested is rate limited and the rate limit has been exceeded",
-            "schema": {
-              "$ref": "#/definitions/apiErrorEnvelope"
+            "edgeRouterPolicies": {
+              "$ref": "#/definitions/edgeRouterPolicyIds"
             },
-            "examples": {
-              "application/json": {
-                "error": {
-                  "args": {
-                    "urlVars": {}
-                  },
-                  "causeMessage": "you have hit a rate limit in the requested operation",
-                  "code": "RATE_LIMITED",
-                  "message": "The resource is rate limited and the rate limit has been exceeded. Please try again later",
-                  "requestId": "270908d6-f2ef-4577-b973-67bec18ae376"
-                },
-                "meta": {
-                  "apiEnrollmentVersion": "0.0.1",
-                  "apiVersion": "0.0.1"
-                }
-              }
-            }
-          },
-          "503": {
-            "description": "The request could not be completed due to the server being busy or in a temporarily bad state",
-            "schema": {
-              "$ref": "#/definitions/apiErrorEnvelope"
-            }
-          }
-        }
-      },
-      "delete": {
-        "description": "Delete a service by id. Requires admin access.",
-        "tags": [
-          "Service"
-        ],
-        "summary": "Delete a service",
-        "operationId": "deleteService",
-        "responses": {
-          "200": {
-            "description": "The delete request was successful and the resource has been removed",
-            "schema": {
-              "$ref": "#/definitions/empty"
-            }
-          },
-          "400": {
-            "description": "The supplied request contains invalid fields or could not be parsed (json and non-json bodies). The error's code, message, and cause fields can be inspected for further information",
-            "schema": {
-              "$ref": "#/definitions/apiErrorEnvelope"
+            "identityRoles": {
+              "$ref": "#/definitions/roles"
             },
-            "examples": {
-              "application/json": {
-                "error": {
-                  "args": {
-                    "urlVars": {}
-                  },
-                  "cause": {
-                    "details": {
-                      "context": "(root)",
-                      "field": "(root)",
-                      "property": "fooField3"
-                    },
-                    "field": "(root)",
-                    "message": "(root): fooField3 is required",
-                    "type": "required",
-                    "value": {
-                      "fooField": "abc",
-                      "fooField2": "def"
-                    }
-                  },
-                  "causeMessage": "schema validation failed",
-                  "code": "COULD_NOT_VALIDATE",
-                  "message": "The supplied request contains an invalid document",
-                  "requestId": "ac6766d6-3a09-44b3-8d8a-1b541d97fdd9"
-                },
-                "meta": {
-                  "apiEnrollmentVersion": "0.0.1",
-                  "apiVersion": "0.0.1"
-                }
-              }
-            }
-          },
-          "401": {
-            "description": "The currently supplied session does not have the correct access rights to request this resource",
-            "schema": {
-              "$ref": "#/definitions/apiErrorEnvelope"
+            "name": {
+              "type": "string"
             },
-            "examples": {
-              "application/json": {
-                "error": {
-                  "args": {
-                    "urlVars": {}
-                  },
-                  "cause": "",
-                  "causeMessage": "",
-                  "code": "UNAUTHORIZED",
-                  "message": "The request could not be completed. The session is not authorized or the credentials are invalid",
-                  "requestId": "0bfe7a04-9229-4b7a-812c-9eb3cc0eac0f"
-                },
-                "meta": {
-                  "apiEnrollmentVersion": "0.0.1",
-                  "apiVersion": "0.0.1"
-                }
-              }
-            }
-          },
-          "409": {
-            "description": "The resource requested to be removed/altered cannot be as it is referenced by another object.",
-            "schema": {
-              "$ref": "#/definitions/apiErrorEnvelope"
+            "rate": {
+              "$ref": "#/definitions/bandwidthRate"
             },
-            "examples": {
-              "application/json": {
-                "error": {
-                  "args": {
-                    "urlVars": {
-                      "id": "71a3000f-7dda-491a-9b90-a19f4ee6c406"
-                    }
-                  },
-                  "causeMessage": "referenced by /some-resource/05f4f710-c155-4a74-86d5-77558eb9cb42",
-                  "code": "CONFLICT_CANNOT_MODIFY_REFERENCED",
-                  "message": "The resource cannot be deleted/modified. Remove all referencing resources first.",
-                  "requestId": "270908d6-f2ef-4577-b973-67bec18ae376"
-                },
-                "meta": {
-                  "apiEnrollmentVersion": "0.0.1",
-                  "apiVersion": "0.0.1"
-                }
-              }
-            }
-          },
-          "429": {
-            "description": "The resource requested is rate limited and the rate limit has been exceeded",
-            "schema": {
-              "$ref": "#/definitions/apiErrorEnvelope"
+            "serviceRoles": {
+              "$ref": "#/definitions/roles"
             },
-            "examples": {
-              "application/json": {
-                "error": {
-                  "args": {
-                    "urlVars": {}
-                  },
-                  "causeMessage": "you have hit a rate limit in the requested operation",
-                  "code": "RATE_LIMITED",
-                  "message": "The resource is rate limited and the rate limit has been exceeded. Please try again later",
-                  "requestId": "270908d6-f2ef-4577-b973-67bec18ae376"
-                },
-                "meta": {
-                  "apiEnrollmentVersion": "0.0.1",
-                  "apiVersion": "0.0.1"
-                }
-              }
-            }
-          },
-          "503": {
-            "description": "The request could not be completed due to the server being busy or in a temporarily bad state",
-            "schema": {
-              "$ref": "#/definitions/apiErrorEnvelope"
+            "shared": {
+              "type": "boolean"
             }
           }
         }
-      },
-      "patch": {
-        "description": "Update the supplied fields on a service. Requires admin access.",
-        "tags": [
-          "Service"
-        ],
-        "summary": "Update the supplied fields on a service",
-        "operationId": "patchService",
-        "parameters": [
-          {
-            "description": "A service patch object",
-            "name": "service",
-            "in": "body",
-            "required": true,
-            "schema": {
-              "$ref": "#/definitions/servicePatch"
-            }
+      ]
+    },
+    "bandwidthLimitList": {
+      "type": "array",
+      "items": {
+        "$ref": "#/definitions/bandwidthLimitDetail"
+      }
+    },
+    "bandwidthLimitPatch": {
+      "type": "object",
+      "properties": {
+        "burst": {
+          "$ref": "#/definitions/bandwidthBurst"
+        },
+        "edgeRouterPolicies": {
+          "$ref": "#/definitions/edgeRouterPolicyIds"
+        },
+        "identityRoles": {
+          "$ref": "#/definitions/roles"
+        },
+        "name": {
+          "type": "string"
+        },
+        "rate": {
+          "$ref": "#/definitions/bandwidthRate"
+        },
+        "serviceRoles": {
+          "$ref": "#/definitions/roles"
+        },
+        "shared": {
+          "type": "boolean"
+        },
+        "tags": {
+          "$ref": "#/definitions/tags"
+        }
+      }
+    },
+    "bandwidthLimitUpdate": {
+      "type": "object",
+      "required": [
+        "name",
+        "rate"
+      ],
+      "properties": {
+        "burst": {
+          "$ref": "#/definitions/bandwidthBurst"
+        },
+        "edgeRouterPolicies": {
+          "$ref": "#/definitions/edgeRouterPolicyIds"
+        },
+        "identityRoles": {
+          "$ref": "#/definitions/roles"
+        },
+        "name": {
+          "type": "string"
+        },
+        "rate": {
+          "$ref": "#/definitions/bandwidthRate"
+        },
+        "serviceRoles": {
+          "$ref": "#/definitions/roles"
+        },
+        "shared": {
+          "type": "boolean"
+        },
+        "tags": {
+          "$ref": "#/definitions/tags"
+        }
+      }
+    },
+    "bandwidthRate": {
+      "description": "The sustained rate, in bytes per second",
+      "type": "integer",
+      "format": "int64"
+    },
+    "baseEntity": {
+      "description": "Fields shared by all Edge API entities",
+      "type": "object",
+      "required": [
+        "id",
+        "createdAt",
+        "updatedAt",
+        "_links",
+        "tags"
+      ],
+      "properties": {
+        "_links": {
+          "$ref": "#/definitions/links"
+        },
+        "createdAt": {
+          "type": "string",
+          "format": "date-time"
+        },
+        "id": {
+          "type": "string"
+        },
+        "tags": {
+          "$ref": "#/definitions/tags"
+        },
+        "updatedAt": {
+          "type": "string",
+          "format": "date-time"
+        }
+      }
+    },
+    "bulkOperationCreate": {
+      "type": "object",
+      "required": [
+        "operation"
+      ],
+      "properties": {
+        "authPolicyId": {
+          "type": "string"
+        },
+        "batchSize": {
+          "description": "The number of identities updated per command. Defaults to 100, may be at most 1000",
+          "type": "integer"
+        },
+        "durationMinutes": {
+          "description": "How long identities are disabled for. If zero, they are disabled until enabled",
+          "type": "integer"
+        },
+        "expiresAt": {
+          "description": "When identities expire. If not provided, the expiry date is cleared",
+          "type": "string",
+          "format": "date-time",
+          "x-nullable": true
+        },
+        "filter": {
+          "description": "A filter selecting the identities to apply the operation to, ex. 'anyOf(roleAttributes) = \"sales\"'",
+          "type": "string"
+        },
+        "identityIds": {
+          "description": "The identities to apply the operation to. Exactly one of identityIds or filter must be provided",
+          "type": "array",
+          "items": {
+            "type": "string"
+          }
+        },
+        "operation": {
+          "type": "string",
+          "enum": [
+            "addRoleAttributes",
+            "removeRoleAttributes",
+            "setAuthPolicy",
+            "disable",
+            "enable",
+            "delete",
+            "setExpiresAt"
+          ]
+        },
+        "roleAttributes": {
+          "description": "The role attributes to add or remove",
+          "type": "array",
+          "items": {
+            "type": "string"
+          }
+        }
+      }
+    },
+    "bulkOperationDetail": {
+      "type": "object",
+      "required": [
+        "id",
+        "operation",
+        "total",
+        "processed",
+        "succeeded",
+        "failed",
+        "complete",
+        "startedAt"
+      ],
+      "properties": {
+        "complete": {
+          "type": "boolean"
+        },
+        "completedAt": {
+          "type": "string",
+          "format": "date-time"
+        },
+        "failed": {
+          "type": "integer"
+        },
+        "failures": {
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/bulkOperationFailure"
           }
-        ],
-        "responses": {
-          "200": {
-            "description": "The patch request was successful and the resource has been altered",
-            "schema": {
-              "$ref": "#/definitions/empty"
-            }
-          },
-          "400": {
-            "description": "The supplied request contains invalid fields or could not be parsed (json and non-json bodies). The error's code, message, and cause fields can be inspected for further information",
-            "schema": {
-              "$ref": "#/definitions/apiErrorEnvelope"
-            },
-            "examples": {
-              "application/json": {
-                "error": {
-                  "args": {
-                    "urlVars": {}
-                  },
-                  "cause": {
-                    "details": {
-                      "context": "(root)",
-                      "field": "(root)",
-                      "property": "fooField3"
-                    },
-                    "field": "(root)",
-                    "message": "(root): fooField3 is required",
-                    "type": "required",
-                    "value": {
-                      "fooField": "abc",
-                      "fooField2": "def"
-                    }
-                  },
-                  "causeMessage": "schema validation failed",
-                  "code": "COULD_NOT_VALIDATE",
-                  "message": "The supplied request contains an invalid document",
-                  "requestId": "ac6766d6-3a09-44b3-8d8a-1b541d97fdd9"
-                },
-                "meta": {
-                  "apiEnrollmentVersion": "0.0.1",
-                  "apiVersion": "0.0.1"
-                }
-              }
-            }
-          },
-          "401": {
-            "description": "The currently supplied session does not have the correct access rights to request this resource",
-            "schema": {
-              "$ref": "#/definitions/apiErrorEnvelope"
+        },
+        "id": {
+          "type": "string"
+        },
+        "operation": {
+          "type": "string"
+        },
+        "processed": {
+          "type": "integer"
+        },
+        "startedAt": {
+          "type": "string",
+          "format": "date-time"
+        },
+        "succeeded": {
+          "type": "integer"
+        },
+        "total": {
+          "type": "integer"
+        }
+      }
+    },
+    "bulkOperationEnvelope": {
+      "type": "object",
+      "required": [
+        "meta",
+        "data"
+      ],
+      "properties": {
+        "data": {
+          "$ref": "#/definitions/bulkOperationDetail"
+        },
+        "meta": {
+          "$ref": "#/definitions/meta"
+        }
+      }
+    },
+    "bulkOperationFailure": {
+      "type": "object",
+      "required": [
+        "identityId",
+        "error"
+      ],
+      "properties": {
+        "error": {
+          "type": "string"
+        },
+        "identityId": {
+          "type": "string"
+        }
+      }
+    },
+    "bulkOperationList": {
+      "type": "array",
+      "items": {
+        "$ref": "#/definitions/bulkOperationDetail"
+      }
+    },
+    "circuitDelete": {
+      "type": "object",
+      "properties": {
+        "immediate": {
+          "type": "boolean"
+        }
+      }
+    },
+    "circuitDetail": {
+      "allOf": [
+        {
+          "$ref": "#/definitions/baseEntity"
+        },
+        {
+          "type": "object",
+          "required": [
+            "service",
+            "terminator",
+            "path"
+          ],
+          "properties": {
+            "clientId": {
+              "type": "string"
             },
-            "examples": {
-              "application/json": {
-                "error": {
-                  "args": {
-                    "urlVars": {}
-                  },
-                  "cause": "",
-                  "causeMessage": "",
-                  "code": "UNAUTHORIZED",
-                  "message": "The request could not be completed. The session is not authorized or the credentials are invalid",
-                  "requestId": "0bfe7a04-9229-4b7a-812c-9eb3cc0eac0f"
-                },
-                "meta": {
-                  "apiEnrollmentVersion": "0.0.1",
-                  "apiVersion": "0.0.1"
-                }
-              }
-            }
-          },
-          "404": {
-            "description": "The requested resource does not exist",
-            "schema": {
-              "$ref": "#/definitions/apiErrorEnvelope"
+            "path": {
+              "$ref": "#/definitions/path"
             },
-            "examples": {
-              "application/json": {
-                "error": {
-                  "args": {
-                    "urlVars": {
-                      "id": "71a3000f-7dda-491a-9b90-a19f4ee6c406"
-                    }
-                  },
-                  "cause": null,
-                  "causeMessage": "",
-                  "code": "NOT_FOUND",
-                  "message": "The resource requested was not found or is no longer available",
-                  "requestId": "270908d6-f2ef-4577-b973-67bec18ae376"
-                },
-                "meta": {
-                  "apiEnrollmentVersion": "0.0.1",
-                  "apiVersion": "0.0.1"
-                }
-              }
-            }
-          },
-          "429": {
-            "description": "The resource requested is rate limited and the rate limit has been exceeded",
-            "schema": {
-              "$ref": "#/definitions/apiErrorEnvelope"
+            "service": {
+              "$ref": "#/definitions/entityRef"
             },
-            "examples": {
-              "application/json": {
-                "error": {
-                  "args": {
-                    "urlVars": {}
-                  },
-                  "causeMessage": "you have hit a rate limit in the requested operation",
-                  "code": "RATE_LIMITED",
-                  "message": "The resource is rate limited and the rate limit has been exceeded. Please try again later",
-                  "requestId": "270908d6-f2ef-4577-b973-67bec18ae376"
-                },
-                "meta": {
-                  "apiEnrollmentVersion": "0.0.1",
-                  "apiVersion": "0.0.1"
-                }
-              }
-            }
-          },
-          "503": {
-            "description": "The request could not be completed due to the server being busy or in a temporarily bad state",
-            "schema": {
-              "$ref": "#/definitions/apiErrorEnvelope"
+            "terminator": {
+              "$ref": "#/definitions/entityRef"
             }
           }
         }
-      },
-      "parameters": [
-        {
-          "type": "string",
-          "description": "The id of the requested resource",
-          "name": "id",
-          "in": "path",
-          "required": true
+      ]
+    },
+    "circuitHistoryEntry": {
+      "type": "object",
+      "required": [
+        "circuitId",
+        "serviceId",
+        "state",
+        "createdAt",
+        "durationMillis",
+        "routers",
+        "links",
+        "pathUpdates",
+        "ingressRxBytes",
+        "ingressTxBytes",
+        "egressRxBytes",
+        "egressTxBytes"
+      ],
+      "properties": {
+        "circuitId": {
+          "type": "string"
+        },
+        "createdAt": {
+          "type": "string",
+          "format": "date-time"
+        },
+        "creationTimespanMillis": {
+          "type": "number",
+          "x-nullable": true
+        },
+        "durationMillis": {
+          "type": "number"
+        },
+        "egressRxBytes": {
+          "type": "integer",
+          "format": "int64"
+        },
+        "egressTxBytes": {
+          "type": "integer",
+          "format": "int64"
+        },
+        "endedAt": {
+          "type": "string",
+          "format": "date-time",
+          "x-nullable": true
+        },
+        "failureCause": {
+          "type": "string"
+        },
+        "hostId": {
+          "type": "string"
+        },
+        "identityId": {
+          "type": "string"
+        },
+        "ingressRxBytes": {
+          "type": "integer",
+          "format": "int64"
+        },
+        "ingressTxBytes": {
+          "type": "integer",
+          "format": "int64"
+        },
+        "instanceId": {
+          "type": "string"
+        },
+        "links": {
+          "type": "array",
+          "items": {
+            "type": "string"
+          }
+        },
+        "pathUpdates": {
+          "type": "integer"
+        },
+        "routers": {
+          "type": "array",
+          "items": {
+            "type": "string"
+          }
+        },
+        "serviceId": {
+          "type": "string"
+        },
+        "state": {
+          "type": "string",
+          "enum": [
+            "active",
+            "ended",
+            "failed"
+          ]
+        },
+        "tags": {
+          "type": "object",
+          "additionalProperties": {
+            "type": "string"
+          }
+        },
+        "terminatorId": {
+          "type": "string"
+        }
+      }
+    },
+    "circuitHistoryList": {
+      "type": "array",
+      "items": {
+        "$ref": "#/definitions/circuitHistoryEntry"
+      }
+    },
+    "circuitList": {
+      "type": "array",
+      "items": {
+        "$ref": "#/definitions/circuitDetail"
+      }
+    },
+    "clusterMemberAdd": {
+      "type": "object",
+      "required": [
+        "address",
+        "isVoter"
+      ],
+      "properties": {
+        "address": {
+          "type": "string"
+        },
+        "isVoter": {
+          "type": "boolean"
+        }
+      }
+    },
+    "clusterMemberListResponse": {
+      "type": "object",
+      "properties": {
+        "data": {
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/clusterMemberListValue"
+          }
+        }
+      }
+    },
+    "clusterMemberListValue": {
+      "type": "object",
+      "required": [
+        "id",
+        "address",
+        "voter",
+        "leader",
+        "version",
+        "connected",
+        "readOnly"
+      ],
+      "properties": {
+        "address": {
+          "type": "string"
+        },
+        "connected": {
+          "type": "boolean"
+        },
+        "id": {
+          "type": "string"
+        },
+        "leader": {
+          "type": "boolean"
+        },
+        "readOnly": {
+          "type": "boolean"
+        },
+        "version": {
+          "type": "string"
+        },
+        "voter": {
+          "type": "boolean"
+        }
+      }
+    },
+    "clusterMemberRemove": {
+      "type": "object",
+      "required": [
+        "id"
+      ],
+      "properties": {
+        "id": {
+          "type": "string"
+        }
+      }
+    },
+    "clusterTransferLeadership": {
+      "type": "object",
+      "properties": {
+        "newLeaderId": {
+          "type": "string"
+        }
+      }
+    },
+    "createEnvelope": {
+      "type": "object",
+      "properties": {
+        "data": {
+          "$ref": "#/definitions/createLocation"
+        },
+        "meta": {
+          "$ref": "#/definitions/meta"
+        }
+      }
+    },
+    "createLocation": {
+      "type": "object",
+      "properties": {
+        "_links": {
+          "$ref": "#/definitions/links"
+        },
+        "id": {
+          "type": "string"
+        }
+      }
+    },
+    "currentIdentityPermissions": {
+      "type": "object",
+      "required": [
+        "isAdmin",
+        "grants"
+      ],
+      "properties": {
+        "grants": {
+          "$ref": "#/definitions/permissions"
+        },
+        "isAdmin": {
+          "type": "boolean"
+        }
+      }
+    },
+    "currentIdentityPermissionsEnvelope": {
+      "type": "object",
+      "required": [
+        "meta",
+        "data"
+      ],
+      "properties": {
+        "data": {
+          "$ref": "#/definitions/currentIdentityPermissions"
+        },
+        "meta": {
+          "$ref": "#/definitions/meta"
+        }
+      }
+    },
+    "dataIntegrityCheckDetail": {
+      "type": "object",
+      "required": [
+        "description",
+        "fixed"
+      ],
+      "properties": {
+        "description": {
+          "type": "string"
+        },
+        "fixed": {
+          "type": "boolean"
+        }
+      }
+    },
+    "dataIntegrityCheckDetailList": {
+      "type": "array",
+      "items": {
+        "$ref": "#/definitions/dataIntegrityCheckDetail"
+      }
+    },
+    "dataIntegrityCheckDetails": {
+      "type": "object",
+      "required": [
+        "inProgress",
+        "fixingErrors",
+        "tooManyErrors",
+        "startTime",
+        "endTime",
+        "error",
+        "results"
+      ],
+      "properties": {
+        "endTime": {
+          "type": "string",
+          "format": "date-time"
+        },
+        "error": {
+          "type": "string"
+        },
+        "fixingErrors": {
+          "type": "boolean"
+        },
+        "inProgress": {
+          "type": "boolean"
+        },
+        "results": {
+          "$ref": "#/definitions/dataIntegrityCheckDetailList"
+        },
+        "startTime": {
+          "type": "string",
+          "format": "date-time"
+        },
+        "tooManyErrors": {
+          "type": "boolean"
+        }
+      }
+    },
+    "dataIntegrityCheckResultEnvelope": {
+      "type": "object",
+      "required": [
+        "meta",
+        "data"
+      ],
+      "properties": {
+        "data": {
+          "$ref": "#/definitions/dataIntegrityCheckDetails"
+        },
+        "meta": {
+          "$ref": "#/definitions/meta"
+        }
+      }
+    },
+    "databaseBackupCreateDetails": {
+      "type": "object",
+      "required": [
+        "name",
+        "size",
+        "durationMs"
+      ],
+      "properties": {
+        "durationMs": {
+          "type": "integer",
+          "format": "int64"
+        },
+        "name": {
+          "type": "string"
+        },
+        "raftIndex": {
+          "type": "integer",
+          "format": "int64"
+        },
+        "removed": {
+          "type": "array",
+          "items": {
+            "type": "string"
+          }
+        },
+        "size": {
+          "type": "integer",
+          "format": "int64"
+        }
+      }
+    },
+    "databaseBackupCreateResultEnvelope": {
+      "type": "object",
+      "required": [
+        "meta",
+        "data"
+      ],
+      "properties": {
+        "data": {
+          "$ref": "#/definitions/databaseBackupCreateDetails"
+        },
+        "meta": {
+          "$ref": "#/definitions/meta"
+        }
+      }
+    },
+    "databaseBackupDetails": {
+      "type": "object",
+      "required": [
+        "name",
+        "size",
+        "createdAt"
+      ],
+      "properties": {
+        "createdAt": {
+          "type": "string",
+          "format": "date-time"
+        },
+        "name": {
+          "type": "string"
+        },
+        "size": {
+          "type": "integer",
+          "format": "int64"
+        }
+      }
+    },
+    "databaseBackupList": {
+      "type": "array",
+      "items": {
+        "$ref": "#/definitions/databaseBackupDetails"
+      }
+    },
+    "databaseBackupStatusDetails": {
+      "type": "object",
+      "required": [
+        "interval",
+        "retain",
+        "target",
+        "compressed",
+        "encrypted",
+        "inProgress",
+        "backups"
+      ],
+      "properties": {
+        "backups": {
+          "$ref": "#/definitions/databaseBackupList"
+        },
+        "compressed": {
+          "type": "boolean"
+        },
+        "encrypted": {
+          "type": "boolean"
+        },
+        "inProgress": {
+          "description": "Set if this controller is currently taking a backup",
+          "type": "boolean"
+        },
+        "interval": {
+          "description": "How often scheduled backups are taken, as a duration such as 24h0m0s",
+          "type": "string"
+        },
+        "lastAttempt": {
+          "type": "string",
+          "format": "date-time",
+          "x-nullable": true
+        },
+        "lastBackup": {
+          "description": "The name of the most recent backup taken by this controller",
+          "type": "string"
+        },
+        "lastError": {
+          "description": "Why the most recent backup attempt failed. Empty if it succeeded",
+          "type": "string"
+        },
+        "lastSuccess": {
+          "type": "string",
+          "format": "date-time",
+          "x-nullable": true
+        },
+        "nextScheduled": {
+          "description": "When the next scheduled backup is due. Only set on the controller which takes scheduled backups",
+          "type": "string",
+          "format": "date-time",
+          "x-nullable": true
+        },
+        "retain": {
+          "description": "How many backups are kept in the target",
+          "type": "integer"
+        },
+        "target": {
+          "description": "Where backups are written",
+          "type": "string"
+        }
+      }
+    },
+    "databaseBackupStatusEnvelope": {
+      "type": "object",
+      "required": [
+        "meta",
+        "data"
+      ],
+      "properties": {
+        "data": {
+          "$ref": "#/definitions/databaseBackupStatusDetails"
+        },
+        "meta": {
+          "$ref": "#/definitions/meta"
+        }
+      }
+    },
+    "databaseSnapshotCreate": {
+      "type": "object",
+      "properties": {
+        "path": {
+          "type": "string"
+        }
+      }
+    },
+    "databaseSnapshotCreateDetails": {
+      "type": "object",
+      "required": [
+        "path"
+      ],
+      "properties": {
+        "path": {
+          "type": "string"
+        }
+      }
+    },
+    "databaseSnapshotCreateResultEnvelope": {
+      "type": "object",
+      "required": [
+        "meta",
+        "data"
+      ],
+      "properties": {
+        "data": {
+          "$ref": "#/definitions/databaseSnapshotCreateDetails"
+        },
+        "meta": {
+          "$ref": "#/definitions/meta"
+        }
+      }
+    },
+    "detailBandwidthLimitEnvelope": {
+      "type": "object",
+      "required": [
+        "meta",
+        "data"
+      ],
+      "properties": {
+        "data": {
+          "$ref": "#/definitions/bandwidthLimitDetail"
+        },
+        "meta": {
+          "$ref": "#/definitions/meta"
+        }
+      }
+    },
+    "detailCircuitEnvelope": {
+      "type": "object",
+      "required": [
+        "meta",
+        "data"
+      ],
+      "properties": {
+        "data": {
+          "$ref": "#/definitions/circuitDetail"
+        },
+        "meta": {
+          "$ref": "#/definitions/meta"
+        }
+      }
+    },
+    "detailCircuitHistoryEnvelope": {
+      "type": "object",
+      "required": [
+        "meta",
+        "data"
+      ],
+      "properties": {
+        "data": {
+          "$ref": "#/definitions/circuitHistoryEntry"
+        },
+        "meta": {
+          "$ref": "#/definitions/meta"
+        }
+      }
+    },
+    "detailLinkEnvelope": {
+      "type": "object",
+      "required": [
+        "meta",
+        "data"
+      ],
+      "properties": {
+        "data": {
+          "$ref": "#/definitions/linkDetail"
+        },
+        "meta": {
+          "$ref": "#/definitions/meta"
+        }
+      }
+    },
+    "detailQuotaEnvelope": {
+      "type": "object",
+      "required": [
+        "meta",
+        "data"
+      ],
+      "properties": {
+        "data": {
+          "$ref": "#/definitions/quotaDetail"
+        },
+        "meta": {
+          "$ref": "#/definitions/meta"
+        }
+      }
+    },
+    "detailRoleEnvelope": {
+      "type": "object",
+      "required": [
+        "meta",
+        "data"
+      ],
+      "properties": {
+        "data": {
+          "$ref": "#/definitions/roleDetail"
+        },
+        "meta": {
+          "$ref": "#/definitions/meta"
+        }
+      }
+    },
+    "detailRouterEnvelope": {
+      "type": "object",
+      "required": [
+        "meta",
+        "data"
+      ],
+      "properties": {
+        "data": {
+          "$ref": "#/definitions/routerDetail"
+        },
+        "meta": {
+          "$ref": "#/definitions/meta"
+        }
+      }
+    },
+    "detailServiceEnvelope": {
+      "type": "object",
+      "required": [
+        "meta",
+        "data"
+      ],
+      "properties": {
+        "data": {
+          "$ref": "#/definitions/serviceDetail"
+        },
+        "meta": {
+          "$ref": "#/definitions/meta"
+        }
+      }
+    },
+    "detailTerminatorEnvelope": {
+      "type": "object",
+      "required": [
+        "meta",
+        "data"
+      ],
+      "properties": {
+        "data": {
+          "$ref": "#/definitions/terminatorDetail"
+        },
+        "meta": {
+          "$ref": "#/definitions/meta"
         }
+      }
+    },
+    "dialBind": {
+      "type": "string",
+      "enum": [
+        "Dial",
+        "Bind"
       ]
     },
-    "/services/{id}/terminators": {
-      "get": {
-        "description": "Retrieves a list of terminator resources that are assigned specific service; supports filtering, sorting, and pagination.\n",
-        "tags": [
-          "Service"
-        ],
-        "summary": "List of terminators assigned to a service",
-        "operationId": "listServiceTerminators",
-        "parameters": [
-          {
-            "type": "integer",
-            "name": "limit",
-            "in": "query"
-          },
-          {
-            "type": "integer",
-            "name": "offset",
-            "in": "query"
-          },
-          {
-            "type": "string",
-            "name": "filter",
-            "in": "query"
-          }
-        ],
-        "responses": {
-          "200": {
-            "description": "A list of terminators",
-            "schema": {
-              "$ref": "#/definitions/listTerminatorsEnvelope"
-            }
-          },
-          "400": {
-            "description": "The supplied request contains invalid fields or could not be parsed (json and non-json bodies). The error's code, message, and cause fields can be inspected for further information",
-            "schema": {
-              "$ref": "#/definitions/apiErrorEnvelope"
-            },
-            "examples": {
-              "application/json": {
-                "error": {
-                  "args": {
-                    "urlVars": {}
-                  },
-                  "cause": {
-                    "details": {
-                      "context": "(root)",
-                      "field": "(root)",
-                      "property": "fooField3"
-                    },
-                    "field": "(root)",
-                    "message": "(root): fooField3 is required",
-                    "type": "required",
-                    "value": {
-                      "fooField": "abc",
-                      "fooField2": "def"
-                    }
-                  },
-                  "causeMessage": "schema validation failed",
-                  "code": "COULD_NOT_VALIDATE",
-                  "message": "The supplied request contains an invalid document",
-                  "requestId": "ac6766d6-3a09-44b3-8d8a-1b541d97fdd9"
-                },
-                "meta": {
-                  "apiEnrollmentVersion": "0.0.1",
-                  "apiVersion": "0.0.1"
-                }
-              }
-            }
-          },
-          "401": {
-            "description": "The currently supplied session does not have the correct access rights to request this resource",
-            "schema": {
-              "$ref": "#/definitions/apiErrorEnvelope"
-            },
-            "examples": {
-              "application/json": {
-                "error": {
-                  "args": {
-                    "urlVars": {}
-                  },
-                  "cause": "",
-                  "causeMessage": "",
-                  "code": "UNAUTHORIZED",
-                  "message": "The request could not be completed. The session is not authorized or the credentials are invalid",
-                  "requestId": "0bfe7a04-9229-4b7a-812c-9eb3cc0eac0f"
-                },
-                "meta": {
-                  "apiEnrollmentVersion": "0.0.1",
-                  "apiVersion": "0.0.1"
-                }
-              }
-            }
-          },
-          "429": {
-            "description": "The resource requested is rate limited and the rate limit has been exceeded",
-            "schema": {
-              "$ref": "#/definitions/apiErrorEnvelope"
-            },
-            "examples": {
-              "application/json": {
-                "error": {
-                  "args": {
-                    "urlVars": {}
-                  },
-                  "causeMessage": "you have hit a rate limit in the requested operation",
-                  "code": "RATE_LIMITED",
-                  "message": "The resource is rate limited and the rate limit has been exceeded. Please try again later",
-                  "requestId": "270908d6-f2ef-4577-b973-67bec18ae376"
-                },
-                "meta": {
-                  "apiEnrollmentVersion": "0.0.1",
-                  "apiVersion": "0.0.1"
-                }
-              }
-            }
-          }
+    "edgeRouterPolicyIds": {
+      "description": "A list of edge router policy ids. The limit only applies on the edge routers granted by these policies",
+      "type": "array",
+      "items": {
+        "type": "string"
+      }
+    },
+    "empty": {
+      "type": "object",
+      "required": [
+        "meta",
+        "data"
+      ],
+      "properties": {
+        "data": {
+          "type": "object",
+          "example": {}
+        },
+        "meta": {
+          "$ref": "#/definitions/meta"
         }
-      },
-      "parameters": [
-        {
+      }
+    },
+    "enrollmentSignerDetail": {
+      "type": "object",
+      "required": [
+        "id",
+        "subject",
+        "issuer",
+        "notBefore",
+        "notAfter",
+        "state",
+        "identityCerts",
+        "routerCerts"
+      ],
+      "properties": {
+        "id": {
+          "description": "The sha1 fingerprint of the signer certificate",
+          "type": "string"
+        },
+        "identityCerts": {
+          "description": "The number of identity certificate authenticators whose certificate was issued by this signer",
+          "type": "integer"
+        },
+        "issuer": {
+          "type": "string"
+        },
+        "notAfter": {
           "type": "string",
-          "description": "The id of the requested resource",
-          "name": "id",
-          "in": "path",
-          "required": true
+          "format": "date-time"
+        },
+        "notBefore": {
+          "type": "string",
+          "format": "date-time"
+        },
+        "routerCerts": {
+          "description": "The number of edge routers whose certificate was issued by this signer",
+          "type": "integer"
+        },
+        "state": {
+          "type": "string",
+          "enum": [
+            "active",
+            "trusted"
+          ]
+        },
+        "subject": {
+          "type": "string"
         }
-      ]
+      }
     },
-    "/terminators": {
-      "get": {
-        "description": "Retrieves a list of terminator resources; supports filtering, sorting, and pagination. Requires admin access.\n",
-        "tags": [
-          "Terminator"
-        ],
-        "summary": "List terminators",
-        "operationId": "listTerminators",
-        "parameters": [
-          {
-            "type": "integer",
-            "name": "limit",
-            "in": "query"
-          },
-          {
-            "type": "integer",
-            "name": "offset",
-            "in": "query"
-          },
-          {
-            "type": "string",
-            "name": "filter",
-            "in": "query"
-          }
-        ],
-        "responses": {
-          "200": {
-            "description": "A list of terminators",
-            "schema": {
-              "$ref": "#/definitions/listTerminatorsEnvelope"
-            }
-          },
-          "400": {
-            "description": "The supplied request contains invalid fields or could not be parsed (json and non-json bodies). The error's code, message, and cause fields can be inspected for further information",
-            "schema": {
-              "$ref": "#/definitions/apiErrorEnvelope"
-            },
-            "examples": {
-              "application/json": {
-                "error": {
-                  "args": {
-                    "urlVars": {}
-                  },
-                  "cause": {
-                    "details": {
-                      "context": "(root)",
-                      "field": "(root)",
-                      "property": "fooField3"
-                    },
-                    "field": "(root)",
-                    "message": "(root): fooField3 is required",
-                    "type": "required",
-                    "value": {
-                      "fooField": "abc",
-                      "fooField2": "def"
-                    }
-                  },
-                  "causeMessage": "schema validation failed",
-                  "code": "COULD_NOT_VALIDATE",
-                  "message": "The supplied request contains an invalid document",
-                  "requestId": "ac6766d6-3a09-44b3-8d8a-1b541d97fdd9"
-                },
-                "meta": {
-                  "apiEnrollmentVersion": "0.0.1",
-                  "apiVersion": "0.0.1"
-                }
-              }
-            }
-          },
-          "401": {
-            "description": "The currently supplied session does not have the correct access rights to request this resource",
-            "schema": {
-              "$ref": "#/definitions/apiErrorEnvelope"
-            },
-            "examples": {
-              "application/json": {
-                "error": {
-                  "args": {
-                    "urlVars": {}
-                  },
-                  "cause": "",
-                  "causeMessage": "",
-                  "code": "UNAUTHORIZED",
-                  "message": "The request could not be completed. The session is not authorized or the credentials are invalid",
-                  "requestId": "0bfe7a04-9229-4b7a-812c-9eb3cc0eac0f"
-                },
-                "meta": {
-                  "apiEnrollmentVersion": "0.0.1",
-                  "apiVersion": "0.0.1"
-                }
-              }
-            }
-          },
-          "429": {
-            "description": "The resource requested is rate limited and the rate limit has been exceeded",
-            "schema": {
-              "$ref": "#/definitions/apiErrorEnvelope"
-            },
-            "examples": {
-              "application/json": {
-                "error": {
-                  "args": {
-                    "urlVars": {}
-                  },
-                  "causeMessage": "you have hit a rate limit in the requested operation",
-                  "code": "RATE_LIMITED",
-                  "message": "The resource is rate limited and the rate limit has been exceeded. Please try again later",
-                  "requestId": "270908d6-f2ef-4577-b973-67bec18ae376"
-                },
-                "meta": {
-                  "apiEnrollmentVersion": "0.0.1",
-                  "apiVersion": "0.0.1"
-                }
-              }
-            }
-          }
+    "enrollmentSignerEnvelope": {
+      "type": "object",
+      "required": [
+        "meta",
+        "data"
+      ],
+      "properties": {
+        "data": {
+          "$ref": "#/definitions/enrollmentSignerDetail"
+        },
+        "meta": {
+          "$ref": "#/definitions/meta"
         }
-      },
-      "post": {
-        "description": "Create a terminator resource. Requires admin access.",
-        "tags": [
-          "Terminator"
-        ],
-        "summary": "Create a terminator resource",
-        "operationId": "createTerminator",
-        "parameters": [
-          {
-            "description": "A terminator to create",
-            "name": "terminator",
-            "in": "body",
-            "required": true,
-            "schema": {
-              "$ref": "#/definitions/terminatorCreate"
-            }
-          }
-        ],
-        "responses": {
-          "201": {
-            "description": "The create request was successful and the resource has been added at the following location",
-            "schema": {
-              "$ref": "#/definitions/createEnvelope"
-            }
-          },
-          "400": {
-            "description": "The supplied request contains invalid fields or could not be parsed (json and non-json bodies). The error's code, message, and cause fields can be inspected for further information",
-            "schema": {
-              "$ref": "#/definitions/apiErrorEnvelope"
-            },
-            "examples": {
-              "application/json": {
-                "error": {
-                  "args": {
-                    "urlVars": {}
-                  },
-                  "cause": {
-                    "details": {
-                      "context": "(root)",
-                      "field": "(root)",
-                      "property": "fooField3"
-                    },
-                    "field": "(root)",
-                    "message": "(root): fooField3 is required",
-                    "type": "required",
-                    "value": {
-                      "fooField": "abc",
-                      "fooField2": "def"
-                    }
-                  },
-                  "causeMessage": "schema validation failed",
-                  "code": "COULD_NOT_VALIDATE",
-                  "message": "The supplied request contains an invalid document",
-                  "requestId": "ac6766d6-3a09-44b3-8d8a-1b541d97fdd9"
-                },
-                "meta": {
-                  "apiEnrollmentVersion": "0.0.1",
-                  "apiVersion": "0.0.1"
-                }
-              }
-            }
-          },
-          "401": {
-            "description": "The currently supplied session does not have the correct access rights to request this resource",
-            "schema": {
-              "$ref": "#/definitions/apiErrorEnvelope"
-            },
-            "examples": {
-              "application/json": {
-                "error": {
-                  "args": {
-                    "urlVars": {}
-                  },
-                  "cause": "",
-                  "causeMessage": "",
-                  "code": "UNAUTHORIZED",
-                  "message": "The request could not be completed. The session is not authorized or the credentials are invalid",
-                  "requestId": "0bfe7a04-9229-4b7a-812c-9eb3cc0eac0f"
-                },
-                "meta": {
-                  "apiEnrollmentVersion": "0.0.1",
-                  "apiVersion": "0.0.1"
-                }
-              }
-            }
-          },
-          "429": {
-            "description": "The resource requested is rate limited and the rate limit has been exceeded",
-            "schema": {
-              "$ref": "#/definitions/apiErrorEnvelope"
-            },
-            "examples": {
-              "application/json": {
-                "error": {
-                  "args": {
-                    "urlVars": {}
-                  },
-                  "causeMessage": "you have hit a rate limit in the requested operation",
-                  "code": "RATE_LIMITED",
-                  "message": "The resource is rate limited and the rate limit has been exceeded. Please try again later",
-                  "requestId": "270908d6-f2ef-4577-b973-67bec18ae376"
-                },
-                "meta": {
-                  "apiEnrollmentVersion": "0.0.1",
-                  "apiVersion": "0.0.1"
-                }
-              }
-            }
-          },
-          "503": {
-            "description": "The request could not be completed due to the server being busy or in a temporarily bad state",
-            "schema": {
-              "$ref": "#/definitions/apiErrorEnvelope"
-            }
-          }
+      }
+    },
+    "enrollmentSignerList": {
+      "type": "array",
+      "items": {
+        "$ref": "#/definitions/enrollmentSignerDetail"
+      }
+    },
+    "enrollmentSignerReissue": {
+      "type": "object",
+      "required": [
+        "signerId",
+        "identityCertsRequested",
+        "identityCertsFailed"
+      ],
+      "properties": {
+        "identityCertsFailed": {
+          "description": "The number of identity certificates which could not be flagged for extension",
+          "type": "integer"
+        },
+        "identityCertsRequested": {
+          "description": "The number of identity certificates which will be extended the next time the identity authenticates",
+          "type": "integer"
+        },
+        "routerCertRotationId": {
+          "description": "The id of the router certificate rotation renewing the edge router certificates, if any",
+          "type": "string"
+        },
+        "signerId": {
+          "type": "string"
         }
       }
     },
-    "/terminators/{id}": {
-      "get": {
-        "description": "Retrieves a single terminator by id. Requires admin access.",
-        "tags": [
-          "Terminator"
-        ],
-        "summary": "Retrieves a single terminator",
-        "operationId": "detailTerminator",
-        "responses": {
-          "200": {
-            "description": "A single terminator",
-            "schema": {
-              "$ref": "#/definitions/detailTerminatorEnvelope"
-            }
-          },
-          "401": {
-            "description": "The currently supplied session does not have the correct access rights to request this resource",
-            "schema": {
-              "$ref": "#/definitions/apiErrorEnvelope"
-            },
-            "examples": {
-              "application/json": {
-                "error": {
-                  "args": {
-                    "urlVars": {}
-                  },
-                  "cause": "",
-                  "causeMessage": "",
-                  "code": "UNAUTHORIZED",
-                  "message": "The request could not be completed. The session is not authorized or the credentials are invalid",
-                  "requestId": "0bfe7a04-9229-4b7a-812c-9eb3cc0eac0f"
-                },
-                "meta": {
-                  "apiEnrollmentVersion": "0.0.1",
-                  "apiVersion": "0.0.1"
-                }
-              }
-            }
-          },
-          "404": {
-            "description": "The requested resource does not exist",
-            "schema": {
-              "$ref": "#/definitions/apiErrorEnvelope"
-            },
-            "examples": {
-              "application/json": {
-                "error": {
-                  "args": {
-                    "urlVars": {
-                      "id": "71a3000f-7dda-491a-9b90-a19f4ee6c406"
-                    }
-                  },
-                  "cause": null,
-                  "causeMessage": "",
-                  "code": "NOT_FOUND",
-                  "message": "The resource requested was not found or is no longer available",
-                  "requestId": "270908d6-f2ef-4577-b973-67bec18ae376"
-                },
-                "meta": {
-                  "apiEnrollmentVersion": "0.0.1",
-                  "apiVersion": "0.0.1"
-                }
-              }
-            }
-          },
-          "429": {
-            "description": "The resource requested is rate limited and the rate limit has been exceeded",
-            "schema": {
-              "$ref": "#/definitions/apiErrorEnvelope"
-            },
-            "examples": {
-              "application/json": {
-                "error": {
-                  "args": {
-                    "urlVars": {}
-                  },
-                  "causeMessage": "you have hit a rate limit in the requested operation",
-                  "code": "RATE_LIMITED",
-                  "message": "The resource is rate limited and the rate limit has been exceeded. Please try again later",
-                  "requestId": "270908d6-f2ef-4577-b973-67bec18ae376"
-                },
-                "meta": {
-                  "apiEnrollmentVersion": "0.0.1",
-                  "apiVersion": "0.0.1"
-                }
-              }
-            }
-          }
+    "enrollmentSignerReissueEnvelope": {
+      "type": "object",
+      "required": [
+        "meta",
+        "data"
+      ],
+      "properties": {
+        "data": {
+          "$ref": "#/definitions/enrollmentSignerReissue"
+        },
+        "meta": {
+          "$ref": "#/definitions/meta"
         }
-      },
-      "put": {
-        "description": "Update all fields on a terminator by id. Requires admin access.",
-        "tags": [
-          "Terminator"
-        ],
-        "summary": "Update all fields on a terminator",
-        "operationId": "updateTerminator",
-        "parameters": [
-          {
-            "description": "A terminator update object",
-            "name": "terminator",
-            "in": "body",
-            "required": true,
-            "schema": {
-              "$ref": "#/definitions/terminatorUpdate"
-            }
-          }
-        ],
-        "responses": {
-          "200": {
-            "description": "The update request was successful and the resource has been altered",
-            "schema": {
-              "$ref": "#/definitions/empty"
-            }
-          },
-          "400": {
-            "description": "The supplied request contains invalid fields or could not be parsed (json and non-json bodies). The error's code, message, and cause fields can be inspected for further information",
-            "schema": {
-              "$ref": "#/definitions/apiErrorEnvelope"
-            },
-            "examples": {
-              "application/json": {
-                "error": {
-                  "args": {
-                    "urlVars": {}
-                  },
-                  "cause": {
-                    "details": {
-                      "context": "(root)",
-                      "field": "(root)",
-                      "property": "fooField3"
-                    },
-                    "field": "(root)",
-                    "message": "(root): fooField3 is required",
-                    "type": "required",
-                    "value": {
-                      "fooField": "abc",
-                      "fooField2": "def"
-                    }
-                  },
-                  "causeMessage": "schema validation failed",
-                  "code": "COULD_NOT_VALIDATE",
-                  "message": "The supplied request contains an invalid document",
-                  "requestId": "ac6766d6-3a09-44b3-8d8a-1b541d97fdd9"
-                },
-                "meta": {
-                  "apiEnrollmentVersion": "0.0.1",
-                  "apiVersion": "0.0.1"
-                }
-              }
-            }
-          },
-          "401": {
-            "description": "The currently supplied session does not have the correct access rights to request this resource",
-            "schema": {
-              "$ref": "#/definitions/apiErrorEnvelope"
-            },
-            "examples": {
-              "application/json": {
-                "error": {
-                  "args": {
-                    "urlVars": {}
-                  },
-                  "cause": "",
-                  "causeMessage": "",
-                  "code": "UNAUTHORIZED",
-                  "message": "The request could not be completed. The session is not authorized or the credentials are invalid",
-                  "requestId": "0bfe7a04-9229-4b7a-812c-9eb3cc0eac0f"
-                },
-                "meta": {
-                  "apiEnrollmentVersion": "0.0.1",
-                  "apiVersion": "0.0.1"
-                }
-              }
-            }
-          },
-          "404": {
-            "description": "The requested resource does not exist",
-            "schema": {
-              "$ref": "#/definitions/apiErrorEnvelope"
-            },
-            "examples": {
-              "application/json": {
-                "error": {
-                  "args": {
-                    "urlVars": {
-                      "id": "71a3000f-7dda-491a-9b90-a19f4ee6c406"
-                    }
-                  },
-                  "cause": null,
-                  "causeMessage": "",
-                  "code": "NOT_FOUND",
-                  "message": "The resource requested was not found or is no longer available",
-                  "requestId": "270908d6-f2ef-4577-b973-67bec18ae376"
-                },
-                "meta": {
-                  "apiEnrollmentVersion": "0.0.1",
-                  "apiVersion": "0.0.1"
-                }
-              }
-            }
-          },
-          "429": {
-            "description": "The resource requested is rate limited and the rate limit has been exceeded",
-            "schema": {
-              "$ref": "#/definitions/apiErrorEnvelope"
-            },
-            "examples": {
-              "application/json": {
-                "error": {
-                  "args": {
-                    "urlVars": {}
-                  },
-                  "causeMessage": "you have hit a rate limit in the requested operation",
-                  "code": "RATE_LIMITED",
-                  "message": "The resource is rate limited and the rate limit has been exceeded. Please try again later",
-                  "requestId": "270908d6-f2ef-4577-b973-67bec18ae376"
-                },
-                "meta": {
-                  "apiEnrollmentVersion": "0.0.1",
-                  "apiVersion": "0.0.1"
-                }
-              }
-            }
-          },
-          "503": {
-            "description": "The request could not be completed due to the server being busy or in a temporarily bad state",
-            "schema": {
-              "$ref": "#/definitions/apiErrorEnvelope"
-            }
+      }
+    },
+    "entityRef": {
+      "description": "A reference to another resource and links to interact with it",
+      "type": "object",
+      "properties": {
+        "_links": {
+          "$ref": "#/definitions/links"
+        },
+        "entity": {
+          "type": "string"
+        },
+        "id": {
+          "type": "string"
+        },
+        "name": {
+          "type": "string"
+        }
+      }
+    },
+    "inspectRequest": {
+      "type": "object",
+      "required": [
+        "appRegex",
+        "requestedValues"
+      ],
+      "properties": {
+        "appRegex": {
+          "type": "string"
+        },
+        "requestedValues": {
+          "type": "array",
+          "items": {
+            "type": "string"
           }
         }
-      },
-      "delete": {
-        "description": "Delete a terminator by id. Requires admin access.",
-        "tags": [
-          "Terminator"
-        ],
-        "summary": "Delete a terminator",
-        "operationId": "deleteTerminator",
-        "responses": {
-          "200": {
-            "description": "The delete request was successful and the resource has been removed",
-            "schema": {
-              "$ref": "#/definitions/empty"
-            }
-          },
-          "400": {
-            "description": "The supplied request contains invalid fields or could not be parsed (json and non-json bodies). The error's code, message, and cause fields can be inspected for further information",
-            "schema": {
-              "$ref": "#/definitions/apiErrorEnvelope"
-            },
-            "examples": {
-              "application/json": {
-                "error": {
-                  "args": {
-                    "urlVars": {}
-                  },
-                  "cause": {
-                    "details": {
-                      "context": "(root)",
-                      "field": "(root)",
-                      "property": "fooField3"
-                    },
-                    "field": "(root)",
-                    "message": "(root): fooField3 is required",
-                    "type": "required",
-                    "value": {
-                      "fooField": "abc",
-                      "fooField2": "def"
-                    }
-                  },
-                  "causeMessage": "schema validation failed",
-                  "code": "COULD_NOT_VALIDATE",
-                  "message": "The supplied request contains an invalid document",
-                  "requestId": "ac6766d6-3a09-44b3-8d8a-1b541d97fdd9"
-                },
-                "meta": {
-                  "apiEnrollmentVersion": "0.0.1",
-                  "apiVersion": "0.0.1"
-                }
-              }
-            }
-          },
-          "401": {
-            "description": "The currently supplied session does not have the correct access rights to request this resource",
-            "schema": {
-              "$ref": "#/definitions/apiErrorEnvelope"
-            },
-            "examples": {
-              "application/json": {
-                "error": {
-                  "args": {
-                    "urlVars": {}
-                  },
-                  "cause": "",
-                  "causeMessage": "",
-                  "code": "UNAUTHORIZED",
-                  "message": "The request could not be completed. The session is not authorized or the credentials are invalid",
-                  "requestId": "0bfe7a04-9229-4b7a-812c-9eb3cc0eac0f"
-                },
-                "meta": {
-                  "apiEnrollmentVersion": "0.0.1",
-                  "apiVersion": "0.0.1"
-                }
-              }
-            }
-          },
-          "409": {
-            "description": "The resource requested to be removed/altered cannot be as it is referenced by another object.",
-            "schema": {
-              "$ref": "#/definitions/apiErrorEnvelope"
-            },
-            "examples": {
-              "application/json": {
-                "error": {
-                  "args": {
-                    "urlVars": {
-                      "id": "71a3000f-7dda-491a-9b90-a19f4ee6c406"
-                    }
-                  },
-                  "causeMessage": "referenced by /some-resource/05f4f710-c155-4a74-86d5-77558eb9cb42",
-                  "code": "CONFLICT_CANNOT_MODIFY_REFERENCED",
-                  "message": "The resource cannot be deleted/modified. Remove all referencing resources first.",
-                  "requestId": "270908d6-f2ef-4577-b973-67bec18ae376"
-                },
-                "meta": {
-                  "apiEnrollmentVersion": "0.0.1",
-                  "apiVersion": "0.0.1"
-                }
-              }
-            }
-          },
-          "429": {
-            "description": "The resource requested is rate limited and the rate limit has been exceeded",
-            "schema": {
-              "$ref": "#/definitions/apiErrorEnvelope"
-            },
-            "examples": {
-              "application/json": {
-                "error": {
-                  "args": {
-                    "urlVars": {}
-                  },
-                  "causeMessage": "you have hit a rate limit in the requested operation",
-                  "code": "RATE_LIMITED",
-                  "message": "The resource is rate limited and the rate limit has been exceeded. Please try again later",
-                  "requestId": "270908d6-f2ef-4577-b973-67bec18ae376"
-                },
-                "meta": {
-                  "apiEnrollmentVersion": "0.0.1",
-                  "apiVersion": "0.0.1"
-                }
-              }
-            }
-          },
-          "503": {
-            "description": "The request could not be completed due to the server being busy or in a temporarily bad state",
-            "schema": {
-              "$ref": "#/definitions/apiErrorEnvelope"
-            }
+      }
+    },
+    "inspectResponse": {
+      "type": "object",
+      "required": [
+        "success"
+      ],
+      "properties": {
+        "errors": {
+          "type": "array",
+          "items": {
+            "type": "string"
+          }
+        },
+        "success": {
+          "type": "boolean"
+        },
+        "values": {
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/inspectResponseValue"
+          }
+        }
+      }
+    },
+    "inspectResponseValue": {
+      "type": "object",
+      "required": [
+        "appId",
+        "name",
+        "value"
+      ],
+      "properties": {
+        "appId": {
+          "type": "string"
+        },
+        "name": {
+          "type": "string"
+        },
+        "value": {
+          "type": [
+            "object",
+            "string",
+            "number",
+            "boolean"
+          ]
+        }
+      }
+    },
+    "interface": {
+      "description": "A resource describing a network interface",
+      "type": "object",
+      "required": [
+        "name",
+        "hardwareAddress",
+        "mtu",
+        "index",
+        "isUp",
+        "isRunning",
+        "isLoopback",
+        "isBroadcast",
+        "isMulticast",
+        "addresses"
+      ],
+      "properties": {
+        "addresses": {
+          "type": "array",
+          "items": {
+            "type": "string"
+          }
+        },
+        "hardwareAddress": {
+          "type": "string"
+        },
+        "index": {
+          "type": "integer",
+          "minimum": 0
+        },
+        "isBroadcast": {
+          "type": "boolean"
+        },
+        "isLoopback": {
+          "type": "boolean"
+        },
+        "isMulticast": {
+          "type": "boolean"
+        },
+        "isRunning": {
+          "type": "boolean"
+        },
+        "isUp": {
+          "type": "boolean"
+        },
+        "mtu": {
+          "type": "integer",
+          "minimum": 0
+        },
+        "name": {
+          "type": "string"
+        }
+      }
+    },
+    "link": {
+      "description": "A link to another resource",
+      "type": "object",
+      "required": [
+        "href"
+      ],
+      "properties": {
+        "comment": {
+          "type": "string"
+        },
+        "href": {
+          "type": "string",
+          "format": "uri"
+        },
+        "method": {
+          "type": "string"
+        }
+      }
+    },
+    "linkConnection": {
+      "type": "object",
+      "properties": {
+        "dest": {
+          "type": "string"
+        },
+        "source": {
+          "type": "string"
+        },
+        "type": {
+          "type": "string"
+        }
+      }
+    },
+    "linkDetail": {
+      "type": "object",
+      "required": [
+        "id",
+        "protocol",
+        "sourceRouter",
+        "destRouter",
+        "state",
+        "staticCost",
+        "sourceLatency",
+        "destLatency",
+        "cost",
+        "down",
+        "iteration"
+      ],
+      "properties": {
+        "connections": {
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/linkConnection"
           }
+        },
+        "cost": {
+          "type": "integer"
+        },
+        "destLatency": {
+          "type": "integer"
+        },
+        "destRouter": {
+          "$ref": "#/definitions/entityRef"
+        },
+        "down": {
+          "type": "boolean"
+        },
+        "id": {
+          "type": "string"
+        },
+        "iteration": {
+          "type": "integer"
+        },
+        "protocol": {
+          "type": "string"
+        },
+        "sourceLatency": {
+          "type": "integer"
+        },
+        "sourceRouter": {
+          "$ref": "#/definitions/entityRef"
+        },
+        "state": {
+          "type": "string"
+        },
+        "staticCost": {
+          "type": "integer"
+        }
+      }
+    },
+    "linkList": {
+      "type": "array",
+      "items": {
+        "$ref": "#/definitions/linkDetail"
+      }
+    },
+    "linkPatch": {
+      "type": "object",
+      "properties": {
+        "down": {
+          "type": "boolean"
+        },
+        "staticCost": {
+          "type": "integer"
         }
+      }
+    },
+    "links": {
+      "description": "A map of named links",
+      "type": "object",
+      "additionalProperties": {
+        "$ref": "#/definitions/link"
       },
-      "patch": {
-        "description": "Update the supplied fields on a terminator. Requires admin access.",
-        "tags": [
-          "Terminator"
-        ],
-        "summary": "Update the supplied fields on a terminator",
-        "operationId": "patchTerminator",
-        "parameters": [
-          {
-            "description": "A terminator patch object",
-            "name": "terminator",
-            "in": "body",
-            "required": true,
-            "schema": {
-              "$ref": "#/definitions/terminatorPatch"
-            }
-          }
-        ],
-        "responses": {
-          "200": {
-            "description": "The patch request was successful and the resource has been altered",
-            "schema": {
-              "$ref": "#/definitions/empty"
-            }
-          },
-          "400": {
-            "description": "The supplied request contains invalid fields or could not be parsed (json and non-json bodies). The error's code, message, and cause fields can be inspected for further information",
-            "schema": {
-              "$ref": "#/definitions/apiErrorEnvelope"
-            },
-            "examples": {
-              "application/json": {
-                "error": {
-                  "args": {
-                    "urlVars": {}
-                  },
-                  "cause": {
-                    "details": {
-                      "context": "(root)",
-                      "field": "(root)",
-                      "property": "fooField3"
-                    },
-                    "field": "(root)",
-                    "message": "(root): fooField3 is required",
-                    "type": "required",
-                    "value": {
-                      "fooField": "abc",
-                      "fooField2": "def"
-                    }
-                  },
-                  "causeMessage": "schema validation failed",
-                  "code": "COULD_NOT_VALIDATE",
-                  "message": "The supplied request contains an invalid document",
-                  "requestId": "ac6766d6-3a09-44b3-8d8a-1b541d97fdd9"
-                },
-                "meta": {
-                  "apiEnrollmentVersion": "0.0.1",
-                  "apiVersion": "0.0.1"
-                }
-              }
-            }
-          },
-          "401": {
-            "description": "The currently supplied session does not have the correct access rights to request this resource",
-            "schema": {
-              "$ref": "#/definitions/apiErrorEnvelope"
-            },
-            "examples": {
-              "application/json": {
-                "error": {
-                  "args": {
-                    "urlVars": {}
-                  },
-                  "cause": "",
-                  "causeMessage": "",
-                  "code": "UNAUTHORIZED",
-                  "message": "The request could not be completed. The session is not authorized or the credentials are invalid",
-                  "requestId": "0bfe7a04-9229-4b7a-812c-9eb3cc0eac0f"
-                },
-                "meta": {
-                  "apiEnrollmentVersion": "0.0.1",
-                  "apiVersion": "0.0.1"
-                }
-              }
-            }
-          },
-          "404": {
-            "description": "The requested resource does not exist",
-            "schema": {
-              "$ref": "#/definitions/apiErrorEnvelope"
-            },
-            "examples": {
-              "application/json": {
-                "error": {
-                  "args": {
-                    "urlVars": {
-                      "id": "71a3000f-7dda-491a-9b90-a19f4ee6c406"
-                    }
-                  },
-                  "cause": null,
-                  "causeMessage": "",
-                  "code": "NOT_FOUND",
-                  "message": "The resource requested was not found or is no longer available",
-                  "requestId": "270908d6-f2ef-4577-b973-67bec18ae376"
-                },
-                "meta": {
-                  "apiEnrollmentVersion": "0.0.1",
-                  "apiVersion": "0.0.1"
-                }
-              }
-            }
-          },
-          "429": {
-            "description": "The resource requested is rate limited and the rate limit has been exceeded",
-            "schema": {
-              "$ref": "#/definitions/apiErrorEnvelope"
-            },
-            "examples": {
-              "application/json": {
-                "error": {
-                  "args": {
-                    "urlVars": {}
-                  },
-                  "causeMessage": "you have hit a rate limit in the requested operation",
-                  "code": "RATE_LIMITED",
-                  "message": "The resource is rate limited and the rate limit has been exceeded. Please try again later",
-                  "requestId": "270908d6-f2ef-4577-b973-67bec18ae376"
-                },
-                "meta": {
-                  "apiEnrollmentVersion": "0.0.1",
-                  "apiVersion": "0.0.1"
-                }
-              }
-            }
-          },
-          "503": {
-            "description": "The request could not be completed due to the server being busy or in a temporarily bad state",
-            "schema": {
-              "$ref": "#/definitions/apiErrorEnvelope"
-            }
-          }
+      "x-omitempty": false
+    },
+    "listAlertRulesEnvelope": {
+      "type": "object",
+      "required": [
+        "meta",
+        "data"
+      ],
+      "properties": {
+        "data": {
+          "$ref": "#/definitions/alertRuleList"
+        },
+        "meta": {
+          "$ref": "#/definitions/meta"
         }
-      },
-      "parameters": [
-        {
-          "type": "string",
-          "description": "The id of the requested resource",
-          "name": "id",
-          "in": "path",
-          "required": true
+      }
+    },
+    "listAlertSilencesEnvelope": {
+      "type": "object",
+      "required": [
+        "meta",
+        "data"
+      ],
+      "properties": {
+        "data": {
+          "$ref": "#/definitions/alertSilenceList"
+        },
+        "meta": {
+          "$ref": "#/definitions/meta"
         }
-      ]
-    }
-  },
-  "definitions": {
-    "apiError": {
+      }
+    },
+    "listAlertsEnvelope": {
       "type": "object",
+      "required": [
+        "meta",
+        "data"
+      ],
       "properties": {
-        "args": {
-          "$ref": "#/definitions/apiErrorArgs"
+        "data": {
+          "$ref": "#/definitions/alertList"
         },
-        "cause": {
-          "$ref": "#/definitions/apiErrorCause"
+        "meta": {
+          "$ref": "#/definitions/meta"
+        }
+      }
+    },
+    "listAuditEntriesEnvelope": {
+      "type": "object",
+      "required": [
+        "meta",
+        "data"
+      ],
+      "properties": {
+        "data": {
+          "$ref": "#/definitions/auditEntryList"
         },
-        "causeMessage": {
-          "type": "string"
+        "meta": {
+          "$ref": "#/definitions/meta"
+        }
+      }
+    },
+    "listBandwidthLimitsEnvelope": {
+      "type": "object",
+      "required": [
+        "meta",
+        "data"
+      ],
+      "properties": {
+        "data": {
+          "$ref": "#/definitions/bandwidthLimitList"
         },
-        "code": {
-          "type": "string"
+        "meta": {
+          "$ref": "#/definitions/meta"
+        }
+      }
+    },
+    "listBulkOperationsEnvelope": {
+      "type": "object",
+      "required": [
+        "meta",
+        "data"
+      ],
+      "properties": {
+        "data": {
+          "$ref": "#/definitions/bulkOperationList"
         },
+        "meta": {
+          "$ref": "#/definitions/meta"
+        }
+      }
+    },
+    "listCircuitHistoryEnvelope": {
+      "type": "object",
+      "required": [
+        "meta",
+        "data"
+      ],
+      "properties": {
         "data": {
-          "type": "object",
-          "additionalProperties": true
+          "$ref": "#/definitions/circuitHistoryList"
         },
-        "message": {
-          "type": "string"
+        "meta": {
+          "$ref": "#/definitions/meta"
+        }
+      }
+    },
+    "listCircuitsEnvelope": {
+      "type": "object",
+      "required": [
+        "meta",
+        "data"
+      ],
+      "properties": {
+        "data": {
+          "$ref": "#/definitions/circuitList"
         },
-        "requestId": {
-          "type": "string"
+        "meta": {
+          "$ref": "#/definitions/meta"
         }
       }
     },
-    "apiErrorArgs": {
+    "listEnrollmentSignersEnvelope": {
       "type": "object",
+      "required": [
+        "meta",
+        "data"
+      ],
       "properties": {
-        "urlVars": {
-          "type": "object",
-          "additionalProperties": {
-            "type": "string"
-          }
+        "data": {
+          "$ref": "#/definitions/enrollmentSignerList"
+        },
+        "meta": {
+          "$ref": "#/definitions/meta"
         }
       }
     },
-    "apiErrorCause": {
-      "allOf": [
-        {
-          "$ref": "#/definitions/apiFieldError"
+    "listLinksEnvelope": {
+      "type": "object",
+      "required": [
+        "meta",
+        "data"
+      ],
+      "properties": {
+        "data": {
+          "$ref": "#/definitions/linkList"
+        },
+        "meta": {
+          "$ref": "#/definitions/meta"
+        }
+      }
+    },
+    "listProbeResultsEnvelope": {
+      "type": "object",
+      "required": [
+        "meta",
+        "data"
+      ],
+      "properties": {
+        "data": {
+          "$ref": "#/definitions/probeResultList"
+        },
+        "meta": {
+          "$ref": "#/definitions/meta"
+        }
+      }
+    },
+    "listProbesEnvelope": {
+      "type": "object",
+      "required": [
+        "meta",
+        "data"
+      ],
+      "properties": {
+        "data": {
+          "$ref": "#/definitions/probeList"
+        },
+        "meta": {
+          "$ref": "#/definitions/meta"
+        }
+      }
+    },
+    "listQuotaUsageEnvelope": {
+      "type": "object",
+      "required": [
+        "meta",
+        "data"
+      ],
+      "properties": {
+        "data": {
+          "$ref": "#/definitions/quotaUsageList"
+        },
+        "meta": {
+          "$ref": "#/definitions/meta"
+        }
+      }
+    },
+    "listQuotasEnvelope": {
+      "type": "object",
+      "required": [
+        "meta",
+        "data"
+      ],
+      "properties": {
+        "data": {
+          "$ref": "#/definitions/quotaList"
+        },
+        "meta": {
+          "$ref": "#/definitions/meta"
+        }
+      }
+    },
+    "listRolesEnvelope": {
+      "type": "object",
+      "required": [
+        "meta",
+        "data"
+      ],
+      "properties": {
+        "data": {
+          "$ref": "#/definitions/roleList"
+        },
+        "meta": {
+          "$ref": "#/definitions/meta"
+        }
+      }
+    },
+    "listRouterCertRotationsEnvelope": {
+      "type": "object",
+      "required": [
+        "meta",
+        "data"
+      ],
+      "properties": {
+        "data": {
+          "$ref": "#/definitions/routerCertRotationList"
+        },
+        "meta": {
+          "$ref": "#/definitions/meta"
+        }
+      }
+    },
+    "listRouterDrainsEnvelope": {
+      "type": "object",
+      "required": [
+        "meta",
+        "data"
+      ],
+      "properties": {
+        "data": {
+          "$ref": "#/definitions/routerDrainList"
+        },
+        "meta": {
+          "$ref": "#/definitions/meta"
+        }
+      }
+    },
+    "listRoutersEnvelope": {
+      "type": "object",
+      "required": [
+        "meta",
+        "data"
+      ],
+      "properties": {
+        "data": {
+          "$ref": "#/definitions/routerList"
+        },
+        "meta": {
+          "$ref": "#/definitions/meta"
+        }
+      }
+    },
+    "listServicesEnvelope": {
+      "type": "object",
+      "required": [
+        "meta",
+        "data"
+      ],
+      "properties": {
+        "data": {
+          "$ref": "#/definitions/serviceList"
         },
-        {
-          "$ref": "#/definitions/apiError"
+        "meta": {
+          "$ref": "#/definitions/meta"
         }
-      ]
+      }
     },
-    "apiErrorEnvelope": {
+    "listTerminatorsEnvelope": {
       "type": "object",
       "required": [
         "meta",
-        "error"
+        "data"
       ],
       "properties": {
-        "error": {
-          "$ref": "#/definitions/apiError"
+        "data": {
+          "$ref": "#/definitions/terminatorList"
         },
         "meta": {
           "$ref": "#/definitions/meta"
         }
       }
     },
-    "apiFieldError": {
+    "meta": {
       "type": "object",
       "properties": {
-        "field": {
+        "apiEnrollmentVersion": {
           "type": "string"
         },
-        "reason": {
+        "apiVersion": {
           "type": "string"
         },
-        "value": {
-          "type": "string"
+        "filterableFields": {
+          "type": "array",
+          "items": {
+            "type": "string"
+          },
+          "x-omitempty": true
+        },
+        "pagination": {
+          "$ref": "#/definitions/pagination"
         }
       }
     },
-    "baseEntity": {
-      "description": "Fields shared by all Edge API entities",
+    "pagination": {
       "type": "object",
       "required": [
-        "id",
-        "createdAt",
-        "updatedAt",
-        "_links",
-        "tags"
+        "limit",
+        "offset",
+        "totalCount"
       ],
       "properties": {
-        "_links": {
-          "$ref": "#/definitions/links"
-        },
-        "createdAt": {
-          "type": "string",
-          "format": "date-time"
-        },
-        "id": {
-          "type": "string"
+        "limit": {
+          "type": "number",
+          "format": "int64"
         },
-        "tags": {
-          "$ref": "#/definitions/tags"
+        "offset": {
+          "type": "number",
+          "format": "int64"
         },
-        "updatedAt": {
-          "type": "string",
-          "format": "date-time"
+        "totalCount": {
+          "type": "number",
+          "format": "int64"
         }
       }
     },
-    "circuitDelete": {
+    "path": {
       "type": "object",
       "properties": {
-        "immediate": {
-          "type": "boolean"
-        }
-      }
-    },
-    "circuitDetail": {
-      "allOf": [
-        {
-          "$ref": "#/definitions/baseEntity"
+        "initiatorLocalAddr": {
+          "description": "The local address of the initiating connection, for intercepted connections the intercepted address",
+          "type": "string"
         },
-        {
-          "type": "object",
-          "required": [
-            "service",
-            "terminator",
-            "path"
-          ],
-          "properties": {
-            "clientId": {
-              "type": "string"
-            },
-            "path": {
-              "$ref": "#/definitions/path"
-            },
-            "service": {
-              "$ref": "#/definitions/entityRef"
-            },
-            "terminator": {
-              "$ref": "#/definitions/entityRef"
-            }
+        "initiatorRemoteAddr": {
+          "description": "The remote address of the initiating connection",
+          "type": "string"
+        },
+        "links": {
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/entityRef"
           }
+        },
+        "nodes": {
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/entityRef"
+          }
+        },
+        "terminatorLocalAddr": {
+          "description": "The local address of the terminating connection",
+          "type": "string"
+        },
+        "terminatorRemoteAddr": {
+          "description": "The remote address of the terminating connection",
+          "type": "string"
         }
-      ]
+      }
     },
-    "circuitList": {
+    "permissions": {
+      "description": "A list of \u003centity type\u003e.\u003cverb\u003e grants, where the verb is read, create, update, delete or *",
       "type": "array",
       "items": {
-        "$ref": "#/definitions/circuitDetail"
+        "type": "string"
       }
     },
-    "clusterMemberAdd": {
+    "policySimulationAction": {
+      "type": "string",
+      "enum": [
+        "create",
+        "update",
+        "delete"
+      ]
+    },
+    "policySimulationChange": {
       "type": "object",
       "required": [
-        "address",
-        "isVoter"
+        "identityId",
+        "identityName",
+        "serviceId",
+        "serviceName",
+        "policyType",
+        "changeType"
       ],
       "properties": {
-        "address": {
-          "type": "string"
+        "addedEdgeRouters": {
+          "type": "array",
+          "items": {
+            "type": "string"
+          }
         },
-        "isVoter": {
-          "type": "boolean"
-        }
-      }
-    },
-    "clusterMemberListResponse": {
-      "type": "object",
-      "properties": {
-        "data": {
+        "changeType": {
+          "type": "string",
+          "enum": [
+            "granted",
+            "revoked",
+            "changed"
+          ]
+        },
+        "edgeRouters": {
+          "description": "The edge routers which could be used after the change",
           "type": "array",
           "items": {
-            "$ref": "#/definitions/clusterMemberListValue"
+            "type": "string"
           }
-        }
-      }
-    },
-    "clusterMemberListValue": {
-      "type": "object",
-      "required": [
-        "id",
-        "address",
-        "voter",
-        "leader",
-        "version",
-        "connected",
-        "readOnly"
-      ],
-      "properties": {
-        "address": {
-          "type": "string"
         },
-        "connected": {
-          "type": "boolean"
+        "identityId": {
+          "type": "string"
         },
-        "id": {
+        "identityName": {
           "type": "string"
         },
-        "leader": {
-          "type": "boolean"
+        "policyType": {
+          "$ref": "#/definitions/dialBind"
         },
-        "readOnly": {
-          "type": "boolean"
+        "postureChecksAfter": {
+          "$ref": "#/definitions/postureCheckSets"
         },
-        "version": {
-          "type": "string"
+        "postureChecksBefore": {
+          "$ref": "#/definitions/postureCheckSets"
         },
-        "voter": {
-          "type": "boolean"
-        }
-      }
-    },
-    "clusterMemberRemove": {
-      "type": "object",
-      "required": [
-        "id"
-      ],
-      "properties": {
-        "id": {
+        "removedEdgeRouters": {
+          "type": "array",
+          "items": {
+            "type": "string"
+          }
+        },
+        "serviceId": {
           "type": "string"
-        }
-      }
-    },
-    "clusterTransferLeadership": {
-      "type": "object",
-      "properties": {
-        "newLeaderId": {
+        },
+        "serviceName": {
           "type": "string"
         }
       }
     },
-    "createEnvelope": {
+    "policySimulationCreate": {
+      "description": "Hypothetical changes to evaluate. For updates, omitted fields keep their current values, while an empty role\nlist clears the roles.\n",
       "type": "object",
       "properties": {
-        "data": {
-          "$ref": "#/definitions/createLocation"
+        "edgeRouterPolicies": {
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/simulatedEdgeRouterPolicy"
+          }
         },
-        "meta": {
-          "$ref": "#/definitions/meta"
-        }
-      }
-    },
-    "createLocation": {
-      "type": "object",
-      "properties": {
-        "_links": {
-          "$ref": "#/definitions/links"
+        "edgeRouterRoleAttributes": {
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/simulatedRoleAttributes"
+          }
         },
-        "id": {
-          "type": "string"
-        }
-      }
-    },
-    "dataIntegrityCheckDetail": {
-      "type": "object",
-      "required": [
-        "description",
-        "fixed"
-      ],
-      "properties": {
-        "description": {
-          "type": "string"
+        "identityRoleAttributes": {
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/simulatedRoleAttributes"
+          }
+        },
+        "maxChanges": {
+          "description": "The maximum number of changes to list. Defaults to 1000. Counts always cover every change",
+          "type": "integer"
+        },
+        "removedEdgeRouters": {
+          "description": "Ids of edge routers to treat as removed",
+          "type": "array",
+          "items": {
+            "type": "string"
+          }
+        },
+        "serviceEdgeRouterPolicies": {
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/simulatedServiceEdgeRouterPolicy"
+          }
+        },
+        "servicePolicies": {
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/simulatedServicePolicy"
+          }
         },
-        "fixed": {
-          "type": "boolean"
+        "serviceRoleAttributes": {
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/simulatedRoleAttributes"
+          }
         }
       }
     },
-    "dataIntegrityCheckDetailList": {
-      "type": "array",
-      "items": {
-        "$ref": "#/definitions/dataIntegrityCheckDetail"
-      }
-    },
-    "dataIntegrityCheckDetails": {
+    "policySimulationResultDetail": {
       "type": "object",
       "required": [
-        "inProgress",
-        "fixingErrors",
-        "tooManyErrors",
-        "startTime",
-        "endTime",
-        "error",
-        "results"
+        "identitiesAffected",
+        "servicesAffected",
+        "granted",
+        "revoked",
+        "changed",
+        "tuplesAdded",
+        "tuplesRemoved",
+        "truncated",
+        "changes"
       ],
       "properties": {
-        "endTime": {
-          "type": "string",
-          "format": "date-time"
+        "changed": {
+          "description": "Identity, service and policy type combinations whose edge routers or posture checks would change",
+          "type": "integer"
         },
-        "error": {
-          "type": "string"
+        "changes": {
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/policySimulationChange"
+          }
         },
-        "fixingErrors": {
-          "type": "boolean"
+        "granted": {
+          "description": "Identity, service and policy type combinations which would gain access",
+          "type": "integer"
         },
-        "inProgress": {
-          "type": "boolean"
+        "identitiesAffected": {
+          "type": "integer"
         },
-        "results": {
-          "$ref": "#/definitions/dataIntegrityCheckDetailList"
+        "revoked": {
+          "description": "Identity, service and policy type combinations which would lose access",
+          "type": "integer"
         },
-        "startTime": {
-          "type": "string",
-          "format": "date-time"
+        "servicesAffected": {
+          "type": "integer"
         },
-        "tooManyErrors": {
+        "truncated": {
           "type": "boolean"
+        },
+        "tuplesAdded": {
+          "description": "Identity, service, policy type and edge router combinations which would be added",
+          "type": "integer"
+        },
+        "tuplesRemoved": {
+          "description": "Identity, service, policy type and edge router combinations which would be removed",
+          "type": "integer"
         }
       }
     },
-    "dataIntegrityCheckResultEnvelope": {
+    "policySimulationResultEnvelope": {
       "type": "object",
       "required": [
         "meta",
@@ -7404,93 +18902,80 @@ func init() {
       ],
       "properties": {
         "data": {
-          "$ref": "#/definitions/dataIntegrityCheckDetails"
+          "$ref": "#/definitions/policySimulationResultDetail"
         },
         "meta": {
           "$ref": "#/definitions/meta"
         }
       }
     },
-    "databaseSnapshotCreate": {
-      "type": "object",
-      "properties": {
-        "path": {
+    "postureCheckSets": {
+      "description": "The posture check ids required by each granting policy. Access requires all checks of any one set",
+      "type": "array",
+      "items": {
+        "type": "array",
+        "items": {
           "type": "string"
         }
       }
     },
-    "databaseSnapshotCreateDetails": {
+    "probeDetail": {
       "type": "object",
       "required": [
-        "path"
+        "name",
+        "type",
+        "router",
+        "intervalSeconds",
+        "timeoutMillis",
+        "slo",
+        "summary"
       ],
       "properties": {
-        "path": {
+        "expect": {
+          "description": "For service probes, the response the service is expected to send",
           "type": "string"
-        }
-      }
-    },
-    "databaseSnapshotCreateResultEnvelope": {
-      "type": "object",
-      "required": [
-        "meta",
-        "data"
-      ],
-      "properties": {
-        "data": {
-          "$ref": "#/definitions/databaseSnapshotCreateDetails"
         },
-        "meta": {
-          "$ref": "#/definitions/meta"
-        }
-      }
-    },
-    "detailCircuitEnvelope": {
-      "type": "object",
-      "required": [
-        "meta",
-        "data"
-      ],
-      "properties": {
-        "data": {
-          "$ref": "#/definitions/circuitDetail"
+        "intervalSeconds": {
+          "type": "integer"
         },
-        "meta": {
-          "$ref": "#/definitions/meta"
-        }
-      }
-    },
-    "detailLinkEnvelope": {
-      "type": "object",
-      "required": [
-        "meta",
-        "data"
-      ],
-      "properties": {
-        "data": {
-          "$ref": "#/definitions/linkDetail"
+        "name": {
+          "type": "string"
         },
-        "meta": {
-          "$ref": "#/definitions/meta"
-        }
-      }
-    },
-    "detailRouterEnvelope": {
-      "type": "object",
-      "required": [
-        "meta",
-        "data"
-      ],
-      "properties": {
-        "data": {
-          "$ref": "#/definitions/routerDetail"
+        "router": {
+          "description": "The id or name of the router which executes the probe",
+          "type": "string"
         },
-        "meta": {
-          "$ref": "#/definitions/meta"
+        "send": {
+          "description": "For service probes, the payload written once connected",
+          "type": "string"
+        },
+        "service": {
+          "description": "For service probes, the service which is dialed",
+          "type": "string"
+        },
+        "slo": {
+          "$ref": "#/definitions/probeSlo"
+        },
+        "summary": {
+          "$ref": "#/definitions/probeSummary"
+        },
+        "targetRouter": {
+          "description": "For routerPath probes, the id or name of the router at the other end of the path",
+          "type": "string"
+        },
+        "timeoutMillis": {
+          "type": "integer"
+        },
+        "type": {
+          "type": "string",
+          "enum": [
+            "routerPath",
+            "service"
+          ]
         }
       }
     },
-    "detailServiceEnvelope": {
+    "probeEnvelope": {
       "type": "object",
       "required": [
         "meta",
@@ -7498,356 +18983,538 @@ func init() {
       ],
       "properties": {
         "data": {
-          "$ref": "#/definitions/serviceDetail"
+          "$ref": "#/definitions/probeDetail"
         },
         "meta": {
           "$ref": "#/definitions/meta"
         }
       }
     },
-    "detailTerminatorEnvelope": {
-      "type": "object",
-      "required": [
-        "meta",
-        "data"
-      ],
-      "properties": {
-        "data": {
-          "$ref": "#/definitions/terminatorDetail"
-        },
-        "meta": {
-          "$ref": "#/definitions/meta"
-        }
+    "probeList": {
+      "type": "array",
+      "items": {
+        "$ref": "#/definitions/probeDetail"
       }
     },
-    "empty": {
+    "probeResult": {
       "type": "object",
       "required": [
-        "meta",
-        "data"
+        "timestamp",
+        "routerId",
+        "success"
       ],
       "properties": {
-        "data": {
-          "type": "object",
-          "example": {}
+        "error": {
+          "type": "string"
         },
-        "meta": {
-          "$ref": "#/definitions/meta"
-        }
-      }
-    },
-    "entityRef": {
-      "description": "A reference to another resource and links to interact with it",
-      "type": "object",
-      "properties": {
-        "_links": {
-          "$ref": "#/definitions/links"
+        "latencyMillis": {
+          "type": "number",
+          "x-nullable": true
         },
-        "entity": {
+        "routerId": {
           "type": "string"
         },
-        "id": {
-          "type": "string"
+        "success": {
+          "type": "boolean"
         },
-        "name": {
+        "targetRouterId": {
           "type": "string"
+        },
+        "timestamp": {
+          "type": "string",
+          "format": "date-time"
         }
       }
     },
-    "inspectRequest": {
-      "type": "object",
-      "required": [
-        "appRegex",
-        "requestedValues"
-      ],
-      "properties": {
-        "appRegex": {
-          "type": "string"
-        },
-        "requestedValues": {
-          "type": "array",
-          "items": {
-            "type": "string"
-          }
-        }
+    "probeResultList": {
+      "type": "array",
+      "items": {
+        "$ref": "#/definitions/probeResult"
       }
     },
-    "inspectResponse": {
+    "probeSlo": {
       "type": "object",
-      "required": [
-        "success"
-      ],
       "properties": {
-        "errors": {
-          "type": "array",
-          "items": {
-            "type": "string"
-          }
+        "availability": {
+          "description": "The percentage of probes which must succeed. Not set if the probe has no availability objective",
+          "type": "number",
+          "x-nullable": true
         },
-        "success": {
-          "type": "boolean"
+        "latencyMillis": {
+          "description": "The upper bound for the given percentile of probe latency. Not set if the probe has no latency objective",
+          "type": "number",
+          "x-nullable": true
         },
-        "values": {
-          "type": "array",
-          "items": {
-            "$ref": "#/definitions/inspectResponseValue"
-          }
+        "percentile": {
+          "type": "number"
         }
       }
     },
-    "inspectResponseValue": {
+    "probeSummary": {
       "type": "object",
       "required": [
-        "appId",
-        "name",
-        "value"
+        "windowSeconds",
+        "total",
+        "succeeded"
       ],
       "properties": {
-        "appId": {
-          "type": "string"
+        "availability": {
+          "description": "The percentage of probes in the window which succeeded. Not set if there are no results in the window",
+          "type": "number",
+          "x-nullable": true
         },
-        "name": {
-          "type": "string"
+        "lastFailure": {
+          "$ref": "#/definitions/probeResult"
         },
-        "value": {
-          "type": [
-            "object",
-            "string",
-            "number",
-            "boolean"
-          ]
+        "lastResult": {
+          "$ref": "#/definitions/probeResult"
+        },
+        "latencyP50Millis": {
+          "type": "number",
+          "x-nullable": true
+        },
+        "latencyP95Millis": {
+          "type": "number",
+          "x-nullable": true
+        },
+        "latencyP99Millis": {
+          "type": "number",
+          "x-nullable": true
+        },
+        "sloLatencyMillis": {
+          "description": "The latency at the SLO percentile",
+          "type": "number",
+          "x-nullable": true
+        },
+        "sloMet": {
+          "description": "Whether the probe met its objectives over the window. Not set if the probe has no objectives or no results",
+          "type": "boolean",
+          "x-nullable": true
+        },
+        "succeeded": {
+          "type": "integer"
+        },
+        "total": {
+          "type": "integer"
+        },
+        "windowSeconds": {
+          "description": "The window over which the summary is computed",
+          "type": "integer"
         }
       }
     },
-    "interface": {
-      "description": "A resource describing a network interface",
+    "quotaBytes": {
+      "description": "A number of bytes. Zero means no limit",
+      "type": "integer",
+      "format": "int64"
+    },
+    "quotaCreate": {
       "type": "object",
       "required": [
         "name",
-        "hardwareAddress",
-        "mtu",
-        "index",
-        "isUp",
-        "isRunning",
-        "isLoopback",
-        "isBroadcast",
-        "isMulticast",
-        "addresses"
+        "scope",
+        "period"
       ],
       "properties": {
-        "addresses": {
-          "type": "array",
-          "items": {
-            "type": "string"
-          }
+        "hardLimit": {
+          "$ref": "#/definitions/quotaBytes"
         },
-        "hardwareAddress": {
-          "type": "string"
-        },
-        "index": {
-          "type": "integer",
-          "minimum": 0
+        "hardLimitAction": {
+          "$ref": "#/definitions/quotaHardLimitAction"
         },
-        "isBroadcast": {
-          "type": "boolean"
+        "identityRoles": {
+          "$ref": "#/definitions/roles"
         },
-        "isLoopback": {
-          "type": "boolean"
+        "name": {
+          "type": "string"
         },
-        "isMulticast": {
-          "type": "boolean"
+        "period": {
+          "$ref": "#/definitions/quotaPeriod"
         },
-        "isRunning": {
-          "type": "boolean"
+        "scope": {
+          "$ref": "#/definitions/quotaScope"
         },
-        "isUp": {
-          "type": "boolean"
+        "serviceRoles": {
+          "$ref": "#/definitions/roles"
         },
-        "mtu": {
-          "type": "integer",
-          "minimum": 0
+        "softLimit": {
+          "$ref": "#/definitions/quotaBytes"
         },
-        "name": {
-          "type": "string"
+        "tags": {
+          "$ref": "#/definitions/tags"
         }
       }
     },
-    "link": {
-      "description": "A link to another resource",
+    "quotaDetail": {
       "type": "object",
-      "required": [
-        "href"
-      ],
-      "properties": {
-        "comment": {
-          "type": "string"
-        },
-        "href": {
-          "type": "string",
-          "format": "uri"
+      "allOf": [
+        {
+          "$ref": "#/definitions/baseEntity"
         },
-        "method": {
-          "type": "string"
+        {
+          "type": "object",
+          "required": [
+            "name",
+            "scope",
+            "period",
+            "softLimit",
+            "hardLimit",
+            "hardLimitAction",
+            "identityRoles",
+            "serviceRoles"
+          ],
+          "properties": {
+            "hardLimit": {
+              "$ref": "#/definitions/quotaBytes"
+            },
+            "hardLimitAction": {
+              "$ref": "#/definitions/quotaHardLimitAction"
+            },
+            "identityRoles": {
+              "$ref": "#/definitions/roles"
+            },
+            "name": {
+              "type": "string"
+            },
+            "period": {
+              "$ref": "#/definitions/quotaPeriod"
+            },
+            "scope": {
+              "$ref": "#/definitions/quotaScope"
+            },
+            "serviceRoles": {
+              "$ref": "#/definitions/roles"
+            },
+            "softLimit": {
+              "$ref": "#/definitions/quotaBytes"
+            }
+          }
         }
+      ]
+    },
+    "quotaHardLimitAction": {
+      "description": "What happens when the hard limit is reached. deny refuses new sessions and circuits, teardown additionally\nremoves existing circuits. Defaults to deny\n",
+      "type": "string",
+      "enum": [
+        "deny",
+        "teardown"
+      ]
+    },
+    "quotaList": {
+      "type": "array",
+      "items": {
+        "$ref": "#/definitions/quotaDetail"
       }
     },
-    "linkConnection": {
+    "quotaPatch": {
       "type": "object",
       "properties": {
-        "dest": {
-          "type": "string"
+        "hardLimit": {
+          "$ref": "#/definitions/quotaBytes"
         },
-        "source": {
-          "type": "string"
+        "hardLimitAction": {
+          "$ref": "#/definitions/quotaHardLimitAction"
         },
-        "type": {
+        "identityRoles": {
+          "$ref": "#/definitions/roles"
+        },
+        "name": {
           "type": "string"
+        },
+        "period": {
+          "$ref": "#/definitions/quotaPeriod"
+        },
+        "scope": {
+          "$ref": "#/definitions/quotaScope"
+        },
+        "serviceRoles": {
+          "$ref": "#/definitions/roles"
+        },
+        "softLimit": {
+          "$ref": "#/definitions/quotaBytes"
+        },
+        "tags": {
+          "$ref": "#/definitions/tags"
         }
       }
     },
-    "linkDetail": {
+    "quotaPeriod": {
+      "description": "The period over which consumption is counted. Periods start at midnight UTC",
+      "type": "string",
+      "enum": [
+        "day",
+        "month"
+      ]
+    },
+    "quotaScope": {
+      "description": "Whether consumption is tracked separately for each matching identity or for each matching service",
+      "type": "string",
+      "enum": [
+        "identity",
+        "service"
+      ]
+    },
+    "quotaUpdate": {
       "type": "object",
       "required": [
-        "id",
-        "protocol",
-        "sourceRouter",
-        "destRouter",
-        "state",
-        "staticCost",
-        "sourceLatency",
-        "destLatency",
-        "cost",
-        "down",
-        "iteration"
+        "name",
+        "scope",
+        "period"
       ],
       "properties": {
-        "connections": {
-          "type": "array",
-          "items": {
-            "$ref": "#/definitions/linkConnection"
-          }
+        "hardLimit": {
+          "$ref": "#/definitions/quotaBytes"
         },
-        "cost": {
-          "type": "integer"
+        "hardLimitAction": {
+          "$ref": "#/definitions/quotaHardLimitAction"
         },
-        "destLatency": {
-          "type": "integer"
+        "identityRoles": {
+          "$ref": "#/definitions/roles"
         },
-        "destRouter": {
-          "$ref": "#/definitions/entityRef"
+        "name": {
+          "type": "string"
         },
-        "down": {
-          "type": "boolean"
+        "period": {
+          "$ref": "#/definitions/quotaPeriod"
         },
-        "id": {
-          "type": "string"
+        "scope": {
+          "$ref": "#/definitions/quotaScope"
         },
-        "iteration": {
-          "type": "integer"
+        "serviceRoles": {
+          "$ref": "#/definitions/roles"
         },
-        "protocol": {
-          "type": "string"
+        "softLimit": {
+          "$ref": "#/definitions/quotaBytes"
         },
-        "sourceLatency": {
-          "type": "integer"
+        "tags": {
+          "$ref": "#/definitions/tags"
+        }
+      }
+    },
+    "quotaUsage": {
+      "type": "object",
+      "required": [
+        "subjectId",
+        "periodStart",
+        "bytes",
+        "softLimitExceeded",
+        "hardLimitExceeded"
+      ],
+      "properties": {
+        "bytes": {
+          "type": "integer",
+          "format": "int64"
         },
-        "sourceRouter": {
-          "$ref": "#/definitions/entityRef"
+        "hardLimitExceeded": {
+          "type": "boolean"
         },
-        "state": {
-          "type": "string"
+        "periodStart": {
+          "type": "string",
+          "format": "date-time"
         },
-        "staticCost": {
-          "type": "integer"
+        "softLimitExceeded": {
+          "type": "boolean"
+        },
+        "subjectId": {
+          "description": "The id of the identity or service, depending on the quota scope",
+          "type": "string"
         }
       }
     },
-    "linkList": {
+    "quotaUsageList": {
       "type": "array",
       "items": {
-        "$ref": "#/definitions/linkDetail"
+        "$ref": "#/definitions/quotaUsage"
       }
     },
-    "linkPatch": {
+    "roleCreate": {
       "type": "object",
+      "required": [
+        "name",
+        "permissions"
+      ],
       "properties": {
-        "down": {
-          "type": "boolean"
+        "identityRoles": {
+          "$ref": "#/definitions/roles"
         },
-        "staticCost": {
-          "type": "integer"
+        "name": {
+          "type": "string"
+        },
+        "permissions": {
+          "$ref": "#/definitions/permissions"
+        },
+        "scopeRoles": {
+          "$ref": "#/definitions/roles"
+        },
+        "tags": {
+          "$ref": "#/definitions/tags"
         }
       }
     },
-    "links": {
-      "description": "A map of named links",
+    "roleDetail": {
       "type": "object",
-      "additionalProperties": {
-        "$ref": "#/definitions/link"
-      },
-      "x-omitempty": false
+      "allOf": [
+        {
+          "$ref": "#/definitions/baseEntity"
+        },
+        {
+          "type": "object",
+          "required": [
+            "name",
+            "identityRoles",
+            "permissions",
+            "scopeRoles"
+          ],
+          "properties": {
+            "identityRoles": {
+              "$ref": "#/definitions/roles"
+            },
+            "name": {
+              "type": "string"
+            },
+            "permissions": {
+              "$ref": "#/definitions/permissions"
+            },
+            "scopeRoles": {
+              "$ref": "#/definitions/roles"
+            }
+          }
+        }
+      ]
     },
-    "listCircuitsEnvelope": {
+    "roleList": {
+      "type": "array",
+      "items": {
+        "$ref": "#/definitions/roleDetail"
+      }
+    },
+    "rolePatch": {
       "type": "object",
-      "required": [
-        "meta",
-        "data"
-      ],
       "properties": {
-        "data": {
-          "$ref": "#/definitions/circuitList"
+        "identityRoles": {
+          "$ref": "#/definitions/roles"
         },
-        "meta": {
-          "$ref": "#/definitions/meta"
+        "name": {
+          "type": "string"
+        },
+        "permissions": {
+          "$ref": "#/definitions/permissions"
+        },
+        "scopeRoles": {
+          "$ref": "#/definitions/roles"
+        },
+        "tags": {
+          "$ref": "#/definitions/tags"
         }
       }
     },
-    "listLinksEnvelope": {
+    "roleUpdate": {
       "type": "object",
       "required": [
-        "meta",
-        "data"
+        "name",
+        "permissions"
       ],
       "properties": {
-        "data": {
-          "$ref": "#/definitions/linkList"
+        "identityRoles": {
+          "$ref": "#/definitions/roles"
         },
-        "meta": {
-          "$ref": "#/definitions/meta"
+        "name": {
+          "type": "string"
+        },
+        "permissions": {
+          "$ref": "#/definitions/permissions"
+        },
+        "scopeRoles": {
+          "$ref": "#/definitions/roles"
+        },
+        "tags": {
+          "$ref": "#/definitions/tags"
         }
       }
     },
-    "listRoutersEnvelope": {
-      "type": "object",
-      "required": [
-        "meta",
-        "data"
-      ],
+    "roles": {
+      "description": "A list of @\u003cid\u003e,",
+      "type": "array",
+      "items": {
+        "type": "string"
+      }
+    },
+    "routerCertRotationCreate": {
+      "type": "object",
       "properties": {
-        "data": {
-          "$ref": "#/definitions/routerList"
+        "batchSize": {
+          "description": "The number of routers rotated at a time. Defaults to 10, may be at most 100",
+          "type": "integer"
         },
-        "meta": {
-          "$ref": "#/definitions/meta"
+        "filter": {
+          "description": "A filter selecting the routers to rotate, ex. 'name contains \"us-east\"'",
+          "type": "string"
+        },
+        "routerIds": {
+          "description": "The routers to rotate. Exactly one of routerIds or filter must be provided",
+          "type": "array",
+          "items": {
+            "type": "string"
+          }
+        },
+        "timeoutSeconds": {
+          "description": "How long to wait for each router to renew its certificates. Defaults to 120, must be at least 10",
+          "type": "integer"
         }
       }
     },
-    "listServicesEnvelope": {
+    "routerCertRotationDetail": {
       "type": "object",
       "required": [
-        "meta",
-        "data"
+        "id",
+        "batchSize",
+        "timeoutSeconds",
+        "total",
+        "completed",
+        "failed",
+        "complete",
+        "startedAt",
+        "routers"
       ],
       "properties": {
-        "data": {
-          "$ref": "#/definitions/serviceList"
+        "batchSize": {
+          "type": "integer"
         },
-        "meta": {
-          "$ref": "#/definitions/meta"
+        "complete": {
+          "type": "boolean"
+        },
+        "completed": {
+          "type": "integer"
+        },
+        "completedAt": {
+          "type": "string",
+          "format": "date-time"
+        },
+        "failed": {
+          "type": "integer"
+        },
+        "id": {
+          "type": "string"
+        },
+        "routers": {
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/routerCertRotationStatus"
+          }
+        },
+        "startedAt": {
+          "type": "string",
+          "format": "date-time"
+        },
+        "timeoutSeconds": {
+          "type": "integer"
+        },
+        "total": {
+          "type": "integer"
         }
       }
     },
-    "listTerminatorsEnvelope": {
+    "routerCertRotationEnvelope": {
       "type": "object",
       "required": [
         "meta",
@@ -7855,70 +19522,51 @@ func init() {
       ],
       "properties": {
         "data": {
-          "$ref": "#/definitions/terminatorList"
+          "$ref": "#/definitions/routerCertRotationDetail"
         },
         "meta": {
           "$ref": "#/definitions/meta"
         }
       }
     },
-    "meta": {
-      "type": "object",
-      "properties": {
-        "apiEnrollmentVersion": {
-          "type": "string"
-        },
-        "apiVersion": {
-          "type": "string"
-        },
-        "filterableFields": {
-          "type": "array",
-          "items": {
-            "type": "string"
-          },
-          "x-omitempty": true
-        },
-        "pagination": {
-          "$ref": "#/definitions/pagination"
-        }
+    "routerCertRotationList": {
+      "type": "array",
+      "items": {
+        "$ref": "#/definitions/routerCertRotationDetail"
       }
     },
-    "pagination": {
+    "routerCertRotationStatus": {
       "type": "object",
       "required": [
-        "limit",
-        "offset",
-        "totalCount"
+        "routerId",
+        "state"
       ],
       "properties": {
-        "limit": {
-          "type": "number",
-          "format": "int64"
+        "completedAt": {
+          "type": "string",
+          "format": "date-time"
         },
-        "offset": {
-          "type": "number",
-          "format": "int64"
+        "error": {
+          "type": "string"
         },
-        "totalCount": {
-          "type": "number",
-          "format": "int64"
-        }
-      }
-    },
-    "path": {
-      "type": "object",
-      "properties": {
-        "links": {
-          "type": "array",
-          "items": {
-            "$ref": "#/definitions/entityRef"
-          }
+        "requestedAt": {
+          "type": "string",
+          "format": "date-time"
         },
-        "nodes": {
-          "type": "array",
-          "items": {
-            "$ref": "#/definitions/entityRef"
-          }
+        "routerId": {
+          "type": "string"
+        },
+        "routerName": {
+          "type": "string"
+        },
+        "state": {
+          "type": "string",
+          "enum": [
+            "pending",
+            "requested",
+            "completed",
+            "failed"
+          ]
         }
       }
     },
@@ -8014,6 +19662,127 @@ func init() {
         }
       ]
     },
+    "routerDrainCreate": {
+      "type": "object",
+      "properties": {
+        "edgeCircuitTimeoutSeconds": {
+          "description": "How long to wait for circuits which start or end at the router to finish. Zero doesn't wait",
+          "type": "integer"
+        },
+        "quiesce": {
+          "description": "If true, the router is quiesced so its terminators aren't used for new circuits",
+          "type": "boolean"
+        }
+      }
+    },
+    "routerDrainDetail": {
+      "type": "object",
+      "required": [
+        "routerId",
+        "state",
+        "startedAt",
+        "transitCircuits",
+        "transitRerouted",
+        "transitEnded",
+        "edgeCircuits",
+        "stragglers"
+      ],
+      "properties": {
+        "completedAt": {
+          "type": "string",
+          "format": "date-time",
+          "x-nullable": true
+        },
+        "edgeCircuitTimeoutSeconds": {
+          "type": "integer"
+        },
+        "edgeCircuits": {
+          "description": "The number of circuits which start or end at the router",
+          "type": "integer"
+        },
+        "quiesce": {
+          "type": "boolean"
+        },
+        "routerId": {
+          "type": "string"
+        },
+        "routerName": {
+          "type": "string"
+        },
+        "startedAt": {
+          "type": "string",
+          "format": "date-time"
+        },
+        "state": {
+          "type": "string",
+          "enum": [
+            "rerouting",
+            "waiting-for-edge-circuits",
+            "drained"
+          ]
+        },
+        "stragglers": {
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/routerDrainStraggler"
+          }
+        },
+        "transitCircuits": {
+          "description": "The number of circuits passing through the router which the drain tried to reroute",
+          "type": "integer"
+        },
+        "transitEnded": {
+          "description": "The number of transit circuits which ended before they were rerouted",
+          "type": "integer"
+        },
+        "transitRerouted": {
+          "type": "integer"
+        }
+      }
+    },
+    "routerDrainEnvelope": {
+      "type": "object",
+      "required": [
+        "meta",
+        "data"
+      ],
+      "properties": {
+        "data": {
+          "$ref": "#/definitions/routerDrainDetail"
+        },
+        "meta": {
+          "$ref": "#/definitions/meta"
+        }
+      }
+    },
+    "routerDrainList": {
+      "type": "array",
+      "items": {
+        "$ref": "#/definitions/routerDrainDetail"
+      }
+    },
+    "routerDrainStraggler": {
+      "type": "object",
+      "required": [
+        "circuitId",
+        "kind"
+      ],
+      "properties": {
+        "circuitId": {
+          "type": "string"
+        },
+        "error": {
+          "type": "string"
+        },
+        "kind": {
+          "type": "string",
+          "enum": [
+            "transit",
+            "edge"
+          ]
+        }
+      }
+    },
     "routerList": {
       "type": "array",
       "items": {
@@ -8096,6 +19865,13 @@ func init() {
         }
       }
     },
+    "semantic": {
+      "type": "string",
+      "enum": [
+        "AllOf",
+        "AnyOf"
+      ]
+    },
     "serviceCreate": {
       "type": "object",
       "required": [
@@ -8134,42 +19910,147 @@ func init() {
             }
           }
         }
-      ]
-    },
-    "serviceList": {
-      "type": "array",
-      "items": {
-        "$ref": "#/definitions/serviceDetail"
+      ]
+    },
+    "serviceList": {
+      "type": "array",
+      "items": {
+        "$ref": "#/definitions/serviceDetail"
+      }
+    },
+    "servicePatch": {
+      "type": "object",
+      "properties": {
+        "name": {
+          "type": "string"
+        },
+        "tags": {
+          "$ref": "#/definitions/tags"
+        },
+        "terminatorStrategy": {
+          "type": "string"
+        }
+      }
+    },
+    "serviceUpdate": {
+      "type": "object",
+      "required": [
+        "name"
+      ],
+      "properties": {
+        "name": {
+          "type": "string"
+        },
+        "tags": {
+          "$ref": "#/definitions/tags"
+        },
+        "terminatorStrategy": {
+          "type": "string"
+        }
+      }
+    },
+    "simulatedEdgeRouterPolicy": {
+      "type": "object",
+      "required": [
+        "action"
+      ],
+      "properties": {
+        "action": {
+          "$ref": "#/definitions/policySimulationAction"
+        },
+        "edgeRouterRoles": {
+          "$ref": "#/definitions/roles"
+        },
+        "id": {
+          "description": "Required for update and delete. Optional for create",
+          "type": "string"
+        },
+        "identityRoles": {
+          "$ref": "#/definitions/roles"
+        },
+        "name": {
+          "type": "string"
+        },
+        "semantic": {
+          "$ref": "#/definitions/semantic"
+        }
+      }
+    },
+    "simulatedRoleAttributes": {
+      "type": "object",
+      "required": [
+        "id",
+        "roleAttributes"
+      ],
+      "properties": {
+        "id": {
+          "type": "string"
+        },
+        "roleAttributes": {
+          "type": "array",
+          "items": {
+            "type": "string"
+          }
+        }
       }
     },
-    "servicePatch": {
+    "simulatedServiceEdgeRouterPolicy": {
       "type": "object",
+      "required": [
+        "action"
+      ],
       "properties": {
+        "action": {
+          "$ref": "#/definitions/policySimulationAction"
+        },
+        "edgeRouterRoles": {
+          "$ref": "#/definitions/roles"
+        },
+        "id": {
+          "description": "Required for update and delete. Optional for create",
+          "type": "string"
+        },
         "name": {
           "type": "string"
         },
-        "tags": {
-          "$ref": "#/definitions/tags"
+        "semantic": {
+          "$ref": "#/definitions/semantic"
         },
-        "terminatorStrategy": {
-          "type": "string"
+        "serviceRoles": {
+          "$ref": "#/definitions/roles"
         }
       }
     },
-    "serviceUpdate": {
+    "simulatedServicePolicy": {
       "type": "object",
       "required": [
-        "name"
+        "action"
       ],
       "properties": {
-        "name": {
+        "action": {
+          "$ref": "#/definitions/policySimulationAction"
+        },
+        "id": {
+          "description": "Required for update and delete. Optional for create",
           "type": "string"
         },
-        "tags": {
-          "$ref": "#/definitions/tags"
+        "identityRoles": {
+          "$ref": "#/definitions/roles"
         },
-        "terminatorStrategy": {
+        "name": {
           "type": "string"
+        },
+        "postureCheckRoles": {
+          "$ref": "#/definitions/roles"
+        },
+        "semantic": {
+          "$ref": "#/definitions/semantic"
+        },
+        "serviceRoles": {
+          "$ref": "#/definitions/roles"
+        },
+        "type": {
+          "$ref": "#/definitions/dialBind"
         }
       }
     },
@@ -8384,6 +20265,158 @@ func init() {
         }
       }
     },
+    "topology": {
+      "type": "object",
+      "required": [
+        "controllerId",
+        "timestamp",
+        "routers",
+        "links"
+      ],
+      "properties": {
+        "controllerId": {
+          "description": "The id of the controller which produced the snapshot",
+          "type": "string"
+        },
+        "links": {
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/topologyLink"
+          }
+        },
+        "routers": {
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/topologyRouter"
+          }
+        },
+        "timestamp": {
+          "type": "string",
+          "format": "date-time"
+        }
+      }
+    },
+    "topologyEnvelope": {
+      "type": "object",
+      "required": [
+        "meta",
+        "data"
+      ],
+      "properties": {
+        "data": {
+          "$ref": "#/definitions/topology"
+        },
+        "meta": {
+          "$ref": "#/definitions/meta"
+        }
+      }
+    },
+    "topologyLink": {
+      "type": "object",
+      "required": [
+        "id",
+        "sourceRouterId",
+        "destRouterId",
+        "protocol",
+        "state",
+        "down",
+        "staticCost",
+        "cost",
+        "sourceLatency",
+        "destLatency",
+        "circuitCount"
+      ],
+      "properties": {
+        "circuitCount": {
+          "description": "The number of circuits routed over the link",
+          "type": "integer"
+        },
+        "cost": {
+          "type": "integer"
+        },
+        "destLatency": {
+          "description": "The latency measured by the destination router, in nanoseconds",
+          "type": "integer"
+        },
+        "destRouterId": {
+          "type": "string"
+        },
+        "down": {
+          "type": "boolean"
+        },
+        "id": {
+          "type": "string"
+        },
+        "protocol": {
+          "type": "string"
+        },
+        "sourceLatency": {
+          "description": "The latency measured by the source router, in nanoseconds",
+          "type": "integer"
+        },
+        "sourceRouterId": {
+          "type": "string"
+        },
+        "state": {
+          "type": "string"
+        },
+        "staticCost": {
+          "type": "integer"
+        }
+      }
+    },
+    "topologyRouter": {
+      "type": "object",
+      "required": [
+        "id",
+        "name",
+        "connected",
+        "cost",
+        "noTraversal",
+        "disabled",
+        "draining",
+        "circuitCount"
+      ],
+      "properties": {
+        "circuitCount": {
+          "description": "The number of circuits whose path includes the router",
+          "type": "integer"
+        },
+        "connected": {
+          "type": "boolean"
+        },
+        "connectedController": {
+          "description": "The id of the controller the router is connected to, if it's connected to the controller which produced the snapshot",
+          "type": "string"
+        },
+        "connectedSince": {
+          "type": "string",
+          "format": "date-time",
+          "x-nullable": true
+        },
+        "cost": {
+          "type": "integer"
+        },
+        "disabled": {
+          "type": "boolean"
+        },
+        "draining": {
+          "type": "boolean"
+        },
+        "id": {
+          "type": "string"
+        },
+        "name": {
+          "type": "string"
+        },
+        "noTraversal": {
+          "type": "boolean"
+        },
+        "version": {
+          "type": "string"
+        }
+      }
+    },
     "versionInfo": {
       "description": "Application build information",
       "type": "object",
@@ -8431,6 +20464,18 @@ func init() {
     }
   },
   "responses": {
+    "auditNotEnabledResponse": {
+      "description": "The audit log is not enabled on this controller",
+      "schema": {
+        "$ref": "#/definitions/apiErrorEnvelope"
+      }
+    },
+    "backupNotConfiguredResponse": {
+      "description": "Database backups are not configured on this controller",
+      "schema": {
+        "$ref": "#/definitions/apiErrorEnvelope"
+      }
+    },
     "badRequestResponse": {
       "description": "The supplied request contains invalid fields or could not be parsed (json and non-json bodies). The error's code, message, and cause fields can be inspected for further information",
       "schema": {
@@ -8493,6 +20538,12 @@ func init() {
         }
       }
     },
+    "circuitHistoryNotEnabledResponse": {
+      "description": "The circuit history is not enabled on this controller",
+      "schema": {
+        "$ref": "#/definitions/apiErrorEnvelope"
+      }
+    },
     "clusterListMembersResponse": {
       "description": "A response to a cluster list-members request",
       "schema": {
@@ -8511,6 +20562,18 @@ func init() {
         "$ref": "#/definitions/dataIntegrityCheckResultEnvelope"
       }
     },
+    "databaseBackupCreateResult": {
+      "description": "The backup which was taken",
+      "schema": {
+        "$ref": "#/definitions/databaseBackupCreateResultEnvelope"
+      }
+    },
+    "databaseBackupStatus": {
+      "description": "The status of scheduled database backups",
+      "schema": {
+        "$ref": "#/definitions/databaseBackupStatusEnvelope"
+      }
+    },
     "databaseSnapshotCreateResult": {
       "description": "The path to the created snapshot",
       "schema": {
@@ -8523,24 +20586,90 @@ func init() {
         "$ref": "#/definitions/empty"
       }
     },
+    "detailAlertSilence": {
+      "description": "A single alert silence",
+      "schema": {
+        "$ref": "#/definitions/alertSilenceEnvelope"
+      }
+    },
+    "detailBandwidthLimit": {
+      "description": "A single bandwidth limit",
+      "schema": {
+        "$ref": "#/definitions/detailBandwidthLimitEnvelope"
+      }
+    },
+    "detailBulkOperation": {
+      "description": "The progress of a bulk operation",
+      "schema": {
+        "$ref": "#/definitions/bulkOperationEnvelope"
+      }
+    },
     "detailCircuit": {
       "description": "A single circuit",
       "schema": {
         "$ref": "#/definitions/detailCircuitEnvelope"
       }
     },
+    "detailCircuitHistory": {
+      "description": "A single circuit from the circuit history",
+      "schema": {
+        "$ref": "#/definitions/detailCircuitHistoryEnvelope"
+      }
+    },
+    "detailCurrentIdentityPermissions": {
+      "description": "The permissions of the current identity",
+      "schema": {
+        "$ref": "#/definitions/currentIdentityPermissionsEnvelope"
+      }
+    },
+    "detailEnrollmentSigner": {
+      "description": "A single enrollment signer",
+      "schema": {
+        "$ref": "#/definitions/enrollmentSignerEnvelope"
+      }
+    },
     "detailLink": {
       "description": "A single link",
       "schema": {
         "$ref": "#/definitions/detailLinkEnvelope"
       }
     },
+    "detailProbe": {
+      "description": "A single synthetic probe",
+      "schema": {
+        "$ref": "#/definitions/probeEnvelope"
+      }
+    },
+    "detailQuota": {
+      "description": "A single quota",
+      "schema": {
+        "$ref": "#/definitions/detailQuotaEnvelope"
+      }
+    },
+    "detailRole": {
+      "description": "A single role",
+      "schema": {
+        "$ref": "#/definitions/detailRoleEnvelope"
+      }
+    },
     "detailRouter": {
       "description": "A single router",
       "schema": {
         "$ref": "#/definitions/detailRouterEnvelope"
       }
     },
+    "detailRouterCertRotation": {
+      "description": "The progress of a router certificate rotation",
+      "schema": {
+        "$ref": "#/definitions/routerCertRotationEnvelope"
+      }
+    },
+    "detailRouterDrain": {
+      "description": "A single router drain",
+      "schema": {
+        "$ref": "#/definitions/routerDrainEnvelope"
+      }
+    },
     "detailService": {
       "description": "A single service",
       "schema": {
@@ -8553,6 +20682,12 @@ func init() {
         "$ref": "#/definitions/detailTerminatorEnvelope"
       }
     },
+    "detailTopology": {
+      "description": "A snapshot of the network topology",
+      "schema": {
+        "$ref": "#/definitions/topologyEnvelope"
+      }
+    },
     "emptyResponse": {
       "description": "Base empty response",
       "schema": {
@@ -8589,18 +20724,108 @@ func init() {
         }
       }
     },
+    "listAlertRules": {
+      "description": "A list of alert rules",
+      "schema": {
+        "$ref": "#/definitions/listAlertRulesEnvelope"
+      }
+    },
+    "listAlertSilences": {
+      "description": "A list of alert silences",
+      "schema": {
+        "$ref": "#/definitions/listAlertSilencesEnvelope"
+      }
+    },
+    "listAlerts": {
+      "description": "A list of active alerts",
+      "schema": {
+        "$ref": "#/definitions/listAlertsEnvelope"
+      }
+    },
+    "listAuditEntries": {
+      "description": "A list of audit log entries",
+      "schema": {
+        "$ref": "#/definitions/listAuditEntriesEnvelope"
+      }
+    },
+    "listBandwidthLimits": {
+      "description": "A list of bandwidth limits",
+      "schema": {
+        "$ref": "#/definitions/listBandwidthLimitsEnvelope"
+      }
+    },
+    "listBulkOperations": {
+      "description": "A list of bulk operations",
+      "schema": {
+        "$ref": "#/definitions/listBulkOperationsEnvelope"
+      }
+    },
+    "listCircuitHistory": {
+      "description": "A list of circuits from the circuit history",
+      "schema": {
+        "$ref": "#/definitions/listCircuitHistoryEnvelope"
+      }
+    },
     "listCircuits": {
       "description": "A list of circuits",
       "schema": {
         "$ref": "#/definitions/listCircuitsEnvelope"
       }
     },
+    "listEnrollmentSigners": {
+      "description": "A list of enrollment signers",
+      "schema": {
+        "$ref": "#/definitions/listEnrollmentSignersEnvelope"
+      }
+    },
     "listLinks": {
       "description": "A list of links",
       "schema": {
         "$ref": "#/definitions/listLinksEnvelope"
       }
     },
+    "listProbeResults": {
+      "description": "A list of probe results",
+      "schema": {
+        "$ref": "#/definitions/listProbeResultsEnvelope"
+      }
+    },
+    "listProbes": {
+      "description": "A list of synthetic probes",
+      "schema": {
+        "$ref": "#/definitions/listProbesEnvelope"
+      }
+    },
+    "listQuotaUsage": {
+      "description": "The current consumption of a quota",
+      "schema": {
+        "$ref": "#/definitions/listQuotaUsageEnvelope"
+      }
+    },
+    "listQuotas": {
+      "description": "A list of quotas",
+      "schema": {
+        "$ref": "#/definitions/listQuotasEnvelope"
+      }
+    },
+    "listRoles": {
+      "description": "A list of roles",
+      "schema": {
+        "$ref": "#/definitions/listRolesEnvelope"
+      }
+    },
+    "listRouterCertRotations": {
+      "description": "A list of router certificate rotations",
+      "schema": {
+        "$ref": "#/definitions/listRouterCertRotationsEnvelope"
+      }
+    },
+    "listRouterDrains": {
+      "description": "A list of router drains",
+      "schema": {
+        "$ref": "#/definitions/listRouterDrainsEnvelope"
+      }
+    },
     "listRouters": {
       "description": "A list of routers",
       "schema": {
@@ -8651,6 +20876,12 @@ func init() {
         "$ref": "#/definitions/empty"
       }
     },
+    "policySimulationResult": {
+      "description": "The access changes the simulated changes would cause",
+      "schema": {
+        "$ref": "#/definitions/policySimulationResultEnvelope"
+      }
+    },
     "rateLimitedResponse": {
       "description": "The resource requested is rate limited and the rate limit has been exceeded",
       "schema": {
@@ -8674,6 +20905,12 @@ func init() {
         }
       }
     },
+    "reissueEnrollmentSigner": {
+      "description": "The certificates which were asked to be re-issued",
+      "schema": {
+        "$ref": "#/definitions/enrollmentSignerReissueEnvelope"
+      }
+    },
     "serverUnavailableResponse": {
       "description": "The request could not be completed due to the server being busy or in a temporarily bad state",
       "schema": {
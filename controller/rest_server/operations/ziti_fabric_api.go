@@ -43,14 +43,26 @@ import (
 	"github.com/go-openapi/strfmt"
 	"github.com/go-openapi/swag"
 
+	"github.com/openziti/ziti/controller/rest_server/operations/alert"
+	"github.com/openziti/ziti/controller/rest_server/operations/audit"
+	"github.com/openziti/ziti/controller/rest_server/operations/bandwidth_limit"
+	"github.com/openziti/ziti/controller/rest_server/operations/bulk_operation"
 	"github.com/openziti/ziti/controller/rest_server/operations/circuit"
+	"github.com/openziti/ziti/controller/rest_server/operations/circuit_history"
 	"github.com/openziti/ziti/controller/rest_server/operations/cluster"
 	"github.com/openziti/ziti/controller/rest_server/operations/database"
+	"github.com/openziti/ziti/controller/rest_server/operations/enrollment_signer"
 	"github.com/openziti/ziti/controller/rest_server/operations/inspect"
 	"github.com/openziti/ziti/controller/rest_server/operations/link"
+	"github.com/openziti/ziti/controller/rest_server/operations/policy_simulation"
+	"github.com/openziti/ziti/controller/rest_server/operations/probe"
+	"github.com/openziti/ziti/controller/rest_server/operations/quota"
+	"github.com/openziti/ziti/controller/rest_server/operations/role"
 	"github.com/openziti/ziti/controller/rest_server/operations/router"
+	"github.com/openziti/ziti/controller/rest_server/operations/router_cert_rotation"
 	"github.com/openziti/ziti/controller/rest_server/operations/service"
 	"github.com/openziti/ziti/controller/rest_server/operations/terminator"
+	"github.com/openziti/ziti/controller/rest_server/operations/topology"
 )
 
 // NewZitiFabricAPI creates a new ZitiFabric instance
@@ -75,6 +87,9 @@ func NewZitiFabricAPI(spec *loads.Document) *ZitiFabricAPI {
 
 		JSONProducer: runtime.JSONProducer(),
 
+		RouterCancelRouterDrainHandler: router.CancelRouterDrainHandlerFunc(func(params router.CancelRouterDrainParams) middleware.Responder {
+			return middleware.NotImplemented("operation router.CancelRouterDrain has not yet been implemented")
+		}),
 		DatabaseCheckDataIntegrityHandler: database.CheckDataIntegrityHandlerFunc(func(params database.CheckDataIntegrityParams, principal interface{}) middleware.Responder {
 			return middleware.NotImplemented("operation database.CheckDataIntegrity has not yet been implemented")
 		}),
@@ -90,15 +105,36 @@ func NewZitiFabricAPI(spec *loads.Document) *ZitiFabricAPI {
 		ClusterClusterTransferLeadershipHandler: cluster.ClusterTransferLeadershipHandlerFunc(func(params cluster.ClusterTransferLeadershipParams) middleware.Responder {
 			return middleware.NotImplemented("operation cluster.ClusterTransferLeadership has not yet been implemented")
 		}),
+		AlertCreateAlertSilenceHandler: alert.CreateAlertSilenceHandlerFunc(func(params alert.CreateAlertSilenceParams) middleware.Responder {
+			return middleware.NotImplemented("operation alert.CreateAlertSilence has not yet been implemented")
+		}),
+		BandwidthLimitCreateBandwidthLimitHandler: bandwidth_limit.CreateBandwidthLimitHandlerFunc(func(params bandwidth_limit.CreateBandwidthLimitParams) middleware.Responder {
+			return middleware.NotImplemented("operation bandwidth_limit.CreateBandwidthLimit has not yet been implemented")
+		}),
+		BulkOperationCreateBulkOperationHandler: bulk_operation.CreateBulkOperationHandlerFunc(func(params bulk_operation.CreateBulkOperationParams) middleware.Responder {
+			return middleware.NotImplemented("operation bulk_operation.CreateBulkOperation has not yet been implemented")
+		}),
+		DatabaseCreateDatabaseBackupHandler: database.CreateDatabaseBackupHandlerFunc(func(params database.CreateDatabaseBackupParams, principal interface{}) middleware.Responder {
+			return middleware.NotImplemented("operation database.CreateDatabaseBackup has not yet been implemented")
+		}),
 		DatabaseCreateDatabaseSnapshotHandler: database.CreateDatabaseSnapshotHandlerFunc(func(params database.CreateDatabaseSnapshotParams, principal interface{}) middleware.Responder {
 			return middleware.NotImplemented("operation database.CreateDatabaseSnapshot has not yet been implemented")
 		}),
 		DatabaseCreateDatabaseSnapshotWithPathHandler: database.CreateDatabaseSnapshotWithPathHandlerFunc(func(params database.CreateDatabaseSnapshotWithPathParams) middleware.Responder {
 			return middleware.NotImplemented("operation database.CreateDatabaseSnapshotWithPath has not yet been implemented")
 		}),
+		QuotaCreateQuotaHandler: quota.CreateQuotaHandlerFunc(func(params quota.CreateQuotaParams) middleware.Responder {
+			return middleware.NotImplemented("operation quota.CreateQuota has not yet been implemented")
+		}),
+		RoleCreateRoleHandler: role.CreateRoleHandlerFunc(func(params role.CreateRoleParams) middleware.Responder {
+			return middleware.NotImplemented("operation role.CreateRole has not yet been implemented")
+		}),
 		RouterCreateRouterHandler: router.CreateRouterHandlerFunc(func(params router.CreateRouterParams) middleware.Responder {
 			return middleware.NotImplemented("operation router.CreateRouter has not yet been implemented")
 		}),
+		RouterCertRotationCreateRouterCertRotationHandler: router_cert_rotation.CreateRouterCertRotationHandlerFunc(func(params router_cert_rotation.CreateRouterCertRotationParams) middleware.Responder {
+			return middleware.NotImplemented("operation router_cert_rotation.CreateRouterCertRotation has not yet been implemented")
+		}),
 		ServiceCreateServiceHandler: service.CreateServiceHandlerFunc(func(params service.CreateServiceParams) middleware.Responder {
 			return middleware.NotImplemented("operation service.CreateService has not yet been implemented")
 		}),
@@ -108,12 +144,24 @@ func NewZitiFabricAPI(spec *loads.Document) *ZitiFabricAPI {
 		DatabaseDataIntegrityResultsHandler: database.DataIntegrityResultsHandlerFunc(func(params database.DataIntegrityResultsParams, principal interface{}) middleware.Responder {
 			return middleware.NotImplemented("operation database.DataIntegrityResults has not yet been implemented")
 		}),
+		AlertDeleteAlertSilenceHandler: alert.DeleteAlertSilenceHandlerFunc(func(params alert.DeleteAlertSilenceParams) middleware.Responder {
+			return middleware.NotImplemented("operation alert.DeleteAlertSilence has not yet been implemented")
+		}),
+		BandwidthLimitDeleteBandwidthLimitHandler: bandwidth_limit.DeleteBandwidthLimitHandlerFunc(func(params bandwidth_limit.DeleteBandwidthLimitParams) middleware.Responder {
+			return middleware.NotImplemented("operation bandwidth_limit.DeleteBandwidthLimit has not yet been implemented")
+		}),
 		CircuitDeleteCircuitHandler: circuit.DeleteCircuitHandlerFunc(func(params circuit.DeleteCircuitParams) middleware.Responder {
 			return middleware.NotImplemented("operation circuit.DeleteCircuit has not yet been implemented")
 		}),
 		LinkDeleteLinkHandler: link.DeleteLinkHandlerFunc(func(params link.DeleteLinkParams) middleware.Responder {
 			return middleware.NotImplemented("operation link.DeleteLink has not yet been implemented")
 		}),
+		QuotaDeleteQuotaHandler: quota.DeleteQuotaHandlerFunc(func(params quota.DeleteQuotaParams) middleware.Responder {
+			return middleware.NotImplemented("operation quota.DeleteQuota has not yet been implemented")
+		}),
+		RoleDeleteRoleHandler: role.DeleteRoleHandlerFunc(func(params role.DeleteRoleParams) middleware.Responder {
+			return middleware.NotImplemented("operation role.DeleteRole has not yet been implemented")
+		}),
 		RouterDeleteRouterHandler: router.DeleteRouterHandlerFunc(func(params router.DeleteRouterParams) middleware.Responder {
 			return middleware.NotImplemented("operation router.DeleteRouter has not yet been implemented")
 		}),
@@ -123,39 +171,126 @@ func NewZitiFabricAPI(spec *loads.Document) *ZitiFabricAPI {
 		TerminatorDeleteTerminatorHandler: terminator.DeleteTerminatorHandlerFunc(func(params terminator.DeleteTerminatorParams) middleware.Responder {
 			return middleware.NotImplemented("operation terminator.DeleteTerminator has not yet been implemented")
 		}),
+		BandwidthLimitDetailBandwidthLimitHandler: bandwidth_limit.DetailBandwidthLimitHandlerFunc(func(params bandwidth_limit.DetailBandwidthLimitParams) middleware.Responder {
+			return middleware.NotImplemented("operation bandwidth_limit.DetailBandwidthLimit has not yet been implemented")
+		}),
+		BulkOperationDetailBulkOperationHandler: bulk_operation.DetailBulkOperationHandlerFunc(func(params bulk_operation.DetailBulkOperationParams) middleware.Responder {
+			return middleware.NotImplemented("operation bulk_operation.DetailBulkOperation has not yet been implemented")
+		}),
 		CircuitDetailCircuitHandler: circuit.DetailCircuitHandlerFunc(func(params circuit.DetailCircuitParams) middleware.Responder {
 			return middleware.NotImplemented("operation circuit.DetailCircuit has not yet been implemented")
 		}),
+		CircuitHistoryDetailCircuitHistoryHandler: circuit_history.DetailCircuitHistoryHandlerFunc(func(params circuit_history.DetailCircuitHistoryParams) middleware.Responder {
+			return middleware.NotImplemented("operation circuit_history.DetailCircuitHistory has not yet been implemented")
+		}),
+		RoleDetailCurrentIdentityPermissionsHandler: role.DetailCurrentIdentityPermissionsHandlerFunc(func(params role.DetailCurrentIdentityPermissionsParams) middleware.Responder {
+			return middleware.NotImplemented("operation role.DetailCurrentIdentityPermissions has not yet been implemented")
+		}),
+		EnrollmentSignerDetailEnrollmentSignerHandler: enrollment_signer.DetailEnrollmentSignerHandlerFunc(func(params enrollment_signer.DetailEnrollmentSignerParams) middleware.Responder {
+			return middleware.NotImplemented("operation enrollment_signer.DetailEnrollmentSigner has not yet been implemented")
+		}),
 		LinkDetailLinkHandler: link.DetailLinkHandlerFunc(func(params link.DetailLinkParams) middleware.Responder {
 			return middleware.NotImplemented("operation link.DetailLink has not yet been implemented")
 		}),
+		ProbeDetailProbeHandler: probe.DetailProbeHandlerFunc(func(params probe.DetailProbeParams) middleware.Responder {
+			return middleware.NotImplemented("operation probe.DetailProbe has not yet been implemented")
+		}),
+		QuotaDetailQuotaHandler: quota.DetailQuotaHandlerFunc(func(params quota.DetailQuotaParams) middleware.Responder {
+			return middleware.NotImplemented("operation quota.DetailQuota has not yet been implemented")
+		}),
+		RoleDetailRoleHandler: role.DetailRoleHandlerFunc(func(params role.DetailRoleParams) middleware.Responder {
+			return middleware.NotImplemented("operation role.DetailRole has not yet been implemented")
+		}),
 		RouterDetailRouterHandler: router.DetailRouterHandlerFunc(func(params router.DetailRouterParams) middleware.Responder {
 			return middleware.NotImplemented("operation router.DetailRouter has not yet been implemented")
 		}),
+		RouterCertRotationDetailRouterCertRotationHandler: router_cert_rotation.DetailRouterCertRotationHandlerFunc(func(params router_cert_rotation.DetailRouterCertRotationParams) middleware.Responder {
+			return middleware.NotImplemented("operation router_cert_rotation.DetailRouterCertRotation has not yet been implemented")
+		}),
+		RouterDetailRouterDrainHandler: router.DetailRouterDrainHandlerFunc(func(params router.DetailRouterDrainParams) middleware.Responder {
+			return middleware.NotImplemented("operation router.DetailRouterDrain has not yet been implemented")
+		}),
 		ServiceDetailServiceHandler: service.DetailServiceHandlerFunc(func(params service.DetailServiceParams) middleware.Responder {
 			return middleware.NotImplemented("operation service.DetailService has not yet been implemented")
 		}),
 		TerminatorDetailTerminatorHandler: terminator.DetailTerminatorHandlerFunc(func(params terminator.DetailTerminatorParams) middleware.Responder {
 			return middleware.NotImplemented("operation terminator.DetailTerminator has not yet been implemented")
 		}),
+		TopologyDetailTopologyHandler: topology.DetailTopologyHandlerFunc(func(params topology.DetailTopologyParams) middleware.Responder {
+			return middleware.NotImplemented("operation topology.DetailTopology has not yet been implemented")
+		}),
+		RouterDrainRouterHandler: router.DrainRouterHandlerFunc(func(params router.DrainRouterParams) middleware.Responder {
+			return middleware.NotImplemented("operation router.DrainRouter has not yet been implemented")
+		}),
 		DatabaseFixDataIntegrityHandler: database.FixDataIntegrityHandlerFunc(func(params database.FixDataIntegrityParams, principal interface{}) middleware.Responder {
 			return middleware.NotImplemented("operation database.FixDataIntegrity has not yet been implemented")
 		}),
 		InspectInspectHandler: inspect.InspectHandlerFunc(func(params inspect.InspectParams) middleware.Responder {
 			return middleware.NotImplemented("operation inspect.Inspect has not yet been implemented")
 		}),
+		AlertListAlertRulesHandler: alert.ListAlertRulesHandlerFunc(func(params alert.ListAlertRulesParams) middleware.Responder {
+			return middleware.NotImplemented("operation alert.ListAlertRules has not yet been implemented")
+		}),
+		AlertListAlertSilencesHandler: alert.ListAlertSilencesHandlerFunc(func(params alert.ListAlertSilencesParams) middleware.Responder {
+			return middleware.NotImplemented("operation alert.ListAlertSilences has not yet been implemented")
+		}),
+		AlertListAlertsHandler: alert.ListAlertsHandlerFunc(func(params alert.ListAlertsParams) middleware.Responder {
+			return middleware.NotImplemented("operation alert.ListAlerts has not yet been implemented")
+		}),
+		AuditListAuditEntriesHandler: audit.ListAuditEntriesHandlerFunc(func(params audit.ListAuditEntriesParams) middleware.Responder {
+			return middleware.NotImplemented("operation audit.ListAuditEntries has not yet been implemented")
+		}),
+		BandwidthLimitListBandwidthLimitsHandler: bandwidth_limit.ListBandwidthLimitsHandlerFunc(func(params bandwidth_limit.ListBandwidthLimitsParams) middleware.Responder {
+			return middleware.NotImplemented("operation bandwidth_limit.ListBandwidthLimits has not yet been implemented")
+		}),
+		BulkOperationListBulkOperationsHandler: bulk_operation.ListBulkOperationsHandlerFunc(func(params bulk_operation.ListBulkOperationsParams) middleware.Responder {
+			return middleware.NotImplemented("operation bulk_operation.ListBulkOperations has not yet been implemented")
+		}),
+		CircuitHistoryListCircuitHistoryHandler: circuit_history.ListCircuitHistoryHandlerFunc(func(params circuit_history.ListCircuitHistoryParams) middleware.Responder {
+			return middleware.NotImplemented("operation circuit_history.ListCircuitHistory has not yet been implemented")
+		}),
 		CircuitListCircuitsHandler: circuit.ListCircuitsHandlerFunc(func(params circuit.ListCircuitsParams) middleware.Responder {
 			return middleware.NotImplemented("operation circuit.ListCircuits has not yet been implemented")
 		}),
+		DatabaseListDatabaseBackupsHandler: database.ListDatabaseBackupsHandlerFunc(func(params database.ListDatabaseBackupsParams, principal interface{}) middleware.Responder {
+			return middleware.NotImplemented("operation database.ListDatabaseBackups has not yet been implemented")
+		}),
+		EnrollmentSignerListEnrollmentSignersHandler: enrollment_signer.ListEnrollmentSignersHandlerFunc(func(params enrollment_signer.ListEnrollmentSignersParams) middleware.Responder {
+			return middleware.NotImplemented("operation enrollment_signer.ListEnrollmentSigners has not yet been implemented")
+		}),
 		LinkListLinksHandler: link.ListLinksHandlerFunc(func(params link.ListLinksParams) middleware.Responder {
 			return middleware.NotImplemented("operation link.ListLinks has not yet been implemented")
 		}),
+		ProbeListProbeResultsHandler: probe.ListProbeResultsHandlerFunc(func(params probe.ListProbeResultsParams) middleware.Responder {
+			return middleware.NotImplemented("operation probe.ListProbeResults has not yet been implemented")
+		}),
+		ProbeListProbesHandler: probe.ListProbesHandlerFunc(func(params probe.ListProbesParams) middleware.Responder {
+			return middleware.NotImplemented("operation probe.ListProbes has not yet been implemented")
+		}),
+		QuotaListQuotaUsageHandler: quota.ListQuotaUsageHandlerFunc(func(params quota.ListQuotaUsageParams) middleware.Responder {
+			return middleware.NotImplemented("operation quota.ListQuotaUsage has not yet been implemented")
+		}),
+		QuotaListQuotasHandler: quota.ListQuotasHandlerFunc(func(params quota.ListQuotasParams) middleware.Responder {
+			return middleware.NotImplemented("operation quota.ListQuotas has not yet been implemented")
+		}),
+		RoleListRolesHandler: role.ListRolesHandlerFunc(func(params role.ListRolesParams) middleware.Responder {
+			return middleware.NotImplemented("operation role.ListRoles has not yet been implemented")
+		}),
+		RouterCertRotationListRouterCertRotationsHandler: router_cert_rotation.ListRouterCertRotationsHandlerFunc(func(params router_cert_rotation.ListRouterCertRotationsParams) middleware.Responder {
+			return middleware.NotImplemented("operation router_cert_rotation.ListRouterCertRotations has not yet been implemented")
+		}),
+		RouterListRouterDrainsHandler: router.ListRouterDrainsHandlerFunc(func(params router.ListRouterDrainsParams) middleware.Responder {
+			return middleware.NotImplemented("operation router.ListRouterDrains has not yet been implemented")
+		}),
 		RouterListRouterTerminatorsHandler: router.ListRouterTerminatorsHandlerFunc(func(params router.ListRouterTerminatorsParams) middleware.Responder {
 			return middleware.NotImplemented("operation router.ListRouterTerminators has not yet been implemented")
 		}),
 		RouterListRoutersHandler: router.ListRoutersHandlerFunc(func(params router.ListRoutersParams) middleware.Responder {
 			return middleware.NotImplemented("operation router.ListRouters has not yet been implemented")
 		}),
+		AuditListServiceHistoryHandler: audit.ListServiceHistoryHandlerFunc(func(params audit.ListServiceHistoryParams) middleware.Responder {
+			return middleware.NotImplemented("operation audit.ListServiceHistory has not yet been implemented")
+		}),
 		ServiceListServiceTerminatorsHandler: service.ListServiceTerminatorsHandlerFunc(func(params service.ListServiceTerminatorsParams) middleware.Responder {
 			return middleware.NotImplemented("operation service.ListServiceTerminators has not yet been implemented")
 		}),
@@ -165,9 +300,18 @@ func NewZitiFabricAPI(spec *loads.Document) *ZitiFabricAPI {
 		TerminatorListTerminatorsHandler: terminator.ListTerminatorsHandlerFunc(func(params terminator.ListTerminatorsParams) middleware.Responder {
 			return middleware.NotImplemented("operation terminator.ListTerminators has not yet been implemented")
 		}),
+		BandwidthLimitPatchBandwidthLimitHandler: bandwidth_limit.PatchBandwidthLimitHandlerFunc(func(params bandwidth_limit.PatchBandwidthLimitParams) middleware.Responder {
+			return middleware.NotImplemented("operation bandwidth_limit.PatchBandwidthLimit has not yet been implemented")
+		}),
 		LinkPatchLinkHandler: link.PatchLinkHandlerFunc(func(params link.PatchLinkParams) middleware.Responder {
 			return middleware.NotImplemented("operation link.PatchLink has not yet been implemented")
 		}),
+		QuotaPatchQuotaHandler: quota.PatchQuotaHandlerFunc(func(params quota.PatchQuotaParams) middleware.Responder {
+			return middleware.NotImplemented("operation quota.PatchQuota has not yet been implemented")
+		}),
+		RolePatchRoleHandler: role.PatchRoleHandlerFunc(func(params role.PatchRoleParams) middleware.Responder {
+			return middleware.NotImplemented("operation role.PatchRole has not yet been implemented")
+		}),
 		RouterPatchRouterHandler: router.PatchRouterHandlerFunc(func(params router.PatchRouterParams) middleware.Responder {
 			return middleware.NotImplemented("operation router.PatchRouter has not yet been implemented")
 		}),
@@ -177,6 +321,24 @@ func NewZitiFabricAPI(spec *loads.Document) *ZitiFabricAPI {
 		TerminatorPatchTerminatorHandler: terminator.PatchTerminatorHandlerFunc(func(params terminator.PatchTerminatorParams) middleware.Responder {
 			return middleware.NotImplemented("operation terminator.PatchTerminator has not yet been implemented")
 		}),
+		EnrollmentSignerReissueEnrollmentSignerHandler: enrollment_signer.ReissueEnrollmentSignerHandlerFunc(func(params enrollment_signer.ReissueEnrollmentSignerParams) middleware.Responder {
+			return middleware.NotImplemented("operation enrollment_signer.ReissueEnrollmentSigner has not yet been implemented")
+		}),
+		AuditRevertServiceHandler: audit.RevertServiceHandlerFunc(func(params audit.RevertServiceParams) middleware.Responder {
+			return middleware.NotImplemented("operation audit.RevertService has not yet been implemented")
+		}),
+		PolicySimulationSimulatePolicyChangesHandler: policy_simulation.SimulatePolicyChangesHandlerFunc(func(params policy_simulation.SimulatePolicyChangesParams) middleware.Responder {
+			return middleware.NotImplemented("operation policy_simulation.SimulatePolicyChanges has not yet been implemented")
+		}),
+		BandwidthLimitUpdateBandwidthLimitHandler: bandwidth_limit.UpdateBandwidthLimitHandlerFunc(func(params bandwidth_limit.UpdateBandwidthLimitParams) middleware.Responder {
+			return middleware.NotImplemented("operation bandwidth_limit.UpdateBandwidthLimit has not yet been implemented")
+		}),
+		QuotaUpdateQuotaHandler: quota.UpdateQuotaHandlerFunc(func(params quota.UpdateQuotaParams) middleware.Responder {
+			return middleware.NotImplemented("operation quota.UpdateQuota has not yet been implemented")
+		}),
+		RoleUpdateRoleHandler: role.UpdateRoleHandlerFunc(func(params role.UpdateRoleParams) middleware.Responder {
+			return middleware.NotImplemented("operation role.UpdateRole has not yet been implemented")
+		}),
 		RouterUpdateRouterHandler: router.UpdateRouterHandlerFunc(func(params router.UpdateRouterParams) middleware.Responder {
 			return middleware.NotImplemented("operation router.UpdateRouter has not yet been implemented")
 		}),
@@ -222,6 +384,8 @@ type ZitiFabricAPI struct {
 	//   - application/json
 	JSONProducer runtime.Producer
 
+	// RouterCancelRouterDrainHandler sets the operation handler for the cancel router drain operation
+	RouterCancelRouterDrainHandler router.CancelRouterDrainHandler
 	// DatabaseCheckDataIntegrityHandler sets the operation handler for the check data integrity operation
 	DatabaseCheckDataIntegrityHandler database.CheckDataIntegrityHandler
 	// ClusterClusterListMembersHandler sets the operation handler for the cluster list members operation
@@ -232,64 +396,162 @@ type ZitiFabricAPI struct {
 	ClusterClusterMemberRemoveHandler cluster.ClusterMemberRemoveHandler
 	// ClusterClusterTransferLeadershipHandler sets the operation handler for the cluster transfer leadership operation
 	ClusterClusterTransferLeadershipHandler cluster.ClusterTransferLeadershipHandler
+	// AlertCreateAlertSilenceHandler sets the operation handler for the create alert silence operation
+	AlertCreateAlertSilenceHandler alert.CreateAlertSilenceHandler
+	// BandwidthLimitCreateBandwidthLimitHandler sets the operation handler for the create bandwidth limit operation
+	BandwidthLimitCreateBandwidthLimitHandler bandwidth_limit.CreateBandwidthLimitHandler
+	// BulkOperationCreateBulkOperationHandler sets the operation handler for the create bulk operation operation
+	BulkOperationCreateBulkOperationHandler bulk_operation.CreateBulkOperationHandler
+	// DatabaseCreateDatabaseBackupHandler sets the operation handler for the create database backup operation
+	DatabaseCreateDatabaseBackupHandler database.CreateDatabaseBackupHandler
 	// DatabaseCreateDatabaseSnapshotHandler sets the operation handler for the create database snapshot operation
 	DatabaseCreateDatabaseSnapshotHandler database.CreateDatabaseSnapshotHandler
 	// DatabaseCreateDatabaseSnapshotWithPathHandler sets the operation handler for the create database snapshot with path operation
 	DatabaseCreateDatabaseSnapshotWithPathHandler database.CreateDatabaseSnapshotWithPathHandler
+	// QuotaCreateQuotaHandler sets the operation handler for the create quota operation
+	QuotaCreateQuotaHandler quota.CreateQuotaHandler
+	// RoleCreateRoleHandler sets the operation handler for the create role operation
+	RoleCreateRoleHandler role.CreateRoleHandler
 	// RouterCreateRouterHandler sets the operation handler for the create router operation
 	RouterCreateRouterHandler router.CreateRouterHandler
+	// RouterCertRotationCreateRouterCertRotationHandler sets the operation handler for the create router cert rotation operation
+	RouterCertRotationCreateRouterCertRotationHandler router_cert_rotation.CreateRouterCertRotationHandler
 	// ServiceCreateServiceHandler sets the operation handler for the create service operation
 	ServiceCreateServiceHandler service.CreateServiceHandler
 	// TerminatorCreateTerminatorHandler sets the operation handler for the create terminator operation
 	TerminatorCreateTerminatorHandler terminator.CreateTerminatorHandler
 	// DatabaseDataIntegrityResultsHandler sets the operation handler for the data integrity results operation
 	DatabaseDataIntegrityResultsHandler database.DataIntegrityResultsHandler
+	// AlertDeleteAlertSilenceHandler sets the operation handler for the delete alert silence operation
+	AlertDeleteAlertSilenceHandler alert.DeleteAlertSilenceHandler
+	// BandwidthLimitDeleteBandwidthLimitHandler sets the operation handler for the delete bandwidth limit operation
+	BandwidthLimitDeleteBandwidthLimitHandler bandwidth_limit.DeleteBandwidthLimitHandler
 	// CircuitDeleteCircuitHandler sets the operation handler for the delete circuit operation
 	CircuitDeleteCircuitHandler circuit.DeleteCircuitHandler
 	// LinkDeleteLinkHandler sets the operation handler for the delete link operation
 	LinkDeleteLinkHandler link.DeleteLinkHandler
+	// QuotaDeleteQuotaHandler sets the operation handler for the delete quota operation
+	QuotaDeleteQuotaHandler quota.DeleteQuotaHandler
+	// RoleDeleteRoleHandler sets the operation handler for the delete role operation
+	RoleDeleteRoleHandler role.DeleteRoleHandler
 	// RouterDeleteRouterHandler sets the operation handler for the delete router operation
 	RouterDeleteRouterHandler router.DeleteRouterHandler
 	// ServiceDeleteServiceHandler sets the operation handler for the delete service operation
 	ServiceDeleteServiceHandler service.DeleteServiceHandler
 	// TerminatorDeleteTerminatorHandler sets the operation handler for the delete terminator operation
 	TerminatorDeleteTerminatorHandler terminator.DeleteTerminatorHandler
+	// BandwidthLimitDetailBandwidthLimitHandler sets the operation handler for the detail bandwidth limit operation
+	BandwidthLimitDetailBandwidthLimitHandler bandwidth_limit.DetailBandwidthLimitHandler
+	// BulkOperationDetailBulkOperationHandler sets the operation handler for the detail bulk operation operation
+	BulkOperationDetailBulkOperationHandler bulk_operation.DetailBulkOperationHandler
 	// CircuitDetailCircuitHandler sets the operation handler for the detail circuit operation
 	CircuitDetailCircuitHandler circuit.DetailCircuitHandler
+	// CircuitHistoryDetailCircuitHistoryHandler sets the operation handler for the detail circuit history operation
+	CircuitHistoryDetailCircuitHistoryHandler circuit_history.DetailCircuitHistoryHandler
+	// RoleDetailCurrentIdentityPermissionsHandler sets the operation handler for the detail current identity permissions operation
+	RoleDetailCurrentIdentityPermissionsHandler role.DetailCurrentIdentityPermissionsHandler
+	// EnrollmentSignerDetailEnrollmentSignerHandler sets the operation handler for the detail enrollment signer operation
+	EnrollmentSignerDetailEnrollmentSignerHandler enrollment_signer.DetailEnrollmentSignerHandler
 	// LinkDetailLinkHandler sets the operation handler for the detail link operation
 	LinkDetailLinkHandler link.DetailLinkHandler
+	// ProbeDetailProbeHandler sets the operation handler for the detail probe operation
+	ProbeDetailProbeHandler probe.DetailProbeHandler
+	// QuotaDetailQuotaHandler sets the operation handler for the detail quota operation
+	QuotaDetailQuotaHandler quota.DetailQuotaHandler
+	// RoleDetailRoleHandler sets the operation handler for the detail role operation
+	RoleDetailRoleHandler role.DetailRoleHandler
 	// RouterDetailRouterHandler sets the operation handler for the detail router operation
 	RouterDetailRouterHandler router.DetailRouterHandler
+	// RouterCertRotationDetailRouterCertRotationHandler sets the operation handler for the detail router cert rotation operation
+	RouterCertRotationDetailRouterCertRotationHandler router_cert_rotation.DetailRouterCertRotationHandler
+	// RouterDetailRouterDrainHandler sets the operation handler for the detail router drain operation
+	RouterDetailRouterDrainHandler router.DetailRouterDrainHandler
 	// ServiceDetailServiceHandler sets the operation handler for the detail service operation
 	ServiceDetailServiceHandler service.DetailServiceHandler
 	// TerminatorDetailTerminatorHandler sets the operation handler for the detail terminator operation
 	TerminatorDetailTerminatorHandler terminator.DetailTerminatorHandler
+	// TopologyDetailTopologyHandler sets the operation handler for the detail topology operation
+	TopologyDetailTopologyHandler topology.DetailTopologyHandler
+	// RouterDrainRouterHandler sets the operation handler for the drain router operation
+	RouterDrainRouterHandler router.DrainRouterHandler
 	// DatabaseFixDataIntegrityHandler sets the operation handler for the fix data integrity operation
 	DatabaseFixDataIntegrityHandler database.FixDataIntegrityHandler
 	// InspectInspectHandler sets the operation handler for the inspect operation
 	InspectInspectHandler inspect.InspectHandler
+	// AlertListAlertRulesHandler sets the operation handler for the list alert rules operation
+	AlertListAlertRulesHandler alert.ListAlertRulesHandler
+	// AlertListAlertSilencesHandler sets the operation handler for the list alert silences operation
+	AlertListAlertSilencesHandler alert.ListAlertSilencesHandler
+	// AlertListAlertsHandler sets the operation handler for the list alerts operation
+	AlertListAlertsHandler alert.ListAlertsHandler
+	// AuditListAuditEntriesHandler sets the operation handler for the list audit entries operation
+	AuditListAuditEntriesHandler audit.ListAuditEntriesHandler
+	// BandwidthLimitListBandwidthLimitsHandler sets the operation handler for the list bandwidth limits operation
+	BandwidthLimitListBandwidthLimitsHandler bandwidth_limit.ListBandwidthLimitsHandler
+	// BulkOperationListBulkOperationsHandler sets the operation handler for the list bulk operations operation
+	BulkOperationListBulkOperationsHandler bulk_operation.ListBulkOperationsHandler
+	// CircuitHistoryListCircuitHistoryHandler sets the operation handler for the list circuit history operation
+	CircuitHistoryListCircuitHistoryHandler circuit_history.ListCircuitHistoryHandler
 	// CircuitListCircuitsHandler sets the operation handler for the list circuits operation
 	CircuitListCircuitsHandler circuit.ListCircuitsHandler
+	// DatabaseListDatabaseBackupsHandler sets the operation handler for the list database backups operation
+	DatabaseListDatabaseBackupsHandler database.ListDatabaseBackupsHandler
+	// EnrollmentSignerListEnrollmentSignersHandler sets the operation handler for the list enrollment signers operation
+	EnrollmentSignerListEnrollmentSignersHandler enrollment_signer.ListEnrollmentSignersHandler
 	// LinkListLinksHandler sets the operation handler for the list links operation
 	LinkListLinksHandler link.ListLinksHandler
+	// ProbeListProbeResultsHandler sets the operation handler for the list probe results operation
+	ProbeListProbeResultsHandler probe.ListProbeResultsHandler
+	// ProbeListProbesHandler sets the operation handler for the list probes operation
+	ProbeListProbesHandler probe.ListProbesHandler
+	// QuotaListQuotaUsageHandler sets the operation handler for the list quota usage operation
+	QuotaListQuotaUsageHandler quota.ListQuotaUsageHandler
+	// QuotaListQuotasHandler sets the operation handler for the list quotas operation
+	QuotaListQuotasHandler quota.ListQuotasHandler
+	// RoleListRolesHandler sets the operation handler for the list roles operation
+	RoleListRolesHandler role.ListRolesHandler
+	// RouterCertRotationListRouterCertRotationsHandler sets the operation handler for the list router cert rotations operation
+	RouterCertRotationListRouterCertRotationsHandler router_cert_rotation.ListRouterCertRotationsHandler
+	// RouterListRouterDrainsHandler sets the operation handler for the list router drains operation
+	RouterListRouterDrainsHandler router.ListRouterDrainsHandler
 	// RouterListRouterTerminatorsHandler sets the operation handler for the list router terminators operation
 	RouterListRouterTerminatorsHandler router.ListRouterTerminatorsHandler
 	// RouterListRoutersHandler sets the operation handler for the list routers operation
 	RouterListRoutersHandler router.ListRoutersHandler
+	// AuditListServiceHistoryHandler sets the operation handler for the list service history operation
+	AuditListServiceHistoryHandler audit.ListServiceHistoryHandler
 	// ServiceListServiceTerminatorsHandler sets the operation handler for the list service terminators operation
 	ServiceListServiceTerminatorsHandler service.ListServiceTerminatorsHandler
 	// ServiceListServicesHandler sets the operation handler for the list services operation
 	ServiceListServicesHandler service.ListServicesHandler
 	// TerminatorListTerminatorsHandler sets the operation handler for the list terminators operation
 	TerminatorListTerminatorsHandler terminator.ListTerminatorsHandler
+	// BandwidthLimitPatchBandwidthLimitHandler sets the operation handler for the patch bandwidth limit operation
+	BandwidthLimitPatchBandwidthLimitHandler bandwidth_limit.PatchBandwidthLimitHandler
 	// LinkPatchLinkHandler sets the operation handler for the patch link operation
 	LinkPatchLinkHandler link.PatchLinkHandler
+	// QuotaPatchQuotaHandler sets the operation handler for the patch quota operation
+	QuotaPatchQuotaHandler quota.PatchQuotaHandler
+	// RolePatchRoleHandler sets the operation handler for the patch role operation
+	RolePatchRoleHandler role.PatchRoleHandler
 	// RouterPatchRouterHandler sets the operation handler for the patch router operation
 	RouterPatchRouterHandler router.PatchRouterHandler
 	// ServicePatchServiceHandler sets the operation handler for the patch service operation
 	ServicePatchServiceHandler service.PatchServiceHandler
 	// TerminatorPatchTerminatorHandler sets the operation handler for the patch terminator operation
 	TerminatorPatchTerminatorHandler terminator.PatchTerminatorHandler
+	// EnrollmentSignerReissueEnrollmentSignerHandler sets the operation handler for the reissue enrollment signer operation
+	EnrollmentSignerReissueEnrollmentSignerHandler enrollment_signer.ReissueEnrollmentSignerHandler
+	// AuditRevertServiceHandler sets the operation handler for the revert service operation
+	AuditRevertServiceHandler audit.RevertServiceHandler
+	// PolicySimulationSimulatePolicyChangesHandler sets the operation handler for the simulate policy changes operation
+	PolicySimulationSimulatePolicyChangesHandler policy_simulation.SimulatePolicyChangesHandler
+	// BandwidthLimitUpdateBandwidthLimitHandler sets the operation handler for the update bandwidth limit operation
+	BandwidthLimitUpdateBandwidthLimitHandler bandwidth_limit.UpdateBandwidthLimitHandler
+	// QuotaUpdateQuotaHandler sets the operation handler for the update quota operation
+	QuotaUpdateQuotaHandler quota.UpdateQuotaHandler
+	// RoleUpdateRoleHandler sets the operation handler for the update role operation
+	RoleUpdateRoleHandler role.UpdateRoleHandler
 	// RouterUpdateRouterHandler sets the operation handler for the update router operation
 	RouterUpdateRouterHandler router.UpdateRouterHandler
 	// ServiceUpdateServiceHandler sets the operation handler for the update service operation
@@ -373,6 +635,9 @@ func (o *ZitiFabricAPI) Validate() error {
 		unregistered = append(unregistered, "JSONProducer")
 	}
 
+	if o.RouterCancelRouterDrainHandler == nil {
+		unregistered = append(unregistered, "router.CancelRouterDrainHandler")
+	}
 	if o.DatabaseCheckDataIntegrityHandler == nil {
 		unregistered = append(unregistered, "database.CheckDataIntegrityHandler")
 	}
@@ -388,15 +653,36 @@ func (o *ZitiFabricAPI) Validate() error {
 	if o.ClusterClusterTransferLeadershipHandler == nil {
 		unregistered = append(unregistered, "cluster.ClusterTransferLeadershipHandler")
 	}
+	if o.AlertCreateAlertSilenceHandler == nil {
+		unregistered = append(unregistered, "alert.CreateAlertSilenceHandler")
+	}
+	if o.BandwidthLimitCreateBandwidthLimitHandler == nil {
+		unregistered = append(unregistered, "bandwidth_limit.CreateBandwidthLimitHandler")
+	}
+	if o.BulkOperationCreateBulkOperationHandler == nil {
+		unregistered = append(unregistered, "bulk_operation.CreateBulkOperationHandler")
+	}
+	if o.DatabaseCreateDatabaseBackupHandler == nil {
+		unregistered = append(unregistered, "database.CreateDatabaseBackupHandler")
+	}
 	if o.DatabaseCreateDatabaseSnapshotHandler == nil {
 		unregistered = append(unregistered, "database.CreateDatabaseSnapshotHandler")
 	}
 	if o.DatabaseCreateDatabaseSnapshotWithPathHandler == nil {
 		unregistered = append(unregistered, "database.CreateDatabaseSnapshotWithPathHandler")
 	}
+	if o.QuotaCreateQuotaHandler == nil {
+		unregistered = append(unregistered, "quota.CreateQuotaHandler")
+	}
+	if o.RoleCreateRoleHandler == nil {
+		unregistered = append(unregistered, "role.CreateRoleHandler")
+	}
 	if o.RouterCreateRouterHandler == nil {
 		unregistered = append(unregistered, "router.CreateRouterHandler")
 	}
+	if o.RouterCertRotationCreateRouterCertRotationHandler == nil {
+		unregistered = append(unregistered, "router_cert_rotation.CreateRouterCertRotationHandler")
+	}
 	if o.ServiceCreateServiceHandler == nil {
 		unregistered = append(unregistered, "service.CreateServiceHandler")
 	}
@@ -406,12 +692,24 @@ func (o *ZitiFabricAPI) Validate() error {
 	if o.DatabaseDataIntegrityResultsHandler == nil {
 		unregistered = append(unregistered, "database.DataIntegrityResultsHandler")
 	}
+	if o.AlertDeleteAlertSilenceHandler == nil {
+		unregistered = append(unregistered, "alert.DeleteAlertSilenceHandler")
+	}
+	if o.BandwidthLimitDeleteBandwidthLimitHandler == nil {
+		unregistered = append(unregistered, "bandwidth_limit.DeleteBandwidthLimitHandler")
+	}
 	if o.CircuitDeleteCircuitHandler == nil {
 		unregistered = append(unregistered, "circuit.DeleteCircuitHandler")
 	}
 	if o.LinkDeleteLinkHandler == nil {
 		unregistered = append(unregistered, "link.DeleteLinkHandler")
 	}
+	if o.QuotaDeleteQuotaHandler == nil {
+		unregistered = append(unregistered, "quota.DeleteQuotaHandler")
+	}
+	if o.RoleDeleteRoleHandler == nil {
+		unregistered = append(unregistered, "role.DeleteRoleHandler")
+	}
 	if o.RouterDeleteRouterHandler == nil {
 		unregistered = append(unregistered, "router.DeleteRouterHandler")
 	}
@@ -421,39 +719,126 @@ func (o *ZitiFabricAPI) Validate() error {
 	if o.TerminatorDeleteTerminatorHandler == nil {
 		unregistered = append(unregistered, "terminator.DeleteTerminatorHandler")
 	}
+	if o.BandwidthLimitDetailBandwidthLimitHandler == nil {
+		unregistered = append(unregistered, "bandwidth_limit.DetailBandwidthLimitHandler")
+	}
+	if o.BulkOperationDetailBulkOperationHandler == nil {
+		unregistered = append(unregistered, "bulk_operation.DetailBulkOperationHandler")
+	}
 	if o.CircuitDetailCircuitHandler == nil {
 		unregistered = append(unregistered, "circuit.DetailCircuitHandler")
 	}
+	if o.CircuitHistoryDetailCircuitHistoryHandler == nil {
+		unregistered = append(unregistered, "circuit_history.DetailCircuitHistoryHandler")
+	}
+	if o.RoleDetailCurrentIdentityPermissionsHandler == nil {
+		unregistered = append(unregistered, "role.DetailCurrentIdentityPermissionsHandler")
+	}
+	if o.EnrollmentSignerDetailEnrollmentSignerHandler == nil {
+		unregistered = append(unregistered, "enrollment_signer.DetailEnrollmentSignerHandler")
+	}
 	if o.LinkDetailLinkHandler == nil {
 		unregistered = append(unregistered, "link.DetailLinkHandler")
 	}
+	if o.ProbeDetailProbeHandler == nil {
+		unregistered = append(unregistered, "probe.DetailProbeHandler")
+	}
+	if o.QuotaDetailQuotaHandler == nil {
+		unregistered = append(unregistered, "quota.DetailQuotaHandler")
+	}
+	if o.RoleDetailRoleHandler == nil {
+		unregistered = append(unregistered, "role.DetailRoleHandler")
+	}
 	if o.RouterDetailRouterHandler == nil {
 		unregistered = append(unregistered, "router.DetailRouterHandler")
 	}
+	if o.RouterCertRotationDetailRouterCertRotationHandler == nil {
+		unregistered = append(unregistered, "router_cert_rotation.DetailRouterCertRotationHandler")
+	}
+	if o.RouterDetailRouterDrainHandler == nil {
+		unregistered = append(unregistered, "router.DetailRouterDrainHandler")
+	}
 	if o.ServiceDetailServiceHandler == nil {
 		unregistered = append(unregistered, "service.DetailServiceHandler")
 	}
 	if o.TerminatorDetailTerminatorHandler == nil {
 		unregistered = append(unregistered, "terminator.DetailTerminatorHandler")
 	}
+	if o.TopologyDetailTopologyHandler == nil {
+		unregistered = append(unregistered, "topology.DetailTopologyHandler")
+	}
+	if o.RouterDrainRouterHandler == nil {
+		unregistered = append(unregistered, "router.DrainRouterHandler")
+	}
 	if o.DatabaseFixDataIntegrityHandler == nil {
 		unregistered = append(unregistered, "database.FixDataIntegrityHandler")
 	}
 	if o.InspectInspectHandler == nil {
 		unregistered = append(unregistered, "inspect.InspectHandler")
 	}
+	if o.AlertListAlertRulesHandler == nil {
+		unregistered = append(unregistered, "alert.ListAlertRulesHandler")
+	}
+	if o.AlertListAlertSilencesHandler == nil {
+		unregistered = append(unregistered, "alert.ListAlertSilencesHandler")
+	}
+	if o.AlertListAlertsHandler == nil {
+		unregistered = append(unregistered, "alert.ListAlertsHandler")
+	}
+	if o.AuditListAuditEntriesHandler == nil {
+		unregistered = append(unregistered, "audit.ListAuditEntriesHandler")
+	}
+	if o.BandwidthLimitListBandwidthLimitsHandler == nil {
+		unregistered = append(unregistered, "bandwidth_limit.ListBandwidthLimitsHandler")
+	}
+	if o.BulkOperationListBulkOperationsHandler == nil {
+		unregistered = append(unregistered, "bulk_operation.ListBulkOperationsHandler")
+	}
+	if o.CircuitHistoryListCircuitHistoryHandler == nil {
+		unregistered = append(unregistered, "circuit_history.ListCircuitHistoryHandler")
+	}
 	if o.CircuitListCircuitsHandler == nil {
 		unregistered = append(unregistered, "circuit.ListCircuitsHandler")
 	}
+	if o.DatabaseListDatabaseBackupsHandler == nil {
+		unregistered = append(unregistered, "database.ListDatabaseBackupsHandler")
+	}
+	if o.EnrollmentSignerListEnrollmentSignersHandler == nil {
+		unregistered = append(unregistered, "enrollment_signer.ListEnrollmentSignersHandler")
+	}
 	if o.LinkListLinksHandler == nil {
 		unregistered = append(unregistered, "link.ListLinksHandler")
 	}
+	if o.ProbeListProbeResultsHandler == nil {
+		unregistered = append(unregistered, "probe.ListProbeResultsHandler")
+	}
+	if o.ProbeListProbesHandler == nil {
+		unregistered = append(unregistered, "probe.ListProbesHandler")
+	}
+	if o.QuotaListQuotaUsageHandler == nil {
+		unregistered = append(unregistered, "quota.ListQuotaUsageHandler")
+	}
+	if o.QuotaListQuotasHandler == nil {
+		unregistered = append(unregistered, "quota.ListQuotasHandler")
+	}
+	if o.RoleListRolesHandler == nil {
+		unregistered = append(unregistered, "role.ListRolesHandler")
+	}
+	if o.RouterCertRotationListRouterCertRotationsHandler == nil {
+		unregistered = append(unregistered, "router_cert_rotation.ListRouterCertRotationsHandler")
+	}
+	if o.RouterListRouterDrainsHandler == nil {
+		unregistered = append(unregistered, "router.ListRouterDrainsHandler")
+	}
 	if o.RouterListRouterTerminatorsHandler == nil {
 		unregistered = append(unregistered, "router.ListRouterTerminatorsHandler")
 	}
 	if o.RouterListRoutersHandler == nil {
 		unregistered = append(unregistered, "router.ListRoutersHandler")
 	}
+	if o.AuditListServiceHistoryHandler == nil {
+		unregistered = append(unregistered, "audit.ListServiceHistoryHandler")
+	}
 	if o.ServiceListServiceTerminatorsHandler == nil {
 		unregistered = append(unregistered, "service.ListServiceTerminatorsHandler")
 	}
@@ -463,9 +848,18 @@ func (o *ZitiFabricAPI) Validate() error {
 	if o.TerminatorListTerminatorsHandler == nil {
 		unregistered = append(unregistered, "terminator.ListTerminatorsHandler")
 	}
+	if o.BandwidthLimitPatchBandwidthLimitHandler == nil {
+		unregistered = append(unregistered, "bandwidth_limit.PatchBandwidthLimitHandler")
+	}
 	if o.LinkPatchLinkHandler == nil {
 		unregistered = append(unregistered, "link.PatchLinkHandler")
 	}
+	if o.QuotaPatchQuotaHandler == nil {
+		unregistered = append(unregistered, "quota.PatchQuotaHandler")
+	}
+	if o.RolePatchRoleHandler == nil {
+		unregistered = append(unregistered, "role.PatchRoleHandler")
+	}
 	if o.RouterPatchRouterHandler == nil {
 		unregistered = append(unregistered, "router.PatchRouterHandler")
 	}
@@ -475,6 +869,24 @@ func (o *ZitiFabricAPI) Validate() error {
 	if o.TerminatorPatchTerminatorHandler == nil {
 		unregistered = append(unregistered, "terminator.PatchTerminatorHandler")
 	}
+	if o.EnrollmentSignerReissueEnrollmentSignerHandler == nil {
+		unregistered = append(unregistered, "enrollment_signer.ReissueEnrollmentSignerHandler")
+	}
+	if o.AuditRevertServiceHandler == nil {
+		unregistered = append(unregistered, "audit.RevertServiceHandler")
+	}
+	if o.PolicySimulationSimulatePolicyChangesHandler == nil {
+		unregistered = append(unregistered, "policy_simulation.SimulatePolicyChangesHandler")
+	}
+	if o.BandwidthLimitUpdateBandwidthLimitHandler == nil {
+		unregistered = append(unregistered, "bandwidth_limit.UpdateBandwidthLimitHandler")
+	}
+	if o.QuotaUpdateQuotaHandler == nil {
+		unregistered = append(unregistered, "quota.UpdateQuotaHandler")
+	}
+	if o.RoleUpdateRoleHandler == nil {
+		unregistered = append(unregistered, "role.UpdateRoleHandler")
+	}
 	if o.RouterUpdateRouterHandler == nil {
 		unregistered = append(unregistered, "router.UpdateRouterHandler")
 	}
@@ -572,6 +984,10 @@ func (o *ZitiFabricAPI) initHandlerCache() {
 		o.handlers = make(map[string]map[string]http.Handler)
 	}
 
+	if o.handlers["DELETE"] == nil {
+		o.handlers["DELETE"] = make(map[string]http.Handler)
+	}
+	o.handlers["DELETE"]["/routers/{id}/drain"] = router.NewCancelRouterDrain(o.context, o.RouterCancelRouterDrainHandler)
 	if o.handlers["POST"] == nil {
 		o.handlers["POST"] = make(map[string]http.Handler)
 	}
@@ -595,6 +1011,22 @@ func (o *ZitiFabricAPI) initHandlerCache() {
 	if o.handlers["POST"] == nil {
 		o.handlers["POST"] = make(map[string]http.Handler)
 	}
+	o.handlers["POST"]["/alert-silences"] = alert.NewCreateAlertSilence(o.context, o.AlertCreateAlertSilenceHandler)
+	if o.handlers["POST"] == nil {
+		o.handlers["POST"] = make(map[string]http.Handler)
+	}
+	o.handlers["POST"]["/bandwidth-limits"] = bandwidth_limit.NewCreateBandwidthLimit(o.context, o.BandwidthLimitCreateBandwidthLimitHandler)
+	if o.handlers["POST"] == nil {
+		o.handlers["POST"] = make(map[string]http.Handler)
+	}
+	o.handlers["POST"]["/bulk-operations"] = bulk_operation.NewCreateBulkOperation(o.context, o.BulkOperationCreateBulkOperationHandler)
+	if o.handlers["POST"] == nil {
+		o.handlers["POST"] = make(map[string]http.Handler)
+	}
+	o.handlers["POST"]["/database/backups"] = database.NewCreateDatabaseBackup(o.context, o.DatabaseCreateDatabaseBackupHandler)
+	if o.handlers["POST"] == nil {
+		o.handlers["POST"] = make(map[string]http.Handler)
+	}
 	o.handlers["POST"]["/database"] = database.NewCreateDatabaseSnapshot(o.context, o.DatabaseCreateDatabaseSnapshotHandler)
 	if o.handlers["POST"] == nil {
 		o.handlers["POST"] = make(map[string]http.Handler)
@@ -603,10 +1035,22 @@ func (o *ZitiFabricAPI) initHandlerCache() {
 	if o.handlers["POST"] == nil {
 		o.handlers["POST"] = make(map[string]http.Handler)
 	}
+	o.handlers["POST"]["/quotas"] = quota.NewCreateQuota(o.context, o.QuotaCreateQuotaHandler)
+	if o.handlers["POST"] == nil {
+		o.handlers["POST"] = make(map[string]http.Handler)
+	}
+	o.handlers["POST"]["/roles"] = role.NewCreateRole(o.context, o.RoleCreateRoleHandler)
+	if o.handlers["POST"] == nil {
+		o.handlers["POST"] = make(map[string]http.Handler)
+	}
 	o.handlers["POST"]["/routers"] = router.NewCreateRouter(o.context, o.RouterCreateRouterHandler)
 	if o.handlers["POST"] == nil {
 		o.handlers["POST"] = make(map[string]http.Handler)
 	}
+	o.handlers["POST"]["/router-cert-rotations"] = router_cert_rotation.NewCreateRouterCertRotation(o.context, o.RouterCertRotationCreateRouterCertRotationHandler)
+	if o.handlers["POST"] == nil {
+		o.handlers["POST"] = make(map[string]http.Handler)
+	}
 	o.handlers["POST"]["/services"] = service.NewCreateService(o.context, o.ServiceCreateServiceHandler)
 	if o.handlers["POST"] == nil {
 		o.handlers["POST"] = make(map[string]http.Handler)
@@ -619,6 +1063,14 @@ func (o *ZitiFabricAPI) initHandlerCache() {
 	if o.handlers["DELETE"] == nil {
 		o.handlers["DELETE"] = make(map[string]http.Handler)
 	}
+	o.handlers["DELETE"]["/alert-silences/{id}"] = alert.NewDeleteAlertSilence(o.context, o.AlertDeleteAlertSilenceHandler)
+	if o.handlers["DELETE"] == nil {
+		o.handlers["DELETE"] = make(map[string]http.Handler)
+	}
+	o.handlers["DELETE"]["/bandwidth-limits/{id}"] = bandwidth_limit.NewDeleteBandwidthLimit(o.context, o.BandwidthLimitDeleteBandwidthLimitHandler)
+	if o.handlers["DELETE"] == nil {
+		o.handlers["DELETE"] = make(map[string]http.Handler)
+	}
 	o.handlers["DELETE"]["/circuits/{id}"] = circuit.NewDeleteCircuit(o.context, o.CircuitDeleteCircuitHandler)
 	if o.handlers["DELETE"] == nil {
 		o.handlers["DELETE"] = make(map[string]http.Handler)
@@ -627,6 +1079,14 @@ func (o *ZitiFabricAPI) initHandlerCache() {
 	if o.handlers["DELETE"] == nil {
 		o.handlers["DELETE"] = make(map[string]http.Handler)
 	}
+	o.handlers["DELETE"]["/quotas/{id}"] = quota.NewDeleteQuota(o.context, o.QuotaDeleteQuotaHandler)
+	if o.handlers["DELETE"] == nil {
+		o.handlers["DELETE"] = make(map[string]http.Handler)
+	}
+	o.handlers["DELETE"]["/roles/{id}"] = role.NewDeleteRole(o.context, o.RoleDeleteRoleHandler)
+	if o.handlers["DELETE"] == nil {
+		o.handlers["DELETE"] = make(map[string]http.Handler)
+	}
 	o.handlers["DELETE"]["/routers/{id}"] = router.NewDeleteRouter(o.context, o.RouterDeleteRouterHandler)
 	if o.handlers["DELETE"] == nil {
 		o.handlers["DELETE"] = make(map[string]http.Handler)
@@ -639,23 +1099,71 @@ func (o *ZitiFabricAPI) initHandlerCache() {
 	if o.handlers["GET"] == nil {
 		o.handlers["GET"] = make(map[string]http.Handler)
 	}
+	o.handlers["GET"]["/bandwidth-limits/{id}"] = bandwidth_limit.NewDetailBandwidthLimit(o.context, o.BandwidthLimitDetailBandwidthLimitHandler)
+	if o.handlers["GET"] == nil {
+		o.handlers["GET"] = make(map[string]http.Handler)
+	}
+	o.handlers["GET"]["/bulk-operations/{id}"] = bulk_operation.NewDetailBulkOperation(o.context, o.BulkOperationDetailBulkOperationHandler)
+	if o.handlers["GET"] == nil {
+		o.handlers["GET"] = make(map[string]http.Handler)
+	}
 	o.handlers["GET"]["/circuits/{id}"] = circuit.NewDetailCircuit(o.context, o.CircuitDetailCircuitHandler)
 	if o.handlers["GET"] == nil {
 		o.handlers["GET"] = make(map[string]http.Handler)
 	}
+	o.handlers["GET"]["/circuit-history/{id}"] = circuit_history.NewDetailCircuitHistory(o.context, o.CircuitHistoryDetailCircuitHistoryHandler)
+	if o.handlers["GET"] == nil {
+		o.handlers["GET"] = make(map[string]http.Handler)
+	}
+	o.handlers["GET"]["/current-identity/permissions"] = role.NewDetailCurrentIdentityPermissions(o.context, o.RoleDetailCurrentIdentityPermissionsHandler)
+	if o.handlers["GET"] == nil {
+		o.handlers["GET"] = make(map[string]http.Handler)
+	}
+	o.handlers["GET"]["/enrollment-signers/{id}"] = enrollment_signer.NewDetailEnrollmentSigner(o.context, o.EnrollmentSignerDetailEnrollmentSignerHandler)
+	if o.handlers["GET"] == nil {
+		o.handlers["GET"] = make(map[string]http.Handler)
+	}
 	o.handlers["GET"]["/links/{id}"] = link.NewDetailLink(o.context, o.LinkDetailLinkHandler)
 	if o.handlers["GET"] == nil {
 		o.handlers["GET"] = make(map[string]http.Handler)
 	}
+	o.handlers["GET"]["/probes/{name}"] = probe.NewDetailProbe(o.context, o.ProbeDetailProbeHandler)
+	if o.handlers["GET"] == nil {
+		o.handlers["GET"] = make(map[string]http.Handler)
+	}
+	o.handlers["GET"]["/quotas/{id}"] = quota.NewDetailQuota(o.context, o.QuotaDetailQuotaHandler)
+	if o.handlers["GET"] == nil {
+		o.handlers["GET"] = make(map[string]http.Handler)
+	}
+	o.handlers["GET"]["/roles/{id}"] = role.NewDetailRole(o.context, o.RoleDetailRoleHandler)
+	if o.handlers["GET"] == nil {
+		o.handlers["GET"] = make(map[string]http.Handler)
+	}
 	o.handlers["GET"]["/routers/{id}"] = router.NewDetailRouter(o.context, o.RouterDetailRouterHandler)
 	if o.handlers["GET"] == nil {
 		o.handlers["GET"] = make(map[string]http.Handler)
 	}
+	o.handlers["GET"]["/router-cert-rotations/{id}"] = router_cert_rotation.NewDetailRouterCertRotation(o.context, o.RouterCertRotationDetailRouterCertRotationHandler)
+	if o.handlers["GET"] == nil {
+		o.handlers["GET"] = make(map[string]http.Handler)
+	}
+	o.handlers["GET"]["/routers/{id}/drain"] = router.NewDetailRouterDrain(o.context, o.RouterDetailRouterDrainHandler)
+	if o.handlers["GET"] == nil {
+		o.handlers["GET"] = make(map[string]http.Handler)
+	}
 	o.handlers["GET"]["/services/{id}"] = service.NewDetailService(o.context, o.ServiceDetailServiceHandler)
 	if o.handlers["GET"] == nil {
 		o.handlers["GET"] = make(map[string]http.Handler)
 	}
 	o.handlers["GET"]["/terminators/{id}"] = terminator.NewDetailTerminator(o.context, o.TerminatorDetailTerminatorHandler)
+	if o.handlers["GET"] == nil {
+		o.handlers["GET"] = make(map[string]http.Handler)
+	}
+	o.handlers["GET"]["/topology"] = topology.NewDetailTopology(o.context, o.TopologyDetailTopologyHandler)
+	if o.handlers["POST"] == nil {
+		o.handlers["POST"] = make(map[string]http.Handler)
+	}
+	o.handlers["POST"]["/routers/{id}/drain"] = router.NewDrainRouter(o.context, o.RouterDrainRouterHandler)
 	if o.handlers["POST"] == nil {
 		o.handlers["POST"] = make(map[string]http.Handler)
 	}
@@ -667,14 +1175,78 @@ func (o *ZitiFabricAPI) initHandlerCache() {
 	if o.handlers["GET"] == nil {
 		o.handlers["GET"] = make(map[string]http.Handler)
 	}
+	o.handlers["GET"]["/alert-rules"] = alert.NewListAlertRules(o.context, o.AlertListAlertRulesHandler)
+	if o.handlers["GET"] == nil {
+		o.handlers["GET"] = make(map[string]http.Handler)
+	}
+	o.handlers["GET"]["/alert-silences"] = alert.NewListAlertSilences(o.context, o.AlertListAlertSilencesHandler)
+	if o.handlers["GET"] == nil {
+		o.handlers["GET"] = make(map[string]http.Handler)
+	}
+	o.handlers["GET"]["/alerts"] = alert.NewListAlerts(o.context, o.AlertListAlertsHandler)
+	if o.handlers["GET"] == nil {
+		o.handlers["GET"] = make(map[string]http.Handler)
+	}
+	o.handlers["GET"]["/audit"] = audit.NewListAuditEntries(o.context, o.AuditListAuditEntriesHandler)
+	if o.handlers["GET"] == nil {
+		o.handlers["GET"] = make(map[string]http.Handler)
+	}
+	o.handlers["GET"]["/bandwidth-limits"] = bandwidth_limit.NewListBandwidthLimits(o.context, o.BandwidthLimitListBandwidthLimitsHandler)
+	if o.handlers["GET"] == nil {
+		o.handlers["GET"] = make(map[string]http.Handler)
+	}
+	o.handlers["GET"]["/bulk-operations"] = bulk_operation.NewListBulkOperations(o.context, o.BulkOperationListBulkOperationsHandler)
+	if o.handlers["GET"] == nil {
+		o.handlers["GET"] = make(map[string]http.Handler)
+	}
+	o.handlers["GET"]["/circuit-history"] = circuit_history.NewListCircuitHistory(o.context, o.CircuitHistoryListCircuitHistoryHandler)
+	if o.handlers["GET"] == nil {
+		o.handlers["GET"] = make(map[string]http.Handler)
+	}
 	o.handlers["GET"]["/circuits"] = circuit.NewListCircuits(o.context, o.CircuitListCircuitsHandler)
 	if o.handlers["GET"] == nil {
 		o.handlers["GET"] = make(map[string]http.Handler)
 	}
+	o.handlers["GET"]["/database/backups"] = database.NewListDatabaseBackups(o.context, o.DatabaseListDatabaseBackupsHandler)
+	if o.handlers["GET"] == nil {
+		o.handlers["GET"] = make(map[string]http.Handler)
+	}
+	o.handlers["GET"]["/enrollment-signers"] = enrollment_signer.NewListEnrollmentSigners(o.context, o.EnrollmentSignerListEnrollmentSignersHandler)
+	if o.handlers["GET"] == nil {
+		o.handlers["GET"] = make(map[string]http.Handler)
+	}
 	o.handlers["GET"]["/links"] = link.NewListLinks(o.context, o.LinkListLinksHandler)
 	if o.handlers["GET"] == nil {
 		o.handlers["GET"] = make(map[string]http.Handler)
 	}
+	o.handlers["GET"]["/probes/{name}/results"] = probe.NewListProbeResults(o.context, o.ProbeListProbeResultsHandler)
+	if o.handlers["GET"] == nil {
+		o.handlers["GET"] = make(map[string]http.Handler)
+	}
+	o.handlers["GET"]["/probes"] = probe.NewListProbes(o.context, o.ProbeListProbesHandler)
+	if o.handlers["GET"] == nil {
+		o.handlers["GET"] = make(map[string]http.Handler)
+	}
+	o.handlers["GET"]["/quotas/{id}/usage"] = quota.NewListQuotaUsage(o.context, o.QuotaListQuotaUsageHandler)
+	if o.handlers["GET"] == nil {
+		o.handlers["GET"] = make(map[string]http.Handler)
+	}
+	o.handlers["GET"]["/quotas"] = quota.NewListQuotas(o.context, o.QuotaListQuotasHandler)
+	if o.handlers["GET"] == nil {
+		o.handlers["GET"] = make(map[string]http.Handler)
+	}
+	o.handlers["GET"]["/roles"] = role.NewListRoles(o.context, o.RoleListRolesHandler)
+	if o.handlers["GET"] == nil {
+		o.handlers["GET"] = make(map[string]http.Handler)
+	}
+	o.handlers["GET"]["/router-cert-rotations"] = router_cert_rotation.NewListRouterCertRotations(o.context, o.RouterCertRotationListRouterCertRotationsHandler)
+	if o.handlers["GET"] == nil {
+		o.handlers["GET"] = make(map[string]http.Handler)
+	}
+	o.handlers["GET"]["/router-drains"] = router.NewListRouterDrains(o.context, o.RouterListRouterDrainsHandler)
+	if o.handlers["GET"] == nil {
+		o.handlers["GET"] = make(map[string]http.Handler)
+	}
 	o.handlers["GET"]["/routers/{id}/terminators"] = router.NewListRouterTerminators(o.context, o.RouterListRouterTerminatorsHandler)
 	if o.handlers["GET"] == nil {
 		o.handlers["GET"] = make(map[string]http.Handler)
@@ -683,6 +1255,10 @@ func (o *ZitiFabricAPI) initHandlerCache() {
 	if o.handlers["GET"] == nil {
 		o.handlers["GET"] = make(map[string]http.Handler)
 	}
+	o.handlers["GET"]["/services/{id}/history"] = audit.NewListServiceHistory(o.context, o.AuditListServiceHistoryHandler)
+	if o.handlers["GET"] == nil {
+		o.handlers["GET"] = make(map[string]http.Handler)
+	}
 	o.handlers["GET"]["/services/{id}/terminators"] = service.NewListServiceTerminators(o.context, o.ServiceListServiceTerminatorsHandler)
 	if o.handlers["GET"] == nil {
 		o.handlers["GET"] = make(map[string]http.Handler)
@@ -695,10 +1271,22 @@ func (o *ZitiFabricAPI) initHandlerCache() {
 	if o.handlers["PATCH"] == nil {
 		o.handlers["PATCH"] = make(map[string]http.Handler)
 	}
+	o.handlers["PATCH"]["/bandwidth-limits/{id}"] = bandwidth_limit.NewPatchBandwidthLimit(o.context, o.BandwidthLimitPatchBandwidthLimitHandler)
+	if o.handlers["PATCH"] == nil {
+		o.handlers["PATCH"] = make(map[string]http.Handler)
+	}
 	o.handlers["PATCH"]["/links/{id}"] = link.NewPatchLink(o.context, o.LinkPatchLinkHandler)
 	if o.handlers["PATCH"] == nil {
 		o.handlers["PATCH"] = make(map[string]http.Handler)
 	}
+	o.handlers["PATCH"]["/quotas/{id}"] = quota.NewPatchQuota(o.context, o.QuotaPatchQuotaHandler)
+	if o.handlers["PATCH"] == nil {
+		o.handlers["PATCH"] = make(map[string]http.Handler)
+	}
+	o.handlers["PATCH"]["/roles/{id}"] = role.NewPatchRole(o.context, o.RolePatchRoleHandler)
+	if o.handlers["PATCH"] == nil {
+		o.handlers["PATCH"] = make(map[string]http.Handler)
+	}
 	o.handlers["PATCH"]["/routers/{id}"] = router.NewPatchRouter(o.context, o.RouterPatchRouterHandler)
 	if o.handlers["PATCH"] == nil {
 		o.handlers["PATCH"] = make(map[string]http.Handler)
@@ -708,6 +1296,30 @@ func (o *ZitiFabricAPI) initHandlerCache() {
 		o.handlers["PATCH"] = make(map[string]http.Handler)
 	}
 	o.handlers["PATCH"]["/terminators/{id}"] = terminator.NewPatchTerminator(o.context, o.TerminatorPatchTerminatorHandler)
+	if o.handlers["POST"] == nil {
+		o.handlers["POST"] = make(map[string]http.Handler)
+	}
+	o.handlers["POST"]["/enrollment-signers/{id}/reissue"] = enrollment_signer.NewReissueEnrollmentSigner(o.context, o.EnrollmentSignerReissueEnrollmentSignerHandler)
+	if o.handlers["POST"] == nil {
+		o.handlers["POST"] = make(map[string]http.Handler)
+	}
+	o.handlers["POST"]["/services/{id}/history/{version}/revert"] = audit.NewRevertService(o.context, o.AuditRevertServiceHandler)
+	if o.handlers["POST"] == nil {
+		o.handlers["POST"] = make(map[string]http.Handler)
+	}
+	o.handlers["POST"]["/policy-simulations"] = policy_simulation.NewSimulatePolicyChanges(o.context, o.PolicySimulationSimulatePolicyChangesHandler)
+	if o.handlers["PUT"] == nil {
+		o.handlers["PUT"] = make(map[string]http.Handler)
+	}
+	o.handlers["PUT"]["/bandwidth-limits/{id}"] = bandwidth_limit.NewUpdateBandwidthLimit(o.context, o.BandwidthLimitUpdateBandwidthLimitHandler)
+	if o.handlers["PUT"] == nil {
+		o.handlers["PUT"] = make(map[string]http.Handler)
+	}
+	o.handlers["PUT"]["/quotas/{id}"] = quota.NewUpdateQuota(o.context, o.QuotaUpdateQuotaHandler)
+	if o.handlers["PUT"] == nil {
+		o.handlers["PUT"] = make(map[string]http.Handler)
+	}
+	o.handlers["PUT"]["/roles/{id}"] = role.NewUpdateRole(o.context, o.RoleUpdateRoleHandler)
 	if o.handlers["PUT"] == nil {
 		o.handlers["PUT"] = make(map[string]http.Handler)
 	}
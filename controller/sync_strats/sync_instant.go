@@ -110,8 +110,9 @@ type InstantStrategy struct {
 	stopped    atomic.Bool
 	*common.RouterDataModel
 
-	changeSetLock sync.Mutex
-	changeSets    map[uint64]*edge_ctrl_pb.DataState_ChangeSet
+	changeSetLock        sync.Mutex
+	changeSets           map[uint64]*edge_ctrl_pb.DataState_ChangeSet
+	bandwidthLimitsDirty map[uint64]struct{}
 }
 
 func (strategy *InstantStrategy) AddPublicKey(cert *tls.Certificate) {
@@ -228,6 +229,7 @@ func (strategy *InstantStrategy) Initialize(logSize uint64, bufferSize uint) err
 	}
 
 	strategy.ae.GetStores().Controller.AddEntityConstraint(controllerHandler)
+	strategy.initializeBandwidthLimitHandlers()
 	strategy.ae.GetDb().AddTxCompleteListener(strategy.completeChangeSet)
 
 	return nil
@@ -268,6 +270,7 @@ func NewInstantStrategy(ae *env.AppEnv, options InstantStrategyOptions) *Instant
 		receivedClientHelloQueue: make(chan *RouterSender, options.MaxQueuedClientHellos),
 		stopNotify:               make(chan struct{}),
 		changeSets:               map[uint64]*edge_ctrl_pb.DataState_ChangeSet{},
+		bandwidthLimitsDirty:     map[uint64]struct{}{},
 	}
 
 	err := strategy.Initialize(ae.GetConfig().RouterDataModel.LogSize, ae.GetConfig().RouterDataModel.ListenerBufferSize)
@@ -991,6 +994,10 @@ func (strategy *InstantStrategy) BuildAll(rdm *common.RouterDataModel) error {
 			return err
 		}
 
+		if err := strategy.BuildBandwidthLimits(index, tx, rdm); err != nil {
+			return err
+		}
+
 		rdm.SetCurrentIndex(index)
 		pfxlog.Logger().WithField("index", index).Info("initialized router data model from db")
 		return nil
@@ -1896,6 +1903,15 @@ func (strategy *InstantStrategy) completeChangeSet(ctx boltz.MutateContext) {
 		}
 	}
 
+	if bandwidthLimitEvents := strategy.getBandwidthLimitChangesAlreadyLocked(index); len(bandwidthLimitEvents) > 0 {
+		if changeSet == nil {
+			changeSet = &edge_ctrl_pb.DataState_ChangeSet{
+				Index: index,
+			}
+		}
+		changeSet.Changes = append(changeSet.Changes, bandwidthLimitEvents...)
+	}
+
 	if changeSet != nil {
 		strategy.ApplyChangeSet(changeSet)
 	}
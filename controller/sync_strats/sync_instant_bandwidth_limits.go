@@ -0,0 +1,217 @@
+/*
+	Copyright NetFoundry Inc.
+
+	Licensed under the Apache License, Version 2.0 (the "License");
+	you may not use this file except in compliance with the License.
+	You may obtain a copy of the License at
+
+	https://www.apache.org/licenses/LICENSE-2.0
+
+	Unless required by applicable law or agreed to in writing, software
+	distributed under the License is distributed on an "AS IS" BASIS,
+	WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+	See the License for the specific language governing permissions and
+	limitations under the License.
+*/
+
+package sync_strats
+
+import (
+	"sort"
+
+	"github.com/michaelquigley/pfxlog"
+	"github.com/openziti/foundation/v2/stringz"
+	"github.com/openziti/storage/ast"
+	"github.com/openziti/storage/boltz"
+	"github.com/openziti/ziti/common"
+	"github.com/openziti/ziti/common/pb/edge_ctrl_pb"
+	"github.com/openziti/ziti/controller/db"
+	"go.etcd.io/bbolt"
+	"google.golang.org/protobuf/proto"
+)
+
+// Bandwidth limits are distributed to routers with their identity, service and router sets already resolved, so
+// routers don't need policy data to enforce them. Because the resolved sets depend on role attributes and edge router
+// policies, any transaction which touches those marks the limits dirty, and they are re-resolved once, when the
+// transaction's change set is completed. Only limits whose resolution actually changed are sent.
+
+func (strategy *InstantStrategy) initializeBandwidthLimitHandlers() {
+	stores := strategy.ae.GetStores()
+
+	stores.BandwidthLimit.AddEntityConstraint(&constraintToIndexedEvents[*db.BandwidthLimit]{
+		indexProvider: strategy.indexProvider,
+		createHandler: strategy.bandwidthLimitChanged,
+		updateHandler: strategy.bandwidthLimitChanged,
+		deleteHandler: strategy.bandwidthLimitChanged,
+	})
+
+	stores.Identity.AddEntityConstraint(&bandwidthLimitDependencyConstraint[*db.Identity]{
+		strategy: strategy,
+		rolesF: func(entity *db.Identity) []string {
+			return entity.RoleAttributes
+		},
+	})
+
+	stores.EdgeService.AddEntityConstraint(&bandwidthLimitDependencyConstraint[*db.EdgeService]{
+		strategy: strategy,
+		rolesF: func(entity *db.EdgeService) []string {
+			return entity.RoleAttributes
+		},
+	})
+
+	stores.EdgeRouter.AddEntityConstraint(&bandwidthLimitDependencyConstraint[*db.EdgeRouter]{
+		strategy: strategy,
+		rolesF: func(entity *db.EdgeRouter) []string {
+			return entity.RoleAttributes
+		},
+	})
+
+	stores.EdgeRouterPolicy.AddEntityConstraint(&bandwidthLimitDependencyConstraint[*db.EdgeRouterPolicy]{
+		strategy: strategy,
+	})
+}
+
+func (strategy *InstantStrategy) bandwidthLimitChanged(index uint64, _ *db.BandwidthLimit) {
+	strategy.markBandwidthLimitsDirty(index)
+}
+
+func (strategy *InstantStrategy) markBandwidthLimitsDirty(index uint64) {
+	strategy.changeSetLock.Lock()
+	defer strategy.changeSetLock.Unlock()
+	strategy.bandwidthLimitsDirty[index] = struct{}{}
+}
+
+// getBandwidthLimitChangesAlreadyLocked re-resolves all bandwidth limits if the given index marked them dirty,
+// returning events for the limits which changed. Must be called with the changeSetLock held.
+func (strategy *InstantStrategy) getBandwidthLimitChangesAlreadyLocked(index uint64) []*edge_ctrl_pb.DataState_Event {
+	_, dirty := strategy.bandwidthLimitsDirty[index]
+	for k := range strategy.bandwidthLimitsDirty {
+		if k <= index {
+			delete(strategy.bandwidthLimitsDirty, k)
+		}
+	}
+
+	if !dirty {
+		return nil
+	}
+
+	var events []*edge_ctrl_pb.DataState_Event
+	current := map[string]struct{}{}
+
+	err := strategy.ae.GetDb().View(func(tx *bbolt.Tx) error {
+		return strategy.iterateBandwidthLimits(tx, func(limit *edge_ctrl_pb.DataState_BandwidthLimit) {
+			current[limit.Id] = struct{}{}
+			existing, found := strategy.RouterDataModel.BandwidthLimits.Get(limit.Id)
+			if !found {
+				events = append(events, newBandwidthLimitEvent(edge_ctrl_pb.DataState_Create, limit))
+			} else if !proto.Equal(existing.DataStateBandwidthLimit, limit) {
+				events = append(events, newBandwidthLimitEvent(edge_ctrl_pb.DataState_Update, limit))
+			}
+		})
+	})
+
+	if err != nil {
+		pfxlog.Logger().WithError(err).WithField("index", index).Error("unable to resolve bandwidth limits")
+		return nil
+	}
+
+	strategy.RouterDataModel.BandwidthLimits.IterCb(func(id string, v *common.BandwidthLimit) {
+		if _, ok := current[id]; !ok {
+			events = append(events, newBandwidthLimitEvent(edge_ctrl_pb.DataState_Delete, v.DataStateBandwidthLimit))
+		}
+	})
+
+	return events
+}
+
+func (strategy *InstantStrategy) BuildBandwidthLimits(index uint64, tx *bbolt.Tx, rdm *common.RouterDataModel) error {
+	return strategy.iterateBandwidthLimits(tx, func(limit *edge_ctrl_pb.DataState_BandwidthLimit) {
+		event := newBandwidthLimitEvent(edge_ctrl_pb.DataState_Create, limit)
+		rdm.HandleBandwidthLimitEvent(index, event, event.Model.(*edge_ctrl_pb.DataState_Event_BandwidthLimit))
+	})
+}
+
+func (strategy *InstantStrategy) iterateBandwidthLimits(tx *bbolt.Tx, f func(limit *edge_ctrl_pb.DataState_BandwidthLimit)) error {
+	store := strategy.ae.GetStores().BandwidthLimit
+	for cursor := store.IterateIds(tx, ast.BoolNodeTrue); cursor.IsValid(); cursor.Next() {
+		entity, err := store.LoadById(tx, string(cursor.Current()))
+		if err != nil {
+			return err
+		}
+
+		limit, err := newBandwidthLimit(tx, store, entity)
+		if err != nil {
+			return err
+		}
+		f(limit)
+	}
+	return nil
+}
+
+func newBandwidthLimit(tx *bbolt.Tx, store db.BandwidthLimitStore, entity *db.BandwidthLimit) (*edge_ctrl_pb.DataState_BandwidthLimit, error) {
+	targets, err := store.ResolveTargets(tx, entity)
+	if err != nil {
+		return nil, err
+	}
+
+	return &edge_ctrl_pb.DataState_BandwidthLimit{
+		Id:            entity.Id,
+		Name:          entity.Name,
+		Rate:          entity.Rate,
+		Burst:         entity.Burst,
+		Shared:        entity.Shared,
+		IdentityIds:   targets.IdentityIds,
+		AllIdentities: targets.AllIdentities,
+		ServiceIds:    targets.ServiceIds,
+		AllServices:   targets.AllServices,
+		RouterIds:     targets.RouterIds,
+		AllRouters:    targets.AllRouters,
+	}, nil
+}
+
+func newBandwidthLimitEvent(action edge_ctrl_pb.DataState_Action, limit *edge_ctrl_pb.DataState_BandwidthLimit) *edge_ctrl_pb.DataState_Event {
+	return &edge_ctrl_pb.DataState_Event{
+		Action: action,
+		Model: &edge_ctrl_pb.DataState_Event_BandwidthLimit{
+			BandwidthLimit: limit,
+		},
+	}
+}
+
+// bandwidthLimitDependencyConstraint marks bandwidth limits dirty when an entity which may affect their resolution
+// changes. If rolesF is set, updates only count if the entity's role attributes changed.
+type bandwidthLimitDependencyConstraint[E boltz.Entity] struct {
+	strategy *InstantStrategy
+	rolesF   func(E) []string
+}
+
+func (h *bandwidthLimitDependencyConstraint[E]) ProcessPreCommit(*boltz.EntityChangeState[E]) error {
+	return nil
+}
+
+func (h *bandwidthLimitDependencyConstraint[E]) ProcessPostCommit(state *boltz.EntityChangeState[E]) {
+	if h.strategy.RouterDataModel.BandwidthLimits.IsEmpty() {
+		return
+	}
+
+	if state.ChangeType == boltz.EntityUpdated && h.rolesF != nil &&
+		equalRoleAttributes(h.rolesF(state.InitialState), h.rolesF(state.FinalState)) {
+		return
+	}
+
+	index, err := h.strategy.indexProvider.NextIndex(state.Ctx)
+	if err != nil {
+		pfxlog.Logger().WithError(err).Errorf("could not process post commit for %T, could not acquire index", state.FinalState)
+		return
+	}
+
+	h.strategy.markBandwidthLimitsDirty(index)
+}
+
+func equalRoleAttributes(a, b []string) bool {
+	a = append([]string(nil), a...)
+	b = append([]string(nil), b...)
+	sort.Strings(a)
+	sort.Strings(b)
+	return stringz.EqualSlices(a, b)
+}
@@ -24,7 +24,11 @@ type fabricWrapper struct {
 	ae *env.AppEnv
 }
 
-func (self *fabricWrapper) WrapRequest(handler api_impl.RequestHandler, request *http.Request, entityId, entitySubId string) middleware.Responder {
+func (self *fabricWrapper) WrapRequest(handler api_impl.RequestHandler, request *http.Request, entityId, entitySubId string, resolvers ...permissions.Resolver) middleware.Responder {
+	if len(resolvers) == 0 {
+		resolvers = []permissions.Resolver{permissions.IsAdmin()}
+	}
+
 	return middleware.ResponderFunc(func(writer http.ResponseWriter, producer runtime.Producer) {
 		rc, err := env.GetRequestContextFromHttpContext(request)
 
@@ -42,9 +46,12 @@ func (self *fabricWrapper) WrapRequest(handler api_impl.RequestHandler, request
 			return
 		}
 
-		if !permissions.IsAdmin().IsAllowed(rc.ActivePermissions...) {
-			rc.RespondWithApiError(errorz.NewUnauthorized())
-			return
+		// scoped grants aren't honored here, as fabric entities don't carry role attributes
+		for _, resolver := range resolvers {
+			if !resolver.IsAllowed(rc.ActivePermissions...) {
+				rc.RespondWithApiError(errorz.NewUnauthorized())
+				return
+			}
 		}
 
 		handler(self.ae.GetHostController().GetNetwork(), rc)
@@ -11,6 +11,7 @@ import (
 	"github.com/openziti/ziti/controller/api"
 	"github.com/openziti/ziti/controller/api_impl"
 	"github.com/openziti/ziti/controller/apierror"
+	"github.com/openziti/ziti/controller/internal/permissions"
 	"github.com/openziti/ziti/controller/network"
 	"github.com/openziti/ziti/controller/rest_server"
 	"net/http"
@@ -31,7 +32,8 @@ type FabricRequestWrapper struct {
 	network *network.Network
 }
 
-func (self *FabricRequestWrapper) WrapRequest(handler api_impl.RequestHandler, request *http.Request, entityId, entitySubId string) openApiMiddleware.Responder {
+// WrapRequest ignores the given permissions, as access is controlled by the client certificate check in WrapHttpHandler
+func (self *FabricRequestWrapper) WrapRequest(handler api_impl.RequestHandler, request *http.Request, entityId, entitySubId string, _ ...permissions.Resolver) openApiMiddleware.Responder {
 	return openApiMiddleware.ResponderFunc(func(writer http.ResponseWriter, producer runtime.Producer) {
 		rc, err := api.GetRequestContextFromHttpContext(request)
 
@@ -14,6 +14,7 @@ import (
 	"github.com/openziti/ziti/common/handler_common"
 	"github.com/openziti/ziti/common/pb/ctrl_pb"
 	"github.com/openziti/ziti/common/pb/mgmt_pb"
+	"github.com/openziti/ziti/tunnel/udp_vconn"
 	"github.com/pkg/errors"
 	"github.com/sirupsen/logrus"
 	"google.golang.org/protobuf/proto"
@@ -22,6 +23,7 @@ import (
 const (
 	AgentAppId      byte = 2
 	DumpApiSessions byte = 128
+	DumpUdpFlows    byte = 129
 )
 
 func (self *Router) RegisterAgentBindHandler(bindHandler channel.BindHandler) {
@@ -48,6 +50,8 @@ func (self *Router) RegisterDefaultAgentOps(debugEnabled bool) {
 	if debugEnabled {
 		self.RegisterAgentOp(DumpApiSessions, self.GetStateManager().DumpApiSessions)
 	}
+
+	self.RegisterAgentOp(DumpUdpFlows, self.agentOpDumpUdpFlows)
 }
 
 func (self *Router) RegisterAgentOp(opId byte, f func(c *bufio.ReadWriter) error) {
@@ -285,6 +289,23 @@ func (self *Router) agentOpUnroute(m *channel.Message, ch channel.Channel) {
 	handler_common.SendOpResult(m, ch, "unroute", fmt.Sprintf("circuit unrouted [%s]", unroute.CircuitId), true)
 }
 
+func (self *Router) agentOpDumpUdpFlows(c *bufio.ReadWriter) error {
+	result := udp_vconn.InspectFlows(time.Second)
+	for i, flow := range result.Flows {
+		val := fmt.Sprintf("%v: service: %v, src: %v, age: %v, idle: %v, rx: %v bytes/%v packets, tx: %v bytes/%v packets\n",
+			i+1, flow.Service, flow.SrcAddr, flow.Age, flow.Idle, flow.RxBytes, flow.RxPackets, flow.TxBytes, flow.TxPackets)
+		if _, err := c.WriteString(val); err != nil {
+			return err
+		}
+	}
+	for _, err := range result.Errors {
+		if _, writeErr := c.WriteString(err + "\n"); writeErr != nil {
+			return writeErr
+		}
+	}
+	return nil
+}
+
 func (self *Router) HandleAgentOp(conn net.Conn) error {
 	bconn := bufio.NewReadWriter(bufio.NewReader(conn), bufio.NewWriter(conn))
 	appId, err := bconn.ReadByte()
@@ -178,6 +178,10 @@ type Config struct {
 			InitialDelay time.Duration
 		}
 	}
+	Probes struct {
+		// Identity is the path to the identity file used to dial services when executing service probes
+		Identity string
+	}
 	ConnectEvents  ConnectEventsConfig
 	Proxy          *transport.ProxyConfiguration
 	Plugins        []string
@@ -764,6 +768,16 @@ func LoadConfigWithOptions(path string, loadIdentity bool) (*Config, error) {
 		}
 	}
 
+	if value, found := cfgmap["probes"]; found {
+		if probesMap, ok := value.(map[interface{}]interface{}); ok {
+			if value, found := probesMap["identity"]; found {
+				cfg.Probes.Identity = fmt.Sprintf("%v", value)
+			}
+		} else {
+			pfxlog.Logger().Warn("invalid [probes] stanza")
+		}
+	}
+
 	if value, found := cfgmap["plugins"]; found {
 		if list, ok := value.([]interface{}); ok {
 			for _, v := range list {
@@ -892,9 +906,9 @@ func LoadConfigWithOptions(path string, loadIdentity bool) (*Config, error) {
 		for _, c := range cfg.Link.Listeners {
 			a := c["advertise"]
 			if a != nil {
-				// should start with tls: or dtls:
+				// should start with tls:, dtls: or quic:
 				parts := strings.Split(a.(string), ":")
-				if parts[0] == "tls" || parts[0] == "dtls" {
+				if parts[0] == "tls" || parts[0] == "dtls" || parts[0] == "quic" {
 					addy := parts[1]
 					e := cfg.Id.ValidFor(addy)
 					if e != nil {
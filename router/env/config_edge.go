@@ -40,6 +40,8 @@ const (
 	DefaultSessionValidateChunkSize   = 1000
 	DefaultSessionValidateMinInterval = "250ms"
 	DefaultSessionValidateMaxInterval = "1500ms"
+	DefaultCertRenewalRetryInterval   = 5 * time.Minute
+	MinCertRenewalRetryInterval       = 10 * time.Second
 )
 
 type EdgeConfig struct {
@@ -53,6 +55,7 @@ type EdgeConfig struct {
 	SessionValidateMaxInterval time.Duration
 	Tcfg                       transport.Configuration
 	ForceExtendEnrollment      bool
+	CertRenewal                CertRenewal
 
 	RouterConfig *Config
 
@@ -69,6 +72,14 @@ type Csr struct {
 	Province           string `yaml:"province"`
 }
 
+// CertRenewal configures when the router renews its certificates. Certificates are always renewed a week before they
+// expire. If LifetimeFraction is set, they are renewed once that fraction of their lifetime has passed, if that is
+// earlier. If a renewal fails, it is retried after RetryInterval.
+type CertRenewal struct {
+	LifetimeFraction float64
+	RetryInterval    time.Duration
+}
+
 type ApiProxy struct {
 	Enabled  bool
 	Listener string
@@ -116,6 +127,10 @@ func (config *EdgeConfig) LoadEdgeConfigFromMap(configMap map[interface{}]interf
 		return err
 	}
 
+	if err = config.loadCertRenewal(configMap); err != nil {
+		return err
+	}
+
 	var edgeConfigMap map[interface{}]interface{}
 
 	if val, ok := configMap["edge"]; ok && val != nil {
@@ -402,6 +417,55 @@ func (config *EdgeConfig) loadCsr(rootConfigMap map[interface{}]interface{}) err
 	return nil
 }
 
+// loadCertRenewal loads the optional root `certRenewal` section
+func (config *EdgeConfig) loadCertRenewal(rootConfigMap map[interface{}]interface{}) error {
+	config.CertRenewal.RetryInterval = DefaultCertRenewalRetryInterval
+
+	val, ok := rootConfigMap["certRenewal"]
+	if !ok || val == nil {
+		return nil
+	}
+
+	certRenewalMap, ok := val.(map[interface{}]interface{})
+	if !ok {
+		return errors.New("[certRenewal] must be a map")
+	}
+
+	if val, ok = certRenewalMap["lifetimeFraction"]; ok {
+		switch fraction := val.(type) {
+		case float64:
+			config.CertRenewal.LifetimeFraction = fraction
+		case int:
+			config.CertRenewal.LifetimeFraction = float64(fraction)
+		default:
+			return errors.Errorf("invalid value %v for [certRenewal.lifetimeFraction], must be a number", val)
+		}
+
+		if config.CertRenewal.LifetimeFraction <= 0 || config.CertRenewal.LifetimeFraction >= 1 {
+			return errors.Errorf("invalid value %v for [certRenewal.lifetimeFraction], must be greater than 0 and less than 1",
+				config.CertRenewal.LifetimeFraction)
+		}
+	}
+
+	if val, ok = certRenewalMap["retryInterval"]; ok {
+		strVal, ok := val.(string)
+		if !ok {
+			return errors.Errorf("invalid value %v for [certRenewal.retryInterval], must be a duration string", val)
+		}
+		retryInterval, err := time.ParseDuration(strVal)
+		if err != nil {
+			return errors.Wrapf(err, "invalid value %v for [certRenewal.retryInterval]", val)
+		}
+		if retryInterval < MinCertRenewalRetryInterval {
+			return errors.Errorf("invalid value %v for [certRenewal.retryInterval], must be at least %v",
+				retryInterval, MinCertRenewalRetryInterval)
+		}
+		config.CertRenewal.RetryInterval = retryInterval
+	}
+
+	return nil
+}
+
 // parseCsr parses the given map as a CSR definition. Error messages are based on the path provided.
 func (config *EdgeConfig) parseCsr(csrMap map[interface{}]interface{}, path string) (*Csr, error) {
 	targetCsr := &Csr{}
@@ -27,6 +27,8 @@ const (
 	DefaultFaultTxInterval             = 15 * time.Second
 	DefaultIdleTxInterval              = 60 * time.Second
 	DefaultIdleCircuitTimeout          = 60 * time.Second
+	DefaultCircuitRehomeInterval       = 30 * time.Second
+	DefaultCircuitRehomeGracePeriod    = 60 * time.Second
 	DefaultXgressDialWorkerQueueLength = 1000
 	MinXgressDialWorkerQueueLength     = 1
 	MaxXgressDialWorkerQueueLength     = 10000
@@ -53,6 +55,8 @@ const (
 )
 
 type ForwarderOptions struct {
+	CircuitRehomeGracePeriod time.Duration
+	CircuitRehomeInterval    time.Duration
 	FaultTxInterval          time.Duration
 	IdleCircuitTimeout       time.Duration
 	IdleTxInterval           time.Duration
@@ -71,9 +75,11 @@ type WorkerPoolOptions struct {
 
 func DefaultForwarderOptions() *ForwarderOptions {
 	return &ForwarderOptions{
-		FaultTxInterval:    DefaultFaultTxInterval,
-		IdleCircuitTimeout: DefaultIdleCircuitTimeout,
-		IdleTxInterval:     DefaultIdleTxInterval,
+		CircuitRehomeGracePeriod: DefaultCircuitRehomeGracePeriod,
+		CircuitRehomeInterval:    DefaultCircuitRehomeInterval,
+		FaultTxInterval:          DefaultFaultTxInterval,
+		IdleCircuitTimeout:       DefaultIdleCircuitTimeout,
+		IdleTxInterval:           DefaultIdleTxInterval,
 		LinkDial: WorkerPoolOptions{
 			QueueLength: DefaultLinkDialQueueLength,
 			WorkerCount: DefaultLinkDialWorkerCount,
@@ -119,6 +125,22 @@ func LoadForwarderOptions(src map[interface{}]interface{}) (*ForwarderOptions, e
 		}
 	}
 
+	if value, found := src["circuitRehomeInterval"]; found {
+		if val, ok := value.(int); ok {
+			options.CircuitRehomeInterval = time.Duration(val) * time.Millisecond
+		} else {
+			return nil, errors.New("invalid value for 'circuitRehomeInterval'")
+		}
+	}
+
+	if value, found := src["circuitRehomeGracePeriod"]; found {
+		if val, ok := value.(int); ok {
+			options.CircuitRehomeGracePeriod = time.Duration(val) * time.Millisecond
+		} else {
+			return nil, errors.New("invalid value for 'circuitRehomeGracePeriod'")
+		}
+	}
+
 	if value, found := src["linkDialQueueLength"]; found {
 		if length, ok := value.(int); ok {
 			if length < MinLinkDialWorkerQueueLength || length > MaxLinkDialWorkerQueueLength {
@@ -59,6 +59,7 @@ type RouterEnv interface {
 	GetForwarder() Forwarder
 	GetXgressMetrics() XgressMetrics
 	NotifyCertsUpdated()
+	RenewCerts()
 	GetAlerter() Alerter
 	GetXgressRegistry() *Registry
 }
@@ -81,6 +82,7 @@ type Forwarder interface {
 	ReportForwardingFault(circuitId string, ctrlId string)
 	RegisterDestination(circuitId string, address xgress.Address, destination Destination)
 	EndCircuit(circuitId string)
+	GetCircuitCtrlId(circuitId string, defaultCtrlId string) string
 }
 
 type Destination interface {
@@ -138,13 +138,14 @@ func (forwarder *Forwarder) Route(ctrlId string, route *ctrl_pb.Route) error {
 			}
 			// It's an ingress destination, which isn't established until after routing has completed
 		}
-		circuitFt.setForwardAddress(xgress.Address(forward.SrcAddress), xgress.Address(forward.DstAddress))
+		circuitFt.setForward(forward)
 		pfxlog.Logger().WithFields(logrus.Fields{
 			"circuitId":   circuitId,
 			"source":      forward.SrcAddress,
 			"destination": forward.DstAddress,
 		}).Debug("route added")
 	}
+	circuitFt.setRouteDetails(route)
 	forwarder.circuits.setForwardTable(circuitId, circuitFt)
 	return nil
 }
@@ -270,12 +271,7 @@ func (forwarder *Forwarder) ForwardControl(srcAddr xgress.Address, control *xgre
 }
 
 func (forwarder *Forwarder) ReportForwardingFault(circuitId string, ctrlId string) {
-	if ctrlId == "" {
-		ct, _ := forwarder.circuits.getForwardTable(circuitId, false)
-		if ct != nil {
-			ctrlId = ct.ctrlId
-		}
-	}
+	ctrlId = forwarder.GetCircuitCtrlId(circuitId, ctrlId)
 
 	if forwarder.faulter != nil {
 		forwarder.faulter.Report(circuitId, ctrlId)
@@ -284,6 +280,51 @@ func (forwarder *Forwarder) ReportForwardingFault(circuitId string, ctrlId strin
 	}
 }
 
+func (forwarder *Forwarder) StartRehomer(ctrls env.NetworkControllers) {
+	rehomer := newRehomer(ctrls, forwarder.Options, forwarder.CloseNotify)
+	rehomer.setCircuitTable(forwarder.circuits)
+
+	if rehomer.interval > 0 {
+		go rehomer.run()
+	} else {
+		logrus.Warnf("circuit re-homing disabled")
+	}
+}
+
+// GetCircuitFragments returns this router's part of the given circuits. Circuits the router doesn't have forwarding
+// tables for are skipped
+func (forwarder *Forwarder) GetCircuitFragments(circuitIds []string) []*ctrl_pb.CircuitFragment {
+	var result []*ctrl_pb.CircuitFragment
+	for _, circuitId := range circuitIds {
+		if ft, found := forwarder.circuits.getForwardTable(circuitId, false); found {
+			result = append(result, ft.getFragment(circuitId))
+		}
+	}
+	return result
+}
+
+// SetCircuitCtrlId records that the given controller now owns the given circuits
+func (forwarder *Forwarder) SetCircuitCtrlId(circuitIds []string, ctrlId string) {
+	for _, circuitId := range circuitIds {
+		if ft, found := forwarder.circuits.getForwardTable(circuitId, false); found {
+			ft.setCtrlId(ctrlId)
+			ft.rehomeRejected.Store(false)
+		}
+	}
+}
+
+// GetCircuitCtrlId returns the id of the controller which currently owns the circuit. This is the controller which
+// routed the circuit, unless the circuit has since been re-homed to another controller. If the router has no
+// forwarding table for the circuit, the given default is returned
+func (forwarder *Forwarder) GetCircuitCtrlId(circuitId string, defaultCtrlId string) string {
+	if ft, found := forwarder.circuits.getForwardTable(circuitId, false); found {
+		if ctrlId := ft.getCtrlId(); ctrlId != "" {
+			return ctrlId
+		}
+	}
+	return defaultCtrlId
+}
+
 func (forwarder *Forwarder) Debug() string {
 	return forwarder.circuits.debug() + forwarder.destinations.debug()
 }
@@ -340,7 +381,7 @@ func (forwarder *Forwarder) InspectCircuits() *inspect.ForwarderCircuits {
 		detail := &inspect.CircuitDetail{
 			Id:                key,
 			TimeSinceActivity: (time.Duration(time.Now().UnixMilli()-atomic.LoadInt64(&ft.last)) * time.Millisecond).String(),
-			CtrlId:            ft.ctrlId,
+			CtrlId:            ft.getCtrlId(),
 			Routes:            routes,
 			Destinations:      map[string]string{},
 		}
@@ -0,0 +1,190 @@
+/*
+	Copyright NetFoundry Inc.
+
+	Licensed under the Apache License, Version 2.0 (the "License");
+	you may not use this file except in compliance with the License.
+	You may obtain a copy of the License at
+
+	https://www.apache.org/licenses/LICENSE-2.0
+
+	Unless required by applicable law or agreed to in writing, software
+	distributed under the License is distributed on an "AS IS" BASIS,
+	WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+	See the License for the specific language governing permissions and
+	limitations under the License.
+*/
+
+package forwarder
+
+import (
+	"sort"
+	"time"
+
+	"github.com/michaelquigley/pfxlog"
+	"github.com/openziti/channel/v4"
+	"github.com/openziti/channel/v4/protobufs"
+	"github.com/openziti/ziti/common/pb/ctrl_pb"
+	"github.com/openziti/ziti/router/env"
+	"github.com/sirupsen/logrus"
+)
+
+const maxRehomeBatchSize = 100
+
+// Rehomer finds circuits whose owning controller has been gone for longer than the grace period and asks another
+// controller to take ownership of them. Only the ingress router of a circuit asks, the controller taking ownership
+// collects the rest of the path from the other routers on the circuit.
+type Rehomer struct {
+	ctrls       env.NetworkControllers
+	circuits    *circuitTable
+	interval    time.Duration
+	gracePeriod time.Duration
+	closeNotify <-chan struct{}
+}
+
+func newRehomer(ctrls env.NetworkControllers, options *env.ForwarderOptions, closeNotify <-chan struct{}) *Rehomer {
+	return &Rehomer{
+		ctrls:       ctrls,
+		interval:    options.CircuitRehomeInterval,
+		gracePeriod: options.CircuitRehomeGracePeriod,
+		closeNotify: closeNotify,
+	}
+}
+
+func (self *Rehomer) setCircuitTable(circuits *circuitTable) {
+	self.circuits = circuits
+}
+
+func (self *Rehomer) run() {
+	logrus.Info("started")
+	defer logrus.Warn("exited")
+
+	for {
+		select {
+		case <-time.After(self.interval):
+			self.rehome()
+
+		case <-self.closeNotify:
+			return
+		}
+	}
+}
+
+func (self *Rehomer) isOrphaned(ctrlId string) bool {
+	ctrl := self.ctrls.GetNetworkController(ctrlId)
+	if ctrl == nil {
+		return true
+	}
+	return !ctrl.IsConnected() && ctrl.TimeSinceLastContact() > self.gracePeriod
+}
+
+func (self *Rehomer) rehome() {
+	orphanedCtrls := map[string]bool{}
+	var orphaned []*ctrl_pb.CircuitFragment
+	tables := map[string]*forwardTable{}
+
+	for circuitId, ft := range self.circuits.circuits.Items() {
+		if ft.rehomeRejected.Load() || !ft.isIngress() {
+			continue
+		}
+
+		ctrlId := ft.getCtrlId()
+		isOrphaned, found := orphanedCtrls[ctrlId]
+		if !found {
+			isOrphaned = self.isOrphaned(ctrlId)
+			orphanedCtrls[ctrlId] = isOrphaned
+		}
+
+		if isOrphaned {
+			orphaned = append(orphaned, ft.getFragment(circuitId))
+			tables[circuitId] = ft
+		}
+	}
+
+	if len(orphaned) == 0 {
+		return
+	}
+
+	log := pfxlog.Logger().WithField("circuits", len(orphaned))
+
+	ch := self.selectTarget(orphanedCtrls)
+	if ch == nil {
+		log.Warn("circuits have no owning controller, but no other controller is available to take ownership")
+		return
+	}
+
+	log = log.WithField("ctrlId", ch.Id())
+	log.Info("asking controller to take ownership of orphaned circuits")
+
+	for len(orphaned) > 0 {
+		batch := orphaned
+		if len(batch) > maxRehomeBatchSize {
+			batch = batch[:maxRehomeBatchSize]
+		}
+		orphaned = orphaned[len(batch):]
+
+		if err := self.sendBatch(ch, batch, tables); err != nil {
+			log.WithError(err).Error("failed to re-home orphaned circuits")
+			return
+		}
+	}
+}
+
+func (self *Rehomer) sendBatch(ch channel.Channel, batch []*ctrl_pb.CircuitFragment, tables map[string]*forwardTable) error {
+	request := &ctrl_pb.CircuitRehomeRequest{
+		Fragments: batch,
+	}
+
+	response := &ctrl_pb.CircuitRehomeResponse{}
+	reply, err := protobufs.MarshalTyped(request).WithTimeout(self.ctrls.DefaultRequestTimeout()).SendForReply(ch)
+	if err = protobufs.TypedResponse(response).Unmarshall(reply, err); err != nil {
+		return err
+	}
+
+	for _, circuitId := range response.Accepted {
+		if ft, found := tables[circuitId]; found {
+			ft.setCtrlId(ch.Id())
+		}
+	}
+
+	for circuitId, reason := range response.Rejected {
+		if ft, found := tables[circuitId]; found {
+			ft.rehomeRejected.Store(true)
+		}
+		pfxlog.Logger().WithField("circuitId", circuitId).WithField("ctrlId", ch.Id()).
+			Warnf("controller declined ownership of orphaned circuit: %s", reason)
+	}
+
+	pfxlog.Logger().WithField("ctrlId", ch.Id()).
+		Infof("controller took ownership of [%d] circuits, declined [%d]", len(response.Accepted), len(response.Rejected))
+
+	return nil
+}
+
+// selectTarget prefers the channel used for model updates, which will be the leader if the leader is reachable. If
+// that isn't available, the responsive controller with the lowest id is used, so that routers tend to pick the same one
+func (self *Rehomer) selectTarget(orphanedCtrls map[string]bool) channel.Channel {
+	isCandidate := func(ctrlId string) bool {
+		if orphanedCtrls[ctrlId] {
+			return false
+		}
+		ch, _ := self.ctrls.GetIfResponsive(ctrlId)
+		return ch != nil
+	}
+
+	if ch := self.ctrls.GetModelUpdateCtrlChannel(); ch != nil && isCandidate(ch.Id()) {
+		return ch
+	}
+
+	channels := self.ctrls.AllResponsiveCtrlChannels()
+	sort.Slice(channels, func(i, j int) bool {
+		return channels[i].Id() < channels[j].Id()
+	})
+
+	for _, ch := range channels {
+		if isCandidate(ch.Id()) {
+			return ch
+		}
+	}
+
+	return nil
+}
@@ -72,14 +72,15 @@ func (self *Scanner) scan() {
 	for circuitId, ft := range circuits {
 		idleTime := time.Duration(now-atomic.LoadInt64(&ft.last)) * time.Millisecond
 		if idleTime > self.timeout {
-			ctrlMap := idleCircuits[ft.ctrlId]
+			ctrlId := ft.getCtrlId()
+			ctrlMap := idleCircuits[ctrlId]
 			if ctrlMap == nil {
 				ctrlMap = map[string]int64{}
-				idleCircuits[ft.ctrlId] = ctrlMap
+				idleCircuits[ctrlId] = ctrlMap
 			}
 			ctrlMap[circuitId] = int64(idleTime)
 			logrus.WithField("circuitId", circuitId).
-				WithField("ctrlId", ft.ctrlId).
+				WithField("ctrlId", ctrlId).
 				WithField("idleTime", idleTime).
 				WithField("idleThreshold", self.timeout).
 				Warn("circuit exceeds idle threshold")
@@ -18,10 +18,13 @@ package forwarder
 
 import (
 	"fmt"
+	"github.com/openziti/foundation/v2/concurrenz"
 	"github.com/openziti/sdk-golang/xgress"
+	"github.com/openziti/ziti/common/pb/ctrl_pb"
 	"github.com/openziti/ziti/router/env"
 	"github.com/orcaman/concurrent-map/v2"
 	"reflect"
+	"sync"
 	"sync/atomic"
 	"time"
 )
@@ -66,24 +69,98 @@ func (st *circuitTable) debug() string {
 	return out
 }
 
-// forwardTable implements a directory of destinations, keyed by source address.
+// forwardTable implements a directory of destinations, keyed by source address. It also keeps the route details
+// needed to report this router's part of the circuit, if the circuit has to be re-homed to another controller.
 type forwardTable struct {
-	ctrlId       string
-	last         int64
-	destinations cmap.ConcurrentMap[string, string]
+	ctrlId         concurrenz.AtomicValue[string]
+	last           int64
+	destinations   cmap.ConcurrentMap[string, string]
+	dstTypes       cmap.ConcurrentMap[string, ctrl_pb.DestType]
+	createdAt      time.Time
+	lock           sync.Mutex
+	tags           map[string]string
+	egress         *ctrl_pb.Route_Egress
+	rehomeRejected atomic.Bool
 }
 
 func newForwardTable(ctrlId string) *forwardTable {
-	return &forwardTable{
-		ctrlId:       ctrlId,
+	ft := &forwardTable{
 		destinations: cmap.New[string](),
+		dstTypes:     cmap.New[ctrl_pb.DestType](),
+		createdAt:    time.Now(),
 	}
+	ft.ctrlId.Store(ctrlId)
+	return ft
+}
+
+func (ft *forwardTable) getCtrlId() string {
+	return ft.ctrlId.Load()
+}
+
+func (ft *forwardTable) setCtrlId(ctrlId string) {
+	ft.ctrlId.Store(ctrlId)
 }
 
 func (ft *forwardTable) setForwardAddress(src, dst xgress.Address) {
 	ft.destinations.Set(string(src), string(dst))
 }
 
+func (ft *forwardTable) setForward(forward *ctrl_pb.Route_Forward) {
+	ft.setForwardAddress(xgress.Address(forward.SrcAddress), xgress.Address(forward.DstAddress))
+	ft.dstTypes.Set(forward.SrcAddress, forward.DstType)
+}
+
+func (ft *forwardTable) setRouteDetails(route *ctrl_pb.Route) {
+	ft.lock.Lock()
+	defer ft.lock.Unlock()
+
+	if route.Tags != nil {
+		ft.tags = route.Tags
+	}
+	if route.Egress != nil {
+		ft.egress = route.Egress
+	}
+}
+
+// isIngress returns true if this router hosts the initiating side of the circuit
+func (ft *forwardTable) isIngress() bool {
+	for _, dstType := range ft.dstTypes.Items() {
+		if dstType == ctrl_pb.DestType_Start {
+			return true
+		}
+	}
+	return false
+}
+
+// getFragment returns this router's part of the circuit, as reported to a controller re-homing the circuit
+func (ft *forwardTable) getFragment(circuitId string) *ctrl_pb.CircuitFragment {
+	result := &ctrl_pb.CircuitFragment{
+		CircuitId: circuitId,
+		CtrlId:    ft.getCtrlId(),
+		CreatedAt: ft.createdAt.UnixMilli(),
+	}
+
+	for src, dst := range ft.destinations.Items() {
+		dstType, _ := ft.dstTypes.Get(src)
+		result.Forwards = append(result.Forwards, &ctrl_pb.Route_Forward{
+			SrcAddress: src,
+			DstAddress: dst,
+			DstType:    dstType,
+		})
+	}
+
+	ft.lock.Lock()
+	defer ft.lock.Unlock()
+
+	result.Tags = ft.tags
+	if ft.egress != nil {
+		result.EgressBinding = ft.egress.Binding
+		result.EgressDestination = ft.egress.Destination
+	}
+
+	return result
+}
+
 func (ft *forwardTable) getForwardAddress(src xgress.Address) (xgress.Address, bool) {
 	if dst, found := ft.destinations.Get(string(src)); found {
 		return xgress.Address(dst), true
@@ -19,6 +19,9 @@ package forwarder
 import (
 	"sync/atomic"
 	"testing"
+
+	"github.com/openziti/ziti/common/pb/ctrl_pb"
+	"github.com/stretchr/testify/require"
 )
 
 // A simple test to check for failure of alignment on atomic operations for 64 bit variables in a struct
@@ -33,3 +36,46 @@ func Test64BitAlignment(t *testing.T) {
 
 	atomic.LoadInt64(&fTable.last)
 }
+
+func TestForwardTableFragment(t *testing.T) {
+	req := require.New(t)
+
+	ingress := newForwardTable("ctrl1")
+	egress := newForwardTable("ctrl1")
+
+	ingress.setForward(&ctrl_pb.Route_Forward{SrcAddress: "ingress", DstAddress: "l0", DstType: ctrl_pb.DestType_Link})
+	ingress.setForward(&ctrl_pb.Route_Forward{SrcAddress: "l0", DstAddress: "ingress", DstType: ctrl_pb.DestType_Start})
+	ingress.setRouteDetails(&ctrl_pb.Route{Tags: map[string]string{"serviceId": "svc"}})
+
+	egress.setForward(&ctrl_pb.Route_Forward{SrcAddress: "egress", DstAddress: "l0", DstType: ctrl_pb.DestType_Link})
+	egress.setForward(&ctrl_pb.Route_Forward{SrcAddress: "l0", DstAddress: "egress", DstType: ctrl_pb.DestType_End})
+	egress.setRouteDetails(&ctrl_pb.Route{
+		Egress: &ctrl_pb.Route_Egress{Binding: "transport", Address: "egress", Destination: "tcp:localhost:1001"},
+	})
+
+	// smart reroutes don't resend the egress, the original should be kept
+	egress.setRouteDetails(&ctrl_pb.Route{})
+
+	req.True(ingress.isIngress())
+	req.False(egress.isIngress())
+
+	fragment := ingress.getFragment("c0")
+	req.Equal("c0", fragment.CircuitId)
+	req.Equal("ctrl1", fragment.CtrlId)
+	req.Equal("svc", fragment.Tags["serviceId"])
+	req.Len(fragment.Forwards, 2)
+	for _, forward := range fragment.Forwards {
+		if forward.SrcAddress == "l0" {
+			req.Equal(ctrl_pb.DestType_Start, forward.DstType)
+		} else {
+			req.Equal(ctrl_pb.DestType_Link, forward.DstType)
+		}
+	}
+
+	fragment = egress.getFragment("c0")
+	req.Equal("transport", fragment.EgressBinding)
+	req.Equal("tcp:localhost:1001", fragment.EgressDestination)
+
+	ingress.setCtrlId("ctrl2")
+	req.Equal("ctrl2", ingress.getFragment("c0").CtrlId)
+}
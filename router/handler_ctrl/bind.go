@@ -45,6 +45,7 @@ type bindHandler struct {
 	xgDialerPool             goroutines.Pool
 	terminatorValidationPool goroutines.Pool
 	ctrlAddressUpdater       CtrlAddressUpdater
+	prober                   *prober
 }
 
 func XgressDialerWorker(_ uint32, f func()) {
@@ -96,6 +97,7 @@ func NewBindHandler(routerEnv InspectRouterEnv, forwarder *forwarder.Forwarder,
 		xgDialerPool:             xgDialerPool,
 		terminatorValidationPool: terminatorValidationPool,
 		ctrlAddressUpdater:       ctrlAddressUpdater,
+		prober:                   newProber(routerEnv),
 	}, nil
 }
 
@@ -116,6 +118,9 @@ func (self *bindHandler) BindChannel(binding channel.Binding) error {
 	binding.AddTypedReceiveHandler(newFaultHandler(self.env.GetXlinkRegistry()))
 	binding.AddTypedReceiveHandler(newUpdateCtrlAddressesHandler(self.env, self.ctrlAddressUpdater))
 	binding.AddTypedReceiveHandler(newUpdateClusterLeaderHandler(self.env, self.ctrlAddressUpdater))
+	binding.AddTypedReceiveHandler(newProbeHandler(self.prober))
+	binding.AddTypedReceiveHandler(newCircuitFragmentsHandler(self.forwarder))
+	binding.AddTypedReceiveHandler(newCircuitOwnershipUpdateHandler(self.forwarder))
 
 	binding.AddPeekHandler(trace.NewChannelPeekHandler(self.env.GetRouterId().Token, binding.GetChannel(), self.forwarder.TraceController()))
 
@@ -0,0 +1,95 @@
+/*
+	Copyright NetFoundry Inc.
+
+	Licensed under the Apache License, Version 2.0 (the "License");
+	you may not use this file except in compliance with the License.
+	You may obtain a copy of the License at
+
+	https://www.apache.org/licenses/LICENSE-2.0
+
+	Unless required by applicable law or agreed to in writing, software
+	distributed under the License is distributed on an "AS IS" BASIS,
+	WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+	See the License for the specific language governing permissions and
+	limitations under the License.
+*/
+
+package handler_ctrl
+
+import (
+	"time"
+
+	"github.com/michaelquigley/pfxlog"
+	"github.com/openziti/channel/v4"
+	"github.com/openziti/channel/v4/protobufs"
+	"github.com/openziti/ziti/common/pb/ctrl_pb"
+	"github.com/openziti/ziti/router/forwarder"
+	"google.golang.org/protobuf/proto"
+)
+
+// circuitFragmentsHandler reports this router's part of circuits a controller is re-homing
+type circuitFragmentsHandler struct {
+	forwarder *forwarder.Forwarder
+}
+
+func newCircuitFragmentsHandler(forwarder *forwarder.Forwarder) *circuitFragmentsHandler {
+	return &circuitFragmentsHandler{
+		forwarder: forwarder,
+	}
+}
+
+func (*circuitFragmentsHandler) ContentType() int32 {
+	return int32(ctrl_pb.ContentType_CircuitFragmentsRequestType)
+}
+
+func (self *circuitFragmentsHandler) HandleReceive(msg *channel.Message, ch channel.Channel) {
+	log := pfxlog.ContextLogger(ch.Label())
+
+	request := &ctrl_pb.CircuitFragmentsRequest{}
+	if err := proto.Unmarshal(msg.Body, request); err != nil {
+		log.WithError(err).Error("failed to unmarshal circuit fragments request")
+		return
+	}
+
+	response := &ctrl_pb.CircuitFragmentsResponse{
+		Fragments: self.forwarder.GetCircuitFragments(request.CircuitIds),
+	}
+
+	go func() {
+		err := protobufs.MarshalTyped(response).
+			ReplyTo(msg).
+			WithTimeout(5 * time.Second).
+			SendAndWaitForWire(ch)
+		if err != nil {
+			log.WithError(err).Error("failed to send circuit fragments response")
+		}
+	}()
+}
+
+// circuitOwnershipUpdateHandler records that the sending controller has taken ownership of circuits
+type circuitOwnershipUpdateHandler struct {
+	forwarder *forwarder.Forwarder
+}
+
+func newCircuitOwnershipUpdateHandler(forwarder *forwarder.Forwarder) *circuitOwnershipUpdateHandler {
+	return &circuitOwnershipUpdateHandler{
+		forwarder: forwarder,
+	}
+}
+
+func (*circuitOwnershipUpdateHandler) ContentType() int32 {
+	return int32(ctrl_pb.ContentType_CircuitOwnershipUpdateType)
+}
+
+func (self *circuitOwnershipUpdateHandler) HandleReceive(msg *channel.Message, ch channel.Channel) {
+	log := pfxlog.ContextLogger(ch.Label())
+
+	update := &ctrl_pb.CircuitOwnershipUpdate{}
+	if err := proto.Unmarshal(msg.Body, update); err != nil {
+		log.WithError(err).Error("failed to unmarshal circuit ownership update")
+		return
+	}
+
+	self.forwarder.SetCircuitCtrlId(update.CircuitIds, ch.Id())
+	log.WithField("ctrlId", ch.Id()).Debugf("controller took ownership of [%d] circuits", len(update.CircuitIds))
+}
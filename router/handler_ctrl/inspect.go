@@ -29,6 +29,7 @@ import (
 	"github.com/openziti/ziti/common/pb/ctrl_pb"
 	"github.com/openziti/ziti/router/forwarder"
 	"github.com/openziti/ziti/router/xgress_router"
+	"github.com/openziti/ziti/tunnel/udp_vconn"
 	"github.com/pkg/errors"
 	"google.golang.org/protobuf/proto"
 )
@@ -115,6 +116,9 @@ func (context *inspectRequestContext) processLocal() {
 			context.inspectXgressDialer("edge", requested)
 		} else if lc == inspect.ErtTerminatorsKey {
 			context.inspectXgressDialer("tunnel", requested)
+		} else if lc == inspect.UdpFlowsKey {
+			result := udp_vconn.InspectFlows(time.Second)
+			context.handleJsonResponse(requested, result)
 		} else if strings.HasPrefix(lc, "circuit:") {
 			circuitId := requested[len("circuit:"):]
 			result := context.handler.fwd.InspectCircuit(circuitId, false)
@@ -0,0 +1,224 @@
+/*
+	Copyright NetFoundry Inc.
+
+	Licensed under the Apache License, Version 2.0 (the "License");
+	you may not use this file except in compliance with the License.
+	You may obtain a copy of the License at
+
+	https://www.apache.org/licenses/LICENSE-2.0
+
+	Unless required by applicable law or agreed to in writing, software
+	distributed under the License is distributed on an "AS IS" BASIS,
+	WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+	See the License for the specific language governing permissions and
+	limitations under the License.
+*/
+
+package handler_ctrl
+
+import (
+	"bytes"
+	"io"
+	"sync"
+	"time"
+
+	"github.com/michaelquigley/pfxlog"
+	"github.com/openziti/channel/v4"
+	"github.com/openziti/channel/v4/protobufs"
+	"github.com/openziti/sdk-golang/ziti"
+	"github.com/openziti/ziti/common/pb/ctrl_pb"
+	"github.com/openziti/ziti/router/env"
+	"github.com/openziti/ziti/router/xlink"
+	"github.com/pkg/errors"
+	"google.golang.org/protobuf/proto"
+)
+
+const defaultProbeTimeout = 10 * time.Second
+
+// prober executes the synthetic probes requested by controllers. It's shared by all controller channels, so that
+// service probes from different controllers use the same probe identity session
+type prober struct {
+	env     env.RouterEnv
+	lock    sync.Mutex
+	zitiCtx ziti.Context
+}
+
+func newProber(env env.RouterEnv) *prober {
+	return &prober{
+		env: env,
+	}
+}
+
+type probeHandler struct {
+	prober *prober
+}
+
+func newProbeHandler(prober *prober) *probeHandler {
+	return &probeHandler{
+		prober: prober,
+	}
+}
+
+func (*probeHandler) ContentType() int32 {
+	return int32(ctrl_pb.ContentType_ProbeRequestType)
+}
+
+func (self *probeHandler) HandleReceive(msg *channel.Message, ch channel.Channel) {
+	log := pfxlog.ContextLogger(ch.Label())
+
+	request := &ctrl_pb.ProbeRequest{}
+	if err := proto.Unmarshal(msg.Body, request); err != nil {
+		log.WithError(err).Error("failed to unmarshal probe request")
+		return
+	}
+
+	go func() {
+		response := self.prober.execute(request)
+		if !response.Success {
+			log.WithField("probe", request.Name).Debugf("probe failed: %s", response.Error)
+		}
+
+		err := protobufs.MarshalTyped(response).
+			ReplyTo(msg).
+			WithTimeout(5 * time.Second).
+			SendAndWaitForWire(ch)
+		if err != nil {
+			log.WithError(err).WithField("probe", request.Name).Error("failed to send probe response")
+		}
+	}()
+}
+
+func (self *prober) execute(request *ctrl_pb.ProbeRequest) *ctrl_pb.ProbeResponse {
+	timeout := time.Duration(request.Timeout)
+	if timeout <= 0 {
+		timeout = defaultProbeTimeout
+	}
+
+	var latency time.Duration
+	var err error
+
+	switch request.Type {
+	case ctrl_pb.ProbeType_RouterPathProbe:
+		latency, err = self.probeRouterPath(request.TargetRouterId, timeout)
+	case ctrl_pb.ProbeType_ServiceProbe:
+		latency, err = self.probeService(request, timeout)
+	default:
+		err = errors.Errorf("unsupported probe type %v", request.Type)
+	}
+
+	if err != nil {
+		return &ctrl_pb.ProbeResponse{Error: err.Error()}
+	}
+
+	return &ctrl_pb.ProbeResponse{
+		Success: true,
+		Latency: latency.Nanoseconds(),
+	}
+}
+
+// probeRouterPath measures the round trip time over the links to the target router, returning the fastest. The
+// probe fails if there are no links to the target router, or if none of them respond in time
+func (self *prober) probeRouterPath(targetRouterId string, timeout time.Duration) (time.Duration, error) {
+	var links []xlink.Xlink
+	for link := range self.env.GetXlinkRegistry().Iter() {
+		if link.DestinationId() == targetRouterId && !link.IsClosed() {
+			links = append(links, link)
+		}
+	}
+
+	if len(links) == 0 {
+		return 0, errors.Errorf("no links to router %s", targetRouterId)
+	}
+
+	var result time.Duration
+	var lastErr error
+	for _, link := range links {
+		probeLink, ok := link.(xlink.LatencyProber)
+		if !ok {
+			lastErr = errors.Errorf("link %s doesn't support latency probes", link.Id())
+			continue
+		}
+
+		latency, err := probeLink.ProbeLatency(timeout)
+		if err != nil {
+			lastErr = errors.Wrapf(err, "latency probe over link %s failed", link.Id())
+			continue
+		}
+
+		if result == 0 || latency < result {
+			result = latency
+		}
+	}
+
+	if result == 0 {
+		return 0, lastErr
+	}
+
+	return result, nil
+}
+
+// probeService dials the service using the router's probe identity. If the request has a payload, it's sent once
+// connected, and if the request has an expected response, the probe only succeeds once a response containing it is
+// received. The latency covers the whole exchange
+func (self *prober) probeService(request *ctrl_pb.ProbeRequest, timeout time.Duration) (time.Duration, error) {
+	zitiCtx, err := self.getZitiContext()
+	if err != nil {
+		return 0, err
+	}
+
+	start := time.Now()
+	conn, err := zitiCtx.DialWithOptions(request.Service, &ziti.DialOptions{ConnectTimeout: timeout})
+	if err != nil {
+		return 0, errors.Wrapf(err, "failed to dial service '%s'", request.Service)
+	}
+	defer func() { _ = conn.Close() }()
+
+	if err = conn.SetDeadline(start.Add(timeout)); err != nil {
+		return 0, err
+	}
+
+	if len(request.Send) > 0 {
+		if _, err = conn.Write(request.Send); err != nil {
+			return 0, errors.Wrapf(err, "failed to write to service '%s'", request.Service)
+		}
+	}
+
+	if len(request.Expect) > 0 {
+		var received []byte
+		buf := make([]byte, 4096)
+		for !bytes.Contains(received, request.Expect) {
+			n, err := conn.Read(buf)
+			received = append(received, buf[:n]...)
+			if err != nil {
+				if err == io.EOF {
+					return 0, errors.Errorf("service '%s' closed the connection without sending the expected response", request.Service)
+				}
+				return 0, errors.Wrapf(err, "did not receive the expected response from service '%s'", request.Service)
+			}
+		}
+	}
+
+	return time.Since(start), nil
+}
+
+func (self *prober) getZitiContext() (ziti.Context, error) {
+	self.lock.Lock()
+	defer self.lock.Unlock()
+
+	if self.zitiCtx != nil {
+		return self.zitiCtx, nil
+	}
+
+	identityFile := self.env.GetConfig().Probes.Identity
+	if identityFile == "" {
+		return nil, errors.New("no probe identity configured, unable to execute service probes")
+	}
+
+	zitiCtx, err := ziti.NewContextFromFile(identityFile)
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to load probe identity from '%s'", identityFile)
+	}
+
+	self.zitiCtx = zitiCtx
+	return zitiCtx, nil
+}
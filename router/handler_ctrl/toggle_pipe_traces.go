@@ -61,7 +61,8 @@ func (handler *traceHandler) HandleReceive(msg *channel.Message, ch channel.Chan
 
 		if matchers.AppMatcher.Matches(handler.appId.Token) {
 			if request.Enable {
-				handler.controller.EnableTracing(trace.SourceTypePipe, matchers.PipeMatcher, handler.eventHandler, resultChan)
+				eventHandler := trace.NewPayloadCaptureHandler(handler.eventHandler, msg)
+				handler.controller.EnableTracing(trace.SourceTypePipe, matchers.PipeMatcher, eventHandler, resultChan)
 			} else {
 				handler.controller.DisableTracing(trace.SourceTypePipe, matchers.PipeMatcher, handler.eventHandler, resultChan)
 			}
@@ -18,6 +18,7 @@ package handler_xgress
 
 import (
 	"github.com/openziti/sdk-golang/xgress"
+	"github.com/openziti/ziti/common/trace"
 	"github.com/openziti/ziti/router/env"
 	"github.com/openziti/ziti/router/metrics"
 )
@@ -26,21 +27,24 @@ type bindHandler struct {
 	dataPlaneAdapter   xgress.DataPlaneAdapter
 	closeHandler       xgress.CloseHandler
 	metricsPeekHandler xgress.PeekHandler
+	tracePeekHandler   *trace.XgressPeekHandler
 	env                env.RouterEnv
 }
 
-func NewBindHandler(env env.RouterEnv, dataPlaneAdapter xgress.DataPlaneAdapter, closeHandler xgress.CloseHandler) *bindHandler {
+func NewBindHandler(env env.RouterEnv, dataPlaneAdapter xgress.DataPlaneAdapter, closeHandler xgress.CloseHandler, traceController trace.Controller) *bindHandler {
 	return &bindHandler{
 		env:                env,
 		dataPlaneAdapter:   dataPlaneAdapter,
 		closeHandler:       closeHandler,
 		metricsPeekHandler: metrics.NewXgressPeekHandler(env.GetXgressMetrics()),
+		tracePeekHandler:   trace.NewXgressPeekHandler(env.GetRouterId(), traceController),
 	}
 }
 
 func (bindHandler *bindHandler) HandleXgressBind(x *xgress.Xgress) {
 	x.SetDataPlaneAdapter(bindHandler.dataPlaneAdapter)
 	x.AddPeekHandler(bindHandler.metricsPeekHandler)
+	x.AddPeekHandler(bindHandler.tracePeekHandler)
 
 	x.AddCloseHandler(bindHandler.closeHandler)
 
@@ -62,9 +62,10 @@ func (txc *closeHandler) HandleXgressClose(x *xgress.Xgress) {
 		fault.Subject = ctrl_pb.FaultSubject_EgressFault
 	}
 
-	ch := txc.ctrls.GetCtrlChannel(x.CtrlId())
+	ctrlId := txc.forwarder.GetCircuitCtrlId(x.CircuitId(), x.CtrlId())
+	ch := txc.ctrls.GetCtrlChannel(ctrlId)
 	if ch == nil {
-		log.WithField("ctrlId", x.CtrlId()).Error("control channel not available")
+		log.WithField("ctrlId", ctrlId).Error("control channel not available")
 	} else {
 		log.Debug("notifying controller of fault")
 		if err := protobufs.MarshalTyped(fault).Send(ch); err != nil {
@@ -262,6 +262,7 @@ func Create(cfg *env.Config, versionProvider versions.VersionProvider) *Router {
 	router.faulter = forwarder.NewFaulter(router, cfg.Forwarder.FaultTxInterval)
 	router.forwarder = forwarder.NewForwarder(metricsRegistry, router.faulter, cfg.Forwarder, closeNotify)
 	router.forwarder.StartScanner(router.ctrls)
+	router.forwarder.StartRehomer(router.ctrls)
 
 	var err error
 	router.ctrlBindhandler, err = handler_ctrl.NewBindHandler(router, router.forwarder, router)
@@ -271,6 +272,7 @@ func Create(cfg *env.Config, versionProvider versions.VersionProvider) *Router {
 
 	router.xgBindHandler = handler_xgress.NewBindHandler(router, router.createDataPlaneAdapter(),
 		handler_xgress.NewCloseHandler(router.ctrls, router.forwarder),
+		router.forwarder.TraceController(),
 	)
 
 	if err = router.RegisterXrctrl(router.stateManager); err != nil {
@@ -604,6 +606,10 @@ func (self *Router) NotifyCertsUpdated() {
 	self.certManager.CertsUpdated()
 }
 
+func (self *Router) RenewCerts() {
+	self.certManager.RenewNow()
+}
+
 func (self *Router) registerComponents() error {
 	self.xlinkFactories = make(map[string]xlink.Factory)
 	acceptor := newXlinkAccepter(self.forwarder)
@@ -0,0 +1,202 @@
+/*
+	Copyright NetFoundry Inc.
+
+	Licensed under the Apache License, Version 2.0 (the "License");
+	you may not use this file except in compliance with the License.
+	You may obtain a copy of the License at
+
+	https://www.apache.org/licenses/LICENSE-2.0
+
+	Unless required by applicable law or agreed to in writing, software
+	distributed under the License is distributed on an "AS IS" BASIS,
+	WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+	See the License for the specific language governing permissions and
+	limitations under the License.
+*/
+
+// Package shaper enforces bandwidth limits on traffic entering the fabric from edge clients. Limits are
+// distributed in the router data model; each matching limit is backed by a token bucket.
+package shaper
+
+import (
+	"errors"
+	"sync"
+	"time"
+)
+
+var ErrClosed = errors.New("flow closed")
+
+// Bucket is a token bucket which queues waiters in arrival order. Since each circuit only ever has one outstanding
+// wait, this serves circuits sharing a bucket round-robin, so a single busy circuit can't starve the others.
+// Payloads larger than the burst are let through once the bucket is full, leaving the bucket in debt.
+type Bucket struct {
+	lock    sync.Mutex
+	rate    float64
+	burst   float64
+	tokens  float64
+	last    time.Time
+	waiters []*waiter
+	timer   *time.Timer
+}
+
+type waiter struct {
+	n       float64
+	granted bool
+	ready   chan struct{}
+}
+
+// NewBucket returns a full bucket with the given rate, in bytes per second, and burst, in bytes
+func NewBucket(rate, burst int64) *Bucket {
+	if burst <= 0 {
+		burst = rate
+	}
+	return &Bucket{
+		rate:   float64(rate),
+		burst:  float64(burst),
+		tokens: float64(burst),
+		last:   time.Now(),
+	}
+}
+
+// SetLimits updates the rate and burst, keeping the current token count within the new burst
+func (self *Bucket) SetLimits(rate, burst int64) {
+	if burst <= 0 {
+		burst = rate
+	}
+
+	self.lock.Lock()
+	defer self.lock.Unlock()
+
+	self.refillAlreadyLocked(time.Now())
+	self.rate = float64(rate)
+	self.burst = float64(burst)
+	if self.tokens > self.burst {
+		self.tokens = self.burst
+	}
+	self.scheduleAlreadyLocked()
+}
+
+// Wait blocks until n bytes may be sent, or closeNotify is closed
+func (self *Bucket) Wait(n int, closeNotify <-chan struct{}) error {
+	self.lock.Lock()
+	self.refillAlreadyLocked(time.Now())
+	if len(self.waiters) == 0 && self.takeAlreadyLocked(float64(n)) {
+		self.lock.Unlock()
+		return nil
+	}
+
+	w := &waiter{
+		n:     float64(n),
+		ready: make(chan struct{}),
+	}
+	self.waiters = append(self.waiters, w)
+	if len(self.waiters) == 1 {
+		self.scheduleAlreadyLocked()
+	}
+	self.lock.Unlock()
+
+	select {
+	case <-w.ready:
+		return nil
+	case <-closeNotify:
+		self.lock.Lock()
+		defer self.lock.Unlock()
+		if w.granted {
+			return nil
+		}
+		self.removeAlreadyLocked(w)
+		return ErrClosed
+	}
+}
+
+// TryTake takes n bytes from the bucket if they're available now and nobody is waiting, returning whether it did
+func (self *Bucket) TryTake(n int) bool {
+	self.lock.Lock()
+	defer self.lock.Unlock()
+
+	self.refillAlreadyLocked(time.Now())
+	return len(self.waiters) == 0 && self.takeAlreadyLocked(float64(n))
+}
+
+// Return gives back n bytes taken by TryTake which ended up not being sent
+func (self *Bucket) Return(n int) {
+	self.lock.Lock()
+	defer self.lock.Unlock()
+
+	self.tokens += float64(n)
+	if self.tokens > self.burst {
+		self.tokens = self.burst
+	}
+	self.grantAlreadyLocked()
+	self.scheduleAlreadyLocked()
+}
+
+func (self *Bucket) takeAlreadyLocked(n float64) bool {
+	if self.tokens >= min(n, self.burst) {
+		self.tokens -= n
+		return true
+	}
+	return false
+}
+
+func (self *Bucket) refillAlreadyLocked(now time.Time) {
+	elapsed := now.Sub(self.last).Seconds()
+	self.last = now
+	if elapsed > 0 {
+		self.tokens += elapsed * self.rate
+		if self.tokens > self.burst {
+			self.tokens = self.burst
+		}
+	}
+}
+
+func (self *Bucket) grantAlreadyLocked() {
+	for len(self.waiters) > 0 && self.takeAlreadyLocked(self.waiters[0].n) {
+		w := self.waiters[0]
+		self.waiters[0] = nil
+		self.waiters = self.waiters[1:]
+		w.granted = true
+		close(w.ready)
+	}
+}
+
+func (self *Bucket) removeAlreadyLocked(w *waiter) {
+	for i, v := range self.waiters {
+		if v == w {
+			self.waiters = append(self.waiters[:i], self.waiters[i+1:]...)
+			break
+		}
+	}
+	// if the head was removed, the next waiter may be servable now
+	self.grantAlreadyLocked()
+	self.scheduleAlreadyLocked()
+}
+
+// scheduleAlreadyLocked arranges for the bucket to be checked again when the waiter at the head of the
+// queue can be served
+func (self *Bucket) scheduleAlreadyLocked() {
+	if len(self.waiters) == 0 {
+		return
+	}
+
+	needed := min(self.waiters[0].n, self.burst) - self.tokens
+	delay := time.Duration(needed / self.rate * float64(time.Second))
+	if delay < time.Millisecond {
+		delay = time.Millisecond
+	}
+
+	if self.timer == nil {
+		self.timer = time.AfterFunc(delay, self.onTimer)
+	} else {
+		self.timer.Reset(delay)
+	}
+}
+
+func (self *Bucket) onTimer() {
+	self.lock.Lock()
+	defer self.lock.Unlock()
+
+	self.refillAlreadyLocked(time.Now())
+	self.grantAlreadyLocked()
+	self.scheduleAlreadyLocked()
+}
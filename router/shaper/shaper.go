@@ -0,0 +1,211 @@
+/*
+	Copyright NetFoundry Inc.
+
+	Licensed under the Apache License, Version 2.0 (the "License");
+	you may not use this file except in compliance with the License.
+	You may obtain a copy of the License at
+
+	https://www.apache.org/licenses/LICENSE-2.0
+
+	Unless required by applicable law or agreed to in writing, software
+	distributed under the License is distributed on an "AS IS" BASIS,
+	WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+	See the License for the specific language governing permissions and
+	limitations under the License.
+*/
+
+package shaper
+
+import (
+	"sync"
+
+	"github.com/openziti/ziti/common"
+)
+
+// Shaper hands out flows, tracking the buckets backing the bandwidth limits which apply on this router. A shared
+// limit has a single bucket, otherwise each identity matching the limit gets its own.
+type Shaper struct {
+	routerId    string
+	rdmProvider func() *common.RouterDataModel
+
+	lock    sync.Mutex
+	buckets map[bucketKey]*bucketEntry
+}
+
+type bucketKey struct {
+	limitId    string
+	identityId string
+}
+
+type bucketEntry struct {
+	bucket *Bucket
+	refs   int
+}
+
+func New(routerId string, rdmProvider func() *common.RouterDataModel) *Shaper {
+	return &Shaper{
+		routerId:    routerId,
+		rdmProvider: rdmProvider,
+		buckets:     map[bucketKey]*bucketEntry{},
+	}
+}
+
+// NewFlow returns a flow for traffic sent by the given identity for the given service. A nil shaper returns a nil
+// flow, meaning traffic isn't shaped.
+func (self *Shaper) NewFlow(identityId, serviceId string) *Flow {
+	if self == nil {
+		return nil
+	}
+	return &Flow{
+		shaper:      self,
+		identityId:  identityId,
+		serviceId:   serviceId,
+		closeNotify: make(chan struct{}),
+	}
+}
+
+// GetBucketCount returns the number of buckets currently in use
+func (self *Shaper) GetBucketCount() int {
+	self.lock.Lock()
+	defer self.lock.Unlock()
+	return len(self.buckets)
+}
+
+func (self *Shaper) acquire(limit *common.BandwidthLimit, identityId string) (bucketKey, *Bucket) {
+	key := bucketKey{limitId: limit.Id}
+	if !limit.Shared {
+		key.identityId = identityId
+	}
+
+	self.lock.Lock()
+	defer self.lock.Unlock()
+
+	entry, found := self.buckets[key]
+	if !found {
+		entry = &bucketEntry{
+			bucket: NewBucket(limit.Rate, limit.Burst),
+		}
+		self.buckets[key] = entry
+	} else {
+		entry.bucket.SetLimits(limit.Rate, limit.Burst)
+	}
+	entry.refs++
+	return key, entry.bucket
+}
+
+func (self *Shaper) release(keys []bucketKey) {
+	if len(keys) == 0 {
+		return
+	}
+
+	self.lock.Lock()
+	defer self.lock.Unlock()
+
+	for _, key := range keys {
+		if entry, found := self.buckets[key]; found {
+			entry.refs--
+			if entry.refs <= 0 {
+				delete(self.buckets, key)
+			}
+		}
+	}
+}
+
+// Flow applies the bandwidth limits matching a single circuit's ingress traffic. The matching limits are looked up
+// lazily and re-resolved whenever the limits in the router data model change.
+type Flow struct {
+	shaper     *Shaper
+	identityId string
+	serviceId  string
+
+	lock        sync.Mutex
+	rdm         *common.RouterDataModel
+	version     uint64
+	keys        []bucketKey
+	buckets     []*Bucket
+	closed      bool
+	closeNotify chan struct{}
+}
+
+// Wait blocks until n bytes may be sent under all matching limits. It returns ErrClosed if the flow is closed
+// while waiting.
+func (self *Flow) Wait(n int) error {
+	buckets, err := self.getBuckets()
+	if err != nil {
+		return err
+	}
+
+	for _, bucket := range buckets {
+		if err = bucket.Wait(n, self.closeNotify); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// Allow takes n bytes from all matching limits without blocking. If any of them doesn't have enough available,
+// nothing is taken and false is returned.
+func (self *Flow) Allow(n int) bool {
+	buckets, err := self.getBuckets()
+	if err != nil {
+		return false
+	}
+
+	for i, bucket := range buckets {
+		if !bucket.TryTake(n) {
+			for _, taken := range buckets[:i] {
+				taken.Return(n)
+			}
+			return false
+		}
+	}
+	return true
+}
+
+// Close releases the flow's buckets and unblocks any pending Wait
+func (self *Flow) Close() {
+	self.lock.Lock()
+	defer self.lock.Unlock()
+
+	if self.closed {
+		return
+	}
+	self.closed = true
+	close(self.closeNotify)
+	self.shaper.release(self.keys)
+	self.keys = nil
+	self.buckets = nil
+}
+
+func (self *Flow) getBuckets() ([]*Bucket, error) {
+	self.lock.Lock()
+	defer self.lock.Unlock()
+
+	if self.closed {
+		return nil, ErrClosed
+	}
+
+	rdm := self.shaper.rdmProvider()
+	if rdm == nil {
+		return nil, nil
+	}
+
+	version := rdm.GetBandwidthLimitsVersion()
+	if rdm == self.rdm && version == self.version {
+		return self.buckets, nil
+	}
+
+	self.shaper.release(self.keys)
+	self.keys = nil
+	self.buckets = nil
+
+	for _, limit := range rdm.GetMatchingBandwidthLimits(self.shaper.routerId, self.identityId, self.serviceId) {
+		key, bucket := self.shaper.acquire(limit, self.identityId)
+		self.keys = append(self.keys, key)
+		self.buckets = append(self.buckets, bucket)
+	}
+
+	self.rdm = rdm
+	self.version = version
+	return self.buckets, nil
+}
@@ -0,0 +1,189 @@
+/*
+	Copyright NetFoundry Inc.
+
+	Licensed under the Apache License, Version 2.0 (the "License");
+	you may not use this file except in compliance with the License.
+	You may obtain a copy of the License at
+
+	https://www.apache.org/licenses/LICENSE-2.0
+
+	Unless required by applicable law or agreed to in writing, software
+	distributed under the License is distributed on an "AS IS" BASIS,
+	WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+	See the License for the specific language governing permissions and
+	limitations under the License.
+*/
+
+package shaper
+
+import (
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/openziti/ziti/common"
+	"github.com/openziti/ziti/common/pb/edge_ctrl_pb"
+	"github.com/stretchr/testify/require"
+)
+
+func TestBucketBurstAndRate(t *testing.T) {
+	req := require.New(t)
+	bucket := NewBucket(10_000, 1_000)
+
+	req.True(bucket.TryTake(1_000))
+	req.False(bucket.TryTake(500))
+
+	start := time.Now()
+	req.NoError(bucket.Wait(500, nil))
+	elapsed := time.Since(start)
+	req.GreaterOrEqual(elapsed, 40*time.Millisecond)
+	req.Less(elapsed, time.Second)
+}
+
+func TestBucketOversizedPayload(t *testing.T) {
+	req := require.New(t)
+	bucket := NewBucket(100_000, 1_000)
+
+	// larger than the burst is allowed once the bucket is full, leaving it in debt
+	req.True(bucket.TryTake(5_000))
+	req.False(bucket.TryTake(1))
+}
+
+func TestBucketWaitClosed(t *testing.T) {
+	req := require.New(t)
+	bucket := NewBucket(1, 1)
+	req.True(bucket.TryTake(1))
+
+	closeNotify := make(chan struct{})
+	errC := make(chan error, 1)
+	go func() {
+		errC <- bucket.Wait(1_000, closeNotify)
+	}()
+
+	time.Sleep(10 * time.Millisecond)
+	close(closeNotify)
+
+	select {
+	case err := <-errC:
+		req.ErrorIs(err, ErrClosed)
+	case <-time.After(time.Second):
+		req.Fail("wait didn't return after close")
+	}
+
+	bucket.lock.Lock()
+	defer bucket.lock.Unlock()
+	req.Empty(bucket.waiters)
+}
+
+func TestBucketFifo(t *testing.T) {
+	req := require.New(t)
+	bucket := NewBucket(20_000, 100)
+	req.True(bucket.TryTake(100))
+
+	var lock sync.Mutex
+	var order []int
+	wg := sync.WaitGroup{}
+	for i := 0; i < 5; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			req.NoError(bucket.Wait(100, nil))
+			lock.Lock()
+			order = append(order, i)
+			lock.Unlock()
+		}()
+		// ensure waiters queue in a known order
+		time.Sleep(time.Millisecond)
+	}
+	wg.Wait()
+	req.Equal([]int{0, 1, 2, 3, 4}, order)
+}
+
+func newTestRdm(limits ...*edge_ctrl_pb.DataState_BandwidthLimit) *common.RouterDataModel {
+	rdm := common.NewBareRouterDataModel()
+	for i, limit := range limits {
+		rdm.HandleBandwidthLimitEvent(uint64(i+1), &edge_ctrl_pb.DataState_Event{
+			Action: edge_ctrl_pb.DataState_Create,
+		}, &edge_ctrl_pb.DataState_Event_BandwidthLimit{BandwidthLimit: limit})
+	}
+	return rdm
+}
+
+func TestShaperBuckets(t *testing.T) {
+	req := require.New(t)
+
+	rdm := newTestRdm(&edge_ctrl_pb.DataState_BandwidthLimit{
+		Id:            "per-identity",
+		Rate:          1_000,
+		Burst:         1_000,
+		AllIdentities: true,
+		ServiceIds:    []string{"s1"},
+		AllRouters:    true,
+	}, &edge_ctrl_pb.DataState_BandwidthLimit{
+		Id:            "shared",
+		Rate:          1_000,
+		Burst:         1_000,
+		Shared:        true,
+		AllIdentities: true,
+		AllServices:   true,
+		RouterIds:     []string{"r1"},
+	})
+
+	shaper := New("r1", func() *common.RouterDataModel { return rdm })
+
+	f1 := shaper.NewFlow("i1", "s1")
+	f2 := shaper.NewFlow("i1", "s1")
+	f3 := shaper.NewFlow("i2", "s1")
+	f4 := shaper.NewFlow("i2", "s2")
+
+	req.True(f1.Allow(100))
+	req.True(f2.Allow(100))
+	req.True(f3.Allow(100))
+	req.True(f4.Allow(100))
+
+	// per-identity buckets for i1 and i2, plus the shared bucket
+	req.Equal(3, shaper.GetBucketCount())
+
+	// the shared bucket has 600 left, so this fails and shouldn't take from the per-identity bucket
+	req.False(f1.Allow(700))
+	req.True(f2.Allow(600))
+
+	f1.Close()
+	f2.Close()
+	req.Equal(2, shaper.GetBucketCount())
+	req.ErrorIs(f1.Wait(1), ErrClosed)
+
+	f3.Close()
+	f4.Close()
+	req.Equal(0, shaper.GetBucketCount())
+}
+
+func TestShaperLimitChanges(t *testing.T) {
+	req := require.New(t)
+
+	rdm := newTestRdm()
+	shaper := New("r1", func() *common.RouterDataModel { return rdm })
+	flow := shaper.NewFlow("i1", "s1")
+	defer flow.Close()
+
+	req.True(flow.Allow(10_000))
+	req.Equal(0, shaper.GetBucketCount())
+
+	rdm.HandleBandwidthLimitEvent(1, &edge_ctrl_pb.DataState_Event{
+		Action: edge_ctrl_pb.DataState_Create,
+	}, &edge_ctrl_pb.DataState_Event_BandwidthLimit{BandwidthLimit: &edge_ctrl_pb.DataState_BandwidthLimit{
+		Id:          "l1",
+		Rate:        100,
+		Burst:       100,
+		IdentityIds: []string{"i1"},
+		AllServices: true,
+		AllRouters:  true,
+	}})
+
+	req.True(flow.Allow(100))
+	req.False(flow.Allow(100))
+	req.Equal(1, shaper.GetBucketCount())
+
+	var nilShaper *Shaper
+	req.Nil(nilShaper.NewFlow("i1", "s1"))
+}
@@ -55,6 +55,7 @@ type CertExpirationChecker struct {
 	ctrls        routerEnv.NetworkControllers
 	edgeConfig   *routerEnv.EdgeConfig
 	certsUpdated chan struct{}
+	renewNow     chan struct{}
 
 	isRunning atomic.Bool
 
@@ -72,6 +73,7 @@ func NewCertExpirationChecker(env CertEnv) *CertExpirationChecker {
 		ctrls:           env.GetNetworkControllers(),
 		edgeConfig:      env.GetConfig().Edge,
 		certsUpdated:    make(chan struct{}, 1),
+		renewNow:        make(chan struct{}, 1),
 		timeoutDuration: DefaultTimeoutDuration,
 	}
 
@@ -102,11 +104,30 @@ func (self *CertExpirationChecker) CertsUpdated() {
 	self.certsUpdated <- struct{}{}
 }
 
+// RenewNow requests that the certificates be renewed immediately, regardless of when they expire. If a renewal is
+// already in progress, no additional renewal is made
+func (self *CertExpirationChecker) RenewNow() {
+	select {
+	case self.renewNow <- struct{}{}:
+	default:
+	}
+}
+
+func (self *CertExpirationChecker) getRetryInterval() time.Duration {
+	if self.edgeConfig.CertRenewal.RetryInterval > 0 {
+		return self.edgeConfig.CertRenewal.RetryInterval
+	}
+	return routerEnv.DefaultCertRenewalRetryInterval
+}
+
 func (self *CertExpirationChecker) Run() error {
 	if !self.isRunning.CompareAndSwap(false, true) {
 		return errors.New("already running")
 	}
 
+	// after a failed renewal, wait before trying again rather than retrying continuously
+	var retryDelay time.Duration
+
 	for {
 		//if we are already requesting then wait for the request to finish (certsUpdated), we give up based on
 		//timeoutDuration, or we are told to shut down
@@ -114,6 +135,7 @@ func (self *CertExpirationChecker) Run() error {
 			select {
 			case <-self.certsUpdated:
 				self.extender.SetIsRequesting(false)
+				self.drainRenewNow()
 			case <-time.After(self.timeoutDuration):
 				self.extender.SetIsRequesting(false)
 			case <-self.closeNotify:
@@ -132,16 +154,29 @@ func (self *CertExpirationChecker) Run() error {
 			if err != nil {
 				return fmt.Errorf("could not determine enrollent extension wait time: %v", err)
 			}
+
+			if durationToWait < retryDelay {
+				durationToWait = retryDelay
+			}
 		}
+		retryDelay = 0
 
 		pfxlog.Logger().Infof("waiting %s to renew certificates", durationToWait)
 
 		select {
 		case <-self.certsUpdated:
 			self.extender.SetIsRequesting(false)
+			self.drainRenewNow()
 		case <-time.After(durationToWait):
 			if err := self.extender.ExtendEnrollment(); err != nil {
-				pfxlog.Logger().Errorf("could not extend enrollment: %v", err)
+				retryDelay = self.getRetryInterval()
+				pfxlog.Logger().Errorf("could not extend enrollment, retrying in %s: %v", retryDelay, err)
+			}
+		case <-self.renewNow:
+			pfxlog.Logger().Info("renewing certificates on request")
+			if err := self.extender.ExtendEnrollment(); err != nil {
+				retryDelay = self.getRetryInterval()
+				pfxlog.Logger().Errorf("could not extend enrollment, retrying in %s: %v", retryDelay, err)
 			}
 		case <-self.closeNotify:
 			return nil
@@ -149,6 +184,14 @@ func (self *CertExpirationChecker) Run() error {
 	}
 }
 
+// drainRenewNow discards any pending renewal request, as certificates were just renewed
+func (self *CertExpirationChecker) drainRenewNow() {
+	select {
+	case <-self.renewNow:
+	default:
+	}
+}
+
 func (self *CertExpirationChecker) AnyCtrlChannelWithTimeout(timeout time.Duration) (channel.Channel, bool) {
 	startTime := time.Now()
 	interval := 500 * time.Millisecond
@@ -244,13 +287,13 @@ func (self *CertExpirationChecker) getWaitTime() (time.Duration, error) {
 		return 0, fmt.Errorf("client certificate has expired")
 	}
 
-	clientExpirationDuration := self.id.Cert().Leaf.NotAfter.Add(-7 * 24 * time.Hour).Sub(now) //1 week before client cert expires
+	clientExpirationDuration := self.getRenewAt(self.id.Cert().Leaf).Sub(now)
 
 	if clientExpirationDuration < 0 {
 		return 0, nil
 	}
 
-	serverExpirationDuration := self.id.ServerCert()[0].Leaf.NotAfter.Add(-7 * 24 * time.Hour).Sub(now) // 1 week before server cert expires
+	serverExpirationDuration := self.getRenewAt(self.id.ServerCert()[0].Leaf).Sub(now)
 
 	if serverExpirationDuration < 0 {
 		return 0, nil
@@ -263,3 +306,18 @@ func (self *CertExpirationChecker) getWaitTime() (time.Duration, error) {
 
 	return durationToWait, nil
 }
+
+// getRenewAt returns when the given certificate should be renewed. This is 1 week before it expires or, if a lifetime
+// fraction is configured, once that fraction of the certificate lifetime has passed, whichever is earlier
+func (self *CertExpirationChecker) getRenewAt(cert *x509.Certificate) time.Time {
+	renewAt := cert.NotAfter.Add(-7 * 24 * time.Hour)
+
+	if fraction := self.edgeConfig.CertRenewal.LifetimeFraction; fraction > 0 && fraction < 1 {
+		lifetime := cert.NotAfter.Sub(cert.NotBefore)
+		if fractionRenewAt := cert.NotBefore.Add(time.Duration(float64(lifetime) * fraction)); fractionRenewAt.Before(renewAt) {
+			renewAt = fractionRenewAt
+		}
+	}
+
+	return renewAt
+}
@@ -236,6 +236,48 @@ func Test_CertExpirationChecker(t *testing.T) {
 			req.GreaterOrEqual(waitTime, 20*time.Second)
 			req.LessOrEqual(waitTime, 30*time.Second)
 		})
+
+		t.Run("lifetime fraction renews before 7d out", func(t *testing.T) {
+			req := require.New(t)
+			certChecker, _ := newCertChecker()
+			certChecker.edgeConfig.CertRenewal.LifetimeFraction = 0.5
+
+			now := time.Now()
+			notBefore := now.Add(-10 * 24 * time.Hour)
+			notAfter := now.Add(30 * 24 * time.Hour)
+
+			certChecker.id.Cert().Leaf.NotBefore = notBefore
+			certChecker.id.Cert().Leaf.NotAfter = notAfter
+			certChecker.id.ServerCert()[0].Leaf.NotBefore = notBefore
+			certChecker.id.ServerCert()[0].Leaf.NotAfter = notAfter
+
+			waitTime, err := certChecker.getWaitTime()
+
+			req.NoError(err)
+			req.GreaterOrEqual(waitTime, 10*24*time.Hour-time.Second) // half of the 40 day lifetime, 10 days out
+			req.LessOrEqual(waitTime, 10*24*time.Hour)
+		})
+
+		t.Run("lifetime fraction later than 7d out is ignored", func(t *testing.T) {
+			req := require.New(t)
+			certChecker, _ := newCertChecker()
+			certChecker.edgeConfig.CertRenewal.LifetimeFraction = 0.9
+
+			now := time.Now()
+			notBefore := now.Add(-1 * 24 * time.Hour)
+			notAfter := now.Add(9 * 24 * time.Hour)
+
+			certChecker.id.Cert().Leaf.NotBefore = notBefore
+			certChecker.id.Cert().Leaf.NotAfter = notAfter
+			certChecker.id.ServerCert()[0].Leaf.NotBefore = notBefore
+			certChecker.id.ServerCert()[0].Leaf.NotAfter = notAfter
+
+			waitTime, err := certChecker.getWaitTime()
+
+			req.NoError(err)
+			req.GreaterOrEqual(waitTime, 2*24*time.Hour-time.Second) // 1 week before expiry, 2 days out
+			req.LessOrEqual(waitTime, 2*24*time.Hour)
+		})
 	})
 
 	t.Run("Run", func(t *testing.T) {
@@ -271,6 +313,63 @@ func Test_CertExpirationChecker(t *testing.T) {
 			closeF()
 		})
 
+		t.Run("renew now invokes extendFunc", func(t *testing.T) {
+			req := require.New(t)
+			certChecker, closeF := newCertChecker()
+			defer closeF()
+
+			var invoked atomic.Bool
+
+			certChecker.extender = &stubExtender{
+				done: func() error {
+					invoked.Store(true)
+					return nil
+				},
+			}
+
+			go func() {
+				_ = certChecker.Run()
+			}()
+
+			time.Sleep(50 * time.Millisecond)
+			req.False(invoked.Load())
+
+			certChecker.RenewNow()
+
+			req.Eventually(invoked.Load, time.Second, 10*time.Millisecond)
+		})
+
+		t.Run("failed extension is retried after retry interval", func(t *testing.T) {
+			req := require.New(t)
+			certChecker, closeF := newCertChecker()
+			defer closeF()
+			certChecker.edgeConfig.CertRenewal.RetryInterval = 100 * time.Millisecond
+
+			var invocations atomic.Int32
+
+			extender := &stubExtender{}
+			extender.done = func() error {
+				invocations.Add(1)
+				extender.SetIsRequesting(false)
+				return errors.New("test")
+			}
+			certChecker.extender = extender
+
+			//will trigger 0 wait duration
+			certChecker.id.Cert().Leaf.NotAfter = time.Now().AddDate(0, 0, 1)
+
+			go func() {
+				_ = certChecker.Run()
+			}()
+
+			time.Sleep(50 * time.Millisecond)
+			req.Equal(int32(1), invocations.Load())
+
+			req.Eventually(func() bool {
+				return invocations.Load() >= 2
+			}, time.Second, 10*time.Millisecond)
+		})
+
 		t.Run("double run errors", func(t *testing.T) {
 			req := require.New(t)
 			certChecker, closeF := newCertChecker()
@@ -0,0 +1,53 @@
+/*
+	Copyright NetFoundry Inc.
+
+	Licensed under the Apache License, Version 2.0 (the "License");
+	you may not use this file except in compliance with the License.
+	You may obtain a copy of the License at
+
+	https://www.apache.org/licenses/LICENSE-2.0
+
+	Unless required by applicable law or agreed to in writing, software
+	distributed under the License is distributed on an "AS IS" BASIS,
+	WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+	See the License for the specific language governing permissions and
+	limitations under the License.
+*/
+
+package state
+
+import (
+	"github.com/michaelquigley/pfxlog"
+	"github.com/openziti/channel/v4"
+	"github.com/openziti/ziti/common/pb/edge_ctrl_pb"
+	"github.com/openziti/ziti/router/env"
+	"google.golang.org/protobuf/proto"
+)
+
+// extendEnrollmentTriggerHandler handles requests from the controller to renew the router certificates, such as
+// those sent by certificate rotation campaigns
+type extendEnrollmentTriggerHandler struct {
+	routerEnv env.RouterEnv
+}
+
+func NewExtendEnrollmentTriggerHandler(routerEnv env.RouterEnv) *extendEnrollmentTriggerHandler {
+	return &extendEnrollmentTriggerHandler{
+		routerEnv: routerEnv,
+	}
+}
+
+func (h *extendEnrollmentTriggerHandler) ContentType() int32 {
+	return int32(edge_ctrl_pb.ContentType_EnrollmentExtendRouterTriggerType)
+}
+
+func (h *extendEnrollmentTriggerHandler) HandleReceive(msg *channel.Message, ch channel.Channel) {
+	trigger := &edge_ctrl_pb.EnrollmentExtendRouterTrigger{}
+	if err := proto.Unmarshal(msg.Body, trigger); err != nil {
+		pfxlog.Logger().WithError(err).WithField("channel", ch.LogicalName()).Error("could not unmarshal enrollment extension trigger")
+		return
+	}
+
+	pfxlog.Logger().WithField("channel", ch.LogicalName()).WithField("campaignId", trigger.CampaignId).
+		Info("controller requested certificate renewal")
+	h.routerEnv.RenewCerts()
+}
@@ -1384,6 +1384,7 @@ func (sm *ManagerImpl) LoadConfig(_ map[interface{}]interface{}) error {
 func (sm *ManagerImpl) BindChannel(binding channel.Binding) error {
 	binding.AddTypedReceiveHandler(NewHelloHandler(sm, sm.env.GetConfig().Edge.EdgeListeners))
 	binding.AddTypedReceiveHandler(NewExtendEnrollmentCertsHandler(sm.env))
+	binding.AddTypedReceiveHandler(NewExtendEnrollmentTriggerHandler(sm.env))
 
 	binding.AddTypedReceiveHandler(NewSessionRemovedHandler(sm))
 	binding.AddTypedReceiveHandler(NewApiSessionAddedHandler(sm, binding))
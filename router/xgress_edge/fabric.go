@@ -31,6 +31,7 @@ import (
 	"github.com/openziti/sdk-golang/xgress"
 	"github.com/openziti/sdk-golang/ziti/edge"
 	"github.com/openziti/ziti/common/pb/edge_ctrl_pb"
+	"github.com/openziti/ziti/router/shaper"
 	"github.com/openziti/ziti/router/state"
 	"github.com/openziti/ziti/router/xgress_common"
 	"github.com/pkg/errors"
@@ -242,6 +243,7 @@ func (self *edgeTerminator) newConnection(connId uint32) (*edgeXgressConn, error
 		mux:        mux,
 		MsgChannel: *edge.NewEdgeMsgChannel(self.edgeClientConn.ch, connId),
 		seq:        NewMsgQueue(4),
+		flow:       self.edgeClientConn.newFlow(self.serviceSessionToken.ServiceId),
 	}
 
 	if err := mux.Add(result); err != nil {
@@ -272,6 +274,7 @@ type edgeXgressConn struct {
 	onClose func()
 	closed  atomic.Bool
 	ctrlRx  xgress.ControlReceiver
+	flow    *shaper.Flow
 
 	data atomic.Pointer[state.ConnState]
 }
@@ -347,6 +350,12 @@ func (self *edgeXgressConn) ReadPayload() ([]byte, map[uint8][]byte, error) {
 	switch msg.ContentType {
 	case edge.ContentTypeData:
 		log.Debugf("received data message with payload size %v", len(msg.Body))
+		if self.flow != nil {
+			if err := self.flow.Wait(len(msg.Body)); err != nil {
+				log.Debug("flow closed while waiting for bandwidth, returning EOF")
+				return nil, nil, xgress.ErrPeerClosed
+			}
+		}
 		return msg.Body, self.getHeaderMap(msg), nil
 
 	case edge.ContentTypeStateClosed:
@@ -422,6 +431,10 @@ func (self *edgeXgressConn) close(notify bool, reason string) {
 	log.Debug("closing channel sequencer, which should cause xgress to close")
 	self.seq.Close()
 
+	if self.flow != nil {
+		self.flow.Close()
+	}
+
 	// we must close the sequencer first, otherwise we can deadlock. The channel rxer can be blocked submitting
 	// the sequencer and then notify send will then be stuck writing to a partially closed channel.
 	if notify && !self.GetChannel().IsClosed() {
@@ -34,6 +34,7 @@ import (
 	"github.com/openziti/ziti/common/pb/edge_ctrl_pb"
 	"github.com/openziti/ziti/router/env"
 	"github.com/openziti/ziti/router/internal/apiproxy"
+	"github.com/openziti/ziti/router/shaper"
 	"github.com/openziti/ziti/router/state"
 	"github.com/openziti/ziti/router/xgress_router"
 	"github.com/pkg/errors"
@@ -55,6 +56,7 @@ type Factory struct {
 	env                  env.RouterEnv
 	reconnectionHandlers concurrenz.CopyOnWriteSlice[reconnectionHandler]
 	connectionTracker    *connectionTracker
+	shaper               *shaper.Shaper
 }
 
 func (factory *Factory) Inspect(key string, timeout time.Duration) any {
@@ -139,6 +141,7 @@ func NewFactory(routerConfig *env.Config, env env.RouterEnv, stateManager state.
 		metricsRegistry:   env.GetMetricsRegistry(),
 		env:               env,
 		connectionTracker: newConnectionTracker(env),
+		shaper:            shaper.New(env.GetRouterId().Token, stateManager.RouterDataModel),
 	}
 
 	factory.stateManager.SetConnectionTracker(factory.connectionTracker)
@@ -42,6 +42,7 @@ import (
 	"github.com/openziti/ziti/common/pb/edge_ctrl_pb"
 	"github.com/openziti/ziti/controller/idgen"
 	"github.com/openziti/ziti/router/env"
+	"github.com/openziti/ziti/router/shaper"
 	"github.com/openziti/ziti/router/state"
 	"github.com/openziti/ziti/router/xgress_common"
 	"github.com/openziti/ziti/router/xgress_router"
@@ -272,6 +273,11 @@ type edgeClientConn struct {
 	xgCircuits      cmap.ConcurrentMap[string, *xgEdgeForwarder]
 }
 
+// newFlow returns a flow applying the bandwidth limits for traffic this client sends for the given service
+func (self *edgeClientConn) newFlow(serviceId string) *shaper.Flow {
+	return self.listener.factory.shaper.NewFlow(self.getIdentityId(), serviceId)
+}
+
 // getIdentityId safely retrieves the identity ID from the associated API session.
 // This method provides a nil-safe way to access the identity ID, which is used
 // for logging, security operations, and connection tracking. Returns empty string
@@ -381,9 +387,10 @@ func (self *edgeClientConn) cleanupXgressCircuit(edgeForwarder *xgEdgeForwarder)
 	}
 
 	controllers := self.listener.factory.env.GetNetworkControllers()
-	ch := controllers.GetCtrlChannel(edgeForwarder.ctrlId)
+	ctrlId := self.forwarder.GetCircuitCtrlId(circuitId, edgeForwarder.ctrlId)
+	ch := controllers.GetCtrlChannel(ctrlId)
 	if ch == nil {
-		log.WithField("ctrlId", edgeForwarder.ctrlId).Error("control channel not available")
+		log.WithField("ctrlId", ctrlId).Error("control channel not available")
 	} else {
 		log.Debug("notifying controller of fault")
 		if err := protobufs.MarshalTyped(fault).Send(ch); err != nil {
@@ -1231,6 +1238,13 @@ func (self *edgeClientConn) handleXgPayload(msg *channel.Message, ch channel.Cha
 		return
 	}
 
+	// this runs on the channel receive loop, so we can't wait for bandwidth. Over limit payloads are dropped
+	// and will be retransmitted by the sdk, which also backs off its send window
+	if edgeFwd.flow != nil && len(payload.Data) > 0 && !edgeFwd.flow.Allow(len(payload.Data)) {
+		self.listener.droppedPayloadsMeter.Mark(1)
+		return
+	}
+
 	if err = self.forwarder.ForwardPayload(edgeFwd.address, payload, 0); err != nil {
 		if !payload.IsCircuitEndFlagSet() && !payload.IsFlagEOFSet() {
 			pfxlog.Logger().WithFields(payload.GetLoggerFields()).WithError(err).Debug("failed to forward payload")
@@ -1315,6 +1329,7 @@ func (self *nonXgConnectHandler) Init(ctx *connectContext) bool {
 		mux:        ctx.SdkConn.msgMux,
 		MsgChannel: *sdkedge.NewEdgeMsgChannel(ctx.SdkConn.ch, ctx.ConnId),
 		seq:        NewMsgQueue(4),
+		flow:       ctx.SdkConn.newFlow(ctx.ServiceSessionToken.ServiceId),
 	}
 
 	self.conn.SetData(&state.ConnState{
@@ -1371,6 +1386,7 @@ type xgEdgeForwarder struct {
 	connId     uint32
 	metrics    env.XgressMetrics
 	tags       map[string]string
+	flow       *shaper.Flow
 }
 
 func (self *xgEdgeForwarder) GetDestinationType() string {
@@ -1443,6 +1459,7 @@ func (self *xgEdgeForwarder) SendControl(ctrl *xgress.Control) error {
 
 func (self *xgEdgeForwarder) Init(ctx *connectContext) bool {
 	self.connId = ctx.ConnId
+	self.flow = self.newFlow(ctx.ServiceSessionToken.ServiceId)
 	// TODO: figure out how to handle session removed
 	return true
 }
@@ -1457,6 +1474,9 @@ func (self *xgEdgeForwarder) UnregisterRouting() {
 	pfxlog.Logger().WithField("circuitId", self.circuitId).Debug("routing unregistered")
 	self.forwarder.EndCircuit(self.circuitId)
 	self.xgCircuits.Set(self.circuitId, self)
+	if self.flow != nil {
+		self.flow.Close()
+	}
 }
 
 func (self *xgEdgeForwarder) FinishConnect(ctx *connectContext, response *ctrl_msg.CreateCircuitResponse, err error) {
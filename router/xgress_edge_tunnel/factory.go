@@ -32,6 +32,7 @@ import (
 	"github.com/openziti/ziti/router/handler_edge_ctrl"
 	"github.com/openziti/ziti/router/state"
 	"github.com/openziti/ziti/router/xgress_router"
+	"github.com/openziti/ziti/tunnel/intercept/socks"
 	"github.com/pkg/errors"
 )
 
@@ -149,6 +150,9 @@ type Options struct {
 	services         []string
 	udpIdleTimeout   time.Duration
 	udpCheckInterval time.Duration
+	socksAddress     string
+	socksUsers       []*socks.User
+	execHealthChecks bool
 }
 
 func (options *Options) load(data xgress.OptionsData) error {
@@ -213,11 +217,11 @@ func (options *Options) load(data xgress.OptionsData) error {
 		}
 
 		if value, found := data["mode"]; found {
-			if strVal, ok := value.(string); ok && stringz.Contains([]string{"tproxy", "host", "proxy"}, strVal) ||
+			if strVal, ok := value.(string); ok && stringz.Contains([]string{"tproxy", "host", "proxy", "socks"}, strVal) ||
 				strings.HasPrefix(strVal, "tproxy:") {
 				options.mode = strVal
 			} else {
-				return errors.Errorf(`invalid value '%v' for mode, must be one of ["tproxy", "host", "proxy"']`, value)
+				return errors.Errorf(`invalid value '%v' for mode, must be one of ["tproxy", "host", "proxy", "socks"']`, value)
 			}
 		}
 
@@ -267,6 +271,28 @@ func (options *Options) load(data xgress.OptionsData) error {
 			}
 		}
 
+		if value, found := data["execHealthChecks"]; found {
+			if boolVal, ok := value.(bool); ok {
+				options.execHealthChecks = boolVal
+			} else {
+				return errors.Errorf(`invalid value '%v' for execHealthChecks, must be a boolean value`, value)
+			}
+		}
+
+		if value, found := data["socksAddress"]; found {
+			if strVal, ok := value.(string); ok {
+				options.socksAddress = strVal
+			} else {
+				return errors.Errorf(`invalid value '%v' for socksAddress, must be a string value`, value)
+			}
+		}
+
+		if value, found := data["socksUsers"]; found {
+			if options.socksUsers, err = socks.LoadUsers(value); err != nil {
+				return err
+			}
+		}
+
 	}
 
 	return nil
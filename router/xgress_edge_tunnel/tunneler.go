@@ -29,6 +29,7 @@ import (
 	"github.com/openziti/ziti/tunnel/intercept"
 	"github.com/openziti/ziti/tunnel/intercept/host"
 	"github.com/openziti/ziti/tunnel/intercept/proxy"
+	"github.com/openziti/ziti/tunnel/intercept/socks"
 	"github.com/openziti/ziti/tunnel/intercept/tproxy"
 	cmap "github.com/orcaman/concurrent-map/v2"
 	"github.com/pkg/errors"
@@ -109,11 +110,23 @@ func (self *tunneler) Start(notifyClose <-chan struct{}) error {
 		if self.interceptor, err = proxy.New(self.alerter, net.IPv4zero, self.listenOptions.services); err != nil {
 			return errors.Wrap(err, "failed to initialize proxy interceptor")
 		}
+	} else if self.listenOptions.mode == "socks" {
+		log.WithField("mode", self.listenOptions.mode).Info("creating socks interceptor")
+		self.listenOptions.resolver = ""
+		socksConfig := socks.Config{
+			Address:    self.listenOptions.socksAddress,
+			Users:      self.listenOptions.socksUsers,
+			Authorizer: socks.NewRouterDataModelAuthorizer(self.fabricProvider.factory.env.GetRouterDataModel),
+		}
+		if self.interceptor, err = socks.New(socksConfig, self.alerter); err != nil {
+			return errors.Wrap(err, "failed to initialize socks interceptor")
+		}
 	} else {
 		return errors.Errorf("unsupported tunnel mode '%v'", self.listenOptions.mode)
 	}
 
 	self.servicePoller.serviceListener = intercept.NewServiceListener(self.interceptor, resolver)
+	self.servicePoller.serviceListener.EnableExecHealthChecks(self.listenOptions.execHealthChecks)
 	self.servicePoller.serviceListener.HandleProviderReady(self.fabricProvider)
 
 	go self.servicePoller.pollServices(self.listenOptions.svcPollRate, notifyClose)
@@ -35,6 +35,7 @@ import (
 	"github.com/openziti/ziti/tunnel/intercept"
 	"github.com/openziti/ziti/tunnel/intercept/host"
 	"github.com/openziti/ziti/tunnel/intercept/proxy"
+	"github.com/openziti/ziti/tunnel/intercept/socks"
 	"github.com/openziti/ziti/tunnel/intercept/tproxy"
 	"github.com/pkg/errors"
 )
@@ -111,11 +112,23 @@ func (self *tunneler) Start() error {
 		if self.interceptor, err = proxy.New(self.env.GetAlerter(), net.IPv4zero, self.listenOptions.services); err != nil {
 			return errors.Wrap(err, "failed to initialize proxy interceptor")
 		}
+	} else if self.listenOptions.mode == "socks" {
+		log.WithField("mode", self.listenOptions.mode).Info("creating socks interceptor")
+		self.listenOptions.resolver = ""
+		socksConfig := socks.Config{
+			Address:    self.listenOptions.socksAddress,
+			Users:      self.listenOptions.socksUsers,
+			Authorizer: socks.NewRouterDataModelAuthorizer(self.env.GetRouterDataModel),
+		}
+		if self.interceptor, err = socks.New(socksConfig, self.env.GetAlerter()); err != nil {
+			return errors.Wrap(err, "failed to initialize socks interceptor")
+		}
 	} else {
 		return errors.Errorf("unsupported tunnel mode '%v'", self.listenOptions.mode)
 	}
 
 	self.serviceListener = intercept.NewServiceListener(self.interceptor, resolver)
+	self.serviceListener.EnableExecHealthChecks(self.listenOptions.execHealthChecks)
 	self.serviceListener.HandleProviderReady(self.fabricProvider)
 
 	if err = self.env.GetRouterDataModel().SubscribeToIdentityChanges(self.env.GetRouterId().Token, self, true); err != nil {
@@ -147,6 +147,12 @@ type Xlink interface {
 	DuplicatesRejected() uint32
 }
 
+// LatencyProber is implemented by links which can measure the round trip time to the router on the other side
+// of the link on demand
+type LatencyProber interface {
+	ProbeLatency(timeout time.Duration) (time.Duration, error)
+}
+
 type MultiConnXLink interface {
 	Xlink
 	MarkLinkStateSynced(ctrlId string)
@@ -25,6 +25,7 @@ import (
 	"github.com/openziti/channel/v4"
 	"github.com/openziti/transport/v2"
 	"github.com/openziti/ziti/router/link"
+	"github.com/openziti/ziti/router/xlink_transport/fec"
 	"github.com/pkg/errors"
 	"github.com/sirupsen/logrus"
 )
@@ -141,6 +142,20 @@ func loadListenerConfig(data map[interface{}]interface{}) (*listenerConfig, erro
 		config.options = channel.DefaultOptions()
 	}
 
+	if value, found := data["fec"]; found {
+		if submap, ok := value.(map[interface{}]interface{}); ok {
+			fecConfig, err := fec.LoadConfig(submap)
+			if err != nil {
+				return nil, errors.Wrap(err, "failed to parse link listener fec config")
+			}
+			config.fec = fecConfig
+		} else {
+			return nil, fmt.Errorf("invalid 'fec' in listener config (%s)", reflect.TypeOf(value))
+		}
+	} else {
+		config.fec = fec.DefaultConfig()
+	}
+
 	return config, nil
 }
 
@@ -152,6 +167,7 @@ type listenerConfig struct {
 	linkCostTags  []string
 	groups        []string
 	options       *channel.Options
+	fec           *fec.Config
 }
 
 func loadDialerConfig(data map[interface{}]interface{}) (*dialerConfig, error) {
@@ -289,6 +305,20 @@ func loadDialerConfig(data map[interface{}]interface{}) (*dialerConfig, error) {
 		}
 	}
 
+	if value, found := data["fec"]; found {
+		if submap, ok := value.(map[interface{}]interface{}); ok {
+			fecConfig, err := fec.LoadConfig(submap)
+			if err != nil {
+				return nil, errors.Wrap(err, "failed to parse link dialer fec config")
+			}
+			config.fec = fecConfig
+		} else {
+			return nil, fmt.Errorf("invalid 'fec' in dialer config (%s)", reflect.TypeOf(value))
+		}
+	} else {
+		config.fec = fec.DefaultConfig()
+	}
+
 	return config, nil
 }
 
@@ -383,4 +413,5 @@ type dialerConfig struct {
 	options                *channel.Options
 	healthyBackoffConfig   *backoffConfig
 	unhealthyBackoffConfig *backoffConfig
+	fec                    *fec.Config
 }
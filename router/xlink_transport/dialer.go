@@ -28,17 +28,21 @@ import (
 	"github.com/openziti/identity"
 	"github.com/openziti/sdk-golang/xgress"
 	"github.com/openziti/transport/v2"
+	"github.com/openziti/transport/v2/dtls"
 	"github.com/openziti/ziti/common/pb/ctrl_pb"
 	"github.com/openziti/ziti/router/xlink"
+	"github.com/openziti/ziti/router/xlink_transport/fec"
 	"github.com/pkg/errors"
 	"github.com/sirupsen/logrus"
 )
 
 var minMultiUnderlayVersion versions.SemVer
+var minFecVersion versions.SemVer
 var devVersion versions.SemVer
 
 func init() {
 	minMultiUnderlayVersion = *versions.MustParseSemVer("1.6.6")
+	minFecVersion = *versions.MustParseSemVer("1.8.0") // first release with link fec
 	devVersion = *versions.MustParseSemVer("0.0.0")
 }
 
@@ -92,6 +96,15 @@ func (self *dialer) Dial(dial xlink.Dial) (xlink.Xlink, error) {
 	dialRouterVersion, err := versions.ParseSemVer(dial.GetRouterVersion())
 	isDevVersion := err == nil && dialRouterVersion.Equals(&devVersion)
 	supportsMultiUnderlay := err == nil && (dialRouterVersion.CompareTo(&minMultiUnderlayVersion) >= 0)
+	supportsFec := err == nil && (dialRouterVersion.CompareTo(&minFecVersion) >= 0)
+
+	if address.Type() == dtls.Type && self.config.fec.Enabled {
+		if isDevVersion || supportsFec {
+			address = fec.WrapAddress(address, linkId.Token, self.config.fec, self.env.GetMetricsRegistry())
+		} else {
+			log.Info("destination router doesn't support link fec, dialing without it")
+		}
+	}
 
 	var xli xlink.Xlink
 	if isDevVersion || supportsMultiUnderlay {
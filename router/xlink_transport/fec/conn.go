@@ -0,0 +1,610 @@
+/*
+	(c) Copyright NetFoundry Inc.
+
+	Licensed under the Apache License, Version 2.0 (the "License");
+	you may not use this file except in compliance with the License.
+	You may obtain a copy of the License at
+
+	https://www.apache.org/licenses/LICENSE-2.0
+
+	Unless required by applicable law or agreed to in writing, software
+	distributed under the License is distributed on an "AS IS" BASIS,
+	WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+	See the License for the specific language governing permissions and
+	limitations under the License.
+*/
+
+package fec
+
+import (
+	"bytes"
+	"encoding/binary"
+	"errors"
+	"io"
+	"net"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/michaelquigley/pfxlog"
+	"github.com/openziti/metrics"
+	"github.com/openziti/transport/v2"
+)
+
+const (
+	frameTypeData     byte = 1
+	frameTypeParity   byte = 2
+	frameTypeFeedback byte = 3
+	frameTypeControl  byte = 0xfe
+
+	dataHeaderLen     = 6 // type, group (4), index
+	parityHeaderLen   = 8 // type, group (4), count, length xor (2)
+	feedbackFrameLen  = 9 // type, received (4), lost (4)
+	negotiateRetries  = 3
+	groupWindow       = 32
+	maxDatagramLen    = 64 * 1024
+	protocolVersion   = 1
+	controlProposal   = 1
+	controlAccept     = 2
+	controlReject     = 3
+	controlHeaderLen  = 6 // type, 'F', 'E', 'C', version, control type. Proposals are followed by the link id
+	negotiateDeadline = time.Second
+)
+
+const (
+	modeUndecided int32 = iota
+	modePlain
+	modeFec
+)
+
+func controlFrame(controlType byte, payload ...byte) []byte {
+	return append([]byte{frameTypeControl, 'F', 'E', 'C', protocolVersion, controlType}, payload...)
+}
+
+func parseControlFrame(b []byte) (byte, []byte, bool) {
+	if len(b) < controlHeaderLen || !bytes.Equal(b[:controlHeaderLen-1], controlFrame(0)[:controlHeaderLen-1]) {
+		return 0, nil, false
+	}
+	return b[controlHeaderLen-1], b[controlHeaderLen:], true
+}
+
+// conn wraps a datagram connection, where each Write sends a single datagram and each Read returns one
+type conn struct {
+	transport.Conn
+	linkId   string
+	config   *Config
+	registry metrics.Registry
+	metrics  *Metrics // set before fec is started, so it may be used by anything which checks the mode first
+	mode     atomic.Int32
+
+	writeLock sync.Mutex
+	encoder   encoder
+
+	readBuf  []byte
+	decoder  decoder
+	pending  [][]byte
+	closed   atomic.Bool
+	closeC   chan struct{}
+	closeErr error
+	closeMu  sync.Once
+}
+
+func newConn(c transport.Conn, linkId string, config *Config, registry metrics.Registry) *conn {
+	result := &conn{
+		Conn:     c,
+		linkId:   linkId,
+		config:   config,
+		registry: registry,
+		readBuf:  make([]byte, maxDatagramLen),
+		closeC:   make(chan struct{}),
+	}
+	result.encoder.groupSize = config.MaxGroupSize
+	result.decoder.groups = map[uint32]*groupState{}
+	result.decoder.lastFeedback = time.Now()
+	return result
+}
+
+// propose asks the listener to use fec. If the listener rejects the proposal, or doesn't respond, the connection is
+// used without fec.
+func (self *conn) propose(timeout time.Duration) error {
+	if timeout <= 0 || timeout > negotiateDeadline*negotiateRetries {
+		timeout = negotiateDeadline * negotiateRetries
+	}
+	retryInterval := timeout / negotiateRetries
+
+	defer func() {
+		_ = self.Conn.SetReadDeadline(time.Time{})
+	}()
+
+	for attempt := 0; attempt < negotiateRetries; attempt++ {
+		if err := self.writeRaw(controlFrame(controlProposal, []byte(self.linkId)...)); err != nil {
+			return err
+		}
+
+		deadline := time.Now().Add(retryInterval)
+		if err := self.Conn.SetReadDeadline(deadline); err != nil {
+			return err
+		}
+
+		for {
+			n, err := self.Conn.Read(self.readBuf)
+			if err != nil {
+				var netErr net.Error
+				if errors.As(err, &netErr) && netErr.Timeout() {
+					break
+				}
+				return err
+			}
+
+			if controlType, _, ok := parseControlFrame(self.readBuf[:n]); ok {
+				if controlType == controlAccept {
+					self.startFec()
+					return nil
+				}
+				if controlType == controlReject {
+					pfxlog.Logger().WithField("remote", self.RemoteAddr().String()).Info("link fec rejected by peer")
+					self.mode.Store(modePlain)
+					return nil
+				}
+			}
+			// nothing else should be sent before the listener responds, ignore it
+		}
+	}
+
+	// listeners always answer proposals, so the peer is probably a router which doesn't know about fec. It will
+	// treat the proposals as invalid messages, which may fail the link hello, in which case the link is re-dialed.
+	pfxlog.Logger().WithField("remote", self.RemoteAddr().String()).Warn("no response to link fec proposal, continuing without fec")
+	self.mode.Store(modePlain)
+	return nil
+}
+
+func (self *conn) startFec() {
+	linkId := self.linkId
+	if linkId == "" {
+		linkId = self.RemoteAddr().String()
+	}
+	self.metrics = NewMetrics(self.registry, linkId)
+	self.decoder.metrics = self.metrics
+	self.mode.Store(modeFec)
+	go self.flushLoop()
+}
+
+func (self *conn) writeRaw(b []byte) error {
+	self.writeLock.Lock()
+	defer self.writeLock.Unlock()
+	_, err := self.Conn.Write(b)
+	return err
+}
+
+func (self *conn) Write(b []byte) (int, error) {
+	if self.mode.Load() != modeFec {
+		return self.Conn.Write(b)
+	}
+
+	self.writeLock.Lock()
+	defer self.writeLock.Unlock()
+
+	frame := make([]byte, dataHeaderLen+len(b))
+	frame[0] = frameTypeData
+	binary.BigEndian.PutUint32(frame[1:], self.encoder.group)
+	frame[5] = byte(self.encoder.count)
+	copy(frame[dataHeaderLen:], b)
+
+	if _, err := self.Conn.Write(frame); err != nil {
+		return 0, err
+	}
+
+	self.encoder.add(b)
+	if self.encoder.count >= self.encoder.groupSize {
+		if err := self.sendParity(); err != nil {
+			return 0, err
+		}
+	}
+
+	return len(b), nil
+}
+
+// sendParity sends the parity datagram for the current group and starts a new group. Must be called with the
+// write lock held.
+func (self *conn) sendParity() error {
+	if self.encoder.count == 0 {
+		return nil
+	}
+
+	frame := make([]byte, parityHeaderLen+len(self.encoder.parity))
+	frame[0] = frameTypeParity
+	binary.BigEndian.PutUint32(frame[1:], self.encoder.group)
+	frame[5] = byte(self.encoder.count)
+	binary.BigEndian.PutUint16(frame[6:], self.encoder.lengthXor)
+	copy(frame[parityHeaderLen:], self.encoder.parity)
+
+	self.encoder.nextGroup()
+
+	_, err := self.Conn.Write(frame)
+	if err == nil {
+		self.metrics.ParitySent.Mark(1)
+	}
+	return err
+}
+
+// flushLoop sends parity for partially filled groups when no data has been written for a flush interval, so that
+// losses in low traffic or interactive sessions can still be recovered quickly
+func (self *conn) flushLoop() {
+	ticker := time.NewTicker(self.config.FlushInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ticker.C:
+			self.writeLock.Lock()
+			if self.encoder.count > 0 && time.Since(self.encoder.lastWrite) >= self.config.FlushInterval {
+				if err := self.sendParity(); err != nil {
+					pfxlog.Logger().WithError(err).Debug("error sending fec parity")
+				}
+			}
+			self.writeLock.Unlock()
+		case <-self.closeC:
+			return
+		}
+	}
+}
+
+// Read returns the next datagram. Like other datagram connections, a datagram is never split across reads, so if b
+// is too small to hold the next datagram, io.ErrShortBuffer is returned and the datagram is dropped.
+func (self *conn) Read(b []byte) (int, error) {
+	for {
+		if len(self.pending) > 0 {
+			next := self.pending[0]
+			self.pending = self.pending[1:]
+			return deliver(b, next)
+		}
+
+		n, err := self.Conn.Read(self.readBuf)
+		if err != nil {
+			return 0, err
+		}
+		datagram := self.readBuf[:n]
+
+		if controlType, payload, ok := parseControlFrame(datagram); ok {
+			self.handleControl(controlType, payload)
+			continue
+		}
+
+		switch self.mode.Load() {
+		case modeUndecided:
+			// the first datagram isn't a proposal, so the dialer isn't using fec
+			self.mode.Store(modePlain)
+			return deliver(b, datagram)
+		case modePlain:
+			return deliver(b, datagram)
+		}
+
+		if payload := self.handleFecFrame(datagram); payload != nil {
+			return deliver(b, payload)
+		}
+	}
+}
+
+func deliver(b []byte, datagram []byte) (int, error) {
+	if len(b) < len(datagram) {
+		return 0, io.ErrShortBuffer
+	}
+	return copy(b, datagram), nil
+}
+
+func (self *conn) handleControl(controlType byte, payload []byte) {
+	if controlType != controlProposal {
+		return // late duplicate responses to our proposal
+	}
+
+	mode := self.mode.Load()
+	if mode == modeUndecided {
+		if self.config.Enabled {
+			self.linkId = string(payload)
+			self.startFec()
+		} else {
+			self.mode.Store(modePlain)
+		}
+		mode = self.mode.Load()
+	}
+
+	// respond to every proposal, in case earlier responses were lost
+	response := controlFrame(controlReject)
+	if mode == modeFec {
+		response = controlFrame(controlAccept)
+	}
+
+	if err := self.writeRaw(response); err != nil {
+		pfxlog.Logger().WithError(err).Debug("error responding to fec proposal")
+	}
+}
+
+// handleFecFrame processes a received fec frame, returning the payload to deliver, if any. Recovered datagrams are
+// queued in pending.
+func (self *conn) handleFecFrame(frame []byte) []byte {
+	if len(frame) == 0 {
+		return nil
+	}
+
+	var result []byte
+
+	switch frame[0] {
+	case frameTypeData:
+		if len(frame) < dataHeaderLen {
+			return nil
+		}
+		group := binary.BigEndian.Uint32(frame[1:])
+		index := int(frame[5])
+		payload := frame[dataHeaderLen:]
+		isNew, recovered := self.decoder.addData(group, index, payload, self.metrics)
+		if isNew {
+			result = payload
+		}
+		if recovered != nil {
+			self.pending = append(self.pending, recovered)
+		}
+	case frameTypeParity:
+		if len(frame) < parityHeaderLen {
+			return nil
+		}
+		group := binary.BigEndian.Uint32(frame[1:])
+		count := int(frame[5])
+		lengthXor := binary.BigEndian.Uint16(frame[6:])
+		if recovered := self.decoder.addParity(group, count, lengthXor, frame[parityHeaderLen:], self.metrics); recovered != nil {
+			result = recovered
+		}
+	case frameTypeFeedback:
+		if len(frame) >= feedbackFrameLen {
+			received := binary.BigEndian.Uint32(frame[1:])
+			lost := binary.BigEndian.Uint32(frame[5:])
+			self.writeLock.Lock()
+			self.encoder.updateGroupSize(received, lost, self.config)
+			self.writeLock.Unlock()
+		}
+	}
+
+	self.sendFeedbackIfDue()
+
+	return result
+}
+
+func (self *conn) sendFeedbackIfDue() {
+	if time.Since(self.decoder.lastFeedback) < self.config.FeedbackInterval {
+		return
+	}
+
+	received, lost := self.decoder.takeStats()
+	if received == 0 && lost == 0 {
+		return
+	}
+
+	frame := make([]byte, feedbackFrameLen)
+	frame[0] = frameTypeFeedback
+	binary.BigEndian.PutUint32(frame[1:], received)
+	binary.BigEndian.PutUint32(frame[5:], lost)
+	if err := self.writeRaw(frame); err != nil {
+		pfxlog.Logger().WithError(err).Debug("error sending fec feedback")
+	}
+}
+
+func (self *conn) Close() error {
+	self.closeMu.Do(func() {
+		close(self.closeC)
+		self.closeErr = self.Conn.Close()
+		if self.mode.Load() == modeFec {
+			self.metrics.Dispose()
+		}
+	})
+	return self.closeErr
+}
+
+// encoder tracks the group currently being sent
+type encoder struct {
+	group     uint32
+	count     int
+	groupSize int
+	parity    []byte
+	lengthXor uint16
+	lastWrite time.Time
+	lossRate  float64
+}
+
+func (self *encoder) add(b []byte) {
+	if len(b) > len(self.parity) {
+		self.parity = append(self.parity, make([]byte, len(b)-len(self.parity))...)
+	}
+	for i, v := range b {
+		self.parity[i] ^= v
+	}
+	self.lengthXor ^= uint16(len(b))
+	self.count++
+	self.lastWrite = time.Now()
+}
+
+func (self *encoder) nextGroup() {
+	self.group++
+	self.count = 0
+	self.parity = nil
+	self.lengthXor = 0
+}
+
+// updateGroupSize adjusts the group size based on the loss reported by the peer. A parity datagram can repair a
+// single loss per group, so groups are sized to expect about half a lost datagram each.
+func (self *encoder) updateGroupSize(received, lost uint32, config *Config) {
+	total := received + lost
+	if total == 0 {
+		return
+	}
+
+	// smooth the reported loss rate, so a single bad interval doesn't cause large swings
+	rate := float64(lost) / float64(total)
+	self.lossRate = 0.7*self.lossRate + 0.3*rate
+
+	// clamp before converting, as the rate decays towards zero and 0.5/rate overflows an int
+	groupSize := config.MaxGroupSize
+	if target := 0.5 / self.lossRate; self.lossRate > 0 && target < float64(config.MaxGroupSize) {
+		groupSize = int(target)
+	}
+	self.groupSize = max(groupSize, config.MinGroupSize)
+}
+
+type groupState struct {
+	received  uint64 // bitmap of received or recovered indexes
+	count     int    // number of datagrams in the group, known once parity arrives
+	maxIndex  int
+	xor       []byte // xor of received payloads
+	lengthXor uint16
+	parity    []byte
+	recovered bool
+}
+
+func (self *groupState) receivedCount() int {
+	result := 0
+	for v := self.received; v != 0; v &= v - 1 {
+		result++
+	}
+	return result
+}
+
+// decoder tracks received groups, recovering single losses when possible
+type decoder struct {
+	metrics      *Metrics
+	groups       map[uint32]*groupState
+	latest       uint32
+	hasLatest    bool
+	received     uint32
+	lost         uint32
+	lastFeedback time.Time
+}
+
+func (self *decoder) getGroup(group uint32) *groupState {
+	if state, found := self.groups[group]; found {
+		return state
+	}
+
+	if self.hasLatest && int32(group-self.latest) <= -groupWindow {
+		return nil // too old, no longer tracked
+	}
+
+	state := &groupState{maxIndex: -1}
+	self.groups[group] = state
+
+	if !self.hasLatest || int32(group-self.latest) > 0 {
+		self.latest = group
+		self.hasLatest = true
+		for key, old := range self.groups {
+			if int32(self.latest-key) >= groupWindow {
+				self.finish(old)
+				delete(self.groups, key)
+			}
+		}
+	}
+
+	return state
+}
+
+// finish records the final statistics of a group which is no longer tracked. The loss reported back to the sender
+// includes recovered datagrams, since it measures the link rather than how well fec is doing.
+func (self *decoder) finish(state *groupState) {
+	count := state.count
+	if count == 0 {
+		count = state.maxIndex + 1 // parity was lost, so the best we know is the highest index seen
+	}
+
+	delivered := state.receivedCount()
+	if delivered < count {
+		self.metrics.Lost.Mark(int64(count - delivered))
+	}
+
+	received := delivered
+	if state.recovered {
+		received--
+	}
+	self.received += uint32(received)
+	if count > received {
+		self.lost += uint32(count - received)
+	}
+}
+
+// addData records a data datagram. It returns whether the datagram should be delivered and, if it completed a
+// recoverable group, the recovered datagram.
+func (self *decoder) addData(group uint32, index int, payload []byte, metrics *Metrics) (bool, []byte) {
+	if index >= MaxGroupSize {
+		return true, nil
+	}
+
+	state := self.getGroup(group)
+	if state == nil {
+		return true, nil // group no longer tracked, deliver without recovery
+	}
+
+	bit := uint64(1) << uint(index)
+	if state.received&bit != 0 {
+		return false, nil // duplicate, or already recovered
+	}
+
+	state.received |= bit
+	state.maxIndex = max(state.maxIndex, index)
+	if len(payload) > len(state.xor) {
+		state.xor = append(state.xor, make([]byte, len(payload)-len(state.xor))...)
+	}
+	for i, v := range payload {
+		state.xor[i] ^= v
+	}
+	state.lengthXor ^= uint16(len(payload))
+
+	return true, self.tryRecover(state, metrics)
+}
+
+// addParity records a parity datagram, returning the recovered datagram if exactly one datagram in the group was lost
+func (self *decoder) addParity(group uint32, count int, lengthXor uint16, parity []byte, metrics *Metrics) []byte {
+	state := self.getGroup(group)
+	if state == nil || state.count != 0 || count == 0 || count > MaxGroupSize {
+		return nil
+	}
+
+	state.count = count
+	state.parity = append([]byte(nil), parity...)
+	state.lengthXor ^= lengthXor
+
+	return self.tryRecover(state, metrics)
+}
+
+func (self *decoder) tryRecover(state *groupState, metrics *Metrics) []byte {
+	if state.count == 0 || state.recovered || state.receivedCount() != state.count-1 {
+		return nil
+	}
+
+	missing := -1
+	for i := 0; i < state.count; i++ {
+		if state.received&(uint64(1)<<uint(i)) == 0 {
+			missing = i
+			break
+		}
+	}
+
+	// the xor of the parity and all received payloads is the missing payload, and likewise for the lengths
+	length := int(state.lengthXor)
+	if missing < 0 || length > len(state.parity) {
+		return nil
+	}
+
+	result := make([]byte, length)
+	copy(result, state.parity)
+	for i := 0; i < length && i < len(state.xor); i++ {
+		result[i] ^= state.xor[i]
+	}
+
+	state.received |= uint64(1) << uint(missing)
+	state.recovered = true
+	metrics.Recovered.Mark(1)
+
+	return result
+}
+
+func (self *decoder) takeStats() (uint32, uint32) {
+	received, lost := self.received, self.lost
+	self.received, self.lost = 0, 0
+	self.lastFeedback = time.Now()
+	return received, lost
+}
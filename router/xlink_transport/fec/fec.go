@@ -0,0 +1,217 @@
+/*
+	(c) Copyright NetFoundry Inc.
+
+	Licensed under the Apache License, Version 2.0 (the "License");
+	you may not use this file except in compliance with the License.
+	You may obtain a copy of the License at
+
+	https://www.apache.org/licenses/LICENSE-2.0
+
+	Unless required by applicable law or agreed to in writing, software
+	distributed under the License is distributed on an "AS IS" BASIS,
+	WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+	See the License for the specific language governing permissions and
+	limitations under the License.
+*/
+
+// Package fec adds forward error correction to datagram based links, such as dtls links. Datagrams are sent in
+// groups, followed by a parity datagram, which is the XOR of the group's datagrams. A receiver missing a single
+// datagram from a group can rebuild it from the parity datagram, without waiting for end-to-end retransmission.
+//
+// Receivers periodically report the loss they observe. Senders use it to size groups, sending parity more often as
+// loss increases. FEC is proposed by the dialer when a link connection is established, and is only used if the
+// listener accepts.
+package fec
+
+import (
+	"fmt"
+	"io"
+	"reflect"
+	"time"
+
+	"github.com/openziti/identity"
+	"github.com/openziti/metrics"
+	"github.com/openziti/transport/v2"
+	"github.com/pkg/errors"
+)
+
+const (
+	DefaultMinGroupSize     = 4
+	DefaultMaxGroupSize     = 32
+	DefaultFlushInterval    = 20 * time.Millisecond
+	DefaultFeedbackInterval = time.Second
+
+	MinGroupSize = 2
+	MaxGroupSize = 64
+
+	MetricParitySent = "fec.parity_sent"
+	MetricRecovered  = "fec.recovered"
+	MetricLost       = "fec.lost"
+)
+
+type Config struct {
+	Enabled          bool
+	MinGroupSize     int
+	MaxGroupSize     int
+	FlushInterval    time.Duration
+	FeedbackInterval time.Duration
+}
+
+func DefaultConfig() *Config {
+	return &Config{
+		MinGroupSize:     DefaultMinGroupSize,
+		MaxGroupSize:     DefaultMaxGroupSize,
+		FlushInterval:    DefaultFlushInterval,
+		FeedbackInterval: DefaultFeedbackInterval,
+	}
+}
+
+// LoadConfig loads the fec section of a link listener or dialer configuration
+func LoadConfig(data map[interface{}]interface{}) (*Config, error) {
+	config := DefaultConfig()
+
+	if value, found := data["enabled"]; found {
+		if enabled, ok := value.(bool); ok {
+			config.Enabled = enabled
+		} else {
+			return nil, errors.Errorf("invalid 'enabled' flag in fec config (%s)", reflect.TypeOf(value))
+		}
+	}
+
+	if err := loadGroupSize(data, "minGroupSize", &config.MinGroupSize); err != nil {
+		return nil, err
+	}
+
+	if err := loadGroupSize(data, "maxGroupSize", &config.MaxGroupSize); err != nil {
+		return nil, err
+	}
+
+	if config.MinGroupSize > config.MaxGroupSize {
+		return nil, errors.Errorf("fec minGroupSize (%d) is larger than maxGroupSize (%d)", config.MinGroupSize, config.MaxGroupSize)
+	}
+
+	if err := loadDuration(data, "flushInterval", &config.FlushInterval); err != nil {
+		return nil, err
+	}
+
+	if err := loadDuration(data, "feedbackInterval", &config.FeedbackInterval); err != nil {
+		return nil, err
+	}
+
+	return config, nil
+}
+
+func loadGroupSize(data map[interface{}]interface{}, key string, target *int) error {
+	if value, found := data[key]; found {
+		intVal, ok := value.(int)
+		if !ok {
+			return errors.Errorf("invalid '%s' setting in fec config, is (%s), should be integer number", key, reflect.TypeOf(value))
+		}
+		if intVal < MinGroupSize || intVal > MaxGroupSize {
+			return errors.Errorf("invalid '%s' setting in fec config, is (%d), must be between %d and %d", key, intVal, MinGroupSize, MaxGroupSize)
+		}
+		*target = intVal
+	}
+	return nil
+}
+
+func loadDuration(data map[interface{}]interface{}, key string, target *time.Duration) error {
+	if value, found := data[key]; found {
+		strVal, ok := value.(string)
+		if !ok {
+			return errors.Errorf("invalid '%s' setting in fec config, is (%s), should be string duration", key, reflect.TypeOf(value))
+		}
+		d, err := time.ParseDuration(strVal)
+		if err != nil {
+			return fmt.Errorf("invalid '%s' setting in fec config, should be string duration (%w)", key, err)
+		}
+		if d <= 0 {
+			return errors.Errorf("invalid '%s' setting in fec config, must be greater than 0", key)
+		}
+		*target = d
+	}
+	return nil
+}
+
+type Metrics struct {
+	ParitySent metrics.Meter
+	Recovered  metrics.Meter
+	Lost       metrics.Meter
+}
+
+// NewMetrics creates the fec meters for a link, named link.<link id>.fec.*, alongside the link's other metrics
+func NewMetrics(registry metrics.Registry, linkId string) *Metrics {
+	prefix := "link." + linkId + "."
+	return &Metrics{
+		ParitySent: registry.Meter(prefix + MetricParitySent),
+		Recovered:  registry.Meter(prefix + MetricRecovered),
+		Lost:       registry.Meter(prefix + MetricLost),
+	}
+}
+
+func (self *Metrics) Dispose() {
+	self.ParitySent.Dispose()
+	self.Recovered.Dispose()
+	self.Lost.Dispose()
+}
+
+// WrapAddress returns an address which negotiates FEC on connections it dials, if enabled in the config, and which
+// accepts FEC on connections it accepts, if enabled and proposed by the dialer. Connections which don't negotiate
+// FEC pass datagrams through unchanged.
+//
+// The link id is sent with the proposal, so that both routers report fec metrics for the link under the same name.
+// Listeners don't know which link a connection belongs to until it's proposed, so they pass an empty link id.
+func WrapAddress(address transport.Address, linkId string, config *Config, registry metrics.Registry) transport.Address {
+	return &fecAddress{
+		Address:  address,
+		linkId:   linkId,
+		config:   config,
+		registry: registry,
+	}
+}
+
+type fecAddress struct {
+	transport.Address
+	linkId   string
+	config   *Config
+	registry metrics.Registry
+}
+
+func (self *fecAddress) Dial(name string, i *identity.TokenId, timeout time.Duration, tcfg transport.Configuration) (transport.Conn, error) {
+	conn, err := self.Address.Dial(name, i, timeout, tcfg)
+	if err != nil {
+		return nil, err
+	}
+	return self.negotiate(conn, timeout)
+}
+
+func (self *fecAddress) DialWithLocalBinding(name string, binding string, i *identity.TokenId, timeout time.Duration, tcfg transport.Configuration) (transport.Conn, error) {
+	conn, err := self.Address.DialWithLocalBinding(name, binding, i, timeout, tcfg)
+	if err != nil {
+		return nil, err
+	}
+	return self.negotiate(conn, timeout)
+}
+
+func (self *fecAddress) negotiate(conn transport.Conn, timeout time.Duration) (transport.Conn, error) {
+	result := newConn(conn, self.linkId, self.config, self.registry)
+	if err := result.propose(timeout); err != nil {
+		_ = conn.Close()
+		return nil, err
+	}
+	return result, nil
+}
+
+func (self *fecAddress) Listen(name string, i *identity.TokenId, acceptF func(transport.Conn), tcfg transport.Configuration) (io.Closer, error) {
+	return self.Address.Listen(name, i, func(conn transport.Conn) {
+		acceptF(newConn(conn, "", self.config, self.registry))
+	}, tcfg)
+}
+
+func (self *fecAddress) MustListen(name string, i *identity.TokenId, acceptF func(transport.Conn), tcfg transport.Configuration) io.Closer {
+	closer, err := self.Listen(name, i, acceptF, tcfg)
+	if err != nil {
+		panic(err)
+	}
+	return closer
+}
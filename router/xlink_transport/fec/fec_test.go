@@ -0,0 +1,277 @@
+package fec
+
+import (
+	"crypto/x509"
+	"fmt"
+	"io"
+	"net"
+	"os"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/openziti/metrics"
+	"github.com/openziti/transport/v2"
+	"github.com/stretchr/testify/require"
+)
+
+type testAddr string
+
+func (self testAddr) Network() string { return "test" }
+func (self testAddr) String() string  { return string(self) }
+
+// testConn is one end of an in memory datagram connection, which can drop outgoing datagrams
+type testConn struct {
+	in       chan []byte
+	out      chan []byte
+	drop     func([]byte) bool
+	lock     sync.Mutex
+	deadline time.Time
+	closed   chan struct{}
+	once     sync.Once
+}
+
+func newTestConnPair() (*testConn, *testConn) {
+	a := make(chan []byte, 1024)
+	b := make(chan []byte, 1024)
+	return &testConn{in: a, out: b, closed: make(chan struct{})}, &testConn{in: b, out: a, closed: make(chan struct{})}
+}
+
+func (self *testConn) Read(b []byte) (int, error) {
+	self.lock.Lock()
+	deadline := self.deadline
+	self.lock.Unlock()
+
+	var timeoutC <-chan time.Time
+	if !deadline.IsZero() {
+		timer := time.NewTimer(time.Until(deadline))
+		defer timer.Stop()
+		timeoutC = timer.C
+	}
+
+	select {
+	case datagram := <-self.in:
+		return copy(b, datagram), nil
+	case <-timeoutC:
+		return 0, os.ErrDeadlineExceeded
+	case <-self.closed:
+		return 0, net.ErrClosed
+	}
+}
+
+func (self *testConn) Write(b []byte) (int, error) {
+	datagram := append([]byte(nil), b...)
+	if self.drop != nil && self.drop(datagram) {
+		return len(b), nil
+	}
+	self.out <- datagram
+	return len(b), nil
+}
+
+func (self *testConn) Close() error {
+	self.once.Do(func() { close(self.closed) })
+	return nil
+}
+
+func (self *testConn) SetReadDeadline(t time.Time) error {
+	self.lock.Lock()
+	defer self.lock.Unlock()
+	self.deadline = t
+	return nil
+}
+
+func (self *testConn) LocalAddr() net.Addr                   { return testAddr("local") }
+func (self *testConn) RemoteAddr() net.Addr                  { return testAddr("remote") }
+func (self *testConn) SetDeadline(t time.Time) error         { return self.SetReadDeadline(t) }
+func (self *testConn) SetWriteDeadline(time.Time) error      { return nil }
+func (self *testConn) Detail() *transport.ConnectionDetail   { return &transport.ConnectionDetail{} }
+func (self *testConn) PeerCertificates() []*x509.Certificate { return nil }
+
+// connect negotiates between a dialer and listener, returning the wrapped connections and a channel of datagrams
+// read by the listener
+func connect(t *testing.T, dialerConfig, listenerConfig *Config, dialerConn, listenerConn *testConn) (*conn, *conn, chan string) {
+	dialer := newConn(dialerConn, "link1", dialerConfig, metrics.NewRegistry("dialer", nil))
+	listener := newConn(listenerConn, "", listenerConfig, metrics.NewRegistry("listener", nil))
+
+	received := make(chan string, 1024)
+	go func() {
+		buf := make([]byte, maxDatagramLen)
+		for {
+			n, err := listener.Read(buf)
+			if err != nil {
+				return
+			}
+			received <- string(buf[:n])
+		}
+	}()
+
+	require.NoError(t, dialer.propose(3*time.Second))
+	return dialer, listener, received
+}
+
+func collect(t *testing.T, received chan string, count int) map[string]bool {
+	result := map[string]bool{}
+	for len(result) < count {
+		select {
+		case msg := <-received:
+			require.False(t, result[msg], "duplicate datagram %s", msg)
+			result[msg] = true
+		case <-time.After(2 * time.Second):
+			require.Failf(t, "timed out", "received %d of %d datagrams", len(result), count)
+		}
+	}
+	return result
+}
+
+func Test_RecoversSingleLossPerGroup(t *testing.T) {
+	req := require.New(t)
+
+	config := DefaultConfig()
+	config.Enabled = true
+	config.MinGroupSize = 4
+	config.MaxGroupSize = 4
+	config.FlushInterval = 10 * time.Millisecond
+
+	dialerConn, listenerConn := newTestConnPair()
+	dialerConn.drop = func(b []byte) bool {
+		// drop the second data datagram of every group
+		return b[0] == frameTypeData && b[5] == 1
+	}
+
+	dialer, listener, received := connect(t, config, config, dialerConn, listenerConn)
+	defer func() { _ = dialer.Close() }()
+	defer func() { _ = listener.Close() }()
+
+	req.Equal(modeFec, dialer.mode.Load())
+
+	// 10 datagrams fills two groups, the last partial group is sent by the flush
+	for i := 0; i < 10; i++ {
+		_, err := dialer.Write([]byte(fmt.Sprintf("datagram-%d-%s", i, string(make([]byte, i)))))
+		req.NoError(err)
+	}
+
+	result := collect(t, received, 10)
+	for i := 0; i < 10; i++ {
+		req.True(result[fmt.Sprintf("datagram-%d-%s", i, string(make([]byte, i)))])
+	}
+	req.Equal(int64(3), listener.metrics.Recovered.Count())
+	req.Equal(modeFec, listener.mode.Load())
+
+	// the listener names its metrics using the link id from the proposal
+	req.Equal("link1", listener.linkId)
+	req.Equal(int64(3), listener.registry.Meter("link.link1."+MetricRecovered).Count())
+	req.Equal(int64(3), dialer.registry.Meter("link.link1."+MetricParitySent).Count())
+}
+
+func Test_RejectedProposalFallsBackToPlain(t *testing.T) {
+	req := require.New(t)
+
+	dialerConfig := DefaultConfig()
+	dialerConfig.Enabled = true
+
+	dialerConn, listenerConn := newTestConnPair()
+	dialer, listener, received := connect(t, dialerConfig, DefaultConfig(), dialerConn, listenerConn)
+	defer func() { _ = dialer.Close() }()
+	defer func() { _ = listener.Close() }()
+
+	req.Equal(modePlain, dialer.mode.Load())
+
+	_, err := dialer.Write([]byte("hello"))
+	req.NoError(err)
+	req.True(collect(t, received, 1)["hello"])
+}
+
+func Test_ListenerPassesThroughWithoutProposal(t *testing.T) {
+	req := require.New(t)
+
+	config := DefaultConfig()
+	config.Enabled = true
+
+	dialerConn, listenerConn := newTestConnPair()
+	listener := newConn(listenerConn, "", config, metrics.NewRegistry("test", nil))
+	defer func() { _ = listener.Close() }()
+
+	_, err := dialerConn.Write([]byte{frameTypeData, 1, 2, 3})
+	req.NoError(err)
+
+	buf := make([]byte, 1024)
+	n, err := listener.Read(buf)
+	req.NoError(err)
+	req.Equal([]byte{frameTypeData, 1, 2, 3}, buf[:n])
+	req.Equal(modePlain, listener.mode.Load())
+}
+
+func Test_UnansweredProposalFallsBackToPlain(t *testing.T) {
+	req := require.New(t)
+
+	config := DefaultConfig()
+	config.Enabled = true
+
+	dialerConn, listenerConn := newTestConnPair()
+	dialer := newConn(dialerConn, "link1", config, metrics.NewRegistry("test", nil))
+	defer func() { _ = dialer.Close() }()
+
+	// nothing reads the listener side, as with a router which doesn't support fec
+	req.NoError(dialer.propose(300 * time.Millisecond))
+	req.Equal(modePlain, dialer.mode.Load())
+
+	_, err := dialer.Write([]byte("hello"))
+	req.NoError(err)
+
+	var last []byte
+	for len(listenerConn.in) > 0 {
+		last = <-listenerConn.in
+	}
+	req.Equal([]byte("hello"), last)
+}
+
+func Test_ReadIntoShortBuffer(t *testing.T) {
+	req := require.New(t)
+
+	dialerConn, listenerConn := newTestConnPair()
+	listener := newConn(listenerConn, "", DefaultConfig(), metrics.NewRegistry("test", nil))
+	defer func() { _ = listener.Close() }()
+
+	_, err := dialerConn.Write([]byte("too long"))
+	req.NoError(err)
+	_, err = dialerConn.Write([]byte("ok"))
+	req.NoError(err)
+
+	buf := make([]byte, 4)
+	_, err = listener.Read(buf)
+	req.ErrorIs(err, io.ErrShortBuffer)
+
+	n, err := listener.Read(buf)
+	req.NoError(err)
+	req.Equal("ok", string(buf[:n]))
+}
+
+func Test_GroupSizeAdaptsToLoss(t *testing.T) {
+	req := require.New(t)
+
+	config := DefaultConfig()
+	e := &encoder{groupSize: config.MaxGroupSize}
+
+	e.updateGroupSize(1000, 0, config)
+	req.Equal(config.MaxGroupSize, e.groupSize)
+
+	for i := 0; i < 20; i++ {
+		e.updateGroupSize(900, 100, config)
+	}
+	req.Equal(5, e.groupSize)
+
+	for i := 0; i < 20; i++ {
+		e.updateGroupSize(500, 500, config)
+	}
+	req.Equal(config.MinGroupSize, e.groupSize)
+
+	// once loss stops, the smoothed rate decays towards zero, and eventually underflows. The group size must grow
+	// back to the maximum and stay there.
+	for i := 0; i < 5000; i++ {
+		e.updateGroupSize(1000, 0, config)
+		if i >= 50 {
+			req.Equal(config.MaxGroupSize, e.groupSize, "iteration %d, loss rate %v", i, e.lossRate)
+		}
+	}
+	req.Less(e.lossRate, 1e-300)
+}
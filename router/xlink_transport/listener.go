@@ -27,8 +27,10 @@ import (
 	"github.com/openziti/identity"
 	"github.com/openziti/sdk-golang/xgress"
 	"github.com/openziti/transport/v2"
+	"github.com/openziti/transport/v2/dtls"
 	fabricMetrics "github.com/openziti/ziti/common/metrics"
 	"github.com/openziti/ziti/router/xlink"
+	"github.com/openziti/ziti/router/xlink_transport/fec"
 	"github.com/pkg/errors"
 	"github.com/sirupsen/logrus"
 )
@@ -57,8 +59,14 @@ func (self *listener) Listen() error {
 
 	acceptor := channel.NewMultiListener(self.handleGroupedUnderlay, self.handleUngroupedNewUnderlay)
 
+	bind := self.config.bind
+	if bind.Type() == dtls.Type {
+		// always wrap datagram listeners, so fec proposals from dialers are answered even when fec is disabled here
+		bind = fec.WrapAddress(bind, "", self.config.fec, self.env.GetMetricsRegistry())
+	}
+
 	var err error
-	if self.listener, err = channel.NewClassicListenerF(self.id, self.config.bind, config, acceptor.AcceptUnderlay); err != nil {
+	if self.listener, err = channel.NewClassicListenerF(self.id, bind, config, acceptor.AcceptUnderlay); err != nil {
 		return fmt.Errorf("error listening (%w)", err)
 	}
 
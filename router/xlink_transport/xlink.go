@@ -26,6 +26,7 @@ import (
 	"github.com/openziti/ziti/common/inspect"
 	"github.com/openziti/ziti/common/pb/ctrl_pb"
 	"github.com/openziti/ziti/router/xlink"
+	"github.com/pkg/errors"
 )
 
 type impl struct {
@@ -109,6 +110,22 @@ func (self *impl) SendControl(msg *xgress.Control) error {
 	return err
 }
 
+// ProbeLatency sends a latency request over the link and waits for the other router to respond to it
+func (self *impl) ProbeLatency(timeout time.Duration) (time.Duration, error) {
+	start := time.Now()
+	msg := channel.NewMessage(channel.ContentTypeLatencyType, nil)
+	reply, err := msg.WithPriority(channel.High).WithTimeout(timeout).SendForReply(self.ch.GetChannel())
+	if err != nil {
+		return 0, err
+	}
+	if reply.ContentType == channel.ContentTypeResultType {
+		if result := channel.UnmarshalResult(reply); !result.Success {
+			return 0, errors.Errorf("latency request failed: %s", result.Message)
+		}
+	}
+	return time.Since(start), nil
+}
+
 func (self *impl) Close() error {
 	self.droppedMsgMeter.Dispose()
 	return self.ch.GetChannel().Close()
@@ -37,6 +37,10 @@ type ServiceConfig struct {
 	Port       int
 	PortChecks []*health.PortCheckDefinition
 	HttpChecks []*health.HttpCheckDefinition
+	TlsChecks  []*health.TlsCheckDefinition
+	DnsChecks  []*health.DnsCheckDefinition
+	GrpcChecks []*health.GrpcCheckDefinition
+	ExecChecks []*health.ExecCheckDefinition
 }
 
 func (self *ServiceConfig) GetPortChecks() []*health.PortCheckDefinition {
@@ -47,6 +51,22 @@ func (self *ServiceConfig) GetHttpChecks() []*health.HttpCheckDefinition {
 	return self.HttpChecks
 }
 
+func (self *ServiceConfig) GetTlsChecks() []*health.TlsCheckDefinition {
+	return self.TlsChecks
+}
+
+func (self *ServiceConfig) GetDnsChecks() []*health.DnsCheckDefinition {
+	return self.DnsChecks
+}
+
+func (self *ServiceConfig) GetGrpcChecks() []*health.GrpcCheckDefinition {
+	return self.GrpcChecks
+}
+
+func (self *ServiceConfig) GetExecChecks() []*health.ExecCheckDefinition {
+	return self.ExecChecks
+}
+
 func (s *ServiceConfig) String() string {
 	return fmt.Sprintf("%v:%v:%v", s.Protocol, s.Hostname, s.Port)
 }
@@ -66,6 +86,10 @@ func (self *ServiceConfig) ToHostV2Config() *HostV2Config {
 		Port:       self.Port,
 		PortChecks: self.PortChecks,
 		HttpChecks: self.HttpChecks,
+		TlsChecks:  self.TlsChecks,
+		DnsChecks:  self.DnsChecks,
+		GrpcChecks: self.GrpcChecks,
+		ExecChecks: self.ExecChecks,
 	}
 
 	return &HostV2Config{
@@ -124,6 +148,10 @@ type HostV1Config struct {
 
 	PortChecks []*health.PortCheckDefinition
 	HttpChecks []*health.HttpCheckDefinition
+	TlsChecks  []*health.TlsCheckDefinition
+	DnsChecks  []*health.DnsCheckDefinition
+	GrpcChecks []*health.GrpcCheckDefinition
+	ExecChecks []*health.ExecCheckDefinition
 
 	ListenOptions *HostV1ListenOptions
 	Proxy         *ProxyConfiguration
@@ -256,6 +284,22 @@ func (self *HostV1Config) GetHttpChecks() []*health.HttpCheckDefinition {
 	return self.HttpChecks
 }
 
+func (self *HostV1Config) GetTlsChecks() []*health.TlsCheckDefinition {
+	return self.TlsChecks
+}
+
+func (self *HostV1Config) GetDnsChecks() []*health.DnsCheckDefinition {
+	return self.DnsChecks
+}
+
+func (self *HostV1Config) GetGrpcChecks() []*health.GrpcCheckDefinition {
+	return self.GrpcChecks
+}
+
+func (self *HostV1Config) GetExecChecks() []*health.ExecCheckDefinition {
+	return self.ExecChecks
+}
+
 func (self *HostV1Config) getValue(options map[string]interface{}, key string) (string, error) {
 	val, ok := options[key]
 	if !ok {
@@ -364,6 +408,20 @@ type InterceptV1Config struct {
 	SourceIp               *string
 	DialOptions            *DialOptions
 	AllowedSourceAddresses []string // white list for source IPs/CIDRs that will be intercepted
+	UdpOptions             *UdpOptions
+}
+
+const (
+	UdpFlowLimitPolicyDropNew = "drop-new"
+	UdpFlowLimitPolicyDropLRU = "drop-lru"
+)
+
+// UdpOptions controls how intercepted UDP traffic for a service is tracked. Each distinct source address is
+// tracked as a flow, backed by its own circuit.
+type UdpOptions struct {
+	MaxFlows           *uint32
+	FlowLimitPolicy    *string
+	IdleTimeoutSeconds *int
 }
 
 type TemplateFunc func(sourceAddr net.Addr, destAddr net.Addr) string
@@ -2,9 +2,13 @@ package health
 
 import (
 	"bytes"
+	"context"
+	"crypto/tls"
 	"fmt"
 	"io"
 	"math"
+	"net"
+	"os/exec"
 	"strconv"
 	"strings"
 	"time"
@@ -14,6 +18,10 @@ import (
 	"github.com/michaelquigley/pfxlog"
 	"github.com/openziti/sdk-golang/ziti/edge"
 	"github.com/pkg/errors"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials"
+	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/health/grpc_health_v1"
 )
 
 type CheckDefinition interface {
@@ -251,3 +259,235 @@ func (self *HttpCheckDefinition) CreateCheck(name string) (Check, error) {
 
 	return checks.NewHTTPCheck(httpCheckConfig)
 }
+
+type TlsCheckDefinition struct {
+	BaseCheckDefinition `mapstructure:",squash"`
+	Address             string
+	ServerName          string
+	InsecureSkipVerify  bool
+	ExpiryThreshold     time.Duration
+}
+
+func (self *TlsCheckDefinition) String() string {
+	return fmt.Sprintf("tls-check address=%v, interval=%v, timeout=%v, serverName=%v, expiryThreshold=%v",
+		self.Address, self.Interval, self.Timeout, self.ServerName, self.ExpiryThreshold)
+}
+
+func (self *TlsCheckDefinition) GetType() string {
+	return "tls"
+}
+
+func (self *TlsCheckDefinition) CreateCheck(name string) (Check, error) {
+	serverName := self.ServerName
+	if serverName == "" {
+		host, _, err := net.SplitHostPort(self.Address)
+		if err != nil {
+			return nil, errors.Wrapf(err, "invalid tls check address '%v'", self.Address)
+		}
+		serverName = host
+	}
+
+	tlsConfig := &tls.Config{
+		ServerName:         serverName,
+		InsecureSkipVerify: self.InsecureSkipVerify,
+	}
+
+	return &checks.CustomCheck{
+		CheckName: name,
+		CheckFunc: func(ctx context.Context) (interface{}, error) {
+			dialer := &tls.Dialer{Config: tlsConfig}
+			conn, err := dialer.DialContext(ctx, "tcp", self.Address)
+			if err != nil {
+				return nil, err
+			}
+			defer func() { _ = conn.Close() }()
+
+			peerCerts := conn.(*tls.Conn).ConnectionState().PeerCertificates
+			if len(peerCerts) == 0 {
+				return nil, errors.Errorf("no certificates presented by %v", self.Address)
+			}
+
+			expiresIn := time.Until(peerCerts[0].NotAfter)
+			details := fmt.Sprintf("certificate expires in %v", expiresIn.Round(time.Second))
+			if expiresIn < self.ExpiryThreshold {
+				return details, errors.Errorf("certificate for %v expires at %v, which is within the expiry threshold of %v",
+					self.Address, peerCerts[0].NotAfter, self.ExpiryThreshold)
+			}
+			return details, nil
+		},
+	}, nil
+}
+
+type DnsCheckDefinition struct {
+	BaseCheckDefinition `mapstructure:",squash"`
+	Hostname            string
+	Resolver            string
+	RecordType          string
+	ExpectAddress       string
+}
+
+func (self *DnsCheckDefinition) String() string {
+	return fmt.Sprintf("dns-check hostname=%v, interval=%v, timeout=%v, resolver=%v, recordType=%v",
+		self.Hostname, self.Interval, self.Timeout, self.Resolver, self.RecordType)
+}
+
+func (self *DnsCheckDefinition) GetType() string {
+	return "dns"
+}
+
+func (self *DnsCheckDefinition) CreateCheck(name string) (Check, error) {
+	var network string
+	switch strings.ToUpper(self.RecordType) {
+	case "":
+		network = "ip"
+	case "A":
+		network = "ip4"
+	case "AAAA":
+		network = "ip6"
+	default:
+		return nil, errors.Errorf("invalid dns check record type '%v', must be A or AAAA", self.RecordType)
+	}
+
+	var expectAddr net.IP
+	if self.ExpectAddress != "" {
+		if expectAddr = net.ParseIP(self.ExpectAddress); expectAddr == nil {
+			return nil, errors.Errorf("invalid dns check expected address '%v'", self.ExpectAddress)
+		}
+	}
+
+	resolver := net.DefaultResolver
+	if self.Resolver != "" {
+		resolverAddr := self.Resolver
+		if _, _, err := net.SplitHostPort(resolverAddr); err != nil {
+			resolverAddr = net.JoinHostPort(resolverAddr, "53")
+		}
+		resolver = &net.Resolver{
+			PreferGo: true,
+			Dial: func(ctx context.Context, network, _ string) (net.Conn, error) {
+				dialer := net.Dialer{}
+				return dialer.DialContext(ctx, network, resolverAddr)
+			},
+		}
+	}
+
+	return &checks.CustomCheck{
+		CheckName: name,
+		CheckFunc: func(ctx context.Context) (interface{}, error) {
+			ips, err := resolver.LookupIP(ctx, network, self.Hostname)
+			if err != nil {
+				return nil, err
+			}
+
+			details := fmt.Sprintf("%v resolved to %v", self.Hostname, ips)
+			if len(ips) == 0 {
+				return details, errors.Errorf("no addresses returned for %v", self.Hostname)
+			}
+
+			if expectAddr != nil {
+				for _, ip := range ips {
+					if ip.Equal(expectAddr) {
+						return details, nil
+					}
+				}
+				return details, errors.Errorf("%v did not resolve to expected address %v", self.Hostname, expectAddr)
+			}
+			return details, nil
+		},
+	}, nil
+}
+
+type GrpcCheckDefinition struct {
+	BaseCheckDefinition `mapstructure:",squash"`
+	Address             string
+	Service             string
+	Tls                 bool
+	ServerName          string
+	InsecureSkipVerify  bool
+}
+
+func (self *GrpcCheckDefinition) String() string {
+	return fmt.Sprintf("grpc-check address=%v, interval=%v, timeout=%v, service=%v, tls=%v",
+		self.Address, self.Interval, self.Timeout, self.Service, self.Tls)
+}
+
+func (self *GrpcCheckDefinition) GetType() string {
+	return "grpc"
+}
+
+func (self *GrpcCheckDefinition) CreateCheck(name string) (Check, error) {
+	creds := insecure.NewCredentials()
+	if self.Tls {
+		creds = credentials.NewTLS(&tls.Config{
+			ServerName:         self.ServerName,
+			InsecureSkipVerify: self.InsecureSkipVerify,
+		})
+	}
+
+	return &checks.CustomCheck{
+		CheckName: name,
+		CheckFunc: func(ctx context.Context) (interface{}, error) {
+			// use a new connection for each check, so connectivity is verified on every execution
+			conn, err := grpc.NewClient(self.Address, grpc.WithTransportCredentials(creds))
+			if err != nil {
+				return nil, errors.Wrapf(err, "unable to create grpc client for %v", self.Address)
+			}
+			defer func() { _ = conn.Close() }()
+
+			client := grpc_health_v1.NewHealthClient(conn)
+			resp, err := client.Check(ctx, &grpc_health_v1.HealthCheckRequest{Service: self.Service})
+			if err != nil {
+				return nil, err
+			}
+			status := resp.GetStatus()
+			if status != grpc_health_v1.HealthCheckResponse_SERVING {
+				return status.String(), errors.Errorf("grpc service '%v' at %v reported status %v", self.Service, self.Address, status)
+			}
+			return status.String(), nil
+		},
+	}, nil
+}
+
+type ExecCheckDefinition struct {
+	BaseCheckDefinition `mapstructure:",squash"`
+	Command             string
+	Args                []string
+	ExpectExitCode      int
+}
+
+func (self *ExecCheckDefinition) String() string {
+	return fmt.Sprintf("exec-check command=%v, interval=%v, timeout=%v, args=%v, exitCode=%v",
+		self.Command, self.Interval, self.Timeout, self.Args, self.ExpectExitCode)
+}
+
+func (self *ExecCheckDefinition) GetType() string {
+	return "exec"
+}
+
+func (self *ExecCheckDefinition) CreateCheck(name string) (Check, error) {
+	if self.Command == "" {
+		return nil, errors.New("exec health check requires a command")
+	}
+
+	return &checks.CustomCheck{
+		CheckName: name,
+		CheckFunc: func(ctx context.Context) (interface{}, error) {
+			cmd := exec.CommandContext(ctx, self.Command, self.Args...)
+			output, err := cmd.CombinedOutput()
+
+			exitCode := 0
+			if err != nil {
+				var exitErr *exec.ExitError
+				if !errors.As(err, &exitErr) {
+					return nil, err
+				}
+				exitCode = exitErr.ExitCode()
+			}
+
+			details := fmt.Sprintf("exit code %v, output: %v", exitCode, strings.TrimSpace(string(output)))
+			if exitCode != self.ExpectExitCode {
+				return details, errors.Errorf("command '%v' exited with code %v, expected %v", self.Command, exitCode, self.ExpectExitCode)
+			}
+			return details, nil
+		},
+	}, nil
+}
@@ -2,11 +2,21 @@ package health
 
 import (
 	"bytes"
+	"context"
 	"encoding/json"
-	"github.com/mitchellh/mapstructure"
-	"github.com/stretchr/testify/require"
+	"net"
+	"net/http"
+	"net/http/httptest"
 	"testing"
 	"time"
+
+	"github.com/miekg/dns"
+	"github.com/mitchellh/mapstructure"
+	"github.com/openziti/sdk-golang/ziti/edge"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+	grpchealth "google.golang.org/grpc/health"
+	"google.golang.org/grpc/health/grpc_health_v1"
 )
 
 func Test_LoadPingTest(t *testing.T) {
@@ -80,3 +90,212 @@ func Test_LoadPingTest(t *testing.T) {
 	req.Nil(pingCheck.Actions[3].Duration)
 	req.Equal("decrease cost 5", pingCheck.Actions[3].Action)
 }
+
+func Test_LoadTlsCheck(t *testing.T) {
+	req := require.New(t)
+
+	var test = `
+        {
+            "interval" : "1m",
+            "timeout" : "5s",
+            "address" : "ldap.internal:636",
+            "serverName" : "ldap.example.com",
+            "expiryThreshold" : "168h"
+        }`
+
+	m := map[string]interface{}{}
+	req.NoError(json.NewDecoder(bytes.NewBufferString(test)).Decode(&m))
+
+	tlsCheck := &TlsCheckDefinition{}
+	decoder, err := mapstructure.NewDecoder(&mapstructure.DecoderConfig{
+		Result:     tlsCheck,
+		DecodeHook: mapstructure.StringToTimeDurationHookFunc(),
+	})
+	req.NoError(err)
+	req.NoError(decoder.Decode(m))
+
+	req.Equal("ldap.internal:636", tlsCheck.Address)
+	req.Equal("ldap.example.com", tlsCheck.ServerName)
+	req.Equal(7*24*time.Hour, tlsCheck.ExpiryThreshold)
+	req.Equal(time.Minute, tlsCheck.Interval)
+	req.Equal(5*time.Second, tlsCheck.Timeout)
+}
+
+func Test_ExecCheck(t *testing.T) {
+	req := require.New(t)
+
+	checkDef := &ExecCheckDefinition{
+		Command: "sh",
+		Args:    []string{"-c", "exit 3"},
+	}
+
+	check, err := checkDef.CreateCheck("test")
+	req.NoError(err)
+
+	_, err = check.Execute(context.Background())
+	req.Error(err)
+
+	checkDef.ExpectExitCode = 3
+	_, err = check.Execute(context.Background())
+	req.NoError(err)
+}
+
+type testUpdater struct{}
+
+func (self testUpdater) UpdateCostAndPrecedence(uint16, edge.Precedence) error {
+	return nil
+}
+
+func (self testUpdater) SendHealthEvent(bool) error {
+	return nil
+}
+
+func countChecks(mgr *manager) int {
+	count := 0
+	mgr.checks.Range(func(key, value any) bool {
+		count++
+		return true
+	})
+	return count
+}
+
+func Test_DisabledExecChecksAreSkipped(t *testing.T) {
+	req := require.New(t)
+
+	checkDefs := []CheckDefinition{
+		&ExecCheckDefinition{BaseCheckDefinition: BaseCheckDefinition{Interval: time.Hour}, Command: "true"},
+		&PortCheckDefinition{BaseCheckDefinition: BaseCheckDefinition{Interval: time.Hour}, Address: "127.0.0.1:1"},
+	}
+
+	mgr := NewManager().(*manager)
+	defer mgr.Shutdown()
+
+	req.NoError(mgr.RegisterServiceChecks(NewServiceState("disabled", 0, 0, testUpdater{}), checkDefs))
+	req.Equal(1, countChecks(mgr))
+
+	mgr.EnableExecChecks(true)
+	req.NoError(mgr.RegisterServiceChecks(NewServiceState("enabled", 0, 0, testUpdater{}), checkDefs))
+	req.Equal(3, countChecks(mgr))
+
+	// exec checks are enabled per manager, not per process
+	other := NewManager().(*manager)
+	defer other.Shutdown()
+	req.NoError(other.RegisterServiceChecks(NewServiceState("other", 0, 0, testUpdater{}), checkDefs))
+	req.Equal(1, countChecks(other))
+}
+
+func Test_TlsCheckExpiryThreshold(t *testing.T) {
+	req := require.New(t)
+
+	server := httptest.NewTLSServer(http.HandlerFunc(func(http.ResponseWriter, *http.Request) {}))
+	defer server.Close()
+
+	address := server.Listener.Addr().String()
+	expiresIn := time.Until(server.Certificate().NotAfter)
+
+	checkDef := &TlsCheckDefinition{
+		Address:            address,
+		InsecureSkipVerify: true,
+		ExpiryThreshold:    time.Hour,
+	}
+	check, err := checkDef.CreateCheck("tls")
+	req.NoError(err)
+	_, err = check.Execute(context.Background())
+	req.NoError(err)
+
+	checkDef.ExpiryThreshold = expiresIn + time.Hour
+	check, err = checkDef.CreateCheck("tls")
+	req.NoError(err)
+	_, err = check.Execute(context.Background())
+	req.ErrorContains(err, "within the expiry threshold")
+
+	// the test server's certificate isn't trusted, so the handshake fails with verification enabled
+	checkDef.InsecureSkipVerify = false
+	checkDef.ExpiryThreshold = 0
+	check, err = checkDef.CreateCheck("tls")
+	req.NoError(err)
+	_, err = check.Execute(context.Background())
+	req.Error(err)
+}
+
+func Test_DnsCheckExpectAddress(t *testing.T) {
+	req := require.New(t)
+
+	conn, err := net.ListenPacket("udp", "127.0.0.1:0")
+	req.NoError(err)
+
+	server := &dns.Server{
+		PacketConn: conn,
+		Handler: dns.HandlerFunc(func(w dns.ResponseWriter, r *dns.Msg) {
+			resp := &dns.Msg{}
+			resp.SetReply(r)
+			for _, q := range r.Question {
+				if q.Qtype == dns.TypeA && q.Name == "app.test." {
+					resp.Answer = append(resp.Answer, &dns.A{
+						Hdr: dns.RR_Header{Name: q.Name, Rrtype: dns.TypeA, Class: dns.ClassINET, Ttl: 60},
+						A:   net.ParseIP("10.1.2.3"),
+					})
+				}
+			}
+			_ = w.WriteMsg(resp)
+		}),
+	}
+	go func() { _ = server.ActivateAndServe() }()
+	defer func() { _ = server.Shutdown() }()
+
+	execute := func(expectAddress string) error {
+		checkDef := &DnsCheckDefinition{
+			Hostname:      "app.test",
+			Resolver:      conn.LocalAddr().String(),
+			RecordType:    "A",
+			ExpectAddress: expectAddress,
+		}
+		check, err := checkDef.CreateCheck("dns")
+		req.NoError(err)
+
+		ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+		defer cancel()
+		_, err = check.Execute(ctx)
+		return err
+	}
+
+	req.NoError(execute(""))
+	req.NoError(execute("10.1.2.3"))
+	req.ErrorContains(execute("10.1.2.4"), "did not resolve to expected address")
+}
+
+func Test_GrpcCheckServingStatus(t *testing.T) {
+	req := require.New(t)
+
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	req.NoError(err)
+
+	healthServer := grpchealth.NewServer()
+	server := grpc.NewServer()
+	grpc_health_v1.RegisterHealthServer(server, healthServer)
+	go func() { _ = server.Serve(listener) }()
+	defer server.Stop()
+
+	checkDef := &GrpcCheckDefinition{
+		Address: listener.Addr().String(),
+		Service: "orders",
+	}
+	check, err := checkDef.CreateCheck("grpc")
+	req.NoError(err)
+
+	execute := func() (interface{}, error) {
+		ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+		defer cancel()
+		return check.Execute(ctx)
+	}
+
+	healthServer.SetServingStatus("orders", grpc_health_v1.HealthCheckResponse_SERVING)
+	details, err := execute()
+	req.NoError(err)
+	req.Equal("SERVING", details)
+
+	healthServer.SetServingStatus("orders", grpc_health_v1.HealthCheckResponse_NOT_SERVING)
+	details, err = execute()
+	req.ErrorContains(err, "NOT_SERVING")
+	req.Equal("NOT_SERVING", details)
+}
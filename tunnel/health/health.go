@@ -120,6 +120,9 @@ func NewManager() Manager {
 }
 
 type Manager interface {
+	// EnableExecChecks controls whether exec health checks may be run. Because exec checks run commands taken from
+	// service configuration, they are disabled by default and must be explicitly enabled by the hosting tunneler.
+	EnableExecChecks(enabled bool)
 	RegisterServiceChecks(service *ServiceState, checkDefinitions []CheckDefinition) error
 	UnregisterServiceChecks(service string)
 	UnregisterServiceContextChecks(service, context string)
@@ -127,10 +130,15 @@ type Manager interface {
 }
 
 type manager struct {
-	checks  sync.Map
-	health  health.Health
-	results chan *result
-	closed  atomic.Bool
+	checks            sync.Map
+	health            health.Health
+	results           chan *result
+	closed            atomic.Bool
+	execChecksEnabled atomic.Bool
+}
+
+func (self *manager) EnableExecChecks(enabled bool) {
+	self.execChecksEnabled.Store(enabled)
 }
 
 func (self *manager) Shutdown() {
@@ -196,6 +204,13 @@ func (self *manager) UnregisterServiceContextChecks(service, hostContext string)
 func (self *manager) RegisterServiceChecks(service *ServiceState, checkDefinitions []CheckDefinition) error {
 	logger := pfxlog.Logger()
 	for idx, checkDefinition := range checkDefinitions {
+		if execCheck, ok := checkDefinition.(*ExecCheckDefinition); ok && !self.execChecksEnabled.Load() {
+			logger.WithField("service", service.Service).
+				WithField("hostContext", service.HostContext).
+				Warnf("exec health checks are not enabled on this host, skipping check for command '%v'", execCheck.Command)
+			continue
+		}
+
 		id := fmt.Sprintf("%v_%v_%v", service.Service, service.HostContext, idx)
 		_, found := self.checks.Load(id)
 		counter := 0
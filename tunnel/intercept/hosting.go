@@ -41,6 +41,10 @@ import (
 type healthChecksProvider interface {
 	GetPortChecks() []*health.PortCheckDefinition
 	GetHttpChecks() []*health.HttpCheckDefinition
+	GetTlsChecks() []*health.TlsCheckDefinition
+	GetDnsChecks() []*health.DnsCheckDefinition
+	GetGrpcChecks() []*health.GrpcCheckDefinition
+	GetExecChecks() []*health.ExecCheckDefinition
 }
 
 func createHostingContexts(service *entities.Service, identity *rest_model.IdentityDetail, tracker AddressTracker) []tunnel.HostingContext {
@@ -289,6 +293,22 @@ func (self *hostingContext) getHealthChecks(provider healthChecksProvider) []hea
 		checkDefinitions = append(checkDefinitions, checkDef)
 	}
 
+	for _, checkDef := range provider.GetTlsChecks() {
+		checkDefinitions = append(checkDefinitions, checkDef)
+	}
+
+	for _, checkDef := range provider.GetDnsChecks() {
+		checkDefinitions = append(checkDefinitions, checkDef)
+	}
+
+	for _, checkDef := range provider.GetGrpcChecks() {
+		checkDefinitions = append(checkDefinitions, checkDef)
+	}
+
+	for _, checkDef := range provider.GetExecChecks() {
+		checkDefinitions = append(checkDefinitions, checkDef)
+	}
+
 	return checkDefinitions
 }
 
@@ -0,0 +1,96 @@
+/*
+	Copyright NetFoundry Inc.
+
+	Licensed under the Apache License, Version 2.0 (the "License");
+	you may not use this file except in compliance with the License.
+	You may obtain a copy of the License at
+
+	https://www.apache.org/licenses/LICENSE-2.0
+
+	Unless required by applicable law or agreed to in writing, software
+	distributed under the License is distributed on an "AS IS" BASIS,
+	WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+	See the License for the specific language governing permissions and
+	limitations under the License.
+*/
+
+package intercept
+
+import (
+	"net"
+	"strings"
+
+	"github.com/openziti/foundation/v2/stringz"
+	"github.com/openziti/ziti/tunnel/entities"
+	"github.com/openziti/ziti/tunnel/utils"
+)
+
+// MatchesInterceptAddress reports whether the given protocol, host and port fall within the intercept.v1
+// configuration of the service. It applies the same address interpretation as GetInterceptAddresses: wildcard
+// domains, IPs/CIDRs and plain hostnames. Unlike GetInterceptAddresses, hostnames are matched by name and no
+// DNS intercept IPs are allocated, which makes it suitable for interceptors where the client names the
+// destination explicitly, such as SOCKS or HTTP CONNECT proxies.
+func MatchesInterceptAddress(service *entities.Service, protocol string, host string, port uint16) bool {
+	return InterceptAddressSpecificity(service, protocol, host, port) >= 0
+}
+
+const (
+	specificityExact = 1 << 20
+	specificityCidr  = 1 << 10
+)
+
+// InterceptAddressSpecificity returns how specifically the intercept.v1 configuration of the service matches the
+// given protocol, host and port, or -1 if it doesn't match. When several services match, the one with the highest
+// specificity should be used, which mirrors how routes are chosen for tproxy intercepts: exact hostnames and IPs
+// win over CIDRs, longer prefixes win over shorter ones, and CIDRs win over wildcard domains, with longer domains
+// winning over shorter ones.
+func InterceptAddressSpecificity(service *entities.Service, protocol string, host string, port uint16) int {
+	cfg := service.InterceptV1Config
+	if cfg == nil || !stringz.Contains(cfg.Protocols, protocol) {
+		return -1
+	}
+
+	portMatched := false
+	for _, portRange := range cfg.PortRanges {
+		if port >= portRange.Low && port <= portRange.High {
+			portMatched = true
+			break
+		}
+	}
+
+	if !portMatched {
+		return -1
+	}
+
+	host = strings.ToLower(strings.TrimSuffix(host, "."))
+	ip := net.ParseIP(host)
+
+	result := -1
+	for _, addr := range cfg.Addresses {
+		if addr == "" {
+			continue
+		}
+
+		if addr[0] == '*' {
+			domain := strings.ToLower(addr)
+			if ip == nil && (domain == "*" || strings.HasSuffix(host, domain[1:]) || (len(domain) > 2 && host == domain[2:])) {
+				result = max(result, len(domain)-1)
+			}
+			continue
+		}
+
+		if ipNet, err := utils.GetCidr(addr); err == nil {
+			if ip != nil && ipNet.Contains(ip) {
+				ones, _ := ipNet.Mask.Size()
+				result = max(result, specificityCidr+ones)
+			}
+			continue
+		}
+
+		if strings.ToLower(addr) == host {
+			return specificityExact
+		}
+	}
+
+	return result
+}
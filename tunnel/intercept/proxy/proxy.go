@@ -290,7 +290,9 @@ func (p *interceptor) handleUDP(service *Service) error {
 		service: service.TunnelService,
 		conn:    udpPacketConn,
 	}
-	vconnManager := udp_vconn.NewManager(service.TunnelService.FabricProvider, udp_vconn.NewUnlimitedConnectionPolicy(), udp_vconn.NewDefaultExpirationPolicy())
+	newConnPolicy := udp_vconn.NewConnPolicyForService(service.TunnelService)
+	expirationPolicy := udp_vconn.NewExpirationPolicyForService(service.TunnelService, udp_vconn.NewDefaultExpirationPolicy())
+	vconnManager := udp_vconn.NewManager(service.TunnelService.FabricProvider, newConnPolicy, expirationPolicy)
 	go reader.generateReadEvents(vconnManager)
 	return nil
 }
@@ -342,6 +344,16 @@ func (reader *udpReader) generateReadEvents(manager udp_vconn.Manager) {
 	}
 }
 
+// sharedUdpConn wraps the service's UDP listener, which is shared by all flows for the service, so that closing
+// a single flow doesn't close the listener
+type sharedUdpConn struct {
+	*net.UDPConn
+}
+
+func (self sharedUdpConn) Close() error {
+	return nil
+}
+
 type udpReadEvent struct {
 	reader  *udpReader
 	buf     *mempool.DefaultPooledBuffer
@@ -357,8 +369,9 @@ func (event *udpReadEvent) Handle(manager udp_vconn.Manager) error {
 		log.Infof("received connection for %v --> %v, which maps to intercepted service %v",
 			event.srcAddr, event.reader.conn.LocalAddr(), event.reader.service)
 		var err error
-		writeQueue, err = manager.CreateWriteQueue(event.srcAddr.(*net.UDPAddr), event.srcAddr, event.reader.service, event.reader.conn)
+		writeQueue, err = manager.CreateWriteQueue(event.srcAddr.(*net.UDPAddr), event.srcAddr, event.reader.service, sharedUdpConn{event.reader.conn})
 		if err != nil {
+			event.buf.Release()
 			return err
 		}
 	}
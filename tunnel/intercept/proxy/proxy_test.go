@@ -0,0 +1,87 @@
+package proxy
+
+import (
+	"net"
+	"testing"
+	"time"
+
+	"github.com/openziti/edge-api/rest_model"
+	"github.com/openziti/foundation/v2/util"
+	"github.com/openziti/ziti/tunnel"
+	"github.com/openziti/ziti/tunnel/entities"
+	"github.com/openziti/ziti/tunnel/udp_vconn"
+	"github.com/stretchr/testify/require"
+)
+
+type testProvider struct {
+	tunnel.FabricProvider
+	conns chan net.Conn
+}
+
+func (self *testProvider) TunnelService(_ tunnel.Service, _ string, conn net.Conn, _ bool, _ []byte) error {
+	self.conns <- conn
+	buf := make([]byte, 1024)
+	for {
+		if _, err := conn.Read(buf); err != nil {
+			return nil
+		}
+	}
+}
+
+func Test_EvictedUdpFlowDoesNotCloseListener(t *testing.T) {
+	req := require.New(t)
+
+	provider := &testProvider{conns: make(chan net.Conn, 4)}
+	service := &entities.Service{
+		ServiceDetail: rest_model.ServiceDetail{
+			BaseEntity: rest_model.BaseEntity{ID: util.Ptr("echo-id")},
+			Name:       util.Ptr("echo"),
+		},
+		InterceptV1Config: &entities.InterceptV1Config{
+			Protocols: []string{"udp"},
+			UdpOptions: &entities.UdpOptions{
+				MaxFlows:        util.Ptr(uint32(1)),
+				FlowLimitPolicy: util.Ptr(entities.UdpFlowLimitPolicyDropLRU),
+			},
+		},
+		FabricProvider: provider,
+	}
+
+	listener, err := net.ListenUDP("udp", &net.UDPAddr{IP: net.IPv4(127, 0, 0, 1)})
+	req.NoError(err)
+	defer func() { _ = listener.Close() }()
+
+	reader := &udpReader{service: service, conn: listener}
+	manager := udp_vconn.NewManager(provider, udp_vconn.NewConnPolicyForService(service), udp_vconn.NewDefaultExpirationPolicy())
+	go reader.generateReadEvents(manager)
+
+	send := func() net.Conn {
+		client, err := net.DialUDP("udp", nil, listener.LocalAddr().(*net.UDPAddr))
+		req.NoError(err)
+		defer func() { _ = client.Close() }()
+
+		_, err = client.Write([]byte("hello"))
+		req.NoError(err)
+
+		select {
+		case conn := <-provider.conns:
+			return conn
+		case <-time.After(2 * time.Second):
+			req.FailNow("no flow created")
+		}
+		return nil
+	}
+
+	first := send()
+
+	// the second flow exceeds the limit, so the first is evicted
+	second := send()
+	req.NotEqual(first.RemoteAddr().String(), second.RemoteAddr().String())
+
+	// the listener must still be open after the eviction, so new flows can be created
+	third := send()
+	req.NotEqual(second.RemoteAddr().String(), third.RemoteAddr().String())
+
+	_, err = third.Write([]byte("response"))
+	req.NoError(err)
+}
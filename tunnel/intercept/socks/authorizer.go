@@ -0,0 +1,76 @@
+/*
+	(c) Copyright NetFoundry Inc.
+
+	Licensed under the Apache License, Version 2.0 (the "License");
+	you may not use this file except in compliance with the License.
+	You may obtain a copy of the License at
+
+	https://www.apache.org/licenses/LICENSE-2.0
+
+	Unless required by applicable law or agreed to in writing, software
+	distributed under the License is distributed on an "AS IS" BASIS,
+	WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+	See the License for the specific language governing permissions and
+	limitations under the License.
+*/
+
+package socks
+
+import (
+	"fmt"
+
+	"github.com/openziti/ziti/common"
+	"github.com/openziti/ziti/common/pb/edge_ctrl_pb"
+	"github.com/openziti/ziti/tunnel/entities"
+)
+
+// NewRouterDataModelAuthorizer returns a DialAuthorizer which evaluates service policies using the router data
+// model. Identities may be given by name or id. Policies with posture checks don't grant access, since posture
+// can't be established for proxy clients.
+func NewRouterDataModelAuthorizer(rdmF func() *common.RouterDataModel) DialAuthorizer {
+	return &rdmAuthorizer{rdmF: rdmF}
+}
+
+type rdmAuthorizer struct {
+	rdmF func() *common.RouterDataModel
+}
+
+func (self *rdmAuthorizer) IsDialAuthorized(identityName string, service *entities.Service) (bool, error) {
+	rdm := self.rdmF()
+	if rdm == nil {
+		return false, fmt.Errorf("router data model not available")
+	}
+
+	identity, found := rdm.Identities.Get(identityName)
+	if !found {
+		for _, candidate := range rdm.Identities.Items() {
+			if candidate.GetName() == identityName {
+				identity = candidate
+				found = true
+				break
+			}
+		}
+	}
+
+	if !found {
+		return false, fmt.Errorf("identity '%s' not found", identityName)
+	}
+
+	if identity.GetDisabled() {
+		return false, nil
+	}
+
+	authorized := false
+	identity.ServicePolicies.IterCb(func(policyId string, _ struct{}) {
+		if authorized {
+			return
+		}
+		policy, found := rdm.ServicePolicies.Get(policyId)
+		if !found || policy.GetPolicyType() != edge_ctrl_pb.PolicyType_DialPolicy || policy.PostureChecks.Count() > 0 {
+			return
+		}
+		authorized = policy.Services.Has(service.GetId())
+	})
+
+	return authorized, nil
+}
@@ -0,0 +1,122 @@
+/*
+	Copyright NetFoundry Inc.
+
+	Licensed under the Apache License, Version 2.0 (the "License");
+	you may not use this file except in compliance with the License.
+	You may obtain a copy of the License at
+
+	https://www.apache.org/licenses/LICENSE-2.0
+
+	Unless required by applicable law or agreed to in writing, software
+	distributed under the License is distributed on an "AS IS" BASIS,
+	WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+	See the License for the specific language governing permissions and
+	limitations under the License.
+*/
+
+package socks
+
+import (
+	"bufio"
+	"encoding/base64"
+	"errors"
+	"fmt"
+	"net"
+	"net/http"
+	"strconv"
+	"strings"
+)
+
+type httpConnectHandshake struct {
+	interceptor *interceptor
+	conn        net.Conn
+	reader      *bufio.Reader
+}
+
+func (self *httpConnectHandshake) negotiate() (*connectRequest, error) {
+	request, err := http.ReadRequest(self.reader)
+	if err != nil {
+		return nil, err
+	}
+
+	if request.Method != http.MethodConnect {
+		self.respond(http.StatusMethodNotAllowed, nil)
+		return nil, fmt.Errorf("unsupported http proxy method '%s'", request.Method)
+	}
+
+	username, password, hasAuth := parseProxyAuthorization(request.Header.Get("Proxy-Authorization"))
+	if self.interceptor.authRequired() && (!hasAuth || !self.interceptor.authenticate(username, password)) {
+		self.respond(http.StatusProxyAuthRequired, map[string]string{"Proxy-Authenticate": `Basic realm="ziti"`})
+		return nil, fmt.Errorf("authentication failed for user '%s'", username)
+	}
+
+	target := request.URL.Host
+	if target == "" {
+		target = request.Host
+	}
+
+	host, portStr, err := net.SplitHostPort(target)
+	if err != nil {
+		self.respond(http.StatusBadRequest, nil)
+		return nil, fmt.Errorf("invalid connect address '%s' (%w)", target, err)
+	}
+
+	port, err := strconv.ParseUint(portStr, 10, 16)
+	if err != nil {
+		self.respond(http.StatusBadRequest, nil)
+		return nil, fmt.Errorf("invalid connect port '%s' (%w)", portStr, err)
+	}
+
+	return &connectRequest{
+		host:     host,
+		port:     uint16(port),
+		username: username,
+	}, nil
+}
+
+func (self *httpConnectHandshake) respond(status int, headers map[string]string) {
+	_ = self.writeResponse(status, headers)
+}
+
+func (self *httpConnectHandshake) writeResponse(status int, headers map[string]string) error {
+	buf := strings.Builder{}
+	buf.WriteString(fmt.Sprintf("HTTP/1.1 %d %s\r\n", status, http.StatusText(status)))
+	for k, v := range headers {
+		buf.WriteString(fmt.Sprintf("%s: %s\r\n", k, v))
+	}
+	if status != http.StatusOK {
+		buf.WriteString("Content-Length: 0\r\nConnection: close\r\n")
+	}
+	buf.WriteString("\r\n")
+	_, err := self.conn.Write([]byte(buf.String()))
+	return err
+}
+
+func (self *httpConnectHandshake) succeed() error {
+	return self.writeResponse(http.StatusOK, nil)
+}
+
+func (self *httpConnectHandshake) fail(err error) {
+	status := http.StatusBadGateway
+	if errors.Is(err, errNotAuthorized) {
+		status = http.StatusForbidden
+	} else if errors.Is(err, errNoMatchingService) {
+		status = http.StatusNotFound
+	}
+	self.respond(status, nil)
+}
+
+func parseProxyAuthorization(value string) (string, string, bool) {
+	const prefix = "Basic "
+	if len(value) < len(prefix) || !strings.EqualFold(value[:len(prefix)], prefix) {
+		return "", "", false
+	}
+
+	decoded, err := base64.StdEncoding.DecodeString(strings.TrimSpace(value[len(prefix):]))
+	if err != nil {
+		return "", "", false
+	}
+
+	username, password, ok := strings.Cut(string(decoded), ":")
+	return username, password, ok
+}
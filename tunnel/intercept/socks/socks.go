@@ -0,0 +1,516 @@
+/*
+	Copyright NetFoundry Inc.
+
+	Licensed under the Apache License, Version 2.0 (the "License");
+	you may not use this file except in compliance with the License.
+	You may obtain a copy of the License at
+
+	https://www.apache.org/licenses/LICENSE-2.0
+
+	Unless required by applicable law or agreed to in writing, software
+	distributed under the License is distributed on an "AS IS" BASIS,
+	WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+	See the License for the specific language governing permissions and
+	limitations under the License.
+*/
+
+// Package socks provides an interceptor which exposes a single SOCKS5 and HTTP CONNECT listener. Clients name
+// the destination host and port, which is mapped to a service using the service's intercept.v1 addresses.
+package socks
+
+import (
+	"bufio"
+	"crypto/subtle"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net"
+	"sort"
+	"strconv"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/michaelquigley/pfxlog"
+	"github.com/openziti/foundation/v2/stringz"
+	"github.com/openziti/ziti/common/alert"
+	"github.com/openziti/ziti/tunnel"
+	"github.com/openziti/ziti/tunnel/dns"
+	"github.com/openziti/ziti/tunnel/entities"
+	"github.com/openziti/ziti/tunnel/intercept"
+	"github.com/openziti/ziti/tunnel/intercept/proxy"
+)
+
+const (
+	DefaultAddress          = "127.0.0.1:1080"
+	DefaultHandshakeTimeout = 10 * time.Second
+
+	// AllServices may be used in a user's service list to authorize the user for every intercepted service
+	AllServices = "*"
+)
+
+var (
+	errNoMatchingService = errors.New("no service matches requested address")
+	errNotAuthorized     = errors.New("user not authorized for service")
+)
+
+type Config struct {
+	Address          string
+	HandshakeTimeout time.Duration
+	Users            []*User
+	Authorizer       DialAuthorizer
+}
+
+// DialAuthorizer checks whether a Ziti identity may dial a service, using the identity's service policies
+type DialAuthorizer interface {
+	IsDialAuthorized(identity string, service *entities.Service) (bool, error)
+}
+
+// User is a proxy user. If any users are configured, clients must authenticate, using SOCKS5 username/password
+// authentication or HTTP Basic proxy authorization. A user which is tied to a Ziti identity may reach the services
+// that identity has dial access to. Otherwise, the user may only reach the services listed for the user.
+type User struct {
+	Username string
+	Password string
+	Identity string
+	Services []string
+}
+
+func (self *User) authenticate(password string) bool {
+	return subtle.ConstantTimeCompare([]byte(self.Password), []byte(password)) == 1
+}
+
+func (self *User) isAuthorized(serviceName string) bool {
+	return stringz.Contains(self.Services, AllServices) || stringz.Contains(self.Services, serviceName)
+}
+
+type interceptor struct {
+	config     Config
+	listener   net.Listener
+	services   map[string]*entities.Service
+	users      map[string]*User
+	authorizer DialAuthorizer
+	lock       sync.RWMutex
+	alerter    proxy.Alerter
+}
+
+func New(config Config, alerter proxy.Alerter) (intercept.Interceptor, error) {
+	if config.Address == "" {
+		config.Address = DefaultAddress
+	}
+
+	if config.HandshakeTimeout <= 0 {
+		config.HandshakeTimeout = DefaultHandshakeTimeout
+	}
+
+	users := map[string]*User{}
+	for _, user := range config.Users {
+		if user.Username == "" {
+			return nil, errors.New("socks proxy users must have a username")
+		}
+		if _, found := users[user.Username]; found {
+			return nil, fmt.Errorf("duplicate socks proxy user '%s'", user.Username)
+		}
+		if user.Identity != "" && config.Authorizer == nil {
+			return nil, fmt.Errorf("socks proxy user '%s' is tied to identity '%s', but identity authorization isn't available", user.Username, user.Identity)
+		}
+		users[user.Username] = user
+	}
+
+	if len(users) == 0 {
+		if err := requireLoopback(config.Address); err != nil {
+			return nil, err
+		}
+	}
+
+	listener, err := net.Listen("tcp", config.Address)
+	if err != nil {
+		return nil, fmt.Errorf("unable to listen on '%s' for socks proxy (%w)", config.Address, err)
+	}
+
+	result := &interceptor{
+		config:     config,
+		listener:   listener,
+		services:   map[string]*entities.Service{},
+		users:      users,
+		authorizer: config.Authorizer,
+		alerter:    alerter,
+	}
+
+	go result.accept()
+
+	return result, nil
+}
+
+// requireLoopback refuses to run an unauthenticated proxy on a non-loopback address, since it would give anyone who
+// can reach the address access to every service the router can dial
+func requireLoopback(address string) error {
+	host, _, err := net.SplitHostPort(address)
+	if err != nil {
+		return fmt.Errorf("invalid socks proxy address '%s' (%w)", address, err)
+	}
+
+	if host == "localhost" {
+		return nil
+	}
+
+	if ip := net.ParseIP(host); ip != nil && ip.IsLoopback() {
+		return nil
+	}
+
+	return fmt.Errorf("socks proxy address '%s' is not a loopback address, socksUsers must be configured", address)
+}
+
+func (self *interceptor) Intercept(service *entities.Service, _ dns.Resolver, _ intercept.AddressTracker) error {
+	log := pfxlog.Logger().WithField("service", service.GetName())
+
+	if service.InterceptV1Config == nil {
+		log.Debug("service has no intercept configuration, not available via socks proxy")
+		return nil
+	}
+
+	self.lock.Lock()
+	self.services[service.GetName()] = service
+	self.lock.Unlock()
+
+	service.FabricProvider.PrepForUse(service.GetId())
+
+	log.WithField("addr", self.listener.Addr().String()).Info("service available via socks proxy")
+	return nil
+}
+
+func (self *interceptor) StopIntercepting(serviceName string, _ intercept.AddressTracker) error {
+	self.lock.Lock()
+	defer self.lock.Unlock()
+
+	delete(self.services, serviceName)
+	return nil
+}
+
+func (self *interceptor) Stop() {
+	pfxlog.Logger().Info("stopping socks proxy interceptor")
+	if err := self.listener.Close(); err != nil {
+		pfxlog.Logger().WithError(err).Error("error closing socks proxy listener")
+	}
+}
+
+func (self *interceptor) accept() {
+	log := pfxlog.Logger().WithField("addr", self.listener.Addr().String())
+	log.Info("socks proxy is listening")
+	defer log.Info("socks proxy stopped")
+
+	for {
+		conn, err := self.listener.Accept()
+		if err != nil {
+			var netErr net.Error
+			if errors.As(err, &netErr) && netErr.Timeout() {
+				continue
+			}
+			if !errors.Is(err, net.ErrClosed) {
+				log.WithError(err).Error("accept failed")
+				self.alerter.ReportError("socks proxy accept failed", []string{err.Error()}, map[string]string{
+					alert.EntityTypeSelf: alert.EntityTypeSelfErt,
+				})
+			}
+			return
+		}
+		go self.handle(conn)
+	}
+}
+
+func (self *interceptor) handle(conn net.Conn) {
+	log := pfxlog.Logger().WithField("src", conn.RemoteAddr().String())
+
+	if err := conn.SetDeadline(time.Now().Add(self.config.HandshakeTimeout)); err != nil {
+		log.WithError(err).Error("unable to set handshake deadline")
+		_ = conn.Close()
+		return
+	}
+
+	reader := bufio.NewReader(conn)
+	first, err := reader.Peek(1)
+	if err != nil {
+		log.WithError(err).Debug("failed to read proxy request")
+		_ = conn.Close()
+		return
+	}
+
+	var h handshake
+	if first[0] == socks5Version {
+		h = &socks5Handshake{interceptor: self, conn: conn, reader: reader}
+	} else {
+		h = &httpConnectHandshake{interceptor: self, conn: conn, reader: reader}
+	}
+
+	req, err := h.negotiate()
+	if err != nil {
+		log.WithError(err).Info("proxy handshake failed")
+		_ = conn.Close()
+		return
+	}
+
+	log = log.WithField("dst", req.address()).WithField("user", req.username)
+
+	service, err := self.authorize(req)
+	if err != nil {
+		log.WithError(err).Info("rejecting proxy request")
+		h.fail(err)
+		_ = conn.Close()
+		return
+	}
+
+	if err = conn.SetDeadline(time.Time{}); err != nil {
+		log.WithError(err).Error("unable to clear handshake deadline")
+		_ = conn.Close()
+		return
+	}
+
+	log = log.WithField("service", service.GetName())
+	log.Debug("proxying connection to service")
+
+	clientConn := &proxiedConn{
+		Conn:      conn,
+		reader:    reader,
+		onConnect: h.succeed,
+	}
+
+	dstAddr := req.netAddr()
+	sourceAddr := service.GetSourceAddr(conn.RemoteAddr(), dstAddr)
+	dstIp, dstHostname := req.host, ""
+	if net.ParseIP(req.host) == nil {
+		dstIp, dstHostname = "", req.host
+	}
+	appInfo := tunnel.GetAppInfo("tcp", dstHostname, dstIp, strconv.Itoa(int(req.port)), sourceAddr)
+	identity := service.GetDialIdentity(conn.RemoteAddr(), dstAddr)
+
+	appInfoJson, err := json.Marshal(appInfo)
+	if err != nil {
+		log.WithError(err).Error("unable to marshal appInfo")
+		_ = conn.Close()
+		return
+	}
+
+	if err = service.FabricProvider.TunnelService(service, identity, clientConn, true, appInfoJson); err != nil {
+		log.WithError(err).Error("tunnel failed")
+		if !clientConn.connected() {
+			h.fail(err)
+		}
+		_ = conn.Close()
+	}
+}
+
+// authorize finds the service matching the requested destination which the proxy user may dial. If several
+// authorized services match, the one with the most specific intercept address is used.
+func (self *interceptor) authorize(req *connectRequest) (*entities.Service, error) {
+	self.lock.RLock()
+	defer self.lock.RUnlock()
+
+	names := make([]string, 0, len(self.services))
+	for name := range self.services {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	var result *entities.Service
+	resultSpecificity := -1
+	matched := false
+
+	for _, name := range names {
+		service := self.services[name]
+		specificity := intercept.InterceptAddressSpecificity(service, "tcp", req.host, req.port)
+		if specificity <= resultSpecificity {
+			continue
+		}
+		matched = true
+
+		if !self.isAuthorized(req.username, service) {
+			continue
+		}
+
+		result = service
+		resultSpecificity = specificity
+	}
+
+	if result != nil {
+		return result, nil
+	}
+
+	if matched {
+		return nil, errNotAuthorized
+	}
+
+	return nil, errNoMatchingService
+}
+
+func (self *interceptor) isAuthorized(username string, service *entities.Service) bool {
+	if len(self.users) == 0 {
+		return true
+	}
+
+	user := self.users[username]
+	if user == nil {
+		return false
+	}
+
+	if user.Identity == "" {
+		return user.isAuthorized(service.GetName())
+	}
+
+	authorized, err := self.authorizer.IsDialAuthorized(user.Identity, service)
+	if err != nil {
+		pfxlog.Logger().WithError(err).WithField("user", username).WithField("identity", user.Identity).
+			WithField("service", service.GetName()).Info("unable to authorize socks proxy user")
+		return false
+	}
+	return authorized
+}
+
+// authenticate returns true if the credentials are valid, or if no users are configured
+func (self *interceptor) authenticate(username, password string) bool {
+	if len(self.users) == 0 {
+		return true
+	}
+	user := self.users[username]
+	return user != nil && user.authenticate(password)
+}
+
+func (self *interceptor) authRequired() bool {
+	return len(self.users) > 0
+}
+
+type handshake interface {
+	negotiate() (*connectRequest, error)
+	succeed() error
+	fail(err error)
+}
+
+type connectRequest struct {
+	host     string
+	port     uint16
+	username string
+}
+
+func (self *connectRequest) address() string {
+	return net.JoinHostPort(self.host, strconv.Itoa(int(self.port)))
+}
+
+func (self *connectRequest) netAddr() net.Addr {
+	if ip := net.ParseIP(self.host); ip != nil {
+		return &net.TCPAddr{IP: ip, Port: int(self.port)}
+	}
+	return hostAddr(self.address())
+}
+
+type hostAddr string
+
+func (self hostAddr) Network() string {
+	return "tcp"
+}
+
+func (self hostAddr) String() string {
+	return string(self)
+}
+
+// proxiedConn sends the handshake success response before the first read from or write to the client, whichever
+// comes first. Reads happen once the circuit has been established, but servers which speak first may have data
+// written to the client before then, and the response must precede it. Any bytes buffered during the handshake are
+// returned before reading from the underlying connection.
+type proxiedConn struct {
+	net.Conn
+	reader     *bufio.Reader
+	onConnect  func() error
+	once       sync.Once
+	connectErr error
+	done       atomic.Bool
+}
+
+func (self *proxiedConn) connected() bool {
+	return self.done.Load()
+}
+
+func (self *proxiedConn) connect() error {
+	self.once.Do(func() {
+		self.done.Store(true)
+		self.connectErr = self.onConnect()
+	})
+	return self.connectErr
+}
+
+func (self *proxiedConn) Read(b []byte) (int, error) {
+	if err := self.connect(); err != nil {
+		return 0, err
+	}
+
+	if self.reader.Buffered() > 0 {
+		return self.reader.Read(b)
+	}
+	return self.Conn.Read(b)
+}
+
+func (self *proxiedConn) Write(b []byte) (int, error) {
+	if err := self.connect(); err != nil {
+		return 0, err
+	}
+	return self.Conn.Write(b)
+}
+
+func (self *proxiedConn) CloseWrite() error {
+	if cw, ok := self.Conn.(interface{ CloseWrite() error }); ok {
+		return cw.CloseWrite()
+	}
+	return self.Conn.Close()
+}
+
+// LoadUsers parses proxy users from router configuration. Each entry must be a map with a username, a password
+// and either the name of the Ziti identity whose dial access the user has, or a list of service names the user
+// may dial.
+func LoadUsers(value interface{}) ([]*User, error) {
+	list, ok := value.([]interface{})
+	if !ok {
+		return nil, errors.New("invalid value for socks users, must be a list of user definitions")
+	}
+
+	var result []*User
+	for idx, entry := range list {
+		userMap, ok := entry.(map[interface{}]interface{})
+		if !ok {
+			return nil, fmt.Errorf("invalid socks user at index %d, must be a map", idx)
+		}
+
+		user := &User{}
+		if user.Username, ok = userMap["username"].(string); !ok || user.Username == "" {
+			return nil, fmt.Errorf("invalid socks user at index %d, username must be a non-empty string", idx)
+		}
+
+		if user.Password, ok = userMap["password"].(string); !ok {
+			return nil, fmt.Errorf("invalid socks user '%s', password must be a string", user.Username)
+		}
+
+		if identity, found := userMap["identity"]; found {
+			if user.Identity, ok = identity.(string); !ok || user.Identity == "" {
+				return nil, fmt.Errorf("invalid identity for socks user '%s', must be a non-empty string", user.Username)
+			}
+		}
+
+		if services, found := userMap["services"]; found {
+			if user.Identity != "" {
+				return nil, fmt.Errorf("socks user '%s' may have an identity or a list of services, but not both", user.Username)
+			}
+			serviceList, ok := services.([]interface{})
+			if !ok {
+				return nil, fmt.Errorf("invalid services for socks user '%s', must be list of strings", user.Username)
+			}
+			for _, service := range serviceList {
+				serviceName, ok := service.(string)
+				if !ok {
+					return nil, fmt.Errorf("invalid service '%v' for socks user '%s', must be a string", service, user.Username)
+				}
+				user.Services = append(user.Services, serviceName)
+			}
+		}
+
+		result = append(result, user)
+	}
+
+	return result, nil
+}
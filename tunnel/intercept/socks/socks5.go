@@ -0,0 +1,210 @@
+/*
+	Copyright NetFoundry Inc.
+
+	Licensed under the Apache License, Version 2.0 (the "License");
+	you may not use this file except in compliance with the License.
+	You may obtain a copy of the License at
+
+	https://www.apache.org/licenses/LICENSE-2.0
+
+	Unless required by applicable law or agreed to in writing, software
+	distributed under the License is distributed on an "AS IS" BASIS,
+	WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+	See the License for the specific language governing permissions and
+	limitations under the License.
+*/
+
+package socks
+
+import (
+	"bufio"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"io"
+	"net"
+)
+
+// SOCKS5 constants, see RFC 1928 and RFC 1929
+const (
+	socks5Version       = 0x05
+	socks5AuthVersion   = 0x01
+	authMethodNone      = 0x00
+	authMethodPassword  = 0x02
+	authMethodNoneValid = 0xff
+
+	cmdConnect = 0x01
+
+	addrTypeIPv4   = 0x01
+	addrTypeDomain = 0x03
+	addrTypeIPv6   = 0x04
+
+	replySucceeded           = 0x00
+	replyGeneralFailure      = 0x01
+	replyNotAllowed          = 0x02
+	replyHostUnreachable     = 0x04
+	replyCommandNotSupported = 0x07
+	replyAddrTypeUnsupported = 0x08
+)
+
+type socks5Handshake struct {
+	interceptor *interceptor
+	conn        net.Conn
+	reader      *bufio.Reader
+}
+
+func (self *socks5Handshake) negotiate() (*connectRequest, error) {
+	username, err := self.negotiateAuth()
+	if err != nil {
+		return nil, err
+	}
+
+	header := make([]byte, 4)
+	if _, err = io.ReadFull(self.reader, header); err != nil {
+		return nil, err
+	}
+
+	if header[0] != socks5Version {
+		return nil, fmt.Errorf("unsupported socks version %d", header[0])
+	}
+
+	if header[1] != cmdConnect {
+		_ = self.reply(replyCommandNotSupported)
+		return nil, fmt.Errorf("unsupported socks command %d", header[1])
+	}
+
+	req := &connectRequest{username: username}
+
+	switch header[3] {
+	case addrTypeIPv4, addrTypeIPv6:
+		size := net.IPv4len
+		if header[3] == addrTypeIPv6 {
+			size = net.IPv6len
+		}
+		ip := make([]byte, size)
+		if _, err = io.ReadFull(self.reader, ip); err != nil {
+			return nil, err
+		}
+		req.host = net.IP(ip).String()
+	case addrTypeDomain:
+		length, err := self.reader.ReadByte()
+		if err != nil {
+			return nil, err
+		}
+		domain := make([]byte, length)
+		if _, err = io.ReadFull(self.reader, domain); err != nil {
+			return nil, err
+		}
+		req.host = string(domain)
+	default:
+		_ = self.reply(replyAddrTypeUnsupported)
+		return nil, fmt.Errorf("unsupported socks address type %d", header[3])
+	}
+
+	port := make([]byte, 2)
+	if _, err = io.ReadFull(self.reader, port); err != nil {
+		return nil, err
+	}
+	req.port = binary.BigEndian.Uint16(port)
+
+	return req, nil
+}
+
+func (self *socks5Handshake) negotiateAuth() (string, error) {
+	header := make([]byte, 2)
+	if _, err := io.ReadFull(self.reader, header); err != nil {
+		return "", err
+	}
+
+	methods := make([]byte, header[1])
+	if _, err := io.ReadFull(self.reader, methods); err != nil {
+		return "", err
+	}
+
+	method := byte(authMethodNone)
+	if self.interceptor.authRequired() {
+		method = authMethodPassword
+	}
+
+	offered := false
+	for _, m := range methods {
+		if m == method {
+			offered = true
+			break
+		}
+	}
+
+	if !offered {
+		_, _ = self.conn.Write([]byte{socks5Version, authMethodNoneValid})
+		return "", errors.New("client did not offer a supported socks authentication method")
+	}
+
+	if _, err := self.conn.Write([]byte{socks5Version, method}); err != nil {
+		return "", err
+	}
+
+	if method == authMethodNone {
+		return "", nil
+	}
+
+	version, err := self.reader.ReadByte()
+	if err != nil {
+		return "", err
+	}
+
+	if version != socks5AuthVersion {
+		return "", fmt.Errorf("unsupported socks authentication version %d", version)
+	}
+
+	username, err := self.readAuthField()
+	if err != nil {
+		return "", err
+	}
+
+	password, err := self.readAuthField()
+	if err != nil {
+		return "", err
+	}
+
+	if !self.interceptor.authenticate(username, password) {
+		_, _ = self.conn.Write([]byte{socks5AuthVersion, 0x01})
+		return "", fmt.Errorf("authentication failed for user '%s'", username)
+	}
+
+	if _, err = self.conn.Write([]byte{socks5AuthVersion, 0x00}); err != nil {
+		return "", err
+	}
+
+	return username, nil
+}
+
+func (self *socks5Handshake) readAuthField() (string, error) {
+	length, err := self.reader.ReadByte()
+	if err != nil {
+		return "", err
+	}
+	value := make([]byte, length)
+	if _, err = io.ReadFull(self.reader, value); err != nil {
+		return "", err
+	}
+	return string(value), nil
+}
+
+func (self *socks5Handshake) reply(code byte) error {
+	_, err := self.conn.Write([]byte{socks5Version, code, 0x00, addrTypeIPv4, 0, 0, 0, 0, 0, 0})
+	return err
+}
+
+func (self *socks5Handshake) succeed() error {
+	return self.reply(replySucceeded)
+}
+
+func (self *socks5Handshake) fail(err error) {
+	code := byte(replyGeneralFailure)
+	if errors.Is(err, errNotAuthorized) {
+		code = replyNotAllowed
+	} else if errors.Is(err, errNoMatchingService) {
+		code = replyHostUnreachable
+	}
+	_ = self.reply(code)
+}
@@ -0,0 +1,248 @@
+package socks
+
+import (
+	"bufio"
+	"encoding/base64"
+	"io"
+	"net"
+	"testing"
+
+	"github.com/openziti/edge-api/rest_model"
+	"github.com/openziti/foundation/v2/util"
+	"github.com/openziti/ziti/tunnel/entities"
+	"github.com/stretchr/testify/require"
+)
+
+func newTestService(name string, addresses ...string) *entities.Service {
+	return &entities.Service{
+		ServiceDetail: rest_model.ServiceDetail{
+			BaseEntity: rest_model.BaseEntity{ID: util.Ptr(name + "-id")},
+			Name:       util.Ptr(name),
+		},
+		InterceptV1Config: &entities.InterceptV1Config{
+			Addresses:  addresses,
+			PortRanges: []*entities.PortRange{{Low: 80, High: 443}},
+			Protocols:  []string{"tcp"},
+		},
+	}
+}
+
+func newTestInterceptor(users ...*User) *interceptor {
+	result := &interceptor{
+		services: map[string]*entities.Service{},
+		users:    map[string]*User{},
+	}
+	for _, user := range users {
+		result.users[user.Username] = user
+	}
+	for _, svc := range []*entities.Service{
+		newTestService("web", "*.web.ziti"),
+		newTestService("db", "10.0.0.0/24", "db.internal"),
+	} {
+		result.services[svc.GetName()] = svc
+	}
+	return result
+}
+
+func Test_Authorize(t *testing.T) {
+	req := require.New(t)
+
+	anon := newTestInterceptor()
+	svc, err := anon.authorize(&connectRequest{host: "app.web.ziti", port: 443})
+	req.NoError(err)
+	req.Equal("web", svc.GetName())
+
+	svc, err = anon.authorize(&connectRequest{host: "10.0.0.5", port: 80})
+	req.NoError(err)
+	req.Equal("db", svc.GetName())
+
+	_, err = anon.authorize(&connectRequest{host: "db.internal", port: 8080})
+	req.ErrorIs(err, errNoMatchingService)
+
+	authed := newTestInterceptor(&User{Username: "ci", Password: "secret", Services: []string{"web"}})
+	_, err = authed.authorize(&connectRequest{host: "app.web.ziti", port: 80, username: "ci"})
+	req.NoError(err)
+
+	_, err = authed.authorize(&connectRequest{host: "db.internal", port: 80, username: "ci"})
+	req.ErrorIs(err, errNotAuthorized)
+
+	_, err = authed.authorize(&connectRequest{host: "app.web.ziti", port: 80, username: "other"})
+	req.ErrorIs(err, errNotAuthorized)
+}
+
+func Test_Socks5Negotiate(t *testing.T) {
+	req := require.New(t)
+
+	server, client := net.Pipe()
+	defer func() { _ = server.Close() }()
+	defer func() { _ = client.Close() }()
+
+	h := &socks5Handshake{
+		interceptor: newTestInterceptor(&User{Username: "ci", Password: "secret", Services: []string{AllServices}}),
+		conn:        server,
+		reader:      bufio.NewReader(server),
+	}
+
+	go func() {
+		_, _ = client.Write([]byte{socks5Version, 2, authMethodNone, authMethodPassword})
+		reply := make([]byte, 2)
+		_, _ = io.ReadFull(client, reply)
+		_, _ = client.Write(append(append([]byte{socks5AuthVersion, 2}, "ci"...), append([]byte{6}, "secret"...)...))
+		_, _ = io.ReadFull(client, reply)
+		host := "app.web.ziti"
+		request := append([]byte{socks5Version, cmdConnect, 0, addrTypeDomain, byte(len(host))}, host...)
+		_, _ = client.Write(append(request, 0x01, 0xbb))
+	}()
+
+	connectReq, err := h.negotiate()
+	req.NoError(err)
+	req.Equal("app.web.ziti", connectReq.host)
+	req.Equal(uint16(443), connectReq.port)
+	req.Equal("ci", connectReq.username)
+}
+
+func Test_HttpConnectNegotiate(t *testing.T) {
+	req := require.New(t)
+
+	server, client := net.Pipe()
+	defer func() { _ = server.Close() }()
+	defer func() { _ = client.Close() }()
+
+	h := &httpConnectHandshake{
+		interceptor: newTestInterceptor(&User{Username: "ci", Password: "secret", Services: []string{AllServices}}),
+		conn:        server,
+		reader:      bufio.NewReader(server),
+	}
+
+	go func() {
+		auth := base64.StdEncoding.EncodeToString([]byte("ci:secret"))
+		_, _ = client.Write([]byte("CONNECT 10.0.0.5:80 HTTP/1.1\r\nHost: 10.0.0.5:80\r\nProxy-Authorization: Basic " + auth + "\r\n\r\n"))
+	}()
+
+	connectReq, err := h.negotiate()
+	req.NoError(err)
+	req.Equal("10.0.0.5", connectReq.host)
+	req.Equal(uint16(80), connectReq.port)
+	req.Equal("ci", connectReq.username)
+}
+
+type testAuthorizer map[string][]string
+
+func (self testAuthorizer) IsDialAuthorized(identity string, service *entities.Service) (bool, error) {
+	for _, name := range self[identity] {
+		if name == service.GetName() {
+			return true, nil
+		}
+	}
+	return false, nil
+}
+
+func Test_AuthorizePrefersMostSpecificAuthorizedService(t *testing.T) {
+	req := require.New(t)
+
+	interceptor := newTestInterceptor(&User{Username: "ci", Services: []string{"db", "db-primary"}})
+	for _, svc := range []*entities.Service{
+		newTestService("all-internal", "10.0.0.0/8"),
+		newTestService("db-primary", "10.0.0.10"),
+		newTestService("a-wildcard", "*.internal"),
+		newTestService("db-replica", "10.0.0.20"),
+	} {
+		interceptor.services[svc.GetName()] = svc
+	}
+
+	svc, err := interceptor.authorize(&connectRequest{host: "10.0.0.10", port: 80, username: "ci"})
+	req.NoError(err)
+	req.Equal("db-primary", svc.GetName())
+
+	// all-internal matches first by name and db-replica matches most specifically, but neither is authorized
+	svc, err = interceptor.authorize(&connectRequest{host: "10.0.0.20", port: 80, username: "ci"})
+	req.NoError(err)
+	req.Equal("db", svc.GetName())
+
+	// a-wildcard sorts first, but the exact hostname match of db is more specific
+	svc, err = interceptor.authorize(&connectRequest{host: "db.internal", port: 80, username: "ci"})
+	req.NoError(err)
+	req.Equal("db", svc.GetName())
+
+	_, err = interceptor.authorize(&connectRequest{host: "10.1.0.5", port: 80, username: "ci"})
+	req.ErrorIs(err, errNotAuthorized)
+}
+
+func Test_AuthorizeByIdentity(t *testing.T) {
+	req := require.New(t)
+
+	interceptor := newTestInterceptor(&User{Username: "ci", Identity: "build-server"})
+	interceptor.authorizer = testAuthorizer{"build-server": {"web"}}
+
+	svc, err := interceptor.authorize(&connectRequest{host: "app.web.ziti", port: 443, username: "ci"})
+	req.NoError(err)
+	req.Equal("web", svc.GetName())
+
+	_, err = interceptor.authorize(&connectRequest{host: "db.internal", port: 443, username: "ci"})
+	req.ErrorIs(err, errNotAuthorized)
+}
+
+func Test_LoadUsers(t *testing.T) {
+	req := require.New(t)
+
+	users, err := LoadUsers([]interface{}{
+		map[interface{}]interface{}{"username": "ci", "password": "secret", "identity": "build-server"},
+		map[interface{}]interface{}{"username": "ops", "password": "secret", "services": []interface{}{"web"}},
+	})
+	req.NoError(err)
+	req.Len(users, 2)
+	req.Equal("build-server", users[0].Identity)
+	req.Equal([]string{"web"}, users[1].Services)
+
+	_, err = LoadUsers([]interface{}{
+		map[interface{}]interface{}{"username": "ci", "password": "secret", "identity": "build-server", "services": []interface{}{"web"}},
+	})
+	req.Error(err)
+}
+
+func Test_UnauthenticatedProxyRequiresLoopback(t *testing.T) {
+	req := require.New(t)
+
+	req.NoError(requireLoopback("127.0.0.1:1080"))
+	req.NoError(requireLoopback("[::1]:1080"))
+	req.NoError(requireLoopback("localhost:1080"))
+	req.Error(requireLoopback("0.0.0.0:1080"))
+	req.Error(requireLoopback("10.0.0.1:1080"))
+
+	_, err := New(Config{Address: "0.0.0.0:0"}, nil)
+	req.Error(err)
+
+	_, err = New(Config{Address: "127.0.0.1:0", Users: []*User{{Username: "ci", Identity: "build-server"}}}, nil)
+	req.Error(err)
+}
+
+func Test_ConnectResponsePrecedesServerFirstData(t *testing.T) {
+	req := require.New(t)
+
+	server, client := net.Pipe()
+	defer func() { _ = server.Close() }()
+	defer func() { _ = client.Close() }()
+
+	connects := 0
+	conn := &proxiedConn{
+		Conn:   server,
+		reader: bufio.NewReader(server),
+		onConnect: func() error {
+			connects++
+			_, err := server.Write([]byte("OK"))
+			return err
+		},
+	}
+
+	go func() {
+		_, _ = conn.Write([]byte("SSH-2.0"))
+		_, _ = conn.Write([]byte("!"))
+	}()
+
+	buf := make([]byte, 10)
+	_, err := io.ReadFull(client, buf)
+	req.NoError(err)
+	req.Equal("OKSSH-2.0!", string(buf))
+	req.Equal(1, connects)
+	req.True(conn.connected())
+}
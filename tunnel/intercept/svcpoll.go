@@ -64,6 +64,11 @@ type ServiceListenerGroup struct {
 	sync.Mutex
 }
 
+// EnableExecHealthChecks controls whether exec health checks are run for services hosted by listeners in the group
+func (self *ServiceListenerGroup) EnableExecHealthChecks(enabled bool) {
+	self.healthCheckMgr.EnableExecChecks(enabled)
+}
+
 func (self *ServiceListenerGroup) NewServiceListener() *ServiceListener {
 	result := &ServiceListener{
 		interceptor:    self.interceptor,
@@ -114,6 +119,11 @@ type ServiceListener struct {
 	*sync.Mutex
 }
 
+// EnableExecHealthChecks controls whether exec health checks are run for services hosted by this listener
+func (self *ServiceListener) EnableExecHealthChecks(enabled bool) {
+	self.healthCheckMgr.EnableExecChecks(enabled)
+}
+
 func (self *ServiceListener) WaitForShutdown() {
 	sig := make(chan os.Signal, 1) //signal.Notify expects a buffered chan of at least 1
 	signal.Notify(sig, syscall.SIGINT, syscall.SIGTERM)
@@ -333,8 +333,9 @@ func (self *tProxy) acceptTCP() {
 }
 
 func (self *tProxy) acceptUDP() {
-	expirationPolicy := udp_vconn.NewTimeoutExpirationPolicy(self.interceptor.udpIdleTimeout, self.interceptor.udpCheckInterval)
-	vconnMgr := udp_vconn.NewManager(self.service.GetFabricProvider(), udp_vconn.NewUnlimitedConnectionPolicy(), expirationPolicy)
+	defaultExpirationPolicy := udp_vconn.NewTimeoutExpirationPolicy(self.interceptor.udpIdleTimeout, self.interceptor.udpCheckInterval)
+	expirationPolicy := udp_vconn.NewExpirationPolicyForService(self.service, defaultExpirationPolicy)
+	vconnMgr := udp_vconn.NewManager(self.service.GetFabricProvider(), udp_vconn.NewConnPolicyForService(self.service), expirationPolicy)
 	self.generateReadEvents(vconnMgr)
 }
 
@@ -392,6 +393,7 @@ func (event *udpReadEvent) Handle(manager udp_vconn.Manager) error {
 		writeConn := packetConn.(*net.UDPConn)
 		writeQueue, err = manager.CreateWriteQueue(origDest, event.srcAddr, event.interceptor.service, writeConn)
 		if err != nil {
+			_ = writeConn.Close()
 			event.buf.Release()
 			return err
 		}
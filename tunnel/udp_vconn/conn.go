@@ -19,6 +19,7 @@ package udp_vconn
 import (
 	"github.com/michaelquigley/pfxlog"
 	"github.com/openziti/foundation/v2/mempool"
+	"github.com/openziti/ziti/common/inspect"
 	"github.com/sirupsen/logrus"
 	"io"
 	"net"
@@ -33,8 +34,13 @@ type udpConn struct {
 	srcAddr     net.Addr
 	manager     *manager
 	writeConn   UDPWriterTo
+	createdAt   time.Time
 	lastUse     atomic.Value
 	closed      atomic.Bool
+	rxBytes     atomic.Uint64
+	rxPackets   atomic.Uint64
+	txBytes     atomic.Uint64
+	txPackets   atomic.Uint64
 	leftOver    []byte
 	leftOverBuf mempool.PooledBuffer
 }
@@ -45,6 +51,8 @@ func (conn *udpConn) Service() string {
 
 func (conn *udpConn) Accept(buffer mempool.PooledBuffer) {
 	logrus.WithField("udpConnId", conn.srcAddr.String()).Debugf("udp->ziti: queuing")
+	conn.rxBytes.Add(uint64(len(buffer.GetPayload())))
+	conn.rxPackets.Add(1)
 	select {
 	case conn.readC <- buffer:
 	case <-conn.closeNotify:
@@ -62,6 +70,22 @@ func (conn *udpConn) GetLastUsed() time.Time {
 	return val.(time.Time)
 }
 
+func (conn *udpConn) inspect(now time.Time) *inspect.UdpFlowDetail {
+	lastUsed := conn.GetLastUsed()
+	return &inspect.UdpFlowDetail{
+		Service:   conn.service,
+		SrcAddr:   conn.srcAddr.String(),
+		LocalAddr: conn.LocalAddr().String(),
+		CreatedAt: conn.createdAt.Format(time.RFC3339),
+		Age:       now.Sub(conn.createdAt).Truncate(time.Millisecond).String(),
+		Idle:      now.Sub(lastUsed).Truncate(time.Millisecond).String(),
+		RxBytes:   conn.rxBytes.Load(),
+		RxPackets: conn.rxPackets.Load(),
+		TxBytes:   conn.txBytes.Load(),
+		TxPackets: conn.txPackets.Load(),
+	}
+}
+
 func (conn *udpConn) WriteTo(w io.Writer) (n int64, err error) {
 	var bytesWritten int64
 	for {
@@ -149,6 +173,10 @@ func (conn *udpConn) Write(b []byte) (int, error) {
 	// TODO: UDP chunking, MTU chunking?
 	n, err := conn.writeConn.WriteTo(b, conn.srcAddr)
 	conn.markUsed()
+	if err == nil {
+		conn.txBytes.Add(uint64(n))
+		conn.txPackets.Add(1)
+	}
 	return n, err
 }
 
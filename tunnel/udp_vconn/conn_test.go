@@ -0,0 +1,115 @@
+package udp_vconn
+
+import (
+	"net"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/openziti/foundation/v2/mempool"
+	"github.com/openziti/ziti/common/inspect"
+	"github.com/stretchr/testify/require"
+)
+
+type testWriter struct {
+	sync.Mutex
+	written [][]byte
+	closed  bool
+}
+
+func (self *testWriter) WriteTo(b []byte, _ net.Addr) (int, error) {
+	self.Lock()
+	defer self.Unlock()
+	self.written = append(self.written, append([]byte(nil), b...))
+	return len(b), nil
+}
+
+func (self *testWriter) Close() error {
+	self.Lock()
+	defer self.Unlock()
+	self.closed = true
+	return nil
+}
+
+func (self *testWriter) LocalAddr() net.Addr {
+	return &net.UDPAddr{IP: net.IPv4(127, 0, 0, 1), Port: 5000}
+}
+
+func newTestConn(srcPort int, writer *testWriter) *udpConn {
+	conn := &udpConn{
+		readC:       make(chan mempool.PooledBuffer, 4),
+		closeNotify: make(chan struct{}),
+		service:     "echo",
+		srcAddr:     &net.UDPAddr{IP: net.IPv4(10, 0, 0, 1), Port: srcPort},
+		writeConn:   writer,
+		createdAt:   time.Now().Add(-time.Minute),
+	}
+	conn.markUsed()
+	return conn
+}
+
+func Test_ConnCounters(t *testing.T) {
+	req := require.New(t)
+
+	writer := &testWriter{}
+	conn := newTestConn(4000, writer)
+
+	conn.Accept(UnpooledBuffer("hello"))
+	conn.Accept(UnpooledBuffer("hi"))
+
+	buf := make([]byte, 16)
+	n, err := conn.Read(buf)
+	req.NoError(err)
+	req.Equal("hello", string(buf[:n]))
+
+	_, err = conn.Write([]byte("response"))
+	req.NoError(err)
+
+	detail := conn.inspect(time.Now())
+	req.Equal("echo", detail.Service)
+	req.Equal("10.0.0.1:4000", detail.SrcAddr)
+	req.Equal("127.0.0.1:5000", detail.LocalAddr)
+	req.Equal(uint64(7), detail.RxBytes)
+	req.Equal(uint64(2), detail.RxPackets)
+	req.Equal(uint64(8), detail.TxBytes)
+	req.Equal(uint64(1), detail.TxPackets)
+	age, err := time.ParseDuration(detail.Age)
+	req.NoError(err)
+	req.GreaterOrEqual(age, time.Minute)
+
+	req.NoError(conn.Close())
+	req.True(writer.closed)
+}
+
+func Test_InspectFlows(t *testing.T) {
+	req := require.New(t)
+
+	m := &manager{
+		eventC:           make(chan Event, 4),
+		connMap:          map[string]*udpConn{},
+		newConnPolicy:    NewConnPolicyForService(newTestService(nil)),
+		expirationPolicy: NewTimeoutExpirationPolicy(time.Hour, time.Hour),
+	}
+
+	open := newTestConn(4000, &testWriter{})
+	open.Accept(UnpooledBuffer("hello"))
+	m.connMap[open.srcAddr.String()] = open
+
+	closed := newTestConn(4001, &testWriter{})
+	_ = closed.Close()
+	m.connMap[closed.srcAddr.String()] = closed
+
+	go m.run()
+	defer close(m.eventC)
+
+	var result *inspect.UdpFlowsInspectResult
+	req.Eventually(func() bool {
+		result = InspectFlows(time.Second)
+		return len(result.Flows) > 0
+	}, time.Second, 10*time.Millisecond)
+
+	req.Empty(result.Errors)
+	req.Len(result.Flows, 1)
+	req.Equal("10.0.0.1:4000", result.Flows[0].SrcAddr)
+	req.Equal(uint64(5), result.Flows[0].RxBytes)
+}
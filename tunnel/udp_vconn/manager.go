@@ -18,8 +18,11 @@ package udp_vconn
 
 import (
 	"errors"
+	"fmt"
 	"github.com/michaelquigley/pfxlog"
+	"github.com/openziti/foundation/v2/concurrenz"
 	"github.com/openziti/foundation/v2/mempool"
+	"github.com/openziti/ziti/common/inspect"
 	"github.com/openziti/ziti/tunnel"
 	"github.com/openziti/ziti/tunnel/entities"
 	"io"
@@ -28,6 +31,37 @@ import (
 	"time"
 )
 
+// ErrMaxFlowsExceeded is returned from CreateWriteQueue when the flow limit is reached and the policy is to drop new flows
+var ErrMaxFlowsExceeded = errors.New("max udp flows exceeded")
+
+var managers = &concurrenz.CopyOnWriteSlice[*manager]{}
+
+// InspectFlows returns the active flows from all running UDP vconn managers
+func InspectFlows(timeout time.Duration) *inspect.UdpFlowsInspectResult {
+	result := &inspect.UdpFlowsInspectResult{}
+	deadline := time.After(timeout)
+
+	for _, m := range managers.Value() {
+		event := &inspectEvent{resultC: make(chan []*inspect.UdpFlowDetail, 1)}
+		select {
+		case m.eventC <- event:
+		case <-deadline:
+			result.Errors = append(result.Errors, "timed out queuing udp flow inspection")
+			return result
+		}
+
+		select {
+		case flows := <-event.resultC:
+			result.Flows = append(result.Flows, flows...)
+		case <-deadline:
+			result.Errors = append(result.Errors, "timed out waiting for udp flow inspection")
+			return result
+		}
+	}
+
+	return result
+}
+
 type manager struct {
 	eventC           chan Event
 	provider         tunnel.FabricProvider
@@ -48,6 +82,9 @@ func (manager *manager) run() {
 	log := pfxlog.Logger()
 	defer log.Info("shutting down udp listener")
 
+	managers.Append(manager)
+	defer managers.Delete(manager)
+
 	timer := time.NewTicker(manager.expirationPolicy.PollFrequency())
 	defer timer.Stop()
 
@@ -63,7 +100,9 @@ func (manager *manager) run() {
 				log.Errorf("EOF detected. stopping UDP event loop")
 				return
 			}
-			if err != nil {
+			if errors.Is(err, ErrMaxFlowsExceeded) {
+				log.WithError(err).Debug("dropping udp datagram")
+			} else if err != nil {
 				log.Errorf("error while handling udp event: %v", err)
 			}
 		case <-timer.C:
@@ -86,7 +125,7 @@ func (manager *manager) CreateWriteQueue(targetAddr *net.UDPAddr, srcAddr net.Ad
 	case AllowDropLRU:
 		manager.dropLRU()
 	case Deny:
-		return nil, errors.New("max connections exceeded")
+		return nil, fmt.Errorf("unable to create flow for %v on service %v: %w", srcAddr, *service.Name, ErrMaxFlowsExceeded)
 	}
 	conn := &udpConn{
 		readC:       make(chan mempool.PooledBuffer, 4),
@@ -95,6 +134,7 @@ func (manager *manager) CreateWriteQueue(targetAddr *net.UDPAddr, srcAddr net.Ad
 		srcAddr:     srcAddr,
 		manager:     manager,
 		writeConn:   writeConn,
+		createdAt:   time.Now(),
 	}
 	conn.markUsed()
 	manager.connMap[srcAddr.String()] = conn
@@ -141,6 +181,23 @@ func (manager *manager) close(conn *udpConn) {
 	delete(manager.connMap, conn.srcAddr.String())
 }
 
+type inspectEvent struct {
+	resultC chan []*inspect.UdpFlowDetail
+}
+
+func (event *inspectEvent) Handle(m Manager) error {
+	manager := m.(*manager)
+	now := time.Now()
+	var result []*inspect.UdpFlowDetail
+	for _, conn := range manager.connMap {
+		if !conn.closed.Load() {
+			result = append(result, conn.inspect(now))
+		}
+	}
+	event.resultC <- result
+	return nil
+}
+
 type errorEvent struct {
 	error
 }
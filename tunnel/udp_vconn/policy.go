@@ -18,6 +18,8 @@ package udp_vconn
 
 import (
 	"time"
+
+	"github.com/openziti/ziti/tunnel/entities"
 )
 
 func NewUnlimitedConnectionPolicy() NewConnPolicy {
@@ -84,3 +86,40 @@ func (policy *timeoutExpirationPolicy) IsExpired(now, lastUsed time.Time) bool {
 func (policy *timeoutExpirationPolicy) PollFrequency() time.Duration {
 	return policy.checkInterval
 }
+
+// NewConnPolicyForService returns a flow limit policy based on the udpOptions in the service's intercept.v1
+// config. If the service doesn't configure a flow limit, flows are unlimited.
+func NewConnPolicyForService(service *entities.Service) NewConnPolicy {
+	options := getUdpOptions(service)
+	if options == nil || options.MaxFlows == nil {
+		return NewUnlimitedConnectionPolicy()
+	}
+
+	if options.FlowLimitPolicy != nil && *options.FlowLimitPolicy == entities.UdpFlowLimitPolicyDropLRU {
+		return NewLimitedConnectionPolicyDropLRU(*options.MaxFlows)
+	}
+	return NewLimitedConnectionPolicyDropNew(*options.MaxFlows)
+}
+
+// NewExpirationPolicyForService returns a timeout expiration policy using the idle timeout from the udpOptions in
+// the service's intercept.v1 config. If the service doesn't configure an idle timeout, defaultPolicy is returned.
+func NewExpirationPolicyForService(service *entities.Service, defaultPolicy ConnExpirationPolicy) ConnExpirationPolicy {
+	options := getUdpOptions(service)
+	if options == nil || options.IdleTimeoutSeconds == nil || *options.IdleTimeoutSeconds <= 0 {
+		return defaultPolicy
+	}
+
+	timeout := time.Duration(*options.IdleTimeoutSeconds) * time.Second
+	checkInterval := defaultPolicy.PollFrequency()
+	if checkInterval > timeout {
+		checkInterval = timeout
+	}
+	return NewTimeoutExpirationPolicy(timeout, checkInterval)
+}
+
+func getUdpOptions(service *entities.Service) *entities.UdpOptions {
+	if service == nil || service.InterceptV1Config == nil {
+		return nil
+	}
+	return service.InterceptV1Config.UdpOptions
+}
@@ -0,0 +1,51 @@
+package udp_vconn
+
+import (
+	"testing"
+	"time"
+
+	"github.com/openziti/foundation/v2/util"
+	"github.com/openziti/ziti/tunnel/entities"
+	"github.com/stretchr/testify/require"
+)
+
+func newTestService(options *entities.UdpOptions) *entities.Service {
+	return &entities.Service{
+		InterceptV1Config: &entities.InterceptV1Config{
+			Protocols:  []string{"udp"},
+			UdpOptions: options,
+		},
+	}
+}
+
+func Test_NewConnPolicyForService(t *testing.T) {
+	req := require.New(t)
+
+	policy := NewConnPolicyForService(&entities.Service{})
+	req.Equal(Allow, policy.NewConnection(100000))
+
+	policy = NewConnPolicyForService(newTestService(&entities.UdpOptions{MaxFlows: util.Ptr(uint32(2))}))
+	req.Equal(Allow, policy.NewConnection(1))
+	req.Equal(Deny, policy.NewConnection(2))
+
+	policy = NewConnPolicyForService(newTestService(&entities.UdpOptions{
+		MaxFlows:        util.Ptr(uint32(2)),
+		FlowLimitPolicy: util.Ptr(entities.UdpFlowLimitPolicyDropLRU),
+	}))
+	req.Equal(Allow, policy.NewConnection(1))
+	req.Equal(AllowDropLRU, policy.NewConnection(2))
+}
+
+func Test_NewExpirationPolicyForService(t *testing.T) {
+	req := require.New(t)
+
+	defaultPolicy := NewTimeoutExpirationPolicy(time.Minute, 10*time.Second)
+	req.Equal(defaultPolicy, NewExpirationPolicyForService(newTestService(nil), defaultPolicy))
+
+	policy := NewExpirationPolicyForService(newTestService(&entities.UdpOptions{IdleTimeoutSeconds: util.Ptr(5)}), defaultPolicy)
+	req.Equal(5*time.Second, policy.PollFrequency())
+
+	now := time.Now()
+	req.False(policy.IsExpired(now, now.Add(-4*time.Second)))
+	req.True(policy.IsExpired(now, now.Add(-6*time.Second)))
+}
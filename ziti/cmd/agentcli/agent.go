@@ -94,6 +94,7 @@ func NewAgentCmd(p common.OptionsProvider) *cobra.Command {
 	routerCmd.AddCommand(NewRouteCmd(p))
 	routerCmd.AddCommand(NewUnrouteCmd(p))
 	routerCmd.AddCommand(NewSimpleAgentCustomCmd("dump-api-sessions", AgentAppRouter, router.DumpApiSessions, p))
+	routerCmd.AddCommand(NewSimpleAgentCustomCmd("dump-udp-flows", AgentAppRouter, router.DumpUdpFlows, p))
 	routerCmd.AddCommand(NewSimpleChAgentCustomCmd("dump-routes", AgentAppRouter, int32(mgmt_pb.ContentType_RouterDebugDumpForwarderTablesRequestType), p))
 	routerCmd.AddCommand(NewSimpleChAgentCustomCmd("dump-links", AgentAppRouter, int32(mgmt_pb.ContentType_RouterDebugDumpLinksRequestType), p))
 	routerCmd.AddCommand(NewForgetLinkAgentCmd(p))
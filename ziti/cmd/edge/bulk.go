@@ -0,0 +1,344 @@
+/*
+	Copyright NetFoundry Inc.
+
+	Licensed under the Apache License, Version 2.0 (the "License");
+	you may not use this file except in compliance with the License.
+	You may obtain a copy of the License at
+
+	https://www.apache.org/licenses/LICENSE-2.0
+
+	Unless required by applicable law or agreed to in writing, software
+	distributed under the License is distributed on an "AS IS" BASIS,
+	WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+	See the License for the specific language governing permissions and
+	limitations under the License.
+*/
+
+package edge
+
+import (
+	"fmt"
+	"io"
+	"time"
+
+	"github.com/go-openapi/strfmt"
+	"github.com/jedib0t/go-pretty/v6/table"
+	"github.com/openziti/foundation/v2/stringz"
+	"github.com/openziti/ziti/controller/rest_client/bulk_operation"
+	"github.com/openziti/ziti/controller/rest_model"
+	"github.com/openziti/ziti/ziti/cmd/api"
+	"github.com/openziti/ziti/ziti/cmd/common"
+	cmdhelper "github.com/openziti/ziti/ziti/cmd/helpers"
+	"github.com/openziti/ziti/ziti/util"
+	"github.com/pkg/errors"
+	"github.com/spf13/cobra"
+)
+
+// newBulkCmd creates a command object for the "bulk" command
+func newBulkCmd(out io.Writer, errOut io.Writer) *cobra.Command {
+	bulkCmd := &cobra.Command{
+		Use:   "bulk",
+		Short: "applies an operation to many identities at once",
+		Long: "Applies an operation to the identities selected by id or by filter. Identities are updated in batches. " +
+			"Unless --no-wait is given, the command waits for the operation to complete, reporting progress and any failures",
+		Run: func(cmd *cobra.Command, args []string) {
+			cmdhelper.CheckErr(cmd.Help())
+		},
+	}
+
+	bulkCmd.AddCommand(newBulkOperationCmd(out, errOut, rest_model.BulkOperationCreateOperationAddRoleAttributes,
+		"add-role-attributes <attribute> [attribute...]", "adds role attributes to identities", cobra.MinimumNArgs(1)))
+	bulkCmd.AddCommand(newBulkOperationCmd(out, errOut, rest_model.BulkOperationCreateOperationRemoveRoleAttributes,
+		"remove-role-attributes <attribute> [attribute...]", "removes role attributes from identities", cobra.MinimumNArgs(1)))
+	bulkCmd.AddCommand(newBulkOperationCmd(out, errOut, rest_model.BulkOperationCreateOperationSetAuthPolicy,
+		"set-auth-policy <auth policy id or name>", "sets the auth policy of identities", cobra.ExactArgs(1)))
+	bulkCmd.AddCommand(newBulkOperationCmd(out, errOut, rest_model.BulkOperationCreateOperationDisable,
+		"disable", "disables identities", cobra.NoArgs))
+	bulkCmd.AddCommand(newBulkOperationCmd(out, errOut, rest_model.BulkOperationCreateOperationEnable,
+		"enable", "enables identities", cobra.NoArgs))
+	bulkCmd.AddCommand(newBulkOperationCmd(out, errOut, rest_model.BulkOperationCreateOperationDelete,
+		"delete", "deletes identities", cobra.NoArgs))
+	bulkCmd.AddCommand(newBulkOperationCmd(out, errOut, rest_model.BulkOperationCreateOperationSetExpiresAt,
+		"set-expires-at <RFC3339 date|none>", "sets when identities expire, or clears the expiry date if 'none' is given", cobra.ExactArgs(1)))
+	bulkCmd.AddCommand(newBulkStatusCmd(out, errOut))
+	bulkCmd.AddCommand(newBulkListCmd(out, errOut))
+	return bulkCmd
+}
+
+type bulkOperationAction struct {
+	api.Options
+	operation   string
+	identityIds []string
+	filter      string
+	batchSize   int64
+	duration    time.Duration
+	noWait      bool
+}
+
+func newBulkOperationCmd(out io.Writer, errOut io.Writer, operation string, use string, short string, args cobra.PositionalArgs) *cobra.Command {
+	action := &bulkOperationAction{
+		Options: api.Options{
+			CommonOptions: common.CommonOptions{
+				Out: out,
+				Err: errOut,
+			},
+		},
+		operation: operation,
+	}
+
+	cmd := &cobra.Command{
+		Use:   use,
+		Short: short,
+		Args:  args,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			action.Cmd = cmd
+			action.Args = args
+			return action.run(args)
+		},
+	}
+
+	action.AddCommonFlags(cmd)
+	cmd.Flags().StringSliceVar(&action.identityIds, "ids", nil, "ids of the identities to apply the operation to")
+	cmd.Flags().StringVar(&action.filter, "filter", "", `filter selecting the identities to apply the operation to, ex: 'anyOf(roleAttributes) = "sales"'`)
+	cmd.Flags().Int64Var(&action.batchSize, "batch-size", 0, "number of identities to update per batch, defaults to 100")
+	cmd.Flags().BoolVar(&action.noWait, "no-wait", false, "return once the operation has started, without waiting for it to complete")
+	if operation == rest_model.BulkOperationCreateOperationDisable {
+		cmd.Flags().DurationVar(&action.duration, "duration", 0, "how long to disable identities for, ex: 24h. If not set, identities are disabled until enabled")
+	}
+
+	return cmd
+}
+
+func (self *bulkOperationAction) run(args []string) error {
+	if (len(self.identityIds) == 0) == (self.filter == "") {
+		return errors.New("exactly one of --ids or --filter must be provided")
+	}
+
+	create := &rest_model.BulkOperationCreate{
+		Operation:       &self.operation,
+		IdentityIds:     self.identityIds,
+		Filter:          self.filter,
+		BatchSize:       self.batchSize,
+		DurationMinutes: int64(self.duration / time.Minute),
+	}
+
+	switch self.operation {
+	case rest_model.BulkOperationCreateOperationAddRoleAttributes, rest_model.BulkOperationCreateOperationRemoveRoleAttributes:
+		create.RoleAttributes = args
+	case rest_model.BulkOperationCreateOperationSetAuthPolicy:
+		authPolicyId, err := mapNameToID("auth-policies", args[0], self.Options)
+		if err != nil {
+			return err
+		}
+		create.AuthPolicyID = authPolicyId
+	case rest_model.BulkOperationCreateOperationSetExpiresAt:
+		if args[0] != "none" {
+			expiresAt, err := time.Parse(time.RFC3339, args[0])
+			if err != nil {
+				return errors.Wrapf(err, "invalid expiry date '%s', must be RFC3339, ex: 2026-12-31T00:00:00Z", args[0])
+			}
+			create.ExpiresAt = (*strfmt.DateTime)(&expiresAt)
+		}
+	}
+
+	client, err := util.NewFabricManagementClient(self)
+	if err != nil {
+		return err
+	}
+
+	ctx, cancelF := self.TimeoutContext()
+	defer cancelF()
+
+	result, err := client.BulkOperation.CreateBulkOperation(&bulk_operation.CreateBulkOperationParams{
+		Context:   ctx,
+		Operation: create,
+	})
+	if err != nil {
+		return util.WrapIfApiError(err)
+	}
+
+	op := result.Payload.Data
+	if self.OutputJSONResponse || self.noWait {
+		if !self.OutputJSONResponse {
+			_, _ = fmt.Fprintf(self.Out, "started bulk operation %s on %d identities\n", stringz.OrEmpty(op.ID), *op.Total)
+		}
+		return nil
+	}
+
+	var lastProcessed int64 = -1
+	for {
+		if *op.Processed != lastProcessed {
+			lastProcessed = *op.Processed
+			_, _ = fmt.Fprintf(self.Out, "processed %d/%d identities, %d failed\n", *op.Processed, *op.Total, *op.Failed)
+		}
+
+		if *op.Complete {
+			break
+		}
+
+		time.Sleep(time.Second)
+
+		if op, err = getBulkOperation(&self.Options, *op.ID); err != nil {
+			return err
+		}
+	}
+
+	renderBulkOperationFailures(&self.Options, op)
+
+	if *op.Failed > 0 {
+		return errors.Errorf("bulk operation %s failed for %d of %d identities", *op.ID, *op.Failed, *op.Total)
+	}
+	return nil
+}
+
+func getBulkOperation(o *api.Options, id string) (*rest_model.BulkOperationDetail, error) {
+	client, err := util.NewFabricManagementClient(o)
+	if err != nil {
+		return nil, err
+	}
+
+	ctx, cancelF := o.TimeoutContext()
+	defer cancelF()
+
+	result, err := client.BulkOperation.DetailBulkOperation(&bulk_operation.DetailBulkOperationParams{
+		Context: ctx,
+		ID:      id,
+	})
+	if err != nil {
+		return nil, util.WrapIfApiError(err)
+	}
+	return result.Payload.Data, nil
+}
+
+func renderBulkOperationFailures(o *api.Options, op *rest_model.BulkOperationDetail) {
+	if len(op.Failures) == 0 {
+		return
+	}
+
+	t := table.NewWriter()
+	t.SetStyle(table.StyleRounded)
+	t.AppendHeader(table.Row{"Identity Id", "Error"})
+	for _, failure := range op.Failures {
+		t.AppendRow(table.Row{stringz.OrEmpty(failure.IdentityID), stringz.OrEmpty(failure.Error)})
+	}
+	api.RenderTable(o, t, nil)
+}
+
+type bulkStatusAction struct {
+	api.Options
+}
+
+func newBulkStatusCmd(out io.Writer, errOut io.Writer) *cobra.Command {
+	action := &bulkStatusAction{
+		Options: api.Options{
+			CommonOptions: common.CommonOptions{
+				Out: out,
+				Err: errOut,
+			},
+		},
+	}
+
+	cmd := &cobra.Command{
+		Use:   "status <bulk operation id>",
+		Short: "shows the progress and failures of a bulk operation",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			action.Cmd = cmd
+			action.Args = args
+			return action.run(args)
+		},
+	}
+
+	action.AddCommonFlags(cmd)
+	return cmd
+}
+
+func (self *bulkStatusAction) run(args []string) error {
+	op, err := getBulkOperation(&self.Options, args[0])
+	if err != nil {
+		return err
+	}
+	if self.OutputJSONResponse {
+		return nil
+	}
+
+	_, _ = fmt.Fprintf(self.Out, "operation: %s\nstatus: %s\nstarted: %s\nprocessed %d/%d identities, %d succeeded, %d failed\n",
+		stringz.OrEmpty(op.Operation), formatBulkOperationState(op), formatBulkOperationTime(op.StartedAt),
+		*op.Processed, *op.Total, *op.Succeeded, *op.Failed)
+
+	renderBulkOperationFailures(&self.Options, op)
+	return nil
+}
+
+type bulkListAction struct {
+	api.Options
+}
+
+func newBulkListCmd(out io.Writer, errOut io.Writer) *cobra.Command {
+	action := &bulkListAction{
+		Options: api.Options{
+			CommonOptions: common.CommonOptions{
+				Out: out,
+				Err: errOut,
+			},
+		},
+	}
+
+	cmd := &cobra.Command{
+		Use:   "list",
+		Short: "lists recent bulk operations, most recent first",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			action.Cmd = cmd
+			action.Args = args
+			return action.run()
+		},
+	}
+
+	action.AddCommonFlags(cmd)
+	return cmd
+}
+
+func (self *bulkListAction) run() error {
+	client, err := util.NewFabricManagementClient(self)
+	if err != nil {
+		return err
+	}
+
+	ctx, cancelF := self.TimeoutContext()
+	defer cancelF()
+
+	result, err := client.BulkOperation.ListBulkOperations(&bulk_operation.ListBulkOperationsParams{
+		Context: ctx,
+	})
+	if err != nil {
+		return util.WrapIfApiError(err)
+	}
+	if self.OutputJSONResponse {
+		return nil
+	}
+
+	t := table.NewWriter()
+	t.SetStyle(table.StyleRounded)
+	t.AppendHeader(table.Row{"Id", "Operation", "Status", "Started", "Total", "Succeeded", "Failed"})
+	for _, op := range result.Payload.Data {
+		t.AppendRow(table.Row{stringz.OrEmpty(op.ID), stringz.OrEmpty(op.Operation), formatBulkOperationState(op),
+			formatBulkOperationTime(op.StartedAt), *op.Total, *op.Succeeded, *op.Failed})
+	}
+	api.RenderTable(&self.Options, t, nil)
+	return nil
+}
+
+func formatBulkOperationState(op *rest_model.BulkOperationDetail) string {
+	if op.Complete != nil && *op.Complete {
+		return "complete"
+	}
+	return "running"
+}
+
+func formatBulkOperationTime(t *strfmt.DateTime) string {
+	if t == nil {
+		return ""
+	}
+	return time.Time(*t).Local().Format(time.RFC3339)
+}
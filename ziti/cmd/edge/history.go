@@ -0,0 +1,329 @@
+/*
+	Copyright NetFoundry Inc.
+
+	Licensed under the Apache License, Version 2.0 (the "License");
+	you may not use this file except in compliance with the License.
+	You may obtain a copy of the License at
+
+	https://www.apache.org/licenses/LICENSE-2.0
+
+	Unless required by applicable law or agreed to in writing, software
+	distributed under the License is distributed on an "AS IS" BASIS,
+	WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+	See the License for the specific language governing permissions and
+	limitations under the License.
+*/
+
+package edge
+
+import (
+	"fmt"
+	"io"
+	"strconv"
+	"time"
+
+	"github.com/go-openapi/strfmt"
+	"github.com/jedib0t/go-pretty/v6/table"
+	"github.com/openziti/foundation/v2/stringz"
+	"github.com/openziti/ziti/controller/rest_client/audit"
+	"github.com/openziti/ziti/controller/rest_model"
+	"github.com/openziti/ziti/ziti/cmd/api"
+	"github.com/openziti/ziti/ziti/cmd/common"
+	cmdhelper "github.com/openziti/ziti/ziti/cmd/helpers"
+	"github.com/openziti/ziti/ziti/util"
+	"github.com/pkg/errors"
+	"github.com/spf13/cobra"
+)
+
+// newHistoryCmd creates a command object for the "history" command
+func newHistoryCmd(out io.Writer, errOut io.Writer) *cobra.Command {
+	historyCmd := &cobra.Command{
+		Use:   "history",
+		Short: "displays and reverts changes recorded in the controller audit log",
+		Run: func(cmd *cobra.Command, args []string) {
+			cmdhelper.CheckErr(cmd.Help())
+		},
+	}
+
+	historyCmd.AddCommand(newHistoryListCmd(out, errOut))
+	historyCmd.AddCommand(newHistoryServiceCmd(out, errOut))
+	historyCmd.AddCommand(newHistoryRevertCmd(out, errOut))
+	return historyCmd
+}
+
+type historyListAction struct {
+	api.Options
+	entityType string
+	entityId   string
+	authorId   string
+	since      string
+	limit      int64
+}
+
+func newHistoryListCmd(out io.Writer, errOut io.Writer) *cobra.Command {
+	action := &historyListAction{
+		Options: api.Options{
+			CommonOptions: common.CommonOptions{
+				Out: out,
+				Err: errOut,
+			},
+		},
+	}
+
+	cmd := &cobra.Command{
+		Use:   "list",
+		Short: "lists recorded entity changes, most recent first",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			action.Cmd = cmd
+			action.Args = args
+			return action.run()
+		},
+	}
+
+	action.AddCommonFlags(cmd)
+	cmd.Flags().StringVar(&action.entityType, "entity-type", "", "only show changes to the given entity type, ex: services")
+	cmd.Flags().StringVar(&action.entityId, "entity-id", "", "only show changes to the entity with the given id")
+	cmd.Flags().StringVar(&action.authorId, "author", "", "only show changes made by the given author id")
+	cmd.Flags().StringVar(&action.since, "since", "", "only show changes since the given RFC3339 timestamp or duration ago, ex: 24h")
+	cmd.Flags().Int64Var(&action.limit, "limit", 100, "maximum number of changes to show")
+
+	return cmd
+}
+
+func (self *historyListAction) run() error {
+	client, err := util.NewFabricManagementClient(self)
+	if err != nil {
+		return err
+	}
+
+	ctx, cancelF := self.TimeoutContext()
+	defer cancelF()
+
+	params := &audit.ListAuditEntriesParams{
+		Context: ctx,
+		Limit:   &self.limit,
+	}
+
+	if self.entityType != "" {
+		params.EntityType = &self.entityType
+	}
+	if self.entityId != "" {
+		params.EntityID = &self.entityId
+	}
+	if self.authorId != "" {
+		params.AuthorID = &self.authorId
+	}
+	if self.since != "" {
+		since, err := parseHistorySince(self.since)
+		if err != nil {
+			return err
+		}
+		params.Since = &since
+	}
+
+	result, err := client.Audit.ListAuditEntries(params)
+	if err != nil {
+		return util.WrapIfApiError(err)
+	}
+	if self.OutputJSONResponse {
+		return nil
+	}
+
+	t := table.NewWriter()
+	t.SetStyle(table.StyleRounded)
+	t.AppendHeader(table.Row{"Timestamp", "Change", "Entity Type", "Entity Id", "Version", "Author", "Source"})
+	for _, entry := range result.Payload.Data {
+		t.AppendRow(table.Row{formatHistoryTimestamp(entry), stringz.OrEmpty(entry.ChangeType), stringz.OrEmpty(entry.EntityType),
+			stringz.OrEmpty(entry.EntityID), formatHistoryVersion(entry), formatHistoryAuthor(entry), formatHistorySource(entry)})
+	}
+	api.RenderTable(&self.Options, t, nil)
+	return nil
+}
+
+type historyServiceAction struct {
+	api.Options
+}
+
+func newHistoryServiceCmd(out io.Writer, errOut io.Writer) *cobra.Command {
+	action := &historyServiceAction{
+		Options: api.Options{
+			CommonOptions: common.CommonOptions{
+				Out: out,
+				Err: errOut,
+			},
+		},
+	}
+
+	cmd := &cobra.Command{
+		Use:   "service <id or name>",
+		Short: "lists the recorded versions of a service, oldest first",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			action.Cmd = cmd
+			action.Args = args
+			return action.run(args)
+		},
+	}
+
+	action.AddCommonFlags(cmd)
+	return cmd
+}
+
+func (self *historyServiceAction) run(args []string) error {
+	serviceId := mapServiceToHistoryId(args[0], self.Options)
+
+	client, err := util.NewFabricManagementClient(self)
+	if err != nil {
+		return err
+	}
+
+	ctx, cancelF := self.TimeoutContext()
+	defer cancelF()
+
+	result, err := client.Audit.ListServiceHistory(&audit.ListServiceHistoryParams{
+		Context: ctx,
+		ID:      serviceId,
+	})
+	if err != nil {
+		return util.WrapIfApiError(err)
+	}
+	if self.OutputJSONResponse {
+		return nil
+	}
+
+	t := table.NewWriter()
+	t.SetStyle(table.StyleRounded)
+	t.AppendHeader(table.Row{"Version", "Timestamp", "Change", "Name", "Author", "Source"})
+	for _, entry := range result.Payload.Data {
+		name := ""
+		state := entry.FinalState
+		if state == nil {
+			state = entry.InitialState
+		}
+		if m, ok := state.(map[string]interface{}); ok {
+			name, _ = m["name"].(string)
+		}
+		t.AppendRow(table.Row{formatHistoryVersion(entry), formatHistoryTimestamp(entry), stringz.OrEmpty(entry.ChangeType), name,
+			formatHistoryAuthor(entry), formatHistorySource(entry)})
+	}
+	api.RenderTable(&self.Options, t, nil)
+	return nil
+}
+
+type historyRevertAction struct {
+	api.Options
+}
+
+func newHistoryRevertCmd(out io.Writer, errOut io.Writer) *cobra.Command {
+	action := &historyRevertAction{
+		Options: api.Options{
+			CommonOptions: common.CommonOptions{
+				Out: out,
+				Err: errOut,
+			},
+		},
+	}
+
+	cmd := &cobra.Command{
+		Use:   "revert <service id or name> <version>",
+		Short: "reverts a service to the state recorded by the given version",
+		Args:  cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			action.Cmd = cmd
+			action.Args = args
+			return action.run(args)
+		},
+	}
+
+	action.AddCommonFlags(cmd)
+	return cmd
+}
+
+func (self *historyRevertAction) run(args []string) error {
+	version, err := strconv.ParseInt(args[1], 10, 64)
+	if err != nil || version < 1 {
+		return errors.Errorf("invalid version '%v', must be a positive integer", args[1])
+	}
+
+	serviceId := mapServiceToHistoryId(args[0], self.Options)
+
+	client, err := util.NewFabricManagementClient(self)
+	if err != nil {
+		return err
+	}
+
+	ctx, cancelF := self.TimeoutContext()
+	defer cancelF()
+
+	_, err = client.Audit.RevertService(&audit.RevertServiceParams{
+		Context: ctx,
+		ID:      serviceId,
+		Version: version,
+	})
+	if err != nil {
+		return util.WrapIfApiError(err)
+	}
+
+	if !self.OutputJSONResponse {
+		_, err = fmt.Fprintf(self.Out, "service %v reverted to version %v\n", serviceId, version)
+	}
+	return err
+}
+
+// mapServiceToHistoryId resolves a service name to its id. Deleted services can't be looked up, so if no service is
+// found, the value is assumed to be the id of a deleted service.
+func mapServiceToHistoryId(val string, o api.Options) string {
+	if id, err := mapNameToID("services", val, o); err == nil {
+		return id
+	}
+	return val
+}
+
+func parseHistorySince(val string) (strfmt.DateTime, error) {
+	if d, err := time.ParseDuration(val); err == nil {
+		return strfmt.DateTime(time.Now().Add(-d)), nil
+	}
+	t, err := time.Parse(time.RFC3339, val)
+	if err != nil {
+		return strfmt.DateTime{}, errors.Errorf("invalid value '%v' for since, must be a duration or RFC3339 timestamp", val)
+	}
+	return strfmt.DateTime(t), nil
+}
+
+func formatHistoryVersion(entry *rest_model.AuditEntry) string {
+	if entry.Version == nil {
+		return ""
+	}
+	return strconv.FormatInt(*entry.Version, 10)
+}
+
+func formatHistoryTimestamp(entry *rest_model.AuditEntry) string {
+	if entry.Timestamp == nil {
+		return ""
+	}
+	return time.Time(*entry.Timestamp).Local().Format(time.DateTime)
+}
+
+func formatHistoryAuthor(entry *rest_model.AuditEntry) string {
+	if entry.Author == nil {
+		return ""
+	}
+	if entry.Author.Name != "" {
+		return fmt.Sprintf("%v (%v)", entry.Author.Name, entry.Author.Type)
+	}
+	if entry.Author.ID != "" {
+		return fmt.Sprintf("%v (%v)", entry.Author.ID, entry.Author.Type)
+	}
+	return entry.Author.Type
+}
+
+func formatHistorySource(entry *rest_model.AuditEntry) string {
+	if entry.Source == nil {
+		return ""
+	}
+	if entry.Source.RemoteAddr != "" {
+		return fmt.Sprintf("%v %v", entry.Source.Type, entry.Source.RemoteAddr)
+	}
+	return entry.Source.Type
+}
@@ -36,6 +36,7 @@ import (
 	"github.com/openziti/edge-api/rest_management_api_client/external_jwt_signer"
 	"github.com/openziti/edge-api/rest_model"
 	"github.com/openziti/foundation/v2/stringz"
+	"github.com/openziti/ziti/controller/rest_client/role"
 	"github.com/openziti/ziti/ziti/cmd/api"
 	"github.com/openziti/ziti/ziti/cmd/common"
 	cmdhelper "github.com/openziti/ziti/ziti/cmd/helpers"
@@ -152,6 +153,7 @@ func newListCmd(out io.Writer, errOut io.Writer) *cobra.Command {
 	servicePolicyListRootCmd.AddCommand(newSubListCmdForEntityType("service-policies", "posture-checks", outputPostureChecks, newOptions()))
 
 	cmd.AddCommand(newListCmdForEntityType("summary", runListSummary, newOptions()))
+	cmd.AddCommand(newListCmdForEntityType("my-permissions", runListMyPermissions, newOptions()))
 
 	cmd.AddCommand(configTypeListRootCmd,
 		configListRootCmd,
@@ -1788,6 +1790,53 @@ func runListSummary(o *api.Options) error {
 	return nil
 }
 
+func runListMyPermissions(o *api.Options) error {
+	client, err := util.NewFabricManagementClient(o)
+	if err != nil {
+		return err
+	}
+
+	ctx, cancelF := o.TimeoutContext()
+	defer cancelF()
+
+	result, err := client.Role.DetailCurrentIdentityPermissions(&role.DetailCurrentIdentityPermissionsParams{
+		Context: ctx,
+	})
+	if err != nil {
+		return util.WrapIfApiError(err)
+	}
+	if o.OutputJSONResponse {
+		return nil
+	}
+
+	permissions := result.Payload.Data
+	if permissions.IsAdmin != nil && *permissions.IsAdmin {
+		_, err = fmt.Fprintln(o.Out, "admin: all permissions are granted")
+		return err
+	}
+
+	grants := append([]string{}, permissions.Grants...)
+	sort.Strings(grants)
+
+	t := table.NewWriter()
+	t.SetStyle(table.StyleRounded)
+	t.AppendHeader(table.Row{"Entity Type", "Verb", "Scope"})
+
+	for _, grant := range grants {
+		entityType, verb, scope := grant, "", ""
+		if idx := strings.Index(entityType, "#"); idx >= 0 {
+			entityType, scope = entityType[:idx], entityType[idx+1:]
+		}
+		if idx := strings.LastIndex(entityType, "."); idx >= 0 {
+			entityType, verb = entityType[:idx], entityType[idx+1:]
+		}
+		t.AppendRow(table.Row{entityType, verb, scope})
+	}
+	api.RenderTable(o, t, nil)
+
+	return nil
+}
+
 func runListPostureCheckTypes(o *api.Options) error {
 	children, pagingInfo, err := listEntitiesWithOptions("posture-check-types", o)
 
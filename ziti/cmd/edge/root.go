@@ -45,6 +45,8 @@ func NewCmdEdge(out io.Writer, errOut io.Writer, p common.OptionsProvider) *cobr
 	cmd.AddCommand(newTraceCmd(out, errOut))
 	cmd.AddCommand(newTraceRouteCmd(out, errOut))
 	cmd.AddCommand(newShowCmd(out, errOut))
+	cmd.AddCommand(newHistoryCmd(out, errOut))
+	cmd.AddCommand(newBulkCmd(out, errOut))
 	cmd.AddCommand(newReEnrollCmd(out, errOut))
 	cmd.AddCommand(newValidateCommand(p))
 	cmd.AddCommand(enroll.NewEnrollIdentityCommand(p))
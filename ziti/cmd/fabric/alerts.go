@@ -0,0 +1,242 @@
+/*
+	Copyright NetFoundry Inc.
+
+	Licensed under the Apache License, Version 2.0 (the "License");
+	you may not use this file except in compliance with the License.
+	You may obtain a copy of the License at
+
+	https://www.apache.org/licenses/LICENSE-2.0
+
+	Unless required by applicable law or agreed to in writing, software
+	distributed under the License is distributed on an "AS IS" BASIS,
+	WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+	See the License for the specific language governing permissions and
+	limitations under the License.
+*/
+
+package fabric
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/go-openapi/strfmt"
+	"github.com/jedib0t/go-pretty/v6/table"
+	fabricRestModel "github.com/openziti/ziti/controller/rest_client"
+	"github.com/openziti/ziti/controller/rest_client/alert"
+	"github.com/openziti/ziti/controller/rest_model"
+	"github.com/openziti/ziti/ziti/cmd/api"
+	"github.com/openziti/ziti/ziti/cmd/common"
+	cmdhelper "github.com/openziti/ziti/ziti/cmd/helpers"
+	"github.com/openziti/ziti/ziti/util"
+	"github.com/pkg/errors"
+	"github.com/spf13/cobra"
+)
+
+func newAlertCmd(p common.OptionsProvider) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "alert",
+		Short: "Lists alerts and alert rules evaluated by the controller and manages alert silences",
+		Run: func(cmd *cobra.Command, args []string) {
+			cmdhelper.CheckErr(cmd.Help())
+		},
+	}
+
+	silenceCmd := &cobra.Command{
+		Use:   "silence",
+		Short: "Manages alert silences",
+		Run: func(cmd *cobra.Command, args []string) {
+			cmdhelper.CheckErr(cmd.Help())
+		},
+	}
+	silenceCmd.AddCommand(newCreateAlertSilenceCmd(p))
+	silenceCmd.AddCommand(newListAlertSilencesCmd(p))
+	silenceCmd.AddCommand(newDeleteAlertSilenceCmd(p))
+
+	cmd.AddCommand(newListAlertsCmd(p))
+	cmd.AddCommand(newListAlertRulesCmd(p))
+	cmd.AddCommand(silenceCmd)
+	return cmd
+}
+
+func newAlertApiCmd(p common.OptionsProvider, use, short string, args cobra.PositionalArgs, run func(o *api.Options) error) *cobra.Command {
+	options := &api.Options{CommonOptions: p()}
+
+	cmd := &cobra.Command{
+		Use:   use,
+		Short: short,
+		Args:  args,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			options.Cmd = cmd
+			options.Args = args
+			return run(options)
+		},
+	}
+
+	options.AddCommonFlags(cmd)
+	return cmd
+}
+
+func newListAlertsCmd(p common.OptionsProvider) *cobra.Command {
+	return newAlertApiCmd(p, "list", "Lists the pending and firing alerts", cobra.NoArgs, runListAlerts)
+}
+
+func runListAlerts(o *api.Options) error {
+	return WithFabricClient(o, func(client *fabricRestModel.ZitiFabric) error {
+		ctx, cancelF := o.GetContext()
+		defer cancelF()
+
+		result, err := client.Alert.ListAlerts(&alert.ListAlertsParams{Context: ctx})
+		return outputResult(result, util.WrapIfApiError(err), o, func(o *api.Options, result *alert.ListAlertsOK) error {
+			t := table.NewWriter()
+			t.SetStyle(table.StyleRounded)
+			t.AppendHeader(table.Row{"Id", "Rule", "Severity", "State", "Since", "Silenced", "Message"})
+			for _, v := range result.Payload.Data {
+				since := v.PendingSince
+				if valOrDefault(v.State) == rest_model.AlertDetailStateFiring {
+					since = &v.FiringSince
+				}
+				t.AppendRow(table.Row{valOrDefault(v.ID), valOrDefault(v.Rule), valOrDefault(v.Severity),
+					valOrDefault(v.State), formatAlertTime(since), v.SilencedBy != "", valOrDefault(v.Message)})
+			}
+			api.RenderTable(o, t, nil)
+			return nil
+		})
+	})
+}
+
+func newListAlertRulesCmd(p common.OptionsProvider) *cobra.Command {
+	return newAlertApiCmd(p, "rules", "Lists the alert rules configured on the controller", cobra.NoArgs, runListAlertRules)
+}
+
+func runListAlertRules(o *api.Options) error {
+	return WithFabricClient(o, func(client *fabricRestModel.ZitiFabric) error {
+		ctx, cancelF := o.GetContext()
+		defer cancelF()
+
+		result, err := client.Alert.ListAlertRules(&alert.ListAlertRulesParams{Context: ctx})
+		return outputResult(result, util.WrapIfApiError(err), o, func(o *api.Options, result *alert.ListAlertRulesOK) error {
+			t := table.NewWriter()
+			t.SetStyle(table.StyleRounded)
+			t.AppendHeader(table.Row{"Name", "Type", "Severity", "For", "Filter"})
+			for _, rule := range result.Payload.Data {
+				t.AppendRow(table.Row{valOrDefault(rule.Name), valOrDefault(rule.Type), valOrDefault(rule.Severity),
+					time.Duration(valOrDefault(rule.ForSeconds)) * time.Second, rule.Filter})
+			}
+			api.RenderTable(o, t, nil)
+			return nil
+		})
+	})
+}
+
+type createAlertSilenceOptions struct {
+	api.Options
+	rule     string
+	entityId string
+	comment  string
+	duration time.Duration
+}
+
+func newCreateAlertSilenceCmd(p common.OptionsProvider) *cobra.Command {
+	options := &createAlertSilenceOptions{
+		Options: api.Options{CommonOptions: p()},
+	}
+
+	cmd := &cobra.Command{
+		Use:   "create",
+		Short: "Silences alerts matching a rule and/or entity for a period of time",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			options.Cmd = cmd
+			options.Args = args
+			return runCreateAlertSilence(options)
+		},
+	}
+
+	options.AddCommonFlags(cmd)
+	cmd.Flags().StringVar(&options.rule, "rule", "", "name of the rule whose alerts are silenced")
+	cmd.Flags().StringVar(&options.entityId, "entity-id", "", "id of the entity whose alerts are silenced")
+	cmd.Flags().StringVar(&options.comment, "comment", "", "why the alerts are silenced")
+	cmd.Flags().DurationVar(&options.duration, "duration", time.Hour, "how long the silence lasts")
+
+	return cmd
+}
+
+func runCreateAlertSilence(o *createAlertSilenceOptions) error {
+	if o.rule == "" && o.entityId == "" {
+		return errors.New("at least one of --rule or --entity-id must be provided")
+	}
+
+	return WithFabricClient(o, func(client *fabricRestModel.ZitiFabric) error {
+		ctx, cancelF := o.GetContext()
+		defer cancelF()
+
+		expiresAt := strfmt.DateTime(time.Now().Add(o.duration))
+		result, err := client.Alert.CreateAlertSilence(&alert.CreateAlertSilenceParams{
+			Context: ctx,
+			Silence: &rest_model.AlertSilenceCreate{
+				Rule:      o.rule,
+				EntityID:  o.entityId,
+				Comment:   o.comment,
+				ExpiresAt: &expiresAt,
+			},
+		})
+		return outputResult(result, util.WrapIfApiError(err), &o.Options, func(o *api.Options, result *alert.CreateAlertSilenceCreated) error {
+			silence := result.Payload.Data
+			_, _ = fmt.Fprintf(o.Out, "created alert silence %s, expiring at %s\n", valOrDefault(silence.ID),
+				formatAlertTime(silence.ExpiresAt))
+			return nil
+		})
+	})
+}
+
+func newListAlertSilencesCmd(p common.OptionsProvider) *cobra.Command {
+	return newAlertApiCmd(p, "list", "Lists the alert silences", cobra.NoArgs, runListAlertSilences)
+}
+
+func runListAlertSilences(o *api.Options) error {
+	return WithFabricClient(o, func(client *fabricRestModel.ZitiFabric) error {
+		ctx, cancelF := o.GetContext()
+		defer cancelF()
+
+		result, err := client.Alert.ListAlertSilences(&alert.ListAlertSilencesParams{Context: ctx})
+		return outputResult(result, util.WrapIfApiError(err), o, func(o *api.Options, result *alert.ListAlertSilencesOK) error {
+			t := table.NewWriter()
+			t.SetStyle(table.StyleRounded)
+			t.AppendHeader(table.Row{"Id", "Rule", "Entity Id", "Expires", "Comment"})
+			for _, silence := range result.Payload.Data {
+				t.AppendRow(table.Row{valOrDefault(silence.ID), silence.Rule, silence.EntityID,
+					formatAlertTime(silence.ExpiresAt), silence.Comment})
+			}
+			api.RenderTable(o, t, nil)
+			return nil
+		})
+	})
+}
+
+func newDeleteAlertSilenceCmd(p common.OptionsProvider) *cobra.Command {
+	return newAlertApiCmd(p, "delete <silence id>", "Deletes an alert silence", cobra.ExactArgs(1), runDeleteAlertSilence)
+}
+
+func runDeleteAlertSilence(o *api.Options) error {
+	return WithFabricClient(o, func(client *fabricRestModel.ZitiFabric) error {
+		ctx, cancelF := o.GetContext()
+		defer cancelF()
+
+		result, err := client.Alert.DeleteAlertSilence(&alert.DeleteAlertSilenceParams{
+			Context: ctx,
+			ID:      o.Args[0],
+		})
+		return outputResult(result, util.WrapIfApiError(err), o, func(o *api.Options, result *alert.DeleteAlertSilenceOK) error {
+			_, _ = fmt.Fprintf(o.Out, "deleted alert silence %s\n", o.Args[0])
+			return nil
+		})
+	})
+}
+
+func formatAlertTime(t *strfmt.DateTime) string {
+	if t == nil || time.Time(*t).IsZero() {
+		return "-"
+	}
+	return time.Time(*t).UTC().Format(time.RFC3339)
+}
@@ -0,0 +1,251 @@
+/*
+	Copyright NetFoundry Inc.
+
+	Licensed under the Apache License, Version 2.0 (the "License");
+	you may not use this file except in compliance with the License.
+	You may obtain a copy of the License at
+
+	https://www.apache.org/licenses/LICENSE-2.0
+
+	Unless required by applicable law or agreed to in writing, software
+	distributed under the License is distributed on an "AS IS" BASIS,
+	WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+	See the License for the specific language governing permissions and
+	limitations under the License.
+*/
+
+package fabric
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/go-openapi/strfmt"
+	"github.com/jedib0t/go-pretty/v6/table"
+	"github.com/openziti/ziti/common/outputz"
+	fabricRestModel "github.com/openziti/ziti/controller/rest_client"
+	"github.com/openziti/ziti/controller/rest_client/circuit_history"
+	"github.com/openziti/ziti/ziti/cmd/api"
+	"github.com/openziti/ziti/ziti/cmd/common"
+	cmdhelper "github.com/openziti/ziti/ziti/cmd/helpers"
+	"github.com/openziti/ziti/ziti/util"
+	"github.com/pkg/errors"
+	"github.com/spf13/cobra"
+)
+
+func newCircuitHistoryCmd(p common.OptionsProvider) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "circuit-history",
+		Short: "Shows circuits from the controller's circuit history, including circuits which have ended or failed",
+		Run: func(cmd *cobra.Command, args []string) {
+			cmdhelper.CheckErr(cmd.Help())
+		},
+	}
+
+	cmd.AddCommand(newListCircuitHistoryCmd(p))
+	cmd.AddCommand(newShowCircuitHistoryCmd(p))
+	return cmd
+}
+
+type listCircuitHistoryAction struct {
+	api.Options
+	identityId   string
+	serviceId    string
+	routerId     string
+	linkId       string
+	terminatorId string
+	hostId       string
+	state        string
+	failureCause string
+	since        string
+	until        string
+	limit        int64
+}
+
+func newListCircuitHistoryCmd(p common.OptionsProvider) *cobra.Command {
+	action := &listCircuitHistoryAction{
+		Options: api.Options{
+			CommonOptions: p(),
+		},
+	}
+
+	cmd := &cobra.Command{
+		Use:     "list",
+		Short:   "Lists circuits from the circuit history, newest first",
+		Example: "ziti fabric circuit-history list --identity laptop --since 2h --until 1h",
+		Args:    cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			action.Cmd = cmd
+			action.Args = args
+			return action.run()
+		},
+	}
+
+	action.AddCommonFlags(cmd)
+	cmd.Flags().StringVar(&action.identityId, "identity", "", "Only show circuits dialed by the given identity id or name")
+	cmd.Flags().StringVar(&action.serviceId, "service", "", "Only show circuits for the given service id or name")
+	cmd.Flags().StringVar(&action.routerId, "router", "", "Only show circuits whose path includes the given router id or name")
+	cmd.Flags().StringVar(&action.linkId, "link", "", "Only show circuits whose path includes the given link id")
+	cmd.Flags().StringVar(&action.terminatorId, "terminator", "", "Only show circuits using the given terminator id")
+	cmd.Flags().StringVar(&action.hostId, "host", "", "Only show circuits to the given hosting identity id or name")
+	cmd.Flags().StringVar(&action.state, "state", "", "Only show circuits in the given state. One of active, ended or failed")
+	cmd.Flags().StringVar(&action.failureCause, "failure-cause", "", "Only show circuits which failed with the given cause, ex: NO_TERMINATORS")
+	cmd.Flags().StringVar(&action.since, "since", "", "Only show circuits active since the given RFC3339 timestamp or duration ago, ex: 2h")
+	cmd.Flags().StringVar(&action.until, "until", "", "Only show circuits active before the given RFC3339 timestamp or duration ago, ex: 1h")
+	cmd.Flags().Int64Var(&action.limit, "limit", 100, "Maximum number of circuits to show")
+	return cmd
+}
+
+// mapCircuitHistoryId maps names to ids where the entity still exists. Entities referenced by the history may have
+// since been deleted, in which case the value is used as is
+func mapCircuitHistoryId(a util.API, entityType string, o *api.Options, val string) string {
+	if id, err := api.MapNameToID(a, entityType, o, val); err == nil {
+		return id
+	}
+	return val
+}
+
+func parseCircuitHistoryTime(name, val string) (*strfmt.DateTime, error) {
+	if d, err := time.ParseDuration(val); err == nil {
+		result := strfmt.DateTime(time.Now().Add(-d))
+		return &result, nil
+	}
+	t, err := time.Parse(time.RFC3339, val)
+	if err != nil {
+		return nil, errors.Errorf("invalid value '%v' for %s, must be a duration or RFC3339 timestamp", val, name)
+	}
+	result := strfmt.DateTime(t)
+	return &result, nil
+}
+
+func (self *listCircuitHistoryAction) run() error {
+	if self.identityId != "" {
+		self.identityId = mapCircuitHistoryId(util.EdgeAPI, "identities", &self.Options, self.identityId)
+	}
+	if self.hostId != "" {
+		self.hostId = mapCircuitHistoryId(util.EdgeAPI, "identities", &self.Options, self.hostId)
+	}
+	if self.serviceId != "" {
+		self.serviceId = mapCircuitHistoryId(util.FabricAPI, "services", &self.Options, self.serviceId)
+	}
+	if self.routerId != "" {
+		self.routerId = mapCircuitHistoryId(util.FabricAPI, "routers", &self.Options, self.routerId)
+	}
+
+	params := &circuit_history.ListCircuitHistoryParams{
+		Limit: &self.limit,
+	}
+
+	stringParams := map[**string]string{
+		&params.IdentityID:   self.identityId,
+		&params.ServiceID:    self.serviceId,
+		&params.RouterID:     self.routerId,
+		&params.LinkID:       self.linkId,
+		&params.TerminatorID: self.terminatorId,
+		&params.HostID:       self.hostId,
+		&params.State:        self.state,
+		&params.FailureCause: self.failureCause,
+	}
+
+	for target, value := range stringParams {
+		if value != "" {
+			*target = &value
+		}
+	}
+
+	var err error
+	if self.since != "" {
+		if params.Since, err = parseCircuitHistoryTime("since", self.since); err != nil {
+			return err
+		}
+	}
+	if self.until != "" {
+		if params.Until, err = parseCircuitHistoryTime("until", self.until); err != nil {
+			return err
+		}
+	}
+
+	return WithFabricClient(&self.Options, func(client *fabricRestModel.ZitiFabric) error {
+		ctx, cancelF := self.GetContext()
+		defer cancelF()
+		params.Context = ctx
+
+		result, err := client.CircuitHistory.ListCircuitHistory(params)
+		return outputResult(result, util.WrapIfApiError(err), &self.Options, func(o *api.Options, result *circuit_history.ListCircuitHistoryOK) error {
+			t := table.NewWriter()
+			t.SetStyle(table.StyleRounded)
+			t.AppendHeader(table.Row{"Circuit", "Created", "Duration", "State", "Service", "Identity", "Path", "Sent", "Received", "Failure"})
+			for _, v := range result.Payload.Data {
+				failure := v.FailureCause
+				if failure == "" {
+					failure = "-"
+				}
+				t.AppendRow(table.Row{valOrDefault(v.CircuitID), formatAlertTime(v.CreatedAt), formatProbeMillis(v.DurationMillis),
+					valOrDefault(v.State), valOrDefault(v.ServiceID), v.IdentityID, strings.Join(v.Routers, " -> "),
+					formatCircuitHistoryBytes(v.IngressRxBytes), formatCircuitHistoryBytes(v.IngressTxBytes), failure})
+			}
+			api.RenderTable(o, t, nil)
+			return nil
+		})
+	})
+}
+
+func newShowCircuitHistoryCmd(p common.OptionsProvider) *cobra.Command {
+	options := &api.Options{CommonOptions: p()}
+
+	cmd := &cobra.Command{
+		Use:   "show <circuit id>",
+		Short: "Shows a circuit from the circuit history",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			options.Cmd = cmd
+			options.Args = args
+			return runShowCircuitHistory(options)
+		},
+	}
+
+	options.AddCommonFlags(cmd)
+	return cmd
+}
+
+func runShowCircuitHistory(o *api.Options) error {
+	return WithFabricClient(o, func(client *fabricRestModel.ZitiFabric) error {
+		ctx, cancelF := o.GetContext()
+		defer cancelF()
+
+		result, err := client.CircuitHistory.DetailCircuitHistory(&circuit_history.DetailCircuitHistoryParams{Context: ctx, ID: o.Args[0]})
+		return outputResult(result, util.WrapIfApiError(err), o, func(o *api.Options, result *circuit_history.DetailCircuitHistoryOK) error {
+			v := result.Payload.Data
+			t := table.NewWriter()
+			t.SetStyle(table.StyleRounded)
+			t.AppendRow(table.Row{"Circuit", valOrDefault(v.CircuitID)})
+			t.AppendRow(table.Row{"State", valOrDefault(v.State)})
+			if v.FailureCause != "" {
+				t.AppendRow(table.Row{"Failure Cause", v.FailureCause})
+			}
+			t.AppendRow(table.Row{"Service", valOrDefault(v.ServiceID)})
+			if v.InstanceID != "" {
+				t.AppendRow(table.Row{"Instance", v.InstanceID})
+			}
+			t.AppendRow(table.Row{"Identity", v.IdentityID})
+			t.AppendRow(table.Row{"Host", v.HostID})
+			t.AppendRow(table.Row{"Terminator", v.TerminatorID})
+			t.AppendRow(table.Row{"Created", formatAlertTime(v.CreatedAt)})
+			t.AppendRow(table.Row{"Ended", formatAlertTime(v.EndedAt)})
+			t.AppendRow(table.Row{"Duration", formatProbeMillis(v.DurationMillis)})
+			t.AppendRow(table.Row{"Creation Time", formatProbeMillis(v.CreationTimespanMillis)})
+			t.AppendRow(table.Row{"Routers", strings.Join(v.Routers, " -> ")})
+			t.AppendRow(table.Row{"Links", strings.Join(v.Links, ", ")})
+			t.AppendRow(table.Row{"Path Updates", valOrDefault(v.PathUpdates)})
+			t.AppendRow(table.Row{"Ingress Rx / Tx", fmt.Sprintf("%s / %s", formatCircuitHistoryBytes(v.IngressRxBytes), formatCircuitHistoryBytes(v.IngressTxBytes))})
+			t.AppendRow(table.Row{"Egress Rx / Tx", fmt.Sprintf("%s / %s", formatCircuitHistoryBytes(v.EgressRxBytes), formatCircuitHistoryBytes(v.EgressTxBytes))})
+			api.RenderTable(o, t, nil)
+			return nil
+		})
+	})
+}
+
+func formatCircuitHistoryBytes(val *int64) string {
+	return outputz.FormatBytes(uint64(valOrDefault(val)))
+}
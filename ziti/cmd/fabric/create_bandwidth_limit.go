@@ -0,0 +1,88 @@
+/*
+	Copyright NetFoundry Inc.
+
+	Licensed under the Apache License, Version 2.0 (the "License");
+	you may not use this file except in compliance with the License.
+	You may obtain a copy of the License at
+
+	https://www.apache.org/licenses/LICENSE-2.0
+
+	Unless required by applicable law or agreed to in writing, software
+	distributed under the License is distributed on an "AS IS" BASIS,
+	WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+	See the License for the specific language governing permissions and
+	limitations under the License.
+*/
+
+package fabric
+
+import (
+	"github.com/Jeffail/gabs"
+	"github.com/openziti/ziti/ziti/cmd/api"
+	"github.com/openziti/ziti/ziti/cmd/common"
+	"github.com/spf13/cobra"
+)
+
+type createBandwidthLimitOptions struct {
+	api.Options
+	rate               int64
+	burst              int64
+	shared             bool
+	identityRoles      []string
+	serviceRoles       []string
+	edgeRouterPolicies []string
+	tags               map[string]string
+}
+
+// newCreateBandwidthLimitCmd creates the 'fabric create bandwidth-limit' command
+func newCreateBandwidthLimitCmd(p common.OptionsProvider) *cobra.Command {
+	options := &createBandwidthLimitOptions{
+		Options: api.Options{
+			CommonOptions: p(),
+		},
+		tags: make(map[string]string),
+	}
+
+	cmd := &cobra.Command{
+		Use:   "bandwidth-limit <name>",
+		Short: "creates a bandwidth limit for matching identities and services",
+		Long: "creates a bandwidth limit for matching identities and services. The limit is enforced where traffic enters " +
+			"the fabric at an edge router. If edge router policies are given, the limit only applies to the identities and " +
+			"edge routers granted by those policies. Unless --shared is set, each identity gets its own budget.",
+		Args:       cobra.ExactArgs(1),
+		RunE:       options.createBandwidthLimit,
+		SuggestFor: []string{},
+	}
+
+	// allow interspersing positional args and flags
+	cmd.Flags().SetInterspersed(true)
+	cmd.Flags().Int64VarP(&options.rate, "rate", "r", 0, "Sustained rate, in bytes per second")
+	cmd.Flags().Int64VarP(&options.burst, "burst", "b", 0, "Burst size, in bytes. Defaults to one second of traffic")
+	cmd.Flags().BoolVar(&options.shared, "shared", false, "All matching traffic on a router shares a single budget, instead of one per identity")
+	cmd.Flags().StringSliceVarP(&options.identityRoles, "identity-roles", "i", nil, "Identities the limit applies to, as @<identity id>, #<role attribute> or #all")
+	cmd.Flags().StringSliceVarP(&options.serviceRoles, "service-roles", "s", nil, "Services the limit applies to, as @<service id>, #<role attribute> or #all")
+	cmd.Flags().StringSliceVarP(&options.edgeRouterPolicies, "edge-router-policies", "e", nil, "Ids of edge router policies the limit applies to")
+	cmd.Flags().StringToStringVarP(&options.tags, "tags", "t", nil, "Add tags to bandwidth limit definition")
+	options.AddCommonFlags(cmd)
+
+	return cmd
+}
+
+// createBandwidthLimit implements the command to create a bandwidth limit
+func (o *createBandwidthLimitOptions) createBandwidthLimit(cmd *cobra.Command, args []string) (err error) {
+	o.Args = args
+	entityData := gabs.New()
+	api.SetJSONValue(entityData, args[0], "name")
+	api.SetJSONValue(entityData, o.rate, "rate")
+	if cmd.Flags().Changed("burst") {
+		api.SetJSONValue(entityData, o.burst, "burst")
+	}
+	api.SetJSONValue(entityData, o.shared, "shared")
+	api.SetJSONValue(entityData, o.identityRoles, "identityRoles")
+	api.SetJSONValue(entityData, o.serviceRoles, "serviceRoles")
+	api.SetJSONValue(entityData, o.edgeRouterPolicies, "edgeRouterPolicies")
+	api.SetJSONValue(entityData, o.tags, "tags")
+
+	result, err := createEntityOfType("bandwidth-limits", entityData.String(), &o.Options)
+	return o.LogCreateResult("bandwidth limit", result, err)
+}
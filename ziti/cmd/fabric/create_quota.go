@@ -0,0 +1,90 @@
+/*
+	Copyright NetFoundry Inc.
+
+	Licensed under the Apache License, Version 2.0 (the "License");
+	you may not use this file except in compliance with the License.
+	You may obtain a copy of the License at
+
+	https://www.apache.org/licenses/LICENSE-2.0
+
+	Unless required by applicable law or agreed to in writing, software
+	distributed under the License is distributed on an "AS IS" BASIS,
+	WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+	See the License for the specific language governing permissions and
+	limitations under the License.
+*/
+
+package fabric
+
+import (
+	"github.com/Jeffail/gabs"
+	"github.com/openziti/ziti/ziti/cmd/api"
+	"github.com/openziti/ziti/ziti/cmd/common"
+	"github.com/spf13/cobra"
+)
+
+type createQuotaOptions struct {
+	api.Options
+	scope           string
+	period          string
+	softLimit       int64
+	hardLimit       int64
+	hardLimitAction string
+	identityRoles   []string
+	serviceRoles    []string
+	tags            map[string]string
+}
+
+// newCreateQuotaCmd creates the 'fabric create quota' command
+func newCreateQuotaCmd(p common.OptionsProvider) *cobra.Command {
+	options := &createQuotaOptions{
+		Options: api.Options{
+			CommonOptions: p(),
+		},
+		tags: make(map[string]string),
+	}
+
+	cmd := &cobra.Command{
+		Use:   "quota <name>",
+		Short: "creates a data usage quota for matching identities or services",
+		Long: "creates a data usage quota for matching identities or services. Usage is tracked per identity or per service, " +
+			"depending on the scope, and resets at the start of each period (UTC). Crossing the soft limit raises a warning " +
+			"alert. Crossing the hard limit raises an error alert and denies new sessions and circuits. With the teardown " +
+			"action, existing circuits are closed as well.",
+		Args:       cobra.ExactArgs(1),
+		RunE:       options.createQuota,
+		SuggestFor: []string{},
+	}
+
+	// allow interspersing positional args and flags
+	cmd.Flags().SetInterspersed(true)
+	cmd.Flags().StringVar(&options.scope, "scope", "identity", "What usage is tracked against. Valid values: identity, service")
+	cmd.Flags().StringVar(&options.period, "period", "month", "How often usage resets. Valid values: day, month")
+	cmd.Flags().Int64Var(&options.softLimit, "soft-limit", 0, "Bytes per period after which a warning alert is raised")
+	cmd.Flags().Int64Var(&options.hardLimit, "hard-limit", 0, "Bytes per period after which the hard limit action is taken")
+	cmd.Flags().StringVar(&options.hardLimitAction, "hard-limit-action", "deny", "Action taken at the hard limit. Valid values: deny, teardown")
+	cmd.Flags().StringSliceVarP(&options.identityRoles, "identity-roles", "i", nil, "Identities the quota applies to, as @<identity id>, #<role attribute> or #all")
+	cmd.Flags().StringSliceVarP(&options.serviceRoles, "service-roles", "s", nil, "Services the quota applies to, as @<service id>, #<role attribute> or #all")
+	cmd.Flags().StringToStringVarP(&options.tags, "tags", "t", nil, "Add tags to quota definition")
+	options.AddCommonFlags(cmd)
+
+	return cmd
+}
+
+// createQuota implements the command to create a quota
+func (o *createQuotaOptions) createQuota(_ *cobra.Command, args []string) (err error) {
+	o.Args = args
+	entityData := gabs.New()
+	api.SetJSONValue(entityData, args[0], "name")
+	api.SetJSONValue(entityData, o.scope, "scope")
+	api.SetJSONValue(entityData, o.period, "period")
+	api.SetJSONValue(entityData, o.softLimit, "softLimit")
+	api.SetJSONValue(entityData, o.hardLimit, "hardLimit")
+	api.SetJSONValue(entityData, o.hardLimitAction, "hardLimitAction")
+	api.SetJSONValue(entityData, o.identityRoles, "identityRoles")
+	api.SetJSONValue(entityData, o.serviceRoles, "serviceRoles")
+	api.SetJSONValue(entityData, o.tags, "tags")
+
+	result, err := createEntityOfType("quotas", entityData.String(), &o.Options)
+	return o.LogCreateResult("quota", result, err)
+}
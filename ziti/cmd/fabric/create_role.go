@@ -0,0 +1,77 @@
+/*
+	Copyright NetFoundry Inc.
+
+	Licensed under the Apache License, Version 2.0 (the "License");
+	you may not use this file except in compliance with the License.
+	You may obtain a copy of the License at
+
+	https://www.apache.org/licenses/LICENSE-2.0
+
+	Unless required by applicable law or agreed to in writing, software
+	distributed under the License is distributed on an "AS IS" BASIS,
+	WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+	See the License for the specific language governing permissions and
+	limitations under the License.
+*/
+
+package fabric
+
+import (
+	"github.com/Jeffail/gabs"
+	"github.com/openziti/ziti/ziti/cmd/api"
+	"github.com/openziti/ziti/ziti/cmd/common"
+	"github.com/spf13/cobra"
+)
+
+type createRoleOptions struct {
+	api.Options
+	identityRoles []string
+	permissions   []string
+	scopeRoles    []string
+	tags          map[string]string
+}
+
+// newCreateRoleCmd creates the 'fabric create role' command
+func newCreateRoleCmd(p common.OptionsProvider) *cobra.Command {
+	options := &createRoleOptions{
+		Options: api.Options{
+			CommonOptions: p(),
+		},
+		tags: make(map[string]string),
+	}
+
+	cmd := &cobra.Command{
+		Use:   "role <name>",
+		Short: "creates a role granting management permissions to identities",
+		Long: "creates a role granting management permissions to identities. Permissions are of the form <entity type>.<verb>, " +
+			"for example services.update or configs.*, where the verb is one of read, create, update, delete or *. If scope roles " +
+			"are given, the permissions only apply to entities with at least one of those role attributes.",
+		Args:       cobra.ExactArgs(1),
+		RunE:       options.createRole,
+		SuggestFor: []string{},
+	}
+
+	// allow interspersing positional args and flags
+	cmd.Flags().SetInterspersed(true)
+	cmd.Flags().StringSliceVarP(&options.identityRoles, "identity-roles", "i", nil, "Identities the role applies to, as @<identity id>, #<role attribute> or #all")
+	cmd.Flags().StringSliceVarP(&options.permissions, "permissions", "p", nil, "Permissions granted by the role")
+	cmd.Flags().StringSliceVarP(&options.scopeRoles, "scope-roles", "s", nil, "Limits the permissions to entities with one of these role attributes, as #<role attribute>")
+	cmd.Flags().StringToStringVarP(&options.tags, "tags", "t", nil, "Add tags to role definition")
+	options.AddCommonFlags(cmd)
+
+	return cmd
+}
+
+// createRole implements the command to create a role
+func (o *createRoleOptions) createRole(_ *cobra.Command, args []string) (err error) {
+	o.Args = args
+	entityData := gabs.New()
+	api.SetJSONValue(entityData, args[0], "name")
+	api.SetJSONValue(entityData, o.identityRoles, "identityRoles")
+	api.SetJSONValue(entityData, o.permissions, "permissions")
+	api.SetJSONValue(entityData, o.scopeRoles, "scopeRoles")
+	api.SetJSONValue(entityData, o.tags, "tags")
+
+	result, err := createEntityOfType("roles", entityData.String(), &o.Options)
+	return o.LogCreateResult("role", result, err)
+}
@@ -19,6 +19,8 @@ func newDbCmd(p common.OptionsProvider) *cobra.Command {
 	cmd.AddCommand(newDbSnapshotCmd(p))
 	cmd.AddCommand(newDbCheckIntegrityCmd(p))
 	cmd.AddCommand(newDbCheckIntegrityStatusCmd(p))
+	cmd.AddCommand(newDbBackupCmd(p))
+	cmd.AddCommand(newDbListBackupsCmd(p))
 
 	return cmd
 }
@@ -0,0 +1,148 @@
+/*
+	Copyright NetFoundry Inc.
+
+	Licensed under the Apache License, Version 2.0 (the "License");
+	you may not use this file except in compliance with the License.
+	You may obtain a copy of the License at
+
+	https://www.apache.org/licenses/LICENSE-2.0
+
+	Unless required by applicable law or agreed to in writing, software
+	distributed under the License is distributed on an "AS IS" BASIS,
+	WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+	See the License for the specific language governing permissions and
+	limitations under the License.
+*/
+
+package fabric
+
+import (
+	"fmt"
+	"github.com/go-openapi/strfmt"
+	fabricRestModel "github.com/openziti/ziti/controller/rest_client"
+	"github.com/openziti/ziti/controller/rest_client/database"
+	"github.com/openziti/ziti/ziti/cmd/api"
+	"github.com/openziti/ziti/ziti/cmd/common"
+	cmdhelper "github.com/openziti/ziti/ziti/cmd/helpers"
+	"strings"
+	"time"
+
+	"github.com/jedib0t/go-pretty/v6/table"
+	"github.com/spf13/cobra"
+)
+
+func newDbBackupCmd(p common.OptionsProvider) *cobra.Command {
+	options := &api.Options{CommonOptions: p()}
+
+	cmd := &cobra.Command{
+		Use:   "backup",
+		Short: "Takes a database backup and writes it to the configured backup target",
+		Args:  cobra.NoArgs,
+		Run: func(cmd *cobra.Command, args []string) {
+			options.Cmd = cmd
+			options.Args = args
+			err := runDbBackup(options)
+			cmdhelper.CheckErr(err)
+		},
+		SuggestFor: []string{},
+	}
+
+	// allow interspersing positional args and flags
+	cmd.Flags().SetInterspersed(true)
+	options.AddCommonFlags(cmd)
+
+	return cmd
+}
+
+func runDbBackup(o *api.Options) error {
+	return WithFabricClient(o, func(client *fabricRestModel.ZitiFabric) error {
+		ctx, cancelF := o.GetContext()
+		defer cancelF()
+
+		result, err := client.Database.CreateDatabaseBackup(&database.CreateDatabaseBackupParams{
+			Context: ctx,
+		}, nil)
+		return outputResult(result, err, o, func(o *api.Options, result *database.CreateDatabaseBackupOK) error {
+			data := result.Payload.Data
+			_, _ = fmt.Fprintf(o.Out, "created backup %s (%d bytes) in %v\n", valOrDefault(data.Name), valOrDefault(data.Size),
+				time.Duration(valOrDefault(data.DurationMs))*time.Millisecond)
+			if len(data.Removed) > 0 {
+				_, _ = fmt.Fprintf(o.Out, "removed backups beyond retention limit: %s\n", strings.Join(data.Removed, ", "))
+			}
+			return nil
+		})
+	})
+}
+
+func newDbListBackupsCmd(p common.OptionsProvider) *cobra.Command {
+	options := &api.Options{CommonOptions: p()}
+
+	cmd := &cobra.Command{
+		Use:   "list-backups",
+		Short: "Shows the database backup schedule and the backups currently retained in the backup target",
+		Args:  cobra.NoArgs,
+		Run: func(cmd *cobra.Command, args []string) {
+			options.Cmd = cmd
+			options.Args = args
+			err := runDbListBackups(options)
+			cmdhelper.CheckErr(err)
+		},
+		SuggestFor: []string{},
+	}
+
+	// allow interspersing positional args and flags
+	cmd.Flags().SetInterspersed(true)
+	cmd.Flags().BoolVar(&options.OutputCSV, "csv", false, "Output CSV instead of a formatted table")
+	options.AddCommonFlags(cmd)
+
+	return cmd
+}
+
+func runDbListBackups(o *api.Options) error {
+	return WithFabricClient(o, func(client *fabricRestModel.ZitiFabric) error {
+		ctx, cancelF := o.GetContext()
+		defer cancelF()
+
+		result, err := client.Database.ListDatabaseBackups(&database.ListDatabaseBackupsParams{
+			Context: ctx,
+		}, nil)
+		return outputResult(result, err, o, outputDbBackups)
+	})
+}
+
+func outputDbBackups(o *api.Options, result *database.ListDatabaseBackupsOK) error {
+	status := result.Payload.Data
+
+	_, _ = fmt.Fprintf(o.Out, "target:          %s\n", valOrDefault(status.Target))
+	_, _ = fmt.Fprintf(o.Out, "interval:        %s\n", valOrDefault(status.Interval))
+	_, _ = fmt.Fprintf(o.Out, "retain:          %d\n", valOrDefault(status.Retain))
+	_, _ = fmt.Fprintf(o.Out, "compressed:      %v\n", valOrDefault(status.Compressed))
+	_, _ = fmt.Fprintf(o.Out, "encrypted:       %v\n", valOrDefault(status.Encrypted))
+	_, _ = fmt.Fprintf(o.Out, "in progress:     %v\n", valOrDefault(status.InProgress))
+	_, _ = fmt.Fprintf(o.Out, "last attempt:    %s\n", formatBackupTime(status.LastAttempt))
+	_, _ = fmt.Fprintf(o.Out, "last success:    %s\n", formatBackupTime(status.LastSuccess))
+	_, _ = fmt.Fprintf(o.Out, "next scheduled:  %s\n", formatBackupTime(status.NextScheduled))
+	if status.LastError != "" {
+		_, _ = fmt.Fprintf(o.Out, "last error:      %s\n", status.LastError)
+	}
+	_, _ = fmt.Fprintln(o.Out)
+
+	t := table.NewWriter()
+	t.SetStyle(table.StyleRounded)
+	t.AppendHeader(table.Row{"Name", "Size", "Created At"})
+
+	for _, entry := range status.Backups {
+		t.AppendRow(table.Row{valOrDefault(entry.Name), valOrDefault(entry.Size), formatBackupTime(entry.CreatedAt)})
+	}
+
+	api.RenderTable(o, t, nil)
+
+	return nil
+}
+
+func formatBackupTime(t *strfmt.DateTime) string {
+	if t == nil {
+		return "-"
+	}
+	return time.Time(*t).UTC().Format(time.RFC3339)
+}
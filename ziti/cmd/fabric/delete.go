@@ -67,8 +67,11 @@ func newDeleteCmd(p common.OptionsProvider) *cobra.Command {
 		}
 	}
 
+	cmd.AddCommand(newDeleteCmdForEntityType("bandwidth-limit", newOptions(false)))
 	cmd.AddCommand(newDeleteCmdForEntityType("circuit", newOptions(true)))
 	cmd.AddCommand(newDeleteCmdForEntityType("link", newOptions(false)))
+	cmd.AddCommand(newDeleteCmdForEntityType("quota", newOptions(false)))
+	cmd.AddCommand(newDeleteCmdForEntityType("role", newOptions(false)))
 	cmd.AddCommand(newDeleteCmdForEntityType("router", newOptions(false)))
 	cmd.AddCommand(newDeleteCmdForEntityType("service", newOptions(false)))
 	cmd.AddCommand(newDeleteCmdForEntityType("terminator", newOptions(false)))
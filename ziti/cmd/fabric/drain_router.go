@@ -0,0 +1,299 @@
+/*
+	Copyright NetFoundry Inc.
+
+	Licensed under the Apache License, Version 2.0 (the "License");
+	you may not use this file except in compliance with the License.
+	You may obtain a copy of the License at
+
+	https://www.apache.org/licenses/LICENSE-2.0
+
+	Unless required by applicable law or agreed to in writing, software
+	distributed under the License is distributed on an "AS IS" BASIS,
+	WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+	See the License for the specific language governing permissions and
+	limitations under the License.
+*/
+
+package fabric
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/jedib0t/go-pretty/v6/table"
+	fabricRestModel "github.com/openziti/ziti/controller/rest_client"
+	"github.com/openziti/ziti/controller/rest_client/router"
+	"github.com/openziti/ziti/controller/rest_model"
+	"github.com/openziti/ziti/ziti/cmd/api"
+	"github.com/openziti/ziti/ziti/cmd/common"
+	cmdhelper "github.com/openziti/ziti/ziti/cmd/helpers"
+	"github.com/openziti/ziti/ziti/util"
+	"github.com/pkg/errors"
+	"github.com/spf13/cobra"
+)
+
+func newDrainCmd(p common.OptionsProvider) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "drain",
+		Short: "Drains routers before maintenance, moving circuits which pass through them onto other routers",
+		Run: func(cmd *cobra.Command, args []string) {
+			cmdhelper.CheckErr(cmd.Help())
+		},
+	}
+
+	cmd.AddCommand(newDrainRouterCmd(p))
+	cmd.AddCommand(newDrainStatusCmd(p))
+	cmd.AddCommand(newListDrainsCmd(p))
+	cmd.AddCommand(newCancelDrainCmd(p))
+	return cmd
+}
+
+type drainRouterOptions struct {
+	api.Options
+	quiesce     bool
+	edgeTimeout time.Duration
+	noWait      bool
+}
+
+func newDrainRouterCmd(p common.OptionsProvider) *cobra.Command {
+	options := &drainRouterOptions{
+		Options: api.Options{CommonOptions: p()},
+	}
+
+	cmd := &cobra.Command{
+		Use:   "router <router id or name>",
+		Short: "Drains a router, rerouting the circuits which pass through it",
+		Long: "Stops using the router for transit and reroutes the circuits which pass through it. If --edge-timeout " +
+			"is given, also waits for the circuits which start or end at the router to finish. Unless --no-wait is " +
+			"given, waits for the drain to complete, reporting progress and any circuits which couldn't be moved. " +
+			"The router isn't used for transit until the drain is cancelled with 'ziti fabric drain cancel'",
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			options.Cmd = cmd
+			options.Args = args
+			return runDrainRouter(options)
+		},
+	}
+
+	options.AddCommonFlags(cmd)
+	cmd.Flags().BoolVar(&options.quiesce, "quiesce", false, "quiesce the router, so its terminators aren't used for new circuits")
+	cmd.Flags().DurationVar(&options.edgeTimeout, "edge-timeout", 0, "how long to wait for circuits which start or end at the router to finish")
+	cmd.Flags().BoolVar(&options.noWait, "no-wait", false, "return once the drain has started, without waiting for it to complete")
+
+	return cmd
+}
+
+func runDrainRouter(o *drainRouterOptions) error {
+	id, err := api.MapNameToID(util.FabricAPI, "routers", &o.Options, o.Args[0])
+	if err != nil {
+		return err
+	}
+
+	return WithFabricClient(o, func(client *fabricRestModel.ZitiFabric) error {
+		ctx, cancelF := o.GetContext()
+		defer cancelF()
+
+		result, err := client.Router.DrainRouter(&router.DrainRouterParams{
+			Context: ctx,
+			ID:      id,
+			Drain: &rest_model.RouterDrainCreate{
+				Quiesce:                   o.quiesce,
+				EdgeCircuitTimeoutSeconds: int64(o.edgeTimeout / time.Second),
+			},
+		})
+		if err != nil {
+			return util.WrapIfApiError(err)
+		}
+
+		drain := result.Payload.Data
+		if o.OutputJSONResponse || o.noWait {
+			if !o.OutputJSONResponse {
+				_, _ = fmt.Fprintf(o.Out, "started draining router %s\n", id)
+			}
+			return nil
+		}
+
+		lastProgress := ""
+		for {
+			progress := formatRouterDrainProgress(drain)
+			if progress != lastProgress {
+				lastProgress = progress
+				_, _ = fmt.Fprintln(o.Out, progress)
+			}
+
+			if valOrDefault(drain.State) == rest_model.RouterDrainDetailStateDrained {
+				break
+			}
+
+			time.Sleep(time.Second)
+
+			if drain, err = getRouterDrain(client, &o.Options, id); err != nil {
+				return err
+			}
+		}
+
+		renderRouterDrainStragglers(&o.Options, drain)
+
+		if len(drain.Stragglers) > 0 {
+			return errors.Errorf("%d circuits weren't moved off router %s", len(drain.Stragglers), id)
+		}
+		return nil
+	})
+}
+
+func getRouterDrain(client *fabricRestModel.ZitiFabric, o *api.Options, id string) (*rest_model.RouterDrainDetail, error) {
+	ctx, cancelF := o.GetContext()
+	defer cancelF()
+
+	result, err := client.Router.DetailRouterDrain(&router.DetailRouterDrainParams{
+		Context: ctx,
+		ID:      id,
+	})
+	if err != nil {
+		return nil, util.WrapIfApiError(err)
+	}
+	return result.Payload.Data, nil
+}
+
+func newDrainStatusCmd(p common.OptionsProvider) *cobra.Command {
+	options := &api.Options{CommonOptions: p()}
+
+	cmd := &cobra.Command{
+		Use:   "status <router id or name>",
+		Short: "Shows the progress of a router drain and any circuits which couldn't be moved",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			options.Cmd = cmd
+			options.Args = args
+			return runDrainStatus(options)
+		},
+	}
+
+	options.AddCommonFlags(cmd)
+	return cmd
+}
+
+func runDrainStatus(o *api.Options) error {
+	id, err := api.MapNameToID(util.FabricAPI, "routers", o, o.Args[0])
+	if err != nil {
+		return err
+	}
+
+	return WithFabricClient(o, func(client *fabricRestModel.ZitiFabric) error {
+		drain, err := getRouterDrain(client, o, id)
+		if err != nil {
+			return err
+		}
+		if o.OutputJSONResponse {
+			return nil
+		}
+
+		_, _ = fmt.Fprintf(o.Out, "state: %s\nstarted: %s\ncompleted: %s\n%s\n", valOrDefault(drain.State),
+			formatRouterCertRotationTime(drain.StartedAt), formatRouterCertRotationTime(drain.CompletedAt),
+			formatRouterDrainProgress(drain))
+
+		renderRouterDrainStragglers(o, drain)
+		return nil
+	})
+}
+
+func newListDrainsCmd(p common.OptionsProvider) *cobra.Command {
+	options := &api.Options{CommonOptions: p()}
+
+	cmd := &cobra.Command{
+		Use:   "list",
+		Short: "Lists in progress and completed router drains, most recent first",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			options.Cmd = cmd
+			options.Args = args
+			return runListDrains(options)
+		},
+	}
+
+	options.AddCommonFlags(cmd)
+	return cmd
+}
+
+func runListDrains(o *api.Options) error {
+	return WithFabricClient(o, func(client *fabricRestModel.ZitiFabric) error {
+		ctx, cancelF := o.GetContext()
+		defer cancelF()
+
+		result, err := client.Router.ListRouterDrains(&router.ListRouterDrainsParams{
+			Context: ctx,
+		})
+		return outputResult(result, util.WrapIfApiError(err), o, func(o *api.Options, result *router.ListRouterDrainsOK) error {
+			t := table.NewWriter()
+			t.SetStyle(table.StyleRounded)
+			t.AppendHeader(table.Row{"Router Id", "Router Name", "State", "Started", "Transit", "Rerouted", "Edge", "Stragglers"})
+			for _, drain := range result.Payload.Data {
+				t.AppendRow(table.Row{valOrDefault(drain.RouterID), drain.RouterName, valOrDefault(drain.State),
+					formatRouterCertRotationTime(drain.StartedAt), valOrDefault(drain.TransitCircuits),
+					valOrDefault(drain.TransitRerouted), valOrDefault(drain.EdgeCircuits), len(drain.Stragglers)})
+			}
+			api.RenderTable(o, t, nil)
+			return nil
+		})
+	})
+}
+
+func newCancelDrainCmd(p common.OptionsProvider) *cobra.Command {
+	options := &api.Options{CommonOptions: p()}
+
+	cmd := &cobra.Command{
+		Use:   "cancel <router id or name>",
+		Short: "Cancels a router drain, allowing the router to be used for transit again",
+		Long:  "Cancelling a drain doesn't dequiesce the router. Use 'ziti agent router dequiesce' if it was quiesced",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			options.Cmd = cmd
+			options.Args = args
+			return runCancelDrain(options)
+		},
+	}
+
+	options.AddCommonFlags(cmd)
+	return cmd
+}
+
+func runCancelDrain(o *api.Options) error {
+	id, err := api.MapNameToID(util.FabricAPI, "routers", o, o.Args[0])
+	if err != nil {
+		return err
+	}
+
+	return WithFabricClient(o, func(client *fabricRestModel.ZitiFabric) error {
+		ctx, cancelF := o.GetContext()
+		defer cancelF()
+
+		result, err := client.Router.CancelRouterDrain(&router.CancelRouterDrainParams{
+			Context: ctx,
+			ID:      id,
+		})
+		return outputResult(result, util.WrapIfApiError(err), o, func(o *api.Options, result *router.CancelRouterDrainOK) error {
+			_, _ = fmt.Fprintf(o.Out, "cancelled drain of router %s\n", id)
+			return nil
+		})
+	})
+}
+
+func formatRouterDrainProgress(drain *rest_model.RouterDrainDetail) string {
+	return fmt.Sprintf("rerouted %d/%d transit circuits, %d ended, %d edge circuits remaining, %d stragglers",
+		valOrDefault(drain.TransitRerouted), valOrDefault(drain.TransitCircuits), valOrDefault(drain.TransitEnded),
+		valOrDefault(drain.EdgeCircuits), len(drain.Stragglers))
+}
+
+func renderRouterDrainStragglers(o *api.Options, drain *rest_model.RouterDrainDetail) {
+	if len(drain.Stragglers) == 0 {
+		return
+	}
+
+	t := table.NewWriter()
+	t.SetStyle(table.StyleRounded)
+	t.AppendHeader(table.Row{"Circuit Id", "Kind", "Error"})
+	for _, straggler := range drain.Stragglers {
+		t.AppendRow(table.Row{valOrDefault(straggler.CircuitID), valOrDefault(straggler.Kind), straggler.Error})
+	}
+	api.RenderTable(o, t, nil)
+}
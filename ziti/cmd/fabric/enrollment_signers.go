@@ -0,0 +1,129 @@
+/*
+	Copyright NetFoundry Inc.
+
+	Licensed under the Apache License, Version 2.0 (the "License");
+	you may not use this file except in compliance with the License.
+	You may obtain a copy of the License at
+
+	https://www.apache.org/licenses/LICENSE-2.0
+
+	Unless required by applicable law or agreed to in writing, software
+	distributed under the License is distributed on an "AS IS" BASIS,
+	WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+	See the License for the specific language governing permissions and
+	limitations under the License.
+*/
+
+package fabric
+
+import (
+	"fmt"
+
+	"github.com/jedib0t/go-pretty/v6/table"
+	fabricRestModel "github.com/openziti/ziti/controller/rest_client"
+	"github.com/openziti/ziti/controller/rest_client/enrollment_signer"
+	"github.com/openziti/ziti/ziti/cmd/api"
+	"github.com/openziti/ziti/ziti/cmd/common"
+	cmdhelper "github.com/openziti/ziti/ziti/cmd/helpers"
+	"github.com/openziti/ziti/ziti/util"
+	"github.com/spf13/cobra"
+)
+
+func newEnrollmentSignerCmd(p common.OptionsProvider) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "enrollment-signer",
+		Short: "Reports on enrollment signers and re-issues certificates during signer rotation",
+		Long: "Enrollment signers issue identity and edge router certificates. The active signer is configured with " +
+			"edge.enrollment.signingCert, signers being rotated in or out with edge.enrollment.trustedSigners",
+		Run: func(cmd *cobra.Command, args []string) {
+			cmdhelper.CheckErr(cmd.Help())
+		},
+	}
+
+	cmd.AddCommand(newListEnrollmentSignersCmd(p))
+	cmd.AddCommand(newReissueEnrollmentSignerCmd(p))
+	return cmd
+}
+
+func newListEnrollmentSignersCmd(p common.OptionsProvider) *cobra.Command {
+	options := &api.Options{CommonOptions: p()}
+
+	cmd := &cobra.Command{
+		Use:   "list",
+		Short: "Lists the enrollment signers and how many certificates issued by each are still in use",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			options.Cmd = cmd
+			options.Args = args
+			return runListEnrollmentSigners(options)
+		},
+	}
+
+	options.AddCommonFlags(cmd)
+	return cmd
+}
+
+func runListEnrollmentSigners(o *api.Options) error {
+	return WithFabricClient(o, func(client *fabricRestModel.ZitiFabric) error {
+		ctx, cancelF := o.GetContext()
+		defer cancelF()
+
+		result, err := client.EnrollmentSigner.ListEnrollmentSigners(&enrollment_signer.ListEnrollmentSignersParams{
+			Context: ctx,
+		})
+		return outputResult(result, util.WrapIfApiError(err), o, func(o *api.Options, result *enrollment_signer.ListEnrollmentSignersOK) error {
+			t := table.NewWriter()
+			t.SetStyle(table.StyleRounded)
+			t.AppendHeader(table.Row{"Id", "Subject", "State", "Expires", "Identity Certs", "Router Certs"})
+			for _, signer := range result.Payload.Data {
+				t.AppendRow(table.Row{valOrDefault(signer.ID), valOrDefault(signer.Subject), valOrDefault(signer.State),
+					formatRouterCertRotationTime(signer.NotAfter), valOrDefault(signer.IdentityCerts),
+					valOrDefault(signer.RouterCerts)})
+			}
+			api.RenderTable(o, t, nil)
+			return nil
+		})
+	})
+}
+
+func newReissueEnrollmentSignerCmd(p common.OptionsProvider) *cobra.Command {
+	options := &api.Options{CommonOptions: p()}
+
+	cmd := &cobra.Command{
+		Use:   "reissue <signer id>",
+		Short: "Re-issues the certificates issued by a trusted enrollment signer using the active signer",
+		Long: "Identities are asked to extend their certificates the next time they authenticate. Edge routers are " +
+			"asked to renew their certificates by a router certificate rotation campaign, which can be followed " +
+			"with 'ziti fabric router-cert-rotation status'",
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			options.Cmd = cmd
+			options.Args = args
+			return runReissueEnrollmentSigner(options)
+		},
+	}
+
+	options.AddCommonFlags(cmd)
+	return cmd
+}
+
+func runReissueEnrollmentSigner(o *api.Options) error {
+	return WithFabricClient(o, func(client *fabricRestModel.ZitiFabric) error {
+		ctx, cancelF := o.GetContext()
+		defer cancelF()
+
+		result, err := client.EnrollmentSigner.ReissueEnrollmentSigner(&enrollment_signer.ReissueEnrollmentSignerParams{
+			Context: ctx,
+			ID:      o.Args[0],
+		})
+		return outputResult(result, util.WrapIfApiError(err), o, func(o *api.Options, result *enrollment_signer.ReissueEnrollmentSignerAccepted) error {
+			reissue := result.Payload.Data
+			_, _ = fmt.Fprintf(o.Out, "requested extension of %d identity certificates, %d failed\n",
+				valOrDefault(reissue.IdentityCertsRequested), valOrDefault(reissue.IdentityCertsFailed))
+			if reissue.RouterCertRotationID != "" {
+				_, _ = fmt.Fprintf(o.Out, "started router certificate rotation %s\n", reissue.RouterCertRotationID)
+			}
+			return nil
+		})
+	})
+}
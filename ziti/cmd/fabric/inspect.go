@@ -34,6 +34,7 @@ func newInspectCmd(p common.OptionsProvider) *cobra.Command {
 	cmd.AddCommand(action.newInspectSubCmd(p, inspectCommon.SdkTerminatorsKey, "gets information from routers about their view of sdk terminators"))
 	cmd.AddCommand(action.newInspectSubCmd(p, inspectCommon.ErtTerminatorsKey, "gets information from routers about their view of ER/T terminators"))
 	cmd.AddCommand(action.newInspectSubCmd(p, inspectCommon.RouterCircuitsKey, "lists router circuits"))
+	cmd.AddCommand(action.newInspectSubCmd(p, inspectCommon.UdpFlowsKey, "lists active intercepted udp flows on the selected routers"))
 	cmd.AddCommand(action.newInspectSubCmd(p, inspectCommon.RouterEdgeCircuitsKey, "lists circuits in the router for edge connections"))
 	cmd.AddCommand(action.newInspectSubCmd(p, inspectCommon.RouterSdkCircuitsKey, "lists circuits from sdks connected to the selected routers"))
 	cmd.AddCommand(action.newInspectSubCmd(p, "router-messaging", "gets information about pending router peer updates and terminator validations"))
@@ -23,8 +23,11 @@ import (
 	edgeRestModel "github.com/openziti/edge-api/rest_model"
 	"github.com/openziti/foundation/v2/stringz"
 	fabricRestModel "github.com/openziti/ziti/controller/rest_client"
+	"github.com/openziti/ziti/controller/rest_client/bandwidth_limit"
 	"github.com/openziti/ziti/controller/rest_client/circuit"
 	"github.com/openziti/ziti/controller/rest_client/link"
+	"github.com/openziti/ziti/controller/rest_client/quota"
+	"github.com/openziti/ziti/controller/rest_client/role"
 	"github.com/openziti/ziti/controller/rest_client/router"
 	"github.com/openziti/ziti/controller/rest_client/service"
 	"github.com/openziti/ziti/controller/rest_client/terminator"
@@ -56,9 +59,13 @@ func newListCmd(p common.OptionsProvider) *cobra.Command {
 		return &api.Options{CommonOptions: p()}
 	}
 
+	listCmd.AddCommand(newListCmdForEntityType("bandwidth-limits", runListBandwidthLimits, newOptions()))
 	listCmd.AddCommand(newListCmdForEntityType("circuits", runListCircuits, newOptions()))
 	listCmd.AddCommand(newListCmdForEntityType("controllers", runListControllers, newOptions()))
 	listCmd.AddCommand(newListCmdForEntityType("links", runListLinks, newOptions()))
+	listCmd.AddCommand(newListCmdForEntityType("quotas", runListQuotas, newOptions()))
+	listCmd.AddCommand(newListQuotaUsageCmd(newOptions()))
+	listCmd.AddCommand(newListCmdForEntityType("roles", runListRoles, newOptions()))
 	listCmd.AddCommand(newListCmdForEntityType("routers", runListRouters, newOptions()))
 	listCmd.AddCommand(newListCmdForEntityType("services", runListServices, newOptions()))
 	listCmd.AddCommand(newListCmdForEntityType("terminators", runListTerminators, newOptions()))
@@ -265,6 +272,172 @@ func outputServices(o *api.Options, result *service.ListServicesOK) error {
 	return nil
 }
 
+func runListBandwidthLimits(o *api.Options) error {
+	return WithFabricClient(o, func(client *fabricRestModel.ZitiFabric) error {
+		ctx, cancelF := o.GetContext()
+		defer cancelF()
+
+		result, err := client.BandwidthLimit.ListBandwidthLimits(&bandwidth_limit.ListBandwidthLimitsParams{
+			Filter:  o.GetFilter(),
+			Context: ctx,
+		})
+		return outputResult(result, err, o, outputBandwidthLimits)
+	})
+}
+
+func outputBandwidthLimits(o *api.Options, result *bandwidth_limit.ListBandwidthLimitsOK) error {
+	t := table.NewWriter()
+	t.SetStyle(table.StyleRounded)
+	t.AppendHeader(table.Row{"ID", "Name", "Rate", "Burst", "Shared", "Identity Roles", "Service Roles", "Edge Router Policies"})
+
+	for _, entity := range result.Payload.Data {
+		t.AppendRow(table.Row{
+			valOrDefault(entity.ID),
+			valOrDefault(entity.Name),
+			valOrDefault(entity.Rate),
+			valOrDefault(entity.Burst),
+			valOrDefault(entity.Shared),
+			strings.Join(entity.IdentityRoles, ", "),
+			strings.Join(entity.ServiceRoles, ", "),
+			strings.Join(entity.EdgeRouterPolicies, ", "),
+		})
+	}
+
+	api.RenderTable(o, t, getPaging(result.Payload.Meta))
+
+	return nil
+}
+
+func runListQuotas(o *api.Options) error {
+	return WithFabricClient(o, func(client *fabricRestModel.ZitiFabric) error {
+		ctx, cancelF := o.GetContext()
+		defer cancelF()
+
+		result, err := client.Quota.ListQuotas(&quota.ListQuotasParams{
+			Filter:  o.GetFilter(),
+			Context: ctx,
+		})
+		return outputResult(result, err, o, outputQuotas)
+	})
+}
+
+func outputQuotas(o *api.Options, result *quota.ListQuotasOK) error {
+	t := table.NewWriter()
+	t.SetStyle(table.StyleRounded)
+	t.AppendHeader(table.Row{"ID", "Name", "Scope", "Period", "Soft Limit", "Hard Limit", "Hard Limit Action", "Identity Roles", "Service Roles"})
+
+	for _, entity := range result.Payload.Data {
+		t.AppendRow(table.Row{
+			valOrDefault(entity.ID),
+			valOrDefault(entity.Name),
+			valOrDefault(entity.Scope),
+			valOrDefault(entity.Period),
+			valOrDefault(entity.SoftLimit),
+			valOrDefault(entity.HardLimit),
+			valOrDefault(entity.HardLimitAction),
+			strings.Join(entity.IdentityRoles, ", "),
+			strings.Join(entity.ServiceRoles, ", "),
+		})
+	}
+
+	api.RenderTable(o, t, getPaging(result.Payload.Meta))
+
+	return nil
+}
+
+// newListQuotaUsageCmd creates the command to list the current period usage of a quota
+func newListQuotaUsageCmd(options *api.Options) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "quota-usage <quota id>",
+		Short: "lists the usage tracked against a quota in the current period",
+		Args:  cobra.ExactArgs(1),
+		Run: func(cmd *cobra.Command, args []string) {
+			options.Cmd = cmd
+			options.Args = args
+			err := runListQuotaUsage(options)
+			cmdhelper.CheckErr(err)
+		},
+		SuggestFor: []string{},
+	}
+
+	// allow interspersing positional args and flags
+	cmd.Flags().SetInterspersed(true)
+	cmd.Flags().BoolVar(&options.OutputCSV, "csv", false, "Output CSV instead of a formatted table")
+	options.AddCommonFlags(cmd)
+
+	return cmd
+}
+
+func runListQuotaUsage(o *api.Options) error {
+	return WithFabricClient(o, func(client *fabricRestModel.ZitiFabric) error {
+		ctx, cancelF := o.GetContext()
+		defer cancelF()
+
+		result, err := client.Quota.ListQuotaUsage(&quota.ListQuotaUsageParams{
+			ID:      o.Args[0],
+			Context: ctx,
+		})
+		return outputResult(result, err, o, outputQuotaUsage)
+	})
+}
+
+func outputQuotaUsage(o *api.Options, result *quota.ListQuotaUsageOK) error {
+	t := table.NewWriter()
+	t.SetStyle(table.StyleRounded)
+	t.AppendHeader(table.Row{"Subject ID", "Period Start", "Bytes", "Soft Limit Exceeded", "Hard Limit Exceeded"})
+
+	for _, usage := range result.Payload.Data {
+		periodStart := ""
+		if usage.PeriodStart != nil {
+			periodStart = time.Time(*usage.PeriodStart).UTC().Format(time.RFC3339)
+		}
+		t.AppendRow(table.Row{
+			valOrDefault(usage.SubjectID),
+			periodStart,
+			valOrDefault(usage.Bytes),
+			valOrDefault(usage.SoftLimitExceeded),
+			valOrDefault(usage.HardLimitExceeded),
+		})
+	}
+
+	api.RenderTable(o, t, nil)
+
+	return nil
+}
+
+func runListRoles(o *api.Options) error {
+	return WithFabricClient(o, func(client *fabricRestModel.ZitiFabric) error {
+		ctx, cancelF := o.GetContext()
+		defer cancelF()
+
+		result, err := client.Role.ListRoles(&role.ListRolesParams{
+			Filter:  o.GetFilter(),
+			Context: ctx,
+		})
+		return outputResult(result, err, o, outputRoles)
+	})
+}
+
+func outputRoles(o *api.Options, result *role.ListRolesOK) error {
+	t := table.NewWriter()
+	t.SetStyle(table.StyleRounded)
+	t.AppendHeader(table.Row{"ID", "Name", "Identity Roles", "Permissions", "Scope Roles"})
+
+	for _, entity := range result.Payload.Data {
+		t.AppendRow(table.Row{
+			valOrDefault(entity.ID),
+			valOrDefault(entity.Name),
+			strings.Join(entity.IdentityRoles, ", "),
+			strings.Join(entity.Permissions, ", "),
+			strings.Join(entity.ScopeRoles, ", "),
+		})
+	}
+
+	api.RenderTable(o, t, getPaging(result.Payload.Meta))
+
+	return nil
+}
+
 func runListRouters(o *api.Options) error {
 	return WithFabricClient(o, func(client *fabricRestModel.ZitiFabric) error {
 		ctx, cancelF := o.GetContext()
@@ -0,0 +1,193 @@
+/*
+	Copyright NetFoundry Inc.
+
+	Licensed under the Apache License, Version 2.0 (the "License");
+	you may not use this file except in compliance with the License.
+	You may obtain a copy of the License at
+
+	https://www.apache.org/licenses/LICENSE-2.0
+
+	Unless required by applicable law or agreed to in writing, software
+	distributed under the License is distributed on an "AS IS" BASIS,
+	WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+	See the License for the specific language governing permissions and
+	limitations under the License.
+*/
+
+package fabric
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/jedib0t/go-pretty/v6/table"
+	fabricRestModel "github.com/openziti/ziti/controller/rest_client"
+	"github.com/openziti/ziti/controller/rest_client/probe"
+	"github.com/openziti/ziti/controller/rest_model"
+	"github.com/openziti/ziti/ziti/cmd/api"
+	"github.com/openziti/ziti/ziti/cmd/common"
+	cmdhelper "github.com/openziti/ziti/ziti/cmd/helpers"
+	"github.com/openziti/ziti/ziti/util"
+	"github.com/spf13/cobra"
+)
+
+func newProbeCmd(p common.OptionsProvider) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "probe",
+		Short: "Shows the synthetic probes scheduled by the controller and their results",
+		Run: func(cmd *cobra.Command, args []string) {
+			cmdhelper.CheckErr(cmd.Help())
+		},
+	}
+
+	cmd.AddCommand(newProbeApiCmd(p, "list", "Lists the probes configured on the controller with their SLO summaries", cobra.NoArgs, runListProbes))
+	cmd.AddCommand(newProbeApiCmd(p, "show <name>", "Shows the SLO summary of a probe", cobra.ExactArgs(1), runShowProbe))
+	cmd.AddCommand(newProbeApiCmd(p, "results <name>", "Lists the results of a probe, newest first", cobra.ExactArgs(1), runListProbeResults))
+	return cmd
+}
+
+func newProbeApiCmd(p common.OptionsProvider, use, short string, args cobra.PositionalArgs, run func(o *api.Options) error) *cobra.Command {
+	options := &api.Options{CommonOptions: p()}
+
+	cmd := &cobra.Command{
+		Use:   use,
+		Short: short,
+		Args:  args,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			options.Cmd = cmd
+			options.Args = args
+			return run(options)
+		},
+	}
+
+	options.AddCommonFlags(cmd)
+	return cmd
+}
+
+func runListProbes(o *api.Options) error {
+	return WithFabricClient(o, func(client *fabricRestModel.ZitiFabric) error {
+		ctx, cancelF := o.GetContext()
+		defer cancelF()
+
+		result, err := client.Probe.ListProbes(&probe.ListProbesParams{Context: ctx})
+		return outputResult(result, util.WrapIfApiError(err), o, func(o *api.Options, result *probe.ListProbesOK) error {
+			t := table.NewWriter()
+			t.SetStyle(table.StyleRounded)
+			t.AppendHeader(table.Row{"Name", "Type", "Router", "Target", "Interval", "Probes", "Availability", "p95 Latency", "SLO Met", "Last Error"})
+			for _, v := range result.Payload.Data {
+				target := v.TargetRouter
+				if valOrDefault(v.Type) == rest_model.ProbeDetailTypeService {
+					target = v.Service
+				}
+
+				summary := v.Summary
+				if summary == nil {
+					summary = &rest_model.ProbeSummary{}
+				}
+
+				lastError := "-"
+				if summary.LastResult != nil && !valOrDefault(summary.LastResult.Success) {
+					lastError = summary.LastResult.Error
+				}
+
+				t.AppendRow(table.Row{valOrDefault(v.Name), valOrDefault(v.Type), valOrDefault(v.Router), target,
+					time.Duration(valOrDefault(v.IntervalSeconds)) * time.Second, valOrDefault(summary.Total),
+					formatProbePercent(summary.Availability), formatProbeMillis(summary.LatencyP95Millis),
+					formatProbeSloMet(summary.SloMet), lastError})
+			}
+			api.RenderTable(o, t, nil)
+			return nil
+		})
+	})
+}
+
+func runShowProbe(o *api.Options) error {
+	return WithFabricClient(o, func(client *fabricRestModel.ZitiFabric) error {
+		ctx, cancelF := o.GetContext()
+		defer cancelF()
+
+		result, err := client.Probe.DetailProbe(&probe.DetailProbeParams{Context: ctx, Name: o.Args[0]})
+		return outputResult(result, util.WrapIfApiError(err), o, func(o *api.Options, result *probe.DetailProbeOK) error {
+			v := result.Payload.Data
+			summary := v.Summary
+			if summary == nil {
+				summary = &rest_model.ProbeSummary{}
+			}
+			slo := v.Slo
+			if slo == nil {
+				slo = &rest_model.ProbeSlo{}
+			}
+
+			t := table.NewWriter()
+			t.SetStyle(table.StyleRounded)
+			t.AppendRow(table.Row{"Name", valOrDefault(v.Name)})
+			t.AppendRow(table.Row{"Type", valOrDefault(v.Type)})
+			t.AppendRow(table.Row{"Router", valOrDefault(v.Router)})
+			if v.TargetRouter != "" {
+				t.AppendRow(table.Row{"Target Router", v.TargetRouter})
+			}
+			if v.Service != "" {
+				t.AppendRow(table.Row{"Service", v.Service})
+			}
+			t.AppendRow(table.Row{"Interval", time.Duration(valOrDefault(v.IntervalSeconds)) * time.Second})
+			t.AppendRow(table.Row{"Timeout", time.Duration(valOrDefault(v.TimeoutMillis)) * time.Millisecond})
+			t.AppendRow(table.Row{"Window", time.Duration(valOrDefault(summary.WindowSeconds)) * time.Second})
+			t.AppendRow(table.Row{"Probes", fmt.Sprintf("%d (%d succeeded)", valOrDefault(summary.Total), valOrDefault(summary.Succeeded))})
+			t.AppendRow(table.Row{"Availability", fmt.Sprintf("%s (objective %s)", formatProbePercent(summary.Availability), formatProbePercent(slo.Availability))})
+			t.AppendRow(table.Row{"p50 / p95 / p99 Latency", fmt.Sprintf("%s / %s / %s", formatProbeMillis(summary.LatencyP50Millis),
+				formatProbeMillis(summary.LatencyP95Millis), formatProbeMillis(summary.LatencyP99Millis))})
+			t.AppendRow(table.Row{fmt.Sprintf("p%v Latency", slo.Percentile), fmt.Sprintf("%s (objective %s)",
+				formatProbeMillis(summary.SloLatencyMillis), formatProbeMillis(slo.LatencyMillis))})
+			t.AppendRow(table.Row{"SLO Met", formatProbeSloMet(summary.SloMet)})
+			if summary.LastFailure != nil {
+				t.AppendRow(table.Row{"Last Failure", fmt.Sprintf("%s: %s", formatAlertTime(summary.LastFailure.Timestamp), summary.LastFailure.Error)})
+			}
+			api.RenderTable(o, t, nil)
+			return nil
+		})
+	})
+}
+
+func runListProbeResults(o *api.Options) error {
+	return WithFabricClient(o, func(client *fabricRestModel.ZitiFabric) error {
+		ctx, cancelF := o.GetContext()
+		defer cancelF()
+
+		result, err := client.Probe.ListProbeResults(&probe.ListProbeResultsParams{Context: ctx, Name: o.Args[0]})
+		return outputResult(result, util.WrapIfApiError(err), o, func(o *api.Options, result *probe.ListProbeResultsOK) error {
+			t := table.NewWriter()
+			t.SetStyle(table.StyleRounded)
+			t.AppendHeader(table.Row{"Time", "Router", "Success", "Latency", "Error"})
+			for _, v := range result.Payload.Data {
+				t.AppendRow(table.Row{formatAlertTime(v.Timestamp), valOrDefault(v.RouterID), valOrDefault(v.Success),
+					formatProbeMillis(v.LatencyMillis), v.Error})
+			}
+			api.RenderTable(o, t, nil)
+			return nil
+		})
+	})
+}
+
+func formatProbeMillis(millis *float64) string {
+	if millis == nil {
+		return "-"
+	}
+	return time.Duration(*millis * float64(time.Millisecond)).Round(time.Microsecond).String()
+}
+
+func formatProbePercent(percent *float64) string {
+	if percent == nil {
+		return "-"
+	}
+	return fmt.Sprintf("%.3f%%", *percent)
+}
+
+func formatProbeSloMet(met *bool) string {
+	if met == nil {
+		return "-"
+	}
+	if *met {
+		return "yes"
+	}
+	return "no"
+}
@@ -35,6 +35,14 @@ func NewFabricCmd(p common.OptionsProvider) *cobra.Command {
 	fabricCmd.AddCommand(newCreateCommand(p), newListCmd(p), newUpdateCommand(p), newDeleteCmd(p))
 	fabricCmd.AddCommand(newInspectCmd(p))
 	fabricCmd.AddCommand(newDbCmd(p))
+	fabricCmd.AddCommand(newSimulatePolicyCmd(p))
+	fabricCmd.AddCommand(newRouterCertRotationCmd(p))
+	fabricCmd.AddCommand(newEnrollmentSignerCmd(p))
+	fabricCmd.AddCommand(newAlertCmd(p))
+	fabricCmd.AddCommand(newDrainCmd(p))
+	fabricCmd.AddCommand(newProbeCmd(p))
+	fabricCmd.AddCommand(newTopologyCmd(p))
+	fabricCmd.AddCommand(newCircuitHistoryCmd(p))
 	fabricCmd.AddCommand(newStreamCommand(p))
 	fabricCmd.AddCommand(newValidateCommand(p))
 	return fabricCmd
@@ -49,6 +57,9 @@ func newCreateCommand(p common.OptionsProvider) *cobra.Command {
 		},
 	}
 
+	createCmd.AddCommand(newCreateBandwidthLimitCmd(p))
+	createCmd.AddCommand(newCreateQuotaCmd(p))
+	createCmd.AddCommand(newCreateRoleCmd(p))
 	createCmd.AddCommand(newCreateRouterCmd(p))
 	createCmd.AddCommand(newCreateServiceCmd(p))
 	createCmd.AddCommand(newCreateTerminatorCmd(p))
@@ -115,6 +126,7 @@ func newValidateCommand(p common.OptionsProvider) *cobra.Command {
 	validateCmd.AddCommand(NewValidateRouterErtTerminatorsCmd(p))
 	validateCmd.AddCommand(NewValidateRouterDataModelCmd(p))
 	validateCmd.AddCommand(NewValidateIdentityConnectionStatusesCmd(p))
+	validateCmd.AddCommand(NewValidateResidencyCmd(p))
 	return validateCmd
 }
 
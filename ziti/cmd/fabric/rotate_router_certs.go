@@ -0,0 +1,273 @@
+/*
+	Copyright NetFoundry Inc.
+
+	Licensed under the Apache License, Version 2.0 (the "License");
+	you may not use this file except in compliance with the License.
+	You may obtain a copy of the License at
+
+	https://www.apache.org/licenses/LICENSE-2.0
+
+	Unless required by applicable law or agreed to in writing, software
+	distributed under the License is distributed on an "AS IS" BASIS,
+	WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+	See the License for the specific language governing permissions and
+	limitations under the License.
+*/
+
+package fabric
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/go-openapi/strfmt"
+	"github.com/jedib0t/go-pretty/v6/table"
+	fabricRestModel "github.com/openziti/ziti/controller/rest_client"
+	"github.com/openziti/ziti/controller/rest_client/router_cert_rotation"
+	"github.com/openziti/ziti/controller/rest_model"
+	"github.com/openziti/ziti/ziti/cmd/api"
+	"github.com/openziti/ziti/ziti/cmd/common"
+	cmdhelper "github.com/openziti/ziti/ziti/cmd/helpers"
+	"github.com/openziti/ziti/ziti/util"
+	"github.com/pkg/errors"
+	"github.com/spf13/cobra"
+)
+
+func newRouterCertRotationCmd(p common.OptionsProvider) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "router-cert-rotation",
+		Short: "Manages router certificate rotation campaigns",
+		Long: "Router certificate rotation campaigns ask routers to renew their certificates, a batch at a time, " +
+			"for example after an intermediate CA has been rotated",
+		Run: func(cmd *cobra.Command, args []string) {
+			cmdhelper.CheckErr(cmd.Help())
+		},
+	}
+
+	cmd.AddCommand(newStartRouterCertRotationCmd(p))
+	cmd.AddCommand(newRouterCertRotationStatusCmd(p))
+	cmd.AddCommand(newListRouterCertRotationsCmd(p))
+	return cmd
+}
+
+type startRouterCertRotationOptions struct {
+	api.Options
+	routerIds []string
+	filter    string
+	batchSize int64
+	timeout   time.Duration
+	noWait    bool
+}
+
+func newStartRouterCertRotationCmd(p common.OptionsProvider) *cobra.Command {
+	options := &startRouterCertRotationOptions{
+		Options: api.Options{CommonOptions: p()},
+	}
+
+	cmd := &cobra.Command{
+		Use:   "start",
+		Short: "Starts a router certificate rotation campaign",
+		Long: "Asks the selected routers to renew their certificates. Unless --no-wait is given, waits for the " +
+			"campaign to complete, reporting progress and any failures",
+		Args: cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			options.Cmd = cmd
+			options.Args = args
+			return runStartRouterCertRotation(options)
+		},
+	}
+
+	options.AddCommonFlags(cmd)
+	cmd.Flags().StringSliceVar(&options.routerIds, "ids", nil, "ids of the routers to rotate")
+	cmd.Flags().StringVar(&options.filter, "filter", "", `filter selecting the routers to rotate, ex: 'true' for all routers`)
+	cmd.Flags().Int64Var(&options.batchSize, "batch-size", 0, "number of routers to rotate at a time, defaults to 10")
+	cmd.Flags().DurationVar(&options.timeout, "router-timeout", 0, "how long to wait for each router to renew its certificates, defaults to 2m")
+	cmd.Flags().BoolVar(&options.noWait, "no-wait", false, "return once the campaign has started, without waiting for it to complete")
+
+	return cmd
+}
+
+func runStartRouterCertRotation(o *startRouterCertRotationOptions) error {
+	if (len(o.routerIds) == 0) == (o.filter == "") {
+		return errors.New("exactly one of --ids or --filter must be provided")
+	}
+
+	return WithFabricClient(o, func(client *fabricRestModel.ZitiFabric) error {
+		ctx, cancelF := o.GetContext()
+		defer cancelF()
+
+		result, err := client.RouterCertRotation.CreateRouterCertRotation(&router_cert_rotation.CreateRouterCertRotationParams{
+			Context: ctx,
+			Rotation: &rest_model.RouterCertRotationCreate{
+				RouterIds:      o.routerIds,
+				Filter:         o.filter,
+				BatchSize:      o.batchSize,
+				TimeoutSeconds: int64(o.timeout / time.Second),
+			},
+		})
+		if err != nil {
+			return util.WrapIfApiError(err)
+		}
+
+		rotation := result.Payload.Data
+		if o.OutputJSONResponse || o.noWait {
+			if !o.OutputJSONResponse {
+				_, _ = fmt.Fprintf(o.Out, "started router certificate rotation %s on %d routers\n", valOrDefault(rotation.ID), valOrDefault(rotation.Total))
+			}
+			return nil
+		}
+
+		var lastProcessed int64 = -1
+		for {
+			processed := valOrDefault(rotation.Completed) + valOrDefault(rotation.Failed)
+			if processed != lastProcessed {
+				lastProcessed = processed
+				_, _ = fmt.Fprintf(o.Out, "rotated %d/%d routers, %d failed\n", valOrDefault(rotation.Completed),
+					valOrDefault(rotation.Total), valOrDefault(rotation.Failed))
+			}
+
+			if valOrDefault(rotation.Complete) {
+				break
+			}
+
+			time.Sleep(time.Second)
+
+			if rotation, err = getRouterCertRotation(client, &o.Options, valOrDefault(rotation.ID)); err != nil {
+				return err
+			}
+		}
+
+		renderRouterCertRotationRouters(&o.Options, rotation, true)
+
+		if valOrDefault(rotation.Failed) > 0 {
+			return errors.Errorf("router certificate rotation %s failed for %d of %d routers", valOrDefault(rotation.ID),
+				valOrDefault(rotation.Failed), valOrDefault(rotation.Total))
+		}
+		return nil
+	})
+}
+
+func getRouterCertRotation(client *fabricRestModel.ZitiFabric, o *api.Options, id string) (*rest_model.RouterCertRotationDetail, error) {
+	ctx, cancelF := o.GetContext()
+	defer cancelF()
+
+	result, err := client.RouterCertRotation.DetailRouterCertRotation(&router_cert_rotation.DetailRouterCertRotationParams{
+		Context: ctx,
+		ID:      id,
+	})
+	if err != nil {
+		return nil, util.WrapIfApiError(err)
+	}
+	return result.Payload.Data, nil
+}
+
+func newRouterCertRotationStatusCmd(p common.OptionsProvider) *cobra.Command {
+	options := &api.Options{CommonOptions: p()}
+
+	cmd := &cobra.Command{
+		Use:   "status <rotation id>",
+		Short: "Shows the progress of a router certificate rotation campaign and the status of each router",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			options.Cmd = cmd
+			options.Args = args
+			return runRouterCertRotationStatus(options)
+		},
+	}
+
+	options.AddCommonFlags(cmd)
+	return cmd
+}
+
+func runRouterCertRotationStatus(o *api.Options) error {
+	return WithFabricClient(o, func(client *fabricRestModel.ZitiFabric) error {
+		rotation, err := getRouterCertRotation(client, o, o.Args[0])
+		if err != nil {
+			return err
+		}
+		if o.OutputJSONResponse {
+			return nil
+		}
+
+		_, _ = fmt.Fprintf(o.Out, "status: %s\nstarted: %s\nrotated %d/%d routers, %d failed\n",
+			formatRouterCertRotationState(rotation), formatRouterCertRotationTime(rotation.StartedAt),
+			valOrDefault(rotation.Completed), valOrDefault(rotation.Total), valOrDefault(rotation.Failed))
+
+		renderRouterCertRotationRouters(o, rotation, false)
+		return nil
+	})
+}
+
+func newListRouterCertRotationsCmd(p common.OptionsProvider) *cobra.Command {
+	options := &api.Options{CommonOptions: p()}
+
+	cmd := &cobra.Command{
+		Use:   "list",
+		Short: "Lists recent router certificate rotation campaigns, most recent first",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			options.Cmd = cmd
+			options.Args = args
+			return runListRouterCertRotations(options)
+		},
+	}
+
+	options.AddCommonFlags(cmd)
+	return cmd
+}
+
+func runListRouterCertRotations(o *api.Options) error {
+	return WithFabricClient(o, func(client *fabricRestModel.ZitiFabric) error {
+		ctx, cancelF := o.GetContext()
+		defer cancelF()
+
+		result, err := client.RouterCertRotation.ListRouterCertRotations(&router_cert_rotation.ListRouterCertRotationsParams{
+			Context: ctx,
+		})
+		return outputResult(result, util.WrapIfApiError(err), o, func(o *api.Options, result *router_cert_rotation.ListRouterCertRotationsOK) error {
+			t := table.NewWriter()
+			t.SetStyle(table.StyleRounded)
+			t.AppendHeader(table.Row{"Id", "Status", "Started", "Total", "Rotated", "Failed"})
+			for _, rotation := range result.Payload.Data {
+				t.AppendRow(table.Row{valOrDefault(rotation.ID), formatRouterCertRotationState(rotation),
+					formatRouterCertRotationTime(rotation.StartedAt), valOrDefault(rotation.Total),
+					valOrDefault(rotation.Completed), valOrDefault(rotation.Failed)})
+			}
+			api.RenderTable(o, t, nil)
+			return nil
+		})
+	})
+}
+
+// renderRouterCertRotationRouters renders the status of each router in the campaign. If failuresOnly is set, only
+// failed routers are shown
+func renderRouterCertRotationRouters(o *api.Options, rotation *rest_model.RouterCertRotationDetail, failuresOnly bool) {
+	t := table.NewWriter()
+	t.SetStyle(table.StyleRounded)
+	t.AppendHeader(table.Row{"Router Id", "Router Name", "State", "Error"})
+	for _, status := range rotation.Routers {
+		state := valOrDefault(status.State)
+		if failuresOnly && state != rest_model.RouterCertRotationStatusStateFailed {
+			continue
+		}
+		t.AppendRow(table.Row{valOrDefault(status.RouterID), status.RouterName, state, status.Error})
+	}
+
+	if t.Length() > 0 {
+		api.RenderTable(o, t, nil)
+	}
+}
+
+func formatRouterCertRotationState(rotation *rest_model.RouterCertRotationDetail) string {
+	if valOrDefault(rotation.Complete) {
+		return "complete"
+	}
+	return "running"
+}
+
+func formatRouterCertRotationTime(t *strfmt.DateTime) string {
+	if t == nil {
+		return "-"
+	}
+	return time.Time(*t).UTC().Format(time.RFC3339)
+}
@@ -0,0 +1,150 @@
+/*
+	Copyright NetFoundry Inc.
+
+	Licensed under the Apache License, Version 2.0 (the "License");
+	you may not use this file except in compliance with the License.
+	You may obtain a copy of the License at
+
+	https://www.apache.org/licenses/LICENSE-2.0
+
+	Unless required by applicable law or agreed to in writing, software
+	distributed under the License is distributed on an "AS IS" BASIS,
+	WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+	See the License for the specific language governing permissions and
+	limitations under the License.
+*/
+
+package fabric
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"os"
+	"slices"
+	"strings"
+
+	"github.com/go-openapi/strfmt"
+	fabricRestModel "github.com/openziti/ziti/controller/rest_client"
+	"github.com/openziti/ziti/controller/rest_client/policy_simulation"
+	"github.com/openziti/ziti/controller/rest_model"
+	"github.com/openziti/ziti/ziti/cmd/api"
+	"github.com/openziti/ziti/ziti/cmd/common"
+	cmdhelper "github.com/openziti/ziti/ziti/cmd/helpers"
+	"github.com/pkg/errors"
+
+	"github.com/jedib0t/go-pretty/v6/table"
+	"github.com/spf13/cobra"
+)
+
+type simulatePolicyOptions struct {
+	api.Options
+	maxChanges   int64
+	failOnRevoke bool
+}
+
+func newSimulatePolicyCmd(p common.OptionsProvider) *cobra.Command {
+	options := &simulatePolicyOptions{
+		Options: api.Options{CommonOptions: p()},
+	}
+
+	cmd := &cobra.Command{
+		Use:   "simulate-policy <simulation file>",
+		Short: "reports how identity to service access would change if the given policy changes were made",
+		Long: "Reports how identity to service access would change if the policy and role attribute changes in the " +
+			"given JSON file were made, without making them. Use - to read the changes from stdin.",
+		Args: cobra.ExactArgs(1),
+		Run: func(cmd *cobra.Command, args []string) {
+			options.Cmd = cmd
+			options.Args = args
+			err := runSimulatePolicy(options)
+			cmdhelper.CheckErr(err)
+		},
+		SuggestFor: []string{},
+	}
+
+	// allow interspersing positional args and flags
+	cmd.Flags().SetInterspersed(true)
+	cmd.Flags().Int64Var(&options.maxChanges, "max-changes", 0, "The maximum number of changes to list. Defaults to 1000")
+	cmd.Flags().BoolVar(&options.failOnRevoke, "fail-on-revoke", false, "Exit with an error if any access would be revoked")
+	cmd.Flags().BoolVar(&options.OutputCSV, "csv", false, "Output CSV instead of a formatted table")
+	options.AddCommonFlags(cmd)
+
+	return cmd
+}
+
+func runSimulatePolicy(o *simulatePolicyOptions) error {
+	var contents []byte
+	var err error
+	if o.Args[0] == "-" {
+		contents, err = io.ReadAll(os.Stdin)
+	} else {
+		contents, err = os.ReadFile(o.Args[0])
+	}
+	if err != nil {
+		return err
+	}
+
+	simulation := &rest_model.PolicySimulationCreate{}
+	if err = json.Unmarshal(contents, simulation); err != nil {
+		return errors.Wrapf(err, "unable to parse simulation file '%s'", o.Args[0])
+	}
+	if err = simulation.Validate(strfmt.Default); err != nil {
+		return err
+	}
+	if o.maxChanges > 0 {
+		simulation.MaxChanges = o.maxChanges
+	}
+
+	return WithFabricClient(&o.Options, func(client *fabricRestModel.ZitiFabric) error {
+		ctx, cancelF := o.GetContext()
+		defer cancelF()
+
+		result, err := client.PolicySimulation.SimulatePolicyChanges(&policy_simulation.SimulatePolicyChangesParams{
+			Simulation: simulation,
+			Context:    ctx,
+		})
+		if err = outputResult(result, err, &o.Options, outputPolicySimulation); err != nil {
+			return err
+		}
+		if o.failOnRevoke && valOrDefault(result.Payload.Data.Revoked) > 0 {
+			return errors.Errorf("simulated changes would revoke access for %d identity/service combinations", *result.Payload.Data.Revoked)
+		}
+		return nil
+	})
+}
+
+func outputPolicySimulation(o *api.Options, result *policy_simulation.SimulatePolicyChangesOK) error {
+	data := result.Payload.Data
+
+	t := table.NewWriter()
+	t.SetStyle(table.StyleRounded)
+	t.AppendHeader(table.Row{"Identity", "Service", "Type", "Change", "Edge Routers Added", "Edge Routers Removed", "Posture Checks"})
+
+	for _, change := range data.Changes {
+		postureChecks := ""
+		if !slices.EqualFunc(change.PostureChecksBefore, change.PostureChecksAfter, slices.Equal[[]string]) {
+			postureChecks = "changed"
+		}
+		t.AppendRow(table.Row{
+			valOrDefault(change.IdentityName),
+			valOrDefault(change.ServiceName),
+			valOrDefault(change.PolicyType),
+			valOrDefault(change.ChangeType),
+			strings.Join(change.AddedEdgeRouters, ", "),
+			strings.Join(change.RemovedEdgeRouters, ", "),
+			postureChecks,
+		})
+	}
+
+	api.RenderTable(o, t, nil)
+
+	_, _ = fmt.Fprintf(o.Out, "identities affected: %d, services affected: %d, granted: %d, revoked: %d, changed: %d\n",
+		valOrDefault(data.IdentitiesAffected), valOrDefault(data.ServicesAffected),
+		valOrDefault(data.Granted), valOrDefault(data.Revoked), valOrDefault(data.Changed))
+	if valOrDefault(data.Truncated) {
+		_, _ = fmt.Fprintf(o.Out, "only the first %d changes are listed\n", len(data.Changes))
+	}
+
+	return nil
+}
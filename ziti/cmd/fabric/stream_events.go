@@ -33,22 +33,25 @@ import (
 
 type streamEventsAction struct {
 	api.Options
-	all          bool
-	alerts       bool
-	apiSessions  bool
-	circuits     bool
-	cluster      bool
-	connect      bool
-	entityChange bool
-	entityCounts bool
-	links        bool
-	metrics      bool
-	routers      bool
-	services     bool
-	sessions     bool
-	sdk          bool
-	terminators  bool
-	usage        bool
+	all               bool
+	alerts            bool
+	apiSessions       bool
+	backups           bool
+	circuits          bool
+	cluster           bool
+	connect           bool
+	entityChange      bool
+	entityCounts      bool
+	identityLifecycle bool
+	links             bool
+	metrics           bool
+	probes            bool
+	routers           bool
+	services          bool
+	sessions          bool
+	sdk               bool
+	terminators       bool
+	usage             bool
 
 	metricsSourceFilter  string
 	metricsFilter        string
@@ -75,13 +78,16 @@ func NewStreamEventsCmd(p common.OptionsProvider) *cobra.Command {
 	streamEventsCmd.Flags().BoolVar(&action.all, "all", false, "Include all events")
 	streamEventsCmd.Flags().BoolVar(&action.alerts, "alerts", false, "Include alert events")
 	streamEventsCmd.Flags().BoolVar(&action.apiSessions, "api-sessions", false, "Include api-session events")
+	streamEventsCmd.Flags().BoolVar(&action.backups, "backups", false, "Include backup events")
 	streamEventsCmd.Flags().BoolVar(&action.circuits, "circuits", false, "Include circuit events")
 	streamEventsCmd.Flags().BoolVar(&action.cluster, "cluster", false, "Include cluster events")
 	streamEventsCmd.Flags().BoolVar(&action.connect, "connect", false, "Include connect events")
 	streamEventsCmd.Flags().BoolVar(&action.entityChange, "entity-change", false, "Include entity change events")
 	streamEventsCmd.Flags().BoolVar(&action.entityCounts, "entity-counts", false, "Include entity count events")
+	streamEventsCmd.Flags().BoolVar(&action.identityLifecycle, "identity-lifecycle", false, "Include identity lifecycle events")
 	streamEventsCmd.Flags().BoolVar(&action.links, "links", false, "Include link events")
 	streamEventsCmd.Flags().BoolVar(&action.metrics, "metrics", false, "Include metrics events")
+	streamEventsCmd.Flags().BoolVar(&action.probes, "probes", false, "Include probe events")
 	streamEventsCmd.Flags().BoolVar(&action.routers, "routers", false, "Include router events")
 	streamEventsCmd.Flags().BoolVar(&action.sdk, "sdk", false, "Include sdk events")
 	streamEventsCmd.Flags().BoolVar(&action.services, "services", false, "Include service events")
@@ -110,6 +116,12 @@ func (self *streamEventsAction) buildSubscriptions(cmd *cobra.Command) []*event.
 		})
 	}
 
+	if self.backups || (self.all && !cmd.Flags().Changed("backups")) {
+		subscriptions = append(subscriptions, &event.Subscription{
+			Type: event.BackupEventNS,
+		})
+	}
+
 	if self.circuits || (self.all && !cmd.Flags().Changed("circuits")) {
 		subscriptions = append(subscriptions, &event.Subscription{
 			Type: event.CircuitEventNS,
@@ -147,6 +159,12 @@ func (self *streamEventsAction) buildSubscriptions(cmd *cobra.Command) []*event.
 		subscriptions = append(subscriptions, subscription)
 	}
 
+	if self.identityLifecycle || (self.all && !cmd.Flags().Changed("identity-lifecycle")) {
+		subscriptions = append(subscriptions, &event.Subscription{
+			Type: event.IdentityLifecycleEventNS,
+		})
+	}
+
 	if self.links || (self.all && !cmd.Flags().Changed("links")) {
 		subscriptions = append(subscriptions, &event.Subscription{
 			Type: event.LinkEventNS,
@@ -170,6 +188,12 @@ func (self *streamEventsAction) buildSubscriptions(cmd *cobra.Command) []*event.
 		subscriptions = append(subscriptions, subscription)
 	}
 
+	if self.probes || (self.all && !cmd.Flags().Changed("probes")) {
+		subscriptions = append(subscriptions, &event.Subscription{
+			Type: event.ProbeEventNS,
+		})
+	}
+
 	if self.routers || (self.all && !cmd.Flags().Changed("routers")) {
 		subscriptions = append(subscriptions, &event.Subscription{
 			Type: event.RouterEventNS,
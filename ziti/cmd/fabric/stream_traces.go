@@ -21,11 +21,13 @@ import (
 	"fmt"
 	"github.com/openziti/channel/v4"
 	"github.com/openziti/channel/v4/trace/pb"
+	"github.com/openziti/sdk-golang/xgress"
 	"github.com/openziti/ziti/common/pb/ctrl_pb"
 	"github.com/openziti/ziti/common/pb/mgmt_pb"
-	"github.com/openziti/sdk-golang/xgress"
+	"github.com/openziti/ziti/common/trace/pcap"
 	"github.com/openziti/ziti/ziti/cmd/api"
 	"github.com/openziti/ziti/ziti/cmd/common"
+	cmdhelper "github.com/openziti/ziti/ziti/cmd/helpers"
 	"github.com/spf13/cobra"
 	"google.golang.org/protobuf/proto"
 	"reflect"
@@ -35,6 +37,10 @@ import (
 
 type streamTracesAction struct {
 	api.Options
+	pcapFile        string
+	router          string
+	protocol        string
+	maxPayloadBytes uint32
 }
 
 func NewStreamTracesCmd(p common.OptionsProvider) *cobra.Command {
@@ -47,10 +53,24 @@ func NewStreamTracesCmd(p common.OptionsProvider) *cobra.Command {
 	streamTracesCmd := &cobra.Command{
 		Use:   "traces <except> [message type}",
 		Short: "Stream trace data from systems where tracing is enabled",
-		Run:   action.streamTraces,
+		Long: "Streams trace data from systems where tracing is enabled. With --pcap, enables payload tracing on the " +
+			"given router and writes the byte streams of the circuits which start or end there to a pcapng file, " +
+			"until interrupted",
+		Example: "ziti fabric stream traces --pcap circuits.pcapng --router edge-router-1 --max-payload-bytes 256",
+		Run: func(cmd *cobra.Command, args []string) {
+			if action.pcapFile != "" {
+				cmdhelper.CheckErr(action.capturePcap())
+				return
+			}
+			action.streamTraces(cmd, args)
+		},
 	}
 
 	action.AddCommonFlags(streamTracesCmd)
+	streamTracesCmd.Flags().StringVar(&action.pcapFile, "pcap", "", "write the payloads of circuits at the router given by --router to this pcapng file")
+	streamTracesCmd.Flags().StringVar(&action.router, "router", "", "id or name of the router to capture payloads at, required with --pcap")
+	streamTracesCmd.Flags().StringVar(&action.protocol, "protocol", pcap.ProtocolTCP, "protocol of the headers synthesized for captured circuits, tcp or udp")
+	streamTracesCmd.Flags().Uint32Var(&action.maxPayloadBytes, "max-payload-bytes", 0, "truncate captured payloads to this many bytes. Zero captures full payloads")
 
 	return streamTracesCmd
 }
@@ -0,0 +1,233 @@
+/*
+	Copyright NetFoundry Inc.
+
+	Licensed under the Apache License, Version 2.0 (the "License");
+	you may not use this file except in compliance with the License.
+	You may obtain a copy of the License at
+
+	https://www.apache.org/licenses/LICENSE-2.0
+
+	Unless required by applicable law or agreed to in writing, software
+	distributed under the License is distributed on an "AS IS" BASIS,
+	WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+	See the License for the specific language governing permissions and
+	limitations under the License.
+*/
+
+package fabric
+
+import (
+	"fmt"
+	"os"
+	"os/signal"
+	"regexp"
+	"sync"
+	"syscall"
+	"time"
+
+	"github.com/michaelquigley/pfxlog"
+	"github.com/openziti/channel/v4"
+	trace_pb "github.com/openziti/channel/v4/trace/pb"
+	"github.com/openziti/sdk-golang/xgress"
+	"github.com/openziti/ziti/common/pb/mgmt_pb"
+	"github.com/openziti/ziti/common/trace"
+	"github.com/openziti/ziti/common/trace/pcap"
+	fabricRestModel "github.com/openziti/ziti/controller/rest_client"
+	"github.com/openziti/ziti/controller/rest_client/circuit"
+	"github.com/openziti/ziti/ziti/cmd/api"
+	"github.com/openziti/ziti/ziti/util"
+	"github.com/pkg/errors"
+	"google.golang.org/protobuf/proto"
+)
+
+// pcapCapture writes the payload traces streamed from the controller to a pcapng file
+type pcapCapture struct {
+	lock     sync.Mutex
+	writer   *pcap.Writer
+	payloads int
+	err      error
+}
+
+func (self *pcapCapture) HandleReceive(msg *channel.Message, _ channel.Channel) {
+	event := &trace_pb.ChannelMessage{}
+	if err := proto.Unmarshal(msg.Body, event); err != nil {
+		pfxlog.Logger().WithError(err).Error("unable to decode trace event")
+		return
+	}
+
+	payload, ok := pcap.ParsePayloadTrace(event)
+	if !ok {
+		return
+	}
+
+	self.lock.Lock()
+	defer self.lock.Unlock()
+
+	if self.err != nil || self.writer == nil {
+		return
+	}
+
+	if self.err = self.writer.Write(payload); self.err == nil {
+		self.payloads++
+	}
+}
+
+func (self *pcapCapture) close() error {
+	self.lock.Lock()
+	defer self.lock.Unlock()
+
+	writer := self.writer
+	self.writer = nil
+	if writer == nil {
+		return self.err
+	}
+
+	if err := writer.Close(); err != nil && self.err == nil {
+		self.err = err
+	}
+	return self.err
+}
+
+func (self *streamTracesAction) capturePcap() error {
+	if self.router == "" {
+		return errors.New("--router is required with --pcap")
+	}
+
+	routerId, err := api.MapNameToID(util.FabricAPI, "routers", &self.Options, self.router)
+	if err != nil {
+		return err
+	}
+
+	client, err := util.NewFabricManagementClient(self)
+	if err != nil {
+		return err
+	}
+
+	file, err := os.Create(self.pcapFile)
+	if err != nil {
+		return err
+	}
+	defer func() { _ = file.Close() }()
+
+	writer, err := pcap.NewWriter(file, self.protocol, func(circuitId string) (string, string) {
+		return self.getCircuitAddresses(client, circuitId)
+	})
+	if err != nil {
+		return err
+	}
+
+	capture := &pcapCapture{writer: writer}
+	closeNotify := make(chan struct{})
+
+	bindHandler := func(binding channel.Binding) error {
+		binding.AddReceiveHandler(int32(mgmt_pb.ContentType_StreamTracesEventType), capture)
+		binding.AddCloseHandler(channel.CloseHandlerF(func(ch channel.Channel) {
+			close(closeNotify)
+		}))
+		return nil
+	}
+
+	ch, err := api.NewWsMgmtChannel(channel.BindHandlerF(bindHandler))
+	if err != nil {
+		return err
+	}
+	defer func() { _ = ch.Close() }()
+
+	streamRequest := &mgmt_pb.StreamTracesRequest{
+		EnabledFilter: true,
+		FilterType:    mgmt_pb.TraceFilterType_INCLUDE,
+		ContentTypes:  []int32{xgress.ContentTypePayloadType},
+	}
+
+	body, err := proto.Marshal(streamRequest)
+	if err != nil {
+		return err
+	}
+
+	if err = channel.NewMessage(int32(mgmt_pb.ContentType_StreamTracesRequestType), body).WithTimeout(5 * time.Second).SendAndWaitForWire(ch); err != nil {
+		return err
+	}
+
+	if err = self.togglePayloadCapture(ch, routerId, true); err != nil {
+		return err
+	}
+
+	_, _ = fmt.Fprintf(self.Out, "capturing payloads at router %s to %s, press Ctrl-C to stop\n", routerId, self.pcapFile)
+
+	signalCh := make(chan os.Signal, 1)
+	signal.Notify(signalCh, os.Interrupt, syscall.SIGTERM)
+	defer signal.Stop(signalCh)
+
+	select {
+	case <-signalCh:
+		if err = self.togglePayloadCapture(ch, routerId, false); err != nil {
+			pfxlog.Logger().WithError(err).Error("unable to disable payload tracing")
+		}
+	case <-closeNotify:
+	}
+
+	err = capture.close()
+	_, _ = fmt.Fprintf(self.Out, "captured %d payloads\n", capture.payloads)
+	return err
+}
+
+func (self *streamTracesAction) togglePayloadCapture(ch channel.Channel, routerId string, enable bool) error {
+	request := &trace_pb.TogglePipeTracesRequest{
+		Enable:    enable,
+		Verbosity: trace_pb.TraceToggleVerbosity_ReportMatches,
+		AppRegex:  "^" + regexp.QuoteMeta(routerId) + "$",
+		PipeRegex: "^xgress$",
+	}
+
+	body, err := proto.Marshal(request)
+	if err != nil {
+		return err
+	}
+
+	msg := channel.NewMessage(int32(mgmt_pb.ContentType_TogglePipeTracesRequestType), body)
+	if enable {
+		msg.Headers.PutBoolHeader(trace.CapturePayloadHeader, true)
+		msg.Headers.PutUint32Header(trace.CapturePayloadMaxBytesHeader, self.maxPayloadBytes)
+	}
+
+	response, err := msg.WithTimeout(5 * time.Second).SendForReply(ch)
+	if err != nil {
+		return err
+	}
+
+	if response.ContentType != channel.ContentTypeResultType {
+		return errors.Errorf("unexpected response type %v", response.ContentType)
+	}
+
+	if result := channel.UnmarshalResult(response); !result.Success {
+		return errors.Errorf("unable to toggle payload tracing: %s", result.Message)
+	}
+	return nil
+}
+
+// getCircuitAddresses returns the client and server addresses of the circuit. For intercepted connections these are
+// the address of the intercepted client and the intercepted address
+func (self *streamTracesAction) getCircuitAddresses(client *fabricRestModel.ZitiFabric, circuitId string) (string, string) {
+	ctx, cancelF := self.GetContext()
+	defer cancelF()
+
+	result, err := client.Circuit.DetailCircuit(&circuit.DetailCircuitParams{
+		Context: ctx,
+		ID:      circuitId,
+	})
+	if err != nil {
+		pfxlog.Logger().WithError(util.WrapIfApiError(err)).WithField("circuitId", circuitId).
+			Warn("unable to look up circuit addresses, using synthesized addresses")
+		return "", ""
+	}
+
+	path := result.Payload.Data.Path
+	if path == nil {
+		return "", ""
+	}
+
+	if path.InitiatorRemoteAddr != "" && path.InitiatorLocalAddr != "" {
+		return path.InitiatorRemoteAddr, path.InitiatorLocalAddr
+	}
+	return path.TerminatorLocalAddr, path.TerminatorRemoteAddr
+}
@@ -0,0 +1,335 @@
+/*
+	Copyright NetFoundry Inc.
+
+	Licensed under the Apache License, Version 2.0 (the "License");
+	you may not use this file except in compliance with the License.
+	You may obtain a copy of the License at
+
+	https://www.apache.org/licenses/LICENSE-2.0
+
+	Unless required by applicable law or agreed to in writing, software
+	distributed under the License is distributed on an "AS IS" BASIS,
+	WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+	See the License for the specific language governing permissions and
+	limitations under the License.
+*/
+
+package fabric
+
+import (
+	"encoding/json"
+	"os"
+	"strings"
+	"time"
+
+	"github.com/jedib0t/go-pretty/v6/table"
+	"github.com/openziti/channel/v4"
+	"github.com/openziti/ziti/common/pb/mgmt_pb"
+	"github.com/openziti/ziti/controller/event"
+	fabricRestModel "github.com/openziti/ziti/controller/rest_client"
+	"github.com/openziti/ziti/controller/rest_client/topology"
+	"github.com/openziti/ziti/controller/rest_model"
+	"github.com/openziti/ziti/ziti/cmd/api"
+	"github.com/openziti/ziti/ziti/cmd/common"
+	"github.com/openziti/ziti/ziti/util"
+	"github.com/pkg/errors"
+	"github.com/spf13/cobra"
+)
+
+const (
+	TopologyUpdateSnapshot      = "snapshot"
+	TopologyUpdateLinkAdded     = "link-added"
+	TopologyUpdateLinkUpdated   = "link-updated"
+	TopologyUpdateLinkRemoved   = "link-removed"
+	TopologyUpdateRouterOnline  = "router-online"
+	TopologyUpdateRouterOffline = "router-offline"
+)
+
+type topologyAction struct {
+	api.Options
+	format string
+	watch  bool
+}
+
+func newTopologyCmd(p common.OptionsProvider) *cobra.Command {
+	action := &topologyAction{
+		Options: api.Options{
+			CommonOptions: p(),
+		},
+	}
+
+	cmd := &cobra.Command{
+		Use:   "topology",
+		Short: "Shows the overlay network graph: routers, links, link costs and latencies and circuit counts",
+		Long: "Shows the overlay network graph: routers, links, link costs and latencies and circuit counts.\n\n" +
+			"The graph can be exported as json, Graphviz dot or GraphML. With --watch, the current topology is\n" +
+			"printed as a json snapshot, followed by one json line per change as links connect or fault and routers\n" +
+			"connect or disconnect.",
+		Example: "ziti fabric topology --format dot | dot -Tsvg > topology.svg",
+		Args:    cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			action.Cmd = cmd
+			action.Args = args
+			if action.watch {
+				return action.watchTopology()
+			}
+			return action.showTopology()
+		},
+	}
+
+	action.AddCommonFlags(cmd)
+	cmd.Flags().StringVar(&action.format, "format", TopologyFormatTable, "Output format. One of table, json, dot or graphml")
+	cmd.Flags().BoolVar(&action.watch, "watch", false, "Print the topology as a json snapshot, then stream updates as json lines")
+	return cmd
+}
+
+func (self *topologyAction) getTopology() (*rest_model.Topology, error) {
+	var result *rest_model.Topology
+	err := WithFabricClient(&self.Options, func(client *fabricRestModel.ZitiFabric) error {
+		ctx, cancelF := self.GetContext()
+		defer cancelF()
+
+		resp, err := client.Topology.DetailTopology(&topology.DetailTopologyParams{Context: ctx})
+		if err != nil {
+			return util.WrapIfApiError(err)
+		}
+		result = resp.Payload.Data
+		return nil
+	})
+	return result, err
+}
+
+func (self *topologyAction) showTopology() error {
+	if self.format == TopologyFormatTable && self.OutputJSONResponse {
+		self.format = TopologyFormatJson
+	}
+
+	result, err := self.getTopology()
+	if err != nil {
+		return err
+	}
+
+	if self.format != TopologyFormatTable {
+		return writeTopology(os.Stdout, result, self.format)
+	}
+
+	names := map[string]string{}
+
+	t := table.NewWriter()
+	t.SetStyle(table.StyleRounded)
+	t.AppendHeader(table.Row{"Router", "ID", "Connected", "Controller", "Version", "Cost", "Circuits", "Flags"})
+	for _, router := range result.Routers {
+		names[valOrDefault(router.ID)] = valOrDefault(router.Name)
+		var flags []string
+		if valOrDefault(router.Disabled) {
+			flags = append(flags, "disabled")
+		}
+		if valOrDefault(router.Draining) {
+			flags = append(flags, "draining")
+		}
+		if valOrDefault(router.NoTraversal) {
+			flags = append(flags, "no-traversal")
+		}
+		t.AppendRow(table.Row{
+			valOrDefault(router.Name),
+			valOrDefault(router.ID),
+			valOrDefault(router.Connected),
+			router.ConnectedController,
+			router.Version,
+			valOrDefault(router.Cost),
+			valOrDefault(router.CircuitCount),
+			strings.Join(flags, ", "),
+		})
+	}
+	api.RenderTable(&self.Options, t, nil)
+
+	routerName := func(id *string) string {
+		if name, ok := names[valOrDefault(id)]; ok {
+			return name
+		}
+		return valOrDefault(id)
+	}
+
+	t = table.NewWriter()
+	t.SetStyle(table.StyleRounded)
+	t.AppendHeader(table.Row{"Link", "Source", "Destination", "Protocol", "State", "Static Cost", "Cost", "Src Latency", "Dst Latency", "Circuits"})
+	for _, link := range result.Links {
+		state := valOrDefault(link.State)
+		if valOrDefault(link.Down) {
+			state += " (down)"
+		}
+		t.AppendRow(table.Row{
+			valOrDefault(link.ID),
+			routerName(link.SourceRouterID),
+			routerName(link.DestRouterID),
+			valOrDefault(link.Protocol),
+			state,
+			valOrDefault(link.StaticCost),
+			valOrDefault(link.Cost),
+			formatTopologyLatency(link.SourceLatency),
+			formatTopologyLatency(link.DestLatency),
+			valOrDefault(link.CircuitCount),
+		})
+	}
+	api.RenderTable(&self.Options, t, nil)
+	return nil
+}
+
+// watchTopology subscribes to link and router events before fetching the snapshot, so that no change is missed.
+// Events which arrive while the snapshot is being fetched are queued and printed after it
+func (self *topologyAction) watchTopology() error {
+	if self.Cmd.Flags().Changed("format") && self.format != TopologyFormatJson {
+		return errors.Errorf("--watch only supports the %s format", TopologyFormatJson)
+	}
+
+	events := make(chan []byte, 256)
+	closeNotify := make(chan struct{})
+
+	bindHandler := func(binding channel.Binding) error {
+		binding.AddReceiveHandlerF(int32(mgmt_pb.ContentType_StreamEventsEventType), func(msg *channel.Message, _ channel.Channel) {
+			select {
+			case events <- msg.Body:
+			case <-closeNotify:
+			}
+		})
+		binding.AddCloseHandler(channel.CloseHandlerF(func(ch channel.Channel) {
+			close(closeNotify)
+		}))
+		return nil
+	}
+
+	ch, err := api.NewWsMgmtChannel(channel.BindHandlerF(bindHandler))
+	if err != nil {
+		return err
+	}
+
+	streamEventsRequest := map[string]interface{}{
+		"format": "json",
+		"subscriptions": []*event.Subscription{
+			{Type: event.LinkEventNS},
+			{Type: event.RouterEventNS},
+		},
+	}
+
+	msgBytes, err := json.Marshal(streamEventsRequest)
+	if err != nil {
+		return err
+	}
+
+	requestMsg := channel.NewMessage(int32(mgmt_pb.ContentType_StreamEventsRequestType), msgBytes)
+	responseMsg, err := requestMsg.WithTimeout(time.Duration(self.Timeout) * time.Second).SendForReply(ch)
+	if err != nil {
+		return err
+	}
+
+	if responseMsg.ContentType != channel.ContentTypeResultType {
+		return errors.Errorf("unexpected response type %v", responseMsg.ContentType)
+	}
+
+	if result := channel.UnmarshalResult(responseMsg); !result.Success {
+		return errors.Errorf("error starting topology updates [%s]", result.Message)
+	}
+
+	snapshot, err := self.getTopology()
+	if err != nil {
+		return err
+	}
+
+	encoder := json.NewEncoder(os.Stdout)
+	if err = encoder.Encode(&topologyUpdate{Type: TopologyUpdateSnapshot, Topology: snapshot}); err != nil {
+		return err
+	}
+
+	for {
+		select {
+		case body := <-events:
+			update, err := topologyUpdateFromEvent(body)
+			if err != nil {
+				return err
+			}
+			if update != nil {
+				if err = encoder.Encode(update); err != nil {
+					return err
+				}
+			}
+		case <-closeNotify:
+			return nil
+		}
+	}
+}
+
+type topologyUpdate struct {
+	Type           string               `json:"type"`
+	Timestamp      *time.Time           `json:"timestamp,omitempty"`
+	Topology       *rest_model.Topology `json:"topology,omitempty"`
+	LinkId         string               `json:"linkId,omitempty"`
+	SourceRouterId string               `json:"sourceRouterId,omitempty"`
+	DestRouterId   string               `json:"destRouterId,omitempty"`
+	Protocol       string               `json:"protocol,omitempty"`
+	Cost           int32                `json:"cost,omitempty"`
+	RouterId       string               `json:"routerId,omitempty"`
+}
+
+// topologyUpdateFromEvent translates a json link or router event into a topology update. Events which don't change
+// the graph, such as link dials, return nil
+func topologyUpdateFromEvent(body []byte) (*topologyUpdate, error) {
+	header := struct {
+		Namespace string `json:"namespace"`
+	}{}
+
+	if err := json.Unmarshal(body, &header); err != nil {
+		return nil, errors.Wrap(err, "unable to parse event")
+	}
+
+	switch header.Namespace {
+	case event.LinkEventNS:
+		linkEvent := &event.LinkEvent{}
+		if err := json.Unmarshal(body, linkEvent); err != nil {
+			return nil, errors.Wrap(err, "unable to parse link event")
+		}
+
+		update := &topologyUpdate{
+			Timestamp:      &linkEvent.Timestamp,
+			LinkId:         linkEvent.LinkId,
+			SourceRouterId: linkEvent.SrcRouterId,
+			DestRouterId:   linkEvent.DstRouterId,
+			Protocol:       linkEvent.Protocol,
+			Cost:           linkEvent.Cost,
+		}
+
+		switch linkEvent.EventType {
+		case event.LinkFromRouterNew, event.LinkConnected:
+			update.Type = TopologyUpdateLinkAdded
+		case event.LinkConnectionsChanged:
+			update.Type = TopologyUpdateLinkUpdated
+		case event.LinkFault, event.LinkDuplicate:
+			update.Type = TopologyUpdateLinkRemoved
+		default:
+			return nil, nil
+		}
+		return update, nil
+
+	case event.RouterEventNS:
+		routerEvent := &event.RouterEvent{}
+		if err := json.Unmarshal(body, routerEvent); err != nil {
+			return nil, errors.Wrap(err, "unable to parse router event")
+		}
+
+		update := &topologyUpdate{
+			Timestamp: &routerEvent.Timestamp,
+			RouterId:  routerEvent.RouterId,
+		}
+
+		switch routerEvent.EventType {
+		case event.RouterOnline:
+			update.Type = TopologyUpdateRouterOnline
+		case event.RouterOffline:
+			update.Type = TopologyUpdateRouterOffline
+		default:
+			return nil, nil
+		}
+		return update, nil
+	}
+
+	return nil, nil
+}
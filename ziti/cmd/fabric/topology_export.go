@@ -0,0 +1,212 @@
+/*
+	Copyright NetFoundry Inc.
+
+	Licensed under the Apache License, Version 2.0 (the "License");
+	you may not use this file except in compliance with the License.
+	You may obtain a copy of the License at
+
+	https://www.apache.org/licenses/LICENSE-2.0
+
+	Unless required by applicable law or agreed to in writing, software
+	distributed under the License is distributed on an "AS IS" BASIS,
+	WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+	See the License for the specific language governing permissions and
+	limitations under the License.
+*/
+
+package fabric
+
+import (
+	"encoding/json"
+	"encoding/xml"
+	"fmt"
+	"io"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/openziti/ziti/controller/rest_model"
+	"github.com/pkg/errors"
+)
+
+const (
+	TopologyFormatTable   = "table"
+	TopologyFormatJson    = "json"
+	TopologyFormatDot     = "dot"
+	TopologyFormatGraphML = "graphml"
+)
+
+// writeTopology exports the topology in the given format. The table format isn't an export format, and is handled
+// by the caller
+func writeTopology(w io.Writer, topology *rest_model.Topology, format string) error {
+	switch format {
+	case TopologyFormatJson:
+		encoder := json.NewEncoder(w)
+		encoder.SetIndent("", "  ")
+		return encoder.Encode(topology)
+	case TopologyFormatDot:
+		return writeTopologyDot(w, topology)
+	case TopologyFormatGraphML:
+		return writeTopologyGraphML(w, topology)
+	}
+	return errors.Errorf("unsupported topology format '%s', must be one of %s, %s, %s or %s", format,
+		TopologyFormatTable, TopologyFormatJson, TopologyFormatDot, TopologyFormatGraphML)
+}
+
+func formatTopologyLatency(nanos *int64) string {
+	return time.Duration(valOrDefault(nanos)).Round(10 * time.Microsecond).String()
+}
+
+// writeTopologyDot writes the topology as a Graphviz digraph. Routers which aren't connected are drawn dashed and
+// links which aren't usable are drawn dotted
+func writeTopologyDot(w io.Writer, topology *rest_model.Topology) error {
+	var b strings.Builder
+	b.WriteString("digraph topology {\n")
+	b.WriteString("  node [shape=box];\n")
+
+	for _, router := range topology.Routers {
+		var attrs []string
+		label := valOrDefault(router.Name)
+		if valOrDefault(router.Draining) {
+			label += "\n(draining)"
+		}
+		attrs = append(attrs, "label="+strconv.Quote(label))
+		attrs = append(attrs, fmt.Sprintf("tooltip=%s", strconv.Quote(fmt.Sprintf("id: %s, circuits: %d",
+			valOrDefault(router.ID), valOrDefault(router.CircuitCount)))))
+		if !valOrDefault(router.Connected) {
+			attrs = append(attrs, "style=dashed")
+		}
+		if valOrDefault(router.Disabled) {
+			attrs = append(attrs, "color=gray")
+		}
+		fmt.Fprintf(&b, "  %s [%s];\n", strconv.Quote(valOrDefault(router.ID)), strings.Join(attrs, ", "))
+	}
+
+	for _, link := range topology.Links {
+		label := fmt.Sprintf("%s\ncost: %d\n%s / %s", valOrDefault(link.Protocol), valOrDefault(link.Cost),
+			formatTopologyLatency(link.SourceLatency), formatTopologyLatency(link.DestLatency))
+		attrs := []string{
+			"label=" + strconv.Quote(label),
+			"tooltip=" + strconv.Quote(fmt.Sprintf("id: %s, circuits: %d", valOrDefault(link.ID), valOrDefault(link.CircuitCount))),
+		}
+		if valOrDefault(link.Down) || valOrDefault(link.State) != "Connected" {
+			attrs = append(attrs, "style=dotted")
+		}
+		fmt.Fprintf(&b, "  %s -> %s [%s];\n", strconv.Quote(valOrDefault(link.SourceRouterID)),
+			strconv.Quote(valOrDefault(link.DestRouterID)), strings.Join(attrs, ", "))
+	}
+
+	b.WriteString("}\n")
+	_, err := io.WriteString(w, b.String())
+	return err
+}
+
+type graphML struct {
+	XMLName xml.Name     `xml:"graphml"`
+	Xmlns   string       `xml:"xmlns,attr"`
+	Keys    []graphMLKey `xml:"key"`
+	Graph   graphMLGraph `xml:"graph"`
+}
+
+type graphMLKey struct {
+	Id       string `xml:"id,attr"`
+	For      string `xml:"for,attr"`
+	AttrName string `xml:"attr.name,attr"`
+	AttrType string `xml:"attr.type,attr"`
+}
+
+type graphMLGraph struct {
+	Id          string        `xml:"id,attr"`
+	EdgeDefault string        `xml:"edgedefault,attr"`
+	Nodes       []graphMLNode `xml:"node"`
+	Edges       []graphMLEdge `xml:"edge"`
+}
+
+type graphMLNode struct {
+	Id   string        `xml:"id,attr"`
+	Data []graphMLData `xml:"data"`
+}
+
+type graphMLEdge struct {
+	Id     string        `xml:"id,attr"`
+	Source string        `xml:"source,attr"`
+	Target string        `xml:"target,attr"`
+	Data   []graphMLData `xml:"data"`
+}
+
+type graphMLData struct {
+	Key   string `xml:"key,attr"`
+	Value string `xml:",chardata"`
+}
+
+// writeTopologyGraphML writes the topology as a directed GraphML graph, with the router and link properties as
+// node and edge data
+func writeTopologyGraphML(w io.Writer, topology *rest_model.Topology) error {
+	doc := &graphML{
+		Xmlns: "http://graphml.graphdrawing.org/xmlns",
+		Graph: graphMLGraph{
+			Id:          "topology",
+			EdgeDefault: "directed",
+		},
+	}
+
+	// key ids must be unique across nodes and edges, so they're prefixed with n_ and e_ respectively
+	addKeys := func(target string, keys ...string) {
+		for i := 0; i < len(keys); i += 2 {
+			id := target[:1] + "_" + keys[i]
+			doc.Keys = append(doc.Keys, graphMLKey{Id: id, For: target, AttrName: keys[i], AttrType: keys[i+1]})
+		}
+	}
+
+	addKeys("node", "name", "string", "connected", "boolean", "connectedController", "string", "version", "string",
+		"cost", "int", "noTraversal", "boolean", "disabled", "boolean", "draining", "boolean", "circuitCount", "int")
+	addKeys("edge", "protocol", "string", "state", "string", "down", "boolean", "staticCost", "int", "cost", "long",
+		"sourceLatency", "long", "destLatency", "long", "circuitCount", "int")
+
+	for _, router := range topology.Routers {
+		doc.Graph.Nodes = append(doc.Graph.Nodes, graphMLNode{
+			Id: valOrDefault(router.ID),
+			Data: []graphMLData{
+				{Key: "n_name", Value: valOrDefault(router.Name)},
+				{Key: "n_connected", Value: strconv.FormatBool(valOrDefault(router.Connected))},
+				{Key: "n_connectedController", Value: router.ConnectedController},
+				{Key: "n_version", Value: router.Version},
+				{Key: "n_cost", Value: strconv.FormatInt(valOrDefault(router.Cost), 10)},
+				{Key: "n_noTraversal", Value: strconv.FormatBool(valOrDefault(router.NoTraversal))},
+				{Key: "n_disabled", Value: strconv.FormatBool(valOrDefault(router.Disabled))},
+				{Key: "n_draining", Value: strconv.FormatBool(valOrDefault(router.Draining))},
+				{Key: "n_circuitCount", Value: strconv.FormatInt(valOrDefault(router.CircuitCount), 10)},
+			},
+		})
+	}
+
+	for _, link := range topology.Links {
+		doc.Graph.Edges = append(doc.Graph.Edges, graphMLEdge{
+			Id:     valOrDefault(link.ID),
+			Source: valOrDefault(link.SourceRouterID),
+			Target: valOrDefault(link.DestRouterID),
+			Data: []graphMLData{
+				{Key: "e_protocol", Value: valOrDefault(link.Protocol)},
+				{Key: "e_state", Value: valOrDefault(link.State)},
+				{Key: "e_down", Value: strconv.FormatBool(valOrDefault(link.Down))},
+				{Key: "e_staticCost", Value: strconv.FormatInt(valOrDefault(link.StaticCost), 10)},
+				{Key: "e_cost", Value: strconv.FormatInt(valOrDefault(link.Cost), 10)},
+				{Key: "e_sourceLatency", Value: strconv.FormatInt(valOrDefault(link.SourceLatency), 10)},
+				{Key: "e_destLatency", Value: strconv.FormatInt(valOrDefault(link.DestLatency), 10)},
+				{Key: "e_circuitCount", Value: strconv.FormatInt(valOrDefault(link.CircuitCount), 10)},
+			},
+		})
+	}
+
+	if _, err := io.WriteString(w, xml.Header); err != nil {
+		return err
+	}
+
+	encoder := xml.NewEncoder(w)
+	encoder.Indent("", "  ")
+	if err := encoder.Encode(doc); err != nil {
+		return err
+	}
+	_, err := io.WriteString(w, "\n")
+	return err
+}
@@ -0,0 +1,133 @@
+/*
+	Copyright NetFoundry Inc.
+
+	Licensed under the Apache License, Version 2.0 (the "License");
+	you may not use this file except in compliance with the License.
+	You may obtain a copy of the License at
+
+	https://www.apache.org/licenses/LICENSE-2.0
+
+	Unless required by applicable law or agreed to in writing, software
+	distributed under the License is distributed on an "AS IS" BASIS,
+	WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+	See the License for the specific language governing permissions and
+	limitations under the License.
+*/
+
+package fabric
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/michaelquigley/pfxlog"
+	"github.com/openziti/channel/v4"
+	"github.com/openziti/channel/v4/protobufs"
+	"github.com/openziti/ziti/common/pb/mgmt_pb"
+	"github.com/openziti/ziti/ziti/cmd/api"
+	"github.com/openziti/ziti/ziti/cmd/common"
+	"github.com/spf13/cobra"
+	"google.golang.org/protobuf/proto"
+)
+
+type validateResidencyAction struct {
+	api.Options
+	filter       string
+	includeValid bool
+
+	eventNotify chan *mgmt_pb.ServiceResidencyDetail
+}
+
+func NewValidateResidencyCmd(p common.OptionsProvider) *cobra.Command {
+	action := validateResidencyAction{
+		Options: api.Options{
+			CommonOptions: p(),
+		},
+	}
+
+	validateResidencyCmd := &cobra.Command{
+		Use:     "residency",
+		Short:   "Validate that services with residency constraints have terminators reachable within the constraints",
+		Example: "ziti fabric validate residency --filter 'name=\"payroll\"' --include-valid",
+		Args:    cobra.ExactArgs(0),
+		RunE:    action.validateResidency,
+	}
+
+	action.AddCommonFlags(validateResidencyCmd)
+	validateResidencyCmd.Flags().BoolVar(&action.includeValid, "include-valid", false, "Show results for valid services as well")
+	validateResidencyCmd.Flags().StringVar(&action.filter, "filter", "", "Specify which services to validate")
+	return validateResidencyCmd
+}
+
+func (self *validateResidencyAction) validateResidency(cmd *cobra.Command, _ []string) error {
+	closeNotify := make(chan struct{})
+	self.eventNotify = make(chan *mgmt_pb.ServiceResidencyDetail, 1)
+
+	bindHandler := func(binding channel.Binding) error {
+		binding.AddReceiveHandler(int32(mgmt_pb.ContentType_ValidateResidencyResultType), self)
+		binding.AddCloseHandler(channel.CloseHandlerF(func(ch channel.Channel) {
+			close(closeNotify)
+		}))
+		return nil
+	}
+
+	ch, err := api.NewWsMgmtChannel(channel.BindHandlerF(bindHandler))
+	if err != nil {
+		return err
+	}
+
+	request := &mgmt_pb.ValidateResidencyRequest{
+		Filter: self.filter,
+	}
+
+	responseMsg, err := protobufs.MarshalTyped(request).WithTimeout(time.Duration(self.Timeout) * time.Second).SendForReply(ch)
+
+	response := &mgmt_pb.ValidateResidencyResponse{}
+	if err = protobufs.TypedResponse(response).Unmarshall(responseMsg, err); err != nil {
+		return err
+	}
+
+	if !response.Success {
+		return fmt.Errorf("failed to start residency validation: %s", response.Message)
+	}
+
+	fmt.Printf("started validation of %v services with residency constraints\n", response.ServiceCount)
+
+	expected := response.ServiceCount
+	invalid := 0
+
+	for expected > 0 {
+		select {
+		case <-closeNotify:
+			fmt.Printf("channel closed, exiting")
+			return nil
+		case detail := <-self.eventNotify:
+			if !detail.Valid {
+				invalid++
+			}
+			if self.includeValid || !detail.Valid {
+				fmt.Printf("serviceId: %s, serviceName: %s, constraints: [%s], valid: %v, message: %s\n",
+					detail.ServiceId, detail.ServiceName, strings.Join(detail.Constraints, ", "), detail.Valid, detail.Message)
+				for _, terminator := range detail.Terminators {
+					fmt.Printf("    terminatorId: %s, routerId: %s, routerName: %s, state: %s, detail: %s\n",
+						terminator.TerminatorId, terminator.RouterId, terminator.RouterName, terminator.State.String(), terminator.Detail)
+				}
+			}
+			expected--
+		}
+	}
+
+	fmt.Printf("%v services with residency constraints have no reachable terminators\n", invalid)
+	return nil
+}
+
+func (self *validateResidencyAction) HandleReceive(msg *channel.Message, _ channel.Channel) {
+	detail := &mgmt_pb.ServiceResidencyDetail{}
+	if err := proto.Unmarshal(msg.Body, detail); err != nil {
+		pfxlog.Logger().WithError(err).Error("unable to unmarshal service residency detail")
+		return
+	}
+
+	self.eventNotify <- detail
+}
@@ -45,6 +45,7 @@ func NewCmdDb(out io.Writer, errOut io.Writer) *cobra.Command {
 	cmd.AddCommand(NewAddDebugAdminAction())
 	cmd.AddCommand(NewAnonymizeAction())
 	cmd.AddCommand(NewDeleteSessionsFromDbCmd())
+	cmd.AddCommand(NewUnpackBackupAction())
 
 	return cmd
 }
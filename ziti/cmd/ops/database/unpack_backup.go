@@ -0,0 +1,107 @@
+/*
+	Copyright NetFoundry Inc.
+
+	Licensed under the Apache License, Version 2.0 (the "License");
+	you may not use this file except in compliance with the License.
+	You may obtain a copy of the License at
+
+	https://www.apache.org/licenses/LICENSE-2.0
+
+	Unless required by applicable law or agreed to in writing, software
+	distributed under the License is distributed on an "AS IS" BASIS,
+	WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+	See the License for the specific language governing permissions and
+	limitations under the License.
+*/
+
+package database
+
+import (
+	"fmt"
+	"github.com/openziti/ziti/controller/backup"
+	"github.com/pkg/errors"
+	"github.com/spf13/cobra"
+	"go.etcd.io/bbolt"
+	"io"
+	"os"
+	"path/filepath"
+)
+
+type UnpackBackupAction struct {
+	keyFile string
+}
+
+func NewUnpackBackupAction() *cobra.Command {
+	action := &UnpackBackupAction{}
+
+	cmd := &cobra.Command{
+		Use:   "unpack-backup <src> <dest>",
+		Short: "Decrypts and decompresses a controller database backup so it can be restored",
+		Long: "Decrypts and decompresses a controller database backup so it can be restored. Whether the backup is " +
+			"encrypted or compressed is determined from the .enc and .gz suffixes of the backup file name.",
+		Args: cobra.ExactArgs(2),
+		RunE: action.Run,
+	}
+
+	cmd.Flags().StringVar(&action.keyFile, "key-file", "", "File containing the key used to encrypt the backup")
+	return cmd
+}
+
+// Run implements this command
+func (o *UnpackBackupAction) Run(cmd *cobra.Command, args []string) error {
+	src, dest := args[0], args[1]
+
+	if _, err := os.Stat(dest); err == nil {
+		return errors.Errorf("destination '%s' already exists", dest)
+	}
+
+	var key []byte
+	if o.keyFile != "" {
+		var err error
+		if key, err = backup.LoadKey(o.keyFile); err != nil {
+			return err
+		}
+	}
+
+	srcFile, err := os.Open(src)
+	if err != nil {
+		return err
+	}
+	defer func() { _ = srcFile.Close() }()
+
+	reader, err := backup.NewArtifactReader(filepath.Base(src), srcFile, key)
+	if err != nil {
+		return err
+	}
+
+	destFile, err := os.OpenFile(dest, os.O_CREATE|os.O_EXCL|os.O_WRONLY, 0600)
+	if err != nil {
+		return err
+	}
+
+	_, err = io.Copy(destFile, reader)
+	if closeErr := destFile.Close(); err == nil {
+		err = closeErr
+	}
+	if err == nil {
+		err = verifyDb(dest)
+	}
+	if err != nil {
+		_ = os.Remove(dest)
+		return errors.Wrapf(err, "unable to unpack backup '%s'", src)
+	}
+
+	_, _ = fmt.Fprintf(cmd.OutOrStdout(), "unpacked backup to %s\n", dest)
+	return nil
+}
+
+func verifyDb(path string) error {
+	options := *bbolt.DefaultOptions
+	options.ReadOnly = true
+
+	db, err := bbolt.Open(path, 0400, &options)
+	if err != nil {
+		return err
+	}
+	return db.Close()
+}
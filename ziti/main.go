@@ -28,6 +28,7 @@ import (
 	"github.com/openziti/transport/v2/ws"
 	"github.com/openziti/transport/v2/wss"
 	"github.com/openziti/ziti/common/build"
+	"github.com/openziti/ziti/common/transport/quic"
 	"github.com/openziti/ziti/common/version"
 	"github.com/openziti/ziti/ziti/cmd"
 	"github.com/sirupsen/logrus"
@@ -45,6 +46,7 @@ func init() {
 	transport.AddAddressParser(ws.AddressParser{})
 	transport.AddAddressParser(wss.AddressParser{})
 	transport.AddAddressParser(udp.AddressParser{})
+	transport.AddAddressParser(quic.AddressParser{})
 
 	build.InitBuildInfo(version.GetCmdBuildInfo())
 }
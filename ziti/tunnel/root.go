@@ -71,6 +71,7 @@ func NewTunnelCmd(legacy bool) *cobra.Command {
 	root.PersistentFlags().BoolVar(&sdkFlowControl, "sdk-flow-control", true, "enables sdk flow control")
 	root.PersistentFlags().Uint8Var(&maxDefaultConnections, "default-connections", 2, "sets the desired number of default connections")
 	root.PersistentFlags().Uint8Var(&maxControlConnections, "control-connections", 1, "sets the desired number of control connections")
+	root.PersistentFlags().BoolVar(&execHealthChecks, "exec-health-checks", false, "allow exec health checks defined in host configs to run commands on this host")
 	root.AddCommand(NewHostCmd())
 	root.AddCommand(NewProxyCmd())
 	for _, cmdF := range hostSpecificCmds {
@@ -96,6 +97,7 @@ var cliAgentAlias string
 var sdkFlowControl bool
 var maxDefaultConnections uint8
 var maxControlConnections uint8
+var execHealthChecks bool
 
 func rootPreRun(cmd *cobra.Command, _ []string) {
 	verbose, err := cmd.Flags().GetBool("verbose")
@@ -148,6 +150,7 @@ func rootPostRun(cmd *cobra.Command, _ []string) {
 	}
 
 	serviceListenerGroup := intercept.NewServiceListenerGroup(interceptor, resolver)
+	serviceListenerGroup.EnableExecHealthChecks(execHealthChecks)
 	dnsIpRange, _ := cmd.Flags().GetString(dnsSvcIpRangeFlag)
 	if err := intercept.SetDnsInterceptIpRange(dnsIpRange); err != nil {
 		log.Fatalf("invalid dns service IP range %s: %v", dnsIpRange, err)